@@ -293,6 +293,11 @@ func ApplyVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request
 				newDisk.Name = request.AddVolumeOptions.Name
 
 				vmiSpec.Domain.Devices.Disks = append(vmiSpec.Domain.Devices.Disks, *newDisk)
+			} else if request.AddVolumeOptions.Filesystem != nil {
+				newFilesystem := request.AddVolumeOptions.Filesystem.DeepCopy()
+				newFilesystem.Name = request.AddVolumeOptions.Name
+
+				vmiSpec.Domain.Devices.Filesystems = append(vmiSpec.Domain.Devices.Filesystems, *newFilesystem)
 			}
 		}
 
@@ -300,6 +305,7 @@ func ApplyVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request
 
 		newVolumesList := []v1.Volume{}
 		newDisksList := []v1.Disk{}
+		newFilesystemsList := []v1.Filesystem{}
 
 		for _, volume := range vmiSpec.Volumes {
 			if volume.Name != request.RemoveVolumeOptions.Name {
@@ -313,8 +319,15 @@ func ApplyVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request
 			}
 		}
 
+		for _, filesystem := range vmiSpec.Domain.Devices.Filesystems {
+			if filesystem.Name != request.RemoveVolumeOptions.Name {
+				newFilesystemsList = append(newFilesystemsList, filesystem)
+			}
+		}
+
 		vmiSpec.Volumes = newVolumesList
 		vmiSpec.Domain.Devices.Disks = newDisksList
+		vmiSpec.Domain.Devices.Filesystems = newFilesystemsList
 	}
 
 	return vmiSpec
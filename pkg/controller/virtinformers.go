@@ -138,6 +138,12 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineBackupTracker objects
 	VirtualMachineBackupTracker() cache.SharedIndexInformer
 
+	// Watches VirtualMachineBackupSchedule objects
+	VirtualMachineBackupSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachineBackupRestore objects
+	VirtualMachineBackupRestore() cache.SharedIndexInformer
+
 	// Watches VirtualMachineExport objects
 	VirtualMachineExport() cache.SharedIndexInformer
 
@@ -150,9 +156,21 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotGroup objects
+	VirtualMachineSnapshotGroup() cache.SharedIndexInformer
+
+	// Watches VirtualMachineSnapshotGrant objects
+	VirtualMachineSnapshotGrant() cache.SharedIndexInformer
+
+	// Watches VirtualMachineRestoreGroup objects
+	VirtualMachineRestoreGroup() cache.SharedIndexInformer
+
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
+	// Watches VirtualMachineStorageMigrationPlan objects
+	VirtualMachineStorageMigrationPlan() cache.SharedIndexInformer
+
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
@@ -697,6 +715,20 @@ func (f *kubeInformerFactory) VirtualMachineBackupTracker() cache.SharedIndexInf
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineBackupSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmBackupScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().BackupV1alpha1().RESTClient(), "virtualmachinebackupschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &backupv1.VirtualMachineBackupSchedule{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineBackupRestore() cache.SharedIndexInformer {
+	return f.getInformer("vmBackupRestoreInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().BackupV1alpha1().RESTClient(), "virtualmachinebackuprestores", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &backupv1.VirtualMachineBackupRestore{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func GetVirtualMachineExportInformerIndexers() cache.Indexers {
 	return cache.Indexers{
 		"pvc": func(obj interface{}) ([]string, error) {
@@ -727,6 +759,20 @@ func GetVirtualMachineExportInformerIndexers() cache.Indexers {
 
 			return nil, nil
 		},
+		"vmbackup": func(obj interface{}) ([]string, error) {
+			export, ok := obj.(*exportv1.VirtualMachineExport)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if export.Spec.Source.APIGroup != nil &&
+				*export.Spec.Source.APIGroup == backupv1.SchemeGroupVersion.Group &&
+				export.Spec.Source.Kind == "VirtualMachineBackup" {
+				return []string{fmt.Sprintf("%s/%s", export.Namespace, export.Spec.Source.Name)}, nil
+			}
+
+			return nil, nil
+		},
 		"vm": func(obj interface{}) ([]string, error) {
 			export, ok := obj.(*exportv1.VirtualMachineExport)
 			if !ok {
@@ -830,6 +876,27 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotGroup() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotGroupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotgroups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotGroup{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotGrant() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotGrantInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotgrants", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotGrant{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineRestoreGroup() cache.SharedIndexInformer {
+	return f.getInformer("vmRestoreGroupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinerestoregroups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineRestoreGroup{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	return f.getInformer("migrationPolicyInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationPolicies, k8sv1.NamespaceAll, fields.Everything())
@@ -837,6 +904,13 @@ func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineStorageMigrationPlan() cache.SharedIndexInformer {
+	return f.getInformer("vmStorageMigrationPlanInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceVirtualMachineStorageMigrationPlans, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &migrationsv1.VirtualMachineStorageMigrationPlan{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clone.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
@@ -880,6 +954,9 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 
 			source := vmClone.Spec.Source
 			if source != nil && *source.APIGroup == snapshot.GroupName && source.Kind == "VirtualMachineSnapshot" {
+				if vmClone.Spec.SourceNamespace != "" {
+					return []string{fmt.Sprintf("%s/%s", vmClone.Spec.SourceNamespace, source.Name)}, nil
+				}
 				return []string{getkey(vmClone, source.Name)}, nil
 			}
 
@@ -33,6 +33,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
+// DiskDataDir is the directory of the ephemeral disk dir holding the overlays of the disks
+const DiskDataDir = "disk-data"
+
 const (
 	ephemeralDiskPVCBaseDir         = "/var/run/kubevirt-private/vmi-disks"
 	ephemeralDiskBlockDeviceBaseDir = "/dev"
@@ -51,6 +54,7 @@ type ephemeralDiskCreator struct {
 	pvcBaseDir      string
 	blockDevBaseDir string
 	discCreateFunc  func(backingFile string, backingFormat string, imagePath string) ([]byte, error)
+	discRebaseFunc  func(backingFile string, backingFormat string, imagePath string) ([]byte, error)
 }
 
 func NewEphemeralDiskCreator(mountBaseDir string) *ephemeralDiskCreator {
@@ -59,6 +63,7 @@ func NewEphemeralDiskCreator(mountBaseDir string) *ephemeralDiskCreator {
 		pvcBaseDir:      ephemeralDiskPVCBaseDir,
 		blockDevBaseDir: ephemeralDiskBlockDeviceBaseDir,
 		discCreateFunc:  createBackingDisk,
+		discRebaseFunc:  rebaseBackingDisk,
 	}
 }
 
@@ -102,6 +107,14 @@ func (c *ephemeralDiskCreator) CreateBackedImageForVolume(volume v1.Volume, back
 	imagePath := c.GetFilePath(volume.Name)
 
 	if _, err := os.Stat(imagePath); err == nil {
+		if volume.ContainerDisk == nil || volume.ContainerDisk.Persistent == nil {
+			return nil
+		}
+		// The overlay of a persistent containerDisk outlives the pod, while the path of its
+		// backing file follows the position of the volume in the VMI spec
+		if output, err := c.discRebaseFunc(backingFile, backingFormat, imagePath); err != nil {
+			return fmt.Errorf("qemu-img failed with output '%s': %v", string(output), err)
+		}
 		return nil
 	} else if !errors.Is(err, os.ErrNotExist) {
 		return err
@@ -154,3 +167,20 @@ func createBackingDisk(backingFile string, backingFormat string, imagePath strin
 	)
 	return cmd.CombinedOutput()
 }
+
+func rebaseBackingDisk(backingFile string, backingFormat string, imagePath string) ([]byte, error) {
+	// Only the backing file path is rewritten, the image behind it stays the same
+	// #nosec No risk for attacker injection. Parameters are predefined strings
+	cmd := exec.Command("qemu-img",
+		"rebase",
+		"-u",
+		"-f",
+		"qcow2",
+		"-b",
+		backingFile,
+		"-F",
+		backingFormat,
+		imagePath,
+	)
+	return cmd.CombinedOutput()
+}
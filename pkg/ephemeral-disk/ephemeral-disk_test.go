@@ -28,6 +28,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -70,6 +72,7 @@ var _ = Describe("ContainerDisk", func() {
 			pvcBaseDir:      pvcBaseTempDirPath,
 			blockDevBaseDir: blockDevBaseDir,
 			discCreateFunc:  fakeCreateBackingDisk,
+			discRebaseFunc:  fakeRebaseBackingDisk,
 		}
 	})
 
@@ -136,6 +139,35 @@ var _ = Describe("ContainerDisk", func() {
 			})
 		})
 
+		Context("With a persistent containerDisk", func() {
+			It("Should point the existing overlay to the current backing file", func() {
+				volume := v1.Volume{
+					Name: "disk0",
+					VolumeSource: v1.VolumeSource{
+						ContainerDisk: &v1.ContainerDiskSource{
+							Image:      "registry:5000/disk",
+							Persistent: &v1.PersistentContainerDisk{},
+						},
+					},
+				}
+				Expect(createBackingImageForPVC("disk_0", false)).To(Succeed())
+				Expect(createBackingImageForPVC("disk_1", false)).To(Succeed())
+
+				var rebasedOnto string
+				creator.discRebaseFunc = func(backingFile string, backingFormat string, imagePath string) ([]byte, error) {
+					rebasedOnto = backingFile
+					return nil, nil
+				}
+
+				Expect(creator.CreateBackedImageForVolume(volume, creator.getBackingFilePath("disk_0", false), "raw")).To(Succeed())
+				Expect(rebasedOnto).To(BeEmpty())
+
+				By("Reusing the overlay after the volume moved")
+				Expect(creator.CreateBackedImageForVolume(volume, creator.getBackingFilePath("disk_1", false), "raw")).To(Succeed())
+				Expect(rebasedOnto).To(Equal(creator.getBackingFilePath("disk_1", false)))
+			})
+		})
+
 		Context("With a block pvc backed ephemeral volume", func() {
 			It("Should create VirtualMachineInstance's ephemeral image", func() {
 				By("Creating a minimal VirtualMachineInstance object with single ephemeral-backed PVC")
@@ -189,3 +221,7 @@ func fakeCreateBackingDisk(backingFile string, backingFormat string, imagePath s
 	err = f.Close()
 	return nil, err
 }
+
+func fakeRebaseBackingDisk(backingFile string, backingFormat string, imagePath string) ([]byte, error) {
+	return nil, nil
+}
@@ -46,6 +46,7 @@ It has these top-level messages:
 	BackupRequest
 	RedefineCheckpointRequest
 	RedefineCheckpointResponse
+	DeleteCheckpointRequest
 */
 package v1
 
@@ -1065,6 +1066,30 @@ func (m *RedefineCheckpointResponse) GetCheckpointInvalid() bool {
 	return false
 }
 
+type DeleteCheckpointRequest struct {
+	Vmi        *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Checkpoint []byte `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (m *DeleteCheckpointRequest) Reset()                    { *m = DeleteCheckpointRequest{} }
+func (m *DeleteCheckpointRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteCheckpointRequest) ProtoMessage()               {}
+func (*DeleteCheckpointRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *DeleteCheckpointRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *DeleteCheckpointRequest) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -1102,6 +1127,7 @@ func init() {
 	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
 	proto.RegisterType((*RedefineCheckpointRequest)(nil), "kubevirt.cmd.v1.RedefineCheckpointRequest")
 	proto.RegisterType((*RedefineCheckpointResponse)(nil), "kubevirt.cmd.v1.RedefineCheckpointResponse")
+	proto.RegisterType((*DeleteCheckpointRequest)(nil), "kubevirt.cmd.v1.DeleteCheckpointRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1150,6 +1176,7 @@ type CmdClient interface {
 	GetScreenshot(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error)
 	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
 	RedefineCheckpoint(ctx context.Context, in *RedefineCheckpointRequest, opts ...grpc.CallOption) (*RedefineCheckpointResponse, error)
+	DeleteCheckpoint(ctx context.Context, in *DeleteCheckpointRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1475,6 +1502,15 @@ func (c *cmdClient) RedefineCheckpoint(ctx context.Context, in *RedefineCheckpoi
 	return out, nil
 }
 
+func (c *cmdClient) DeleteCheckpoint(ctx context.Context, in *DeleteCheckpointRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/DeleteCheckpoint", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1513,6 +1549,7 @@ type CmdServer interface {
 	GetScreenshot(context.Context, *VMIRequest) (*ScreenshotResponse, error)
 	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
 	RedefineCheckpoint(context.Context, *RedefineCheckpointRequest) (*RedefineCheckpointResponse, error)
+	DeleteCheckpoint(context.Context, *DeleteCheckpointRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -2149,6 +2186,24 @@ func _Cmd_RedefineCheckpoint_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_DeleteCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).DeleteCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/DeleteCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).DeleteCheckpoint(ctx, req.(*DeleteCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2293,6 +2348,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RedefineCheckpoint",
 			Handler:    _Cmd_RedefineCheckpoint_Handler,
 		},
+		{
+			MethodName: "DeleteCheckpoint",
+			Handler:    _Cmd_DeleteCheckpoint_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2301,130 +2360,130 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1986 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0x1b, 0xb7,
-	0x11, 0x37, 0x45, 0x4a, 0x26, 0x57, 0x7f, 0x62, 0xc3, 0x92, 0x7c, 0x62, 0x6b, 0x5b, 0x45, 0x3b,
-	0xae, 0xd2, 0x26, 0x52, 0xed, 0x38, 0x99, 0x8e, 0xa7, 0x93, 0x71, 0x44, 0x51, 0x8a, 0x12, 0xd3,
-	0xa6, 0x8f, 0x92, 0x3c, 0x4d, 0x9b, 0xc9, 0x40, 0x77, 0x10, 0x89, 0xea, 0x0e, 0x60, 0x0e, 0x38,
-	0xd6, 0xcc, 0x53, 0x3b, 0xe9, 0xf4, 0xa1, 0x33, 0xfd, 0x60, 0xfd, 0x04, 0x7d, 0xeb, 0xb7, 0xe8,
-	0x7b, 0x06, 0xb8, 0x3b, 0xea, 0xc8, 0xbb, 0x13, 0xad, 0x21, 0x9f, 0x08, 0x60, 0x77, 0x7f, 0xbb,
-	0x58, 0xec, 0x2e, 0xb0, 0x47, 0xf8, 0xb0, 0x7f, 0xd9, 0xdd, 0xeb, 0x11, 0xee, 0x7a, 0x34, 0xf8,
-	0xd8, 0x23, 0x21, 0x77, 0x7a, 0x34, 0xf8, 0xd8, 0x11, 0xfe, 0x9e, 0xe3, 0xbb, 0x7b, 0x83, 0x27,
-	0xfa, 0x67, 0xb7, 0x1f, 0x08, 0x25, 0xd0, 0x07, 0x97, 0xe1, 0x39, 0x1d, 0xb0, 0x40, 0xed, 0xea,
-	0xb5, 0xc1, 0x13, 0x7c, 0x01, 0xf7, 0xde, 0x50, 0x3f, 0x3c, 0xa3, 0x81, 0x64, 0x82, 0xdb, 0x54,
-	0xf6, 0x05, 0x97, 0x14, 0x7d, 0x0a, 0xd5, 0x20, 0x1e, 0x5b, 0xa5, 0xed, 0xd2, 0xce, 0xf2, 0xd3,
-	0xad, 0xdd, 0x09, 0xd1, 0xdd, 0x84, 0xd9, 0x1e, 0xb1, 0x22, 0x0b, 0x6e, 0x0f, 0x22, 0x24, 0x6b,
-	0x61, 0xbb, 0xb4, 0x53, 0xb3, 0x93, 0x29, 0x7e, 0x04, 0xe5, 0xb3, 0xd6, 0xb1, 0x61, 0xf0, 0xd9,
-	0x57, 0x52, 0x70, 0x03, 0xbb, 0x62, 0x27, 0x53, 0xfc, 0x04, 0xca, 0x8d, 0xf6, 0x29, 0x5a, 0x83,
-	0x05, 0xe6, 0x1a, 0xda, 0xaa, 0xbd, 0xc0, 0x5c, 0x54, 0x87, 0xaa, 0x64, 0xe7, 0x1e, 0xe3, 0x5d,
-	0x69, 0x2d, 0x6c, 0x97, 0x77, 0x56, 0xed, 0xd1, 0x1c, 0xef, 0xc1, 0xed, 0x4e, 0x34, 0xce, 0x88,
-	0xad, 0xc3, 0xe2, 0x80, 0x78, 0x21, 0x35, 0x66, 0x54, 0xec, 0x68, 0x82, 0x9b, 0xb0, 0xd8, 0x26,
-	0x5d, 0x2a, 0x35, 0xd9, 0x11, 0x21, 0x57, 0x46, 0xa2, 0x62, 0x47, 0x13, 0x84, 0xa0, 0x12, 0x72,
-	0xa6, 0x62, 0xd3, 0xcd, 0x58, 0xaf, 0x49, 0xf6, 0x03, 0xb5, 0xca, 0x06, 0xda, 0x8c, 0xf1, 0x33,
-	0x58, 0x6a, 0x51, 0x5f, 0x04, 0x43, 0xb4, 0x09, 0x4b, 0xc4, 0x4f, 0x01, 0xc5, 0xb3, 0x3c, 0x24,
-	0xfc, 0xdf, 0x12, 0x54, 0x1a, 0xd4, 0xf3, 0x32, 0xb6, 0xee, 0xc1, 0x92, 0x6f, 0xe0, 0x0c, 0xfb,
-	0xf2, 0xd3, 0xfb, 0x19, 0x4f, 0x47, 0xda, 0xec, 0x98, 0x0d, 0x7d, 0x04, 0x8b, 0x7d, 0xbd, 0x0d,
-	0xab, 0xbc, 0x5d, 0xde, 0x59, 0x7e, 0xba, 0x99, 0xe1, 0x37, 0x9b, 0xb4, 0x23, 0x26, 0xf4, 0x19,
-	0xd4, 0x5c, 0x26, 0x15, 0xe1, 0x0e, 0x95, 0x56, 0xc5, 0x48, 0x58, 0x19, 0x89, 0xd8, 0x8f, 0xf6,
-	0x15, 0x2b, 0xda, 0x81, 0x8a, 0xd3, 0x0f, 0xa5, 0xb5, 0x68, 0x44, 0xd6, 0x33, 0x22, 0x8d, 0xf6,
-	0xa9, 0x6d, 0x38, 0xf0, 0x0b, 0xa8, 0x9e, 0x88, 0xbe, 0xf0, 0x44, 0x77, 0x88, 0x9e, 0x01, 0xf0,
-	0xd0, 0x27, 0xdf, 0x39, 0xd4, 0xf3, 0xa4, 0x55, 0x32, 0xb2, 0x1b, 0x59, 0x59, 0xea, 0x79, 0x76,
-	0x4d, 0x33, 0xea, 0x91, 0xc4, 0xff, 0x2a, 0xc1, 0x52, 0xa7, 0xb5, 0xcf, 0x84, 0x44, 0x18, 0x56,
-	0x7c, 0xc2, 0xc3, 0x0b, 0xe2, 0xa8, 0x30, 0xa0, 0x81, 0xf1, 0x53, 0xcd, 0x1e, 0x5b, 0xd3, 0x51,
-	0xd4, 0x0f, 0x84, 0x1b, 0x3a, 0x89, 0x87, 0x93, 0x69, 0x3a, 0x00, 0xcb, 0x63, 0x01, 0x88, 0xee,
-	0x40, 0x59, 0x5e, 0x86, 0x56, 0xc5, 0xac, 0xea, 0xa1, 0x3e, 0xbc, 0x0b, 0xe2, 0x33, 0x6f, 0x68,
-	0x2d, 0x9a, 0xc5, 0x78, 0x86, 0xff, 0x59, 0x82, 0xea, 0x01, 0x93, 0x97, 0xc7, 0xfc, 0x42, 0x18,
-	0x26, 0x11, 0xf8, 0x44, 0xc5, 0x86, 0xc4, 0x33, 0xb4, 0x0d, 0xcb, 0xe7, 0xc4, 0xb9, 0x64, 0xbc,
-	0x7b, 0xc8, 0x3c, 0x1a, 0x9b, 0x91, 0x5e, 0x42, 0x0f, 0x01, 0xb4, 0xbd, 0xc4, 0xeb, 0x24, 0xf1,
-	0x53, 0xb1, 0x53, 0x2b, 0x1a, 0x41, 0xbb, 0x24, 0x61, 0xa8, 0x18, 0x86, 0xf4, 0x12, 0xfe, 0x7f,
-	0x09, 0x56, 0x1b, 0x5e, 0x28, 0x15, 0x0d, 0x1a, 0x82, 0x5f, 0xb0, 0x2e, 0xda, 0x05, 0xd4, 0x7c,
-	0xd7, 0x27, 0xdc, 0xd5, 0xf6, 0xc9, 0x26, 0x27, 0xe7, 0x1e, 0x8d, 0x42, 0xa9, 0x6a, 0xe7, 0x50,
-	0xd0, 0x1f, 0x60, 0xeb, 0x30, 0xa0, 0x54, 0xc7, 0x83, 0x4d, 0xfb, 0x22, 0x50, 0x8c, 0x77, 0x0f,
-	0x98, 0x8c, 0xc4, 0x16, 0x8c, 0x58, 0x31, 0x03, 0x7a, 0x0e, 0xd6, 0xbe, 0x70, 0x7a, 0xf2, 0x80,
-	0xc9, 0xbe, 0x47, 0x86, 0x87, 0x22, 0x68, 0x1e, 0x1e, 0x1f, 0x85, 0x54, 0x2a, 0x69, 0xf6, 0x53,
-	0xb5, 0x0b, 0xe9, 0x5a, 0xb6, 0x43, 0x03, 0x46, 0xbc, 0x86, 0xe0, 0x52, 0x78, 0xf4, 0xa5, 0xb8,
-	0x52, 0x5c, 0x89, 0x64, 0x8b, 0xe8, 0xf8, 0x13, 0xd8, 0x3a, 0xe6, 0x8a, 0x06, 0x17, 0xc4, 0xa1,
-	0xfb, 0x8c, 0xbb, 0x8c, 0x77, 0x5b, 0xac, 0x1b, 0x10, 0xa5, 0xcf, 0x71, 0x53, 0x27, 0x9f, 0xea,
-	0x09, 0x37, 0x39, 0x90, 0x68, 0x86, 0xff, 0x77, 0x1b, 0x36, 0xce, 0x22, 0xe7, 0xb5, 0x88, 0xd3,
-	0x63, 0x9c, 0xbe, 0xee, 0x6b, 0x01, 0x89, 0xbe, 0x86, 0xf5, 0x71, 0x42, 0x14, 0x69, 0x71, 0x5d,
-	0xcb, 0x66, 0x5b, 0x44, 0xb6, 0x73, 0x85, 0xd0, 0x33, 0xd8, 0x68, 0x51, 0x7f, 0x9f, 0x78, 0x9e,
-	0x10, 0xbc, 0xa3, 0x88, 0x92, 0x6d, 0x1a, 0x30, 0x11, 0x79, 0x73, 0xd5, 0xce, 0x27, 0xa2, 0xdf,
-	0xc1, 0xbd, 0x76, 0x40, 0xf5, 0xba, 0x43, 0x14, 0x75, 0xcf, 0x84, 0x17, 0xfa, 0x71, 0xfe, 0xd6,
-	0xec, 0x3c, 0x92, 0x2e, 0xc0, 0x2a, 0xce, 0x29, 0xe3, 0xaf, 0xbc, 0x02, 0x9c, 0x24, 0x9d, 0x3d,
-	0x62, 0x45, 0x1d, 0xa8, 0x99, 0x00, 0xd0, 0xb1, 0x1b, 0x67, 0xee, 0xa7, 0x19, 0xb9, 0x5c, 0x37,
-	0xed, 0x8e, 0xe4, 0x9a, 0x5c, 0x05, 0x43, 0xfb, 0x0a, 0xa7, 0x20, 0xea, 0x96, 0x0a, 0xa3, 0xee,
-	0x00, 0x56, 0x9d, 0x74, 0xd8, 0x5a, 0xb7, 0xcd, 0x06, 0x1e, 0x66, 0xcb, 0x40, 0x9a, 0xcb, 0x1e,
-	0x17, 0x42, 0x3f, 0x96, 0x60, 0x8b, 0x25, 0x61, 0x70, 0x20, 0x7c, 0xc2, 0xf8, 0x17, 0x4a, 0x11,
-	0xa7, 0xe7, 0x53, 0xae, 0xac, 0xaa, 0xd9, 0x5b, 0xf3, 0x3d, 0xf7, 0x76, 0x5c, 0x84, 0x13, 0xed,
-	0xb5, 0x58, 0x0f, 0xe2, 0x80, 0x46, 0xc4, 0x51, 0x10, 0x5a, 0x35, 0xa3, 0xfd, 0xf3, 0x9b, 0x6a,
-	0x1f, 0x01, 0x44, 0x6a, 0x73, 0x90, 0xeb, 0x6f, 0x61, 0x6d, 0xfc, 0x20, 0x74, 0xe1, 0xba, 0xa4,
-	0xc3, 0x38, 0xda, 0xf5, 0x10, 0xed, 0xa5, 0x2f, 0xb7, 0xbc, 0xc0, 0x48, 0xaa, 0x57, 0x7c, 0xef,
-	0x3d, 0x5f, 0xf8, 0x7d, 0xa9, 0xfe, 0x12, 0x1e, 0x5e, 0xef, 0x85, 0x1c, 0x45, 0x63, 0xb7, 0x68,
-	0x2d, 0x8d, 0xf6, 0x3d, 0xdc, 0x2f, 0xd8, 0x55, 0x0e, 0xcc, 0x8b, 0x71, 0x7b, 0x7f, 0x93, 0xb1,
-	0xb7, 0x30, 0xdb, 0x53, 0x2a, 0xf1, 0x00, 0xe0, 0xac, 0x75, 0x6c, 0xd3, 0xef, 0x75, 0x81, 0x41,
-	0x8f, 0xa1, 0x3c, 0xf0, 0x59, 0x9c, 0xc3, 0xd9, 0xcb, 0x49, 0x73, 0x6a, 0x06, 0xf4, 0x02, 0x6e,
-	0x8b, 0xe8, 0x18, 0x62, 0xed, 0x8f, 0xdf, 0xef, 0xd0, 0xec, 0x44, 0x0c, 0x9f, 0xc0, 0x9d, 0x2b,
-	0x7b, 0x6e, 0xa8, 0xdd, 0x1a, 0xd7, 0xbe, 0x72, 0x85, 0xfa, 0x63, 0x09, 0x96, 0x9b, 0xef, 0xa8,
-	0x93, 0x20, 0x3e, 0x04, 0x70, 0xcd, 0xa9, 0xbc, 0x22, 0x3e, 0x8d, 0x9d, 0x97, 0x5a, 0xd1, 0x48,
-	0x0d, 0xe1, 0xfb, 0x84, 0xbb, 0xc9, 0x95, 0x17, 0x4f, 0xf5, 0x5b, 0xe3, 0x8b, 0xa0, 0x9b, 0x14,
-	0x13, 0x33, 0x46, 0x8f, 0x61, 0x4d, 0x31, 0x9f, 0x8a, 0x50, 0x75, 0xa8, 0x23, 0xb8, 0x2b, 0x4d,
-	0x0d, 0x59, 0xb4, 0x27, 0x56, 0xf1, 0x1a, 0xac, 0x34, 0xfd, 0xbe, 0x1a, 0xc6, 0x56, 0xe0, 0xcf,
-	0xa1, 0x6a, 0xa7, 0xde, 0x72, 0x32, 0x74, 0x1c, 0x2a, 0x65, 0x7c, 0xc1, 0x24, 0x53, 0x4d, 0xf1,
-	0xa9, 0x94, 0xa4, 0x9b, 0x04, 0x46, 0x32, 0xc5, 0xdf, 0xc1, 0x5a, 0x14, 0x5b, 0xb3, 0x3e, 0x24,
-	0x37, 0x61, 0x29, 0xda, 0x7c, 0xac, 0x21, 0x9e, 0x61, 0x0e, 0xf7, 0x22, 0x05, 0xa6, 0xba, 0xce,
-	0xaa, 0x65, 0x1b, 0x96, 0xdd, 0x2b, 0xb4, 0xe4, 0x12, 0x4f, 0x2d, 0xe1, 0x77, 0x70, 0xd7, 0x5c,
-	0x68, 0x26, 0x9b, 0x66, 0xd4, 0xf6, 0x11, 0xdc, 0xed, 0x4e, 0x62, 0xc5, 0x3a, 0xb3, 0x04, 0xfc,
-	0x8f, 0x12, 0x6c, 0x18, 0xd5, 0xa7, 0x92, 0x06, 0x2f, 0x99, 0x54, 0xb3, 0xaa, 0x7f, 0x06, 0x1b,
-	0xdd, 0x3c, 0xbc, 0xd8, 0x84, 0x7c, 0x22, 0xfe, 0x77, 0x09, 0x2c, 0x63, 0x86, 0x7e, 0xd3, 0xc8,
-	0xa1, 0x54, 0xd4, 0x9f, 0xd9, 0xed, 0xcf, 0xc1, 0xea, 0x16, 0x40, 0xc6, 0xc6, 0x14, 0xd2, 0xf1,
-	0x10, 0x56, 0xa2, 0xb4, 0x99, 0xcd, 0x84, 0x3a, 0x54, 0xe9, 0x3b, 0xa6, 0x1a, 0xc2, 0x8d, 0x54,
-	0x2e, 0xda, 0xa3, 0xb9, 0x8e, 0x3d, 0xa9, 0xdc, 0xd7, 0xa1, 0x8a, 0x9f, 0x90, 0xf1, 0x0c, 0x7f,
-	0x03, 0x77, 0x8c, 0x27, 0xda, 0xfa, 0xa1, 0xfc, 0x9e, 0x69, 0x9b, 0x4d, 0xc4, 0x85, 0xdc, 0x44,
-	0xfc, 0x2a, 0x8e, 0xb3, 0x08, 0x7b, 0xa6, 0xbd, 0x61, 0x01, 0xab, 0xfa, 0x4d, 0xf7, 0x03, 0xbd,
-	0x69, 0xb5, 0xfa, 0x0c, 0x36, 0x43, 0x7e, 0x61, 0x44, 0x4f, 0xf2, 0x8c, 0x2e, 0xa0, 0xe2, 0xb7,
-	0x70, 0x37, 0xea, 0x50, 0x0e, 0x42, 0xbf, 0x7f, 0x53, 0xa5, 0x75, 0xa8, 0xba, 0xa1, 0xdf, 0x6f,
-	0x13, 0xd5, 0x8b, 0x0f, 0x7f, 0x34, 0xc7, 0xe7, 0xf0, 0x41, 0xa7, 0x79, 0x36, 0x8f, 0xdc, 0xd3,
-	0xc5, 0x8c, 0x0e, 0xcc, 0xab, 0x28, 0x2e, 0xc4, 0xf1, 0x14, 0xff, 0xad, 0x04, 0x5b, 0x2f, 0x4d,
-	0xcf, 0xdc, 0xa2, 0x44, 0x86, 0x01, 0xd5, 0x17, 0xe2, 0x1c, 0x52, 0xdd, 0x9b, 0xc4, 0x8c, 0x15,
-	0x67, 0x09, 0xf8, 0x5b, 0xfd, 0xde, 0xfd, 0x0b, 0x75, 0x54, 0x64, 0x47, 0x87, 0x3a, 0x01, 0x55,
-	0xf3, 0xbb, 0x6a, 0x24, 0x6c, 0x1e, 0xb0, 0x40, 0x0d, 0x6d, 0xa2, 0xe8, 0x5c, 0xca, 0x26, 0x86,
-	0x15, 0x37, 0x01, 0x6c, 0x9d, 0x47, 0xfa, 0xca, 0xf6, 0xd8, 0x1a, 0x96, 0x80, 0x3a, 0x4e, 0x40,
-	0x29, 0x97, 0x3d, 0x31, 0xb3, 0x3b, 0x11, 0x54, 0x7c, 0xe6, 0x27, 0xc5, 0xc1, 0x8c, 0xf5, 0x9a,
-	0x4b, 0x14, 0x31, 0x39, 0xba, 0x62, 0x9b, 0x31, 0x7e, 0x03, 0xab, 0xfb, 0xc4, 0xb9, 0x0c, 0xfb,
-	0xf3, 0x73, 0x9e, 0x03, 0x5b, 0x36, 0x75, 0xe9, 0x05, 0xe3, 0xb4, 0xd1, 0xa3, 0xce, 0x65, 0x5f,
-	0x30, 0x7e, 0xe3, 0xb3, 0x79, 0x08, 0xe0, 0x8c, 0x84, 0x63, 0x0d, 0xa9, 0x15, 0xfc, 0xf7, 0x12,
-	0xd4, 0xf3, 0xb4, 0xcc, 0x1c, 0x84, 0x57, 0x3a, 0x8e, 0xf9, 0x80, 0x78, 0x2c, 0x69, 0xfa, 0xb2,
-	0x84, 0xa7, 0xff, 0xb9, 0x0f, 0xe5, 0x86, 0xef, 0xa2, 0x57, 0x80, 0x3a, 0x43, 0xee, 0x8c, 0x3f,
-	0x8a, 0xd0, 0xcf, 0x72, 0x37, 0x17, 0xb9, 0xa1, 0x5e, 0x6c, 0x0d, 0xbe, 0x85, 0x5e, 0xc3, 0xbd,
-	0x36, 0x09, 0x25, 0x9d, 0x1b, 0xe0, 0x1b, 0xd8, 0x38, 0xe5, 0xfd, 0xb9, 0x42, 0x76, 0x60, 0x3d,
-	0xaa, 0x98, 0x13, 0x88, 0xd9, 0x8e, 0x65, 0xac, 0xb0, 0x5e, 0x0f, 0x6a, 0xc3, 0xe6, 0x69, 0x5c,
-	0x2f, 0xe7, 0xe9, 0x4c, 0x9b, 0x4a, 0xaa, 0xe6, 0x06, 0x78, 0x02, 0x56, 0x47, 0x5c, 0x28, 0x9b,
-	0x9e, 0x0b, 0x31, 0x3f, 0x54, 0x1b, 0x36, 0x3b, 0xbd, 0x50, 0xb9, 0xe2, 0xaf, 0x7c, 0x6e, 0x98,
-	0xaf, 0x00, 0x7d, 0xcd, 0x3c, 0x6f, 0x6e, 0x78, 0x6d, 0x58, 0x3f, 0xa0, 0x1e, 0x55, 0xf3, 0x3b,
-	0x9c, 0xb7, 0xb0, 0x11, 0x35, 0x0a, 0x93, 0x90, 0xbf, 0xc8, 0x7e, 0xd0, 0x9b, 0x68, 0x28, 0xa6,
-	0x9e, 0xba, 0x4e, 0xc9, 0x91, 0xd0, 0x09, 0x09, 0xba, 0x54, 0xcd, 0x60, 0xe9, 0x1f, 0xe1, 0x41,
-	0x83, 0x70, 0x87, 0x4e, 0x78, 0xf3, 0xea, 0x23, 0xcb, 0x6c, 0x47, 0xcf, 0xba, 0x9c, 0x78, 0x91,
-	0x91, 0x6d, 0xe1, 0x36, 0x3c, 0x4a, 0x78, 0xd8, 0x9f, 0x01, 0xf3, 0x4f, 0xf0, 0xe8, 0x90, 0x71,
-	0xe2, 0xb1, 0xc9, 0x4c, 0x9a, 0x87, 0xc1, 0xaf, 0x00, 0x7d, 0x29, 0x54, 0xdf, 0x0b, 0xbb, 0x5f,
-	0x0a, 0xa9, 0x0e, 0xe8, 0x80, 0x39, 0x54, 0xce, 0x80, 0xd7, 0x82, 0xda, 0x11, 0x55, 0x51, 0x93,
-	0x82, 0x1e, 0x64, 0x38, 0xd3, 0xed, 0x56, 0xfd, 0x51, 0xb6, 0x73, 0x1f, 0xeb, 0x9e, 0x4c, 0x50,
-	0xad, 0x8d, 0xe0, 0xcc, 0xe5, 0x3d, 0x0d, 0xf3, 0x57, 0x05, 0x98, 0x63, 0x37, 0xbf, 0xa9, 0x79,
-	0x2b, 0x47, 0x54, 0x8d, 0x9a, 0x9b, 0x69, 0xb0, 0x38, 0x43, 0xce, 0xf4, 0x45, 0x06, 0xb4, 0x7a,
-	0x44, 0x4d, 0x13, 0x31, 0xd5, 0xce, 0xc7, 0xf9, 0x80, 0x99, 0x06, 0xe4, 0x16, 0xfa, 0xb3, 0x71,
-	0x41, 0xaa, 0x19, 0x98, 0x06, 0xfd, 0x61, 0x3e, 0x74, 0x5e, 0x3b, 0x71, 0x0b, 0xed, 0x43, 0x45,
-	0x3f, 0xba, 0xa7, 0x61, 0x5e, 0x7b, 0xe6, 0x4d, 0xa8, 0xe8, 0xa6, 0x04, 0xfd, 0x3c, 0x8b, 0x71,
-	0xd5, 0xe2, 0xd7, 0x1f, 0x14, 0x50, 0x53, 0xc5, 0xb8, 0x36, 0x6a, 0x02, 0x72, 0x8a, 0xc6, 0x64,
-	0xf3, 0x51, 0x74, 0x26, 0xe9, 0x1e, 0xc2, 0x64, 0x8f, 0x35, 0x91, 0x35, 0xa3, 0xb7, 0x3a, 0xc2,
-	0x05, 0x7f, 0x35, 0xa4, 0x1e, 0xf2, 0xd3, 0x6a, 0x9e, 0x3e, 0x9b, 0xd4, 0x3f, 0x48, 0x37, 0x0f,
-	0xcf, 0x9c, 0xbf, 0x9f, 0xe2, 0x3a, 0x92, 0x79, 0x86, 0x34, 0xda, 0xa7, 0x72, 0xc6, 0xcb, 0x2e,
-	0x83, 0x19, 0xff, 0x93, 0x33, 0xcb, 0x9d, 0x0c, 0x47, 0x54, 0xc5, 0x7d, 0xca, 0xb4, 0xed, 0x6f,
-	0x67, 0xbf, 0x36, 0x8f, 0x37, 0x38, 0xf8, 0x16, 0x22, 0xb0, 0x7e, 0x44, 0x55, 0xa6, 0x27, 0xb9,
-	0xde, 0xc4, 0xec, 0x47, 0xb5, 0xc2, 0xa6, 0x06, 0xdf, 0x42, 0xdf, 0x02, 0xca, 0x76, 0x1c, 0x28,
-	0xef, 0xc3, 0x5c, 0x41, 0x5b, 0x72, 0xbd, 0x4b, 0x1c, 0xb8, 0x3f, 0x2a, 0x5a, 0xe3, 0xad, 0xc7,
-	0x34, 0xff, 0xfc, 0x3a, 0xe7, 0x5b, 0x66, 0x5e, 0xeb, 0x62, 0x6a, 0xcd, 0xaa, 0xf6, 0xfb, 0xa8,
-	0xc9, 0xb8, 0xde, 0x3f, 0xbf, 0xcc, 0x3a, 0x3e, 0xd3, 0x9e, 0x44, 0x2f, 0xc1, 0xa8, 0x83, 0x98,
-	0xfa, 0x12, 0x1c, 0x6b, 0x34, 0xae, 0x77, 0x87, 0x00, 0x94, 0x7d, 0xdd, 0xe7, 0x78, 0xbb, 0xb0,
-	0xd1, 0xa8, 0xff, 0xf6, 0xbd, 0x78, 0x13, 0x85, 0xfb, 0x95, 0x6f, 0x16, 0x06, 0x4f, 0xce, 0x97,
-	0xcc, 0x5f, 0xbe, 0x9f, 0xfc, 0x14, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xa2, 0xd3, 0x2d, 0x1f, 0x1e,
-	0x00, 0x00,
+	// 1998 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x5f, 0x73, 0x1b, 0xb7,
+	0x11, 0x37, 0x45, 0x4a, 0x26, 0x57, 0x7f, 0x62, 0xc3, 0x92, 0x7c, 0x62, 0x1b, 0x5b, 0x45, 0x3b,
+	0xae, 0xd2, 0x26, 0x52, 0xed, 0x38, 0x99, 0x8e, 0xa7, 0x93, 0x71, 0x44, 0xd1, 0x8a, 0x12, 0xd3,
+	0xa6, 0x8f, 0x96, 0x3c, 0x4d, 0x9b, 0xc9, 0x40, 0x77, 0x20, 0x89, 0xea, 0x0e, 0x60, 0x0e, 0x38,
+	0xd6, 0xf4, 0x53, 0x3b, 0xe9, 0xf4, 0xa1, 0x33, 0xfd, 0x16, 0xfd, 0x4e, 0x7d, 0xeb, 0xb7, 0xe8,
+	0x7b, 0x07, 0xb8, 0x3b, 0xea, 0xc8, 0xbb, 0x13, 0xad, 0x21, 0xfb, 0x44, 0x00, 0xbb, 0xfb, 0xdb,
+	0xc5, 0x62, 0x77, 0x81, 0x3d, 0xc2, 0x47, 0x83, 0x8b, 0xde, 0x41, 0x9f, 0x70, 0xd7, 0xa3, 0xc1,
+	0x27, 0x1e, 0x09, 0xb9, 0xd3, 0xa7, 0xc1, 0x27, 0x8e, 0xf0, 0x0f, 0x1c, 0xdf, 0x3d, 0x18, 0x3e,
+	0xd4, 0x3f, 0xfb, 0x83, 0x40, 0x28, 0x81, 0x3e, 0xb8, 0x08, 0xcf, 0xe9, 0x90, 0x05, 0x6a, 0x5f,
+	0xaf, 0x0d, 0x1f, 0xe2, 0x2e, 0xdc, 0x79, 0x45, 0xfd, 0xf0, 0x8c, 0x06, 0x92, 0x09, 0x6e, 0x53,
+	0x39, 0x10, 0x5c, 0x52, 0xf4, 0x19, 0x54, 0x83, 0x78, 0x6c, 0x95, 0x76, 0x4b, 0x7b, 0xab, 0x8f,
+	0x76, 0xf6, 0xa7, 0x44, 0xf7, 0x13, 0x66, 0x7b, 0xcc, 0x8a, 0x2c, 0xb8, 0x39, 0x8c, 0x90, 0xac,
+	0xa5, 0xdd, 0xd2, 0x5e, 0xcd, 0x4e, 0xa6, 0xf8, 0x3e, 0x94, 0xcf, 0x5a, 0x27, 0x86, 0xc1, 0x67,
+	0x5f, 0x4b, 0xc1, 0x0d, 0xec, 0x9a, 0x9d, 0x4c, 0xf1, 0x43, 0x28, 0x37, 0xda, 0xa7, 0x68, 0x03,
+	0x96, 0x98, 0x6b, 0x68, 0xeb, 0xf6, 0x12, 0x73, 0x51, 0x1d, 0xaa, 0x92, 0x9d, 0x7b, 0x8c, 0xf7,
+	0xa4, 0xb5, 0xb4, 0x5b, 0xde, 0x5b, 0xb7, 0xc7, 0x73, 0x7c, 0x00, 0x37, 0x3b, 0xd1, 0x38, 0x23,
+	0xb6, 0x09, 0xcb, 0x43, 0xe2, 0x85, 0xd4, 0x98, 0x51, 0xb1, 0xa3, 0x09, 0x6e, 0xc2, 0x72, 0x9b,
+	0xf4, 0xa8, 0xd4, 0x64, 0x47, 0x84, 0x5c, 0x19, 0x89, 0x8a, 0x1d, 0x4d, 0x10, 0x82, 0x4a, 0xc8,
+	0x99, 0x8a, 0x4d, 0x37, 0x63, 0xbd, 0x26, 0xd9, 0x3b, 0x6a, 0x95, 0x0d, 0xb4, 0x19, 0xe3, 0xc7,
+	0xb0, 0xd2, 0xa2, 0xbe, 0x08, 0x46, 0x68, 0x1b, 0x56, 0x88, 0x9f, 0x02, 0x8a, 0x67, 0x79, 0x48,
+	0xf8, 0xdf, 0x25, 0xa8, 0x34, 0xa8, 0xe7, 0x65, 0x6c, 0x3d, 0x80, 0x15, 0xdf, 0xc0, 0x19, 0xf6,
+	0xd5, 0x47, 0x77, 0x33, 0x9e, 0x8e, 0xb4, 0xd9, 0x31, 0x1b, 0xfa, 0x18, 0x96, 0x07, 0x7a, 0x1b,
+	0x56, 0x79, 0xb7, 0xbc, 0xb7, 0xfa, 0x68, 0x3b, 0xc3, 0x6f, 0x36, 0x69, 0x47, 0x4c, 0xe8, 0x73,
+	0xa8, 0xb9, 0x4c, 0x2a, 0xc2, 0x1d, 0x2a, 0xad, 0x8a, 0x91, 0xb0, 0x32, 0x12, 0xb1, 0x1f, 0xed,
+	0x4b, 0x56, 0xb4, 0x07, 0x15, 0x67, 0x10, 0x4a, 0x6b, 0xd9, 0x88, 0x6c, 0x66, 0x44, 0x1a, 0xed,
+	0x53, 0xdb, 0x70, 0xe0, 0xa7, 0x50, 0x7d, 0x2d, 0x06, 0xc2, 0x13, 0xbd, 0x11, 0x7a, 0x0c, 0xc0,
+	0x43, 0x9f, 0x7c, 0xef, 0x50, 0xcf, 0x93, 0x56, 0xc9, 0xc8, 0x6e, 0x65, 0x65, 0xa9, 0xe7, 0xd9,
+	0x35, 0xcd, 0xa8, 0x47, 0x12, 0xff, 0xa3, 0x04, 0x2b, 0x9d, 0xd6, 0x21, 0x13, 0x12, 0x61, 0x58,
+	0xf3, 0x09, 0x0f, 0xbb, 0xc4, 0x51, 0x61, 0x40, 0x03, 0xe3, 0xa7, 0x9a, 0x3d, 0xb1, 0xa6, 0xa3,
+	0x68, 0x10, 0x08, 0x37, 0x74, 0x12, 0x0f, 0x27, 0xd3, 0x74, 0x00, 0x96, 0x27, 0x02, 0x10, 0xdd,
+	0x82, 0xb2, 0xbc, 0x08, 0xad, 0x8a, 0x59, 0xd5, 0x43, 0x7d, 0x78, 0x5d, 0xe2, 0x33, 0x6f, 0x64,
+	0x2d, 0x9b, 0xc5, 0x78, 0x86, 0xff, 0x5e, 0x82, 0xea, 0x11, 0x93, 0x17, 0x27, 0xbc, 0x2b, 0x0c,
+	0x93, 0x08, 0x7c, 0xa2, 0x62, 0x43, 0xe2, 0x19, 0xda, 0x85, 0xd5, 0x73, 0xe2, 0x5c, 0x30, 0xde,
+	0x7b, 0xc6, 0x3c, 0x1a, 0x9b, 0x91, 0x5e, 0x42, 0xf7, 0x00, 0xb4, 0xbd, 0xc4, 0xeb, 0x24, 0xf1,
+	0x53, 0xb1, 0x53, 0x2b, 0x1a, 0x41, 0xbb, 0x24, 0x61, 0xa8, 0x18, 0x86, 0xf4, 0x12, 0xfe, 0x6f,
+	0x09, 0xd6, 0x1b, 0x5e, 0x28, 0x15, 0x0d, 0x1a, 0x82, 0x77, 0x59, 0x0f, 0xed, 0x03, 0x6a, 0xbe,
+	0x1d, 0x10, 0xee, 0x6a, 0xfb, 0x64, 0x93, 0x93, 0x73, 0x8f, 0x46, 0xa1, 0x54, 0xb5, 0x73, 0x28,
+	0xe8, 0x77, 0xb0, 0xf3, 0x2c, 0xa0, 0x54, 0xc7, 0x83, 0x4d, 0x07, 0x22, 0x50, 0x8c, 0xf7, 0x8e,
+	0x98, 0x8c, 0xc4, 0x96, 0x8c, 0x58, 0x31, 0x03, 0x7a, 0x02, 0xd6, 0xa1, 0x70, 0xfa, 0xf2, 0x88,
+	0xc9, 0x81, 0x47, 0x46, 0xcf, 0x44, 0xd0, 0x7c, 0x76, 0x72, 0x1c, 0x52, 0xa9, 0xa4, 0xd9, 0x4f,
+	0xd5, 0x2e, 0xa4, 0x6b, 0xd9, 0x0e, 0x0d, 0x18, 0xf1, 0x1a, 0x82, 0x4b, 0xe1, 0xd1, 0xe7, 0xe2,
+	0x52, 0x71, 0x25, 0x92, 0x2d, 0xa2, 0xe3, 0x4f, 0x61, 0xe7, 0x84, 0x2b, 0x1a, 0x74, 0x89, 0x43,
+	0x0f, 0x19, 0x77, 0x19, 0xef, 0xb5, 0x58, 0x2f, 0x20, 0x4a, 0x9f, 0xe3, 0xb6, 0x4e, 0x3e, 0xd5,
+	0x17, 0x6e, 0x72, 0x20, 0xd1, 0x0c, 0xff, 0xe7, 0x26, 0x6c, 0x9d, 0x45, 0xce, 0x6b, 0x11, 0xa7,
+	0xcf, 0x38, 0x7d, 0x39, 0xd0, 0x02, 0x12, 0x7d, 0x03, 0x9b, 0x93, 0x84, 0x28, 0xd2, 0xac, 0x52,
+	0x41, 0xb6, 0x45, 0x64, 0x3b, 0x57, 0x08, 0x3d, 0x86, 0xad, 0x16, 0xf5, 0x0f, 0x89, 0xe7, 0x09,
+	0xc1, 0x3b, 0x8a, 0x28, 0xd9, 0xa6, 0x01, 0x13, 0x91, 0x37, 0xd7, 0xed, 0x7c, 0x22, 0xfa, 0x0d,
+	0xdc, 0x69, 0x07, 0x54, 0xaf, 0x3b, 0x44, 0x51, 0xf7, 0x4c, 0x78, 0xa1, 0x1f, 0xe7, 0x6f, 0xcd,
+	0xce, 0x23, 0xe9, 0x02, 0xac, 0xe2, 0x9c, 0xb2, 0x2a, 0x05, 0x05, 0x38, 0x49, 0x3a, 0x7b, 0xcc,
+	0x8a, 0x3a, 0x50, 0x33, 0x01, 0xa0, 0x63, 0x37, 0xce, 0xdc, 0xcf, 0x32, 0x72, 0xb9, 0x6e, 0xda,
+	0x1f, 0xcb, 0x35, 0xb9, 0x0a, 0x46, 0xf6, 0x25, 0x4e, 0x41, 0xd4, 0xad, 0x14, 0x46, 0xdd, 0x11,
+	0xac, 0x3b, 0xe9, 0xb0, 0xb5, 0x6e, 0x9a, 0x0d, 0xdc, 0xcb, 0x96, 0x81, 0x34, 0x97, 0x3d, 0x29,
+	0x84, 0x7e, 0x2c, 0xc1, 0x0e, 0x4b, 0xc2, 0xe0, 0x48, 0xf8, 0x84, 0xf1, 0x2f, 0x95, 0x22, 0x4e,
+	0xdf, 0xa7, 0x5c, 0x59, 0x55, 0xb3, 0xb7, 0xe6, 0x7b, 0xee, 0xed, 0xa4, 0x08, 0x27, 0xda, 0x6b,
+	0xb1, 0x1e, 0xc4, 0x01, 0x8d, 0x89, 0xe3, 0x20, 0xb4, 0x6a, 0x46, 0xfb, 0x17, 0xd7, 0xd5, 0x3e,
+	0x06, 0x88, 0xd4, 0xe6, 0x20, 0xd7, 0xdf, 0xc0, 0xc6, 0xe4, 0x41, 0xe8, 0xc2, 0x75, 0x41, 0x47,
+	0x71, 0xb4, 0xeb, 0x21, 0x3a, 0x48, 0x5f, 0x6e, 0x79, 0x81, 0x91, 0x54, 0xaf, 0xf8, 0xde, 0x7b,
+	0xb2, 0xf4, 0xdb, 0x52, 0xfd, 0x39, 0xdc, 0xbb, 0xda, 0x0b, 0x39, 0x8a, 0x26, 0x6e, 0xd1, 0x5a,
+	0x1a, 0xed, 0x07, 0xb8, 0x5b, 0xb0, 0xab, 0x1c, 0x98, 0xa7, 0x93, 0xf6, 0xfe, 0x2a, 0x63, 0x6f,
+	0x61, 0xb6, 0xa7, 0x54, 0xe2, 0x21, 0xc0, 0x59, 0xeb, 0xc4, 0xa6, 0x3f, 0xe8, 0x02, 0x83, 0x1e,
+	0x40, 0x79, 0xe8, 0xb3, 0x38, 0x87, 0xb3, 0x97, 0x93, 0xe6, 0xd4, 0x0c, 0xe8, 0x29, 0xdc, 0x14,
+	0xd1, 0x31, 0xc4, 0xda, 0x1f, 0xbc, 0xdf, 0xa1, 0xd9, 0x89, 0x18, 0x7e, 0x0d, 0xb7, 0x2e, 0xed,
+	0xb9, 0xa6, 0x76, 0x6b, 0x52, 0xfb, 0xda, 0x25, 0xea, 0x8f, 0x25, 0x58, 0x6d, 0xbe, 0xa5, 0x4e,
+	0x82, 0x78, 0x0f, 0xc0, 0x35, 0xa7, 0xf2, 0x82, 0xf8, 0x34, 0x76, 0x5e, 0x6a, 0x45, 0x23, 0x35,
+	0x84, 0xef, 0x13, 0xee, 0x26, 0x57, 0x5e, 0x3c, 0xd5, 0x6f, 0x8d, 0x2f, 0x83, 0x5e, 0x52, 0x4c,
+	0xcc, 0x18, 0x3d, 0x80, 0x0d, 0xc5, 0x7c, 0x2a, 0x42, 0xd5, 0xa1, 0x8e, 0xe0, 0xae, 0x34, 0x35,
+	0x64, 0xd9, 0x9e, 0x5a, 0xc5, 0x1b, 0xb0, 0xd6, 0xf4, 0x07, 0x6a, 0x14, 0x5b, 0x81, 0xbf, 0x80,
+	0xaa, 0x9d, 0x7a, 0xcb, 0xc9, 0xd0, 0x71, 0xa8, 0x94, 0xf1, 0x05, 0x93, 0x4c, 0x35, 0xc5, 0xa7,
+	0x52, 0x92, 0x5e, 0x12, 0x18, 0xc9, 0x14, 0x7f, 0x0f, 0x1b, 0x51, 0x6c, 0xcd, 0xfb, 0x90, 0xdc,
+	0x86, 0x95, 0x68, 0xf3, 0xb1, 0x86, 0x78, 0x86, 0x39, 0xdc, 0x89, 0x14, 0x98, 0xea, 0x3a, 0xaf,
+	0x96, 0x5d, 0x58, 0x75, 0x2f, 0xd1, 0x92, 0x4b, 0x3c, 0xb5, 0x84, 0xdf, 0xc2, 0x6d, 0x73, 0xa1,
+	0x99, 0x6c, 0x9a, 0x53, 0xdb, 0xc7, 0x70, 0xbb, 0x37, 0x8d, 0x15, 0xeb, 0xcc, 0x12, 0xf0, 0xdf,
+	0x4a, 0xb0, 0x65, 0x54, 0x9f, 0x4a, 0x1a, 0x3c, 0x67, 0x52, 0xcd, 0xab, 0xfe, 0x31, 0x6c, 0xf5,
+	0xf2, 0xf0, 0x62, 0x13, 0xf2, 0x89, 0xf8, 0x9f, 0x25, 0xb0, 0x8c, 0x19, 0xfa, 0x4d, 0x23, 0x47,
+	0x52, 0x51, 0x7f, 0x6e, 0xb7, 0x3f, 0x01, 0xab, 0x57, 0x00, 0x19, 0x1b, 0x53, 0x48, 0xc7, 0x23,
+	0x58, 0x8b, 0xd2, 0x66, 0x3e, 0x13, 0xea, 0x50, 0xa5, 0x6f, 0x99, 0x6a, 0x08, 0x37, 0x52, 0xb9,
+	0x6c, 0x8f, 0xe7, 0x3a, 0xf6, 0xa4, 0x72, 0x5f, 0x86, 0x2a, 0x7e, 0x42, 0xc6, 0x33, 0xfc, 0x2d,
+	0xdc, 0x32, 0x9e, 0x68, 0xeb, 0x87, 0xf2, 0x7b, 0xa6, 0x6d, 0x36, 0x11, 0x97, 0x72, 0x13, 0xf1,
+	0x6b, 0xb8, 0x9d, 0xc2, 0x9e, 0x6b, 0x6f, 0x58, 0xc0, 0xba, 0x7e, 0xd3, 0xbd, 0xa3, 0xd7, 0xad,
+	0x56, 0x9f, 0xc3, 0x76, 0xc8, 0xbb, 0x46, 0xf4, 0x75, 0x9e, 0xd1, 0x05, 0x54, 0xfc, 0x06, 0x6e,
+	0x47, 0x1d, 0xca, 0x51, 0xe8, 0x0f, 0xae, 0xab, 0xb4, 0x0e, 0x55, 0x37, 0xf4, 0x07, 0x6d, 0xa2,
+	0xfa, 0xf1, 0xe1, 0x8f, 0xe7, 0xf8, 0x1c, 0x3e, 0xe8, 0x34, 0xcf, 0x16, 0x91, 0x7b, 0xba, 0x98,
+	0xd1, 0xa1, 0x79, 0x15, 0xc5, 0x85, 0x38, 0x9e, 0xe2, 0xbf, 0x94, 0x60, 0xe7, 0xb9, 0xe9, 0x99,
+	0x5b, 0x94, 0xc8, 0x30, 0xa0, 0xfa, 0x42, 0x5c, 0x40, 0xaa, 0x7b, 0xd3, 0x98, 0xb1, 0xe2, 0x2c,
+	0x01, 0x7f, 0xa7, 0xdf, 0xbb, 0x7f, 0xa2, 0x8e, 0x8a, 0xec, 0xe8, 0x50, 0x27, 0xa0, 0x6a, 0x71,
+	0x57, 0x8d, 0x84, 0xed, 0x23, 0x16, 0xa8, 0x91, 0x4d, 0x14, 0x5d, 0x48, 0xd9, 0xc4, 0xb0, 0xe6,
+	0x26, 0x80, 0xad, 0xf3, 0x48, 0x5f, 0xd9, 0x9e, 0x58, 0xc3, 0x12, 0x50, 0xc7, 0x09, 0x28, 0xe5,
+	0xb2, 0x2f, 0xe6, 0x76, 0x27, 0x82, 0x8a, 0xcf, 0xfc, 0xa4, 0x38, 0x98, 0xb1, 0x5e, 0x73, 0x89,
+	0x22, 0x26, 0x47, 0xd7, 0x6c, 0x33, 0xc6, 0xaf, 0x60, 0xfd, 0x90, 0x38, 0x17, 0xe1, 0x60, 0x71,
+	0xce, 0x73, 0x60, 0xc7, 0xa6, 0x2e, 0xed, 0x32, 0x4e, 0x1b, 0x7d, 0xea, 0x5c, 0x0c, 0x04, 0xe3,
+	0xd7, 0x3e, 0x9b, 0x7b, 0x00, 0xce, 0x58, 0x38, 0xd6, 0x90, 0x5a, 0xc1, 0x7f, 0x2d, 0x41, 0x3d,
+	0x4f, 0xcb, 0xdc, 0x41, 0x78, 0xa9, 0xe3, 0x84, 0x0f, 0x89, 0xc7, 0x92, 0xa6, 0x2f, 0x4b, 0xc0,
+	0x04, 0xee, 0x1e, 0x51, 0x8f, 0xaa, 0xff, 0xdf, 0x36, 0x1f, 0xfd, 0xcb, 0x82, 0x72, 0xc3, 0x77,
+	0xd1, 0x0b, 0x40, 0x9d, 0x11, 0x77, 0x26, 0xdf, 0x5d, 0xe8, 0x27, 0xb9, 0xc0, 0x91, 0x09, 0xf5,
+	0xe2, 0x0d, 0xe3, 0x1b, 0xe8, 0x25, 0xdc, 0x69, 0x93, 0x50, 0xd2, 0x85, 0x01, 0xbe, 0x82, 0xad,
+	0x53, 0x3e, 0x58, 0x28, 0x64, 0x07, 0x36, 0xa3, 0xa2, 0x3c, 0x85, 0x98, 0x6d, 0x8a, 0x26, 0x6a,
+	0xf7, 0xd5, 0xa0, 0x36, 0x6c, 0x9f, 0xf2, 0x6e, 0x1e, 0xec, 0x5c, 0xce, 0xb4, 0xa9, 0xa4, 0x6a,
+	0x61, 0x80, 0xaf, 0xc1, 0xea, 0x88, 0xae, 0xb2, 0xe9, 0xb9, 0x10, 0x8b, 0x43, 0xb5, 0x61, 0xbb,
+	0xd3, 0x0f, 0x95, 0x2b, 0xfe, 0xcc, 0x17, 0x86, 0xf9, 0x02, 0xd0, 0x37, 0xcc, 0xf3, 0x16, 0x86,
+	0xd7, 0x86, 0xcd, 0x28, 0xa5, 0x16, 0x86, 0xf8, 0x06, 0xb6, 0xa2, 0x5e, 0x64, 0x1a, 0xf2, 0x67,
+	0x19, 0xa9, 0xe9, 0x9e, 0x65, 0xe6, 0xa9, 0xeb, 0x94, 0x1c, 0x0b, 0xbd, 0x26, 0x41, 0x8f, 0xaa,
+	0x39, 0x2c, 0xfd, 0x3d, 0x7c, 0xd8, 0xd0, 0xdf, 0x11, 0xa7, 0xbc, 0x39, 0x56, 0x30, 0xe7, 0xd1,
+	0xb3, 0x1e, 0x27, 0x5e, 0x64, 0x64, 0x5b, 0xb8, 0x0d, 0x8f, 0x12, 0x1e, 0x0e, 0xe6, 0xc0, 0xfc,
+	0x03, 0xdc, 0x7f, 0xc6, 0x38, 0xf1, 0xd8, 0x3b, 0xba, 0x78, 0x83, 0x5f, 0x00, 0xfa, 0x4a, 0xa8,
+	0x81, 0x17, 0xf6, 0xbe, 0x12, 0x52, 0x1d, 0xd1, 0x21, 0x73, 0xa8, 0x9c, 0x03, 0xaf, 0x05, 0xb5,
+	0x63, 0xaa, 0xa2, 0x3e, 0x08, 0x7d, 0x98, 0xe1, 0x4c, 0x77, 0x74, 0xf5, 0xfb, 0x19, 0xf2, 0x64,
+	0x83, 0x66, 0x82, 0x6a, 0x63, 0x0c, 0x67, 0xde, 0x07, 0xb3, 0x30, 0x7f, 0x51, 0x80, 0x39, 0xf1,
+	0xb8, 0x30, 0x35, 0x6f, 0xed, 0x98, 0xaa, 0x71, 0xff, 0x34, 0x0b, 0x16, 0x67, 0xc8, 0x99, 0xd6,
+	0xcb, 0x80, 0x56, 0x8f, 0xa9, 0xe9, 0x53, 0x66, 0xda, 0xf9, 0x20, 0x1f, 0x30, 0xd3, 0xe3, 0xdc,
+	0x40, 0x7f, 0x34, 0x2e, 0x48, 0xf5, 0x1b, 0xb3, 0xa0, 0x3f, 0xca, 0x87, 0xce, 0xeb, 0x58, 0x6e,
+	0xa0, 0x43, 0xa8, 0xe8, 0x77, 0xfd, 0x2c, 0xcc, 0x2b, 0xcf, 0xbc, 0x09, 0x15, 0xdd, 0xf7, 0xa0,
+	0x9f, 0x66, 0x31, 0x2e, 0xbf, 0x22, 0xd4, 0x3f, 0x2c, 0xa0, 0xa6, 0x8a, 0x71, 0x6d, 0xdc, 0x67,
+	0xe4, 0x14, 0x8d, 0xe9, 0xfe, 0xa6, 0x8e, 0xaf, 0x62, 0x49, 0x65, 0x8f, 0x35, 0x95, 0x35, 0xe3,
+	0x76, 0x00, 0xe1, 0x82, 0x7f, 0x33, 0x52, 0xbd, 0xc2, 0xac, 0x9a, 0xa7, 0xcf, 0x26, 0xf5, 0x27,
+	0xd5, 0xf5, 0xc3, 0x33, 0xe7, 0x1f, 0xae, 0xb8, 0x8e, 0x64, 0x9e, 0x21, 0x8d, 0xf6, 0xa9, 0x9c,
+	0xf3, 0xb2, 0xcb, 0x60, 0x46, 0x1b, 0x9e, 0xeb, 0x4e, 0x86, 0x63, 0xaa, 0xe2, 0x56, 0x68, 0xd6,
+	0xf6, 0x77, 0x33, 0xe4, 0xa9, 0x1e, 0x0a, 0xdf, 0x40, 0x04, 0x36, 0x8f, 0xa9, 0xca, 0xb4, 0x3d,
+	0x57, 0x9b, 0x98, 0xfd, 0x6e, 0x57, 0xd8, 0x37, 0xe1, 0x1b, 0xe8, 0x3b, 0x40, 0xd9, 0xa6, 0x06,
+	0xe5, 0x7d, 0xfb, 0x2b, 0xe8, 0x7c, 0xae, 0x76, 0x89, 0x03, 0x77, 0xc7, 0x45, 0x6b, 0xb2, 0xbb,
+	0x99, 0xe5, 0x9f, 0x5f, 0xe6, 0x7c, 0x2e, 0xcd, 0xeb, 0x8e, 0x4c, 0xad, 0x59, 0xd7, 0x7e, 0x1f,
+	0xf7, 0x31, 0x57, 0xfb, 0xe7, 0xe7, 0x59, 0xc7, 0x67, 0x3a, 0xa0, 0xe8, 0x25, 0x18, 0x35, 0x29,
+	0x33, 0x5f, 0x82, 0x13, 0xbd, 0xcc, 0xd5, 0xee, 0x10, 0x80, 0xb2, 0x0d, 0x44, 0x8e, 0xb7, 0x0b,
+	0x7b, 0x99, 0xfa, 0xaf, 0xdf, 0x8b, 0x37, 0x75, 0xbf, 0xdf, 0x9a, 0x6e, 0x17, 0xd0, 0x5e, 0xd6,
+	0xb3, 0xf9, 0x1d, 0xc5, 0x95, 0x7b, 0x39, 0xac, 0x7c, 0xbb, 0x34, 0x7c, 0x78, 0xbe, 0x62, 0xfe,
+	0xb0, 0xfe, 0xf4, 0x7f, 0x03, 0x00, 0x82, 0x93, 0x0c, 0xb8, 0xdd, 0x1e, 0x00, 0x00,
 }
@@ -30,6 +30,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/safepath"
 
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
 var mountBaseDir = filepath.Join(util.VirtShareDir, "/hotplug-disks")
@@ -49,6 +50,7 @@ type HotplugDiskManagerInterface interface {
 	GetHotplugTargetPodPathOnHost(virtlauncherPodUID types.UID) (*safepath.Path, error)
 	GetFileSystemDiskTargetPathFromHostView(virtlauncherPodUID types.UID, volumeName string, create bool) (*safepath.Path, error)
 	GetFileSystemDirectoryTargetPathFromHostView(virtlauncherPodUID types.UID, volumeName string, create bool) (*safepath.Path, error)
+	GetFileSystemSocketTargetPathFromHostView(virtlauncherPodUID types.UID, volumeName string, create bool) (*safepath.Path, error)
 }
 
 func NewHotplugDiskManager(kubeletPodsDir string) *hotplugDiskManager {
@@ -103,6 +105,21 @@ func (h *hotplugDiskManager) GetFileSystemDiskTargetPathFromHostView(virtlaunche
 	return safepath.JoinNoFollow(targetPath, diskName)
 }
 
+// GetFileSystemSocketTargetPathFromHostView gets the virtiofsd socket file of a hotplugged filesystem in the target pod (virt-launcher) on the host.
+func (h *hotplugDiskManager) GetFileSystemSocketTargetPathFromHostView(virtlauncherPodUID types.UID, volumeName string, create bool) (*safepath.Path, error) {
+	targetPath, err := h.GetHotplugTargetPodPathOnHost(virtlauncherPodUID)
+	if err != nil {
+		return nil, err
+	}
+	socketName := virtiofs.HotplugVirtioFSSocketName(volumeName)
+	if create {
+		if err := safepath.TouchAtNoFollow(targetPath, socketName, 0666); err != nil && !os.IsExist(err) {
+			return nil, err
+		}
+	}
+	return safepath.JoinNoFollow(targetPath, socketName)
+}
+
 // SetLocalDirectory creates the base directory where disk images will be mounted when hotplugged. File system volumes will be in
 // a directory under this, that contains the volume name. block volumes will be in this directory as a block device.
 func SetLocalDirectory(dir string) error {
@@ -117,4 +117,22 @@ var _ = Describe("HotplugDisk", func() {
 		_, err := hotplug.GetFileSystemDiskTargetPathFromHostView(testUID, "testvolume", false)
 		Expect(err).To(HaveOccurred())
 	})
+
+	It("GetFileSystemSocketTargetPathFromHostView should create the socket file", func() {
+		testUID := types.UID("abcd")
+		_ = os.MkdirAll(TargetPodBasePath(podsBaseDir, testUID), 0755)
+		res, err := hotplug.GetFileSystemSocketTargetPathFromHostView(testUID, "testvolume", true)
+		Expect(err).ToNot(HaveOccurred())
+		targetPath := filepath.Join(TargetPodBasePath(podsBaseDir, testUID), "testvolume.sock")
+		exists, _ := diskutils.FileExists(targetPath)
+		Expect(exists).To(BeTrue())
+		Expect(unsafepath.UnsafeAbsolute(res.Raw())).To(Equal(targetPath))
+	})
+
+	It("GetFileSystemSocketTargetPathFromHostView should not create a missing socket file", func() {
+		testUID := types.UID("abcd")
+		_ = os.MkdirAll(TargetPodBasePath(podsBaseDir, testUID), 0755)
+		_, err := hotplug.GetFileSystemSocketTargetPathFromHostView(testUID, "testvolume", false)
+		Expect(err).To(MatchError(os.ErrNotExist))
+	})
 })
@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/apply"
+	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("instancetype.spec.diskIOTune", func() {
+	var (
+		applier          = apply.NewVMIApplier()
+		field            = k8sfield.NewPath("spec", "template", "spec")
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{
+			DiskIOTune: &virtv1.DiskIOTune{
+				TotalIOPSSec: pointer.P(int64(500)),
+			},
+		}
+	)
+
+	It("should apply the I/O limits to the disks and LUNs", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.Disks = []virtv1.Disk{
+			{Name: "disk", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{}}},
+			{Name: "lun", DiskDevice: virtv1.DiskDevice{LUN: &virtv1.LunTarget{}}},
+			{Name: "cdrom", DiskDevice: virtv1.DiskDevice{CDRom: &virtv1.CDRomTarget{}}},
+		}
+
+		Expect(applier.ApplyToVMI(field, instancetypeSpec, nil, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+		disks := vmi.Spec.Domain.Devices.Disks
+		Expect(disks[0].IOTune).To(Equal(instancetypeSpec.DiskIOTune))
+		Expect(disks[1].IOTune).To(Equal(instancetypeSpec.DiskIOTune))
+		Expect(disks[2].IOTune).To(BeNil())
+	})
+
+	It("should detect a conflict when a disk defines its own I/O limits", func() {
+		userIOTune := &virtv1.DiskIOTune{ReadBytesSec: pointer.P(int64(1000))}
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.Disks = []virtv1.Disk{
+			{Name: "disk", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{}}},
+			{Name: "user", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{}}, IOTune: userIOTune},
+		}
+
+		Expect(applier.ApplyToVMI(field, instancetypeSpec, nil, &vmi.Spec, &vmi.ObjectMeta)).To(
+			ContainElement(conflict.NewFromPath(field.Child("domain", "devices", "disks").Index(1).Child("ioTune"))))
+		Expect(vmi.Spec.Domain.Devices.Disks[0].IOTune).To(BeNil())
+		Expect(vmi.Spec.Domain.Devices.Disks[1].IOTune).To(Equal(userIOTune))
+	})
+})
@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package apply
+
+import (
+	"reflect"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
+)
+
+func applyInterfaceBandwidth(
+	baseConflict *conflict.Conflict,
+	instancetypeSpec *v1beta1.VirtualMachineInstancetypeSpec,
+	vmiSpec *virtv1.VirtualMachineInstanceSpec,
+) conflict.Conflicts {
+	if instancetypeSpec.InterfaceBandwidth == nil {
+		return nil
+	}
+
+	var conflicts conflict.Conflicts
+	for i, iface := range vmiSpec.Domain.Devices.Interfaces {
+		if iface.Bandwidth != nil {
+			conflicts = append(conflicts, conflict.NewFromPath(
+				baseConflict.Child("domain", "devices", "interfaces").Index(i).Child("bandwidth")))
+		}
+	}
+	if len(conflicts) > 0 {
+		return conflicts
+	}
+
+	for i := range vmiSpec.Domain.Devices.Interfaces {
+		iface := &vmiSpec.Domain.Devices.Interfaces[i]
+		if !supportsBandwidth(iface) {
+			continue
+		}
+		iface.Bandwidth = instancetypeSpec.InterfaceBandwidth.DeepCopy()
+	}
+
+	return nil
+}
+
+// supportsBandwidth reports whether the interface uses the bridge or masquerade binding,
+// an interface without a binding is defaulted to one of them.
+func supportsBandwidth(iface *virtv1.Interface) bool {
+	if iface.Bridge != nil || iface.Masquerade != nil {
+		return true
+	}
+	return reflect.ValueOf(iface.InterfaceBindingMethod).IsZero() && iface.Binding == nil
+}
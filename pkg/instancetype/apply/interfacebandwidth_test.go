@@ -0,0 +1,76 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/apply"
+	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("instancetype.spec.interfaceBandwidth", func() {
+	var (
+		applier          = apply.NewVMIApplier()
+		field            = k8sfield.NewPath("spec", "template", "spec")
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{
+			InterfaceBandwidth: &virtv1.InterfaceBandwidth{
+				Inbound: &virtv1.BandwidthLimit{Average: 1000},
+			},
+		}
+	)
+
+	It("should apply the bandwidth limits to the bridge and masquerade interfaces", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.Interfaces = []virtv1.Interface{
+			{Name: "bridge", InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Bridge: &virtv1.InterfaceBridge{}}},
+			{Name: "masquerade", InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Masquerade: &virtv1.InterfaceMasquerade{}}},
+			{Name: "unset"},
+			{Name: "sriov", InterfaceBindingMethod: virtv1.InterfaceBindingMethod{SRIOV: &virtv1.InterfaceSRIOV{}}},
+			{Name: "plugin", Binding: &virtv1.PluginBinding{Name: "custom"}},
+		}
+
+		Expect(applier.ApplyToVMI(field, instancetypeSpec, nil, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+		ifaces := vmi.Spec.Domain.Devices.Interfaces
+		Expect(ifaces[0].Bandwidth).To(Equal(instancetypeSpec.InterfaceBandwidth))
+		Expect(ifaces[1].Bandwidth).To(Equal(instancetypeSpec.InterfaceBandwidth))
+		Expect(ifaces[2].Bandwidth).To(Equal(instancetypeSpec.InterfaceBandwidth))
+		Expect(ifaces[3].Bandwidth).To(BeNil())
+		Expect(ifaces[4].Bandwidth).To(BeNil())
+	})
+
+	It("should detect a conflict when an interface defines its own bandwidth limits", func() {
+		userBandwidth := &virtv1.InterfaceBandwidth{Outbound: &virtv1.BandwidthLimit{Average: 500}}
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.Interfaces = []virtv1.Interface{
+			{Name: "bridge", InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Bridge: &virtv1.InterfaceBridge{}}},
+			{Name: "user", InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Bridge: &virtv1.InterfaceBridge{}}, Bandwidth: userBandwidth},
+		}
+
+		Expect(applier.ApplyToVMI(field, instancetypeSpec, nil, &vmi.Spec, &vmi.ObjectMeta)).To(
+			ContainElement(conflict.NewFromPath(field.Child("domain", "devices", "interfaces").Index(1).Child("bandwidth"))))
+		Expect(vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth).To(BeNil())
+		Expect(vmi.Spec.Domain.Devices.Interfaces[1].Bandwidth).To(Equal(userBandwidth))
+	})
+})
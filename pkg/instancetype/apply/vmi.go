@@ -63,6 +63,8 @@ func (a *vmiApplier) ApplyToVMI(
 		conflicts = append(conflicts, applyMemory(baseConflict, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyIOThreads(baseConflict, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyIOThreadPolicy(baseConflict, instancetypeSpec, vmiSpec)...)
+		conflicts = append(conflicts, applyDiskIOTune(baseConflict, instancetypeSpec, vmiSpec)...)
+		conflicts = append(conflicts, applyInterfaceBandwidth(baseConflict, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyLaunchSecurity(baseConflict, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyGPUs(baseConflict, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyHostDevices(baseConflict, instancetypeSpec, vmiSpec)...)
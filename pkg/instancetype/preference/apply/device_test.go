@@ -172,6 +172,33 @@ var _ = Describe("Preference.Devices", func() {
 		})
 	})
 
+	Context("PreferredInterfaceBandwidth", func() {
+		preferredBandwidth := &virtv1.InterfaceBandwidth{Inbound: &virtv1.BandwidthLimit{Average: 1000}}
+
+		BeforeEach(func() {
+			preferenceSpec.Devices.PreferredInterfaceBandwidth = preferredBandwidth
+		})
+
+		It("should be applied to the interfaces without bandwidth limits", func() {
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth).To(Equal(preferredBandwidth))
+			Expect(vmi.Spec.Domain.Devices.Interfaces[1].Bandwidth).To(Equal(preferredBandwidth))
+		})
+
+		It("should not override the bandwidth limits of the interface", func() {
+			userBandwidth := &virtv1.InterfaceBandwidth{Outbound: &virtv1.BandwidthLimit{Average: 500}}
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = userBandwidth
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth).To(Equal(userBandwidth))
+		})
+
+		It("should not be applied on interface that has the SR-IOV binding", func() {
+			vmi.Spec.Domain.Devices.Interfaces[0].SRIOV = &virtv1.InterfaceSRIOV{}
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth).To(BeNil())
+		})
+	})
+
 	It("should apply to VMI", func() {
 		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
 
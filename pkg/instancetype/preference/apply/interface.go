@@ -49,5 +49,9 @@ func applyInterfacePreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceS
 			isInterfaceOnPodNetwork(vmiIface.Name, vmiSpec) {
 			vmiIface.Masquerade = preferenceSpec.Devices.PreferredInterfaceMasquerade.DeepCopy()
 		}
+		if preferenceSpec.Devices.PreferredInterfaceBandwidth != nil && vmiIface.Bandwidth == nil &&
+			(vmiIface.Bridge != nil || vmiIface.Masquerade != nil || isInterfaceBindingUnset(vmiIface)) {
+			vmiIface.Bandwidth = preferenceSpec.Devices.PreferredInterfaceBandwidth.DeepCopy()
+		}
 	}
 }
@@ -142,6 +142,14 @@ func WithFilesystemPVC(claimName string) Option {
 	}
 }
 
+// WithHotplugFilesystemPVC specifies a filesystem backed by a hotpluggable PVC to be used.
+func WithHotplugFilesystemPVC(claimName string) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		addFilesystem(vmi, newVirtiofsFilesystem(claimName))
+		addVolume(vmi, newPersistentVolumeClaimVolume(claimName, claimName, true))
+	}
+}
+
 // WithFilesystemDV specifies a filesystem backed by a DV to be used.
 func WithFilesystemDV(dataVolumeName string) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
@@ -0,0 +1,63 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import "github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+var (
+	containerDiskCacheMetrics = []operatormetrics.Metric{
+		containerDiskCacheHits,
+		containerDiskCacheMisses,
+		containerDiskCacheEvictions,
+	}
+
+	containerDiskCacheHits = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_container_disk_cache_hits_total",
+			Help: "The total number of containerDisk images served from the node cache of virt-handler.",
+		},
+	)
+
+	containerDiskCacheMisses = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_container_disk_cache_misses_total",
+			Help: "The total number of containerDisk images which had to be added to the node cache of virt-handler.",
+		},
+	)
+
+	containerDiskCacheEvictions = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_container_disk_cache_evictions_total",
+			Help: "The total number of unused containerDisk images evicted from the node cache of virt-handler under disk pressure.",
+		},
+	)
+)
+
+func IncContainerDiskCacheHits() {
+	containerDiskCacheHits.Inc()
+}
+
+func IncContainerDiskCacheMisses() {
+	containerDiskCacheMisses.Inc()
+}
+
+func IncContainerDiskCacheEvictions() {
+	containerDiskCacheEvictions.Inc()
+}
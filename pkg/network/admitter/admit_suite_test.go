@@ -32,10 +32,28 @@ func TestAdmitter(t *testing.T) {
 type stubClusterConfigChecker struct {
 	bridgeBindingOnPodNetEnabled   bool
 	passtBindingFeatureGateEnabled bool
+	firewallFeatureGateEnabled     bool
+	ipv6AutoConfigGateEnabled      bool
+	bandwidthFeatureGateEnabled    bool
+	ipPreservingMigrationEnabled   bool
 }
 
 func (s stubClusterConfigChecker) PasstBindingEnabled() bool { return s.passtBindingFeatureGateEnabled }
 
+func (s stubClusterConfigChecker) InterfaceFirewallEnabled() bool {
+	return s.firewallFeatureGateEnabled
+}
+
+func (s stubClusterConfigChecker) IPv6AutoConfigEnabled() bool { return s.ipv6AutoConfigGateEnabled }
+
+func (s stubClusterConfigChecker) InterfaceBandwidthEnabled() bool {
+	return s.bandwidthFeatureGateEnabled
+}
+
+func (s stubClusterConfigChecker) BridgeIPPreservingMigrationEnabled() bool {
+	return s.ipPreservingMigrationEnabled
+}
+
 func (s stubClusterConfigChecker) IsBridgeInterfaceOnPodNetworkEnabled() bool {
 	return s.bridgeBindingOnPodNetEnabled
 }
@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"math"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// maxBandwidthKiB is the largest rate and burst traffic control accepts, in kibibytes
+const maxBandwidthKiB = math.MaxUint32 / 1024
+
+func validateInterfacesBandwidth(
+	fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Bandwidth == nil {
+			continue
+		}
+		bandwidthPath := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth")
+		if !config.InterfaceBandwidthEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "InterfaceBandwidth feature gate is not enabled",
+				Field:   bandwidthPath.String(),
+			})
+			continue
+		}
+		if iface.Bridge == nil && iface.Masquerade == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "bandwidth is only supported by the bridge and masquerade bindings",
+				Field:   bandwidthPath.String(),
+			})
+			continue
+		}
+		if iface.Bandwidth.Inbound != nil {
+			causes = append(causes, validateBandwidthLimit(bandwidthPath.Child("inbound"), *iface.Bandwidth.Inbound)...)
+		}
+		if iface.Bandwidth.Outbound != nil {
+			causes = append(causes, validateBandwidthLimit(bandwidthPath.Child("outbound"), *iface.Bandwidth.Outbound)...)
+		}
+	}
+	return causes
+}
+
+func validateBandwidthLimit(limitPath *field.Path, limit v1.BandwidthLimit) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if cause := validateBandwidthValue(limitPath, "average", limit.Average); cause != nil {
+		causes = append(causes, *cause)
+	}
+	if limit.Peak != nil {
+		if cause := validateBandwidthValue(limitPath, "peak", *limit.Peak); cause != nil {
+			causes = append(causes, *cause)
+		} else if *limit.Peak < limit.Average {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("bandwidth peak %d must not be lower than the average %d", *limit.Peak, limit.Average),
+				Field:   limitPath.Child("peak").String(),
+			})
+		}
+	}
+	if limit.Burst != nil {
+		if cause := validateBandwidthValue(limitPath, "burst", *limit.Burst); cause != nil {
+			causes = append(causes, *cause)
+		}
+	}
+	return causes
+}
+
+func validateBandwidthValue(limitPath *field.Path, name string, value int64) *metav1.StatusCause {
+	if value >= 1 && value <= maxBandwidthKiB {
+		return nil
+	}
+	return &metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("bandwidth %s %d is out of the range 1-%d", name, value, maxBandwidthKiB),
+		Field:   limitPath.Child(name).String(),
+	}
+}
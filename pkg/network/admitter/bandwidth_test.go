@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating interface bandwidth", func() {
+	newSpec := func(bindingMethod v1.InterfaceBindingMethod, bandwidth *v1.InterfaceBandwidth) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: bindingMethod,
+			Bandwidth:              bandwidth,
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+	enabledConfig := stubClusterConfigChecker{bandwidthFeatureGateEnabled: true}
+
+	It("should reject bandwidth limits when the feature gate is disabled", func() {
+		spec := newSpec(masquerade, &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}})
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}).Validate()
+
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "InterfaceBandwidth feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].bandwidth",
+		}))
+	})
+
+	It("should reject bandwidth limits on an SR-IOV interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}, &v1.InterfaceBandwidth{})
+		spec.Networks[0] = v1.Network{
+			Name:          "default",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "sriov"}},
+		}
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()
+
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "bandwidth is only supported by the bridge and masquerade bindings",
+			Field:   "fake.domain.devices.interfaces[0].bandwidth",
+		}))
+	})
+
+	It("should accept valid limits", func() {
+		spec := newSpec(masquerade, &v1.InterfaceBandwidth{
+			Inbound:  &v1.BandwidthLimit{Average: 1000, Peak: pointer.P(int64(2000)), Burst: pointer.P(int64(512))},
+			Outbound: &v1.BandwidthLimit{Average: 1000},
+		})
+
+		Expect(admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()).To(BeEmpty())
+	})
+
+	It("should reject a peak lower than the average", func() {
+		spec := newSpec(masquerade, &v1.InterfaceBandwidth{
+			Outbound: &v1.BandwidthLimit{Average: 1000, Peak: pointer.P(int64(500))},
+		})
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()
+
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "bandwidth peak 500 must not be lower than the average 1000",
+			Field:   "fake.domain.devices.interfaces[0].bandwidth.outbound.peak",
+		}))
+	})
+
+	It("should reject values out of range", func() {
+		spec := newSpec(masquerade, &v1.InterfaceBandwidth{
+			Inbound: &v1.BandwidthLimit{Average: 0, Burst: pointer.P(int64(4194304))},
+		})
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()
+
+		Expect(causes).To(ConsistOf(
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "bandwidth average 0 is out of the range 1-4194303",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.inbound.average",
+			},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "bandwidth burst 4194304 is out of the range 1-4194303",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.inbound.burst",
+			},
+		))
+	})
+})
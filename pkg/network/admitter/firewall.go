@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validateInterfacesFirewall(
+	fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Firewall == nil {
+			continue
+		}
+		ifacePath := fieldPath.Child("domain", "devices", "interfaces").Index(idx)
+		if !config.InterfaceFirewallEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "InterfaceFirewall feature gate is not enabled",
+				Field:   ifacePath.Child("firewall").String(),
+			})
+			continue
+		}
+		if iface.Bridge == nil && iface.Masquerade == nil && iface.PasstBinding == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "firewall is only supported by the bridge, masquerade and passt bindings",
+				Field:   ifacePath.Child("firewall").String(),
+			})
+			continue
+		}
+		causes = append(causes, validateFirewallRules(ifacePath.Child("firewall", "ingress"), iface.Firewall.Ingress)...)
+		causes = append(causes, validateFirewallRules(ifacePath.Child("firewall", "egress"), iface.Firewall.Egress)...)
+	}
+	return causes
+}
+
+func validateFirewallRules(rulesPath *field.Path, rules []v1.FirewallRule) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	ruleNames := map[string]struct{}{}
+	for idx, rule := range rules {
+		rulePath := rulesPath.Index(idx)
+		if errs := k8svalidation.IsDNS1123Label(rule.Name); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("firewall rule name %q is invalid: %s", rule.Name, errs[0]),
+				Field:   rulePath.Child("name").String(),
+			})
+		}
+		if _, exists := ruleNames[rule.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("firewall rule name %q is not unique", rule.Name),
+				Field:   rulePath.Child("name").String(),
+			})
+		}
+		ruleNames[rule.Name] = struct{}{}
+		causes = append(causes, validateFirewallRuleMatch(rulePath, rule)...)
+	}
+	return causes
+}
+
+func validateFirewallRuleMatch(rulePath *field.Path, rule v1.FirewallRule) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	switch rule.Action {
+	case "", v1.FirewallActionAllow, v1.FirewallActionDeny:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("firewall rule action %q is not supported", rule.Action),
+			Field:   rulePath.Child("action").String(),
+		})
+	}
+
+	switch rule.Protocol {
+	case "", v1.FirewallProtocolTCP, v1.FirewallProtocolUDP, v1.FirewallProtocolSCTP, v1.FirewallProtocolICMP:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("firewall rule protocol %q is not supported", rule.Protocol),
+			Field:   rulePath.Child("protocol").String(),
+		})
+	}
+
+	if len(rule.Ports) > 0 && (rule.Protocol == "" || rule.Protocol == v1.FirewallProtocolICMP) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "firewall rule ports require the TCP, UDP or SCTP protocol",
+			Field:   rulePath.Child("ports").String(),
+		})
+	}
+	for portIdx, port := range rule.Ports {
+		if port < 1 || port > 65535 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("firewall rule port %d is out of the range 1-65535", port),
+				Field:   rulePath.Child("ports").Index(portIdx).String(),
+			})
+		}
+	}
+
+	for cidrIdx, cidr := range rule.CIDRBlocks {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("firewall rule CIDR %q is invalid", cidr),
+				Field:   rulePath.Child("cidrBlocks").Index(cidrIdx).String(),
+			})
+		}
+	}
+	return causes
+}
@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface firewall", func() {
+	newSpec := func(bindingMethod v1.InterfaceBindingMethod, firewall *v1.InterfaceFirewall) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: bindingMethod,
+			Firewall:               firewall,
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+	enabledConfig := stubClusterConfigChecker{firewallFeatureGateEnabled: true}
+
+	It("should reject a firewall when the feature gate is disabled", func() {
+		spec := newSpec(masquerade, &v1.InterfaceFirewall{Ingress: []v1.FirewallRule{{Name: "ssh"}}})
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}).Validate()
+
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "InterfaceFirewall feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].firewall",
+		}))
+	})
+
+	It("should reject a firewall on an SR-IOV interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}, &v1.InterfaceFirewall{})
+		spec.Networks[0] = v1.Network{
+			Name:          "default",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "sriov"}},
+		}
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()
+
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "firewall is only supported by the bridge, masquerade and passt bindings",
+			Field:   "fake.domain.devices.interfaces[0].firewall",
+		}))
+	})
+
+	It("should accept valid rules", func() {
+		spec := newSpec(masquerade, &v1.InterfaceFirewall{
+			Ingress: []v1.FirewallRule{
+				{Name: "ssh", Protocol: v1.FirewallProtocolTCP, Ports: []int32{22}, CIDRBlocks: []string{"10.0.0.0/8", "fd10::/64"}},
+				{Name: "ping", Protocol: v1.FirewallProtocolICMP},
+			},
+			Egress: []v1.FirewallRule{{Name: "ssh", Action: v1.FirewallActionDeny}},
+		})
+
+		Expect(admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()).To(BeEmpty())
+	})
+
+	DescribeTable("should reject an invalid rule", func(rule v1.FirewallRule, expectedCause metav1.StatusCause) {
+		spec := newSpec(masquerade, &v1.InterfaceFirewall{Egress: []v1.FirewallRule{rule}})
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()
+
+		Expect(causes).To(ConsistOf(expectedCause))
+	},
+		Entry("with an invalid name", v1.FirewallRule{Name: "Not_Valid"}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: `firewall rule name "Not_Valid" is invalid: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`,
+			Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].name",
+		}),
+		Entry("with an unknown action", v1.FirewallRule{Name: "r", Action: "Reject"}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: `firewall rule action "Reject" is not supported`,
+			Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].action",
+		}),
+		Entry("with an unknown protocol", v1.FirewallRule{Name: "r", Protocol: "GRE"}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: `firewall rule protocol "GRE" is not supported`,
+			Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].protocol",
+		}),
+		Entry("with ports and no protocol", v1.FirewallRule{Name: "r", Ports: []int32{80}}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "firewall rule ports require the TCP, UDP or SCTP protocol",
+			Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].ports",
+		}),
+		Entry("with an out of range port", v1.FirewallRule{Name: "r", Protocol: v1.FirewallProtocolUDP, Ports: []int32{70000}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "firewall rule port 70000 is out of the range 1-65535",
+				Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].ports[0]",
+			}),
+		Entry("with an invalid CIDR", v1.FirewallRule{Name: "r", CIDRBlocks: []string{"10.0.0.1"}}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: `firewall rule CIDR "10.0.0.1" is invalid`,
+			Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].cidrBlocks[0]",
+		}),
+	)
+
+	It("should reject duplicated rule names in the same direction", func() {
+		spec := newSpec(masquerade, &v1.InterfaceFirewall{Ingress: []v1.FirewallRule{{Name: "web"}, {Name: "web"}}})
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()
+
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueDuplicate,
+			Message: `firewall rule name "web" is not unique`,
+			Field:   "fake.domain.devices.interfaces[0].firewall.ingress[1].name",
+		}))
+	})
+})
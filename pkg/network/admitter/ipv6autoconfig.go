@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+func validateInterfacesIPv6AutoConfig(
+	fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Bridge == nil || iface.Bridge.AutoConfig == nil {
+			continue
+		}
+		autoConfigPath := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("bridge", "autoConfig")
+		if !config.IPv6AutoConfigEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "IPv6AutoConfig feature gate is not enabled",
+				Field:   autoConfigPath.String(),
+			})
+			continue
+		}
+		// The advertised configuration is taken from the IPAM results of the secondary network.
+		if network := vmispec.LookupNetworkByName(spec.Networks, iface.Name); network != nil && !vmispec.IsSecondaryMultusNetwork(*network) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "IPv6 auto-configuration is only supported on secondary Multus networks",
+				Field:   autoConfigPath.String(),
+			})
+			continue
+		}
+		causes = append(causes, validateIPv6AutoConfig(autoConfigPath, iface.Bridge.AutoConfig)...)
+	}
+	return causes
+}
+
+func validateIPv6AutoConfig(autoConfigPath *field.Path, autoConfig *v1.IPv6AutoConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	switch autoConfig.Mode {
+	case "", v1.IPv6AutoConfigModeSLAAC, v1.IPv6AutoConfigModeDHCPv6:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("IPv6 auto-configuration mode %q is not supported", autoConfig.Mode),
+			Field:   autoConfigPath.Child("mode").String(),
+		})
+	}
+
+	if autoConfig.DelegatedPrefix == "" {
+		return causes
+	}
+	delegatedPrefixPath := autoConfigPath.Child("delegatedPrefix")
+	if autoConfig.Mode == v1.IPv6AutoConfigModeSLAAC {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "prefix delegation requires the DHCPv6 mode",
+			Field:   delegatedPrefixPath.String(),
+		})
+	}
+	ip, ipNet, err := net.ParseCIDR(autoConfig.DelegatedPrefix)
+	if err != nil || ip.To4() != nil || !ip.Equal(ipNet.IP) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("delegated prefix %q is not a valid IPv6 prefix", autoConfig.DelegatedPrefix),
+			Field:   delegatedPrefixPath.String(),
+		})
+	}
+	return causes
+}
@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating IPv6 auto-configuration", func() {
+	newSpec := func(autoConfig *v1.IPv6AutoConfig) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "red",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{AutoConfig: autoConfig}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name:          "red",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+		}}
+		return spec
+	}
+
+	enabledConfig := stubClusterConfigChecker{ipv6AutoConfigGateEnabled: true}
+
+	It("should reject an IPv6 auto-configuration on the pod network", func() {
+		spec := newSpec(&v1.IPv6AutoConfig{})
+		spec.Networks[0].NetworkSource = v1.NetworkSource{Pod: &v1.PodNetwork{}}
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()
+
+		Expect(causes).To(ContainElement(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "IPv6 auto-configuration is only supported on secondary Multus networks",
+			Field:   "fake.domain.devices.interfaces[0].bridge.autoConfig",
+		}))
+	})
+
+	It("should reject an IPv6 auto-configuration when the feature gate is disabled", func() {
+		spec := newSpec(&v1.IPv6AutoConfig{})
+
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}).Validate()
+
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "IPv6AutoConfig feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].bridge.autoConfig",
+		}))
+	})
+
+	DescribeTable("should accept a valid IPv6 auto-configuration", func(autoConfig *v1.IPv6AutoConfig) {
+		Expect(admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(autoConfig), enabledConfig).Validate()).To(BeEmpty())
+	},
+		Entry("with the default mode", &v1.IPv6AutoConfig{}),
+		Entry("with the SLAAC mode", &v1.IPv6AutoConfig{Mode: v1.IPv6AutoConfigModeSLAAC}),
+		Entry("with the DHCPv6 mode", &v1.IPv6AutoConfig{Mode: v1.IPv6AutoConfigModeDHCPv6}),
+		Entry("with a delegated prefix", &v1.IPv6AutoConfig{DelegatedPrefix: "fd20:1:2::/56"}),
+	)
+
+	DescribeTable("should reject an invalid IPv6 auto-configuration", func(autoConfig *v1.IPv6AutoConfig, expectedCauses ...metav1.StatusCause) {
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(autoConfig), enabledConfig).Validate()
+
+		Expect(causes).To(ConsistOf(expectedCauses))
+	},
+		Entry("with an unknown mode", &v1.IPv6AutoConfig{Mode: "Static"}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: `IPv6 auto-configuration mode "Static" is not supported`,
+			Field:   "fake.domain.devices.interfaces[0].bridge.autoConfig.mode",
+		}),
+		Entry("with a delegated prefix in SLAAC mode",
+			&v1.IPv6AutoConfig{Mode: v1.IPv6AutoConfigModeSLAAC, DelegatedPrefix: "fd20:1:2::/56"},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "prefix delegation requires the DHCPv6 mode",
+				Field:   "fake.domain.devices.interfaces[0].bridge.autoConfig.delegatedPrefix",
+			}),
+		Entry("with an IPv4 delegated prefix", &v1.IPv6AutoConfig{DelegatedPrefix: "10.10.0.0/16"}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: `delegated prefix "10.10.0.0/16" is not a valid IPv6 prefix`,
+			Field:   "fake.domain.devices.interfaces[0].bridge.autoConfig.delegatedPrefix",
+		}),
+		Entry("with a delegated prefix with host bits", &v1.IPv6AutoConfig{DelegatedPrefix: "fd20:1:2::1/56"}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: `delegated prefix "fd20:1:2::1/56" is not a valid IPv6 prefix`,
+			Field:   "fake.domain.devices.interfaces[0].bridge.autoConfig.delegatedPrefix",
+		}),
+	)
+})
@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+func validateInterfacesMigrationMode(
+	fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Bridge == nil || iface.Bridge.MigrationMode == "" {
+			continue
+		}
+		migrationModePath := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("bridge", "migrationMode")
+		if !config.BridgeIPPreservingMigrationEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "BridgeIPPreservingMigration feature gate is not enabled",
+				Field:   migrationModePath.String(),
+			})
+			continue
+		}
+		if iface.Bridge.MigrationMode != v1.BridgeMigrationModePreserveIP {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("bridge migration mode %q is not supported", iface.Bridge.MigrationMode),
+				Field:   migrationModePath.String(),
+			})
+			continue
+		}
+		// Secondary networks keep their addresses across migrations, only the pod address changes.
+		if network := vmispec.LookupNetworkByName(spec.Networks, iface.Name); network != nil && network.Pod == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "bridge migration mode is only supported on the pod network",
+				Field:   migrationModePath.String(),
+			})
+		}
+	}
+	return causes
+}
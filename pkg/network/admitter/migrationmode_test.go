@@ -0,0 +1,92 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating bridge migration mode", func() {
+	newSpec := func(migrationMode v1.BridgeMigrationMode) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{MigrationMode: migrationMode}},
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	enabledConfig := stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true, ipPreservingMigrationEnabled: true}
+
+	It("should accept preserving the guest IP on the pod network", func() {
+		spec := newSpec(v1.BridgeMigrationModePreserveIP)
+
+		Expect(admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig).Validate()).To(BeEmpty())
+	})
+
+	DescribeTable("should reject", func(
+		spec *v1.VirtualMachineInstanceSpec, config stubClusterConfigChecker, expectedCause metav1.StatusCause,
+	) {
+		causes := admitter.NewValidator(k8sfield.NewPath("fake"), spec, config).Validate()
+
+		Expect(causes).To(ConsistOf(expectedCause))
+	},
+		Entry("a migration mode when the feature gate is disabled",
+			newSpec(v1.BridgeMigrationModePreserveIP),
+			stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "BridgeIPPreservingMigration feature gate is not enabled",
+				Field:   "fake.domain.devices.interfaces[0].bridge.migrationMode",
+			},
+		),
+		Entry("an unknown migration mode",
+			newSpec("Proxy"),
+			enabledConfig,
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: `bridge migration mode "Proxy" is not supported`,
+				Field:   "fake.domain.devices.interfaces[0].bridge.migrationMode",
+			},
+		),
+		Entry("preserving the guest IP on a secondary network",
+			func() *v1.VirtualMachineInstanceSpec {
+				spec := newSpec(v1.BridgeMigrationModePreserveIP)
+				spec.Networks[0].NetworkSource = v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}
+				return spec
+			}(),
+			enabledConfig,
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "bridge migration mode is only supported on the pod network",
+				Field:   "fake.domain.devices.interfaces[0].bridge.migrationMode",
+			},
+		),
+	)
+})
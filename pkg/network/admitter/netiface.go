@@ -20,10 +20,12 @@
 package admitter
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"regexp"
 
+	dhcpoptions "kubevirt.io/kubevirt/pkg/network/dhcp/options"
 	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
@@ -268,13 +270,14 @@ func validateForwardPortNonZero(field *k8sfield.Path, idx int, forwardPort v1.Po
 func validateDHCPOptions(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if iface.DHCPOptions != nil {
-		causes = append(causes, validateDHCPExtraOptions(field, iface)...)
+		causes = append(causes, validateDHCPPrivateOptions(field, iface)...)
 		causes = append(causes, validateDHCPNTPServersAreValidIPv4Addresses(field, iface, idx)...)
+		causes = append(causes, validateDHCPExtraOptions(field, iface, idx)...)
 	}
 	return causes
 }
 
-func validateDHCPExtraOptions(field *k8sfield.Path, iface v1.Interface) []metav1.StatusCause {
+func validateDHCPPrivateOptions(field *k8sfield.Path, iface v1.Interface) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	privateOptions := iface.DHCPOptions.PrivateOptions
 	if countUniqueDHCPPrivateOptions(privateOptions) < len(privateOptions) {
@@ -306,6 +309,82 @@ func validateDHCPNTPServersAreValidIPv4Addresses(field *k8sfield.Path, iface v1.
 	return causes
 }
 
+func validateDHCPExtraOptions(field *k8sfield.Path, iface v1.Interface, idx int) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	type familyCode struct {
+		family v1.DHCPOptionFamily
+		code   int32
+	}
+	seenCodes := map[familyCode]struct{}{}
+	for optionIdx, extraOption := range iface.DHCPOptions.ExtraOptions {
+		optionField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "extraOptions").Index(optionIdx)
+		family := dhcpoptions.Family(extraOption)
+		if family != v1.DHCPOptionFamilyIPv4 && family != v1.DHCPOptionFamilyIPv6 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("DHCP option family %q is not supported, must be IPv4 or IPv6", extraOption.Family),
+				Field:   optionField.Child("family").String(),
+			})
+			continue
+		}
+
+		if cause := validateDHCPExtraOptionCode(optionField.Child("code"), family, extraOption.Code); cause != nil {
+			causes = append(causes, *cause)
+		} else if _, exists := seenCodes[familyCode{family, extraOption.Code}]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("duplicate %s DHCP option %d", family, extraOption.Code),
+				Field:   optionField.Child("code").String(),
+			})
+		}
+		seenCodes[familyCode{family, extraOption.Code}] = struct{}{}
+
+		value, err := dhcpoptions.Encode(extraOption)
+		if errors.Is(err, dhcpoptions.ErrUnsupportedType) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("DHCP option type %q is not supported", extraOption.Type),
+				Field:   optionField.Child("type").String(),
+			})
+		} else if err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid DHCP option value: %v", err),
+				Field:   optionField.Child("value").String(),
+			})
+		} else if family == v1.DHCPOptionFamilyIPv4 && len(value) > dhcpoptions.MaxIPv4ValueLen {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("DHCP option value exceeds %d bytes", dhcpoptions.MaxIPv4ValueLen),
+				Field:   optionField.Child("value").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDHCPExtraOptionCode(codeField *k8sfield.Path, family v1.DHCPOptionFamily, code int32) *metav1.StatusCause {
+	maxCode := int32(dhcpoptions.MaxIPv4Code)
+	if family == v1.DHCPOptionFamilyIPv6 {
+		maxCode = dhcpoptions.MaxIPv6Code
+	}
+	if code < 1 || code > maxCode {
+		return &metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s DHCP option code must be in range 1 to %d", family, maxCode),
+			Field:   codeField.String(),
+		}
+	}
+	if dhcpoptions.IsManagedByServer(family, code) {
+		return &metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s DHCP option %d is managed by the DHCP server", family, code),
+			Field:   codeField.String(),
+		}
+	}
+	return nil
+}
+
 func validateDHCPPrivateOptionsWithinRange(field *k8sfield.Path, dhcpPrivateOption v1.DHCPPrivateOptions) (causes []metav1.StatusCause) {
 	if !(dhcpPrivateOption.Option >= 224 && dhcpPrivateOption.Option <= 254) {
 		causes = append(causes, metav1.StatusCause{
@@ -21,6 +21,7 @@ package admitter_test
 
 import (
 	"fmt"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -362,6 +363,86 @@ var _ = Describe("Validating VMI network spec", func() {
 					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ntpServers[1]",
 				}},
 			),
+			Entry(
+				"unsupported extra option family",
+				v1.DHCPOptions{ExtraOptions: []v1.DHCPExtraOption{{Family: "IPv5", Code: 66, Value: "tftp.kubevirt.io"}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueNotSupported",
+					Message: `DHCP option family "IPv5" is not supported, must be IPv4 or IPv6`,
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.extraOptions[0].family",
+				}},
+			),
+			Entry(
+				"out of range extra option codes",
+				v1.DHCPOptions{ExtraOptions: []v1.DHCPExtraOption{
+					{Code: 255, Value: "end"},
+					{Family: v1.DHCPOptionFamilyIPv6, Code: 0, Value: "reserved"},
+				}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "IPv4 DHCP option code must be in range 1 to 254",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.extraOptions[0].code",
+				}, {
+					Type:    "FieldValueInvalid",
+					Message: "IPv6 DHCP option code must be in range 1 to 65535",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.extraOptions[1].code",
+				}},
+			),
+			Entry(
+				"extra options managed by the server",
+				v1.DHCPOptions{ExtraOptions: []v1.DHCPExtraOption{
+					{Code: 54, Type: v1.DHCPOptionTypeIPAddresses, Value: "10.0.0.1"},
+					{Family: v1.DHCPOptionFamilyIPv6, Code: 3, Type: v1.DHCPOptionTypeHex, Value: "00"},
+				}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "IPv4 DHCP option 54 is managed by the DHCP server",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.extraOptions[0].code",
+				}, {
+					Type:    "FieldValueInvalid",
+					Message: "IPv6 DHCP option 3 is managed by the DHCP server",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.extraOptions[1].code",
+				}},
+			),
+			Entry(
+				"duplicate extra options",
+				v1.DHCPOptions{ExtraOptions: []v1.DHCPExtraOption{
+					{Code: 66, Value: "tftp.kubevirt.io"},
+					{Family: v1.DHCPOptionFamilyIPv4, Code: 66, Value: "tftp2.kubevirt.io"},
+				}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueDuplicate",
+					Message: "duplicate IPv4 DHCP option 66",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.extraOptions[1].code",
+				}},
+			),
+			Entry(
+				"unsupported extra option type",
+				v1.DHCPOptions{ExtraOptions: []v1.DHCPExtraOption{{Code: 19, Type: "Bool", Value: "true"}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueNotSupported",
+					Message: `DHCP option type "Bool" is not supported`,
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.extraOptions[0].type",
+				}},
+			),
+			Entry(
+				"extra option value not matching its type",
+				v1.DHCPOptions{ExtraOptions: []v1.DHCPExtraOption{{Code: 26, Type: v1.DHCPOptionTypeUint16, Value: "70000"}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: `invalid DHCP option value: "70000" is not a 16 bits unsigned integer`,
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.extraOptions[0].value",
+				}},
+			),
+			Entry(
+				"too long IPv4 extra option value",
+				v1.DHCPOptions{ExtraOptions: []v1.DHCPExtraOption{{Code: 66, Value: strings.Repeat("a", 256)}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCP option value exceeds 255 bytes",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.extraOptions[0].value",
+				}},
+			),
 		)
 
 		DescribeTable("should accept interface DHCP options with", func(dhcpOpts v1.DHCPOptions) {
@@ -380,6 +461,13 @@ var _ = Describe("Validating VMI network spec", func() {
 				PrivateOptions: []v1.DHCPPrivateOptions{{Option: 240, Value: "extra.options.kubevirt.io"}},
 			}),
 			Entry(" valid NTP servers", v1.DHCPOptions{NTPServers: []string{"127.0.0.1", "127.0.0.2"}}),
+			Entry("valid extra options", v1.DHCPOptions{ExtraOptions: []v1.DHCPExtraOption{
+				{Code: 26, Type: v1.DHCPOptionTypeUint16, Value: "1400"},
+				{Code: 66, Value: "tftp.kubevirt.io"},
+				{Code: 42, Type: v1.DHCPOptionTypeIPAddresses, Value: "10.0.0.1,10.0.0.2"},
+				{Family: v1.DHCPOptionFamilyIPv6, Code: 56, Type: v1.DHCPOptionTypeIPAddresses, Value: "fd00::1"},
+				{Family: v1.DHCPOptionFamilyIPv6, Code: 66, Type: v1.DHCPOptionTypeHex, Value: "0001"},
+			}}),
 			Entry(
 				"unique DHCPPrivateOptions",
 				v1.DHCPOptions{
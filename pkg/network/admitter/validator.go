@@ -28,6 +28,10 @@ import (
 type clusterConfigChecker interface {
 	IsBridgeInterfaceOnPodNetworkEnabled() bool
 	PasstBindingEnabled() bool
+	InterfaceFirewallEnabled() bool
+	IPv6AutoConfigEnabled() bool
+	InterfaceBandwidthEnabled() bool
+	BridgeIPPreservingMigrationEnabled() bool
 }
 
 type Validator struct {
@@ -57,6 +61,10 @@ func (v Validator) Validate() []metav1.StatusCause {
 	causes = append(causes, validateInterfaceNameUnique(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesAssignedToNetworks(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesFields(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfacesFirewall(v.field, v.vmiSpec, v.configChecker)...)
+	causes = append(causes, validateInterfacesIPv6AutoConfig(v.field, v.vmiSpec, v.configChecker)...)
+	causes = append(causes, validateInterfacesBandwidth(v.field, v.vmiSpec, v.configChecker)...)
+	causes = append(causes, validateInterfacesMigrationMode(v.field, v.vmiSpec, v.configChecker)...)
 
 	return causes
 }
@@ -26,6 +26,8 @@ import (
 
 	"github.com/vishvananda/netlink"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
@@ -99,6 +101,9 @@ type DHCPConfig struct {
 	IPAMDisabled        bool
 	Gateway             net.IP
 	Subdomain           string
+	IPv6AutoConfigMode  v1.IPv6AutoConfigMode
+	IPv6AutoConfigTap   string
+	IPv6DelegatedPrefix *net.IPNet
 }
 
 func (d DHCPConfig) String() string {
@@ -174,6 +174,15 @@ func applyDynamicIfaceRequestOnVMI(
 				vmiIface.State = vmIface.State
 			}
 		}
+
+		shouldUpdateExistingIfaceFirewall := existsInVMISpec &&
+			vmiIfaceCopy.State != v1.InterfaceStateAbsent &&
+			!equality.Semantic.DeepEqual(vmIface.Firewall, vmiIfaceCopy.Firewall)
+
+		if shouldUpdateExistingIfaceFirewall {
+			vmiIface := vmispec.LookupInterfaceByName(vmiSpecCopy.Domain.Devices.Interfaces, vmIface.Name)
+			vmiIface.Firewall = vmIface.Firewall.DeepCopy()
+		}
 	}
 	return vmiSpecCopy
 }
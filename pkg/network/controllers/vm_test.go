@@ -169,6 +169,32 @@ var _ = Describe("VM Network Controller", func() {
 		}),
 	)
 
+	It("sync succeeds to update the firewall of an existing interface", func() {
+		clientset := fake.NewSimpleClientset()
+		c := controllers.NewVMController(clientset)
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+		vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Firewall = &v1.InterfaceFirewall{
+			Ingress: []v1.FirewallRule{{Name: "ssh", Protocol: v1.FirewallProtocolTCP, Ports: []int32{22}}},
+		}
+
+		// Simulate the existence of the VMI on the server (to allow the Sync to patch it).
+		_, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, k8smetav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = c.Sync(vm, vmi)
+		Expect(err).NotTo(HaveOccurred())
+
+		updatedVMI, err := clientset.KubevirtV1().
+			VirtualMachineInstances(vmi.Namespace).
+			Get(context.Background(), vmi.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(Equal(vm.Spec.Template.Spec.Domain.Devices.Interfaces))
+	})
+
 	It("sync does not hotplug a new absent interface", func() {
 		clientset := fake.NewSimpleClientset()
 		c := controllers.NewVMController(clientset)
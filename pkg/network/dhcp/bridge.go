@@ -19,13 +19,19 @@
 package dhcp
 
 import (
+	"fmt"
+	"net"
+
 	"github.com/vishvananda/netlink"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
 	virtnetlink "kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
 type cacheCreator interface {
@@ -39,6 +45,7 @@ type BridgeConfigGenerator struct {
 	vmiSpecIfaces    []v1.Interface
 	vmiSpecIface     *v1.Interface
 	subdomain        string
+	networkInfoPath  string
 }
 
 func (d *BridgeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
@@ -48,6 +55,12 @@ func (d *BridgeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
 		return nil, err
 	}
 
+	if d.vmiSpecIface != nil && vmispec.HasIPv6AutoConfig(*d.vmiSpecIface) {
+		if err := d.generateIPv6AutoConfig(dhcpConfig, d.vmiSpecIface.Bridge.AutoConfig); err != nil {
+			return nil, err
+		}
+	}
+
 	if dhcpConfig.IPAMDisabled {
 		return dhcpConfig, nil
 	}
@@ -68,3 +81,77 @@ func (d *BridgeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
 
 	return dhcpConfig, nil
 }
+
+// generateIPv6AutoConfig sets the IPv6 address assigned by the network IPAM to the pod interface,
+// as reported by Multus in the network-info, for it to be advertised to the guest.
+func (d *BridgeConfigGenerator) generateIPv6AutoConfig(dhcpConfig *cache.DHCPConfig, autoConfig *v1.IPv6AutoConfig) error {
+	networkInfo, err := downwardapi.ReadNetworkInfo(d.networkInfoPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the IPAM results of network %s: %w", d.vmiSpecIface.Name, err)
+	}
+	ipamIP := lookupIPAMIPv6Address(networkInfo, d.vmiSpecIface.Name)
+	if ipamIP == nil {
+		log.Log.Warningf("no IPv6 address is assigned to network %s, skipping the IPv6 auto-configuration", d.vmiSpecIface.Name)
+		return nil
+	}
+
+	prefixLen, err := d.podIfacePrefixLen(ipamIP)
+	if err != nil {
+		return err
+	}
+	dhcpConfig.IPv6 = netlink.Addr{IPNet: &net.IPNet{IP: ipamIP, Mask: net.CIDRMask(prefixLen, net.IPv6len*8)}}
+	dhcpConfig.IPv6AutoConfigTap = virtnetlink.GenerateIPv6AutoConfigTapName(d.podInterfaceName)
+
+	dhcpConfig.IPv6AutoConfigMode = autoConfig.Mode
+	if dhcpConfig.IPv6AutoConfigMode == "" {
+		dhcpConfig.IPv6AutoConfigMode = v1.IPv6AutoConfigModeDHCPv6
+	}
+	if autoConfig.DelegatedPrefix != "" {
+		_, delegatedPrefix, err := net.ParseCIDR(autoConfig.DelegatedPrefix)
+		if err != nil {
+			return err
+		}
+		dhcpConfig.IPv6DelegatedPrefix = delegatedPrefix
+	}
+
+	dhcpConfig.IPAMDisabled = false
+	return nil
+}
+
+// podIfacePrefixLen returns the prefix length of the given address on the pod interface.
+// The SLAAC prefix length is used when the address is not found.
+func (d *BridgeConfigGenerator) podIfacePrefixLen(ip net.IP) (int, error) {
+	const slaacPrefixLen = 64
+	podIfaceLink, err := d.handler.LinkByName(d.podInterfaceName)
+	if err != nil {
+		return 0, err
+	}
+	addrs, err := d.handler.AddrList(podIfaceLink, netlink.FAMILY_V6)
+	if err != nil {
+		return 0, err
+	}
+	for _, addr := range addrs {
+		if addr.IP.Equal(ip) {
+			prefixLen, _ := addr.Mask.Size()
+			return prefixLen, nil
+		}
+	}
+	return slaacPrefixLen, nil
+}
+
+func lookupIPAMIPv6Address(networkInfo *downwardapi.NetworkInfo, networkName string) net.IP {
+	if networkInfo == nil {
+		return nil
+	}
+	for _, iface := range networkInfo.Interfaces {
+		if iface.Network != networkName {
+			continue
+		}
+		for _, ipStr := range iface.IPs {
+			if ip := net.ParseIP(ipStr); ip != nil && ip.To4() == nil && ip.IsGlobalUnicast() {
+				return ip
+			}
+		}
+	}
+	return nil
+}
@@ -19,6 +19,9 @@
 package dhcp
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/vishvananda/netlink"
 	"go.uber.org/mock/gomock"
 
@@ -112,5 +115,62 @@ var _ = Describe("Bridge DHCP configurator", func() {
 			expectedConfig := cache.DHCPConfig{IPAMDisabled: true}
 			Expect(*config).To(Equal(expectedConfig))
 		})
+		Context("with IPv6 auto-configuration", func() {
+			var iface v1.Interface
+
+			BeforeEach(func() {
+				Expect(cache.WriteDHCPInterfaceCache(
+					&cacheCreator, launcherPID, ifaceName, &cache.DHCPConfig{IPAMDisabled: true},
+				)).To(Succeed())
+
+				iface = v1.Interface{
+					Name: "network",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{
+						Bridge: &v1.InterfaceBridge{AutoConfig: &v1.IPv6AutoConfig{DelegatedPrefix: "fd20:1:2::/56"}},
+					},
+				}
+				generator = BridgeConfigGenerator{
+					cacheCreator:     &cacheCreator,
+					podInterfaceName: ifaceName,
+					vmiSpecIfaces:    []v1.Interface{iface},
+					vmiSpecIface:     &iface,
+					handler:          mockHandler,
+					networkInfoPath:  filepath.Join(GinkgoT().TempDir(), "network-info"),
+				}
+			})
+
+			It("Should advertise the IPv6 address assigned by the network IPAM", func() {
+				Expect(os.WriteFile(generator.networkInfoPath,
+					[]byte(`{"interfaces":[{"network":"network","ips":["10.10.0.5","fd20:10::5"]}]}`), 0o644)).To(Succeed())
+
+				podIfaceLink := &netlink.GenericLink{LinkAttrs: netlink.LinkAttrs{Name: ifaceName}}
+				mockHandler.EXPECT().LinkByName(ifaceName).Return(podIfaceLink, nil)
+				podIfaceAddr, err := netlink.ParseAddr("fd20:10::5/80")
+				Expect(err).ToNot(HaveOccurred())
+				mockHandler.EXPECT().AddrList(podIfaceLink, netlink.FAMILY_V6).Return([]netlink.Addr{*podIfaceAddr}, nil)
+				podNicLink := &netlink.GenericLink{LinkAttrs: netlink.LinkAttrs{MTU: 1410}}
+				mockHandler.EXPECT().LinkByName(virtnetlink.GenerateNewBridgedVmiInterfaceName(ifaceName)).Return(podNicLink, nil)
+
+				config, err := generator.Generate()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.IPAMDisabled).To(BeFalse())
+				Expect(config.IP.IPNet).To(BeNil())
+				Expect(config.IPv6.IPNet.String()).To(Equal("fd20:10::5/80"))
+				Expect(config.IPv6AutoConfigMode).To(Equal(v1.IPv6AutoConfigModeDHCPv6))
+				Expect(config.IPv6AutoConfigTap).To(Equal(virtnetlink.GenerateIPv6AutoConfigTapName(ifaceName)))
+				Expect(config.IPv6DelegatedPrefix.String()).To(Equal("fd20:1:2::/56"))
+				Expect(config.Mtu).To(Equal(uint16(1410)))
+			})
+
+			It("Should not advertise when no IPv6 address is assigned by the network IPAM", func() {
+				Expect(os.WriteFile(generator.networkInfoPath,
+					[]byte(`{"interfaces":[{"network":"network","ips":["10.10.0.5"]}]}`), 0o644)).To(Succeed())
+
+				config, err := generator.Generate()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(*config).To(Equal(cache.DHCPConfig{IPAMDisabled: true}))
+			})
+		})
 	})
 })
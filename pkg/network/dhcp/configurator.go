@@ -25,12 +25,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 
 	"kubevirt.io/client-go/log"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
 )
 
@@ -67,6 +69,7 @@ func NewBridgeConfigurator(cacheCreator cacheCreator, advertisingIfaceName strin
 			vmiSpecIfaces:    vmiSpecIfaces,
 			vmiSpecIface:     vmiSpecIface,
 			subdomain:        subdomain,
+			networkInfoPath:  path.Join(downwardapi.MountPath, downwardapi.NetworkInfoVolumePath),
 		},
 	}
 }
@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package options
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	MaxIPv4Code = 254
+	MaxIPv6Code = 65535
+
+	// MaxIPv4ValueLen is the length limit of DHCP option values, which have a single byte length field.
+	MaxIPv4ValueLen = 255
+)
+
+var ErrUnsupportedType = errors.New("option type is not supported")
+
+// Options managed by the servers, which are required for the address assignment.
+var (
+	managedIPv4Codes = map[int32]struct{}{
+		1:  {}, // Subnet Mask
+		50: {}, // Requested IP Address
+		51: {}, // IP Address Lease Time
+		52: {}, // Option Overload
+		53: {}, // DHCP Message Type
+		54: {}, // Server Identifier
+		55: {}, // Parameter Request List
+		61: {}, // Client Identifier
+	}
+	managedIPv6Codes = map[int32]struct{}{
+		1:  {}, // Client Identifier
+		2:  {}, // Server Identifier
+		3:  {}, // IA_NA
+		4:  {}, // IA_TA
+		5:  {}, // IA Address
+		6:  {}, // Option Request
+		9:  {}, // Relay Message
+		13: {}, // Status Code
+		14: {}, // Rapid Commit
+		25: {}, // IA_PD
+		26: {}, // IA Prefix
+	}
+)
+
+// Family returns the family of the server which passes the option.
+func Family(option v1.DHCPExtraOption) v1.DHCPOptionFamily {
+	if option.Family == "" {
+		return v1.DHCPOptionFamilyIPv4
+	}
+	return option.Family
+}
+
+// FilterByFamily returns the extra options of the given family.
+func FilterByFamily(dhcpOptions *v1.DHCPOptions, family v1.DHCPOptionFamily) []v1.DHCPExtraOption {
+	if dhcpOptions == nil {
+		return nil
+	}
+	var filtered []v1.DHCPExtraOption
+	for _, option := range dhcpOptions.ExtraOptions {
+		if Family(option) == family {
+			filtered = append(filtered, option)
+		}
+	}
+	return filtered
+}
+
+// IsManagedByServer reports whether the option code is reserved to the server of the given family.
+func IsManagedByServer(family v1.DHCPOptionFamily, code int32) bool {
+	managedCodes := managedIPv4Codes
+	if family == v1.DHCPOptionFamilyIPv6 {
+		managedCodes = managedIPv6Codes
+	}
+	_, managed := managedCodes[code]
+	return managed
+}
+
+// Encode returns the option value as passed by the server.
+func Encode(option v1.DHCPExtraOption) ([]byte, error) {
+	switch option.Type {
+	case "", v1.DHCPOptionTypeString:
+		return []byte(option.Value), nil
+	case v1.DHCPOptionTypeUint8:
+		return encodeUint(option.Value, 8)
+	case v1.DHCPOptionTypeUint16:
+		return encodeUint(option.Value, 16)
+	case v1.DHCPOptionTypeUint32:
+		return encodeUint(option.Value, 32)
+	case v1.DHCPOptionTypeIPAddresses:
+		return encodeIPAddresses(option.Value, Family(option))
+	case v1.DHCPOptionTypeHex:
+		value, err := hex.DecodeString(option.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a hexadecimal string", option.Value)
+		}
+		return value, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, option.Type)
+}
+
+func encodeUint(value string, bitSize int) ([]byte, error) {
+	number, err := strconv.ParseUint(value, 10, bitSize)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a %d bits unsigned integer", value, bitSize)
+	}
+	encoded := binary.BigEndian.AppendUint64(nil, number)
+	return encoded[len(encoded)-bitSize/8:], nil
+}
+
+func encodeIPAddresses(value string, family v1.DHCPOptionFamily) ([]byte, error) {
+	var encoded []byte
+	for _, address := range strings.Split(value, ",") {
+		ip := net.ParseIP(strings.TrimSpace(address))
+		switch {
+		case ip == nil:
+			return nil, fmt.Errorf("%q is not an IP address", address)
+		case family == v1.DHCPOptionFamilyIPv4 && ip.To4() != nil:
+			encoded = append(encoded, ip.To4()...)
+		case family == v1.DHCPOptionFamilyIPv6 && ip.To4() == nil:
+			encoded = append(encoded, ip.To16()...)
+		default:
+			return nil, fmt.Errorf("%q is not an %s address", address, family)
+		}
+	}
+	return encoded, nil
+}
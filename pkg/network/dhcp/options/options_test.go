@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package options_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/dhcp/options"
+)
+
+var _ = Describe("DHCP extra options", func() {
+	DescribeTable("should encode", func(option v1.DHCPExtraOption, expected []byte) {
+		Expect(options.Encode(option)).To(Equal(expected))
+	},
+		Entry("a string by default", v1.DHCPExtraOption{Code: 66, Value: "tftp.example.com"}, []byte("tftp.example.com")),
+		Entry("an uint8", v1.DHCPExtraOption{Code: 19, Type: v1.DHCPOptionTypeUint8, Value: "1"}, []byte{1}),
+		Entry("an uint16", v1.DHCPExtraOption{Code: 26, Type: v1.DHCPOptionTypeUint16, Value: "1400"}, []byte{0x05, 0x78}),
+		Entry("an uint32", v1.DHCPExtraOption{Code: 2, Type: v1.DHCPOptionTypeUint32, Value: "3600"}, []byte{0, 0, 0x0e, 0x10}),
+		Entry("IPv4 addresses", v1.DHCPExtraOption{Code: 42, Type: v1.DHCPOptionTypeIPAddresses, Value: "10.0.0.1, 10.0.0.2"},
+			[]byte{10, 0, 0, 1, 10, 0, 0, 2}),
+		Entry("IPv6 addresses",
+			v1.DHCPExtraOption{Family: v1.DHCPOptionFamilyIPv6, Code: 56, Type: v1.DHCPOptionTypeIPAddresses, Value: "fd00::1"},
+			[]byte{0xfd, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}),
+		Entry("a hexadecimal string", v1.DHCPExtraOption{Code: 43, Type: v1.DHCPOptionTypeHex, Value: "01020aff"},
+			[]byte{1, 2, 10, 255}),
+	)
+
+	DescribeTable("should fail to encode", func(option v1.DHCPExtraOption) {
+		_, err := options.Encode(option)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("an out of range uint8", v1.DHCPExtraOption{Code: 19, Type: v1.DHCPOptionTypeUint8, Value: "256"}),
+		Entry("a negative uint16", v1.DHCPExtraOption{Code: 26, Type: v1.DHCPOptionTypeUint16, Value: "-1"}),
+		Entry("an invalid IP address", v1.DHCPExtraOption{Code: 42, Type: v1.DHCPOptionTypeIPAddresses, Value: "10.0.0"}),
+		Entry("an IPv6 address for the IPv4 family", v1.DHCPExtraOption{Code: 42, Type: v1.DHCPOptionTypeIPAddresses, Value: "fd00::1"}),
+		Entry("an IPv4 address for the IPv6 family",
+			v1.DHCPExtraOption{Family: v1.DHCPOptionFamilyIPv6, Code: 56, Type: v1.DHCPOptionTypeIPAddresses, Value: "10.0.0.1"}),
+		Entry("an invalid hexadecimal string", v1.DHCPExtraOption{Code: 43, Type: v1.DHCPOptionTypeHex, Value: "0g"}),
+		Entry("an unknown type", v1.DHCPExtraOption{Code: 43, Type: "Bool", Value: "true"}),
+	)
+
+	It("should filter the options by family", func() {
+		ipv4Option := v1.DHCPExtraOption{Code: 66, Value: "tftp.example.com"}
+		ipv6Option := v1.DHCPExtraOption{Family: v1.DHCPOptionFamilyIPv6, Code: 59, Value: "tftp://tftp.example.com/boot.efi"}
+		dhcpOptions := &v1.DHCPOptions{ExtraOptions: []v1.DHCPExtraOption{ipv4Option, ipv6Option}}
+
+		Expect(options.FilterByFamily(dhcpOptions, v1.DHCPOptionFamilyIPv4)).To(ConsistOf(ipv4Option))
+		Expect(options.FilterByFamily(dhcpOptions, v1.DHCPOptionFamilyIPv6)).To(ConsistOf(ipv6Option))
+		Expect(options.FilterByFamily(nil, v1.DHCPOptionFamilyIPv6)).To(BeEmpty())
+	})
+
+	It("should report the options managed by the server", func() {
+		Expect(options.IsManagedByServer(v1.DHCPOptionFamilyIPv4, 54)).To(BeTrue())
+		Expect(options.IsManagedByServer(v1.DHCPOptionFamilyIPv4, 42)).To(BeFalse())
+		Expect(options.IsManagedByServer(v1.DHCPOptionFamilyIPv6, 2)).To(BeTrue())
+		Expect(options.IsManagedByServer(v1.DHCPOptionFamilyIPv6, 54)).To(BeFalse())
+	})
+})
@@ -35,6 +35,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	dhcpoptions "kubevirt.io/kubevirt/pkg/network/dhcp/options"
 	"kubevirt.io/kubevirt/pkg/network/dns"
 )
 
@@ -181,6 +182,15 @@ func prepareDHCPOptions(
 				}
 			}
 		}
+
+		for _, extraOption := range dhcpoptions.FilterByFamily(customDHCPOptions, v1.DHCPOptionFamilyIPv4) {
+			value, err := dhcpoptions.Encode(extraOption)
+			if err != nil {
+				return nil, fmt.Errorf("invalid dhcp option %d: %v", extraOption.Code, err)
+			}
+			log.Log.Infof("Setting dhcp option %d to %v", extraOption.Code, value)
+			dhcpOptions[dhcp.OptionCode(byte(extraOption.Code))] = value
+		}
 	}
 
 	return dhcpOptions, nil
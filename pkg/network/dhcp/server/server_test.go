@@ -212,6 +212,34 @@ var _ = Describe("DHCP Server", func() {
 			Expect(options[240]).To(Equal([]byte("private.options.kubevirt.io")))
 		})
 
+		It("should contain extra options, which replace the default options", func() {
+			ip := net.ParseIP("192.168.2.1")
+			dhcpOptions := &v1.DHCPOptions{
+				ExtraOptions: []v1.DHCPExtraOption{
+					{Code: 26, Type: v1.DHCPOptionTypeUint16, Value: "1400"},
+					{Code: 150, Type: v1.DHCPOptionTypeIPAddresses, Value: "192.168.2.2"},
+					{Family: v1.DHCPOptionFamilyIPv6, Code: 59, Value: "tftp://[fd00::1]/boot.efi"},
+				},
+			}
+
+			options, err := prepareDHCPOptions(ip.DefaultMask(), ip, nil, nil, nil, 1500, "myhost", dhcpOptions)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options[dhcp4.OptionInterfaceMTU]).To(Equal([]byte{0x05, 0x78}))
+			Expect(options[150]).To(Equal([]byte{192, 168, 2, 2}))
+			Expect(options).ToNot(HaveKey(dhcp4.OptionCode(59)))
+		})
+
+		It("should fail with an extra option value not matching its type", func() {
+			ip := net.ParseIP("192.168.2.1")
+			dhcpOptions := &v1.DHCPOptions{
+				ExtraOptions: []v1.DHCPExtraOption{{Code: 26, Type: v1.DHCPOptionTypeUint16, Value: "mtu"}},
+			}
+
+			_, err := prepareDHCPOptions(ip.DefaultMask(), ip, nil, nil, nil, 1500, "myhost", dhcpOptions)
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("expects the gateway as an IPv4 addresses", func() {
 			gw := net.ParseIP("192.168.2.1")
 			options, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, nil, nil, 1500, "myhost", nil)
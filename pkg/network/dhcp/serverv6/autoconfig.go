@@ -0,0 +1,233 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package serverv6
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	unsolicitedRAInterval = 3 * time.Minute
+	maxFrameSize          = 65535
+)
+
+// AutoConfig describes the IPv6 auto-configuration offered to a single guest interface.
+type AutoConfig struct {
+	// ClientIP is the address leased to the guest using DHCPv6.
+	ClientIP net.IP
+	// Prefix is the on-link prefix advertised to the guest.
+	Prefix *net.IPNet
+	// Managed directs the guest to acquire its address using DHCPv6 instead of SLAAC.
+	Managed bool
+	// DelegatedPrefix is delegated to the guest using DHCPv6 prefix delegation.
+	DelegatedPrefix *net.IPNet
+	MTU             uint16
+	Nameservers     [][]byte
+	// DHCPOptions are the custom options of the guest interface, of which the IPv6 extra options are served using DHCPv6.
+	DHCPOptions *v1.DHCPOptions
+}
+
+type autoConfigResponder struct {
+	config      AutoConfig
+	mac         net.HardwareAddr
+	linkLocalIP net.IP
+	dhcpv6      *DHCPv6Handler
+}
+
+// SingleClientIPv6AutoConfigResponder sends router advertisements and, in managed mode, serves DHCPv6 requests
+// on the given tap device.
+// The tap device is expected to be connected to the guest interface bridge, where it is the only peer of the guest.
+// Frames are crafted by the responder, which therefore requires no privileges other than owning the tap device.
+func SingleClientIPv6AutoConfigResponder(tapName string, config AutoConfig) error {
+	log.Log.Infof("Starting SingleClientIPv6AutoConfigResponder on %s", tapName)
+
+	tap, err := openTap(tapName)
+	if err != nil {
+		return fmt.Errorf("couldn't create IPv6 auto-configuration responder: %v", err)
+	}
+	defer tap.Close()
+
+	mac, err := newResponderMAC()
+	if err != nil {
+		return fmt.Errorf("couldn't create IPv6 auto-configuration responder: %v", err)
+	}
+	responder, err := newAutoConfigResponder(config, mac)
+	if err != nil {
+		return fmt.Errorf("couldn't create IPv6 auto-configuration responder: %v", err)
+	}
+
+	go responder.advertise(tap)
+
+	buf := make([]byte, maxFrameSize)
+	for {
+		n, err := tap.Read(buf)
+		if err != nil {
+			return fmt.Errorf("failed to run IPv6 auto-configuration responder: %v", err)
+		}
+		if reply := responder.respond(buf[:n]); reply != nil {
+			if _, err := tap.Write(reply); err != nil {
+				log.Log.Reason(err).Error("IPv6 auto-configuration responder failed sending a reply to the client")
+			}
+		}
+	}
+}
+
+func newAutoConfigResponder(config AutoConfig, mac net.HardwareAddr) (*autoConfigResponder, error) {
+	responder := &autoConfigResponder{
+		config:      config,
+		mac:         mac,
+		linkLocalIP: linkLocalAddress(mac),
+	}
+	if config.Managed {
+		extraModifiers, err := prepareExtraDHCPv6Modifiers(config.DHCPOptions)
+		if err != nil {
+			return nil, err
+		}
+		responder.dhcpv6 = &DHCPv6Handler{
+			clientIP:        config.ClientIP,
+			modifiers:       append(prepareDHCPv6Modifiers(config.ClientIP, mac, config.Nameservers), extraModifiers...),
+			delegatedPrefix: config.DelegatedPrefix,
+		}
+	}
+	return responder, nil
+}
+
+func (r *autoConfigResponder) advertise(tap *os.File) {
+	ticker := time.NewTicker(unsolicitedRAInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := tap.Write(r.routerAdvertisementFrame()); err != nil {
+			log.Log.Reason(err).Error("IPv6 auto-configuration responder failed sending a router advertisement")
+		}
+		<-ticker.C
+	}
+}
+
+func (r *autoConfigResponder) routerAdvertisementFrame() []byte {
+	return newICMPv6Frame(
+		r.mac,
+		multicastMAC(allNodesMulticast),
+		r.linkLocalIP,
+		allNodesMulticast,
+		ndpHopLimit,
+		newRouterAdvertisement(r.config, r.mac),
+	)
+}
+
+// respond returns the frame answering the given frame, or nil when it does not require an answer.
+func (r *autoConfigResponder) respond(frame []byte) []byte {
+	packet, err := parseIPv6Frame(frame)
+	if err != nil {
+		return nil
+	}
+
+	switch packet.nextHeader {
+	case protocolICMPv6:
+		if isRouterSolicitation(packet) {
+			log.Log.V(4).Info("IPv6 auto-configuration responder received a router solicitation")
+			return r.routerAdvertisementFrame()
+		}
+	case protocolUDP:
+		if r.dhcpv6 != nil && isDHCPv6Request(packet) {
+			return r.respondDHCPv6(packet)
+		}
+	}
+	return nil
+}
+
+func (r *autoConfigResponder) respondDHCPv6(packet *ipv6Packet) []byte {
+	msg, err := dhcpv6.FromBytes(packet.payload[udpHeaderLen:])
+	if err != nil {
+		log.Log.Reason(err).V(4).Info("IPv6 auto-configuration responder received a malformed DHCPv6 request")
+		return nil
+	}
+	if _, isMessage := msg.(*dhcpv6.Message); !isMessage {
+		return nil
+	}
+
+	response, err := r.dhcpv6.buildResponse(msg)
+	if err != nil {
+		log.Log.Reason(err).Error("DHCPv6 failed building a response to the client")
+		return nil
+	}
+
+	return newUDPFrame(
+		r.mac,
+		packet.srcMAC,
+		r.linkLocalIP,
+		packet.src,
+		dhcpv6.DefaultServerPort,
+		dhcpv6.DefaultClientPort,
+		response.ToBytes(),
+	)
+}
+
+func isRouterSolicitation(packet *ipv6Packet) bool {
+	return packet.hopLimit == ndpHopLimit &&
+		len(packet.payload) >= 8 &&
+		packet.payload[0] == icmpv6TypeRouterSolicitation
+}
+
+func isDHCPv6Request(packet *ipv6Packet) bool {
+	return len(packet.payload) > udpHeaderLen &&
+		binary.BigEndian.Uint16(packet.payload[2:4]) == dhcpv6.DefaultServerPort
+}
+
+func openTap(name string) (*os.File, error) {
+	fd, err := unix.Open("/dev/net/tun", unix.O_RDWR|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the tun device: %v", err)
+	}
+
+	ifreq, err := unix.NewIfreq(name)
+	if err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	ifreq.SetUint16(unix.IFF_TAP | unix.IFF_NO_PI)
+	if err := unix.IoctlIfreq(fd, unix.TUNSETIFF, ifreq); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to attach to tap device %s: %v", name, err)
+	}
+
+	return os.NewFile(uintptr(fd), name), nil
+}
+
+// newResponderMAC returns a random locally administered unicast address.
+// The tap device address cannot be used, as the bridge delivers frames sent to its ports addresses to the host.
+func newResponderMAC() (net.HardwareAddr, error) {
+	mac := make(net.HardwareAddr, 6)
+	if _, err := rand.Read(mac); err != nil {
+		return nil, err
+	}
+	mac[0] = (mac[0] | 0x02) &^ 0x01
+	return mac, nil
+}
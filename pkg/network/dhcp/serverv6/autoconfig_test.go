@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package serverv6
+
+import (
+	"encoding/binary"
+	"net"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IPv6 auto-configuration responder", func() {
+	var (
+		responderMAC net.HardwareAddr
+		clientMAC    net.HardwareAddr
+		clientLLA    net.IP
+		config       AutoConfig
+	)
+
+	BeforeEach(func() {
+		responderMAC, _ = net.ParseMAC("02:00:00:00:00:01")
+		clientMAC, _ = net.ParseMAC("34:56:78:9a:bc:de")
+		clientLLA = linkLocalAddress(clientMAC)
+		_, prefix, err := net.ParseCIDR("fd20:10::/64")
+		Expect(err).ToNot(HaveOccurred())
+		config = AutoConfig{
+			ClientIP:    net.ParseIP("fd20:10::5"),
+			Prefix:      prefix,
+			Managed:     true,
+			MTU:         1450,
+			Nameservers: [][]byte{net.ParseIP("fd20:10::53").To16()},
+		}
+	})
+
+	It("should derive the link-local address from the MAC address", func() {
+		Expect(linkLocalAddress(clientMAC).String()).To(Equal("fe80::3656:78ff:fe9a:bcde"))
+	})
+
+	It("should map multicast addresses to ethernet addresses", func() {
+		Expect(multicastMAC(net.ParseIP("ff02::1:2")).String()).To(Equal("33:33:00:01:00:02"))
+	})
+
+	DescribeTable("router advertisement", func(managed bool, expectedFlags, expectedPrefixFlags byte) {
+		config.Managed = managed
+		msg := newRouterAdvertisement(config, responderMAC)
+
+		Expect(msg[0]).To(Equal(byte(icmpv6TypeRouterAdvertisement)))
+		Expect(msg[5]).To(Equal(expectedFlags))
+		Expect(binary.BigEndian.Uint16(msg[6:8])).To(BeZero(), "router lifetime")
+
+		options := ndpOptions(msg[16:])
+		Expect(options).To(HaveKeyWithValue(byte(ndpOptSourceLinkLayerAddr), []byte(responderMAC)))
+		Expect(options).To(HaveKey(byte(ndpOptMTU)))
+		Expect(binary.BigEndian.Uint32(options[ndpOptMTU][2:6])).To(Equal(uint32(1450)))
+
+		Expect(options).To(HaveKey(byte(ndpOptPrefixInformation)))
+		prefixInfo := options[ndpOptPrefixInformation]
+		Expect(prefixInfo[0]).To(Equal(byte(64)))
+		Expect(prefixInfo[1]).To(Equal(expectedPrefixFlags))
+		Expect(net.IP(prefixInfo[14:30]).String()).To(Equal("fd20:10::"))
+
+		Expect(options).To(HaveKey(byte(ndpOptRecursiveDNSServer)))
+		Expect(net.IP(options[ndpOptRecursiveDNSServer][6:22]).String()).To(Equal("fd20:10::53"))
+	},
+		Entry("in DHCPv6 mode sets the managed and other flags", true, byte(raFlagManaged|raFlagOther), byte(prefixFlagOnLink)),
+		Entry("in SLAAC mode sets the autonomous prefix flag", false, byte(0), byte(prefixFlagOnLink|prefixFlagAutonomous)),
+	)
+
+	It("should not include the RDNSS option without nameservers", func() {
+		config.Nameservers = nil
+		Expect(ndpOptions(newRouterAdvertisement(config, responderMAC)[16:])).ToNot(HaveKey(byte(ndpOptRecursiveDNSServer)))
+	})
+
+	It("should answer a router solicitation with a router advertisement", func() {
+		responder, err := newAutoConfigResponder(config, responderMAC)
+		Expect(err).ToNot(HaveOccurred())
+		rs := newICMPv6Frame(clientMAC, multicastMAC(net.ParseIP("ff02::2")), clientLLA, net.ParseIP("ff02::2"),
+			ndpHopLimit, []byte{icmpv6TypeRouterSolicitation, 0, 0, 0, 0, 0, 0, 0})
+
+		reply, err := parseIPv6Frame(responder.respond(rs))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reply.srcMAC).To(Equal(responderMAC))
+		Expect(reply.dstMAC.String()).To(Equal("33:33:00:00:00:01"))
+		Expect(reply.src).To(Equal(linkLocalAddress(responderMAC)))
+		Expect(reply.dst.String()).To(Equal("ff02::1"))
+		Expect(reply.hopLimit).To(Equal(uint8(ndpHopLimit)))
+		Expect(reply.nextHeader).To(Equal(uint8(protocolICMPv6)))
+		Expect(reply.payload[0]).To(Equal(byte(icmpv6TypeRouterAdvertisement)))
+		Expect(checksum(reply.src, reply.dst, protocolICMPv6, reply.payload)).To(BeZero())
+	})
+
+	It("should ignore a router solicitation not sent with the neighbor discovery hop limit", func() {
+		responder, err := newAutoConfigResponder(config, responderMAC)
+		Expect(err).ToNot(HaveOccurred())
+		rs := newICMPv6Frame(clientMAC, multicastMAC(net.ParseIP("ff02::2")), clientLLA, net.ParseIP("ff02::2"),
+			64, []byte{icmpv6TypeRouterSolicitation, 0, 0, 0, 0, 0, 0, 0})
+		Expect(responder.respond(rs)).To(BeNil())
+	})
+
+	It("should reply to a DHCPv6 request with the address and the delegated prefix", func() {
+		_, delegatedPrefix, err := net.ParseCIDR("fd20:1:2::/56")
+		Expect(err).ToNot(HaveOccurred())
+		config.DelegatedPrefix = delegatedPrefix
+		responder, err := newAutoConfigResponder(config, responderMAC)
+		Expect(err).ToNot(HaveOccurred())
+
+		request, err := newMessage(dhcpv6.MessageTypeRequest)
+		Expect(err).ToNot(HaveOccurred())
+		request.UpdateOption(&dhcpv6.OptIAPD{IaId: [4]byte{9, 9, 9, 9}})
+
+		reply, err := parseIPv6Frame(responder.respond(newDHCPv6RequestFrame(clientMAC, clientLLA, request)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reply.dstMAC).To(Equal(clientMAC))
+		Expect(reply.dst).To(Equal(clientLLA))
+		Expect(reply.nextHeader).To(Equal(uint8(protocolUDP)))
+		Expect(checksum(reply.src, reply.dst, protocolUDP, reply.payload)).To(BeZero())
+		Expect(binary.BigEndian.Uint16(reply.payload[0:2])).To(Equal(uint16(dhcpv6.DefaultServerPort)))
+		Expect(binary.BigEndian.Uint16(reply.payload[2:4])).To(Equal(uint16(dhcpv6.DefaultClientPort)))
+
+		msg, err := dhcpv6.MessageFromBytes(reply.payload[udpHeaderLen:])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg.Type()).To(Equal(dhcpv6.MessageTypeReply))
+		Expect(msg.Options.OneIANA().Options.OneAddress().IPv6Addr.String()).To(Equal("fd20:10::5"))
+		Expect(msg.Options.OneIAPD().Options.Prefixes()[0].Prefix.String()).To(Equal("fd20:1:2::/56"))
+		Expect(msg.Options.DNS()).To(HaveLen(1))
+		Expect(msg.Options.DNS()[0].String()).To(Equal("fd20:10::53"))
+	})
+
+	It("should ignore DHCPv6 requests in SLAAC mode", func() {
+		config.Managed = false
+		responder, err := newAutoConfigResponder(config, responderMAC)
+		Expect(err).ToNot(HaveOccurred())
+
+		request, err := newMessage(dhcpv6.MessageTypeSolicit)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(responder.respond(newDHCPv6RequestFrame(clientMAC, clientLLA, request))).To(BeNil())
+	})
+
+	It("should ignore frames which are not IPv6", func() {
+		responder, err := newAutoConfigResponder(config, responderMAC)
+		Expect(err).ToNot(HaveOccurred())
+		frame := make([]byte, 64)
+		binary.BigEndian.PutUint16(frame[12:14], 0x0800)
+		Expect(responder.respond(frame)).To(BeNil())
+	})
+})
+
+func newDHCPv6RequestFrame(clientMAC net.HardwareAddr, clientIP net.IP, msg *dhcpv6.Message) []byte {
+	allDHCPServers := net.ParseIP("ff02::1:2")
+	return newUDPFrame(clientMAC, multicastMAC(allDHCPServers), clientIP, allDHCPServers,
+		dhcpv6.DefaultClientPort, dhcpv6.DefaultServerPort, msg.ToBytes())
+}
+
+func ndpOptions(data []byte) map[byte][]byte {
+	options := map[byte][]byte{}
+	for len(data) >= 8 && data[1] > 0 {
+		optLen := int(data[1]) * 8
+		options[data[0]] = data[2:optLen]
+		data = data[optLen:]
+	}
+	return options
+}
@@ -0,0 +1,163 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package serverv6
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+const (
+	etherTypeIPv6     = 0x86dd
+	ethernetHeaderLen = 14
+	ipv6HeaderLen     = 40
+	udpHeaderLen      = 8
+
+	protocolICMPv6 = 58
+	protocolUDP    = 17
+)
+
+// ipv6Packet is an IPv6 packet carried by an ethernet frame.
+// Extension headers are not supported.
+type ipv6Packet struct {
+	srcMAC     net.HardwareAddr
+	dstMAC     net.HardwareAddr
+	src        net.IP
+	dst        net.IP
+	nextHeader uint8
+	hopLimit   uint8
+	payload    []byte
+}
+
+func parseIPv6Frame(frame []byte) (*ipv6Packet, error) {
+	if len(frame) < ethernetHeaderLen+ipv6HeaderLen {
+		return nil, fmt.Errorf("frame is too short: %d bytes", len(frame))
+	}
+	if etherType := binary.BigEndian.Uint16(frame[12:14]); etherType != etherTypeIPv6 {
+		return nil, fmt.Errorf("frame ether type %#04x is not IPv6", etherType)
+	}
+	header := frame[ethernetHeaderLen : ethernetHeaderLen+ipv6HeaderLen]
+	payloadLen := int(binary.BigEndian.Uint16(header[4:6]))
+	if len(frame) < ethernetHeaderLen+ipv6HeaderLen+payloadLen {
+		return nil, fmt.Errorf("frame is shorter than its IPv6 payload length %d", payloadLen)
+	}
+	payloadStart := ethernetHeaderLen + ipv6HeaderLen
+	return &ipv6Packet{
+		dstMAC:     net.HardwareAddr(frame[0:6]),
+		srcMAC:     net.HardwareAddr(frame[6:12]),
+		nextHeader: header[6],
+		hopLimit:   header[7],
+		src:        net.IP(header[8:24]),
+		dst:        net.IP(header[24:40]),
+		payload:    frame[payloadStart : payloadStart+payloadLen],
+	}, nil
+}
+
+func (p ipv6Packet) marshal() []byte {
+	frame := make([]byte, ethernetHeaderLen+ipv6HeaderLen, ethernetHeaderLen+ipv6HeaderLen+len(p.payload))
+	copy(frame[0:6], p.dstMAC)
+	copy(frame[6:12], p.srcMAC)
+	binary.BigEndian.PutUint16(frame[12:14], etherTypeIPv6)
+
+	header := frame[ethernetHeaderLen:]
+	header[0] = 6 << 4
+	binary.BigEndian.PutUint16(header[4:6], uint16(len(p.payload)))
+	header[6] = p.nextHeader
+	header[7] = p.hopLimit
+	copy(header[8:24], p.src.To16())
+	copy(header[24:40], p.dst.To16())
+
+	return append(frame, p.payload...)
+}
+
+// newICMPv6Frame sets the checksum of the given ICMPv6 message and encapsulates it.
+func newICMPv6Frame(srcMAC, dstMAC net.HardwareAddr, src, dst net.IP, hopLimit uint8, msg []byte) []byte {
+	binary.BigEndian.PutUint16(msg[2:4], 0)
+	binary.BigEndian.PutUint16(msg[2:4], checksum(src, dst, protocolICMPv6, msg))
+	return ipv6Packet{
+		srcMAC:     srcMAC,
+		dstMAC:     dstMAC,
+		src:        src,
+		dst:        dst,
+		nextHeader: protocolICMPv6,
+		hopLimit:   hopLimit,
+		payload:    msg,
+	}.marshal()
+}
+
+func newUDPFrame(srcMAC, dstMAC net.HardwareAddr, src, dst net.IP, srcPort, dstPort uint16, data []byte) []byte {
+	const udpHopLimit = 64
+	datagram := make([]byte, udpHeaderLen, udpHeaderLen+len(data))
+	binary.BigEndian.PutUint16(datagram[0:2], srcPort)
+	binary.BigEndian.PutUint16(datagram[2:4], dstPort)
+	binary.BigEndian.PutUint16(datagram[4:6], uint16(udpHeaderLen+len(data)))
+	datagram = append(datagram, data...)
+	binary.BigEndian.PutUint16(datagram[6:8], checksum(src, dst, protocolUDP, datagram))
+	return ipv6Packet{
+		srcMAC:     srcMAC,
+		dstMAC:     dstMAC,
+		src:        src,
+		dst:        dst,
+		nextHeader: protocolUDP,
+		hopLimit:   udpHopLimit,
+		payload:    datagram,
+	}.marshal()
+}
+
+// checksum computes the internet checksum of an upper-layer payload, including the IPv6 pseudo-header (RFC 8200).
+func checksum(src, dst net.IP, nextHeader uint8, payload []byte) uint16 {
+	pseudoHeader := make([]byte, 0, 2*net.IPv6len+8)
+	pseudoHeader = append(pseudoHeader, src.To16()...)
+	pseudoHeader = append(pseudoHeader, dst.To16()...)
+	pseudoHeader = binary.BigEndian.AppendUint32(pseudoHeader, uint32(len(payload)))
+	pseudoHeader = append(pseudoHeader, 0, 0, 0, nextHeader)
+
+	var sum uint32
+	for _, data := range [][]byte{pseudoHeader, payload} {
+		for i := 0; i+1 < len(data); i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(data[i : i+2]))
+		}
+		if len(data)%2 == 1 {
+			sum += uint32(data[len(data)-1]) << 8
+		}
+	}
+	for sum > 0xffff {
+		sum = (sum >> 16) + (sum & 0xffff)
+	}
+	return ^uint16(sum)
+}
+
+// multicastMAC returns the ethernet address an IPv6 multicast address is mapped to (RFC 2464).
+func multicastMAC(ip net.IP) net.HardwareAddr {
+	ip16 := ip.To16()
+	return net.HardwareAddr{0x33, 0x33, ip16[12], ip16[13], ip16[14], ip16[15]}
+}
+
+// linkLocalAddress returns the modified EUI-64 based link-local address of the given ethernet address (RFC 4291).
+func linkLocalAddress(mac net.HardwareAddr) net.IP {
+	ip := make(net.IP, net.IPv6len)
+	ip[0], ip[1] = 0xfe, 0x80
+	ip[8] = mac[0] ^ 0x02
+	ip[9], ip[10] = mac[1], mac[2]
+	ip[11], ip[12] = 0xff, 0xfe
+	ip[13], ip[14], ip[15] = mac[3], mac[4], mac[5]
+	return ip
+}
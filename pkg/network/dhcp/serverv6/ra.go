@@ -0,0 +1,92 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package serverv6
+
+import (
+	"encoding/binary"
+	"math"
+	"net"
+)
+
+const (
+	icmpv6TypeRouterSolicitation  = 133
+	icmpv6TypeRouterAdvertisement = 134
+
+	// Neighbor discovery messages are only accepted with the maximal hop limit (RFC 4861).
+	ndpHopLimit = 255
+
+	raCurHopLimit = 64
+	raFlagManaged = 0x80
+	raFlagOther   = 0x40
+
+	ndpOptSourceLinkLayerAddr = 1
+	ndpOptPrefixInformation   = 3
+	ndpOptMTU                 = 5
+	ndpOptRecursiveDNSServer  = 25
+
+	prefixFlagOnLink     = 0x80
+	prefixFlagAutonomous = 0x40
+
+	infiniteLifetime = math.MaxUint32
+)
+
+var allNodesMulticast = net.ParseIP("ff02::1")
+
+// newRouterAdvertisement builds the ICMPv6 router advertisement message (RFC 4861) describing the given configuration.
+// The router lifetime is zero, the responder is not a default router.
+func newRouterAdvertisement(config AutoConfig, srcMAC net.HardwareAddr) []byte {
+	msg := make([]byte, 16)
+	msg[0] = icmpv6TypeRouterAdvertisement
+	msg[4] = raCurHopLimit
+	if config.Managed {
+		msg[5] = raFlagManaged | raFlagOther
+	}
+
+	msg = append(msg, ndpOptSourceLinkLayerAddr, 1)
+	msg = append(msg, srcMAC...)
+
+	if config.MTU > 0 {
+		msg = append(msg, ndpOptMTU, 1, 0, 0)
+		msg = binary.BigEndian.AppendUint32(msg, uint32(config.MTU))
+	}
+
+	if config.Prefix != nil {
+		prefixLen, _ := config.Prefix.Mask.Size()
+		flags := byte(prefixFlagOnLink)
+		if !config.Managed {
+			flags |= prefixFlagAutonomous
+		}
+		msg = append(msg, ndpOptPrefixInformation, 4, byte(prefixLen), flags)
+		msg = binary.BigEndian.AppendUint32(msg, infiniteLifetime)
+		msg = binary.BigEndian.AppendUint32(msg, infiniteLifetime)
+		msg = append(msg, 0, 0, 0, 0)
+		msg = append(msg, config.Prefix.IP.Mask(config.Prefix.Mask).To16()...)
+	}
+
+	if len(config.Nameservers) > 0 {
+		msg = append(msg, ndpOptRecursiveDNSServer, byte(1+2*len(config.Nameservers)), 0, 0)
+		msg = binary.BigEndian.AppendUint32(msg, infiniteLifetime)
+		for _, nameserver := range config.Nameservers {
+			msg = append(msg, net.IP(nameserver).To16()...)
+		}
+	}
+
+	return msg
+}
@@ -28,7 +28,10 @@ import (
 	"github.com/insomniacslk/dhcp/dhcpv6/server6"
 	"github.com/insomniacslk/dhcp/iana"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
+
+	dhcpoptions "kubevirt.io/kubevirt/pkg/network/dhcp/options"
 )
 
 const (
@@ -36,11 +39,20 @@ const (
 )
 
 type DHCPv6Handler struct {
-	clientIP  net.IP
-	modifiers []dhcpv6.Modifier
+	clientIP        net.IP
+	modifiers       []dhcpv6.Modifier
+	delegatedPrefix *net.IPNet
 }
 
-func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string, ipv6Nameservers [][]byte) error {
+// SingleClientDHCPv6Server serves the given client address and, when a delegated prefix is provided,
+// delegates it to the client using DHCPv6 prefix delegation.
+func SingleClientDHCPv6Server(
+	clientIP net.IP,
+	serverIfaceName string,
+	ipv6Nameservers [][]byte,
+	delegatedPrefix *net.IPNet,
+	customDHCPOptions *v1.DHCPOptions,
+) error {
 	log.Log.Info("Starting SingleClientDHCPv6Server")
 
 	iface, err := net.InterfaceByName(serverIfaceName)
@@ -49,10 +61,16 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string, ipv6Names
 	}
 
 	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr, ipv6Nameservers)
+	extraModifiers, err := prepareExtraDHCPv6Modifiers(customDHCPOptions)
+	if err != nil {
+		return fmt.Errorf("couldn't create DHCPv6 server: %v", err)
+	}
+	modifiers = append(modifiers, extraModifiers...)
 
 	handler := &DHCPv6Handler{
-		clientIP:  clientIP,
-		modifiers: modifiers,
+		clientIP:        clientIP,
+		modifiers:       modifiers,
+		delegatedPrefix: delegatedPrefix,
 	}
 
 	conn, err := NewConnection(iface)
@@ -76,7 +94,7 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string, ipv6Names
 func (h *DHCPv6Handler) ServeDHCPv6(conn net.PacketConn, peer net.Addr, m dhcpv6.DHCPv6) {
 	log.Log.V(4).Info("DHCPv6 serving a new request")
 
-	// TODO if we extend the server to support bridge binding, we need to filter out non-vm requests
+	// With bridge binding, requests which are not sent by the VM are dropped by virt-handler before reaching the server.
 
 	response, err := h.buildResponse(m)
 	if err != nil {
@@ -118,6 +136,15 @@ func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error
 		ianaResponse.IaId = ianaRequest.IaId
 		response.UpdateOption(ianaResponse)
 	}
+
+	if iapdRequest := dhcpv6Msg.Options.OneIAPD(); iapdRequest != nil && h.delegatedPrefix != nil {
+		optIAPrefix := &dhcpv6.OptIAPrefix{
+			Prefix:            h.delegatedPrefix,
+			PreferredLifetime: infiniteLease,
+			ValidLifetime:     infiniteLease,
+		}
+		dhcpv6.WithIAPD(iapdRequest.IaId, optIAPrefix)(response)
+	}
 	return response, nil
 }
 
@@ -137,3 +164,21 @@ func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr
 
 	return modifiers
 }
+
+// prepareExtraDHCPv6Modifiers returns the modifiers setting the IPv6 extra options,
+// which replace the options set by the preceding modifiers.
+func prepareExtraDHCPv6Modifiers(customDHCPOptions *v1.DHCPOptions) ([]dhcpv6.Modifier, error) {
+	var modifiers []dhcpv6.Modifier
+	for _, extraOption := range dhcpoptions.FilterByFamily(customDHCPOptions, v1.DHCPOptionFamilyIPv6) {
+		value, err := dhcpoptions.Encode(extraOption)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DHCPv6 option %d: %v", extraOption.Code, err)
+		}
+		log.Log.Infof("Setting DHCPv6 option %d to %v", extraOption.Code, value)
+		modifiers = append(modifiers, dhcpv6.WithOption(&dhcpv6.OptionGeneric{
+			OptionCode: dhcpv6.OptionCode(extraOption.Code),
+			OptionData: value,
+		}))
+	}
+	return modifiers, nil
+}
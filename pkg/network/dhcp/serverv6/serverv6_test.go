@@ -27,6 +27,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
 )
 
 var _ = Describe("DHCPv6", func() {
@@ -79,6 +81,40 @@ var _ = Describe("DHCPv6", func() {
 			Expect(dnsString).To(ContainSubstring("2001:4860:4860::8844"))
 		})
 	})
+	Context("prepareExtraDHCPv6Modifiers", func() {
+		It("should set the IPv6 extra options, replacing the preceding options", func() {
+			clientIP := net.ParseIP("fd10:0:2::2")
+			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+			ipv6Nameservers := [][]byte{net.ParseIP("2001:4860:4860::8888").To16()}
+			dhcpOptions := &v1.DHCPOptions{
+				ExtraOptions: []v1.DHCPExtraOption{
+					{Family: v1.DHCPOptionFamilyIPv6, Code: 23, Type: v1.DHCPOptionTypeIPAddresses, Value: "fd00::53"},
+					{Family: v1.DHCPOptionFamilyIPv6, Code: 59, Value: "tftp://[fd00::1]/boot.efi"},
+					{Code: 66, Value: "tftp.kubevirt.io"},
+				},
+			}
+			extraModifiers, err := prepareExtraDHCPv6Modifiers(dhcpOptions)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(extraModifiers).To(HaveLen(2))
+
+			msg := &dhcpv6.Message{MessageType: dhcpv6.MessageTypeReply}
+			for _, modifier := range append(prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, ipv6Nameservers), extraModifiers...) {
+				modifier(msg)
+			}
+
+			Expect(msg.Options.Get(dhcpv6.OptionDNSRecursiveNameServer)).To(HaveLen(1))
+			Expect(msg.Options.GetOne(dhcpv6.OptionDNSRecursiveNameServer).ToBytes()).To(Equal([]byte(net.ParseIP("fd00::53"))))
+			Expect(msg.Options.GetOne(dhcpv6.OptionBootfileURL).ToBytes()).To(Equal([]byte("tftp://[fd00::1]/boot.efi")))
+		})
+
+		It("should fail with an option value not matching its type", func() {
+			dhcpOptions := &v1.DHCPOptions{
+				ExtraOptions: []v1.DHCPExtraOption{{Family: v1.DHCPOptionFamilyIPv6, Code: 23, Type: v1.DHCPOptionTypeIPAddresses, Value: "10.0.0.53"}},
+			}
+			_, err := prepareExtraDHCPv6Modifiers(dhcpOptions)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 	Context("buildResponse should build a response with", func() {
 		var handler *DHCPv6Handler
 
@@ -137,6 +173,32 @@ var _ = Describe("DHCPv6", func() {
 			expectedLength := len(handler.modifiers) + 1
 			Expect(replyMessage.Options.Options).To(HaveLen(expectedLength))
 		})
+		It("no iapd option when no prefix is delegated", func() {
+			clientMessage, err := newMessage(dhcpv6.MessageTypeSolicit)
+			Expect(err).ToNot(HaveOccurred())
+			clientMessage.UpdateOption(&dhcpv6.OptIAPD{IaId: [4]byte{9, 9, 9, 9}})
+
+			replyMessage, err := handler.buildResponse(clientMessage)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(replyMessage.Options.OneIAPD()).To(BeNil())
+		})
+		It("iapd option containing the delegated prefix and the iaid from the request", func() {
+			_, delegatedPrefix, err := net.ParseCIDR("fd20:1:2::/56")
+			Expect(err).ToNot(HaveOccurred())
+			handler.delegatedPrefix = delegatedPrefix
+
+			clientMessage, err := newMessage(dhcpv6.MessageTypeRequest)
+			Expect(err).ToNot(HaveOccurred())
+			clientMessage.UpdateOption(&dhcpv6.OptIAPD{IaId: [4]byte{9, 9, 9, 9}})
+
+			replyMessage, err := handler.buildResponse(clientMessage)
+			Expect(err).ToNot(HaveOccurred())
+			iapd := replyMessage.Options.OneIAPD()
+			Expect(iapd).ToNot(BeNil())
+			Expect(iapd.IaId).To(Equal([4]byte{9, 9, 9, 9}))
+			Expect(iapd.Options.Prefixes()).To(HaveLen(1))
+			Expect(iapd.Options.Prefixes()[0].Prefix).To(Equal(delegatedPrefix))
+		})
 		It("handle request without iana option", func() {
 			clientMac, _ := net.ParseMAC("34:56:78:9A:BC:DE")
 			duid := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: clientMac}
@@ -21,12 +21,20 @@ package downwardapi
 
 import (
 	"cmp"
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
 	"slices"
+	"time"
 
 	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
 	"kubevirt.io/client-go/log"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
 )
 
 const (
@@ -57,6 +65,7 @@ func generateNetworkInfo(networkStatusesByNetworkName map[string]networkv1.Netwo
 				Network:    networkName,
 				DeviceInfo: networkStatus.DeviceInfo,
 				Mac:        networkStatus.Mac,
+				IPs:        networkStatus.IPs,
 			},
 		)
 	}
@@ -68,3 +77,28 @@ func generateNetworkInfo(networkStatusesByNetworkName map[string]networkv1.Netwo
 
 	return NetworkInfo{Interfaces: downwardAPIInterfaces}
 }
+
+// ReadNetworkInfo polls the network-info file at the given path until it is populated and parses it.
+// A nil network-info is returned when the file does not exist (i.e. the network-info volume is not mounted).
+func ReadNetworkInfo(path string) (*NetworkInfo, error) {
+	var networkInfoBytes []byte
+	err := virtwait.PollImmediately(100*time.Millisecond, time.Second, func(_ context.Context) (bool, error) {
+		var err error
+		networkInfoBytes, err = os.ReadFile(path)
+		return len(networkInfoBytes) > 0, err
+	})
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, nil
+	case errors.Is(err, context.DeadlineExceeded):
+		return nil, fmt.Errorf("%w: file is not populated with network-info", err)
+	case err != nil:
+		return nil, err
+	}
+
+	var networkInfo NetworkInfo
+	if err := json.Unmarshal(networkInfoBytes, &networkInfo); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal network-info: %w", err)
+	}
+	return &networkInfo, nil
+}
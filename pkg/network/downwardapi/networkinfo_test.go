@@ -21,6 +21,8 @@ package downwardapi_test
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -92,4 +94,34 @@ var _ = Describe("Network info", func() {
 
 		Expect(actualNetworkInfo).To(Equal(expectedNetworkInfo))
 	})
+
+	Context("ReadNetworkInfo", func() {
+		It("should read the network info from a populated file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), downwardapi.NetworkInfoVolumePath)
+			Expect(os.WriteFile(path, []byte(`{"interfaces":[{"network":"foo","ips":["fd20::5"]}]}`), 0o600)).To(Succeed())
+
+			networkInfo, err := downwardapi.ReadNetworkInfo(path)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(networkInfo).To(Equal(&downwardapi.NetworkInfo{
+				Interfaces: []downwardapi.Interface{{Network: "foo", IPs: []string{"fd20::5"}}},
+			}))
+		})
+
+		It("should return no network info when the file does not exist", func() {
+			networkInfo, err := downwardapi.ReadNetworkInfo(filepath.Join(GinkgoT().TempDir(), downwardapi.NetworkInfoVolumePath))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(networkInfo).To(BeNil())
+		})
+
+		It("should fail when the file is not populated", func() {
+			path := filepath.Join(GinkgoT().TempDir(), downwardapi.NetworkInfoVolumePath)
+			Expect(os.WriteFile(path, nil, 0o600)).To(Succeed())
+
+			_, err := downwardapi.ReadNetworkInfo(path)
+
+			Expect(err).To(MatchError(ContainSubstring("file is not populated with network-info")))
+		})
+	})
 })
@@ -23,6 +23,7 @@ package driver
 
 import (
 	"fmt"
+	"net"
 	"os"
 
 	"github.com/vishvananda/netlink"
@@ -193,12 +194,30 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 		}()
 	}
 
-	if nic.IPv6.IPNet != nil {
+	if nic.IPv6AutoConfigMode != "" {
+		autoConfig := dhcpserverv6.AutoConfig{
+			ClientIP:        nic.IPv6.IP,
+			Prefix:          &net.IPNet{IP: nic.IPv6.IP.Mask(nic.IPv6.Mask), Mask: nic.IPv6.Mask},
+			Managed:         nic.IPv6AutoConfigMode == v1.IPv6AutoConfigModeDHCPv6,
+			DelegatedPrefix: nic.IPv6DelegatedPrefix,
+			MTU:             nic.Mtu,
+			Nameservers:     nameservers.IPv6,
+			DHCPOptions:     dhcpOptions,
+		}
+		go func() {
+			if err = IPv6AutoConfigResponder(nic.IPv6AutoConfigTap, autoConfig); err != nil {
+				log.Log.Reason(err).Error("failed to run IPv6 auto-configuration responder")
+				panic(err)
+			}
+		}()
+	} else if nic.IPv6.IPNet != nil {
 		go func() {
 			if err = DHCPv6Server(
 				nic.IPv6.IP,
 				bridgeInterfaceName,
 				nameservers.IPv6,
+				nil,
+				dhcpOptions,
 			); err != nil {
 				log.Log.Reason(err).Error("failed to run DHCPv6 Server")
 				panic(err)
@@ -212,3 +231,4 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 // Allow mocking for tests
 var DHCPServer = dhcpserver.SingleClientDHCPServer
 var DHCPv6Server = dhcpserverv6.SingleClientDHCPv6Server
+var IPv6AutoConfigResponder = dhcpserverv6.SingleClientIPv6AutoConfigResponder
@@ -21,6 +21,7 @@ package fake
 
 import (
 	"net"
+	"syscall"
 
 	vishnetlink "github.com/vishvananda/netlink"
 )
@@ -31,6 +32,9 @@ type NetLink struct {
 	ip6AddressesByLinkName map[string][]vishnetlink.Addr
 	routes4                []vishnetlink.Route
 	routes6                []vishnetlink.Route
+	qdiscs                 []vishnetlink.Qdisc
+	classes                []vishnetlink.Class
+	filters                []vishnetlink.Filter
 }
 
 func New() *NetLink {
@@ -232,6 +236,102 @@ func (n *NetLink) RouteAdd(route *vishnetlink.Route) error {
 	return nil
 }
 
+func (n *NetLink) QdiscList(link vishnetlink.Link) ([]vishnetlink.Qdisc, error) {
+	var qdiscs []vishnetlink.Qdisc
+	for _, qdisc := range n.qdiscs {
+		if qdisc.Attrs().LinkIndex == link.Attrs().Index {
+			qdiscs = append(qdiscs, qdisc)
+		}
+	}
+	return qdiscs, nil
+}
+
+func (n *NetLink) QdiscReplace(qdisc vishnetlink.Qdisc) error {
+	for i, q := range n.qdiscs {
+		if q.Attrs().LinkIndex == qdisc.Attrs().LinkIndex && q.Attrs().Parent == qdisc.Attrs().Parent {
+			n.qdiscs[i] = qdisc
+			return nil
+		}
+	}
+	n.qdiscs = append(n.qdiscs, qdisc)
+	return nil
+}
+
+// QdiscDel removes the qdisc along with its classes and filters, as the kernel does.
+func (n *NetLink) QdiscDel(qdisc vishnetlink.Qdisc) error {
+	linkIndex := qdisc.Attrs().LinkIndex
+	var qdiscs []vishnetlink.Qdisc
+	for _, q := range n.qdiscs {
+		if q.Attrs().LinkIndex != linkIndex || q.Attrs().Parent != qdisc.Attrs().Parent {
+			qdiscs = append(qdiscs, q)
+		}
+	}
+	if len(qdiscs) == len(n.qdiscs) {
+		return syscall.ENOENT
+	}
+	n.qdiscs = qdiscs
+
+	major, _ := vishnetlink.MajorMinor(qdisc.Attrs().Handle)
+	var classes []vishnetlink.Class
+	for _, class := range n.classes {
+		if classMajor, _ := vishnetlink.MajorMinor(class.Attrs().Parent); class.Attrs().LinkIndex != linkIndex || classMajor != major {
+			classes = append(classes, class)
+		}
+	}
+	n.classes = classes
+	var filters []vishnetlink.Filter
+	for _, filter := range n.filters {
+		if filterMajor, _ := vishnetlink.MajorMinor(filter.Attrs().Parent); filter.Attrs().LinkIndex != linkIndex || filterMajor != major {
+			filters = append(filters, filter)
+		}
+	}
+	n.filters = filters
+	return nil
+}
+
+func (n *NetLink) ClassList(link vishnetlink.Link, parent uint32) ([]vishnetlink.Class, error) {
+	var classes []vishnetlink.Class
+	for _, class := range n.classes {
+		if class.Attrs().LinkIndex == link.Attrs().Index && class.Attrs().Parent == parent {
+			classes = append(classes, class)
+		}
+	}
+	return classes, nil
+}
+
+func (n *NetLink) ClassReplace(class vishnetlink.Class) error {
+	for i, c := range n.classes {
+		if c.Attrs().LinkIndex == class.Attrs().LinkIndex && c.Attrs().Handle == class.Attrs().Handle {
+			n.classes[i] = class
+			return nil
+		}
+	}
+	n.classes = append(n.classes, class)
+	return nil
+}
+
+func (n *NetLink) FilterList(link vishnetlink.Link, parent uint32) ([]vishnetlink.Filter, error) {
+	var filters []vishnetlink.Filter
+	for _, filter := range n.filters {
+		if filter.Attrs().LinkIndex == link.Attrs().Index && filter.Attrs().Parent == parent {
+			filters = append(filters, filter)
+		}
+	}
+	return filters, nil
+}
+
+func (n *NetLink) FilterReplace(filter vishnetlink.Filter) error {
+	for i, f := range n.filters {
+		if f.Attrs().LinkIndex == filter.Attrs().LinkIndex && f.Attrs().Parent == filter.Attrs().Parent &&
+			f.Attrs().Priority == filter.Attrs().Priority {
+			n.filters[i] = filter
+			return nil
+		}
+	}
+	n.filters = append(n.filters, filter)
+	return nil
+}
+
 func (n *NetLink) lookupLinkByName(name string) vishnetlink.Link {
 	for i, l := range n.links {
 		if l.Attrs().Name == name {
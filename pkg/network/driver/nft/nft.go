@@ -22,6 +22,7 @@ package nft
 import (
 	"fmt"
 	"os/exec"
+	"strings"
 )
 
 type NFTBin struct{}
@@ -31,6 +32,9 @@ type IPFamily string
 const (
 	IPv4 IPFamily = "ip"
 	IPv6 IPFamily = "ip6"
+
+	Inet   IPFamily = "inet"
+	Bridge IPFamily = "bridge"
 )
 
 const (
@@ -54,6 +58,30 @@ func (n NFTBin) AddRule(family IPFamily, table, chain string, rulespec ...string
 	return execute(cmd)
 }
 
+// ApplyRuleset applies the given nft script atomically.
+func (n NFTBin) ApplyRuleset(ruleset string) error {
+	cmd := exec.Command(nftBin, "-f", "-")
+	cmd.Stdin = strings.NewReader(ruleset)
+	return execute(cmd)
+}
+
+// ListTable returns the table content in the nft JSON format.
+func (n NFTBin) ListTable(family IPFamily, name string) ([]byte, error) {
+	cmd := exec.Command(nftBin, "-j", "list", "table", string(family), name)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list table %s %s, error: %v", family, name, err)
+	}
+	return output, nil
+}
+
+// ResetTableScript renders the nft script statements removing the given table, so the statements
+// following them recreate it from scratch.
+// Adding the table before deleting it ensures the deletion never fails.
+func ResetTableScript(family IPFamily, name string) string {
+	return fmt.Sprintf("add table %s %s\ndelete table %s %s\n", family, name, family, name)
+}
+
 func execute(cmd *exec.Cmd) error {
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("%s, error: %v", string(output), err)
@@ -48,3 +48,10 @@ func GenerateNewBridgedVmiInterfaceName(originalPodInterfaceName string) string
 	trimmedName := strings.TrimPrefix(originalPodInterfaceName, namescheme.HashedIfacePrefix)
 	return fmt.Sprintf("%s-nic", trimmedName)
 }
+
+// GenerateIPv6AutoConfigTapName returns the name of the tap device used to advertise
+// the IPv6 configuration of the guest interface.
+func GenerateIPv6AutoConfigTapName(podInterfaceName string) string {
+	trimmedName := strings.TrimPrefix(podInterfaceName, namescheme.HashedIfacePrefix)
+	return fmt.Sprintf("%s-ra", trimmedName)
+}
@@ -66,6 +66,16 @@ var _ = Describe("Common Methods", func() {
 			Expect(hashedIfaceName).To(Equal("16477688c0e-nic"))
 		})
 	})
+	Context("GenerateIPv6AutoConfigTapName function", func() {
+		It("Should return the IPv6 auto-configuration tap name", func() {
+			Expect(virtnetlink.GenerateIPv6AutoConfigTapName("net12")).To(Equal("net12-ra"))
+		})
+		It("Should return hash network name IPv6 auto-configuration tap name", func() {
+			hashedIfaceName := virtnetlink.GenerateIPv6AutoConfigTapName("pod16477688c0e")
+			Expect(len(hashedIfaceName)).To(BeNumerically("<=", maxInterfaceNameLength))
+			Expect(hashedIfaceName).To(Equal("16477688c0e-ra"))
+		})
+	})
 	Context("GenerateBridgeName function", func() {
 		It("Should return the new bridge interface name", func() {
 			Expect(virtnetlink.GenerateBridgeName("eth0")).To(Equal("k6t-eth0"))
@@ -163,7 +163,8 @@ func (g Generator) generateMultusAnnotation(vmi *v1.VirtualMachineInstance, pod
 
 func (g Generator) generateNetworkInfoAnnotation(vmi *v1.VirtualMachineInstance, pod *k8scorev1.Pod) string {
 	ifaces := vmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
-		return iface.SRIOV != nil || vmispec.HasBindingPluginDeviceInfo(iface, g.clusterConfigurer.GetNetworkBindings())
+		return iface.SRIOV != nil || vmispec.HasBindingPluginDeviceInfo(iface, g.clusterConfigurer.GetNetworkBindings()) ||
+			vmispec.HasIPv6AutoConfig(iface)
 	})
 
 	if len(ifaces) == 0 {
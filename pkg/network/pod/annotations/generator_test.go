@@ -411,6 +411,31 @@ var _ = Describe("Annotations Generator", func() {
 			))
 		})
 
+		It("Should generate the network info annotation with the IPAM addresses when there is a bridge interface with IPv6 auto-configuration", func() {
+			bridgeIface := libvmi.InterfaceDeviceWithBridgeBinding(networkName4)
+			bridgeIface.Bridge.AutoConfig = &v1.IPv6AutoConfig{}
+			vmi := libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithInterface(bridgeIface),
+				libvmi.WithNetwork(libvmi.MultusNetwork(networkName4, networkAttachmentDefinitionName4)),
+			)
+
+			const multusNetworkStatusWithPrimaryAndBridgeSecondaryNet = `[` +
+				`{"name":"k8s-pod-network","ips":["10.244.196.146","fd10:244::c491"],"default":true,"dns":{}},` +
+				`{"name":"default/br-net","interface":"podeeea394806a","ips":["10.10.0.5","fd20:10::5"],"mac":"6a:1f:28:23:58:40","dns":{}}` +
+				`]`
+
+			podAnnotations := map[string]string{networkv1.NetworkStatusAnnot: multusNetworkStatusWithPrimaryAndBridgeSecondaryNet}
+
+			generator := annotations.NewGenerator(clusterConfig)
+			actualAnnotations := generator.GenerateFromActivePod(vmi, newStubVirtLauncherPod(vmi, podAnnotations))
+
+			Expect(actualAnnotations).To(HaveKeyWithValue(
+				downwardapi.NetworkInfoAnnot,
+				`{"interfaces":[{"network":"goo","mac":"6a:1f:28:23:58:40","ips":["10.10.0.5","fd20:10::5"]}]}`,
+			))
+		})
+
 		It("Should generate the network info annotation when there is SR-IOV interface and binding plugin interface with device-info", func() {
 			vmi := libvmi.New(
 				libvmi.WithNamespace(testNamespace),
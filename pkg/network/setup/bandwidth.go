@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+type bandwidthAdapter interface {
+	Apply(tapName string, bandwidth *v1.InterfaceBandwidth) error
+}
+
+// SetupBandwidth enforces the bandwidth limits of the VMI interfaces in the virt-launcher pod network namespace.
+// The limits of an interface are only applied when they differ from the ones previously applied on it.
+func (c *NetConf) SetupBandwidth(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	c.configStateMutex.RLock()
+	appliedBandwidths, exists := c.appliedBandwidths[string(vmi.UID)]
+	c.configStateMutex.RUnlock()
+	if !exists {
+		appliedBandwidths = map[string]*v1.InterfaceBandwidth{}
+	}
+
+	var ifacesToReconcile []v1.Interface
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.State == v1.InterfaceStateAbsent || (iface.Bridge == nil && iface.Masquerade == nil) {
+			continue
+		}
+		if !equality.Semantic.DeepEqual(iface.Bandwidth, appliedBandwidths[iface.Name]) {
+			ifacesToReconcile = append(ifacesToReconcile, iface)
+		}
+	}
+	if len(ifacesToReconcile) == 0 {
+		return nil
+	}
+
+	networksByName := vmispec.IndexNetworkSpecByName(vmi.Spec.Networks)
+	var errs []error
+	err := c.nsFactory(launcherPid).Do(func() error {
+		for _, iface := range ifacesToReconcile {
+			network, exists := networksByName[iface.Name]
+			if !exists {
+				continue
+			}
+			tapName := link.GenerateTapDeviceName(lookupPodIfaceName(network, vmi.Status.Interfaces), network)
+			if err := c.bandwidth.Apply(tapName, iface.Bandwidth); err != nil {
+				errs = append(errs, fmt.Errorf("failed to setup the bandwidth of interface %s: %w", iface.Name, err))
+				continue
+			}
+			if iface.Bandwidth == nil {
+				delete(appliedBandwidths, iface.Name)
+			} else {
+				appliedBandwidths[iface.Name] = iface.Bandwidth.DeepCopy()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.configStateMutex.Lock()
+	c.appliedBandwidths[string(vmi.UID)] = appliedBandwidths
+	c.configStateMutex.Unlock()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
+)
+
+var _ = Describe("netconf bandwidth", func() {
+	const (
+		launcherPid = 0
+		networkName = "default"
+	)
+
+	var (
+		netConf *netsetup.NetConf
+		bwStub  *bandwidthStub
+		vmi     *v1.VirtualMachineInstance
+	)
+
+	limits := &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}}
+
+	newNetConf := func(nsExecutor netsetup.NSExecutor) *netsetup.NetConf {
+		return netsetup.NewNetConfWithCustomFactoryAndConfigState(
+			func(int) netsetup.NSExecutor { return nsExecutor },
+			&tempCacheCreator{},
+			map[string]*netpod.State{},
+			cConfigStub{},
+			netsetup.WithBandwidthAdapter(bwStub),
+		)
+	}
+
+	BeforeEach(func() {
+		bwStub = &bandwidthStub{}
+		netConf = newNetConf(nsExecutorStub{})
+		vmi = &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{UID: "123", Name: "vmi1"}}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   networkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			Bandwidth:              limits,
+		}}
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: networkName, PodInterfaceName: "eth0"}}
+	})
+
+	It("should not touch the network namespace when no interface has bandwidth limits", func() {
+		vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = nil
+		netConf = newNetConf(nsExecutorStub{shouldNotBeExecuted: true})
+
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+		Expect(bwStub.applied).To(BeEmpty())
+	})
+
+	It("should apply the limits on the tap device", func() {
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+
+		Expect(bwStub.applied).To(Equal([]appliedBandwidth{{tapName: "tap0", bandwidth: limits}}))
+	})
+
+	It("should not reapply unchanged limits", func() {
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+
+		Expect(bwStub.applied).To(HaveLen(1))
+	})
+
+	It("should reapply updated limits", func() {
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+		updatedLimits := &v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: 2000}}
+		vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = updatedLimits
+
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+
+		Expect(bwStub.applied).To(HaveLen(2))
+		Expect(bwStub.applied[1].bandwidth).To(Equal(updatedLimits))
+	})
+
+	It("should remove the limits once when they are removed", func() {
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+		vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = nil
+
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+
+		Expect(bwStub.applied).To(Equal([]appliedBandwidth{
+			{tapName: "tap0", bandwidth: limits},
+			{tapName: "tap0", bandwidth: nil},
+		}))
+	})
+
+	It("should ignore the interfaces of other bindings", func() {
+		vmi.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}
+		netConf = newNetConf(nsExecutorStub{shouldNotBeExecuted: true})
+
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+		Expect(bwStub.applied).To(BeEmpty())
+	})
+
+	It("should retry the limits which failed to be applied", func() {
+		bwStub.applyErr = errors.New("test")
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(MatchError(ContainSubstring("test")))
+
+		bwStub.applyErr = nil
+		Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+		Expect(bwStub.applied).To(HaveLen(1))
+	})
+})
+
+type appliedBandwidth struct {
+	tapName   string
+	bandwidth *v1.InterfaceBandwidth
+}
+
+type bandwidthStub struct {
+	applied  []appliedBandwidth
+	applyErr error
+}
+
+func (b *bandwidthStub) Apply(tapName string, bandwidth *v1.InterfaceBandwidth) error {
+	if b.applyErr != nil {
+		return b.applyErr
+	}
+	b.applied = append(b.applied, appliedBandwidth{tapName: tapName, bandwidth: bandwidth})
+	return nil
+}
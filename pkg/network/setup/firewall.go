@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+type firewallAdapter interface {
+	EnsureConntrack(target firewall.Target) error
+	Apply(ruleset string) error
+	Counters(podIfaceName string, target firewall.Target, fw *v1.InterfaceFirewall) ([]v1.FirewallRuleStatus, error)
+}
+
+// SetupFirewall enforces the firewall rules of the VMI interfaces in the virt-launcher pod network namespace
+// and reports the counters of the rules in the interfaces status.
+// A ruleset is only applied when it differs from the one previously applied on the interface.
+func (c *NetConf) SetupFirewall(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	c.configStateMutex.RLock()
+	appliedRulesets, exists := c.firewallRulesets[string(vmi.UID)]
+	c.configStateMutex.RUnlock()
+	if !exists {
+		appliedRulesets = map[string]string{}
+	}
+
+	var ifacesToReconcile []v1.Interface
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		if iface.Firewall != nil || hasFirewallStatus(vmi, iface.Name) {
+			ifacesToReconcile = append(ifacesToReconcile, iface)
+		}
+	}
+	if len(ifacesToReconcile) == 0 {
+		return nil
+	}
+
+	networksByName := vmispec.IndexNetworkSpecByName(vmi.Spec.Networks)
+	var errs []error
+	err := c.nsFactory(launcherPid).Do(func() error {
+		for _, iface := range ifacesToReconcile {
+			network, exists := networksByName[iface.Name]
+			if !exists {
+				continue
+			}
+			podIfaceName := lookupPodIfaceName(network, vmi.Status.Interfaces)
+			target, supported := firewall.TargetFor(iface, network, podIfaceName)
+			if !supported {
+				continue
+			}
+			if err := c.reconcileFirewall(vmi, iface, podIfaceName, target, appliedRulesets); err != nil {
+				errs = append(errs, fmt.Errorf("failed to setup the firewall of interface %s: %w", iface.Name, err))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.configStateMutex.Lock()
+	c.firewallRulesets[string(vmi.UID)] = appliedRulesets
+	c.configStateMutex.Unlock()
+
+	return errors.Join(errs...)
+}
+
+func (c *NetConf) reconcileFirewall(
+	vmi *v1.VirtualMachineInstance,
+	iface v1.Interface,
+	podIfaceName string,
+	target firewall.Target,
+	appliedRulesets map[string]string,
+) error {
+	ruleset := firewall.Ruleset(podIfaceName, target, iface.Firewall)
+	if appliedRulesets[podIfaceName] != ruleset {
+		if firewall.HasRules(iface.Firewall) {
+			if err := c.firewall.EnsureConntrack(target); err != nil {
+				return err
+			}
+		}
+		if err := c.firewall.Apply(ruleset); err != nil {
+			return err
+		}
+		appliedRulesets[podIfaceName] = ruleset
+	}
+
+	ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name)
+	if ifaceStatus == nil {
+		return nil
+	}
+	if iface.Firewall == nil {
+		ifaceStatus.FirewallRules = nil
+		return nil
+	}
+	counters, err := c.firewall.Counters(podIfaceName, target, iface.Firewall)
+	if err != nil {
+		return err
+	}
+	ifaceStatus.FirewallRules = counters
+	return nil
+}
+
+func hasFirewallStatus(vmi *v1.VirtualMachineInstance, ifaceName string) bool {
+	ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, ifaceName)
+	return ifaceStatus != nil && len(ifaceStatus.FirewallRules) > 0
+}
+
+func lookupPodIfaceName(network v1.Network, ifaceStatuses []v1.VirtualMachineInstanceNetworkInterface) string {
+	if ifaceStatus := vmispec.LookupInterfaceStatusByName(ifaceStatuses, network.Name); ifaceStatus != nil &&
+		ifaceStatus.PodInterfaceName != "" {
+		return ifaceStatus.PodInterfaceName
+	}
+	return namescheme.HashedPodInterfaceName(network, ifaceStatuses)
+}
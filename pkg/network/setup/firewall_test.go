@@ -0,0 +1,169 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
+)
+
+var _ = Describe("netconf firewall", func() {
+	const (
+		launcherPid = 0
+		networkName = "default"
+	)
+
+	var (
+		netConf *netsetup.NetConf
+		fwStub  *firewallStub
+		vmi     *v1.VirtualMachineInstance
+	)
+
+	sshFirewall := &v1.InterfaceFirewall{
+		Ingress: []v1.FirewallRule{{Name: "ssh", Protocol: v1.FirewallProtocolTCP, Ports: []int32{22}}},
+	}
+	sshCounters := []v1.FirewallRuleStatus{{Name: "ssh", Direction: v1.FirewallDirectionIngress, Packets: 2, Bytes: 120}}
+	tapTarget := firewall.Target{Family: nft.Bridge, IfaceName: "tap0"}
+
+	BeforeEach(func() {
+		fwStub = &firewallStub{counters: sshCounters}
+		netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(
+			func(int) netsetup.NSExecutor { return nsExecutorStub{} },
+			&tempCacheCreator{},
+			map[string]*netpod.State{},
+			cConfigStub{},
+			netsetup.WithFirewallAdapter(fwStub),
+		)
+		vmi = &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{UID: "123", Name: "vmi1"}}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   networkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			Firewall:               sshFirewall,
+		}}
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: networkName, PodInterfaceName: "eth0"}}
+	})
+
+	It("should not touch the network namespace when no interface has a firewall", func() {
+		vmi.Spec.Domain.Devices.Interfaces[0].Firewall = nil
+		netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(
+			func(int) netsetup.NSExecutor { return nsExecutorStub{shouldNotBeExecuted: true} },
+			&tempCacheCreator{},
+			map[string]*netpod.State{},
+			cConfigStub{},
+			netsetup.WithFirewallAdapter(fwStub),
+		)
+
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+		Expect(fwStub.appliedRulesets).To(BeEmpty())
+	})
+
+	It("should apply the ruleset and report the counters", func() {
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+
+		Expect(fwStub.appliedRulesets).To(Equal([]string{firewall.Ruleset("eth0", tapTarget, sshFirewall)}))
+		Expect(vmi.Status.Interfaces[0].FirewallRules).To(Equal(sshCounters))
+	})
+
+	It("should not reapply an unchanged ruleset", func() {
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+
+		Expect(fwStub.appliedRulesets).To(HaveLen(1))
+	})
+
+	It("should reapply an updated ruleset", func() {
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+		updatedFirewall := &v1.InterfaceFirewall{Egress: []v1.FirewallRule{{Name: "dns", Protocol: v1.FirewallProtocolUDP, Ports: []int32{53}}}}
+		vmi.Spec.Domain.Devices.Interfaces[0].Firewall = updatedFirewall
+
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+
+		Expect(fwStub.appliedRulesets).To(HaveLen(2))
+		Expect(fwStub.appliedRulesets[1]).To(Equal(firewall.Ruleset("eth0", tapTarget, updatedFirewall)))
+	})
+
+	It("should remove the firewall and its counters when the rules are removed", func() {
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+		vmi.Spec.Domain.Devices.Interfaces[0].Firewall = nil
+
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+
+		Expect(fwStub.appliedRulesets).To(HaveLen(2))
+		Expect(fwStub.appliedRulesets[1]).To(Equal(firewall.Ruleset("eth0", tapTarget, nil)))
+		Expect(vmi.Status.Interfaces[0].FirewallRules).To(BeEmpty())
+	})
+
+	It("should fail when the ruleset cannot be applied", func() {
+		fwStub.applyErr = errors.New("test")
+
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(MatchError(ContainSubstring("test")))
+		Expect(vmi.Status.Interfaces[0].FirewallRules).To(BeEmpty())
+	})
+
+	It("should fail without applying the ruleset when the connections cannot be tracked", func() {
+		fwStub.conntrackErr = errors.New("nf_conntrack_bridge is not loaded")
+
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(MatchError(ContainSubstring("nf_conntrack_bridge")))
+		Expect(fwStub.appliedRulesets).To(BeEmpty())
+	})
+
+	It("should remove the firewall when the connections cannot be tracked", func() {
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+		fwStub.conntrackErr = errors.New("nf_conntrack_bridge is not loaded")
+		vmi.Spec.Domain.Devices.Interfaces[0].Firewall = nil
+
+		Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+		Expect(fwStub.appliedRulesets).To(HaveLen(2))
+	})
+})
+
+type firewallStub struct {
+	appliedRulesets []string
+	applyErr        error
+	conntrackErr    error
+	counters        []v1.FirewallRuleStatus
+}
+
+func (f *firewallStub) EnsureConntrack(_ firewall.Target) error {
+	return f.conntrackErr
+}
+
+func (f *firewallStub) Apply(ruleset string) error {
+	if f.applyErr != nil {
+		return f.applyErr
+	}
+	f.appliedRulesets = append(f.appliedRulesets, ruleset)
+	return nil
+}
+
+func (f *firewallStub) Counters(_ string, _ firewall.Target, _ *v1.InterfaceFirewall) ([]v1.FirewallRuleStatus, error) {
+	return f.counters, nil
+}
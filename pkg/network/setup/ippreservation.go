@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"fmt"
+	"strconv"
+
+	netutils "k8s.io/utils/net"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// PublishPreservedAddresses reports in the migration state the IPv4 address and gateway the guest
+// received on the pod network interface that preserves them, as served by the DHCP server of the
+// migration source virt-launcher pod.
+// The migration target serves the published addresses to the guest and translates them to its own.
+func (c *NetConf) PublishPreservedAddresses(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	iface := vmispec.LookupIPPreservingPodInterface(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks)
+	if iface == nil || vmi.Status.MigrationState == nil ||
+		lookupMigratedInterface(vmi.Status.MigrationState.MigratedInterfaces, iface.Name) != nil {
+		return nil
+	}
+
+	podIfaceName := lookupPodIfaceName(*vmispec.LookupPodNetwork(vmi.Spec.Networks), vmi.Status.Interfaces)
+	dhcpConfig, err := cache.ReadDHCPInterfaceCache(c.cacheCreator, strconv.Itoa(launcherPid), podIfaceName)
+	if err != nil {
+		return fmt.Errorf("failed to read the guest address of interface %s: %w", iface.Name, err)
+	}
+
+	migratedIface := v1.MigratedInterfaceState{Name: iface.Name}
+	if dhcpConfig.IPAMDisabled {
+		migratedIface.Phase = v1.MigratedInterfaceFailed
+		migratedIface.Message = "the guest has no IPv4 address to preserve"
+	} else {
+		migratedIface.Phase = v1.MigratedInterfacePending
+		migratedIface.GuestIP = dhcpConfig.IP.IPNet.String()
+		migratedIface.GuestGateway = dhcpConfig.Gateway.String()
+	}
+	vmi.Status.MigrationState.MigratedInterfaces = append(vmi.Status.MigrationState.MigratedInterfaces, migratedIface)
+	return nil
+}
+
+// ReportPreservedAddresses marks the published guest addresses as ready once the network of the
+// migration target virt-launcher pod is set up, reporting the pod address they are translated to.
+func (c *NetConf) ReportPreservedAddresses(vmi *v1.VirtualMachineInstance) error {
+	if vmi.Status.MigrationState == nil {
+		return nil
+	}
+	c.configStateMutex.RLock()
+	state, exists := c.state[string(vmi.UID)]
+	c.configStateMutex.RUnlock()
+	if !exists {
+		return nil
+	}
+
+	networksByName := vmispec.IndexNetworkSpecByName(vmi.Spec.Networks)
+	for idx := range vmi.Status.MigrationState.MigratedInterfaces {
+		migratedIface := &vmi.Status.MigrationState.MigratedInterfaces[idx]
+		network, exists := networksByName[migratedIface.Name]
+		if migratedIface.Phase != v1.MigratedInterfacePending || !exists {
+			continue
+		}
+		_, _, finishedNets, err := state.PendingStartedFinished([]v1.Network{network})
+		if err != nil {
+			return err
+		}
+		if len(finishedNets) == 0 {
+			continue
+		}
+		podIfaceData, err := cache.ReadPodInterfaceCache(c.cacheCreator, string(vmi.UID), migratedIface.Name)
+		if err != nil {
+			return fmt.Errorf("failed to read the pod address of interface %s: %w", migratedIface.Name, err)
+		}
+		migratedIface.Phase = v1.MigratedInterfaceReady
+		migratedIface.TargetIP = firstIPv4(podIfaceData.PodIPs)
+	}
+	return nil
+}
+
+// IsWaitingForPreservedAddresses reports whether the migration target network cannot be set up yet,
+// as the migration source did not publish the address of the guest.
+func IsWaitingForPreservedAddresses(vmi *v1.VirtualMachineInstance) bool {
+	iface := vmispec.LookupIPPreservingPodInterface(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks)
+	return iface != nil && vmi.Status.MigrationState != nil &&
+		lookupMigratedInterface(vmi.Status.MigrationState.MigratedInterfaces, iface.Name) == nil
+}
+
+// preservedAddresses returns the guest addresses published by the migration source, by interface name.
+func preservedAddresses(vmi *v1.VirtualMachineInstance) map[string]netpod.PreservedAddress {
+	if vmi.Status.MigrationState == nil {
+		return nil
+	}
+	addresses := map[string]netpod.PreservedAddress{}
+	for _, migratedIface := range vmi.Status.MigrationState.MigratedInterfaces {
+		if migratedIface.Phase == v1.MigratedInterfaceFailed || migratedIface.GuestIP == "" {
+			continue
+		}
+		addresses[migratedIface.Name] = netpod.PreservedAddress{
+			IP:      migratedIface.GuestIP,
+			Gateway: migratedIface.GuestGateway,
+		}
+	}
+	return addresses
+}
+
+func lookupMigratedInterface(migratedIfaces []v1.MigratedInterfaceState, name string) *v1.MigratedInterfaceState {
+	for idx := range migratedIfaces {
+		if migratedIfaces[idx].Name == name {
+			return &migratedIfaces[idx]
+		}
+	}
+	return nil
+}
+
+func firstIPv4(ips []string) string {
+	for _, ip := range ips {
+		if netutils.IsIPv4String(ip) {
+			return ip
+		}
+	}
+	return ""
+}
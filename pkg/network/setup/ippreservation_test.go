@@ -0,0 +1,163 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network_test
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vishnetlink "github.com/vishvananda/netlink"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/cache"
+	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
+)
+
+var _ = Describe("netconf IP preservation", func() {
+	const (
+		launcherPid = 0
+		networkName = "default"
+
+		guestIP      = "10.244.1.5/24"
+		guestGateway = "10.244.1.1"
+		targetPodIP  = "10.244.2.7"
+	)
+
+	var (
+		netConf      *netsetup.NetConf
+		cacheCreator *tempCacheCreator
+		stateMap     map[string]*netpod.State
+		vmi          *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		cacheCreator = &tempCacheCreator{}
+		stateMap = map[string]*netpod.State{}
+		netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(
+			func(int) netsetup.NSExecutor { return nsExecutorStub{} }, cacheCreator, stateMap, cConfigStub{},
+		)
+		vmi = &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{UID: "123", Name: "vmi1"}}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name: networkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				Bridge: &v1.InterfaceBridge{MigrationMode: v1.BridgeMigrationModePreserveIP},
+			},
+		}}
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: networkName, PodInterfaceName: "eth0"}}
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{}
+	})
+
+	writeDHCPConfig := func(dhcpConfig *cache.DHCPConfig) {
+		Expect(cache.WriteDHCPInterfaceCache(cacheCreator, "0", "eth0", dhcpConfig)).To(Succeed())
+	}
+
+	Context("on the migration source", func() {
+		It("should publish the address served to the guest", func() {
+			addr, err := vishnetlink.ParseAddr(guestIP)
+			Expect(err).NotTo(HaveOccurred())
+			writeDHCPConfig(&cache.DHCPConfig{IP: *addr, Gateway: net.ParseIP(guestGateway)})
+			Expect(netsetup.IsWaitingForPreservedAddresses(vmi)).To(BeTrue())
+
+			Expect(netConf.PublishPreservedAddresses(vmi, launcherPid)).To(Succeed())
+			Expect(netConf.PublishPreservedAddresses(vmi, launcherPid)).To(Succeed())
+
+			Expect(vmi.Status.MigrationState.MigratedInterfaces).To(ConsistOf(v1.MigratedInterfaceState{
+				Name:         networkName,
+				Phase:        v1.MigratedInterfacePending,
+				GuestIP:      guestIP,
+				GuestGateway: guestGateway,
+			}))
+			Expect(netsetup.IsWaitingForPreservedAddresses(vmi)).To(BeFalse())
+		})
+
+		It("should report a failure when the guest has no IPv4 address", func() {
+			writeDHCPConfig(&cache.DHCPConfig{IPAMDisabled: true})
+
+			Expect(netConf.PublishPreservedAddresses(vmi, launcherPid)).To(Succeed())
+
+			Expect(vmi.Status.MigrationState.MigratedInterfaces).To(ConsistOf(v1.MigratedInterfaceState{
+				Name:    networkName,
+				Phase:   v1.MigratedInterfaceFailed,
+				Message: "the guest has no IPv4 address to preserve",
+			}))
+			Expect(netsetup.IsWaitingForPreservedAddresses(vmi)).To(BeFalse())
+		})
+
+		It("should not publish anything when the guest does not preserve its address", func() {
+			vmi.Spec.Domain.Devices.Interfaces[0].Bridge.MigrationMode = ""
+
+			Expect(netConf.PublishPreservedAddresses(vmi, launcherPid)).To(Succeed())
+
+			Expect(vmi.Status.MigrationState.MigratedInterfaces).To(BeEmpty())
+			Expect(netsetup.IsWaitingForPreservedAddresses(vmi)).To(BeFalse())
+		})
+
+		It("should fail when the address served to the guest cannot be read", func() {
+			Expect(netConf.PublishPreservedAddresses(vmi, launcherPid)).NotTo(Succeed())
+		})
+	})
+
+	Context("on the migration target", func() {
+		var stateCache stateCacheStub
+
+		BeforeEach(func() {
+			vmi.Status.MigrationState.MigratedInterfaces = []v1.MigratedInterfaceState{{
+				Name:         networkName,
+				Phase:        v1.MigratedInterfacePending,
+				GuestIP:      guestIP,
+				GuestGateway: guestGateway,
+			}}
+			stateCache = newConfigStateCacheStub()
+			stateMap[string(vmi.UID)] = netpod.NewState(stateCache, nsExecutorStub{})
+			Expect(cache.WritePodInterfaceCache(cacheCreator, string(vmi.UID), networkName, &cache.PodIfaceCacheData{
+				PodIP:  "fd10:244::7",
+				PodIPs: []string{"fd10:244::7", targetPodIP},
+			})).To(Succeed())
+		})
+
+		It("should keep the address pending until the pod network is set up", func() {
+			Expect(stateCache.Write(networkName, cache.PodIfaceNetworkPreparationStarted)).To(Succeed())
+
+			Expect(netConf.ReportPreservedAddresses(vmi)).To(Succeed())
+
+			Expect(vmi.Status.MigrationState.MigratedInterfaces[0].Phase).To(Equal(v1.MigratedInterfacePending))
+		})
+
+		It("should report the pod address the guest address is translated to", func() {
+			Expect(stateCache.Write(networkName, cache.PodIfaceNetworkPreparationFinished)).To(Succeed())
+
+			Expect(netConf.ReportPreservedAddresses(vmi)).To(Succeed())
+
+			Expect(vmi.Status.MigrationState.MigratedInterfaces).To(ConsistOf(v1.MigratedInterfaceState{
+				Name:         networkName,
+				Phase:        v1.MigratedInterfaceReady,
+				GuestIP:      guestIP,
+				GuestGateway: guestGateway,
+				TargetIP:     targetPodIP,
+			}))
+		})
+	})
+})
@@ -33,6 +33,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/netns"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/bandwidth"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -54,8 +56,16 @@ type NetConf struct {
 	configStateMutex *sync.RWMutex
 
 	clusterConfigurer clusterConfigurer
+
+	firewall         firewallAdapter
+	firewallRulesets map[string]map[string]string
+
+	bandwidth         bandwidthAdapter
+	appliedBandwidths map[string]map[string]*v1.InterfaceBandwidth
 }
 
+type netConfOption func(*NetConf)
+
 type nsFactory func(int) NSExecutor
 
 type NSExecutor interface {
@@ -69,13 +79,39 @@ func NewNetConf(clusterConfigurer clusterConfigurer) *NetConf {
 	}, cacheFactory, map[string]*netpod.State{}, clusterConfigurer)
 }
 
-func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator cacheCreator, state map[string]*netpod.State, clusterConfigurer clusterConfigurer) *NetConf {
-	return &NetConf{
+func NewNetConfWithCustomFactoryAndConfigState(
+	nsFactory nsFactory,
+	cacheCreator cacheCreator,
+	state map[string]*netpod.State,
+	clusterConfigurer clusterConfigurer,
+	opts ...netConfOption,
+) *NetConf {
+	netConf := &NetConf{
 		state:             state,
 		configStateMutex:  &sync.RWMutex{},
 		cacheCreator:      cacheCreator,
 		nsFactory:         nsFactory,
 		clusterConfigurer: clusterConfigurer,
+		firewall:          firewall.New(),
+		firewallRulesets:  map[string]map[string]string{},
+		bandwidth:         bandwidth.New(),
+		appliedBandwidths: map[string]map[string]*v1.InterfaceBandwidth{},
+	}
+	for _, opt := range opts {
+		opt(netConf)
+	}
+	return netConf
+}
+
+func WithFirewallAdapter(adapter firewallAdapter) netConfOption {
+	return func(c *NetConf) {
+		c.firewall = adapter
+	}
+}
+
+func WithBandwidthAdapter(adapter bandwidthAdapter) netConfOption {
+	return func(c *NetConf) {
+		c.bandwidth = adapter
 	}
 }
 
@@ -111,6 +147,7 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 		netpod.WithBindingPlugins(c.clusterConfigurer.GetNetworkBindings()),
 		netpod.WithLogger(log.Log.Object(vmi)),
 		netpod.WithVMIIfaceStatuses(vmi.Status.Interfaces),
+		netpod.WithPreservedAddresses(preservedAddresses(vmi)),
 	)
 
 	if err := netpod.Setup(); err != nil {
@@ -122,6 +159,8 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 	c.configStateMutex.Lock()
 	delete(c.state, string(vmi.UID))
+	delete(c.firewallRulesets, string(vmi.UID))
+	delete(c.appliedBandwidths, string(vmi.UID))
 	c.configStateMutex.Unlock()
 	podCache := cache.NewPodInterfaceCache(c.cacheCreator, string(vmi.UID))
 	if err := podCache.Remove(); err != nil {
@@ -0,0 +1,209 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package bandwidth
+
+import (
+	"fmt"
+
+	vishnetlink "github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/netlink"
+)
+
+type netlinkHandler interface {
+	LinkByName(name string) (vishnetlink.Link, error)
+	QdiscList(link vishnetlink.Link) ([]vishnetlink.Qdisc, error)
+	QdiscReplace(qdisc vishnetlink.Qdisc) error
+	QdiscDel(qdisc vishnetlink.Qdisc) error
+	ClassReplace(class vishnetlink.Class) error
+	FilterReplace(filter vishnetlink.Filter) error
+}
+
+// Bandwidth limits the traffic of the tap devices of the bridge and masquerade bindings.
+// The traffic received by the guest is shaped by an HTB qdisc on the egress of the tap device,
+// while the traffic sent by the guest is policed on the ingress of the tap device.
+type Bandwidth struct {
+	handler netlinkHandler
+}
+
+const (
+	kibibyte = 1024
+
+	// policerMTU is the largest packet the policer accepts when a peak rate is set,
+	// it fits the packets aggregated by the tap device.
+	policerMTU = 64 * kibibyte
+
+	shaperClassMinor = 1
+)
+
+var (
+	shaperHandle = vishnetlink.MakeHandle(1, 0)
+	shaperClass  = vishnetlink.MakeHandle(1, shaperClassMinor)
+
+	ingressHandle = vishnetlink.MakeHandle(0xffff, 0)
+)
+
+type option func(*Bandwidth)
+
+func New(opts ...option) Bandwidth {
+	b := Bandwidth{handler: netlink.NetLink{}}
+	for _, opt := range opts {
+		opt(&b)
+	}
+	return b
+}
+
+func WithNetlinkAdapter(h netlinkHandler) option {
+	return func(b *Bandwidth) {
+		b.handler = h
+	}
+}
+
+// Apply enforces the bandwidth limits on the tap device.
+// The limits previously applied on the device which are no longer requested are removed.
+func (b Bandwidth) Apply(tapName string, bandwidth *v1.InterfaceBandwidth) error {
+	link, err := b.handler.LinkByName(tapName)
+	if err != nil {
+		return fmt.Errorf("failed to find the tap device %s: %w", tapName, err)
+	}
+
+	var inbound, outbound *v1.BandwidthLimit
+	if bandwidth != nil {
+		inbound, outbound = bandwidth.Inbound, bandwidth.Outbound
+	}
+
+	if inbound != nil {
+		err = b.shape(link, *inbound)
+	} else {
+		err = b.removeQdisc(link, vishnetlink.HANDLE_ROOT, shaperHandle)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to limit the inbound traffic of %s: %w", tapName, err)
+	}
+
+	if outbound != nil {
+		err = b.police(link, *outbound)
+	} else {
+		err = b.removeQdisc(link, vishnetlink.HANDLE_INGRESS, ingressHandle)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to limit the outbound traffic of %s: %w", tapName, err)
+	}
+	return nil
+}
+
+func (b Bandwidth) shape(link vishnetlink.Link, limit v1.BandwidthLimit) error {
+	qdisc := vishnetlink.NewHtb(vishnetlink.QdiscAttrs{
+		LinkIndex: link.Attrs().Index,
+		Handle:    shaperHandle,
+		Parent:    vishnetlink.HANDLE_ROOT,
+	})
+	qdisc.Defcls = shaperClassMinor
+	if err := b.handler.QdiscReplace(qdisc); err != nil {
+		return err
+	}
+
+	burst := uint32(burstBytes(limit))
+	class := vishnetlink.NewHtbClass(
+		vishnetlink.ClassAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    shaperClass,
+			Parent:    shaperHandle,
+		},
+		vishnetlink.HtbClassAttrs{
+			Rate:    bitsPerSecond(limit.Average),
+			Ceil:    bitsPerSecond(peak(limit)),
+			Buffer:  burst,
+			Cbuffer: burst,
+		},
+	)
+	return b.handler.ClassReplace(class)
+}
+
+func (b Bandwidth) police(link vishnetlink.Link, limit v1.BandwidthLimit) error {
+	qdisc := &vishnetlink.Ingress{
+		QdiscAttrs: vishnetlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    ingressHandle,
+			Parent:    vishnetlink.HANDLE_INGRESS,
+		},
+	}
+	if err := b.handler.QdiscReplace(qdisc); err != nil {
+		return err
+	}
+
+	policer := vishnetlink.NewPoliceAction()
+	policer.Rate = uint32(limit.Average * kibibyte)
+	policer.Burst = uint32(burstBytes(limit))
+	policer.ExceedAction = vishnetlink.TC_POLICE_SHOT
+	policer.NotExceedAction = vishnetlink.TC_POLICE_OK
+	if limit.Peak != nil {
+		policer.PeakRate = uint32(*limit.Peak * kibibyte)
+		policer.Mtu = policerMTU
+	}
+
+	filter := &vishnetlink.MatchAll{
+		FilterAttrs: vishnetlink.FilterAttrs{
+			LinkIndex: link.Attrs().Index,
+			Parent:    ingressHandle,
+			Handle:    1,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []vishnetlink.Action{policer},
+	}
+	return b.handler.FilterReplace(filter)
+}
+
+// removeQdisc removes the qdisc attached to the parent when it is the one managing the limits.
+func (b Bandwidth) removeQdisc(link vishnetlink.Link, parent, handle uint32) error {
+	qdiscs, err := b.handler.QdiscList(link)
+	if err != nil {
+		return err
+	}
+	for _, qdisc := range qdiscs {
+		if qdisc.Attrs().Parent == parent && qdisc.Attrs().Handle == handle {
+			return b.handler.QdiscDel(qdisc)
+		}
+	}
+	return nil
+}
+
+func peak(limit v1.BandwidthLimit) int64 {
+	if limit.Peak != nil {
+		return *limit.Peak
+	}
+	return limit.Average
+}
+
+// burstBytes defaults the burst to the traffic sent in one second at the average rate.
+func burstBytes(limit v1.BandwidthLimit) int64 {
+	if limit.Burst != nil {
+		return *limit.Burst * kibibyte
+	}
+	return limit.Average * kibibyte
+}
+
+func bitsPerSecond(kibibytesPerSecond int64) uint64 {
+	return uint64(kibibytesPerSecond) * kibibyte * 8
+}
@@ -0,0 +1,139 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package bandwidth_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vishnetlink "github.com/vishvananda/netlink"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/netlink/fake"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/bandwidth"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("interface bandwidth", func() {
+	const tapName = "tap0"
+
+	var (
+		nlink *fake.NetLink
+		tap   vishnetlink.Link
+		bw    bandwidth.Bandwidth
+	)
+
+	BeforeEach(func() {
+		nlink = fake.New()
+		Expect(nlink.LinkAdd(&vishnetlink.Tuntap{LinkAttrs: vishnetlink.LinkAttrs{Name: tapName}})).To(Succeed())
+		var err error
+		tap, err = nlink.LinkByName(tapName)
+		Expect(err).NotTo(HaveOccurred())
+		bw = bandwidth.New(bandwidth.WithNetlinkAdapter(nlink))
+	})
+
+	It("should shape the traffic received by the guest with an HTB class", func() {
+		Expect(bw.Apply(tapName, &v1.InterfaceBandwidth{
+			Inbound: &v1.BandwidthLimit{Average: 1000, Peak: pointer.P(int64(2000)), Burst: pointer.P(int64(500))},
+		})).To(Succeed())
+
+		qdiscs, err := nlink.QdiscList(tap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(qdiscs).To(HaveLen(1))
+		Expect(qdiscs[0].Type()).To(Equal("htb"))
+		Expect(qdiscs[0].Attrs().Parent).To(Equal(uint32(vishnetlink.HANDLE_ROOT)))
+		Expect(qdiscs[0].(*vishnetlink.Htb).Defcls).To(Equal(uint32(1)))
+
+		classes, err := nlink.ClassList(tap, qdiscs[0].Attrs().Handle)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(classes).To(HaveLen(1))
+		class := classes[0].(*vishnetlink.HtbClass)
+		Expect(class.Handle).To(Equal(vishnetlink.MakeHandle(1, 1)))
+		Expect(class.Rate).To(Equal(uint64(1000 * 1024)))
+		Expect(class.Ceil).To(Equal(uint64(2000 * 1024)))
+	})
+
+	It("should police the traffic sent by the guest on the ingress of the tap device", func() {
+		Expect(bw.Apply(tapName, &v1.InterfaceBandwidth{
+			Outbound: &v1.BandwidthLimit{Average: 1000, Peak: pointer.P(int64(2000))},
+		})).To(Succeed())
+
+		qdiscs, err := nlink.QdiscList(tap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(qdiscs).To(HaveLen(1))
+		Expect(qdiscs[0].Type()).To(Equal("ingress"))
+
+		filters, err := nlink.FilterList(tap, qdiscs[0].Attrs().Handle)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Type()).To(Equal("matchall"))
+		actions := filters[0].(*vishnetlink.MatchAll).Actions
+		Expect(actions).To(HaveLen(1))
+		policer := actions[0].(*vishnetlink.PoliceAction)
+		Expect(policer.Rate).To(Equal(uint32(1000 * 1024)))
+		Expect(policer.Burst).To(Equal(uint32(1000*1024)), "burst should default to one second at the average rate")
+		Expect(policer.PeakRate).To(Equal(uint32(2000 * 1024)))
+		Expect(policer.Mtu).NotTo(BeZero())
+		Expect(policer.ExceedAction).To(Equal(vishnetlink.TC_POLICE_SHOT))
+	})
+
+	It("should update the limits in place", func() {
+		Expect(bw.Apply(tapName, &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}})).To(Succeed())
+		Expect(bw.Apply(tapName, &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 3000}})).To(Succeed())
+
+		classes, err := nlink.ClassList(tap, vishnetlink.MakeHandle(1, 0))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(classes).To(HaveLen(1))
+		Expect(classes[0].(*vishnetlink.HtbClass).Rate).To(Equal(uint64(3000 * 1024)))
+	})
+
+	It("should remove the limits no longer requested", func() {
+		Expect(bw.Apply(tapName, &v1.InterfaceBandwidth{
+			Inbound:  &v1.BandwidthLimit{Average: 1000},
+			Outbound: &v1.BandwidthLimit{Average: 1000},
+		})).To(Succeed())
+
+		Expect(bw.Apply(tapName, &v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: 1000}})).To(Succeed())
+		qdiscs, err := nlink.QdiscList(tap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(qdiscs).To(HaveLen(1))
+		Expect(qdiscs[0].Type()).To(Equal("ingress"))
+
+		Expect(bw.Apply(tapName, nil)).To(Succeed())
+		Expect(nlink.QdiscList(tap)).To(BeEmpty())
+	})
+
+	It("should keep the qdiscs it does not manage", func() {
+		fqCodel := &vishnetlink.FqCodel{QdiscAttrs: vishnetlink.QdiscAttrs{
+			LinkIndex: tap.Attrs().Index,
+			Handle:    vishnetlink.MakeHandle(0x8001, 0),
+			Parent:    vishnetlink.HANDLE_ROOT,
+		}}
+		Expect(nlink.QdiscReplace(fqCodel)).To(Succeed())
+
+		Expect(bw.Apply(tapName, nil)).To(Succeed())
+		Expect(nlink.QdiscList(tap)).To(ConsistOf(fqCodel))
+	})
+
+	It("should fail when the tap device does not exist", func() {
+		Expect(bw.Apply("tap1", &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}})).NotTo(Succeed())
+	})
+})
@@ -69,6 +69,12 @@ func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Statu
 		if len(dhcpRoutes) > 0 {
 			dhcpConfig.Routes = &dhcpRoutes
 		}
+
+		if preservedAddress, exists := n.preservedAddressesByIface[vmiSpecIface.Name]; exists {
+			if err := preserveGuestAddress(&dhcpConfig, preservedAddress); err != nil {
+				return err
+			}
+		}
 	}
 
 	log.Log.V(4).Infof("The generated dhcpConfig: %s\nRoutes: %+v", dhcpConfig.String(), dhcpConfig.Routes)
@@ -79,6 +85,36 @@ func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Statu
 	return nil
 }
 
+// preserveGuestAddress serves the guest with the address and gateway it had before the migration,
+// instead of the pod ones. The address is served as a single host with an on-link route to the gateway,
+// so that the guest reaches every destination, its former subnet included, through the translated gateway.
+// The routes through the pod gateway are served through the guest gateway.
+func preserveGuestAddress(dhcpConfig *cache.DHCPConfig, preservedAddress PreservedAddress) error {
+	guestIP, _, err := net.ParseCIDR(preservedAddress.IP)
+	if err != nil {
+		return err
+	}
+	guestGateway := net.ParseIP(preservedAddress.Gateway)
+	if guestGateway == nil {
+		return fmt.Errorf("invalid preserved gateway address %q", preservedAddress.Gateway)
+	}
+
+	hostMask := net.CIDRMask(net.IPv4len*8, net.IPv4len*8)
+	routes := []vishnetlink.Route{{Dst: &net.IPNet{IP: guestGateway.To4(), Mask: hostMask}}}
+	if dhcpConfig.Routes != nil {
+		for _, route := range *dhcpConfig.Routes {
+			if route.Gw.Equal(dhcpConfig.Gateway) {
+				route.Gw = guestGateway
+			}
+			routes = append(routes, route)
+		}
+	}
+	dhcpConfig.Routes = &routes
+	dhcpConfig.IP = vishnetlink.Addr{IPNet: &net.IPNet{IP: guestIP.To4(), Mask: hostMask}}
+	dhcpConfig.Gateway = guestGateway
+	return nil
+}
+
 func translateNmstateToNetlinkRoutes(otherRoutes []nmstate.Route) ([]vishnetlink.Route, error) {
 	var dhcpRoutes []vishnetlink.Route
 	for _, nmstateRoute := range otherRoutes {
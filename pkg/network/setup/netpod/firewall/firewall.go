@@ -0,0 +1,372 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/link"
+)
+
+type nftable interface {
+	ApplyRuleset(ruleset string) error
+	ListTable(family nft.IPFamily, name string) ([]byte, error)
+}
+
+type Firewall struct {
+	nftable              nftable
+	isKernelModuleLoaded func(name string) bool
+}
+
+// Target is the device on which the firewall of an interface is enforced.
+// Traffic of the bridge and masquerade bindings is filtered on the tap device
+// using the bridge family, while traffic of the passt binding is filtered on
+// the pod interface using the inet family.
+type Target struct {
+	Family    nft.IPFamily
+	IfaceName string
+}
+
+const (
+	tablePrefix = "kubevirt_fw_"
+
+	ingressHookChain  = "ingress_hook"
+	egressHookChain   = "egress_hook"
+	ingressRulesChain = "ingress_rules"
+	egressRulesChain  = "egress_rules"
+
+	bridgeConntrackModule = "nf_conntrack_bridge"
+)
+
+type option func(*Firewall)
+
+func New(opts ...option) Firewall {
+	f := Firewall{nftable: nft.NFTBin{}, isKernelModuleLoaded: isKernelModuleLoaded}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
+
+func WithNftableAdapter(h nftable) option {
+	return func(f *Firewall) {
+		f.nftable = h
+	}
+}
+
+// TargetFor returns the target of the interface firewall, reporting false
+// when the interface binding does not support a firewall.
+func TargetFor(iface v1.Interface, network v1.Network, podIfaceName string) (Target, bool) {
+	switch {
+	case iface.Bridge != nil, iface.Masquerade != nil:
+		return Target{Family: nft.Bridge, IfaceName: link.GenerateTapDeviceName(podIfaceName, network)}, true
+	case iface.PasstBinding != nil:
+		return Target{Family: nft.Inet, IfaceName: podIfaceName}, true
+	}
+	return Target{}, false
+}
+
+func WithKernelModuleChecker(isLoaded func(name string) bool) option {
+	return func(f *Firewall) {
+		f.isKernelModuleLoaded = isLoaded
+	}
+}
+
+// HasRules reports whether the firewall defines rules to enforce.
+func HasRules(firewall *v1.InterfaceFirewall) bool {
+	return firewall != nil && (len(firewall.Ingress) > 0 || len(firewall.Egress) > 0)
+}
+
+func TableName(podIfaceName string) string {
+	return tablePrefix + podIfaceName
+}
+
+// Apply atomically replaces the firewall table with the given ruleset.
+func (f Firewall) Apply(ruleset string) error {
+	if err := f.nftable.ApplyRuleset(ruleset); err != nil {
+		return fmt.Errorf("failed to apply the firewall ruleset: %v", err)
+	}
+	return nil
+}
+
+// EnsureConntrack verifies that the connections of the target are tracked, as the ruleset accepts
+// the reply traffic according to the connection state.
+// The bridge family only tracks connections when the nf_conntrack_bridge module is loaded,
+// without it the reply traffic would be dropped.
+func (f Firewall) EnsureConntrack(target Target) error {
+	if target.Family != nft.Bridge || f.isKernelModuleLoaded(bridgeConntrackModule) {
+		return nil
+	}
+	return fmt.Errorf("the %s kernel module is required to filter the traffic of %s, but it is not loaded on the node",
+		bridgeConntrackModule, target.IfaceName)
+}
+
+func isKernelModuleLoaded(name string) bool {
+	_, err := os.Stat(filepath.Join("/sys/module", name))
+	return err == nil
+}
+
+// Ruleset renders the nft script replacing the firewall table of the pod interface.
+// The script only removes the table when the firewall has no rules.
+func Ruleset(podIfaceName string, target Target, firewall *v1.InterfaceFirewall) string {
+	var sb strings.Builder
+	sb.WriteString(nft.ResetTableScript(target.Family, TableName(podIfaceName)))
+	if !HasRules(firewall) {
+		return sb.String()
+	}
+
+	fmt.Fprintf(&sb, "table %s %s {\n", target.Family, TableName(podIfaceName))
+	if len(firewall.Ingress) > 0 {
+		writeChains(&sb, target, v1.FirewallDirectionIngress, firewall.Ingress)
+	}
+	if len(firewall.Egress) > 0 {
+		writeChains(&sb, target, v1.FirewallDirectionEgress, firewall.Egress)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func writeChains(sb *strings.Builder, target Target, direction v1.FirewallDirection, rules []v1.FirewallRule) {
+	hookChain, rulesChain := chainsByDirection(direction)
+
+	fmt.Fprintf(sb, "\tchain %s {\n", hookChain)
+	fmt.Fprintf(sb, "\t\ttype filter hook %s priority filter; policy accept;\n", hookByDirection(target.Family, direction))
+	fmt.Fprintf(sb, "\t\t%s %q jump %s\n", ifaceMatch(target.Family, direction), target.IfaceName, rulesChain)
+	sb.WriteString("\t}\n")
+
+	fmt.Fprintf(sb, "\tchain %s {\n", rulesChain)
+	sb.WriteString("\t\tct state established,related accept\n")
+	if target.Family == nft.Bridge {
+		sb.WriteString("\t\tether type != { ip, ip6 } accept\n")
+	}
+	sb.WriteString("\t\ticmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept\n")
+	if direction == v1.FirewallDirectionEgress {
+		sb.WriteString("\t\tudp sport 68 udp dport 67 accept\n")
+		sb.WriteString("\t\tudp sport 546 udp dport 547 accept\n")
+	} else {
+		sb.WriteString("\t\tudp sport 67 udp dport 68 accept\n")
+		sb.WriteString("\t\tudp sport 547 udp dport 546 accept\n")
+	}
+	for _, rule := range rules {
+		for _, statement := range ruleStatements(direction, rule) {
+			fmt.Fprintf(sb, "\t\t%s\n", statement)
+		}
+	}
+	sb.WriteString("\t\tdrop\n")
+	sb.WriteString("\t}\n")
+}
+
+// ruleStatements renders a rule into one statement per IP family of its CIDR blocks.
+// All the statements of a rule share its name as comment, which identifies their counters.
+func ruleStatements(direction v1.FirewallDirection, rule v1.FirewallRule) []string {
+	var matches []string
+	protocolMatch := protocolMatch(rule)
+
+	addrField := "saddr"
+	if direction == v1.FirewallDirectionEgress {
+		addrField = "daddr"
+	}
+	ipv4Blocks, ipv6Blocks := splitCIDRBlocksByFamily(rule.CIDRBlocks)
+	if len(ipv4Blocks) > 0 {
+		matches = append(matches, fmt.Sprintf("%s %s { %s }", nft.IPv4, addrField, strings.Join(ipv4Blocks, ", ")))
+	}
+	if len(ipv6Blocks) > 0 {
+		matches = append(matches, fmt.Sprintf("%s %s { %s }", nft.IPv6, addrField, strings.Join(ipv6Blocks, ", ")))
+	}
+	if len(matches) == 0 {
+		matches = append(matches, "")
+	}
+
+	verdict := fmt.Sprintf("counter %s comment %q", actionVerdict(rule.Action), rule.Name)
+	var statements []string
+	for _, addrMatch := range matches {
+		statement := strings.Join(nonEmpty(addrMatch, protocolMatch, verdict), " ")
+		statements = append(statements, statement)
+	}
+	return statements
+}
+
+// Counters reads the counters of the firewall rules from the table of the pod interface.
+// The counters are reported in the order of the rules, ingress first.
+func (f Firewall) Counters(podIfaceName string, target Target, firewall *v1.InterfaceFirewall) ([]v1.FirewallRuleStatus, error) {
+	output, err := f.nftable.ListTable(target.Family, TableName(podIfaceName))
+	if err != nil {
+		return nil, err
+	}
+
+	var ruleset nftRuleset
+	if err = json.Unmarshal(output, &ruleset); err != nil {
+		return nil, fmt.Errorf("failed to parse the firewall ruleset: %v", err)
+	}
+
+	type ruleKey struct {
+		chain string
+		name  string
+	}
+	countersByRule := map[ruleKey]nftCounter{}
+	for _, object := range ruleset.Objects {
+		if object.Rule == nil || object.Rule.Comment == "" {
+			continue
+		}
+		for _, expr := range object.Rule.Expr {
+			if expr.Counter == nil {
+				continue
+			}
+			key := ruleKey{chain: object.Rule.Chain, name: object.Rule.Comment}
+			counter := countersByRule[key]
+			counter.Packets += expr.Counter.Packets
+			counter.Bytes += expr.Counter.Bytes
+			countersByRule[key] = counter
+		}
+	}
+
+	var statuses []v1.FirewallRuleStatus
+	for _, direction := range []v1.FirewallDirection{v1.FirewallDirectionIngress, v1.FirewallDirectionEgress} {
+		_, rulesChain := chainsByDirection(direction)
+		for _, rule := range rulesByDirection(firewall, direction) {
+			counter := countersByRule[ruleKey{chain: rulesChain, name: rule.Name}]
+			statuses = append(statuses, v1.FirewallRuleStatus{
+				Name:      rule.Name,
+				Direction: direction,
+				Packets:   counter.Packets,
+				Bytes:     counter.Bytes,
+			})
+		}
+	}
+	return statuses, nil
+}
+
+type nftRuleset struct {
+	Objects []struct {
+		Rule *struct {
+			Chain   string `json:"chain"`
+			Comment string `json:"comment"`
+			Expr    []struct {
+				Counter *nftCounter `json:"counter"`
+			} `json:"expr"`
+		} `json:"rule"`
+	} `json:"nftables"`
+}
+
+type nftCounter struct {
+	Packets int64 `json:"packets"`
+	Bytes   int64 `json:"bytes"`
+}
+
+func rulesByDirection(firewall *v1.InterfaceFirewall, direction v1.FirewallDirection) []v1.FirewallRule {
+	if firewall == nil {
+		return nil
+	}
+	if direction == v1.FirewallDirectionEgress {
+		return firewall.Egress
+	}
+	return firewall.Ingress
+}
+
+func protocolMatch(rule v1.FirewallRule) string {
+	switch rule.Protocol {
+	case "":
+		return ""
+	case v1.FirewallProtocolICMP:
+		return "meta l4proto { icmp, ipv6-icmp }"
+	}
+
+	match := "meta l4proto " + strings.ToLower(string(rule.Protocol))
+	if len(rule.Ports) > 0 {
+		var ports []string
+		for _, port := range rule.Ports {
+			ports = append(ports, strconv.Itoa(int(port)))
+		}
+		match += fmt.Sprintf(" th dport { %s }", strings.Join(ports, ", "))
+	}
+	return match
+}
+
+func splitCIDRBlocksByFamily(cidrBlocks []string) (ipv4Blocks, ipv6Blocks []string) {
+	for _, cidrBlock := range cidrBlocks {
+		ip, _, err := net.ParseCIDR(cidrBlock)
+		if err != nil {
+			continue
+		}
+		if ip.To4() != nil {
+			ipv4Blocks = append(ipv4Blocks, cidrBlock)
+		} else {
+			ipv6Blocks = append(ipv6Blocks, cidrBlock)
+		}
+	}
+	return ipv4Blocks, ipv6Blocks
+}
+
+func actionVerdict(action v1.FirewallAction) string {
+	if action == v1.FirewallActionDeny {
+		return "drop"
+	}
+	return "accept"
+}
+
+func chainsByDirection(direction v1.FirewallDirection) (hookChain, rulesChain string) {
+	if direction == v1.FirewallDirectionEgress {
+		return egressHookChain, egressRulesChain
+	}
+	return ingressHookChain, ingressRulesChain
+}
+
+// hookByDirection returns the hook on which the traffic of the guest is filtered:
+// on the bridge, frames are received from the tap device on prerouting and sent to it on postrouting,
+// while passt sends and receives the guest traffic through local sockets.
+func hookByDirection(family nft.IPFamily, direction v1.FirewallDirection) string {
+	switch {
+	case family == nft.Bridge && direction == v1.FirewallDirectionEgress:
+		return "prerouting"
+	case family == nft.Bridge:
+		return "postrouting"
+	case direction == v1.FirewallDirectionEgress:
+		return "output"
+	default:
+		return "input"
+	}
+}
+
+func ifaceMatch(family nft.IPFamily, direction v1.FirewallDirection) string {
+	isGuestSending := direction == v1.FirewallDirectionEgress
+	if family == nft.Bridge && isGuestSending || family != nft.Bridge && !isGuestSending {
+		return "iifname"
+	}
+	return "oifname"
+}
+
+func nonEmpty(items ...string) []string {
+	var result []string
+	for _, item := range items {
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,229 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
+)
+
+var _ = Describe("interface firewall", func() {
+	bridgeTarget := firewall.Target{Family: nft.Bridge, IfaceName: "tap0"}
+
+	Context("target", func() {
+		podNetwork := *v1.DefaultPodNetwork()
+
+		It("should be the tap device for the masquerade binding", func() {
+			iface := v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}}
+			target, exists := firewall.TargetFor(iface, podNetwork, "eth0")
+			Expect(exists).To(BeTrue())
+			Expect(target).To(Equal(bridgeTarget))
+		})
+
+		It("should be the pod interface for the passt binding", func() {
+			iface := v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{PasstBinding: &v1.InterfacePasstBinding{}}}
+			target, exists := firewall.TargetFor(iface, podNetwork, "eth0")
+			Expect(exists).To(BeTrue())
+			Expect(target).To(Equal(firewall.Target{Family: nft.Inet, IfaceName: "eth0"}))
+		})
+
+		It("should not exist for the SR-IOV binding", func() {
+			iface := v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}}
+			_, exists := firewall.TargetFor(iface, podNetwork, "eth0")
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	Context("ruleset", func() {
+		It("should only remove the table when there are no rules", func() {
+			Expect(firewall.Ruleset("eth0", bridgeTarget, nil)).To(Equal(
+				"add table bridge kubevirt_fw_eth0\n" +
+					"delete table bridge kubevirt_fw_eth0\n"))
+		})
+
+		It("should filter the traffic of the tap device on the bridge", func() {
+			fw := &v1.InterfaceFirewall{
+				Ingress: []v1.FirewallRule{{
+					Name:       "ssh",
+					Protocol:   v1.FirewallProtocolTCP,
+					Ports:      []int32{22, 2222},
+					CIDRBlocks: []string{"10.0.0.0/8", "fd10::/64"},
+				}},
+				Egress: []v1.FirewallRule{{Name: "no-ping", Action: v1.FirewallActionDeny, Protocol: v1.FirewallProtocolICMP}, {Name: "all"}},
+			}
+
+			Expect(firewall.Ruleset("eth0", bridgeTarget, fw)).To(Equal(`add table bridge kubevirt_fw_eth0
+delete table bridge kubevirt_fw_eth0
+table bridge kubevirt_fw_eth0 {
+	chain ingress_hook {
+		type filter hook postrouting priority filter; policy accept;
+		oifname "tap0" jump ingress_rules
+	}
+	chain ingress_rules {
+		ct state established,related accept
+		ether type != { ip, ip6 } accept
+		icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept
+		udp sport 67 udp dport 68 accept
+		udp sport 547 udp dport 546 accept
+		ip saddr { 10.0.0.0/8 } meta l4proto tcp th dport { 22, 2222 } counter accept comment "ssh"
+		ip6 saddr { fd10::/64 } meta l4proto tcp th dport { 22, 2222 } counter accept comment "ssh"
+		drop
+	}
+	chain egress_hook {
+		type filter hook prerouting priority filter; policy accept;
+		iifname "tap0" jump egress_rules
+	}
+	chain egress_rules {
+		ct state established,related accept
+		ether type != { ip, ip6 } accept
+		icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept
+		udp sport 68 udp dport 67 accept
+		udp sport 546 udp dport 547 accept
+		meta l4proto { icmp, ipv6-icmp } counter drop comment "no-ping"
+		counter accept comment "all"
+		drop
+	}
+}
+`))
+		})
+
+		It("should filter the traffic of the pod interface on the local hooks for passt", func() {
+			fw := &v1.InterfaceFirewall{Egress: []v1.FirewallRule{{Name: "dns", Protocol: v1.FirewallProtocolUDP, Ports: []int32{53}}}}
+
+			Expect(firewall.Ruleset("eth0", firewall.Target{Family: nft.Inet, IfaceName: "eth0"}, fw)).To(Equal(`add table inet kubevirt_fw_eth0
+delete table inet kubevirt_fw_eth0
+table inet kubevirt_fw_eth0 {
+	chain egress_hook {
+		type filter hook output priority filter; policy accept;
+		oifname "eth0" jump egress_rules
+	}
+	chain egress_rules {
+		ct state established,related accept
+		icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept
+		udp sport 68 udp dport 67 accept
+		udp sport 546 udp dport 547 accept
+		meta l4proto udp th dport { 53 } counter accept comment "dns"
+		drop
+	}
+}
+`))
+		})
+	})
+
+	Context("apply", func() {
+		It("should apply the ruleset", func() {
+			nftStub := &nftableStub{}
+			Expect(firewall.New(firewall.WithNftableAdapter(nftStub)).Apply("ruleset")).To(Succeed())
+			Expect(nftStub.appliedRuleset).To(Equal("ruleset"))
+		})
+
+		It("should fail when the ruleset cannot be applied", func() {
+			nftStub := &nftableStub{applyErr: errors.New("test")}
+			Expect(firewall.New(firewall.WithNftableAdapter(nftStub)).Apply("ruleset")).To(MatchError(ContainSubstring("test")))
+		})
+	})
+
+	Context("connection tracking", func() {
+		moduleLoaded := func(loaded bool) func(string) bool {
+			return func(name string) bool {
+				Expect(name).To(Equal("nf_conntrack_bridge"))
+				return loaded
+			}
+		}
+
+		It("should be available on the bridge when the bridge conntrack module is loaded", func() {
+			fw := firewall.New(firewall.WithKernelModuleChecker(moduleLoaded(true)))
+			Expect(fw.EnsureConntrack(bridgeTarget)).To(Succeed())
+		})
+
+		It("should fail on the bridge when the bridge conntrack module is not loaded", func() {
+			fw := firewall.New(firewall.WithKernelModuleChecker(moduleLoaded(false)))
+			Expect(fw.EnsureConntrack(bridgeTarget)).To(MatchError(ContainSubstring("nf_conntrack_bridge")))
+		})
+
+		It("should not require the bridge conntrack module on the pod interface", func() {
+			fw := firewall.New(firewall.WithKernelModuleChecker(func(string) bool { return false }))
+			Expect(fw.EnsureConntrack(firewall.Target{Family: nft.Inet, IfaceName: "eth0"})).To(Succeed())
+		})
+	})
+
+	Context("counters", func() {
+		It("should sum the counters of the statements of each rule", func() {
+			nftStub := &nftableStub{table: `{"nftables": [
+{"metainfo": {"version": "1.0.9", "json_schema_version": 1}},
+{"table": {"family": "bridge", "name": "kubevirt_fw_eth0", "handle": 1}},
+{"chain": {"family": "bridge", "table": "kubevirt_fw_eth0", "name": "ingress_rules", "handle": 2}},
+{"rule": {"family": "bridge", "table": "kubevirt_fw_eth0", "chain": "ingress_rules", "handle": 3,
+  "expr": [{"match": {"op": "in", "left": {"ct": {"key": "state"}}, "right": ["established", "related"]}}, {"accept": null}]}},
+{"rule": {"family": "bridge", "table": "kubevirt_fw_eth0", "chain": "ingress_rules", "handle": 4, "comment": "ssh",
+  "expr": [{"counter": {"packets": 2, "bytes": 120}}, {"accept": null}]}},
+{"rule": {"family": "bridge", "table": "kubevirt_fw_eth0", "chain": "ingress_rules", "handle": 5, "comment": "ssh",
+  "expr": [{"counter": {"packets": 1, "bytes": 80}}, {"accept": null}]}},
+{"rule": {"family": "bridge", "table": "kubevirt_fw_eth0", "chain": "egress_rules", "handle": 6, "comment": "ssh",
+  "expr": [{"counter": {"packets": 7, "bytes": 700}}, {"drop": null}]}}
+]}`}
+			fw := &v1.InterfaceFirewall{
+				Ingress: []v1.FirewallRule{{Name: "ssh"}, {Name: "web"}},
+				Egress:  []v1.FirewallRule{{Name: "ssh"}},
+			}
+
+			counters, err := firewall.New(firewall.WithNftableAdapter(nftStub)).Counters("eth0", bridgeTarget, fw)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nftStub.listedTable).To(Equal("bridge kubevirt_fw_eth0"))
+			Expect(counters).To(Equal([]v1.FirewallRuleStatus{
+				{Name: "ssh", Direction: v1.FirewallDirectionIngress, Packets: 3, Bytes: 200},
+				{Name: "web", Direction: v1.FirewallDirectionIngress},
+				{Name: "ssh", Direction: v1.FirewallDirectionEgress, Packets: 7, Bytes: 700},
+			}))
+		})
+
+		It("should fail when the table cannot be listed", func() {
+			nftStub := &nftableStub{listErr: errors.New("test")}
+			_, err := firewall.New(firewall.WithNftableAdapter(nftStub)).Counters("eth0", bridgeTarget, &v1.InterfaceFirewall{})
+			Expect(err).To(MatchError("test"))
+		})
+	})
+})
+
+type nftableStub struct {
+	appliedRuleset string
+	applyErr       error
+	listedTable    string
+	table          string
+	listErr        error
+}
+
+func (n *nftableStub) ApplyRuleset(ruleset string) error {
+	n.appliedRuleset = ruleset
+	return n.applyErr
+}
+
+func (n *nftableStub) ListTable(family nft.IPFamily, name string) ([]byte, error) {
+	n.listedTable = string(family) + " " + name
+	return []byte(n.table), n.listErr
+}
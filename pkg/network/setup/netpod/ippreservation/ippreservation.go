@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ippreservation
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/link"
+)
+
+type nftable interface {
+	ApplyRuleset(ruleset string) error
+}
+
+// Translator lets a migrated bridge binding guest keep the IPv4 address and gateway it received
+// before the migration. The guest addresses are statelessly rewritten to the addresses of the
+// pod on the bridge, in the IP header of the traffic and in the ARP payload, so that the pod
+// network only ever sees the pod addresses.
+// The guest is served its address as a single host, so that its gateway is the only neighbor
+// it resolves on the pod network.
+type Translator struct {
+	nftable nftable
+}
+
+// Translation maps the addresses the guest keeps to the addresses of the pod.
+type Translation struct {
+	GuestIP      string
+	GuestGateway string
+	PodIP        string
+	PodGateway   string
+}
+
+const tablePrefix = "kubevirt_ipp_"
+
+type option func(*Translator)
+
+func New(opts ...option) Translator {
+	t := Translator{nftable: nft.NFTBin{}}
+	for _, opt := range opts {
+		opt(&t)
+	}
+	return t
+}
+
+func WithNftableAdapter(h nftable) option {
+	return func(t *Translator) {
+		t.nftable = h
+	}
+}
+
+func (t Translator) Setup(podIfaceName string, vmiNetwork v1.Network, translation Translation) error {
+	if err := t.nftable.ApplyRuleset(Ruleset(podIfaceName, vmiNetwork, translation)); err != nil {
+		return fmt.Errorf("failed to translate the guest addresses of %s: %v", podIfaceName, err)
+	}
+	return nil
+}
+
+// Ruleset renders the nft script replacing the bridge table which translates the guest addresses.
+// The translation happens before the guest traffic is filtered by the interface firewall.
+func Ruleset(podIfaceName string, vmiNetwork v1.Network, translation Translation) string {
+	tableName := tablePrefix + podIfaceName
+	tapName := link.GenerateTapDeviceName(podIfaceName, vmiNetwork)
+	podNicName := link.GenerateNewBridgedVmiInterfaceName(podIfaceName)
+
+	var sb strings.Builder
+	sb.WriteString(nft.ResetTableScript(nft.Bridge, tableName))
+	fmt.Fprintf(&sb, "table %s %s {\n", nft.Bridge, tableName)
+
+	sb.WriteString("\tchain prerouting {\n")
+	sb.WriteString("\t\ttype filter hook prerouting priority dstnat; policy accept;\n")
+	writeTranslation(&sb, tapName, "saddr", translation.GuestIP, translation.PodIP)
+	writeTranslation(&sb, podNicName, "daddr", translation.PodIP, translation.GuestIP)
+	if translation.GuestGateway != translation.PodGateway {
+		writeTranslation(&sb, tapName, "daddr", translation.GuestGateway, translation.PodGateway)
+		writeTranslation(&sb, podNicName, "saddr", translation.PodGateway, translation.GuestGateway)
+	}
+	sb.WriteString("\t}\n")
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func writeTranslation(sb *strings.Builder, iifName, addrField, fromIP, toIP string) {
+	fmt.Fprintf(sb, "\t\tiifname %q %s %s %s %s %s set %s\n", iifName, nft.IPv4, addrField, fromIP, nft.IPv4, addrField, toIP)
+	fmt.Fprintf(sb, "\t\tiifname %q arp %s ip %s arp %s ip set %s\n", iifName, addrField, fromIP, addrField, toIP)
+}
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ippreservation_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/ippreservation"
+)
+
+var _ = Describe("Guest address translation", func() {
+	const podIfaceName = "eth0"
+
+	network := *v1.DefaultPodNetwork()
+
+	translation := ippreservation.Translation{
+		GuestIP:      "10.244.1.5",
+		GuestGateway: "10.244.1.1",
+		PodIP:        "10.244.2.7",
+		PodGateway:   "10.244.2.1",
+	}
+
+	It("should translate the guest address and gateway to the pod ones", func() {
+		Expect(ippreservation.Ruleset(podIfaceName, network, translation)).To(Equal(`add table bridge kubevirt_ipp_eth0
+delete table bridge kubevirt_ipp_eth0
+table bridge kubevirt_ipp_eth0 {
+	chain prerouting {
+		type filter hook prerouting priority dstnat; policy accept;
+		iifname "tap0" ip saddr 10.244.1.5 ip saddr set 10.244.2.7
+		iifname "tap0" arp saddr ip 10.244.1.5 arp saddr ip set 10.244.2.7
+		iifname "eth0-nic" ip daddr 10.244.2.7 ip daddr set 10.244.1.5
+		iifname "eth0-nic" arp daddr ip 10.244.2.7 arp daddr ip set 10.244.1.5
+		iifname "tap0" ip daddr 10.244.1.1 ip daddr set 10.244.2.1
+		iifname "tap0" arp daddr ip 10.244.1.1 arp daddr ip set 10.244.2.1
+		iifname "eth0-nic" ip saddr 10.244.2.1 ip saddr set 10.244.1.1
+		iifname "eth0-nic" arp saddr ip 10.244.2.1 arp saddr ip set 10.244.1.1
+	}
+}
+`))
+	})
+
+	It("should not translate the gateway when the pod has the same one", func() {
+		sameGateway := translation
+		sameGateway.PodGateway = sameGateway.GuestGateway
+
+		Expect(ippreservation.Ruleset(podIfaceName, network, sameGateway)).To(Equal(`add table bridge kubevirt_ipp_eth0
+delete table bridge kubevirt_ipp_eth0
+table bridge kubevirt_ipp_eth0 {
+	chain prerouting {
+		type filter hook prerouting priority dstnat; policy accept;
+		iifname "tap0" ip saddr 10.244.1.5 ip saddr set 10.244.2.7
+		iifname "tap0" arp saddr ip 10.244.1.5 arp saddr ip set 10.244.2.7
+		iifname "eth0-nic" ip daddr 10.244.2.7 ip daddr set 10.244.1.5
+		iifname "eth0-nic" arp daddr ip 10.244.2.7 arp daddr ip set 10.244.1.5
+	}
+}
+`))
+	})
+
+	It("should apply the ruleset", func() {
+		nftable := &nftableStub{}
+		translator := ippreservation.New(ippreservation.WithNftableAdapter(nftable))
+
+		Expect(translator.Setup(podIfaceName, network, translation)).To(Succeed())
+		Expect(nftable.rulesets).To(ConsistOf(ippreservation.Ruleset(podIfaceName, network, translation)))
+	})
+
+	It("should fail when the ruleset cannot be applied", func() {
+		translator := ippreservation.New(ippreservation.WithNftableAdapter(&nftableStub{err: errors.New("test")}))
+		Expect(translator.Setup(podIfaceName, network, translation)).NotTo(Succeed())
+	})
+})
+
+type nftableStub struct {
+	rulesets []string
+	err      error
+}
+
+func (n *nftableStub) ApplyRuleset(ruleset string) error {
+	n.rulesets = append(n.rulesets, ruleset)
+	return n.err
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipv6autoconfig
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/link"
+)
+
+type nftable interface {
+	ApplyRuleset(ruleset string) error
+}
+
+// IsolatedTap confines the IPv6 auto-configuration tap device to the guest tap device.
+// The advertised configuration therefore never leaks to the pod network, and in the DHCPv6 mode,
+// the guest DHCPv6 requests are only answered by the tap device responder.
+type IsolatedTap struct {
+	nftable nftable
+}
+
+const tablePrefix = "kubevirt_ra_"
+
+type option func(*IsolatedTap)
+
+func New(opts ...option) IsolatedTap {
+	t := IsolatedTap{nftable: nft.NFTBin{}}
+	for _, opt := range opts {
+		opt(&t)
+	}
+	return t
+}
+
+func WithNftableAdapter(h nftable) option {
+	return func(t *IsolatedTap) {
+		t.nftable = h
+	}
+}
+
+func (t IsolatedTap) Setup(podIfaceName string, vmiIface v1.Interface, vmiNetwork v1.Network) error {
+	if err := t.nftable.ApplyRuleset(Ruleset(podIfaceName, vmiIface, vmiNetwork)); err != nil {
+		return fmt.Errorf("failed to isolate the IPv6 auto-configuration tap of %s: %v", podIfaceName, err)
+	}
+	return nil
+}
+
+// Ruleset renders the nft script replacing the bridge table which isolates the IPv6 auto-configuration tap device.
+func Ruleset(podIfaceName string, vmiIface v1.Interface, vmiNetwork v1.Network) string {
+	tableName := tablePrefix + podIfaceName
+	raTapName := link.GenerateIPv6AutoConfigTapName(podIfaceName)
+	tapName := link.GenerateTapDeviceName(podIfaceName, vmiNetwork)
+	podNicName := link.GenerateNewBridgedVmiInterfaceName(podIfaceName)
+	managed := vmiIface.Bridge.AutoConfig.Mode != v1.IPv6AutoConfigModeSLAAC
+
+	var sb strings.Builder
+	sb.WriteString(nft.ResetTableScript(nft.Bridge, tableName))
+	fmt.Fprintf(&sb, "table %s %s {\n", nft.Bridge, tableName)
+
+	sb.WriteString("\tchain forward {\n")
+	sb.WriteString("\t\ttype filter hook forward priority filter; policy accept;\n")
+	fmt.Fprintf(&sb, "\t\tiifname %q oifname != %q drop\n", raTapName, tapName)
+	fmt.Fprintf(&sb, "\t\toifname %q iifname != %q drop\n", raTapName, tapName)
+	fmt.Fprintf(&sb, "\t\toifname %q icmpv6 type nd-router-solicit accept\n", raTapName)
+	if managed {
+		fmt.Fprintf(&sb, "\t\toifname %q udp dport 547 accept\n", raTapName)
+	}
+	fmt.Fprintf(&sb, "\t\toifname %q drop\n", raTapName)
+	if managed {
+		fmt.Fprintf(&sb, "\t\tiifname %q oifname %q udp dport 547 drop\n", tapName, podNicName)
+	}
+	sb.WriteString("\t}\n")
+
+	sb.WriteString("\tchain output {\n")
+	sb.WriteString("\t\ttype filter hook output priority filter; policy accept;\n")
+	fmt.Fprintf(&sb, "\t\toifname %q drop\n", raTapName)
+	sb.WriteString("\t}\n")
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
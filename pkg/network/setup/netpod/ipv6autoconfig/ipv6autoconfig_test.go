@@ -0,0 +1,110 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipv6autoconfig_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/ipv6autoconfig"
+)
+
+var _ = Describe("IPv6 auto-configuration tap isolation", func() {
+	network := v1.Network{
+		Name:          "blue",
+		NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}},
+	}
+
+	newIface := func(mode v1.IPv6AutoConfigMode) v1.Interface {
+		return v1.Interface{
+			Name: "blue",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				Bridge: &v1.InterfaceBridge{AutoConfig: &v1.IPv6AutoConfig{Mode: mode}},
+			},
+		}
+	}
+
+	It("should let the DHCPv6 requests of the guest only reach the responder in the DHCPv6 mode", func() {
+		Expect(ipv6autoconfig.Ruleset("pod16477688c0e", newIface(""), network)).To(Equal(`add table bridge kubevirt_ra_pod16477688c0e
+delete table bridge kubevirt_ra_pod16477688c0e
+table bridge kubevirt_ra_pod16477688c0e {
+	chain forward {
+		type filter hook forward priority filter; policy accept;
+		iifname "16477688c0e-ra" oifname != "tap16477688c0e" drop
+		oifname "16477688c0e-ra" iifname != "tap16477688c0e" drop
+		oifname "16477688c0e-ra" icmpv6 type nd-router-solicit accept
+		oifname "16477688c0e-ra" udp dport 547 accept
+		oifname "16477688c0e-ra" drop
+		iifname "tap16477688c0e" oifname "16477688c0e-nic" udp dport 547 drop
+	}
+	chain output {
+		type filter hook output priority filter; policy accept;
+		oifname "16477688c0e-ra" drop
+	}
+}
+`))
+	})
+
+	It("should only pass router solicitations to the responder in the SLAAC mode", func() {
+		Expect(ipv6autoconfig.Ruleset("pod16477688c0e", newIface(v1.IPv6AutoConfigModeSLAAC), network)).To(Equal(`add table bridge kubevirt_ra_pod16477688c0e
+delete table bridge kubevirt_ra_pod16477688c0e
+table bridge kubevirt_ra_pod16477688c0e {
+	chain forward {
+		type filter hook forward priority filter; policy accept;
+		iifname "16477688c0e-ra" oifname != "tap16477688c0e" drop
+		oifname "16477688c0e-ra" iifname != "tap16477688c0e" drop
+		oifname "16477688c0e-ra" icmpv6 type nd-router-solicit accept
+		oifname "16477688c0e-ra" drop
+	}
+	chain output {
+		type filter hook output priority filter; policy accept;
+		oifname "16477688c0e-ra" drop
+	}
+}
+`))
+	})
+
+	It("should apply the ruleset", func() {
+		nftable := &nftableStub{}
+		isolatedTap := ipv6autoconfig.New(ipv6autoconfig.WithNftableAdapter(nftable))
+
+		Expect(isolatedTap.Setup("pod16477688c0e", newIface(""), network)).To(Succeed())
+		Expect(nftable.rulesets).To(ConsistOf(ipv6autoconfig.Ruleset("pod16477688c0e", newIface(""), network)))
+	})
+
+	It("should fail when the ruleset cannot be applied", func() {
+		isolatedTap := ipv6autoconfig.New(ipv6autoconfig.WithNftableAdapter(&nftableStub{err: errors.New("test")}))
+		Expect(isolatedTap.Setup("pod16477688c0e", newIface(""), network)).NotTo(Succeed())
+	})
+})
+
+type nftableStub struct {
+	rulesets []string
+	err      error
+}
+
+func (n *nftableStub) ApplyRuleset(ruleset string) error {
+	n.rulesets = append(n.rulesets, ruleset)
+	return n.err
+}
@@ -36,6 +36,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/ippreservation"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/ipv6autoconfig"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
@@ -55,6 +57,14 @@ type masqueradeAdapter interface {
 	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
 }
 
+type ipv6AutoConfigAdapter interface {
+	Setup(podIfaceName string, vmiIface v1.Interface, vmiNetwork v1.Network) error
+}
+
+type ipPreservationAdapter interface {
+	Setup(podIfaceName string, vmiNetwork v1.Network, translation ippreservation.Translation) error
+}
+
 type cacheCreator interface {
 	New(filePath string) *cache.Cache
 }
@@ -72,17 +82,28 @@ type NetPod struct {
 	ownerID          int
 	queuesCapByIface map[string]int
 
-	nmstateAdapter    nmstateAdapter
-	masqueradeAdapter masqueradeAdapter
+	nmstateAdapter        nmstateAdapter
+	masqueradeAdapter     masqueradeAdapter
+	ipv6AutoConfigAdapter ipv6AutoConfigAdapter
+	ipPreservationAdapter ipPreservationAdapter
 
 	cacheCreator cacheCreator
 	state        *State
 
 	bindingPluginsByName map[string]v1.InterfaceBindingPlugin
 
+	preservedAddressesByIface map[string]PreservedAddress
+
 	log *log.FilteredLogger
 }
 
+// PreservedAddress is the IPv4 address, in CIDR notation, and the gateway a bridge binding guest
+// keeps across a live migration.
+type PreservedAddress struct {
+	IP      string
+	Gateway string
+}
+
 type option func(*NetPod)
 
 func NewNetPod(vmiNetworks []v1.Network, vmiIfaces []v1.Interface, vmiUID string, podPID, ownerID, queuesCapacity int, state *State, opts ...option) NetPod {
@@ -94,8 +115,10 @@ func NewNetPod(vmiNetworks []v1.Network, vmiIfaces []v1.Interface, vmiUID string
 		ownerID:       ownerID,
 		state:         state,
 
-		nmstateAdapter:    nmstate.New(),
-		masqueradeAdapter: masquerade.New(),
+		nmstateAdapter:        nmstate.New(),
+		masqueradeAdapter:     masquerade.New(),
+		ipv6AutoConfigAdapter: ipv6autoconfig.New(),
+		ipPreservationAdapter: ippreservation.New(),
 
 		cacheCreator:         cache.CacheCreator{},
 		bindingPluginsByName: map[string]v1.InterfaceBindingPlugin{},
@@ -123,6 +146,18 @@ func WithMasqueradeAdapter(h masqueradeAdapter) option {
 	}
 }
 
+func WithIPv6AutoConfigAdapter(h ipv6AutoConfigAdapter) option {
+	return func(n *NetPod) {
+		n.ipv6AutoConfigAdapter = h
+	}
+}
+
+func WithIPPreservationAdapter(h ipPreservationAdapter) option {
+	return func(n *NetPod) {
+		n.ipPreservationAdapter = h
+	}
+}
+
 func WithCacheCreator(c cacheCreator) option {
 	return func(n *NetPod) {
 		n.cacheCreator = c
@@ -141,6 +176,13 @@ func WithLogger(logger *log.FilteredLogger) option {
 	}
 }
 
+// WithPreservedAddresses sets the addresses the guest keeps on the migration target, by interface name.
+func WithPreservedAddresses(addresses map[string]PreservedAddress) option {
+	return func(n *NetPod) {
+		n.preservedAddressesByIface = addresses
+	}
+}
+
 func WithVMIIfaceStatuses(vmiIfaceStatuses []v1.VirtualMachineInstanceNetworkInterface) option {
 	return func(n *NetPod) {
 		n.vmiIfaceStatuses = vmiIfaceStatuses
@@ -249,7 +291,15 @@ func (n NetPod) config(currentStatus *nmstate.Status) error {
 
 	// Configuring NAT (nftables) is temporary done outside nmstate.
 	// This should be eventually embedded into the nmstate desired state and applied by it.
-	return n.setupNAT(desiredSpec, currentStatus)
+	if err = n.setupNAT(desiredSpec, currentStatus); err != nil {
+		return err
+	}
+
+	if err = n.setupIPv6AutoConfig(currentStatus); err != nil {
+		return err
+	}
+
+	return n.setupIPPreservation(currentStatus)
 }
 
 func (n NetPod) composeDesiredSpec(currentStatus *nmstate.Status) (*nmstate.Spec, error) {
@@ -400,7 +450,24 @@ func (n NetPod) bridgeBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceS
 		Metadata:   &nmstate.IfaceMetadata{NetworkName: vmiNetworkName},
 	}
 
-	return []nmstate.Interface{bridgeIface, podIface, tapIface, dummyIface}, nil
+	ifacesSpec := []nmstate.Interface{bridgeIface, podIface, tapIface, dummyIface}
+
+	if vmispec.HasIPv6AutoConfig(n.vmiSpecIfaces[vmiIfaceIndex]) {
+		ifacesSpec = append(ifacesSpec, nmstate.Interface{
+			Name:       link.GenerateIPv6AutoConfigTapName(podIfaceName),
+			TypeName:   nmstate.TypeTap,
+			State:      nmstate.IfaceStateUp,
+			MTU:        podStatusIface.MTU,
+			Controller: bridgeIface.Name,
+			Tap: &nmstate.TapDevice{
+				UID: n.ownerID,
+				GID: n.ownerID,
+			},
+			Metadata: &nmstate.IfaceMetadata{Pid: n.podPID, NetworkName: vmiNetworkName},
+		})
+	}
+
+	return ifacesSpec, nil
 }
 
 func (n NetPod) networkQueues(vmiIfaceIndex int) int {
@@ -545,6 +612,62 @@ func (n NetPod) setupNAT(desiredSpec *nmstate.Spec, currentStatus *nmstate.Statu
 	return n.masqueradeAdapter.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface[0])
 }
 
+// setupIPv6AutoConfig isolates the IPv6 auto-configuration tap devices, which are created by nmstate.
+func (n NetPod) setupIPv6AutoConfig(currentStatus *nmstate.Status) error {
+	autoConfigIfaces := vmispec.FilterInterfacesSpec(n.vmiSpecIfaces, func(i v1.Interface) bool {
+		return vmispec.HasIPv6AutoConfig(i) && i.State != v1.InterfaceStateAbsent
+	})
+	if len(autoConfigIfaces) == 0 {
+		return nil
+	}
+	podIfaceNameByVMINetwork := createNetworkNameScheme(n.vmiSpecNets, n.vmiIfaceStatuses, currentStatus.Interfaces)
+	for _, iface := range autoConfigIfaces {
+		vmiNetwork := vmispec.LookupNetworkByName(n.vmiSpecNets, iface.Name)
+		if err := n.ipv6AutoConfigAdapter.Setup(podIfaceNameByVMINetwork[iface.Name], iface, *vmiNetwork); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n NetPod) setupIPPreservation(currentStatus *nmstate.Status) error {
+	if len(n.preservedAddressesByIface) == 0 {
+		return nil
+	}
+	podIfaceStatusByName := ifaceStatusByName(currentStatus.Interfaces)
+	podIfaceNameByVMINetwork := createNetworkNameScheme(n.vmiSpecNets, n.vmiIfaceStatuses, currentStatus.Interfaces)
+	for _, iface := range n.vmiSpecIfaces {
+		preservedAddress, exists := n.preservedAddressesByIface[iface.Name]
+		if !exists || iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		podIfaceName := podIfaceNameByVMINetwork[iface.Name]
+		podIP := firstIPGlobalUnicast(podIfaceStatusByName[podIfaceName].IPv4)
+		if podIP == nil {
+			return fmt.Errorf("pod link (%s) has no IPv4 address to translate the guest address to", podIfaceName)
+		}
+		linkRoutes, err := filterIPv4RoutesByInterface(currentStatus, podIfaceName)
+		if err != nil {
+			return err
+		}
+		guestIP, _, err := net.ParseCIDR(preservedAddress.IP)
+		if err != nil {
+			return err
+		}
+		translation := ippreservation.Translation{
+			GuestIP:      guestIP.String(),
+			GuestGateway: preservedAddress.Gateway,
+			PodIP:        podIP.IP,
+			PodGateway:   linkRoutes[0].NextHopAddress,
+		}
+		vmiNetwork := vmispec.LookupNetworkByName(n.vmiSpecNets, iface.Name)
+		if err := n.ipPreservationAdapter.Setup(podIfaceName, *vmiNetwork, translation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (n NetPod) lookupMasquradeBridge(desiredIfacesSpec []nmstate.Interface) *nmstate.Interface {
 	masqueradeIfaces := vmispec.FilterInterfacesSpec(n.vmiSpecIfaces, func(i v1.Interface) bool {
 		return i.Masquerade != nil
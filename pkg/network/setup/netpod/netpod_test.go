@@ -42,6 +42,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/driver/procsys"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/ippreservation"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
@@ -423,6 +424,90 @@ var _ = Describe("netpod", func() {
 		Expect(cache.ReadDHCPInterfaceCache(&baseCacheCreator, "0", "eth0")).To(Equal(expDHCPConfig))
 	})
 
+	It("setup bridge binding preserving the guest address of a migrated VMI", func() {
+		const (
+			podGatewayIP4Address   = "10.222.222.254"
+			guestIP4CIDR           = "10.111.111.5/24"
+			guestGatewayIP4Address = "10.111.111.1"
+
+			podIfaceOrignalMAC = "12:34:56:78:90:ab"
+		)
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: podIfaceOrignalMAC,
+				MTU:        1500,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        primaryIPv4Address,
+						PrefixLen: 30,
+					}},
+				},
+			}},
+			Routes: nmstate.Routes{Running: []nmstate.Route{
+				{
+					Destination:      "0.0.0.0/0",
+					NextHopInterface: "eth0",
+					NextHopAddress:   podGatewayIP4Address,
+				},
+				{
+					Destination:      "192.168.1.0/24",
+					NextHopInterface: "eth0",
+					NextHopAddress:   podGatewayIP4Address,
+				},
+				{
+					Destination:      "10.222.0.0/16",
+					NextHopInterface: "eth0",
+				},
+			}},
+		}}
+
+		vmiIface := v1.Interface{
+			Name: defaultPodNetworkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				Bridge: &v1.InterfaceBridge{MigrationMode: v1.BridgeMigrationModePreserveIP},
+			},
+		}
+		ipPreservationStub := ipPreservationStub{}
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{vmiIface},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithIPPreservationAdapter(&ipPreservationStub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+			netpod.WithPreservedAddresses(map[string]netpod.PreservedAddress{
+				defaultPodNetworkName: {IP: guestIP4CIDR, Gateway: guestGatewayIP4Address},
+			}),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+
+		expDHCPConfig, err := expectedDHCPConfig(
+			"10.111.111.5/32",
+			podIfaceOrignalMAC,
+			guestGatewayIP4Address,
+			"192.168.1.0/24",
+			"10.222.0.0/16",
+		)
+		Expect(err).NotTo(HaveOccurred())
+		guestGatewayRoute := vishnetlink.Route{Dst: &net.IPNet{IP: net.ParseIP(guestGatewayIP4Address), Mask: net.CIDRMask(32, 32)}}
+		routes := append([]vishnetlink.Route{guestGatewayRoute}, *expDHCPConfig.Routes...)
+		expDHCPConfig.Routes = &routes
+		Expect(cache.ReadDHCPInterfaceCache(&baseCacheCreator, "0", "eth0")).To(Equal(expDHCPConfig))
+
+		Expect(ipPreservationStub.podIfaceNames).To(ConsistOf("eth0"))
+		Expect(ipPreservationStub.translations).To(ConsistOf(ippreservation.Translation{
+			GuestIP:      "10.111.111.5",
+			GuestGateway: guestGatewayIP4Address,
+			PodIP:        primaryIPv4Address,
+			PodGateway:   podGatewayIP4Address,
+		}))
+	})
+
 	It("setup bridge binding with IP custom primary interface name", func() {
 		const (
 			defaultGatewayIP4Address = "10.222.222.254"
@@ -1070,6 +1155,50 @@ var _ = Describe("netpod", func() {
 			Expect(masqstub.podIfaceSpec.Name).To(Equal("eth0"))
 			Expect(masqstub.vmiIfaceSpec.Name).To(Equal(defaultPodNetworkName))
 		})
+
+		Context("with IPv6 auto-configuration", func() {
+			BeforeEach(func() {
+				specInterfaces[1].Bridge.AutoConfig = &v1.IPv6AutoConfig{Mode: v1.IPv6AutoConfigModeSLAAC}
+			})
+
+			It("setup secondary bridge binding with an isolated IPv6 auto-configuration tap", func() {
+				ipv6AutoConfigStub := ipv6AutoConfigStub{}
+				netPod := netpod.NewNetPod(
+					specNetworks,
+					specInterfaces,
+					vmiUID, 0, 0, 0, state,
+					netpod.WithNMStateAdapter(&nmstatestub),
+					netpod.WithMasqueradeAdapter(&masqstub),
+					netpod.WithIPv6AutoConfigAdapter(&ipv6AutoConfigStub),
+					netpod.WithCacheCreator(&baseCacheCreator),
+				)
+				Expect(netPod.Setup()).To(Succeed())
+				Expect(nmstatestub.spec.Interfaces).To(ContainElement(nmstate.Interface{
+					Name:       "914f438d88d-ra",
+					TypeName:   nmstate.TypeTap,
+					State:      nmstate.IfaceStateUp,
+					MTU:        1500,
+					Controller: "k6t-914f438d88d",
+					Tap:        &nmstate.TapDevice{UID: 0, GID: 0},
+					Metadata:   &nmstate.IfaceMetadata{Pid: 0, NetworkName: secondaryNetworkName},
+				}))
+				Expect(ipv6AutoConfigStub.podIfaceNames).To(ConsistOf(secondaryPodInterfaceName))
+				Expect(ipv6AutoConfigStub.vmiIfaces).To(ConsistOf(specInterfaces[1]))
+			})
+
+			It("fails setup when the IPv6 auto-configuration tap isolation fails", func() {
+				netPod := netpod.NewNetPod(
+					specNetworks,
+					specInterfaces,
+					vmiUID, 0, 0, 0, state,
+					netpod.WithNMStateAdapter(&nmstatestub),
+					netpod.WithMasqueradeAdapter(&masqstub),
+					netpod.WithIPv6AutoConfigAdapter(&ipv6AutoConfigStub{setupErr: errIPv6AutoConfigSetup}),
+					netpod.WithCacheCreator(&baseCacheCreator),
+				)
+				Expect(netPod.Setup()).To(MatchError(errIPv6AutoConfigSetup))
+			})
+		})
 	})
 
 	It("should preserve network queue count if interface is already in the domain", func() {
@@ -1958,6 +2087,34 @@ func expectedDHCPConfig(podIfaceCIDR, podIfaceMAC, defaultGW, staticRouteDst, st
 	}, nil
 }
 
+type ipPreservationStub struct {
+	podIfaceNames []string
+	translations  []ippreservation.Translation
+}
+
+func (i *ipPreservationStub) Setup(podIfaceName string, _ v1.Network, translation ippreservation.Translation) error {
+	i.podIfaceNames = append(i.podIfaceNames, podIfaceName)
+	i.translations = append(i.translations, translation)
+	return nil
+}
+
+type ipv6AutoConfigStub struct {
+	setupErr      error
+	podIfaceNames []string
+	vmiIfaces     []v1.Interface
+}
+
+var errIPv6AutoConfigSetup = errors.New("IPv6 auto-configuration Setup Test Error")
+
+func (i *ipv6AutoConfigStub) Setup(podIfaceName string, vmiIface v1.Interface, _ v1.Network) error {
+	if i.setupErr != nil {
+		return i.setupErr
+	}
+	i.podIfaceNames = append(i.podIfaceNames, podIfaceName)
+	i.vmiIfaces = append(i.vmiIfaces, vmiIface)
+	return nil
+}
+
 type netnsStub struct {
 	shouldFail bool
 }
@@ -124,6 +124,7 @@ func (c *NetStat) UpdateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domai
 	interfacesStatus = ifacesStatusFromMultus(interfacesStatus, multusStatusNetworksByName, vmiInterfacesSpecByName)
 
 	interfacesStatus = restorePodIfaceNames(interfacesStatus, vmi.Status.Interfaces)
+	interfacesStatus = restoreFirewallRules(interfacesStatus, vmi.Status.Interfaces)
 	vmi.Status.Interfaces = interfacesStatus
 
 	c.removeAbsentIfacesFromVolatileCache(vmi)
@@ -170,6 +171,23 @@ func restorePodIfaceNames(
 	return interfacesStatus
 }
 
+// restoreFirewallRules restores the firewall rule counters, which are collected when the firewall is set up
+func restoreFirewallRules(
+	interfacesStatus []v1.VirtualMachineInstanceNetworkInterface,
+	prevIfaceStatuses []v1.VirtualMachineInstanceNetworkInterface,
+) []v1.VirtualMachineInstanceNetworkInterface {
+	for i, ifaceStatus := range interfacesStatus {
+		if ifaceStatus.Name == "" {
+			continue
+		}
+		if prevIfaceStatus := netvmispec.LookupInterfaceStatusByName(prevIfaceStatuses, ifaceStatus.Name); prevIfaceStatus != nil {
+			interfacesStatus[i].FirewallRules = prevIfaceStatus.FirewallRules
+		}
+	}
+
+	return interfacesStatus
+}
+
 func movePrimaryIfaceStatusToFront(
 	interfacesStatus []v1.VirtualMachineInstanceNetworkInterface,
 	primaryNetworkName string,
@@ -1245,6 +1245,22 @@ var _ = Describe("netstat", func() {
 		}))
 	})
 
+	It("VMI with a secondary interface status reported should keep the prev firewall rules", func() {
+		const secondaryNetworkName = "secondary"
+		firewallRules := []v1.FirewallRuleStatus{{Name: "ssh", Direction: v1.FirewallDirectionIngress, Packets: 3, Bytes: 180}}
+
+		setup.Vmi.Spec.Domain.Devices.Interfaces = append(setup.Vmi.Spec.Domain.Devices.Interfaces, newVMISpecIfaceWithBridgeBinding(secondaryNetworkName))
+		setup.Vmi.Spec.Networks = append(setup.Vmi.Spec.Networks, newVMISpecMultusNetwork(secondaryNetworkName))
+		setup.Vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: secondaryNetworkName, InfoSource: netvmispec.InfoSourceMultusStatus, FirewallRules: firewallRules},
+		}
+		Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+		Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{
+			{Name: secondaryNetworkName, InfoSource: netvmispec.InfoSourceMultusStatus, FirewallRules: firewallRules},
+		}))
+	})
+
 	Context("Link state", func() {
 		const (
 			linkStateUp   = "up"
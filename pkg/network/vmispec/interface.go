@@ -45,6 +45,38 @@ func SRIOVInterfaceExist(ifaces []v1.Interface) bool {
 	return false
 }
 
+func IPv6AutoConfigInterfaceExist(ifaces []v1.Interface) bool {
+	for _, iface := range ifaces {
+		if HasIPv6AutoConfig(iface) {
+			return true
+		}
+	}
+	return false
+}
+
+func HasIPv6AutoConfig(iface v1.Interface) bool {
+	return iface.Bridge != nil && iface.Bridge.AutoConfig != nil
+}
+
+// PreservesIPOnMigration reports whether the guest keeps the address of the interface across live migrations.
+func PreservesIPOnMigration(iface v1.Interface) bool {
+	return iface.Bridge != nil && iface.Bridge.MigrationMode == v1.BridgeMigrationModePreserveIP
+}
+
+// LookupIPPreservingPodInterface returns the interface connected to the pod network
+// when the guest keeps its address across live migrations.
+func LookupIPPreservingPodInterface(ifaces []v1.Interface, networks []v1.Network) *v1.Interface {
+	podNetwork := LookupPodNetwork(networks)
+	if podNetwork == nil {
+		return nil
+	}
+	iface := LookupInterfaceByName(ifaces, podNetwork.Name)
+	if iface == nil || !PreservesIPOnMigration(*iface) {
+		return nil
+	}
+	return iface
+}
+
 func FilterInterfacesSpec(ifaces []v1.Interface, predicate func(i v1.Interface) bool) []v1.Interface {
 	var filteredIfaces []v1.Interface
 	for _, iface := range ifaces {
@@ -77,6 +109,9 @@ func VerifyVMIMigratable(vmi *v1.VirtualMachineInstance, bindingPlugins map[stri
 		if _, isLiveMigrationAllowed := vmi.Annotations[v1.AllowPodBridgeNetworkLiveMigrationAnnotation]; isLiveMigrationAllowed {
 			return nil
 		}
+		if PreservesIPOnMigration(*primaryIface) {
+			return nil
+		}
 	case primaryIface.Binding != nil:
 		if binding, exist := bindingPlugins[primaryIface.Binding.Name]; exist && binding.Migration != nil {
 			return nil
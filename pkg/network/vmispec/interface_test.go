@@ -70,6 +70,33 @@ var _ = Describe("VMI network spec", func() {
 		})
 	})
 
+	Context("IPv6 auto-configuration", func() {
+		It("is not found when the bridge interfaces do not request it", func() {
+			ifaces := []v1.Interface{
+				{Name: "bridge-net", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+				{Name: "masq-net", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			}
+
+			Expect(netvmispec.IPv6AutoConfigInterfaceExist(ifaces)).To(BeFalse())
+		})
+
+		It("is found on a bridge interface requesting it", func() {
+			ifaces := []v1.Interface{
+				{Name: "masq-net", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+				{
+					Name: "bridge-net",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{
+						Bridge: &v1.InterfaceBridge{AutoConfig: &v1.IPv6AutoConfig{}},
+					},
+				},
+			}
+
+			Expect(netvmispec.HasIPv6AutoConfig(ifaces[0])).To(BeFalse())
+			Expect(netvmispec.HasIPv6AutoConfig(ifaces[1])).To(BeTrue())
+			Expect(netvmispec.IPv6AutoConfigInterfaceExist(ifaces)).To(BeTrue())
+		})
+	})
+
 	Context("migratable", func() {
 		const (
 			migratablePlugin    = "mig"
@@ -98,6 +125,12 @@ var _ = Describe("VMI network spec", func() {
 					libvmi.WithAnnotation(v1.AllowPodBridgeNetworkLiveMigrationAnnotation, ""),
 				),
 			),
+			Entry("when the VMI uses bridge to connect to the pod network and preserves the guest IP",
+				libvmi.New(
+					libvmi.WithInterface(ipPreservingBridgeInterface(podNet0)),
+					libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				),
+			),
 			Entry("when the VMI uses migratable binding plugin to connect to the pod network",
 				libvmi.New(
 					libvmi.WithInterface(interfaceWithBindingPlugin(podNet0, migratablePlugin)),
@@ -130,6 +163,36 @@ var _ = Describe("VMI network spec", func() {
 		)
 	})
 
+	Context("IP preserving pod interface", func() {
+		It("is found when the pod network bridge interface preserves the guest IP", func() {
+			iface := ipPreservingBridgeInterface("default")
+
+			Expect(netvmispec.PreservesIPOnMigration(iface)).To(BeTrue())
+			Expect(netvmispec.LookupIPPreservingPodInterface(
+				[]v1.Interface{iface}, []v1.Network{*v1.DefaultPodNetwork()},
+			)).To(Equal(&iface))
+		})
+
+		It("is not found when the guest IP is preserved on a secondary network", func() {
+			iface := ipPreservingBridgeInterface("secondary")
+			network := v1.Network{
+				Name:          "secondary",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "nad"}},
+			}
+
+			Expect(netvmispec.LookupIPPreservingPodInterface([]v1.Interface{iface}, []v1.Network{network})).To(BeNil())
+		})
+
+		It("is not found when the pod network bridge interface does not preserve the guest IP", func() {
+			iface := *v1.DefaultBridgeNetworkInterface()
+
+			Expect(netvmispec.PreservesIPOnMigration(iface)).To(BeFalse())
+			Expect(netvmispec.LookupIPPreservingPodInterface(
+				[]v1.Interface{iface}, []v1.Network{*v1.DefaultPodNetwork()},
+			)).To(BeNil())
+		})
+	})
+
 	const (
 		deviceInfoPlugin    = "deviceinfo"
 		nonDeviceInfoPlugin = "non_deviceinfo"
@@ -181,3 +244,12 @@ func interfaceWithBindingPlugin(name, pluginName string) v1.Interface {
 		Binding: &v1.PluginBinding{Name: pluginName},
 	}
 }
+
+func ipPreservingBridgeInterface(name string) v1.Interface {
+	return v1.Interface{
+		Name: name,
+		InterfaceBindingMethod: v1.InterfaceBindingMethod{
+			Bridge: &v1.InterfaceBridge{MigrationMode: v1.BridgeMigrationModePreserveIP},
+		},
+	}
+}
@@ -90,9 +90,11 @@ func haveCurrentIfacesChanged(desiredIfacesByName, currentIfacesByName map[strin
 func areNormalizedIfacesEqual(iface1, iface2 v1.Interface) bool {
 	normalizedIface1 := iface1.DeepCopy()
 	normalizedIface1.State = ""
+	normalizedIface1.Firewall = nil
 
 	normalizedIface2 := iface2.DeepCopy()
 	normalizedIface2.State = ""
+	normalizedIface2.Firewall = nil
 
 	return reflect.DeepEqual(normalizedIface1, normalizedIface2)
 }
@@ -106,6 +106,20 @@ var _ = Describe("IsRestartRequired", func() {
 		Entry("From down to down", v1.InterfaceStateLinkDown, v1.InterfaceStateLinkDown),
 	)
 
+	It("should not require restart when the interface firewall changes", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+
+		vm := libvmi.NewVirtualMachine(vmi).DeepCopy()
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Firewall = &v1.InterfaceFirewall{
+			Ingress: []v1.FirewallRule{{Name: "ssh", Protocol: v1.FirewallProtocolTCP, Ports: []int32{22}}},
+		}
+
+		Expect(vmliveupdate.IsRestartRequired(vm, vmi)).To(BeFalse())
+	})
+
 	It("should not require restart when secondary NICs are hotplugged", func() {
 		vmi := libvmi.New(
 			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
@@ -25,6 +25,7 @@ import (
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -86,9 +87,27 @@ func (admitter *VMBackupAdmitter) Admit(ctx context.Context, ar *admissionv1.Adm
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes, err = validateFreezeHooksAccess(ctx, admitter.Client, ar.Request.UserInfo, ar.Request.Namespace,
+		backupSourceVMName(vmBackup.Spec.Source), vmBackup.Spec.Hooks, k8sfield.NewPath("spec", "hooks"))
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	return &admissionv1.AdmissionResponse{Allowed: true}
 }
 
+// backupSourceVMName returns the name of the VM a backup source directly refers
+// to, or an empty name for a VirtualMachineBackupTracker
+func backupSourceVMName(source corev1.TypedLocalObjectReference) string {
+	if source.Kind != "VirtualMachine" {
+		return ""
+	}
+	return source.Name
+}
+
 func (admitter *VMBackupAdmitter) validateSingleBackup(vmBackup *backupv1.VirtualMachineBackup, namespace string) ([]metav1.StatusCause, error) {
 	objects, err := admitter.VMBackupInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
 	if err != nil {
@@ -143,3 +162,122 @@ func (admitter *VMBackupTrackerAdmitter) Admit(ctx context.Context, ar *admissio
 
 	return &admissionv1.AdmissionResponse{Allowed: true}
 }
+
+// VMBackupScheduleAdmitter validates VirtualMachineBackupSchedules
+type VMBackupScheduleAdmitter struct {
+	Config *virtconfig.ClusterConfig
+	Client kubecli.KubevirtClient
+}
+
+// NewVMBackupScheduleAdmitter creates a VMBackupScheduleAdmitter
+func NewVMBackupScheduleAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient) *VMBackupScheduleAdmitter {
+	return &VMBackupScheduleAdmitter{
+		Config: config,
+		Client: client,
+	}
+}
+
+// Admit validates an AdmissionReview for VirtualMachineBackupSchedule
+func (admitter *VMBackupScheduleAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != backupv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackupschedules" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.IncrementalBackupEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("IncrementalBackup feature gate not enabled"))
+	}
+
+	schedule := &backupv1.VirtualMachineBackupSchedule{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, schedule); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+	specField := k8sfield.NewPath("spec")
+	if _, err := backup.ParseCronSchedule(schedule.Spec.Schedule); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid schedule: %v", err),
+			Field:   specField.Child("schedule").String(),
+		})
+	}
+	if schedule.Spec.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(schedule.Spec.Selector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid selector: %v", err),
+				Field:   specField.Child("selector").String(),
+			})
+		}
+		// The backups of all the selected VMs would be pushed to the same PVC
+		if mode := schedule.Spec.Template.Mode; mode == nil || *mode == backupv1.PushMode {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "push mode is not supported with a selector",
+				Field:   specField.Child("template", "mode").String(),
+			})
+		}
+	}
+
+	// The backups created by the schedule run the hooks on behalf of its author
+	vmName := ""
+	if schedule.Spec.Source != nil {
+		vmName = schedule.Spec.Source.Name
+	}
+	hooksCauses, err := validateFreezeHooksAccess(ctx, admitter.Client, ar.Request.UserInfo, ar.Request.Namespace,
+		vmName, schedule.Spec.Template.Hooks, specField.Child("template", "hooks"))
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	causes = append(causes, hooksCauses...)
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+// VMBackupRestoreAdmitter validates VirtualMachineBackupRestores
+type VMBackupRestoreAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMBackupRestoreAdmitter creates a VMBackupRestoreAdmitter
+func NewVMBackupRestoreAdmitter(config *virtconfig.ClusterConfig) *VMBackupRestoreAdmitter {
+	return &VMBackupRestoreAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview for VirtualMachineBackupRestore
+func (admitter *VMBackupRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != backupv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackuprestores" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation != admissionv1.Create {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	if !admitter.Config.IncrementalBackupEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("IncrementalBackup feature gate not enabled"))
+	}
+
+	restore := &backupv1.VirtualMachineBackupRestore{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, restore); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	if restore.Spec.VolumeName != nil && *restore.Spec.VolumeName == "" {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "volumeName must not be empty",
+			Field:   k8sfield.NewPath("spec", "volumeName").String(),
+		}})
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
@@ -497,3 +497,204 @@ func createBackupTrackerUpdateAdmissionReview(old, current *backupv1.VirtualMach
 
 	return ar
 }
+
+var _ = Describe("Validating VirtualMachineBackupSchedule Admitter", func() {
+	var (
+		kvStore  cache.Store
+		admitter *VMBackupScheduleAdmitter
+	)
+
+	newSchedule := func(schedule string) *backupv1.VirtualMachineBackupSchedule {
+		return &backupv1.VirtualMachineBackupSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-schedule",
+				Namespace: "default",
+			},
+			Spec: backupv1.VirtualMachineBackupScheduleSpec{
+				Schedule: schedule,
+				Source: &corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     "test-vm",
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		var config *virtconfig.ClusterConfig
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		enableFeatureGate(kvStore, "IncrementalBackup")
+		admitter = NewVMBackupScheduleAdmitter(config, kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT())))
+	})
+
+	It("should reject invalid resource", func() {
+		ar := createBackupScheduleAdmissionReview(newSchedule("@daily"))
+		ar.Request.Resource.Resource = "invalidresource"
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+	})
+
+	It("should reject Create operation when IncrementalBackup feature gate is not enabled", func() {
+		ar := createBackupScheduleAdmissionReview(newSchedule("@daily"))
+		disableFeatureGate(kvStore, "IncrementalBackup")
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("IncrementalBackup feature gate not enabled"))
+	})
+
+	DescribeTable("should validate the schedule", func(schedule string, allowed bool) {
+		ar := createBackupScheduleAdmissionReview(newSchedule(schedule))
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(Equal(allowed))
+		if !allowed {
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.schedule"))
+		}
+	},
+		Entry("with a macro", "@hourly", true),
+		Entry("with a cron expression", "30 2 * * 1-5", true),
+		Entry("with steps and lists", "*/15 0,12 1 */2 *", true),
+		Entry("with too few fields", "0 2 * *", false),
+		Entry("with an out of range value", "0 24 * * *", false),
+		Entry("with an unknown macro", "@every5m", false),
+	)
+
+	It("should reject an invalid selector", func() {
+		schedule := newSchedule("@daily")
+		schedule.Spec.Source = nil
+		schedule.Spec.Selector = &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      "app",
+				Operator: "Unknown",
+			}},
+		}
+		ar := createBackupScheduleAdmissionReview(schedule)
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.selector"))
+	})
+
+	DescribeTable("should validate the backup mode of a schedule with a selector", func(mode *backupv1.BackupMode, allowed bool) {
+		schedule := newSchedule("@daily")
+		schedule.Spec.Source = nil
+		schedule.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"backup": "nightly"}}
+		schedule.Spec.Template.Mode = mode
+		ar := createBackupScheduleAdmissionReview(schedule)
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(Equal(allowed))
+		if !allowed {
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.template.mode"))
+		}
+	},
+		Entry("rejecting an unset mode", nil, false),
+		Entry("rejecting push mode", pointer.P(backupv1.PushMode), false),
+		Entry("allowing pull mode", pointer.P(backupv1.PullMode), true),
+	)
+})
+
+func createBackupScheduleAdmissionReview(schedule *backupv1.VirtualMachineBackupSchedule) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    backupv1.SchemeGroupVersion.Group,
+				Resource: "virtualmachinebackupschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
+
+var _ = Describe("Validating VirtualMachineBackupRestore Admitter", func() {
+	var (
+		kvStore  cache.Store
+		admitter *VMBackupRestoreAdmitter
+	)
+
+	newRestore := func() *backupv1.VirtualMachineBackupRestore {
+		return &backupv1.VirtualMachineBackupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-restore",
+				Namespace: "default",
+			},
+			Spec: backupv1.VirtualMachineBackupRestoreSpec{
+				Target: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     "test-vm",
+				},
+				CheckpointName: "test-checkpoint",
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		var config *virtconfig.ClusterConfig
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		enableFeatureGate(kvStore, "IncrementalBackup")
+		admitter = NewVMBackupRestoreAdmitter(config)
+	})
+
+	It("should reject invalid resource", func() {
+		ar := createBackupRestoreAdmissionReview(newRestore())
+		ar.Request.Resource.Resource = "invalidresource"
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+	})
+
+	It("should reject Create operation when IncrementalBackup feature gate is not enabled", func() {
+		ar := createBackupRestoreAdmissionReview(newRestore())
+		disableFeatureGate(kvStore, "IncrementalBackup")
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("IncrementalBackup feature gate not enabled"))
+	})
+
+	It("should allow a restore of the whole VM", func() {
+		resp := admitter.Admit(context.Background(), createBackupRestoreAdmissionReview(newRestore()))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject an empty volume name", func() {
+		restore := newRestore()
+		restore.Spec.VolumeName = pointer.P("")
+
+		resp := admitter.Admit(context.Background(), createBackupRestoreAdmissionReview(restore))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumeName"))
+	})
+})
+
+func createBackupRestoreAdmissionReview(restore *backupv1.VirtualMachineBackupRestore) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(restore)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    backupv1.SchemeGroupVersion.Group,
+				Resource: "virtualmachinebackuprestores",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
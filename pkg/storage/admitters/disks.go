@@ -25,6 +25,7 @@ import (
 	"regexp"
 	"strings"
 
+	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -32,6 +33,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 const (
@@ -76,6 +78,154 @@ func ValidateContainerDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanc
 	return causes
 }
 
+func ValidatePersistentContainerDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, volume := range spec.Volumes {
+		if volume.ContainerDisk == nil || volume.ContainerDisk.Persistent == nil {
+			continue
+		}
+		persistentField := field.Child("volumes").Index(idx).Child("containerDisk", "persistent")
+		if !config.PersistentContainerDiskEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "PersistentContainerDisk feature gate is not enabled",
+				Field:   persistentField.String(),
+			})
+			continue
+		}
+		if volume.ContainerDisk.Persistent.Capacity.Sign() <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must be a positive quantity", persistentField.Child("capacity").String()),
+				Field:   persistentField.Child("capacity").String(),
+			})
+		}
+		for modeIdx, mode := range volume.ContainerDisk.Persistent.AccessModes {
+			switch mode {
+			case k8sv1.ReadWriteOnce, k8sv1.ReadWriteMany, k8sv1.ReadWriteOncePod:
+			default:
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: fmt.Sprintf("access mode %s is not supported, the overlay has to be writable", mode),
+					Field:   persistentField.Child("accessModes").Index(modeIdx).String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+func ValidateDisksIOTune(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.IOTune == nil {
+			continue
+		}
+		ioTuneField := field.Child("domain", "devices", "disks").Index(idx).Child("ioTune")
+		if !config.DiskIOTuneEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DiskIOTune feature gate is not enabled",
+				Field:   ioTuneField.String(),
+			})
+			continue
+		}
+		if disk.Disk == nil && disk.LUN == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is only supported for disks and LUNs", ioTuneField.String()),
+				Field:   ioTuneField.String(),
+			})
+			continue
+		}
+		causes = append(causes, validateDiskIOTune(ioTuneField, disk.IOTune)...)
+	}
+	return causes
+}
+
+func validateDiskIOTune(field *k8sfield.Path, ioTune *v1.DiskIOTune) []metav1.StatusCause {
+	burst := ioTune.Burst
+	if burst == nil {
+		burst = &v1.DiskIOTuneBurst{}
+	}
+	limits := []struct {
+		name         string
+		limit, burst *int64
+	}{
+		{"totalBytesSec", ioTune.TotalBytesSec, burst.TotalBytesSec},
+		{"readBytesSec", ioTune.ReadBytesSec, burst.ReadBytesSec},
+		{"writeBytesSec", ioTune.WriteBytesSec, burst.WriteBytesSec},
+		{"totalIOPSSec", ioTune.TotalIOPSSec, burst.TotalIOPSSec},
+		{"readIOPSSec", ioTune.ReadIOPSSec, burst.ReadIOPSSec},
+		{"writeIOPSSec", ioTune.WriteIOPSSec, burst.WriteIOPSSec},
+	}
+
+	var causes []metav1.StatusCause
+	hasBurst := false
+	for _, l := range limits {
+		limitField := field.Child(l.name)
+		burstField := field.Child("burst", l.name)
+		if l.limit != nil && *l.limit < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative", limitField.String()),
+				Field:   limitField.String(),
+			})
+		}
+		if l.burst == nil {
+			continue
+		}
+		hasBurst = true
+		if l.limit == nil || *l.limit <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s requires %s to be set", burstField.String(), limitField.String()),
+				Field:   burstField.String(),
+			})
+		} else if *l.burst < *l.limit {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be lower than %s", burstField.String(), limitField.String()),
+				Field:   burstField.String(),
+			})
+		}
+	}
+
+	isSet := func(value *int64) bool { return value != nil && *value > 0 }
+	if isSet(ioTune.TotalBytesSec) && (isSet(ioTune.ReadBytesSec) || isSet(ioTune.WriteBytesSec)) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can't be combined with the read or write bytes limit", field.Child("totalBytesSec").String()),
+			Field:   field.Child("totalBytesSec").String(),
+		})
+	}
+	if isSet(ioTune.TotalIOPSSec) && (isSet(ioTune.ReadIOPSSec) || isSet(ioTune.WriteIOPSSec)) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can't be combined with the read or write IOPS limit", field.Child("totalIOPSSec").String()),
+			Field:   field.Child("totalIOPSSec").String(),
+		})
+	}
+
+	if length := burst.LengthSeconds; length != nil {
+		lengthField := field.Child("burst", "lengthSeconds")
+		if *length < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be at least one second", lengthField.String()),
+				Field:   lengthField.String(),
+			})
+		} else if !hasBurst {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s requires a burst limit", lengthField.String()),
+				Field:   lengthField.String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateDiskName(field *k8sfield.Path, idx int, disks []v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for otherIdx, disk := range disks {
@@ -26,12 +26,17 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("Disk Validation", func() {
@@ -566,3 +571,120 @@ var _ = Describe("Disk Validation", func() {
 		})
 	})
 })
+
+var _ = Describe("Persistent containerDisk Validation", func() {
+	newSpec := func(persistent *v1.PersistentContainerDisk) *v1.VirtualMachineInstanceSpec {
+		return &v1.VirtualMachineInstanceSpec{
+			Volumes: []v1.Volume{{
+				Name: "disk0",
+				VolumeSource: v1.VolumeSource{
+					ContainerDisk: &v1.ContainerDiskSource{Image: "registry:5000/disk", Persistent: persistent},
+				},
+			}},
+		}
+	}
+
+	configWithFeatureGates := func(featureGates ...string) *virtconfig.ClusterConfig {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		return config
+	}
+
+	It("should reject persistent containerDisks when the feature gate is disabled", func() {
+		spec := newSpec(&v1.PersistentContainerDisk{Capacity: resource.MustParse("1Gi")})
+		causes := ValidatePersistentContainerDisks(k8sfield.NewPath("fake"), spec, configWithFeatureGates())
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal("fake.volumes[0].containerDisk.persistent"))
+	})
+
+	DescribeTable("should validate persistent containerDisks", func(persistent *v1.PersistentContainerDisk, expectedFields ...string) {
+		spec := newSpec(persistent)
+		causes := ValidatePersistentContainerDisks(k8sfield.NewPath("fake"), spec, configWithFeatureGates(featuregate.PersistentContainerDiskGate))
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, field := range expectedFields {
+			Expect(causes[i].Field).To(Equal(field))
+		}
+	},
+		Entry("accept a valid overlay", &v1.PersistentContainerDisk{
+			Capacity:    resource.MustParse("1Gi"),
+			AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+		}),
+		Entry("reject an overlay without capacity", &v1.PersistentContainerDisk{},
+			"fake.volumes[0].containerDisk.persistent.capacity"),
+		Entry("reject a read-only overlay", &v1.PersistentContainerDisk{
+			Capacity:    resource.MustParse("1Gi"),
+			AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce, k8sv1.ReadOnlyMany},
+		}, "fake.volumes[0].containerDisk.persistent.accessModes[1]"),
+	)
+
+	It("should ignore ephemeral containerDisks", func() {
+		spec := newSpec(nil)
+		Expect(ValidatePersistentContainerDisks(k8sfield.NewPath("fake"), spec, configWithFeatureGates())).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Disk IOTune Validation", func() {
+	newSpec := func(diskDevice v1.DiskDevice, ioTune *v1.DiskIOTune) *v1.VirtualMachineInstanceSpec {
+		return &v1.VirtualMachineInstanceSpec{
+			Domain: v1.DomainSpec{
+				Devices: v1.Devices{
+					Disks: []v1.Disk{{Name: "disk0", DiskDevice: diskDevice, IOTune: ioTune}},
+				},
+			},
+		}
+	}
+	disk := v1.DiskDevice{Disk: &v1.DiskTarget{}}
+
+	configWithFeatureGates := func(featureGates ...string) *virtconfig.ClusterConfig {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		return config
+	}
+
+	It("should reject I/O limits when the feature gate is disabled", func() {
+		spec := newSpec(disk, &v1.DiskIOTune{TotalIOPSSec: pointer.P(int64(100))})
+		causes := ValidateDisksIOTune(k8sfield.NewPath("fake"), spec, configWithFeatureGates())
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].ioTune"))
+	})
+
+	DescribeTable("should validate I/O limits", func(diskDevice v1.DiskDevice, ioTune *v1.DiskIOTune, expectedFields ...string) {
+		spec := newSpec(diskDevice, ioTune)
+		causes := ValidateDisksIOTune(k8sfield.NewPath("fake"), spec, configWithFeatureGates(featuregate.DiskIOTuneGate))
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, field := range expectedFields {
+			Expect(causes[i].Field).To(Equal(field))
+		}
+	},
+		Entry("accept valid limits with a burst", disk, &v1.DiskIOTune{
+			ReadBytesSec: pointer.P(int64(1000)),
+			TotalIOPSSec: pointer.P(int64(100)),
+			Burst: &v1.DiskIOTuneBurst{
+				TotalIOPSSec:  pointer.P(int64(200)),
+				LengthSeconds: pointer.P(int64(10)),
+			},
+		}),
+		Entry("accept limits on a LUN", v1.DiskDevice{LUN: &v1.LunTarget{}}, &v1.DiskIOTune{TotalIOPSSec: pointer.P(int64(100))}),
+		Entry("reject limits on a cdrom", v1.DiskDevice{CDRom: &v1.CDRomTarget{}}, &v1.DiskIOTune{TotalIOPSSec: pointer.P(int64(100))},
+			"fake.domain.devices.disks[0].ioTune"),
+		Entry("reject a negative limit", disk, &v1.DiskIOTune{WriteIOPSSec: pointer.P(int64(-1))},
+			"fake.domain.devices.disks[0].ioTune.writeIOPSSec"),
+		Entry("reject a total limit combined with a read limit", disk, &v1.DiskIOTune{
+			TotalBytesSec: pointer.P(int64(1000)),
+			ReadBytesSec:  pointer.P(int64(1000)),
+		}, "fake.domain.devices.disks[0].ioTune.totalBytesSec"),
+		Entry("reject a burst without its base limit", disk, &v1.DiskIOTune{
+			Burst: &v1.DiskIOTuneBurst{ReadIOPSSec: pointer.P(int64(200))},
+		}, "fake.domain.devices.disks[0].ioTune.burst.readIOPSSec"),
+		Entry("reject a burst lower than its base limit", disk, &v1.DiskIOTune{
+			ReadIOPSSec: pointer.P(int64(200)),
+			Burst:       &v1.DiskIOTuneBurst{ReadIOPSSec: pointer.P(int64(100))},
+		}, "fake.domain.devices.disks[0].ioTune.burst.readIOPSSec"),
+		Entry("reject a burst length without burst", disk, &v1.DiskIOTune{
+			ReadIOPSSec: pointer.P(int64(200)),
+			Burst:       &v1.DiskIOTuneBurst{LengthSeconds: pointer.P(int64(10))},
+		}, "fake.domain.devices.disks[0].ioTune.burst.lengthSeconds"),
+	)
+})
@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"fmt"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+const guestExecSubresource = "guestexec"
+
+// validateFreezeHooksAccess rejects guest exec hooks of users who may not run
+// commands in the guest themselves, as virt-controller runs the hooks through the
+// guestexec subresource on their behalf. An empty vmName requires the user to be
+// allowed to run commands in every VMI of the namespace.
+func validateFreezeHooksAccess(
+	ctx context.Context,
+	client kubecli.KubevirtClient,
+	userInfo authenticationv1.UserInfo,
+	namespace, vmName string,
+	hooks *v1.FreezeHooks,
+	field *k8sfield.Path,
+) ([]metav1.StatusCause, error) {
+	if hooks == nil {
+		return nil, nil
+	}
+
+	var guestExecFields []*k8sfield.Path
+	for i, hook := range hooks.PreFreeze {
+		if hook.GuestExec != nil {
+			guestExecFields = append(guestExecFields, field.Child("preFreeze").Index(i).Child("guestExec"))
+		}
+	}
+	for i, hook := range hooks.PostThaw {
+		if hook.GuestExec != nil {
+			guestExecFields = append(guestExecFields, field.Child("postThaw").Index(i).Child("guestExec"))
+		}
+	}
+	if len(guestExecFields) == 0 {
+		return nil, nil
+	}
+
+	extra := map[string]authv1.ExtraValue{}
+	for key, value := range userInfo.Extra {
+		extra[key] = authv1.ExtraValue(value)
+	}
+	sar := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:   userInfo.Username,
+			Groups: userInfo.Groups,
+			UID:    userInfo.UID,
+			Extra:  extra,
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Namespace:   namespace,
+				Verb:        "update",
+				Group:       v1.SubresourceGroupName,
+				Resource:    "virtualmachineinstances",
+				Subresource: guestExecSubresource,
+				Name:        vmName,
+			},
+		},
+	}
+	sar, err := client.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if sar.Status.Allowed {
+		return nil, nil
+	}
+
+	var causes []metav1.StatusCause
+	for _, guestExecField := range guestExecFields {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeForbidden,
+			Message: fmt.Sprintf("user %s is not allowed to run commands in the guest", userInfo.Username),
+			Field:   guestExecField.String(),
+		})
+	}
+	return causes, nil
+}
@@ -0,0 +1,120 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+var _ = Describe("Freeze hooks access validation", func() {
+	const (
+		namespace = "default"
+		vmName    = "test-vm"
+	)
+
+	var (
+		virtClient *kubecli.MockKubevirtClient
+		allowed    bool
+		sars       []*authv1.SubjectAccessReview
+	)
+
+	userInfo := authenticationv1.UserInfo{
+		Username: "user",
+		Groups:   []string{"group"},
+		Extra:    map[string]authenticationv1.ExtraValue{"scope": {"a"}},
+	}
+	hooksField := k8sfield.NewPath("spec", "hooks")
+
+	BeforeEach(func() {
+		allowed = false
+		sars = nil
+		k8sClient := k8sfake.NewSimpleClientset()
+		k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			sar := action.(k8stesting.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+			sars = append(sars, sar)
+			sar.Status.Allowed = allowed
+			return true, sar, nil
+		})
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+	})
+
+	guestExecHook := v1.FreezeHook{Name: "flush", GuestExec: &v1.GuestExecHook{Command: []string{"sync"}}}
+	httpHook := v1.FreezeHook{Name: "http", HTTP: &v1.HTTPHook{PodName: "db", Port: 8080}}
+
+	It("should not review the access without guest exec hooks", func() {
+		hooks := &v1.FreezeHooks{PreFreeze: []v1.FreezeHook{httpHook}}
+
+		causes, err := validateFreezeHooksAccess(context.Background(), virtClient, userInfo, namespace, vmName, hooks, hooksField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(causes).To(BeEmpty())
+		Expect(sars).To(BeEmpty())
+	})
+
+	It("should review the access of the user to the guestexec subresource of the VMI", func() {
+		allowed = true
+		hooks := &v1.FreezeHooks{PreFreeze: []v1.FreezeHook{guestExecHook}}
+
+		causes, err := validateFreezeHooksAccess(context.Background(), virtClient, userInfo, namespace, vmName, hooks, hooksField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(causes).To(BeEmpty())
+		Expect(sars).To(HaveLen(1))
+		Expect(sars[0].Spec.User).To(Equal("user"))
+		Expect(sars[0].Spec.Groups).To(Equal([]string{"group"}))
+		Expect(sars[0].Spec.Extra).To(Equal(map[string]authv1.ExtraValue{"scope": {"a"}}))
+		Expect(sars[0].Spec.ResourceAttributes).To(Equal(&authv1.ResourceAttributes{
+			Namespace:   namespace,
+			Verb:        "update",
+			Group:       v1.SubresourceGroupName,
+			Resource:    "virtualmachineinstances",
+			Subresource: "guestexec",
+			Name:        vmName,
+		}))
+	})
+
+	It("should reject the guest exec hooks of a user who may not run commands in the guest", func() {
+		hooks := &v1.FreezeHooks{
+			PreFreeze: []v1.FreezeHook{httpHook, guestExecHook},
+			PostThaw:  []v1.FreezeHook{guestExecHook},
+		}
+
+		causes, err := validateFreezeHooksAccess(context.Background(), virtClient, userInfo, namespace, vmName, hooks, hooksField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(causes).To(HaveLen(2))
+		Expect(causes[0].Type).To(Equal(metav1.CauseTypeForbidden))
+		Expect(causes[0].Field).To(Equal("spec.hooks.preFreeze[1].guestExec"))
+		Expect(causes[1].Field).To(Equal("spec.hooks.postThaw[0].guestExec"))
+	})
+})
@@ -33,9 +33,9 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
-// AdmitHotplugStorage compares the old and new volumes and disks, and ensures that they match and are valid.
-func AdmitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Disk, volumeStatuses []v1.VolumeStatus, newVMI *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) *admissionv1.AdmissionResponse {
-	if err := validateExpectedDisksAndFilesystems(newVolumes, newDisks, newVMI.Spec.Domain.Devices.Filesystems, config); err != nil {
+// AdmitHotplugStorage compares the old and new volumes, disks and filesystems, and ensures that they match and are valid.
+func AdmitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Disk, newFilesystems, oldFilesystems []v1.Filesystem, volumeStatuses []v1.VolumeStatus, newVMI *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) *admissionv1.AdmissionResponse {
+	if err := validateExpectedDisksAndFilesystems(newVolumes, newDisks, newFilesystems, config); err != nil {
 		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 			{
 				Type:    metav1.CauseTypeFieldValueInvalid,
@@ -51,13 +51,18 @@ func AdmitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks
 
 	newDiskMap := getDiskMap(newDisks)
 	oldDiskMap := getDiskMap(oldDisks)
+	newFilesystemMap := getFilesystemMap(newFilesystems)
+	oldFilesystemMap := getFilesystemMap(oldFilesystems)
 
 	permanentAr := verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, newDiskMap, oldDiskMap, migratedVolumeMap)
 	if permanentAr != nil {
 		return permanentAr
 	}
 
-	hotplugAr := verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap, newDiskMap, oldDiskMap, migratedVolumeMap)
+	_, sharedMemory := newVMI.Annotations[v1.HotplugFilesystemsAnnotation]
+	filesystemHotplugAllowed := config.HotplugFilesystemsEnabled() && sharedMemory
+
+	hotplugAr := verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap, newDiskMap, oldDiskMap, newFilesystemMap, oldFilesystemMap, migratedVolumeMap, filesystemHotplugAllowed)
 	if hotplugAr != nil {
 		return hotplugAr
 	}
@@ -123,6 +128,27 @@ func ValidateHotplugDiskConfiguration(disk *v1.Disk, name, messagePrefix, field
 	return nil
 }
 
+// ValidateHotplugFilesystemConfiguration ensures that a hotplugged filesystem can be served by virtiofsd
+func ValidateHotplugFilesystemConfiguration(filesystem *v1.Filesystem, name, messagePrefix, field string) []metav1.StatusCause {
+	if filesystem == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s for [%s] requires the filesystem field to be set.", messagePrefix, name),
+			Field:   field,
+		}}
+	}
+
+	if filesystem.Virtiofs == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s for filesystem [%s] requires it to be of type 'virtiofs'.", messagePrefix, name),
+			Field:   field,
+		}}
+	}
+
+	return nil
+}
+
 func validateExpectedDisksAndFilesystems(volumes []v1.Volume, disks []v1.Disk, filesystems []v1.Filesystem, config *virtconfig.ClusterConfig) error {
 	names := make(map[string]struct{})
 	for _, volume := range volumes {
@@ -150,7 +176,7 @@ func validateExpectedDisksAndFilesystems(volumes []v1.Volume, disks []v1.Disk, f
 }
 
 func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk,
-	migratedVols map[string]bool) *admissionv1.AdmissionResponse {
+	newFilesystems, oldFilesystems map[string]v1.Filesystem, migratedVols map[string]bool, filesystemHotplugAllowed bool) *admissionv1.AdmissionResponse {
 	for k, v := range newHotplugVolumeMap {
 		if _, ok := oldHotplugVolumeMap[k]; ok {
 			_, okMigVol := migratedVols[k]
@@ -163,7 +189,16 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if filesystem, ok := newFilesystems[k]; ok {
+				if !equality.Semantic.DeepEqual(filesystem, oldFilesystems[k]) {
+					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+						{
+							Type:    metav1.CauseTypeFieldValueInvalid,
+							Message: fmt.Sprintf("hotplug filesystem %s, changed", k),
+						},
+					})
+				}
+			} else if v.MemoryDump == nil {
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
@@ -172,7 +207,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 						},
 					})
 				}
-				if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+				if !disksEqual(newDisks[k], oldDisks[k]) {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
 							Type:    metav1.CauseTypeFieldValueInvalid,
@@ -191,7 +226,20 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if filesystem, ok := newFilesystems[k]; ok {
+				if !filesystemHotplugAllowed {
+					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+						{
+							Type:    metav1.CauseTypeFieldValueInvalid,
+							Message: fmt.Sprintf("filesystem %s can't be hotplugged, the VMI was not started with the HotplugFilesystems feature gate", k),
+						},
+					})
+				}
+				causes := ValidateHotplugFilesystemConfiguration(&filesystem, k, "Hotplug configuration", "")
+				if len(causes) > 0 {
+					return webhookutils.ToAdmissionResponse(causes)
+				}
+			} else if v.MemoryDump == nil {
 				// Also ensure the matching new disk exists and has a valid bus
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
@@ -256,7 +304,7 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 				},
 			})
 		}
-		if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+		if !disksEqual(newDisks[k], oldDisks[k]) {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -268,6 +316,13 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 	return nil
 }
 
+// disksEqual compares disks without their I/O limits, which are live-updatable
+func disksEqual(newDisk, oldDisk v1.Disk) bool {
+	newDisk.IOTune = nil
+	oldDisk.IOTune = nil
+	return equality.Semantic.DeepEqual(newDisk, oldDisk)
+}
+
 func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	newDiskMap := make(map[string]v1.Disk, 0)
 	for _, disk := range disks {
@@ -278,6 +333,14 @@ func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	return newDiskMap
 }
 
+func getFilesystemMap(filesystems []v1.Filesystem) map[string]v1.Filesystem {
+	filesystemMap := make(map[string]v1.Filesystem, len(filesystems))
+	for _, filesystem := range filesystems {
+		filesystemMap[filesystem.Name] = filesystem
+	}
+	return filesystemMap
+}
+
 func getHotplugVolumes(volumes []v1.Volume, volumeStatuses []v1.VolumeStatus) map[string]v1.Volume {
 	permanentVolumesFromStatus := make(map[string]v1.Volume, 0)
 	for _, volume := range volumeStatuses {
@@ -337,10 +337,16 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 		for _, featureGate := range featureGates {
 			enableFeatureGate(featureGate)
 		}
-		result := AdmitHotplugStorage(newVolumes, oldVolumes, newDisks, oldDisks, volumeStatuses, newVMI, config)
+		result := AdmitHotplugStorage(newVolumes, oldVolumes, newDisks, oldDisks, filesystems, filesystems, volumeStatuses, newVMI, config)
 		Expect(equality.Semantic.DeepEqual(result, expected)).To(BeTrue(), "result: %v and expected: %v do not match", result, expected)
 	}
 
+	It("Should accept I/O limit changes of a permanent disk", func() {
+		newDisks := makeDisks(0)
+		newDisks[0].IOTune = &v1.DiskIOTune{TotalIOPSSec: pointer.P(int64(100))}
+		testHotplugResponse(makeVolumes(0), makeVolumes(0), newDisks, makeDisks(0), makeFilesystems(), makeStatus(1, 0), nil)
+	})
+
 	DescribeTable("Should return proper admission response", testHotplugResponse,
 		Entry("Should accept if no volumes are there or added",
 			makeVolumes(),
@@ -538,6 +544,44 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 		)
 	})
 
+	Context("with hotplugged filesystems", func() {
+		DescribeTable("Should return proper admission response", func(oldFilesystems, newFilesystems []v1.Filesystem, sharedMemory bool, featureGate string, expected *admissionv1.AdmissionResponse) {
+			enableFeatureGate(featureGate)
+			oldVolumes := makeVolumes(0)
+			if len(oldFilesystems) > 0 {
+				oldVolumes = makeVolumes(0, 1)
+			}
+			newVMI := api.NewMinimalVMI("testvmi")
+			newVMI.Spec.Volumes = makeVolumes(0, 1)
+			newVMI.Spec.Domain.Devices.Disks = makeDisks(0)
+			newVMI.Spec.Domain.Devices.Filesystems = newFilesystems
+			if sharedMemory {
+				newVMI.Annotations = map[string]string{v1.HotplugFilesystemsAnnotation: ""}
+			}
+
+			result := AdmitHotplugStorage(newVMI.Spec.Volumes, oldVolumes, makeDisks(0), makeDisks(0),
+				newFilesystems, oldFilesystems, makeStatus(1, 0), newVMI, config)
+			Expect(equality.Semantic.DeepEqual(result, expected)).To(BeTrue(), "result: %v and expected: %v do not match", result, expected)
+		},
+			Entry("Should accept a new virtiofs filesystem",
+				nil, makeFilesystems(1), true, featuregate.HotplugFilesystemsGate, nil),
+			Entry("Should accept an unchanged hotplugged filesystem",
+				makeFilesystems(1), makeFilesystems(1), true, featuregate.HotplugFilesystemsGate, nil),
+			Entry("Should reject a new filesystem without the feature gate",
+				nil, makeFilesystems(1), true, featuregate.HotplugVolumesGate,
+				makeExpected("filesystem volume-name-1 can't be hotplugged, the VMI was not started with the HotplugFilesystems feature gate", "")),
+			Entry("Should reject a new filesystem if the VMI was started without shared memory",
+				nil, makeFilesystems(1), false, featuregate.HotplugFilesystemsGate,
+				makeExpected("filesystem volume-name-1 can't be hotplugged, the VMI was not started with the HotplugFilesystems feature gate", "")),
+			Entry("Should reject a new filesystem which is not virtiofs",
+				nil, []v1.Filesystem{{Name: "volume-name-1"}}, true, featuregate.HotplugFilesystemsGate,
+				makeExpected("Hotplug configuration for filesystem [volume-name-1] requires it to be of type 'virtiofs'.", "")),
+			Entry("Should reject a changed hotplugged filesystem",
+				makeFilesystems(1), []v1.Filesystem{{Name: "volume-name-1"}}, true, featuregate.HotplugFilesystemsGate,
+				makeExpected("hotplug filesystem volume-name-1, changed", "")),
+		)
+	})
+
 	DescribeTable("should allow change for a persistent volume if it is a migrated volume", func(hotpluggable bool) {
 		disks := []v1.Disk{
 			{
@@ -601,7 +645,7 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 				DestinationPVCInfo: &v1.PersistentVolumeClaimInfo{ClaimName: "pvc1"},
 			},
 		}
-		Expect(AdmitHotplugStorage(newVols, oldVols, disks, disks, volumeStatuses, vmi, config)).To(BeNil())
+		Expect(AdmitHotplugStorage(newVols, oldVols, disks, disks, nil, nil, volumeStatuses, vmi, config)).To(BeNil())
 	},
 		Entry("and not hotpluggable", false),
 		Entry("and hotpluggable", true),
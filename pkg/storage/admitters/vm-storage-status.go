@@ -71,7 +71,8 @@ func (a *Admitter) validateSnapshotStatus() []metav1.StatusCause {
 		}}
 	}
 
-	if !compareVolumes(oldVM.Spec.Template.Spec.Volumes, a.vm.Spec.Template.Spec.Volumes) {
+	// The memory state of a snapshot is saved through a volume added to the VM
+	if !compareVolumes(withoutSavedStateVolumes(oldVM.Spec.Template.Spec.Volumes), withoutSavedStateVolumes(a.vm.Spec.Template.Spec.Volumes)) {
 		return []metav1.StatusCause{{
 			Type:    metav1.CauseTypeFieldValueNotSupported,
 			Message: fmt.Sprintf("Cannot update vm disks or volumes until snapshot %q completes", *a.vm.Status.SnapshotInProgress),
@@ -89,6 +90,17 @@ func (a *Admitter) validateSnapshotStatus() []metav1.StatusCause {
 	return nil
 }
 
+func withoutSavedStateVolumes(volumes []v1.Volume) []v1.Volume {
+	var filtered []v1.Volume
+	for _, volume := range volumes {
+		if volume.MemoryDump != nil && volume.MemoryDump.Format == v1.MemoryDumpFormatSavedState {
+			continue
+		}
+		filtered = append(filtered, volume)
+	}
+	return filtered
+}
+
 func compareVolumes(old, new []v1.Volume) bool {
 	if len(old) != len(new) {
 		return false
@@ -38,8 +38,10 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 // VMRestoreAdmitter validates VirtualMachineRestores
@@ -114,6 +116,12 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 					if newCauses != nil {
 						causes = append(causes, newCauses...)
 					}
+
+					newCauses, err = admitter.validateSnapshotNamespace(ctx, vmRestore)
+					if err != nil {
+						return webhookutils.ToAdmissionResponseError(err)
+					}
+					causes = append(causes, newCauses...)
 				default:
 					causes = []metav1.StatusCause{
 						{
@@ -185,10 +193,14 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause, err error) {
 	targetName := vmRestore.Spec.Target.Name
 	namespace := vmRestore.Namespace
+	snapshotNamespace := namespace
+	if vmRestore.Spec.VirtualMachineSnapshotNamespace != "" {
+		snapshotNamespace = vmRestore.Spec.VirtualMachineSnapshotNamespace
+	}
 
 	causes = admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
 
-	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
+	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(snapshotNamespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, nil
@@ -208,7 +220,7 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 			return nil, fmt.Errorf("snapshot content name is nil in vmSnapshot status")
 		}
 
-		vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(namespace).Get(ctx, *contentName, metav1.GetOptions{})
+		vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(snapshotNamespace).Get(ctx, *contentName, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -350,3 +362,48 @@ func (admitter *VMRestoreAdmitter) validateVolumeOwnershipPolicy(ctx context.Con
 
 	return causes
 }
+
+func (admitter *VMRestoreAdmitter) validateSnapshotNamespace(ctx context.Context, vmRestore *snapshotv1.VirtualMachineRestore) ([]metav1.StatusCause, error) {
+	snapshotNamespace := vmRestore.Spec.VirtualMachineSnapshotNamespace
+	// Cancel if the snapshot is restored in its own namespace
+	if snapshotNamespace == "" || snapshotNamespace == vmRestore.Namespace {
+		return nil, nil
+	}
+
+	field := k8sfield.NewPath("spec", "virtualMachineSnapshotNamespace")
+	if !admitter.Config.CrossNamespaceRestoreEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s feature gate is not enabled", featuregate.CrossNamespaceRestoreGate),
+			Field:   field.String(),
+		}}, nil
+	}
+
+	var causes []metav1.StatusCause
+	if vmRestore.Spec.VolumeRestorePolicy != nil && *vmRestore.Spec.VolumeRestorePolicy == snapshotv1.VolumeRestorePolicyInPlace {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "volume restore policy InPlace can't be used to restore a snapshot from another namespace",
+			Field:   k8sfield.NewPath("spec", "volumeRestorePolicy").String(),
+		})
+	}
+
+	grantList, err := admitter.Client.VirtualMachineSnapshotGrant(snapshotNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	grants := make([]*snapshotv1.VirtualMachineSnapshotGrant, 0, len(grantList.Items))
+	for i := range grantList.Items {
+		grants = append(grants, &grantList.Items[i])
+	}
+	if !storagetypes.IsVirtualMachineSnapshotGranted(grants, vmRestore.Spec.VirtualMachineSnapshotName, vmRestore.Namespace) {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("VirtualMachineSnapshot %s/%s is not granted to namespace %s",
+				snapshotNamespace, vmRestore.Spec.VirtualMachineSnapshotName, vmRestore.Namespace),
+			Field: field.String(),
+		})
+	}
+
+	return causes, nil
+}
@@ -46,6 +46,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
@@ -95,12 +96,12 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 	})
 
 	Context("With feature gate enabled", func() {
-		enableFeatureGate := func(featureGate string) {
+		enableFeatureGate := func(featureGates ...string) {
 			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
 				Spec: v1.KubeVirtSpec{
 					Configuration: v1.KubeVirtConfiguration{
 						DeveloperConfiguration: &v1.DeveloperConfiguration{
-							FeatureGates: []string{featureGate},
+							FeatureGates: featureGates,
 						},
 					},
 				},
@@ -640,6 +641,86 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				Entry("target exists", true),
 			)
 
+			Context("when restoring a snapshot from another namespace", func() {
+				const sourceNamespace = "production"
+
+				var (
+					restore        *snapshotv1.VirtualMachineRestore
+					sourceSnapshot *snapshotv1.VirtualMachineSnapshot
+				)
+
+				newGrant := func(to ...snapshotv1.VirtualMachineSnapshotGrantTo) *snapshotv1.VirtualMachineSnapshotGrant {
+					return &snapshotv1.VirtualMachineSnapshotGrant{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "grant",
+							Namespace: sourceNamespace,
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotGrantSpec{
+							From: []snapshotv1.VirtualMachineSnapshotGrantFrom{{Namespace: "default"}},
+							To:   to,
+						},
+					}
+				}
+
+				BeforeEach(func() {
+					enableFeatureGate(featuregate.SnapshotGate, featuregate.CrossNamespaceRestoreGate)
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+							VirtualMachineSnapshotName:      vmSnapshotName,
+							VirtualMachineSnapshotNamespace: sourceNamespace,
+						},
+					}
+					sourceSnapshot = snapshot.DeepCopy()
+					sourceSnapshot.Namespace = sourceNamespace
+				})
+
+				It("should accept when the snapshot is granted", func() {
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, sourceSnapshot, newGrant()).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+
+				It("should reject when the feature gate is disabled", func() {
+					enableFeatureGate(featuregate.SnapshotGate)
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, sourceSnapshot, newGrant()).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotNamespace"))
+				})
+
+				DescribeTable("should reject when the snapshot is not granted", func(grants ...runtime.Object) {
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, append(grants, vm, sourceSnapshot)...).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotNamespace"))
+				},
+					Entry("without any grant"),
+					Entry("with a grant to another snapshot", newGrant(snapshotv1.VirtualMachineSnapshotGrantTo{Name: "other"})),
+				)
+
+				It("should reject the InPlace volume restore policy", func() {
+					restore.Spec.VolumeRestorePolicy = pointer.P(snapshotv1.VolumeRestorePolicyInPlace)
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, sourceSnapshot, newGrant()).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumeRestorePolicy"))
+				})
+			})
+
 			Context("when using Patches", func() {
 
 				var restore *snapshotv1.VirtualMachineRestore
@@ -765,10 +846,10 @@ func createTestVMRestoreAdmitter(
 	vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
 	kubevirtClient := kubevirtfake.NewSimpleClientset(objs...)
 
-	virtClient.EXPECT().VirtualMachineSnapshot("default").
-		Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots("default")).AnyTimes()
+	virtClient.EXPECT().VirtualMachineSnapshot(gomock.Any()).DoAndReturn(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots).AnyTimes()
 	virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
-	virtClient.EXPECT().VirtualMachineSnapshotContent("default").Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotContents("default")).AnyTimes()
+	virtClient.EXPECT().VirtualMachineSnapshotContent(gomock.Any()).DoAndReturn(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotContents).AnyTimes()
+	virtClient.EXPECT().VirtualMachineSnapshotGrant(gomock.Any()).DoAndReturn(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGrants).AnyTimes()
 
 	restoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
 	for _, obj := range objs {
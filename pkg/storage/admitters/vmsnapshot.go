@@ -107,6 +107,15 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 				},
 			}
 		}
+		if len(causes) > 0 {
+			break
+		}
+
+		causes, err = validateFreezeHooksAccess(ctx, admitter.Client, ar.Request.UserInfo, ar.Request.Namespace,
+			vmSnapshot.Spec.Source.Name, vmSnapshot.Spec.Hooks, k8sfield.NewPath("spec", "hooks"))
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
 
 	case admissionv1.Update:
 		prevObj := &snapshotv1.VirtualMachineSnapshot{}
@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMSnapshotGroupAdmitter validates VirtualMachineSnapshotGroups
+type VMSnapshotGroupAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMSnapshotGroupAdmitter creates a VMSnapshotGroupAdmitter
+func NewVMSnapshotGroupAdmitter(config *virtconfig.ClusterConfig) *VMSnapshotGroupAdmitter {
+	return &VMSnapshotGroupAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview for VirtualMachineSnapshotGroup
+func (admitter *VMSnapshotGroupAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinesnapshotgroups" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	group := &snapshotv1.VirtualMachineSnapshotGroup{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, group); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		selectorField := k8sfield.NewPath("spec", "selector")
+		if len(group.Spec.Selector.MatchLabels) == 0 && len(group.Spec.Selector.MatchExpressions) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "selector must not be empty",
+				Field:   selectorField.String(),
+			})
+		} else if _, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid selector: %v", err),
+				Field:   selectorField.String(),
+			})
+		}
+
+	case admissionv1.Update:
+		prevObj := &snapshotv1.VirtualMachineSnapshotGroup{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, prevObj); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, group.Spec) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "spec in immutable after creation",
+				Field:   k8sfield.NewPath("spec").String(),
+			})
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+// VMRestoreGroupAdmitter validates VirtualMachineRestoreGroups
+type VMRestoreGroupAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMRestoreGroupAdmitter creates a VMRestoreGroupAdmitter
+func NewVMRestoreGroupAdmitter(config *virtconfig.ClusterConfig) *VMRestoreGroupAdmitter {
+	return &VMRestoreGroupAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview for VirtualMachineRestoreGroup
+func (admitter *VMRestoreGroupAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinerestoregroups" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("Snapshot/Restore feature gate not enabled"))
+	}
+
+	restoreGroup := &snapshotv1.VirtualMachineRestoreGroup{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, restoreGroup); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		if restoreGroup.Spec.VirtualMachineSnapshotGroupName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "missing virtualMachineSnapshotGroupName",
+				Field:   k8sfield.NewPath("spec", "virtualMachineSnapshotGroupName").String(),
+			})
+		}
+
+	case admissionv1.Update:
+		prevObj := &snapshotv1.VirtualMachineRestoreGroup{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, prevObj); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, restoreGroup.Spec) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "spec in immutable after creation",
+				Field:   k8sfield.NewPath("spec").String(),
+			})
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
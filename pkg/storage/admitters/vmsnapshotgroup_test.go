@@ -0,0 +1,239 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotGroup Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newSnapshotGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+			},
+		}
+	}
+
+	It("should reject anything without the feature gate", func() {
+		ar := createSnapshotGroupAdmissionReview(nil, newSnapshotGroup())
+		resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(Equal("snapshot feature gate not enabled"))
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			enableSnapshotFeatureGate(kvStore)
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring("unexpected resource"))
+		})
+
+		It("should accept a valid selector", func() {
+			ar := createSnapshotGroupAdmissionReview(nil, newSnapshotGroup())
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject an empty selector", func() {
+			group := newSnapshotGroup()
+			group.Spec.Selector = metav1.LabelSelector{}
+
+			ar := createSnapshotGroupAdmissionReview(nil, group)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.selector"))
+		})
+
+		It("should reject an invalid selector", func() {
+			group := newSnapshotGroup()
+			group.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: "Bogus"},
+			}
+
+			ar := createSnapshotGroupAdmissionReview(nil, group)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("invalid selector"))
+		})
+
+		It("should reject spec update", func() {
+			old := newSnapshotGroup()
+			group := newSnapshotGroup()
+			group.Spec.Selector.MatchLabels["app"] = "web"
+
+			ar := createSnapshotGroupAdmissionReview(old, group)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should allow metadata update", func() {
+			old := newSnapshotGroup()
+			group := newSnapshotGroup()
+			group.Labels = map[string]string{"foo": "bar"}
+
+			ar := createSnapshotGroupAdmissionReview(old, group)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Validating VirtualMachineRestoreGroup Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newRestoreGroup := func() *snapshotv1.VirtualMachineRestoreGroup {
+		return &snapshotv1.VirtualMachineRestoreGroup{
+			Spec: snapshotv1.VirtualMachineRestoreGroupSpec{
+				VirtualMachineSnapshotGroupName: "group",
+			},
+		}
+	}
+
+	It("should reject anything without the feature gate", func() {
+		ar := createRestoreGroupAdmissionReview(nil, newRestoreGroup())
+		resp := NewVMRestoreGroupAdmitter(config).Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(Equal("Snapshot/Restore feature gate not enabled"))
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			enableSnapshotFeatureGate(kvStore)
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should accept a restore of a snapshot group", func() {
+			ar := createRestoreGroupAdmissionReview(nil, newRestoreGroup())
+			resp := NewVMRestoreGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a missing snapshot group name", func() {
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Spec.VirtualMachineSnapshotGroupName = ""
+
+			ar := createRestoreGroupAdmissionReview(nil, restoreGroup)
+			resp := NewVMRestoreGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotGroupName"))
+		})
+
+		It("should reject spec update", func() {
+			old := newRestoreGroup()
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Spec.VirtualMachineSnapshotGroupName = "other"
+
+			ar := createRestoreGroupAdmissionReview(old, restoreGroup)
+			resp := NewVMRestoreGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func enableSnapshotFeatureGate(kvStore cache.Store) {
+	testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+		Spec: v1.KubeVirtSpec{
+			Configuration: v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{"Snapshot"},
+				},
+			},
+		},
+	})
+}
+
+func createGroupAdmissionReview(resource string, old, current interface{}) *admissionv1.AdmissionReview {
+	currentBytes, _ := json.Marshal(current)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: resource,
+			},
+			Object: runtime.RawExtension{
+				Raw: currentBytes,
+			},
+		},
+	}
+	if old != nil {
+		oldBytes, _ := json.Marshal(old)
+		ar.Request.Operation = admissionv1.Update
+		ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+	}
+
+	return ar
+}
+
+func createSnapshotGroupAdmissionReview(old, current *snapshotv1.VirtualMachineSnapshotGroup) *admissionv1.AdmissionReview {
+	if old == nil {
+		return createGroupAdmissionReview("virtualmachinesnapshotgroups", nil, current)
+	}
+	return createGroupAdmissionReview("virtualmachinesnapshotgroups", old, current)
+}
+
+func createRestoreGroupAdmissionReview(old, current *snapshotv1.VirtualMachineRestoreGroup) *admissionv1.AdmissionReview {
+	if old == nil {
+		return createGroupAdmissionReview("virtualmachinerestoregroups", nil, current)
+	}
+	return createGroupAdmissionReview("virtualmachinerestoregroups", old, current)
+}
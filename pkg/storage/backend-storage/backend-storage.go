@@ -125,6 +125,7 @@ func RecoverFromBrokenMigration(client kubecli.KubevirtClient, migration *corev1
 	// which would indicate that the libvirt migration finished.
 	// A JobComplete condition indicates the file is present, the migration was successful and the target PVC prevails
 	// A JobFailed condition indicated the file is absent, the migration didn't finish and the source PVC prevails
+	// The meta directory is never encrypted, so the job doesn't need the key of an encrypted PVC
 
 	jobName := "recover-" + migration.Name
 
@@ -307,6 +308,9 @@ func IsBackendStorageNeeded(obj interface{}) bool {
 
 // MigrationHandoff runs at the end of a successful live migration.
 // It labels the target backend-storage PVC as current for the VM and deletes the source backend-storage PVC.
+// When the VM state is encrypted, the key of the target PVC is re-wrapped with the current key encryption key,
+// whether the PVC changed or not, and the unwrapped key of the source PVC is removed. The key itself is not rotated,
+// the target runs with the key of the source as libvirt migrates the TPM state with it.
 func MigrationHandoff(client kubecli.KubevirtClient, pvcStore cache.Store, migration *corev1.VirtualMachineInstanceMigration) error {
 	if migration == nil || migration.Status.MigrationState == nil ||
 		(migration.Status.MigrationState.SourcePersistentStatePVCName == "" && !migration.IsDecentralized()) ||
@@ -317,17 +321,20 @@ func MigrationHandoff(client kubecli.KubevirtClient, pvcStore cache.Store, migra
 	sourcePVC := migration.Status.MigrationState.SourcePersistentStatePVCName
 	targetPVC := migration.Status.MigrationState.TargetPersistentStatePVCName
 
-	if sourcePVC == targetPVC {
-		// RWX backend-storage, nothing to do
-		return nil
-	}
-
 	// Let's label the target first, then remove the source.
 	// The target might already be labelled if this function was already called for this migration
-	target := pvcForMigrationTargetFromStore(pvcStore, migration)
+	target := PVCForMigrationTarget(pvcStore, migration)
 	if target == nil {
 		return fmt.Errorf("target PVC not found for migration %s/%s", migration.Namespace, migration.Name)
 	}
+	if err := rewrapEncryptionKey(client, target); err != nil {
+		return fmt.Errorf("failed to re-wrap the key of PVC %s: %v", targetPVC, err)
+	}
+
+	if sourcePVC == targetPVC {
+		// RWX backend-storage, nothing else to do
+		return nil
+	}
 	labels := target.Labels
 	if labels == nil {
 		labels = make(map[string]string)
@@ -355,6 +362,9 @@ func MigrationHandoff(client kubecli.KubevirtClient, pvcStore cache.Store, migra
 	}
 
 	if sourcePVC != "" {
+		if err := deleteLauncherKeys(client, migration.Namespace, sourcePVC); err != nil {
+			return fmt.Errorf("failed to delete the keys of PVC %s: %v", sourcePVC, err)
+		}
 		err := client.CoreV1().PersistentVolumeClaims(migration.Namespace).Delete(context.Background(), sourcePVC, metav1.DeleteOptions{})
 		if err != nil && !k8serrors.IsNotFound(err) {
 			return fmt.Errorf("failed to delete PVC: %v", err)
@@ -380,6 +390,9 @@ func MigrationAbort(client kubecli.KubevirtClient, migration *corev1.VirtualMach
 		return nil
 	}
 
+	if err := deleteLauncherKeys(client, migration.Namespace, targetPVC); err != nil {
+		return fmt.Errorf("failed to delete the keys of PVC %s: %v", targetPVC, err)
+	}
 	err := client.CoreV1().PersistentVolumeClaims(migration.Namespace).Delete(context.Background(), targetPVC, metav1.DeleteOptions{})
 	if err != nil && !k8serrors.IsNotFound(err) {
 		return fmt.Errorf("failed to delete PVC: %v", err)
@@ -502,7 +515,9 @@ func (bs *BackendStorage) UpdateVolumeStatus(vmi *corev1.VirtualMachineInstance,
 	})
 }
 
-func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels map[string]string) (*v1.PersistentVolumeClaim, error) {
+// createPVC creates a backend storage PVC, encrypted with the given key when a key provider is given.
+// The PVC is new and empty, so its content is encrypted from the first write on.
+func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels map[string]string, keyProvider keyProvider, key []byte) (*v1.PersistentVolumeClaim, error) {
 	storageClass, err := bs.getStorageClass()
 	if err != nil {
 		return nil, err
@@ -528,11 +543,17 @@ func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels m
 	// This helps avoid issues with provisioners that reject the hardcoded 10Mi PVC size used here.
 	labels[storagetypes.LabelApplyStorageProfile] = "true"
 
+	var annotations map[string]string
+	if keyProvider != nil {
+		annotations = map[string]string{EncryptionAnnotation: string(keyProvider.Name())}
+	}
+
 	pvc := &v1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName:    basePVC(vmi) + "-",
 			OwnerReferences: ownerReferences,
 			Labels:          labels,
+			Annotations:     annotations,
 		},
 		Spec: v1.PersistentVolumeClaimSpec{
 			AccessModes: []v1.PersistentVolumeAccessMode{accessMode},
@@ -549,6 +570,16 @@ func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels m
 		return nil, err
 	}
 
+	if keyProvider != nil {
+		if err := storeEncryptionKey(bs.client, pvc, key, keyProvider); err != nil {
+			// A PVC without its key can't be used, remove it to start over
+			if delErr := bs.client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Delete(context.Background(), pvc.Name, metav1.DeleteOptions{}); delErr != nil {
+				log.Log.Reason(delErr).Warningf("failed to delete backend storage PVC %s/%s without encryption key", pvc.Namespace, pvc.Name)
+			}
+			return nil, fmt.Errorf("failed to create the encryption key of backend storage PVC %s/%s: %v", pvc.Namespace, pvc.Name, err)
+		}
+	}
+
 	return pvc, nil
 }
 
@@ -559,7 +590,17 @@ func (bs *BackendStorage) DeletePVCForVMI(vmi *corev1.VirtualMachineInstance, pv
 func (bs *BackendStorage) CreatePVCForVMI(vmi *corev1.VirtualMachineInstance) (*v1.PersistentVolumeClaim, error) {
 	pvc := PVCForVMI(bs.pvcStore, vmi)
 	if pvc == nil {
-		return bs.createPVC(vmi, map[string]string{PVCPrefix: vmi.Name})
+		keyProvider, err := bs.encryptionKeyProvider()
+		if err != nil {
+			return nil, err
+		}
+		var key []byte
+		if keyProvider != nil {
+			if key, err = newEncryptionKey(); err != nil {
+				return nil, err
+			}
+		}
+		return bs.createPVC(vmi, map[string]string{PVCPrefix: vmi.Name}, keyProvider, key)
 	}
 
 	if _, exists := pvc.Labels[PVCPrefix]; !exists {
@@ -569,6 +610,11 @@ func (bs *BackendStorage) CreatePVCForVMI(vmi *corev1.VirtualMachineInstance) (*
 	return pvc, nil
 }
 
+// CreatePVCForMigrationTarget returns the backend storage PVC of a migration target.
+// The target PVC is encrypted if and only if the source PVC is, with the same key:
+// libvirt migrates the TPM state with the key of the source, and only a target
+// without a source PVC, like the target of a decentralized migration, starts unencrypted.
+// The key encryption key may still change, the key gets re-wrapped on handoff.
 func (bs *BackendStorage) CreatePVCForMigrationTarget(vmi *corev1.VirtualMachineInstance, migrationName string) (*v1.PersistentVolumeClaim, error) {
 	pvc := PVCForVMI(bs.pvcStore, vmi)
 	if pvc != nil {
@@ -578,7 +624,16 @@ func (bs *BackendStorage) CreatePVCForMigrationTarget(vmi *corev1.VirtualMachine
 		}
 	}
 
-	return bs.createPVC(vmi, map[string]string{corev1.MigrationNameLabel: migrationName})
+	var keyProvider keyProvider
+	var key []byte
+	if isEncrypted(pvc) {
+		var err error
+		if key, keyProvider, err = loadEncryptionKey(bs.client, pvc); err != nil {
+			return nil, err
+		}
+	}
+
+	return bs.createPVC(vmi, map[string]string{corev1.MigrationNameLabel: migrationName}, keyProvider, key)
 }
 
 // IsPVCReady returns true if either:
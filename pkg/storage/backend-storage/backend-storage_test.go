@@ -33,6 +33,9 @@ import (
 	v1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -45,6 +48,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/tpm"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -248,6 +252,11 @@ var _ = Describe("Backend Storage", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(sourcePVC.Labels).To(HaveKeyWithValue("persistent-state-for", vmiName))
 		})
+		It("Should recover from a broken migration without the key of an encrypted PVC", func() {
+			job := buildRecoveryJob("recover-"+migrationName, "launcher", migration)
+			Expect(job.Spec.Template.Spec.Volumes).To(HaveLen(1))
+			Expect(job.Spec.Template.Spec.Containers[0].VolumeMounts).To(ConsistOf(HaveField("SubPath", "meta")))
+		})
 		It("Should keep the shared PVC on migration failure", func() {
 			migration.Status.MigrationState.TargetPersistentStatePVCName = sourcePVCName
 			err := MigrationAbort(virtClient, migration)
@@ -288,10 +297,246 @@ var _ = Describe("Backend Storage", func() {
 			err := storageClassStore.Add(&sc)
 			Expect(err).NotTo(HaveOccurred())
 
-			pvc, err := backendStorage.createPVC(vmi, map[string]string{})
+			pvc, err := backendStorage.createPVC(vmi, map[string]string{}, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pvc).NotTo(BeNil())
 			Expect(pvc.Labels).To(HaveKeyWithValue(storagetypes.LabelApplyStorageProfile, "true"))
+			Expect(pvc.Annotations).ToNot(HaveKey(EncryptionAnnotation))
+		})
+
+		Context("with VM state encryption", func() {
+			var vmi *virtv1.VirtualMachineInstance
+
+			setEncryption := func(encryption *virtv1.VMStateEncryption) {
+				kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+				kvCR.Spec.Configuration.VMStateEncryption = encryption
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+			}
+
+			setKeyEncryptionKey := func(kek, previousKEK []byte) {
+				secret := &v1.Secret{
+					ObjectMeta: k8smetav1.ObjectMeta{Name: "kek"},
+					Data:       map[string][]byte{tpm.StateEncryptionKeyFile: kek, previousKeyEncryptionKeyEntry: previousKEK},
+				}
+				_, err := k8sClient.CoreV1().Secrets("kubevirt").Create(context.TODO(), secret, k8smetav1.CreateOptions{})
+				if errors.IsAlreadyExists(err) {
+					_, err = k8sClient.CoreV1().Secrets("kubevirt").Update(context.TODO(), secret, k8smetav1.UpdateOptions{})
+				}
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			useKMS := func() {
+				setKeyEncryptionKey([]byte("key-encryption-key"), nil)
+				setEncryption(&virtv1.VMStateEncryption{
+					KeyProvider: virtv1.VMStateKeyProviderKMS,
+					KMS:         &virtv1.VMStateKMS{KeyEncryptionKeySecret: "kek"},
+				})
+			}
+
+			getKeySecret := func(pvc *v1.PersistentVolumeClaim) *v1.Secret {
+				secret, err := k8sClient.CoreV1().Secrets(nsName).Get(context.TODO(), pvc.Name+"-key", k8smetav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.OwnerReferences).To(ConsistOf(HaveField("Name", pvc.Name)))
+				return secret
+			}
+
+			getLauncherKey := func(pvc *v1.PersistentVolumeClaim) []byte {
+				Expect(backendStorage.EnsureEncryptionKey(vmi, pvc)).To(Succeed())
+				secret, err := k8sClient.CoreV1().Secrets(nsName).Get(context.TODO(), EncryptionKeySecretName(vmi, pvc), k8smetav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.OwnerReferences).To(ConsistOf(HaveField("Name", vmiName)))
+				return secret.Data[tpm.StateEncryptionKeyFile]
+			}
+
+			createSourcePVC := func() *v1.PersistentVolumeClaim {
+				pvc, err := backendStorage.CreatePVCForVMI(vmi)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pvcStore.Add(pvc)).To(Succeed())
+				return pvc
+			}
+
+			createTargetPVC := func() *v1.PersistentVolumeClaim {
+				pvc, err := backendStorage.CreatePVCForMigrationTarget(vmi, "migration")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pvcStore.Add(pvc)).To(Succeed())
+				return pvc
+			}
+
+			BeforeEach(func() {
+				vmi = libvmi.New(libvmi.WithName(vmiName), libvmi.WithNamespace(nsName), libvmi.WithTPM(true))
+				vmi.UID = "vmi-uid"
+				Expect(storageClassStore.Add(&storagev1.StorageClass{
+					ObjectMeta: k8smetav1.ObjectMeta{
+						Name:        "sc",
+						Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+					},
+				})).To(Succeed())
+
+				// The fake clientset doesn't honor generateName
+				generatedNames := 0
+				k8sClient.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (bool, runtime.Object, error) {
+					pvc := action.(testing.CreateAction).GetObject().(*v1.PersistentVolumeClaim)
+					if pvc.Name == "" {
+						generatedNames++
+						pvc.Name = fmt.Sprintf("%s%d", pvc.GenerateName, generatedNames)
+						pvc.Namespace = nsName
+						pvc.UID = types.UID(pvc.Name)
+					}
+					return false, nil, nil
+				})
+			})
+
+			It("should store a random key next to the PVC with the Secret key provider", func() {
+				setEncryption(&virtv1.VMStateEncryption{KeyProvider: virtv1.VMStateKeyProviderSecret})
+
+				pvc := createSourcePVC()
+				Expect(pvc.Annotations).To(HaveKeyWithValue(EncryptionAnnotation, string(virtv1.VMStateKeyProviderSecret)))
+				key := getKeySecret(pvc).Data[wrappedKeyEntry]
+				Expect(key).To(HaveLen(encryptionKeySize))
+				Expect(getLauncherKey(pvc)).To(Equal(key))
+			})
+
+			It("should only keep the wrapped key at rest with the KMS key provider", func() {
+				useKMS()
+
+				pvc := createSourcePVC()
+				Expect(pvc.Annotations).To(HaveKeyWithValue(EncryptionAnnotation, string(virtv1.VMStateKeyProviderKMS)))
+				keySecret := getKeySecret(pvc)
+				Expect(keySecret.Data).To(HaveLen(1))
+				Expect(keySecret.Annotations).To(HaveKeyWithValue(keyEncryptionKeyAnnotation, "kek"))
+
+				key := getLauncherKey(pvc)
+				Expect(key).To(HaveLen(encryptionKeySize))
+				Expect(keySecret.Data[wrappedKeyEntry]).ToNot(ContainSubstring(string(key)))
+			})
+
+			It("should give the key of the source to the backend storage of a migration target", func() {
+				useKMS()
+
+				sourcePVC := createSourcePVC()
+				targetPVC := createTargetPVC()
+
+				Expect(targetPVC.Name).ToNot(Equal(sourcePVC.Name))
+				Expect(targetPVC.Annotations).To(HaveKeyWithValue(EncryptionAnnotation, string(virtv1.VMStateKeyProviderKMS)))
+				Expect(getLauncherKey(targetPVC)).To(Equal(getLauncherKey(sourcePVC)))
+			})
+
+			It("should not encrypt the backend storage of a migration target when the source is not encrypted", func() {
+				sourcePVC := createSourcePVC()
+				Expect(sourcePVC.Annotations).ToNot(HaveKey(EncryptionAnnotation))
+				useKMS()
+
+				targetPVC := createTargetPVC()
+				Expect(targetPVC.Annotations).ToNot(HaveKey(EncryptionAnnotation))
+				Expect(EncryptionKeySecretName(vmi, targetPVC)).To(BeEmpty())
+			})
+
+			It("should keep encrypting the backend storage of a migration target when the encryption got disabled", func() {
+				useKMS()
+				sourcePVC := createSourcePVC()
+				setEncryption(nil)
+
+				targetPVC := createTargetPVC()
+				Expect(targetPVC.Annotations).To(HaveKeyWithValue(EncryptionAnnotation, string(virtv1.VMStateKeyProviderKMS)))
+				Expect(getLauncherKey(targetPVC)).To(Equal(getLauncherKey(sourcePVC)))
+			})
+
+			It("should only rotate the key encryption key on handoff of a shared PVC", func() {
+				useKMS()
+				pvc := createSourcePVC()
+				key := getLauncherKey(pvc)
+				wrappedKey := getKeySecret(pvc).Data[wrappedKeyEntry]
+
+				By("Rotating the key encryption key")
+				setKeyEncryptionKey([]byte("new-key-encryption-key"), []byte("key-encryption-key"))
+
+				migration := &virtv1.VirtualMachineInstanceMigration{
+					ObjectMeta: k8smetav1.ObjectMeta{Name: "migration", Namespace: nsName},
+					Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: vmiName},
+					Status: virtv1.VirtualMachineInstanceMigrationStatus{
+						MigrationState: &virtv1.VirtualMachineInstanceMigrationState{
+							SourcePersistentStatePVCName: pvc.Name,
+							TargetPersistentStatePVCName: pvc.Name,
+						},
+					},
+				}
+				Expect(MigrationHandoff(virtClient, pvcStore, migration)).To(Succeed())
+				Expect(getKeySecret(pvc).Data[wrappedKeyEntry]).ToNot(Equal(wrappedKey))
+
+				By("Dropping the previous key encryption key")
+				setKeyEncryptionKey([]byte("new-key-encryption-key"), nil)
+				unwrappedKey, _, err := loadEncryptionKey(virtClient, pvc)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(unwrappedKey).To(Equal(key))
+			})
+
+			It("should keep the key as it is on handoff with the Secret key provider", func() {
+				setEncryption(&virtv1.VMStateEncryption{KeyProvider: virtv1.VMStateKeyProviderSecret})
+				sourcePVC := createSourcePVC()
+				key := getLauncherKey(sourcePVC)
+				targetPVC := createTargetPVC()
+				keySecret := getKeySecret(targetPVC)
+
+				migration := &virtv1.VirtualMachineInstanceMigration{
+					ObjectMeta: k8smetav1.ObjectMeta{Name: "migration", Namespace: nsName},
+					Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: vmiName},
+					Status: virtv1.VirtualMachineInstanceMigrationStatus{
+						MigrationState: &virtv1.VirtualMachineInstanceMigrationState{
+							SourcePersistentStatePVCName: sourcePVC.Name,
+							TargetPersistentStatePVCName: targetPVC.Name,
+						},
+					},
+				}
+				Expect(MigrationHandoff(virtClient, pvcStore, migration)).To(Succeed())
+				Expect(getKeySecret(targetPVC).Data).To(Equal(keySecret.Data))
+				Expect(getLauncherKey(targetPVC)).To(Equal(key))
+			})
+
+			It("should remove the unwrapped key of the source on handoff", func() {
+				useKMS()
+				sourcePVC := createSourcePVC()
+				getLauncherKey(sourcePVC)
+				targetPVC := createTargetPVC()
+				getLauncherKey(targetPVC)
+
+				migration := &virtv1.VirtualMachineInstanceMigration{
+					ObjectMeta: k8smetav1.ObjectMeta{Name: "migration", Namespace: nsName},
+					Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: vmiName},
+					Status: virtv1.VirtualMachineInstanceMigrationStatus{
+						MigrationState: &virtv1.VirtualMachineInstanceMigrationState{
+							SourcePersistentStatePVCName: sourcePVC.Name,
+							TargetPersistentStatePVCName: targetPVC.Name,
+						},
+					},
+				}
+				Expect(MigrationHandoff(virtClient, pvcStore, migration)).To(Succeed())
+
+				_, err := k8sClient.CoreV1().Secrets(nsName).Get(context.TODO(), EncryptionKeySecretName(vmi, sourcePVC), k8smetav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+				_, err = k8sClient.CoreV1().Secrets(nsName).Get(context.TODO(), EncryptionKeySecretName(vmi, targetPVC), k8smetav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not leave a PVC behind when its key can't be created", func() {
+				setEncryption(&virtv1.VMStateEncryption{
+					KeyProvider: virtv1.VMStateKeyProviderKMS,
+					KMS:         &virtv1.VMStateKMS{KeyEncryptionKeySecret: "missing"},
+				})
+
+				_, err := backendStorage.CreatePVCForVMI(vmi)
+				Expect(err).To(MatchError(ContainSubstring("failed to get the key encryption key")))
+
+				pvcs, err := k8sClient.CoreV1().PersistentVolumeClaims(nsName).List(context.TODO(), k8smetav1.ListOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pvcs.Items).To(BeEmpty())
+			})
+
+			It("should reject the KMS key provider without a key encryption key", func() {
+				setEncryption(&virtv1.VMStateEncryption{KeyProvider: virtv1.VMStateKeyProviderKMS})
+
+				_, err := backendStorage.CreatePVCForVMI(vmi)
+				Expect(err).To(MatchError(ContainSubstring("requires a key encryption key secret")))
+			})
 		})
 	})
 
@@ -0,0 +1,349 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backendstorage
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	clientutil "kubevirt.io/client-go/util"
+
+	"kubevirt.io/kubevirt/pkg/tpm"
+)
+
+const (
+	// EncryptionAnnotation marks a backend storage PVC whose content is encrypted,
+	// its value is the key provider of the key
+	EncryptionAnnotation = "kubevirt.io/backend-storage-encryption"
+
+	// keyEncryptionKeyAnnotation records on the key Secret of a PVC the Secret holding the key encryption key
+	keyEncryptionKeyAnnotation = "kubevirt.io/backend-storage-key-encryption-key"
+	// launcherKeyLabel marks the Secrets handing the key of a PVC over to virt-launcher, its value is the PVC name
+	launcherKeyLabel = "kubevirt.io/backend-storage-key-for"
+
+	encryptionKeySuffix = "-key"
+	encryptionKeySize   = 32
+
+	// wrappedKeyEntry is the entry of the key Secret of a PVC holding the wrapped key
+	wrappedKeyEntry = "wrapped-key"
+	// previousKeyEncryptionKeyEntry lets the key encryption key rotate, keys wrapped
+	// with the previous one are re-wrapped with the new one on the next migration
+	previousKeyEncryptionKeyEntry = "previous-key"
+)
+
+// keyProvider wraps the key encrypting the content of a backend storage PVC, so that only the
+// wrapped key is kept at rest
+type keyProvider interface {
+	Name() corev1.VMStateKeyProvider
+	Wrap(pvc *v1.PersistentVolumeClaim, key []byte) ([]byte, error)
+	Unwrap(pvc *v1.PersistentVolumeClaim, wrappedKey []byte) ([]byte, error)
+	// annotations records on the key Secret what is needed to unwrap the key
+	annotations() map[string]string
+}
+
+// secretKeyProvider keeps the key in the Secret as is, the Secret is the key store
+type secretKeyProvider struct{}
+
+func (secretKeyProvider) Name() corev1.VMStateKeyProvider {
+	return corev1.VMStateKeyProviderSecret
+}
+
+func (secretKeyProvider) Wrap(_ *v1.PersistentVolumeClaim, key []byte) ([]byte, error) {
+	return key, nil
+}
+
+func (secretKeyProvider) Unwrap(_ *v1.PersistentVolumeClaim, wrappedKey []byte) ([]byte, error) {
+	return wrappedKey, nil
+}
+
+func (secretKeyProvider) annotations() map[string]string {
+	return nil
+}
+
+// kmsKeyProvider wraps the key with a key encryption key held by the KMS, so that the key
+// can't be recovered without the KMS. Until KMS plugins are supported, the key encryption key
+// is read from a Secret in the install namespace.
+type kmsKeyProvider struct {
+	client     kubecli.KubevirtClient
+	secretName string
+}
+
+func (p kmsKeyProvider) Name() corev1.VMStateKeyProvider {
+	return corev1.VMStateKeyProviderKMS
+}
+
+func (p kmsKeyProvider) keyEncryptionKeys() (current []byte, previous []byte, err error) {
+	namespace, err := clientutil.GetNamespace()
+	if err != nil {
+		return nil, nil, err
+	}
+	secret, err := p.client.CoreV1().Secrets(namespace).Get(context.Background(), p.secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the key encryption key: %v", err)
+	}
+	current = secret.Data[tpm.StateEncryptionKeyFile]
+	if len(current) == 0 {
+		return nil, nil, fmt.Errorf("secret %s/%s has no %q entry", namespace, p.secretName, tpm.StateEncryptionKeyFile)
+	}
+	return current, secret.Data[previousKeyEncryptionKeyEntry], nil
+}
+
+// keyEncryptionCipher turns key encryption keys of any length into an AES-256-GCM cipher
+func keyEncryptionCipher(kek []byte) (cipher.AEAD, error) {
+	aesKey := sha256.Sum256(kek)
+	block, err := aes.NewCipher(aesKey[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (p kmsKeyProvider) Wrap(pvc *v1.PersistentVolumeClaim, key []byte) ([]byte, error) {
+	kek, _, err := p.keyEncryptionKeys()
+	if err != nil {
+		return nil, err
+	}
+	aead, err := keyEncryptionCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	// The wrapped key is bound to its PVC
+	return aead.Seal(nonce, nonce, key, []byte(pvc.UID)), nil
+}
+
+func (p kmsKeyProvider) Unwrap(pvc *v1.PersistentVolumeClaim, wrappedKey []byte) ([]byte, error) {
+	kek, previousKEK, err := p.keyEncryptionKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range [][]byte{kek, previousKEK} {
+		if len(k) == 0 {
+			continue
+		}
+		aead, err := keyEncryptionCipher(k)
+		if err != nil {
+			return nil, err
+		}
+		if len(wrappedKey) < aead.NonceSize() {
+			return nil, fmt.Errorf("the wrapped key of PVC %s/%s is truncated", pvc.Namespace, pvc.Name)
+		}
+		nonce, sealed := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+		if key, err := aead.Open(nil, nonce, sealed, []byte(pvc.UID)); err == nil {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to unwrap the key of PVC %s/%s with the key encryption key", pvc.Namespace, pvc.Name)
+}
+
+func (p kmsKeyProvider) annotations() map[string]string {
+	return map[string]string{keyEncryptionKeyAnnotation: p.secretName}
+}
+
+func isEncrypted(pvc *v1.PersistentVolumeClaim) bool {
+	if pvc == nil {
+		return false
+	}
+	_, encrypted := pvc.Annotations[EncryptionAnnotation]
+	return encrypted
+}
+
+func keySecretName(pvcName string) string {
+	return pvcName + encryptionKeySuffix
+}
+
+// EncryptionKeySecretName returns the name of the Secret handing the key of an encrypted
+// backend storage PVC over to the virt-launcher of a VMI, or an empty string if the PVC is not encrypted
+func EncryptionKeySecretName(vmi *corev1.VirtualMachineInstance, pvc *v1.PersistentVolumeClaim) string {
+	if !isEncrypted(pvc) {
+		return ""
+	}
+	return keySecretName(pvc.Name) + "-" + string(vmi.UID)
+}
+
+func newEncryptionKey() ([]byte, error) {
+	key := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (bs *BackendStorage) encryptionKeyProvider() (keyProvider, error) {
+	encryption := bs.clusterConfig.GetVMStateEncryption()
+	if encryption == nil {
+		return nil, nil
+	}
+
+	switch encryption.KeyProvider {
+	case corev1.VMStateKeyProviderSecret, "":
+		return secretKeyProvider{}, nil
+	case corev1.VMStateKeyProviderKMS:
+		if encryption.KMS == nil || encryption.KMS.KeyEncryptionKeySecret == "" {
+			return nil, fmt.Errorf("the KMS key provider requires a key encryption key secret")
+		}
+		return kmsKeyProvider{client: bs.client, secretName: encryption.KMS.KeyEncryptionKeySecret}, nil
+	default:
+		return nil, fmt.Errorf("unknown VM state key provider %s", encryption.KeyProvider)
+	}
+}
+
+// keyProviderFor returns the key provider which wrapped the key stored in a key Secret
+func keyProviderFor(client kubecli.KubevirtClient, secret *v1.Secret) (keyProvider, error) {
+	switch corev1.VMStateKeyProvider(secret.Annotations[EncryptionAnnotation]) {
+	case corev1.VMStateKeyProviderSecret:
+		return secretKeyProvider{}, nil
+	case corev1.VMStateKeyProviderKMS:
+		return kmsKeyProvider{client: client, secretName: secret.Annotations[keyEncryptionKeyAnnotation]}, nil
+	default:
+		return nil, fmt.Errorf("unknown key provider of secret %s/%s", secret.Namespace, secret.Name)
+	}
+}
+
+// loadEncryptionKey unwraps the key of an encrypted PVC
+func loadEncryptionKey(client kubecli.KubevirtClient, pvc *v1.PersistentVolumeClaim) ([]byte, keyProvider, error) {
+	secret, err := client.CoreV1().Secrets(pvc.Namespace).Get(context.Background(), keySecretName(pvc.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the key of backend storage PVC %s/%s: %v", pvc.Namespace, pvc.Name, err)
+	}
+	provider, err := keyProviderFor(client, secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := provider.Unwrap(pvc, secret.Data[wrappedKeyEntry])
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, provider, nil
+}
+
+// storeEncryptionKey wraps the key of a PVC and stores it in a Secret owned by the PVC,
+// so that the key goes away together with the PVC
+func storeEncryptionKey(client kubecli.KubevirtClient, pvc *v1.PersistentVolumeClaim, key []byte, provider keyProvider) error {
+	wrappedKey, err := provider.Wrap(pvc, key)
+	if err != nil {
+		return err
+	}
+
+	annotations := map[string]string{EncryptionAnnotation: string(provider.Name())}
+	for k, v := range provider.annotations() {
+		annotations[k] = v
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        keySecretName(pvc.Name),
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(pvc, v1.SchemeGroupVersion.WithKind("PersistentVolumeClaim")),
+			},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			wrappedKeyEntry: wrappedKey,
+		},
+	}
+
+	_, err = client.CoreV1().Secrets(pvc.Namespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		_, err = client.CoreV1().Secrets(pvc.Namespace).Update(context.Background(), secret, metav1.UpdateOptions{})
+	}
+	return err
+}
+
+// rewrapEncryptionKey wraps the key of an encrypted PVC again, with the current key encryption key.
+// Only the key encryption key rotates, the key stays the same and the Secret key provider stores it as it was.
+func rewrapEncryptionKey(client kubecli.KubevirtClient, pvc *v1.PersistentVolumeClaim) error {
+	if !isEncrypted(pvc) {
+		return nil
+	}
+	key, provider, err := loadEncryptionKey(client, pvc)
+	if err != nil {
+		return err
+	}
+	return storeEncryptionKey(client, pvc, key, provider)
+}
+
+// EnsureEncryptionKey hands the unwrapped key of an encrypted backend storage PVC over to the
+// virt-launcher of a VMI. The Secret is owned by the VMI, the unwrapped key is only kept while the VMI exists.
+// Anyone who can read the Secrets of the namespace can read the key meanwhile, the encryption only protects
+// the VM state from those with access to the PVC or its storage.
+func (bs *BackendStorage) EnsureEncryptionKey(vmi *corev1.VirtualMachineInstance, pvc *v1.PersistentVolumeClaim) error {
+	secretName := EncryptionKeySecretName(vmi, pvc)
+	if secretName == "" {
+		return nil
+	}
+	_, err := bs.client.CoreV1().Secrets(pvc.Namespace).Get(context.Background(), secretName, metav1.GetOptions{})
+	if err == nil || !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	key, _, err := loadEncryptionKey(bs.client, pvc)
+	if err != nil {
+		return err
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   secretName,
+			Labels: map[string]string{launcherKeyLabel: pvc.Name},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vmi, corev1.VirtualMachineInstanceGroupVersionKind),
+			},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			tpm.StateEncryptionKeyFile: key,
+		},
+	}
+	_, err = bs.client.CoreV1().Secrets(pvc.Namespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteLauncherKeys removes the unwrapped keys of a PVC which is not used anymore
+func deleteLauncherKeys(client kubecli.KubevirtClient, namespace, pvcName string) error {
+	secrets, err := client.CoreV1().Secrets(namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: launcherKeyLabel + "=" + pvcName,
+	})
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets.Items {
+		err := client.CoreV1().Secrets(namespace).Delete(context.Background(), secret.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
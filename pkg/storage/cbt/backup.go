@@ -21,12 +21,14 @@ package cbt
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -43,6 +45,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/freezehooks"
+	"kubevirt.io/kubevirt/pkg/storage/types"
 )
 
 const (
@@ -70,6 +74,8 @@ const (
 	trackerCheckpointRedefinitionPending = "Waiting for checkpoint redefinition on tracker %s"
 	invalidBackupModeMsg                 = "invalid backup mode: %s"
 	backupSourceNameEmptyMsg             = "Source name is empty"
+	freezeHookFailedMsg                  = "freeze hook failed: %s"
+	freezeHooksRunningMsg                = "Waiting for the %s hooks to finish"
 )
 
 var (
@@ -77,24 +83,35 @@ var (
 )
 
 type VMBackupController struct {
-	client                kubecli.KubevirtClient
-	backupInformer        cache.SharedIndexInformer
-	backupTrackerInformer cache.SharedIndexInformer
-	vmStore               cache.Store
-	vmiStore              cache.Store
-	pvcStore              cache.Store
-	recorder              record.EventRecorder
-	backupQueue           workqueue.TypedRateLimitingInterface[string]
-	trackerQueue          workqueue.TypedRateLimitingInterface[string]
-	hasSynced             func() bool
+	client                 kubecli.KubevirtClient
+	backupInformer         cache.SharedIndexInformer
+	backupTrackerInformer  cache.SharedIndexInformer
+	backupScheduleInformer cache.SharedIndexInformer
+	backupRestoreInformer  cache.SharedIndexInformer
+	vmStore                cache.Store
+	vmiStore               cache.Store
+	pvcStore               cache.Store
+	podStore               cache.Store
+	launcherImage          string
+	recorder               record.EventRecorder
+	backupQueue            workqueue.TypedRateLimitingInterface[string]
+	trackerQueue           workqueue.TypedRateLimitingInterface[string]
+	scheduleQueue          workqueue.TypedRateLimitingInterface[string]
+	restoreQueue           workqueue.TypedRateLimitingInterface[string]
+	freezeHooks            *freezehooks.AsyncRunner
+	hasSynced              func() bool
 }
 
 func NewVMBackupController(client kubecli.KubevirtClient,
 	backupInformer cache.SharedIndexInformer,
 	backupTrackerInformer cache.SharedIndexInformer,
+	backupScheduleInformer cache.SharedIndexInformer,
+	backupRestoreInformer cache.SharedIndexInformer,
 	vmInformer cache.SharedIndexInformer,
 	vmiInformer cache.SharedIndexInformer,
 	pvcInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	launcherImage string,
 	recorder record.EventRecorder,
 ) (*VMBackupController, error) {
 	c := &VMBackupController{
@@ -106,17 +123,34 @@ func NewVMBackupController(client kubecli.KubevirtClient,
 			workqueue.DefaultTypedControllerRateLimiter[string](),
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmbackup-tracker"},
 		),
-		backupInformer:        backupInformer,
-		backupTrackerInformer: backupTrackerInformer,
-		vmStore:               vmInformer.GetStore(),
-		vmiStore:              vmiInformer.GetStore(),
-		pvcStore:              pvcInformer.GetStore(),
-		recorder:              recorder,
-		client:                client,
-	}
+		scheduleQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmbackup-schedule"},
+		),
+		restoreQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmbackup-restore"},
+		),
+		backupInformer:         backupInformer,
+		backupTrackerInformer:  backupTrackerInformer,
+		backupScheduleInformer: backupScheduleInformer,
+		backupRestoreInformer:  backupRestoreInformer,
+		vmStore:                vmInformer.GetStore(),
+		vmiStore:               vmiInformer.GetStore(),
+		pvcStore:               pvcInformer.GetStore(),
+		podStore:               podInformer.GetStore(),
+		launcherImage:          launcherImage,
+		recorder:               recorder,
+		client:                 client,
+	}
+	c.freezeHooks = freezehooks.NewAsyncRunner(client, func(key string) {
+		c.backupQueue.Add(key)
+	})
 
 	c.hasSynced = func() bool {
-		return backupInformer.HasSynced() && backupTrackerInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && pvcInformer.HasSynced()
+		return backupInformer.HasSynced() && backupTrackerInformer.HasSynced() && backupScheduleInformer.HasSynced() &&
+			backupRestoreInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() &&
+			pvcInformer.HasSynced() && podInformer.HasSynced()
 	}
 
 	_, err := backupInformer.AddEventHandler(
@@ -148,6 +182,36 @@ func NewVMBackupController(client kubecli.KubevirtClient,
 		return nil, err
 	}
 
+	_, err = backupScheduleInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleBackupSchedule,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleBackupSchedule(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = backupRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleBackupRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleBackupRestore(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = podInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleBackupRestorePod(newObj) },
+			DeleteFunc: c.handleBackupRestorePod,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
@@ -165,6 +229,7 @@ func (ctrl *VMBackupController) handleBackup(obj interface{}) {
 
 		log.Log.V(3).Infof("enqueued %q for sync", objName)
 		ctrl.backupQueue.Add(objName)
+		ctrl.enqueueBackupSchedule(backup)
 	}
 }
 
@@ -249,6 +314,8 @@ func (ctrl *VMBackupController) Run(threadiness int, stopCh <-chan struct{}) err
 	defer utilruntime.HandleCrash()
 	defer ctrl.backupQueue.ShutDown()
 	defer ctrl.trackerQueue.ShutDown()
+	defer ctrl.scheduleQueue.ShutDown()
+	defer ctrl.restoreQueue.ShutDown()
 
 	log.Log.Info("Starting backup controller.")
 	defer log.Log.Info("Shutting down backup controller.")
@@ -263,6 +330,8 @@ func (ctrl *VMBackupController) Run(threadiness int, stopCh <-chan struct{}) err
 	for range threadiness {
 		go wait.Until(ctrl.runWorker, time.Second, stopCh)
 		go wait.Until(ctrl.runTrackerWorker, time.Second, stopCh)
+		go wait.Until(ctrl.runScheduleWorker, time.Second, stopCh)
+		go wait.Until(ctrl.runRestoreWorker, time.Second, stopCh)
 	}
 
 	<-stopCh
@@ -299,7 +368,11 @@ type SyncInfo struct {
 	event           string
 	checkpointName  *string
 	backupType      backupv1.BackupType
-	includedVolumes []backupv1.BackupVolumeInfo
+	includedVolumes []v1.BackupVolumeInfo
+	export          *backupv1.BackupExport
+	baseCheckpoint  *string
+	virtualMachine  *runtime.RawExtension
+	freezeHooks     []v1.FreezeHookResult
 }
 
 func syncInfoError(err error) *SyncInfo {
@@ -398,6 +471,22 @@ func (ctrl *VMBackupController) sync(backup *backupv1.VirtualMachineBackup) *Syn
 			}
 		}
 
+		if failure := failedFreezeHook(backup); failure != "" {
+			if vmiExists {
+				done, syncInfo := ctrl.cleanup(backup, vmi)
+				if syncInfo != nil {
+					return syncInfo
+				}
+				if !done {
+					return syncInfoError(fmt.Errorf("ongoing cleanup for failed freeze hook"))
+				}
+			}
+			return &SyncInfo{
+				event:  backupFailedEvent,
+				reason: fmt.Sprintf(backupFailed, failure),
+			}
+		}
+
 		if !sourceExists {
 			return &SyncInfo{
 				event:  backupInitializingEvent,
@@ -453,14 +542,21 @@ func (ctrl *VMBackupController) sync(backup *backupv1.VirtualMachineBackup) *Syn
 			return syncInfo
 		}
 
-		if backupDeleting {
+		if backupDeleting || isBackupExportExpired(backup) {
 			backupStatus := vmi.Status.ChangedBlockTracking.BackupStatus
 			if !backupStatus.Completed && backupStatus.BackupName == backup.Name {
+				if !backupDeleting {
+					logger.Infof(backupExportExpiredMsg, backup.Name)
+				}
 				if syncInfo := ctrl.handleAbort(backup, vmi); syncInfo != nil {
 					return syncInfo
 				}
 			}
 		}
+
+		if syncInfo := ctrl.collectPostThawHooks(backup, vmi); syncInfo != nil {
+			return syncInfo
+		}
 	}
 
 	return ctrl.checkBackupCompletion(backup, vmi, backupTracker)
@@ -505,6 +601,8 @@ func (ctrl *VMBackupController) handleBackupInitiation(backup *backupv1.VirtualM
 		}
 		backupOptions.Mode = backupv1.PushMode
 		backupOptions.PushPath = pointer.P(hotplugdisk.GetVolumeMountDir(volumeName))
+	case backupv1.PullMode:
+		backupOptions.Mode = backupv1.PullMode
 	default:
 		logger.Errorf(invalidBackupModeMsg, *backup.Spec.Mode)
 		return syncInfoError(fmt.Errorf(invalidBackupModeMsg, *backup.Spec.Mode))
@@ -513,9 +611,37 @@ func (ctrl *VMBackupController) handleBackupInitiation(backup *backupv1.VirtualM
 	logger.Infof("Starting backup for VMI %s with mode %s", vmi.Name, backupOptions.Mode)
 	backupType := backupv1.Full
 	if isIncrementalBackup(backup, backupTracker) {
-		backupOptions.Incremental = pointer.P(backupTracker.Status.LatestCheckpoint.Name)
-		backupType = backupv1.Incremental
-		logger.Infof("Setting incremental backup from checkpoint: %s", backupTracker.Status.LatestCheckpoint.Name)
+		if reason := fullBackupRequiredReason(backupTracker, time.Now()); reason != "" {
+			logger.Infof(retentionFullBackupRequiredMsg, backupTracker.Name, reason)
+		} else {
+			backupOptions.Incremental = pointer.P(backupTracker.Status.LatestCheckpoint.Name)
+			backupType = backupv1.Incremental
+			logger.Infof("Setting incremental backup from checkpoint: %s", backupTracker.Status.LatestCheckpoint.Name)
+		}
+	}
+
+	virtualMachine, err := ctrl.captureVirtualMachine(vmi.Namespace, vmi.Name)
+	if err != nil {
+		err = fmt.Errorf("failed to capture the VM definition: %w", err)
+		logger.Error(err.Error())
+		return syncInfoError(err)
+	}
+
+	hookResults, done, err := ctrl.runFreezeHooks(backup, vmi, v1.PreFreezeStage)
+	if !done {
+		return &SyncInfo{
+			event:  backupInitializingEvent,
+			reason: fmt.Sprintf(freezeHooksRunningMsg, v1.PreFreezeStage),
+		}
+	}
+	if err != nil {
+		// the backup fails once the target is cleaned up
+		logger.Reason(err).Error("Pre-freeze hook failed")
+		return &SyncInfo{
+			event:       backupInitializingEvent,
+			reason:      fmt.Sprintf(freezeHookFailedMsg, err),
+			freezeHooks: hookResults,
+		}
 	}
 
 	err = ctrl.client.VirtualMachineInstance(vmi.Namespace).Backup(context.Background(), vmi.Name, &backupOptions)
@@ -526,11 +652,110 @@ func (ctrl *VMBackupController) handleBackupInitiation(backup *backupv1.VirtualM
 	}
 	logger.Infof("Started backup for VMI %s successfully", vmi.Name)
 
+	// the guest filesystem is thawed once the backup job started, the
+	// results of the post-thaw hooks are collected while the backup is
+	// progressing
+	_, _, _ = ctrl.runFreezeHooks(backup, vmi, v1.PostThawStage)
+
+	return &SyncInfo{
+		event:          backupInitiatedEvent,
+		reason:         backupInProgress,
+		backupType:     backupType,
+		baseCheckpoint: backupOptions.Incremental,
+		virtualMachine: virtualMachine,
+		freezeHooks:    hookResults,
+	}
+}
+
+// runFreezeHooks starts the hooks of the given stage in the background
+// unless the backup skips the freeze of the guest filesystem. done is false
+// until the hooks finished, the backup is requeued at that point.
+func (ctrl *VMBackupController) runFreezeHooks(backup *backupv1.VirtualMachineBackup, vmi *v1.VirtualMachineInstance, stage v1.FreezeHookStage) ([]v1.FreezeHookResult, bool, error) {
+	if backup.Spec.SkipQuiesce || backup.Spec.Hooks == nil {
+		return nil, true, nil
+	}
+	hooks := backup.Spec.Hooks.PreFreeze
+	if stage == v1.PostThawStage {
+		hooks = backup.Spec.Hooks.PostThaw
+	}
+	if len(hooks) == 0 {
+		return nil, true, nil
+	}
+	key := cacheKeyFunc(backup.Namespace, backup.Name)
+	results, done, err := ctrl.freezeHooks.Run(key, vmi.Namespace, vmi.Name, stage, hooks)
+	if !done {
+		log.Log.Object(backup).V(3).Infof("Waiting for %s hooks of the backup", stage)
+	}
+	return results, done, err
+}
+
+// collectPostThawHooks records the results of the post-thaw hooks started
+// along with the backup. The backup does not complete before they finished.
+func (ctrl *VMBackupController) collectPostThawHooks(backup *backupv1.VirtualMachineBackup, vmi *v1.VirtualMachineInstance) *SyncInfo {
+	if !ctrl.freezeHooks.Started(cacheKeyFunc(backup.Namespace, backup.Name), v1.PostThawStage) {
+		return nil
+	}
+	results, done, err := ctrl.runFreezeHooks(backup, vmi, v1.PostThawStage)
+	if !done {
+		return &SyncInfo{}
+	}
+	if err != nil {
+		log.Log.Object(backup).Reason(err).Error("Post-thaw hook failed")
+	}
 	return &SyncInfo{
-		event:      backupInitiatedEvent,
-		reason:     backupInProgress,
-		backupType: backupType,
+		freezeHooks: freezehooks.MergeResults(backup.Status.FreezeHooks, results, v1.PostThawStage),
+	}
+}
+
+// failedFreezeHook returns a description of the first recorded freeze hook
+// failure which must fail the backup, or an empty string
+func failedFreezeHook(backup *backupv1.VirtualMachineBackup) string {
+	if backup.Status == nil || backup.Spec.Hooks == nil {
+		return ""
+	}
+	for _, result := range backup.Status.FreezeHooks {
+		if result.Succeeded {
+			continue
+		}
+		hooks := backup.Spec.Hooks.PreFreeze
+		if result.Stage == v1.PostThawStage {
+			hooks = backup.Spec.Hooks.PostThaw
+		}
+		for _, hook := range hooks {
+			if hook.Name == result.Name && freezehooks.IsFailPolicy(hook) {
+				return fmt.Sprintf("%s hook %s failed: %s", result.Stage, result.Name, result.Message)
+			}
+		}
 	}
+	return ""
+}
+
+// captureVirtualMachine returns the definition of the VM as it is when the
+// backup starts, it is used to recreate the VM when the backup is restored
+func (ctrl *VMBackupController) captureVirtualMachine(namespace, name string) (*runtime.RawExtension, error) {
+	obj, exists, err := ctrl.vmStore.GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	vm := obj.(*v1.VirtualMachine)
+
+	captured := &v1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.GroupVersion.String(),
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        vm.Name,
+			Labels:      vm.Labels,
+			Annotations: vm.Annotations,
+		},
+		Spec: vm.Spec,
+	}
+	raw, err := json.Marshal(captured)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
 }
 
 func (ctrl *VMBackupController) handleAbort(backup *backupv1.VirtualMachineBackup, vmi *v1.VirtualMachineInstance) *SyncInfo {
@@ -592,6 +817,12 @@ func (ctrl *VMBackupController) updateStatus(backup *backupv1.VirtualMachineBack
 			if syncInfo.backupType != "" {
 				backupOut.Status.Type = syncInfo.backupType
 			}
+			if syncInfo.baseCheckpoint != nil {
+				backupOut.Status.BaseCheckpointName = syncInfo.baseCheckpoint
+			}
+			if syncInfo.virtualMachine != nil {
+				backupOut.Status.VirtualMachine = syncInfo.virtualMachine
+			}
 		case backupAbortingEvent:
 			updateBackupCondition(backupOut, newProgressingCondition(corev1.ConditionTrue, syncInfo.reason))
 			updateBackupCondition(backupOut, newAbortingCondition(corev1.ConditionTrue, syncInfo.reason))
@@ -604,6 +835,7 @@ func (ctrl *VMBackupController) updateStatus(backup *backupv1.VirtualMachineBack
 			switch syncInfo.event {
 			case backupFailedEvent:
 				ctrl.recorder.Eventf(backupOut, corev1.EventTypeWarning, backupFailedEvent, syncInfo.reason)
+				updateBackupCondition(backupOut, newFailureCondition(corev1.ConditionTrue, syncInfo.reason))
 			case backupCompletedWithWarningEvent:
 				ctrl.recorder.Eventf(backupOut, corev1.EventTypeWarning, backupCompletedWithWarningEvent, syncInfo.reason)
 			case backupCompletedEvent:
@@ -617,10 +849,18 @@ func (ctrl *VMBackupController) updateStatus(backup *backupv1.VirtualMachineBack
 			if syncInfo.checkpointName != nil {
 				backupOut.Status.CheckpointName = syncInfo.checkpointName
 			}
+			// the export of a pull mode backup is gone once the backup is done
+			backupOut.Status.Export = nil
 		}
 		if len(syncInfo.includedVolumes) > 0 {
 			backupOut.Status.IncludedVolumes = syncInfo.includedVolumes
 		}
+		if syncInfo.export != nil {
+			backupOut.Status.Export = syncInfo.export
+		}
+		if len(syncInfo.freezeHooks) > 0 {
+			backupOut.Status.FreezeHooks = syncInfo.freezeHooks
+		}
 	}
 
 	if isBackupDeleting(backupOut) && controller.HasFinalizer(backupOut, vmBackupFinalizer) {
@@ -845,17 +1085,25 @@ func (ctrl *VMBackupController) checkBackupCompletion(backup *backupv1.VirtualMa
 	}
 	backupStatus := vmi.Status.ChangedBlockTracking.BackupStatus
 	if !backupStatus.Completed {
+		syncInfo := &SyncInfo{}
 		if len(backupStatus.Volumes) > 0 && len(backup.Status.IncludedVolumes) == 0 {
-			return &SyncInfo{
-				includedVolumes: backupStatus.Volumes,
-			}
+			syncInfo.includedVolumes = backupStatus.Volumes
 		}
-		return nil
+		if isPullMode(backup) && len(backupStatus.Volumes) > 0 && backup.Status.Export == nil {
+			syncInfo.export = newBackupExport(backup, vmi, backupStatus.Volumes)
+		}
+		if syncInfo.includedVolumes == nil && syncInfo.export == nil {
+			ctrl.enqueueOnBackupExportExpiration(backup)
+			return nil
+		}
+		return syncInfo
 	}
 
+	hookFailure := failedFreezeHook(backup)
+
 	// Update BackupTracker with the new checkpoint if applicable
-	if backupTracker != nil && backupStatus.CheckpointName != nil && !backupStatus.Failed {
-		if err := ctrl.updateBackupTracker(backup.Namespace, backupTracker, backupStatus); err != nil {
+	if backupTracker != nil && backupStatus.CheckpointName != nil && !backupStatus.Failed && hookFailure == "" {
+		if err := ctrl.updateBackupTracker(backup, vmi, backupTracker, backupStatus); err != nil {
 			log.Log.Object(backup).Reason(err).Error("Failed to update BackupTracker")
 			return syncInfoError(err)
 		}
@@ -871,16 +1119,58 @@ func (ctrl *VMBackupController) checkBackupCompletion(backup *backupv1.VirtualMa
 	}
 
 	syncInfo = resolveCompletion(backup, backupStatus)
+	if hookFailure != "" && !backupStatus.Failed {
+		log.Log.Object(backup).Info(fmt.Sprintf(backupFailed, hookFailure))
+		syncInfo = &SyncInfo{
+			event:  backupFailedEvent,
+			reason: fmt.Sprintf(backupFailed, hookFailure),
+		}
+	}
 
 	// We allow tracking checkpoints only if BackupTracker is specified
-	if backupTracker != nil && !backupStatus.Failed {
+	if backupTracker != nil && !backupStatus.Failed && hookFailure == "" {
 		syncInfo.checkpointName = backupStatus.CheckpointName
 	}
-	syncInfo.includedVolumes = backupStatus.Volumes
+	syncInfo.includedVolumes = ctrl.withVolumeClaims(vmi, backupStatus.Volumes)
 
 	return syncInfo
 }
 
+// withVolumeClaims adds to the backed up volumes the description of their
+// PVCs, so they can be provisioned again when the backup is restored
+func (ctrl *VMBackupController) withVolumeClaims(vmi *v1.VirtualMachineInstance, volumes []v1.BackupVolumeInfo) []v1.BackupVolumeInfo {
+	if len(volumes) == 0 {
+		return volumes
+	}
+	claimNames := types.GetPVCsFromVolumes(vmi.Spec.Volumes)
+
+	result := make([]v1.BackupVolumeInfo, len(volumes))
+	for i, volume := range volumes {
+		result[i] = *volume.DeepCopy()
+		claimName, ok := claimNames[volume.VolumeName]
+		if !ok || volume.Claim != nil {
+			continue
+		}
+		obj, exists, err := ctrl.pvcStore.GetByKey(cacheKeyFunc(vmi.Namespace, claimName))
+		if err != nil || !exists {
+			continue
+		}
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+		capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+		if !ok {
+			capacity = pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		}
+		result[i].Claim = &v1.BackupVolumeClaim{
+			ClaimName:        claimName,
+			Capacity:         capacity,
+			StorageClassName: pvc.Spec.StorageClassName,
+			VolumeMode:       pvc.Spec.VolumeMode,
+			AccessModes:      pvc.Spec.AccessModes,
+		}
+	}
+	return result
+}
+
 func resolveCompletion(backup *backupv1.VirtualMachineBackup, status *v1.VirtualMachineInstanceBackupStatus) *SyncInfo {
 	fmtReason := func(base string, msg *string) string {
 		if msg == nil {
@@ -912,26 +1202,47 @@ func resolveCompletion(backup *backupv1.VirtualMachineBackup, status *v1.Virtual
 	}
 }
 
-func (ctrl *VMBackupController) updateBackupTracker(namespace string, tracker *backupv1.VirtualMachineBackupTracker, backupStatus *v1.VirtualMachineInstanceBackupStatus) error {
+func (ctrl *VMBackupController) updateBackupTracker(backup *backupv1.VirtualMachineBackup, vmi *v1.VirtualMachineInstance, tracker *backupv1.VirtualMachineBackupTracker, backupStatus *v1.VirtualMachineInstanceBackupStatus) error {
 	if tracker == nil {
 		return nil
 	}
+	namespace := backup.Namespace
 
 	newCheckpoint := backupv1.BackupCheckpoint{
 		Name:         *backupStatus.CheckpointName,
 		CreationTime: backupStatus.StartTimestamp,
+		BackupName:   backup.Name,
 		Volumes:      backupStatus.Volumes,
 	}
+	if backup.Status != nil {
+		newCheckpoint.Type = backup.Status.Type
+	}
+
+	history := appendCheckpoint(checkpointHistory(tracker), newCheckpoint)
+	history, pruned := pruneCheckpoints(tracker.Spec.RetentionPolicy, history, time.Now())
+	if len(pruned) > 0 {
+		log.Log.Infof("Pruning %d checkpoints of BackupTracker %s/%s", len(pruned), namespace, tracker.Name)
+		if err := ctrl.deletePrunedCheckpoints(namespace, vmi.Name, pruned); err != nil {
+			return err
+		}
+		if err := ctrl.deletePrunedBackups(namespace, pruned); err != nil {
+			return err
+		}
+	}
 
 	newStatus := &backupv1.VirtualMachineBackupTrackerStatus{
 		LatestCheckpoint: &newCheckpoint,
+		Checkpoints:      history,
 	}
 
 	patchSet := patch.New()
 	if tracker.Status == nil || tracker.Status.LatestCheckpoint == nil || tracker.Status.LatestCheckpoint.Name == "" {
 		patchSet.AddOption(patch.WithAdd("/status", newStatus))
 	} else {
-		patchSet.AddOption(patch.WithReplace("/status/latestCheckpoint", &newCheckpoint))
+		patchSet.AddOption(
+			patch.WithReplace("/status/latestCheckpoint", &newCheckpoint),
+			patch.WithAdd("/status/checkpoints", history),
+		)
 	}
 
 	patchBytes, err := patchSet.GeneratePayload()
@@ -959,6 +1270,12 @@ func (ctrl *VMBackupController) updateBackupTracker(namespace string, tracker *b
 	return nil
 }
 
+func (ctrl *VMBackupController) enqueueOnBackupExportExpiration(backup *backupv1.VirtualMachineBackup) {
+	if timeLeft := backupExportTimeLeft(backup); timeLeft != nil && *timeLeft > 0 {
+		ctrl.backupQueue.AddAfter(cacheKeyFunc(backup.Namespace, backup.Name), *timeLeft)
+	}
+}
+
 func isPushMode(backup *backupv1.VirtualMachineBackup) bool {
 	return backup.Spec.Mode == nil || *backup.Spec.Mode == backupv1.PushMode
 }
@@ -1048,6 +1365,10 @@ func newDeletingCondition(status corev1.ConditionStatus, reason string) backupv1
 	return newCondition(backupv1.ConditionDeleting, status, reason)
 }
 
+func newFailureCondition(status corev1.ConditionStatus, reason string) backupv1.Condition {
+	return newCondition(backupv1.ConditionFailure, status, reason)
+}
+
 func hasCondition(conditions []backupv1.Condition, condType backupv1.ConditionType) bool {
 	for _, cond := range conditions {
 		if cond.Type == condType {
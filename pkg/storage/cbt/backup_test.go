@@ -21,7 +21,9 @@ package cbt
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -29,6 +31,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8stypes "k8s.io/apimachinery/pkg/types"
@@ -46,6 +49,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/freezehooks"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -286,6 +290,9 @@ var _ = Describe("Backup Controller", func() {
 
 		mockBackupQueue = testutils.NewMockWorkQueue(controller.backupQueue)
 		controller.backupQueue = mockBackupQueue
+		controller.freezeHooks = freezehooks.NewAsyncRunner(virtClient, func(key string) {
+			controller.backupQueue.Add(key)
+		})
 
 		virtClient.EXPECT().VirtualMachine(testNamespace).Return(vmInterface).AnyTimes()
 		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
@@ -669,7 +676,7 @@ var _ = Describe("Backup Controller", func() {
 			controller.vmStore.Add(vm)
 
 			// VMI with backup in progress but volumes already populated by virt-launcher
-			volumesInfo := []backupv1.BackupVolumeInfo{
+			volumesInfo := []v1.BackupVolumeInfo{
 				{VolumeName: "rootdisk", DiskTarget: "vda"},
 				{VolumeName: "datadisk", DiskTarget: "vdb"},
 			}
@@ -692,7 +699,7 @@ var _ = Describe("Backup Controller", func() {
 		})
 
 		It("should not update includedVolumes when already set in backup status", func() {
-			existingVolumes := []backupv1.BackupVolumeInfo{
+			existingVolumes := []v1.BackupVolumeInfo{
 				{VolumeName: "rootdisk", DiskTarget: "vda"},
 			}
 			backup := createBackup(backupName, vmName, pvcName)
@@ -1255,6 +1262,7 @@ var _ = Describe("Backup Controller", func() {
 					}
 				}
 				Expect(hasDone).To(BeTrue(), "backup should be done")
+				Expect(hasCondition(updateObj.Status.Conditions, backupv1.ConditionFailure)).To(BeTrue(), "backup should be failed")
 				Expect(hasAbortingDone).To(BeTrue(), "backup was aborting and should have its Aborting condition set to false")
 				return true, updateObj, nil
 			})
@@ -1363,7 +1371,7 @@ var _ = Describe("Backup Controller", func() {
 
 				addBackup(backup)
 
-				volumesInfo := []backupv1.BackupVolumeInfo{
+				volumesInfo := []v1.BackupVolumeInfo{
 					{VolumeName: "rootdisk", DiskTarget: "vda"},
 					{VolumeName: "datadisk", DiskTarget: "vdb"},
 				}
@@ -1635,9 +1643,64 @@ var _ = Describe("Backup Controller", func() {
 		Expect(syncInfo.event).To(Equal(backupInitiatedEvent))
 		Expect(syncInfo.reason).To(Equal(backupInProgress))
 		Expect(syncInfo.backupType).To(Equal(backupv1.Incremental))
+		Expect(syncInfo.baseCheckpoint).To(Equal(pointer.P(checkpointName)))
 		Expect(backupCalled).To(BeTrue())
 	})
 
+	It("should capture the VM definition when initiating the backup", func() {
+		backup := createBackup(backupName, vmName, pvcName)
+		backup.Finalizers = []string{vmBackupFinalizer}
+
+		vm := createVM(vmName)
+		vm.Labels = map[string]string{"app": "test"}
+		vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+		controller.vmStore.Add(vm)
+		controller.vmiStore.Add(createInitializedVMI())
+		controller.pvcStore.Add(createPVC(pvcName))
+
+		vmiInterface.EXPECT().Backup(gomock.Any(), vmName, gomock.Any()).Return(nil)
+
+		syncInfo := controller.sync(backup)
+		Expect(syncInfo).ToNot(BeNil())
+		Expect(syncInfo.err).ToNot(HaveOccurred())
+		Expect(syncInfo.baseCheckpoint).To(BeNil())
+		Expect(syncInfo.virtualMachine).ToNot(BeNil())
+
+		captured := &v1.VirtualMachine{}
+		Expect(json.Unmarshal(syncInfo.virtualMachine.Raw, captured)).To(Succeed())
+		Expect(captured.Kind).To(Equal(v1.VirtualMachineGroupVersionKind.Kind))
+		Expect(captured.Name).To(Equal(vmName))
+		Expect(captured.Namespace).To(BeEmpty())
+		Expect(captured.UID).To(BeEmpty())
+		Expect(captured.Labels).To(Equal(vm.Labels))
+		Expect(captured.Spec).To(Equal(vm.Spec))
+	})
+
+	It("should describe the PVCs of the backed up volumes", func() {
+		vmi := createVMI()
+		pvc := createPVC("test-disk")
+		pvc.Spec.StorageClassName = pointer.P("local")
+		pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+		pvc.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5Gi")}
+		controller.pvcStore.Add(pvc)
+
+		volumes := []v1.BackupVolumeInfo{
+			{VolumeName: "disk0", DiskTarget: "vda"},
+			{VolumeName: "unknown", DiskTarget: "vdb"},
+		}
+		result := controller.withVolumeClaims(vmi, volumes)
+		Expect(result).To(HaveLen(2))
+		Expect(result[0].Claim).To(Equal(&v1.BackupVolumeClaim{
+			ClaimName:        "test-disk",
+			Capacity:         resource.MustParse("5Gi"),
+			StorageClassName: pointer.P("local"),
+			VolumeMode:       pointer.P(corev1.PersistentVolumeFilesystem),
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+		}))
+		Expect(result[1].Claim).To(BeNil())
+		Expect(volumes[0].Claim).To(BeNil())
+	})
+
 	It("should initiate full backup with ForceFullBackup even with LatestCheckpoint", func() {
 		backupTracker := createBackupTracker(backupTrackerName, vmName, checkpointName)
 		controller.backupTrackerInformer.GetStore().Add(backupTracker)
@@ -1677,6 +1740,43 @@ var _ = Describe("Backup Controller", func() {
 		Expect(backupCalled).To(BeTrue())
 	})
 
+	It("should initiate full backup when the retention policy of the backupTracker requires it", func() {
+		backupTracker := createBackupTracker(backupTrackerName, vmName, checkpointName)
+		backupTracker.Spec.RetentionPolicy = &backupv1.BackupRetentionPolicy{
+			MaxIncrementals: pointer.P(int32(1)),
+		}
+		backupTracker.Status.Checkpoints = []backupv1.BackupCheckpoint{
+			{Name: "full-checkpoint", Type: backupv1.Full},
+			{Name: checkpointName, Type: backupv1.Incremental},
+		}
+		controller.backupTrackerInformer.GetStore().Add(backupTracker)
+
+		backup := createBackupWithTracker(backupName, vmName, pvcName)
+		backup.Finalizers = []string{vmBackupFinalizer}
+
+		vm := createVM(vmName)
+		controller.vmStore.Add(vm)
+
+		vmi := createInitializedVMI()
+		controller.vmiStore.Add(vmi)
+
+		pvc := createPVC(pvcName)
+		controller.pvcStore.Add(pvc)
+
+		vmiInterface.EXPECT().
+			Backup(gomock.Any(), vmName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, options *backupv1.BackupOptions) error {
+				Expect(options.Incremental).To(BeNil())
+				return nil
+			})
+
+		syncInfo := controller.sync(backup)
+		Expect(syncInfo).ToNot(BeNil())
+		Expect(syncInfo.err).ToNot(HaveOccurred())
+		Expect(syncInfo.event).To(Equal(backupInitiatedEvent))
+		Expect(syncInfo.backupType).To(Equal(backupv1.Full))
+	})
+
 	It("should initiate cleanup when backup completed", func() {
 		backup := createBackup(backupName, vmName, pvcName)
 		backup.Finalizers = []string{vmBackupFinalizer}
@@ -1728,7 +1828,7 @@ var _ = Describe("Backup Controller", func() {
 		controller.vmStore.Add(vm)
 
 		// VMI with backup completed and PVC already detached
-		volumesInfo := []backupv1.BackupVolumeInfo{
+		volumesInfo := []v1.BackupVolumeInfo{
 			{VolumeName: "rootdisk", DiskTarget: "vda"},
 			{VolumeName: "datadisk", DiskTarget: "vdb"},
 		}
@@ -1786,7 +1886,7 @@ var _ = Describe("Backup Controller", func() {
 			controller.vmStore.Add(vm)
 
 			// VMI with backup completed, checkpoint name, and volumes info
-			volumesInfo := []backupv1.BackupVolumeInfo{
+			volumesInfo := []v1.BackupVolumeInfo{
 				{VolumeName: "rootdisk", DiskTarget: "vda"},
 				{VolumeName: "datadisk", DiskTarget: "vdb"},
 			}
@@ -1855,6 +1955,85 @@ var _ = Describe("Backup Controller", func() {
 		Entry("when tracker already has a checkpoint", "old-checkpoint", "\"op\":\"replace\""),
 	)
 
+	It("should prune checkpoints and backups falling out of the backupTracker retention policy", func() {
+		const oldBackupName = "old-backup"
+		oldCheckpoint := backupv1.BackupCheckpoint{
+			Name:       "old-checkpoint",
+			BackupName: oldBackupName,
+			Type:       backupv1.Full,
+		}
+		backupTracker := createBackupTracker(backupTrackerName, vmName, oldCheckpoint.Name)
+		backupTracker.Spec.RetentionPolicy = &backupv1.BackupRetentionPolicy{
+			MaxChainLength: pointer.P(int32(1)),
+		}
+		backupTracker.Status.Checkpoints = []backupv1.BackupCheckpoint{oldCheckpoint}
+		controller.backupTrackerInformer.GetStore().Add(backupTracker)
+
+		oldBackup := createBackupWithTracker(oldBackupName, vmName, pvcName)
+		_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Create(context.Background(), oldBackup, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		backup := createBackupWithTracker(backupName, vmName, pvcName)
+		backup.Finalizers = []string{vmBackupFinalizer}
+		backup.Status = &backupv1.VirtualMachineBackupStatus{
+			Type: backupv1.Full,
+			Conditions: []backupv1.Condition{
+				{Type: backupv1.ConditionProgressing, Status: corev1.ConditionTrue},
+			},
+		}
+		addBackup(backup)
+
+		vm := createVM(vmName)
+		controller.vmStore.Add(vm)
+
+		vmi := createVMI()
+		vmi.Status.ChangedBlockTracking.BackupStatus = &v1.VirtualMachineInstanceBackupStatus{
+			BackupName:     backupName,
+			Completed:      true,
+			CheckpointName: pointer.P(checkpointName),
+		}
+		controller.vmiStore.Add(vmi)
+
+		pvc := createPVC(pvcName)
+		controller.pvcStore.Add(pvc)
+
+		vmiInterface.EXPECT().DeleteCheckpoint(gomock.Any(), vmName, &oldCheckpoint).Return(nil)
+		vmiInterface.EXPECT().
+			Patch(gomock.Any(), vmName, k8stypes.JSONPatchType, gomock.Any(), gomock.Any()).
+			Return(vmi, nil)
+
+		var patchedHistory []backupv1.BackupCheckpoint
+		kubevirtClient.Fake.PrependReactor("patch", "virtualmachinebackuptrackers", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			patchBytes := action.(testing.PatchAction).GetPatch()
+			var ops []struct {
+				Path  string          `json:"path"`
+				Value json.RawMessage `json:"value"`
+			}
+			Expect(json.Unmarshal(patchBytes, &ops)).To(Succeed())
+			for _, op := range ops {
+				if op.Path == "/status/checkpoints" {
+					Expect(json.Unmarshal(op.Value, &patchedHistory)).To(Succeed())
+				}
+			}
+			return true, backupTracker, nil
+		})
+		virtClient.EXPECT().VirtualMachineBackupTracker(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(testNamespace))
+
+		syncInfo := controller.sync(backup)
+		Expect(syncInfo).ToNot(BeNil())
+		Expect(syncInfo.err).ToNot(HaveOccurred())
+		Expect(syncInfo.event).To(Equal(backupCompletedEvent))
+
+		Expect(patchedHistory).To(HaveLen(1))
+		Expect(patchedHistory[0].Name).To(Equal(checkpointName))
+		Expect(patchedHistory[0].BackupName).To(Equal(backupName))
+		Expect(patchedHistory[0].Type).To(Equal(backupv1.Full))
+
+		_, err = kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), oldBackupName, metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
 	It("should update backupTracker even when cleanup returns early", func() {
 		backupTracker := createBackupTracker(backupTrackerName, vmName, "")
 		controller.backupTrackerInformer.GetStore().Add(backupTracker)
@@ -1905,4 +2084,326 @@ var _ = Describe("Backup Controller", func() {
 		// But backupTracker was still updated before cleanup
 		Expect(trackerPatched).To(BeTrue())
 	})
+
+	Context("freeze hooks", func() {
+		const hookName = "flush"
+
+		createBackupWithHooks := func() *backupv1.VirtualMachineBackup {
+			backup := createBackup(backupName, vmName, pvcName)
+			backup.Finalizers = []string{vmBackupFinalizer}
+			backup.Spec.Hooks = &v1.FreezeHooks{
+				PreFreeze: []v1.FreezeHook{{
+					Name:      hookName,
+					GuestExec: &v1.GuestExecHook{Command: []string{"/usr/bin/db-flush"}},
+				}},
+				PostThaw: []v1.FreezeHook{{
+					Name:      hookName,
+					GuestExec: &v1.GuestExecHook{Command: []string{"/usr/bin/db-resume"}},
+				}},
+			}
+			return backup
+		}
+
+		BeforeEach(func() {
+			controller.vmStore.Add(createVM(vmName))
+			controller.pvcStore.Add(createPVC(pvcName))
+		})
+
+		// the hooks run in the background, the backup is requeued once
+		// they finished
+		waitForHooks := func() {
+			Eventually(mockBackupQueue.Len).Should(Equal(1))
+			key, _ := mockBackupQueue.Get()
+			mockBackupQueue.Done(key)
+		}
+
+		It("should run the pre-freeze hooks before the backup starts and the post-thaw hooks after", func() {
+			backup := createBackupWithHooks()
+			controller.vmiStore.Add(createInitializedVMI())
+
+			gomock.InOrder(
+				vmiInterface.EXPECT().GuestExec(gomock.Any(), vmName, &v1.GuestExecOptions{
+					Command:        []string{"/usr/bin/db-flush"},
+					TimeoutSeconds: 30,
+				}).Return(nil),
+				vmiInterface.EXPECT().Backup(gomock.Any(), vmName, gomock.Any()).Return(nil),
+				vmiInterface.EXPECT().GuestExec(gomock.Any(), vmName, &v1.GuestExecOptions{
+					Command:        []string{"/usr/bin/db-resume"},
+					TimeoutSeconds: 30,
+				}).Return(nil),
+			)
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.err).ToNot(HaveOccurred())
+			Expect(syncInfo.event).To(Equal(backupInitializingEvent))
+			Expect(syncInfo.reason).To(Equal(fmt.Sprintf(freezeHooksRunningMsg, v1.PreFreezeStage)))
+			waitForHooks()
+
+			syncInfo = controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.err).ToNot(HaveOccurred())
+			Expect(syncInfo.event).To(Equal(backupInitiatedEvent))
+			Expect(syncInfo.freezeHooks).To(Equal([]v1.FreezeHookResult{
+				{Name: hookName, Stage: v1.PreFreezeStage, Succeeded: true},
+			}))
+			waitForHooks()
+
+			backup.Status = &backupv1.VirtualMachineBackupStatus{
+				Conditions: []backupv1.Condition{
+					{Type: backupv1.ConditionProgressing, Status: corev1.ConditionTrue},
+				},
+				FreezeHooks: syncInfo.freezeHooks,
+			}
+			syncInfo = controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.err).ToNot(HaveOccurred())
+			Expect(syncInfo.event).To(BeEmpty())
+			Expect(syncInfo.freezeHooks).To(Equal([]v1.FreezeHookResult{
+				{Name: hookName, Stage: v1.PreFreezeStage, Succeeded: true},
+				{Name: hookName, Stage: v1.PostThawStage, Succeeded: true},
+			}))
+		})
+
+		It("should not run the hooks when quiesce is skipped", func() {
+			backup := createBackupWithHooks()
+			backup.Spec.SkipQuiesce = true
+			controller.vmiStore.Add(createInitializedVMI())
+
+			vmiInterface.EXPECT().Backup(gomock.Any(), vmName, gomock.Any()).Return(nil)
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.event).To(Equal(backupInitiatedEvent))
+			Expect(syncInfo.freezeHooks).To(BeEmpty())
+		})
+
+		It("should not start the backup when a pre-freeze hook fails", func() {
+			backup := createBackupWithHooks()
+			controller.vmiStore.Add(createInitializedVMI())
+
+			vmiInterface.EXPECT().GuestExec(gomock.Any(), vmName, gomock.Any()).Return(fmt.Errorf("exit code 1"))
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.event).To(Equal(backupInitializingEvent))
+			waitForHooks()
+
+			syncInfo = controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.err).ToNot(HaveOccurred())
+			Expect(syncInfo.event).To(Equal(backupInitializingEvent))
+			Expect(syncInfo.reason).To(ContainSubstring("PreFreeze hook flush failed: exit code 1"))
+			Expect(syncInfo.freezeHooks).To(Equal([]v1.FreezeHookResult{
+				{Name: hookName, Stage: v1.PreFreezeStage, Message: "exit code 1"},
+			}))
+		})
+
+		It("should fail the backup once cleaned up after a pre-freeze hook failed", func() {
+			backup := createBackupWithHooks()
+			backup.Status = &backupv1.VirtualMachineBackupStatus{
+				Conditions: []backupv1.Condition{
+					{Type: backupv1.ConditionInitializing, Status: corev1.ConditionTrue},
+				},
+				FreezeHooks: []v1.FreezeHookResult{
+					{Name: hookName, Stage: v1.PreFreezeStage, Message: "exit code 1"},
+				},
+			}
+			vmi := createVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus = &v1.VirtualMachineInstanceBackupStatus{
+				BackupName: backupName,
+			}
+			controller.vmiStore.Add(vmi)
+
+			vmiInterface.EXPECT().Patch(gomock.Any(), vmName, k8stypes.JSONPatchType, gomock.Any(), gomock.Any()).Return(vmi, nil)
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.event).To(Equal(backupFailedEvent))
+			Expect(syncInfo.reason).To(Equal(fmt.Sprintf(backupFailed, "PreFreeze hook flush failed: exit code 1")))
+		})
+
+		DescribeTable("on completion after a post-thaw hook failed", func(policy v1.FreezeHookFailurePolicy, expectedEvent string) {
+			backup := createBackupWithHooks()
+			backup.Spec.Hooks.PostThaw[0].OnFailure = pointer.P(policy)
+			backup.Status = &backupv1.VirtualMachineBackupStatus{
+				Conditions: []backupv1.Condition{
+					{Type: backupv1.ConditionProgressing, Status: corev1.ConditionTrue},
+				},
+				FreezeHooks: []v1.FreezeHookResult{
+					{Name: hookName, Stage: v1.PreFreezeStage, Succeeded: true},
+					{Name: hookName, Stage: v1.PostThawStage, Message: "exit code 1"},
+				},
+			}
+			vmi := createVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus = &v1.VirtualMachineInstanceBackupStatus{
+				BackupName:     backupName,
+				Completed:      true,
+				CheckpointName: pointer.P(checkpointName),
+			}
+			controller.vmiStore.Add(vmi)
+
+			vmiInterface.EXPECT().Patch(gomock.Any(), vmName, k8stypes.JSONPatchType, gomock.Any(), gomock.Any()).Return(vmi, nil)
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.event).To(Equal(expectedEvent))
+		},
+			Entry("should fail the backup with the Fail policy", v1.FreezeHookFail, backupFailedEvent),
+			Entry("should complete the backup with the Continue policy", v1.FreezeHookContinue, backupCompletedEvent),
+		)
+
+		It("should record the freeze hook results in the status", func() {
+			backup := createBackupWithHooks()
+			addBackup(backup)
+			results := []v1.FreezeHookResult{
+				{Name: hookName, Stage: v1.PreFreezeStage, Succeeded: true},
+			}
+
+			err := controller.updateStatus(backup, &SyncInfo{
+				event:       backupInitiatedEvent,
+				reason:      backupInProgress,
+				freezeHooks: results,
+			}, log.DefaultLogger())
+			Expect(err).ToNot(HaveOccurred())
+
+			updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), backupName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Status.FreezeHooks).To(Equal(results))
+		})
+	})
+
+	Context("pull mode", func() {
+		createPullBackup := func() *backupv1.VirtualMachineBackup {
+			backup := createBackup(backupName, vmName, pvcName)
+			backup.Finalizers = []string{vmBackupFinalizer}
+			backup.Spec.PvcName = nil
+			backup.Spec.Mode = pointer.P(backupv1.PullMode)
+			backup.Spec.TTLDuration = &metav1.Duration{Duration: time.Hour}
+			return backup
+		}
+
+		createPullVMI := func() *v1.VirtualMachineInstance {
+			vmi := createVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus = &v1.VirtualMachineInstanceBackupStatus{
+				BackupName:     backupName,
+				CheckpointName: pointer.P(checkpointName),
+			}
+			return vmi
+		}
+
+		progressingStatus := func(backupType backupv1.BackupType) *backupv1.VirtualMachineBackupStatus {
+			return &backupv1.VirtualMachineBackupStatus{
+				Type: backupType,
+				Conditions: []backupv1.Condition{
+					{Type: backupv1.ConditionProgressing, Status: corev1.ConditionTrue},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			controller.vmStore.Add(createVM(vmName))
+		})
+
+		It("should initiate backup without a target PVC", func() {
+			backup := createPullBackup()
+			controller.vmiStore.Add(createPullVMI())
+
+			vmiInterface.EXPECT().
+				Backup(gomock.Any(), vmName, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, name string, options *backupv1.BackupOptions) error {
+					Expect(options.Cmd).To(Equal(backupv1.Start))
+					Expect(options.Mode).To(Equal(backupv1.PullMode))
+					Expect(options.PushPath).To(BeNil())
+					return nil
+				})
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.err).ToNot(HaveOccurred())
+			Expect(syncInfo.event).To(Equal(backupInitiatedEvent))
+		})
+
+		DescribeTable("should publish the export once the volumes are known", func(backupType backupv1.BackupType, expectedBitmap *string) {
+			backup := createPullBackup()
+			backup.Status = progressingStatus(backupType)
+
+			vmi := createPullVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus.Volumes = []v1.BackupVolumeInfo{
+				{VolumeName: "rootdisk", DiskTarget: "vda"},
+			}
+			controller.vmiStore.Add(vmi)
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.err).ToNot(HaveOccurred())
+			Expect(syncInfo.event).To(BeEmpty())
+			Expect(syncInfo.export).ToNot(BeNil())
+			Expect(syncInfo.export.Endpoint).To(Equal(
+				fmt.Sprintf("/apis/subresources.kubevirt.io/v1/namespaces/%s/virtualmachineinstances/%s/backup", testNamespace, vmName)))
+			Expect(syncInfo.export.ExpirationTime).ToNot(BeNil())
+			Expect(syncInfo.export.ExpirationTime.Time).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+			Expect(syncInfo.export.Volumes).To(Equal([]backupv1.BackupExportVolume{
+				{VolumeName: "rootdisk", ExportName: "rootdisk", DirtyBitmap: expectedBitmap},
+			}))
+		},
+			Entry("without a dirty bitmap for a full backup", backupv1.Full, nil),
+			Entry("with a dirty bitmap for an incremental backup", backupv1.Incremental, pointer.P("backup-rootdisk")),
+		)
+
+		It("should requeue the backup for the expiration of its export", func() {
+			backup := createPullBackup()
+			backup.Status = progressingStatus(backupv1.Full)
+			backup.Status.IncludedVolumes = []v1.BackupVolumeInfo{{VolumeName: "rootdisk", DiskTarget: "vda"}}
+			backup.Status.Export = &backupv1.BackupExport{
+				ExpirationTime: pointer.P(metav1.NewTime(time.Now().Add(time.Hour))),
+			}
+
+			vmi := createPullVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus.Volumes = backup.Status.IncludedVolumes
+			controller.vmiStore.Add(vmi)
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).To(BeNil())
+			Expect(mockBackupQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+		})
+
+		It("should abort the backup when its export expires", func() {
+			backup := createPullBackup()
+			backup.Status = progressingStatus(backupv1.Full)
+			backup.Status.Export = &backupv1.BackupExport{
+				ExpirationTime: pointer.P(metav1.NewTime(time.Now().Add(-time.Minute))),
+			}
+			controller.vmiStore.Add(createPullVMI())
+
+			vmiInterface.EXPECT().
+				Backup(gomock.Any(), vmName, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, name string, options *backupv1.BackupOptions) error {
+					Expect(options.Cmd).To(Equal(backupv1.Abort))
+					return nil
+				})
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.event).To(Equal(backupAbortingEvent))
+		})
+
+		It("should clear the export when the backup completes", func() {
+			backup := createPullBackup()
+			backup.Status = progressingStatus(backupv1.Full)
+			backup.Status.Export = &backupv1.BackupExport{Endpoint: "endpoint"}
+			_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Create(context.Background(), backup, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = controller.updateStatus(backup, &SyncInfo{
+				event:  backupCompletedEvent,
+				reason: backupCompleted,
+			}, log.DefaultLogger())
+			Expect(err).ToNot(HaveOccurred())
+
+			updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), backupName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Status.Export).To(BeNil())
+		})
+	})
 })
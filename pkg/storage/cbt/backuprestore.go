@@ -0,0 +1,788 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	backupRestoreLabel           = "backup.kubevirt.io/restore"
+	backupRestoreUIDAnnotation   = "backup.kubevirt.io/lastRestoreUID"
+	backupRestoreImporterApp     = "backup-restore-importer"
+	backupRestoreImporterName    = "importer"
+	backupRestoreSourceDir       = "/backups"
+	backupRestoreTargetDir       = "/target"
+	backupRestoreTargetDevice    = "/dev/target"
+	backupRestoreTargetImageFile = "disk.img"
+
+	backupRestoreProgressingEvent = "VirtualMachineBackupRestoreProgressing"
+	backupRestoreCompletedEvent   = "VirtualMachineBackupRestoreCompleted"
+	backupRestoreFailedEvent      = "VirtualMachineBackupRestoreFailed"
+
+	backupRestoreInProgress         = "Restore is in progress"
+	backupRestoreCompleted          = "Successfully completed VirtualMachineBackupRestore"
+	backupRestoreFailed             = "Restore has failed: %s"
+	checkpointBackupNotFoundMsg     = "no completed backup found for checkpoint %s"
+	incompleteBackupChainMsg        = "incomplete backup chain, no completed backup found for checkpoint %s"
+	pullModeBackupRestoreMsg        = "backup %s is a pull mode backup, only push mode backups can be restored"
+	backupNoVMDefinitionMsg         = "backup %s has no VM definition"
+	backupVolumeNotFoundMsg         = "volume %s is not part of backup %s"
+	backupVolumeNoClaimMsg          = "volume %s of backup %s has no PVC description"
+	restoreTargetVMExistsMsg        = "VM %s already exists"
+	restoreTargetVMNotFoundMsg      = "VM %s does not exist"
+	restoreTargetVMRunningMsg       = "VM %s is running, it has to be stopped to restore volume %s"
+	restoreTargetVolumeNotFoundMsg  = "VM %s has no volume %s"
+	backupRestoreImporterFailedMsg  = "importer of volume %s failed"
+	backupRestoreVolumeProgressMsg  = "Restored %d out of %d volumes"
+	backupRestoreVolumesRestoredMsg = "Restored volumes of VM %s from checkpoint %s"
+)
+
+// restoreVolume is a volume to restore, along with the backups holding its
+// data, starting with the full backup
+type restoreVolume struct {
+	name    string
+	claim   *v1.BackupVolumeClaim
+	backups []*backupv1.VirtualMachineBackup
+}
+
+func (ctrl *VMBackupController) handleBackupRestore(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if restore, ok := obj.(*backupv1.VirtualMachineBackupRestore); ok {
+		key := cacheKeyFunc(restore.Namespace, restore.Name)
+		log.Log.V(3).Infof("enqueued restore %q for sync", key)
+		ctrl.restoreQueue.Add(key)
+	}
+}
+
+// handleBackupRestorePod enqueues the restore an importer pod belongs to
+func (ctrl *VMBackupController) handleBackupRestorePod(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	restoreName, ok := pod.Labels[backupRestoreLabel]
+	if !ok {
+		return
+	}
+	ctrl.restoreQueue.Add(cacheKeyFunc(pod.Namespace, restoreName))
+}
+
+func (ctrl *VMBackupController) runRestoreWorker() {
+	for ctrl.ExecuteRestore() {
+	}
+}
+
+func (ctrl *VMBackupController) ExecuteRestore() bool {
+	key, quit := ctrl.restoreQueue.Get()
+	if quit {
+		return false
+	}
+	defer ctrl.restoreQueue.Done(key)
+
+	err := ctrl.executeRestore(key)
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineBackupRestore %v", key)
+		ctrl.restoreQueue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineBackupRestore %v", key)
+		ctrl.restoreQueue.Forget(key)
+	}
+	return true
+}
+
+func (ctrl *VMBackupController) executeRestore(key string) error {
+	logger := log.Log.With("VirtualMachineBackupRestore", key)
+	logger.V(3).Infof("Processing VirtualMachineBackupRestore %s", key)
+
+	storeObj, exists, err := ctrl.backupRestoreInformer.GetStore().GetByKey(key)
+	if err != nil {
+		logger.Errorf("Error getting restore from store: %v", err)
+		return err
+	}
+	if !exists {
+		logger.V(3).Infof("Restore %s no longer exists in store", key)
+		return nil
+	}
+
+	restore, ok := storeObj.(*backupv1.VirtualMachineBackupRestore)
+	if !ok {
+		logger.Errorf("Unexpected resource type: %T", storeObj)
+		return fmt.Errorf("unexpected resource %+v", storeObj)
+	}
+	if restore.DeletionTimestamp != nil {
+		return nil
+	}
+
+	status, err := ctrl.syncRestore(restore)
+	if err != nil {
+		return err
+	}
+
+	if !equality.Semantic.DeepEqual(restore.Status, status) {
+		restoreCopy := restore.DeepCopy()
+		restoreCopy.Status = status
+		_, err = ctrl.client.VirtualMachineBackupRestore(restore.Namespace).UpdateStatus(context.Background(), restoreCopy, metav1.UpdateOptions{})
+		if err != nil {
+			logger.Reason(err).Errorf("Updating the VirtualMachineBackupRestore status failed")
+			return err
+		}
+	}
+	return nil
+}
+
+// syncRestore rebuilds the volumes of the restore from its backup chain
+// and returns the new status of the restore
+func (ctrl *VMBackupController) syncRestore(restore *backupv1.VirtualMachineBackupRestore) (*backupv1.VirtualMachineBackupRestoreStatus, error) {
+	status := &backupv1.VirtualMachineBackupRestoreStatus{}
+	if restore.Status != nil {
+		status = restore.Status.DeepCopy()
+	}
+	if hasCondition(status.Conditions, backupv1.ConditionDone) {
+		return status, nil
+	}
+
+	chain, reason := ctrl.resolveBackupChain(restore.Namespace, restore.Spec.CheckpointName)
+	if reason != "" {
+		return ctrl.failRestore(restore, status, reason)
+	}
+	vm, reason := backupVirtualMachine(chain[len(chain)-1])
+	if reason != "" {
+		return ctrl.failRestore(restore, status, reason)
+	}
+	volumes, reason := restoreVolumes(restore, chain)
+	if reason != "" {
+		return ctrl.failRestore(restore, status, reason)
+	}
+	if len(status.Volumes) == 0 {
+		if reason = ctrl.verifyRestoreTarget(restore); reason != "" {
+			return ctrl.failRestore(restore, status, reason)
+		}
+	}
+
+	status.Backups = nil
+	for _, backup := range chain {
+		status.Backups = append(status.Backups, backup.Name)
+	}
+
+	completed := map[string]bool{}
+	for _, volume := range status.Volumes {
+		completed[volume.VolumeName] = volume.Completed
+	}
+	var volumeStatuses []backupv1.BackupRestoreVolumeStatus
+	restored := 0
+	for _, volume := range volumes {
+		volumeStatus := backupv1.BackupRestoreVolumeStatus{
+			VolumeName: volume.name,
+			ClaimName:  restoreClaimName(restore, volume.name),
+			Completed:  completed[volume.name],
+		}
+		if !volumeStatus.Completed {
+			done, err := ctrl.restoreVolumeData(restore, vm.Name, volume, volumeStatus.ClaimName)
+			if err != nil {
+				return nil, err
+			}
+			if done == nil {
+				return ctrl.failRestore(restore, status, fmt.Sprintf(backupRestoreImporterFailedMsg, volume.name))
+			}
+			volumeStatus.Completed = *done
+		}
+		if volumeStatus.Completed {
+			restored++
+		}
+		volumeStatuses = append(volumeStatuses, volumeStatus)
+	}
+	status.Volumes = volumeStatuses
+
+	if restored < len(status.Volumes) {
+		reason = fmt.Sprintf(backupRestoreVolumeProgressMsg, restored, len(status.Volumes))
+		if !hasCondition(status.Conditions, backupv1.ConditionProgressing) {
+			ctrl.recorder.Eventf(restore, corev1.EventTypeNormal, backupRestoreProgressingEvent, backupRestoreInProgress)
+		}
+		status.Conditions = updateCondition(status.Conditions, newProgressingCondition(corev1.ConditionTrue, reason))
+		status.Conditions = updateCondition(status.Conditions, newDoneCondition(corev1.ConditionFalse, backupRestoreInProgress))
+		return status, nil
+	}
+
+	if restore.Spec.VolumeName != nil {
+		reason, err := ctrl.restoreTargetVolume(restore, status.Volumes[0])
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			return ctrl.failRestore(restore, status, reason)
+		}
+	} else {
+		reason, err := ctrl.restoreTargetVM(restore, vm, status.Volumes)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			return ctrl.failRestore(restore, status, reason)
+		}
+	}
+	if err := ctrl.deleteRestoreImporters(restore, status.Volumes); err != nil {
+		return nil, err
+	}
+
+	log.Log.Object(restore).Infof(backupRestoreVolumesRestoredMsg, restore.Spec.Target.Name, restore.Spec.CheckpointName)
+	ctrl.recorder.Eventf(restore, corev1.EventTypeNormal, backupRestoreCompletedEvent, backupRestoreCompleted)
+	status.Conditions = updateCondition(status.Conditions, newProgressingCondition(corev1.ConditionFalse, backupRestoreCompleted))
+	status.Conditions = updateCondition(status.Conditions, newDoneCondition(corev1.ConditionTrue, backupRestoreCompleted))
+	return status, nil
+}
+
+// failRestore marks the restore as done and failed, and deletes what the
+// restore provisioned so far
+func (ctrl *VMBackupController) failRestore(restore *backupv1.VirtualMachineBackupRestore, status *backupv1.VirtualMachineBackupRestoreStatus, reason string) (*backupv1.VirtualMachineBackupRestoreStatus, error) {
+	if err := ctrl.deleteRestoreImporters(restore, status.Volumes); err != nil {
+		return nil, err
+	}
+	for _, volume := range status.Volumes {
+		err := ctrl.client.CoreV1().PersistentVolumeClaims(restore.Namespace).Delete(context.Background(), volume.ClaimName, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	reason = fmt.Sprintf(backupRestoreFailed, reason)
+	log.Log.Object(restore).Info(reason)
+	ctrl.recorder.Eventf(restore, corev1.EventTypeWarning, backupRestoreFailedEvent, reason)
+	status.Volumes = nil
+	status.Conditions = updateCondition(status.Conditions, newProgressingCondition(corev1.ConditionFalse, reason))
+	status.Conditions = updateCondition(status.Conditions, newDoneCondition(corev1.ConditionTrue, reason))
+	return status, nil
+}
+
+// resolveBackupChain returns the backups to restore the checkpoint from,
+// starting with the full backup the checkpoint is based on, or the reason
+// the checkpoint can not be restored
+func (ctrl *VMBackupController) resolveBackupChain(namespace, checkpointName string) ([]*backupv1.VirtualMachineBackup, string) {
+	objs, err := ctrl.backupInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err.Error()
+	}
+	byCheckpoint := map[string]*backupv1.VirtualMachineBackup{}
+	for _, obj := range objs {
+		backup := obj.(*backupv1.VirtualMachineBackup)
+		if !IsBackupDone(backup.Status) || isBackupFailed(backup.Status) || backup.Status.CheckpointName == nil {
+			continue
+		}
+		byCheckpoint[*backup.Status.CheckpointName] = backup
+	}
+
+	backup, ok := byCheckpoint[checkpointName]
+	if !ok {
+		return nil, fmt.Sprintf(checkpointBackupNotFoundMsg, checkpointName)
+	}
+	var chain []*backupv1.VirtualMachineBackup
+	for {
+		if !isPushMode(backup) {
+			return nil, fmt.Sprintf(pullModeBackupRestoreMsg, backup.Name)
+		}
+		chain = append([]*backupv1.VirtualMachineBackup{backup}, chain...)
+		if backup.Status.Type != backupv1.Incremental {
+			return chain, ""
+		}
+		if backup.Status.BaseCheckpointName == nil {
+			return nil, fmt.Sprintf(incompleteBackupChainMsg, *backup.Status.CheckpointName)
+		}
+		base := *backup.Status.BaseCheckpointName
+		if backup, ok = byCheckpoint[base]; !ok || len(chain) > len(byCheckpoint) {
+			return nil, fmt.Sprintf(incompleteBackupChainMsg, base)
+		}
+	}
+}
+
+// backupVirtualMachine returns the VM definition captured by the backup
+func backupVirtualMachine(backup *backupv1.VirtualMachineBackup) (*v1.VirtualMachine, string) {
+	if backup.Status.VirtualMachine == nil || len(backup.Status.VirtualMachine.Raw) == 0 {
+		return nil, fmt.Sprintf(backupNoVMDefinitionMsg, backup.Name)
+	}
+	vm := &v1.VirtualMachine{}
+	if err := json.Unmarshal(backup.Status.VirtualMachine.Raw, vm); err != nil {
+		return nil, fmt.Sprintf("invalid VM definition in backup %s: %v", backup.Name, err)
+	}
+	return vm, ""
+}
+
+// restoreVolumes returns the volumes to restore, every volume has to be
+// part of all the backups of the chain
+func restoreVolumes(restore *backupv1.VirtualMachineBackupRestore, chain []*backupv1.VirtualMachineBackup) ([]restoreVolume, string) {
+	latest := chain[len(chain)-1]
+	var volumes []restoreVolume
+	for _, info := range latest.Status.IncludedVolumes {
+		if restore.Spec.VolumeName != nil && *restore.Spec.VolumeName != info.VolumeName {
+			continue
+		}
+		if info.Claim == nil {
+			return nil, fmt.Sprintf(backupVolumeNoClaimMsg, info.VolumeName, latest.Name)
+		}
+		for _, backup := range chain {
+			if !backupIncludesVolume(backup, info.VolumeName) {
+				return nil, fmt.Sprintf(backupVolumeNotFoundMsg, info.VolumeName, backup.Name)
+			}
+		}
+		volumes = append(volumes, restoreVolume{
+			name:    info.VolumeName,
+			claim:   info.Claim,
+			backups: chain,
+		})
+	}
+	if len(volumes) == 0 {
+		volumeName := ""
+		if restore.Spec.VolumeName != nil {
+			volumeName = *restore.Spec.VolumeName
+		}
+		return nil, fmt.Sprintf(backupVolumeNotFoundMsg, volumeName, latest.Name)
+	}
+	return volumes, ""
+}
+
+func backupIncludesVolume(backup *backupv1.VirtualMachineBackup, volumeName string) bool {
+	for _, info := range backup.Status.IncludedVolumes {
+		if info.VolumeName == volumeName {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyRestoreTarget checks the target VM can be restored before any
+// volume is provisioned
+func (ctrl *VMBackupController) verifyRestoreTarget(restore *backupv1.VirtualMachineBackupRestore) string {
+	vmName := restore.Spec.Target.Name
+	obj, exists, err := ctrl.vmStore.GetByKey(cacheKeyFunc(restore.Namespace, vmName))
+	if err != nil {
+		return err.Error()
+	}
+	if restore.Spec.VolumeName == nil {
+		if exists {
+			return fmt.Sprintf(restoreTargetVMExistsMsg, vmName)
+		}
+		return ""
+	}
+
+	if !exists {
+		return fmt.Sprintf(restoreTargetVMNotFoundMsg, vmName)
+	}
+	if volumeIndex(obj.(*v1.VirtualMachine), *restore.Spec.VolumeName) < 0 {
+		return fmt.Sprintf(restoreTargetVolumeNotFoundMsg, vmName, *restore.Spec.VolumeName)
+	}
+	_, vmiExists, err := ctrl.getVMI(restore.Namespace, vmName)
+	if err != nil {
+		return err.Error()
+	}
+	if vmiExists {
+		return fmt.Sprintf(restoreTargetVMRunningMsg, vmName, *restore.Spec.VolumeName)
+	}
+	return ""
+}
+
+func volumeIndex(vm *v1.VirtualMachine, volumeName string) int {
+	if vm.Spec.Template == nil {
+		return -1
+	}
+	for i, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.Name == volumeName {
+			return i
+		}
+	}
+	return -1
+}
+
+func restoreClaimName(restore *backupv1.VirtualMachineBackupRestore, volumeName string) string {
+	return fmt.Sprintf("restore-%s-%s", restore.UID, volumeName)
+}
+
+func restoreImporterPodName(claimName string) string {
+	return fmt.Sprintf("%s-importer", claimName)
+}
+
+// restoreVolumeData makes sure the PVC of the volume and its importer pod
+// exist, it returns whether the data of the volume was restored, or nil if
+// the importer failed
+func (ctrl *VMBackupController) restoreVolumeData(restore *backupv1.VirtualMachineBackupRestore, vmName string, volume restoreVolume, claimName string) (*bool, error) {
+	if err := ctrl.ensureRestoreClaim(restore, volume, claimName); err != nil {
+		return nil, err
+	}
+
+	podName := restoreImporterPodName(claimName)
+	obj, exists, err := ctrl.podStore.GetByKey(cacheKeyFunc(restore.Namespace, podName))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		pod := newRestoreImporterPod(restore, vmName, volume, claimName, ctrl.launcherImage)
+		_, err = ctrl.client.CoreV1().Pods(restore.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+		return pointer.P(false), nil
+	}
+
+	switch obj.(*corev1.Pod).Status.Phase {
+	case corev1.PodSucceeded:
+		return pointer.P(true), nil
+	case corev1.PodFailed:
+		return nil, nil
+	default:
+		return pointer.P(false), nil
+	}
+}
+
+func (ctrl *VMBackupController) ensureRestoreClaim(restore *backupv1.VirtualMachineBackupRestore, volume restoreVolume, claimName string) error {
+	_, exists, err := ctrl.pvcStore.GetByKey(cacheKeyFunc(restore.Namespace, claimName))
+	if err != nil || exists {
+		return err
+	}
+
+	// The image is converted into a file of the disk capacity on
+	// filesystem volumes, the filesystem needs room for its own metadata
+	size := volume.claim.Capacity
+	if !types.IsPVCBlock(volume.claim.VolumeMode) {
+		sizeWithOverhead, err := types.GetSizeIncludingDefaultFSOverhead(&size)
+		if err != nil {
+			return err
+		}
+		size = *sizeWithOverhead
+	}
+
+	accessModes := volume.claim.AccessModes
+	if len(accessModes) == 0 {
+		accessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claimName,
+			Namespace: restore.Namespace,
+			Labels: map[string]string{
+				backupRestoreLabel: restore.Name,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      accessModes,
+			StorageClassName: volume.claim.StorageClassName,
+			VolumeMode:       volume.claim.VolumeMode,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+		},
+	}
+	_, err = ctrl.client.CoreV1().PersistentVolumeClaims(restore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// qcow2Image is the qemu block node of a backup file, backed by the file of
+// the previous backup of the chain
+type qcow2Image struct {
+	Driver  string      `json:"driver"`
+	File    imageFile   `json:"file"`
+	Backing *qcow2Image `json:"backing,omitempty"`
+}
+
+type imageFile struct {
+	Driver   string `json:"driver"`
+	Filename string `json:"filename"`
+}
+
+// restoreImageSpec returns the qemu-img image specification reading the
+// volume through the backup chain, the backing of every backup file is given
+// explicitly so the backup files are never modified
+func restoreImageSpec(vmName string, volume restoreVolume, claimDirs map[string]string) (string, error) {
+	var image *qcow2Image
+	for _, backup := range volume.backups {
+		image = &qcow2Image{
+			Driver: "qcow2",
+			File: imageFile{
+				Driver: "file",
+				Filename: filepath.Join(claimDirs[*backup.Spec.PvcName], vmName, *backup.Status.CheckpointName,
+					fmt.Sprintf("%s-%s.qcow2", backup.Name, volume.name)),
+			},
+			Backing: image,
+		}
+	}
+	spec, err := json.Marshal(image)
+	if err != nil {
+		return "", err
+	}
+	return "json:" + string(spec), nil
+}
+
+func newRestoreImporterPod(restore *backupv1.VirtualMachineBackupRestore, vmName string, volume restoreVolume, claimName, image string) *corev1.Pod {
+	container := corev1.Container{
+		Name:            backupRestoreImporterName,
+		Image:           image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: pointer.P(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+	}
+	var volumes []corev1.Volume
+	claimDirs := map[string]string{}
+	for _, backup := range volume.backups {
+		backupClaim := *backup.Spec.PvcName
+		if _, ok := claimDirs[backupClaim]; ok {
+			continue
+		}
+		volumeName := fmt.Sprintf("backup-%d", len(volumes))
+		claimDirs[backupClaim] = filepath.Join(backupRestoreSourceDir, volumeName)
+		volumes = append(volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: backupClaim,
+					ReadOnly:  true,
+				},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: claimDirs[backupClaim],
+			ReadOnly:  true,
+		})
+	}
+	volumes = append(volumes, corev1.Volume{
+		Name: "target",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			},
+		},
+	})
+
+	// the image specification only contains names derived from the API
+	// objects, it can not fail to marshal
+	imageSpec, _ := restoreImageSpec(vmName, volume, claimDirs)
+	container.Command = []string{"qemu-img", "convert", "-p", "-O", "raw"}
+	if volume.claim.VolumeMode != nil && *volume.claim.VolumeMode == corev1.PersistentVolumeBlock {
+		container.VolumeDevices = []corev1.VolumeDevice{{Name: "target", DevicePath: backupRestoreTargetDevice}}
+		container.Command = append(container.Command, "-n", imageSpec, backupRestoreTargetDevice)
+	} else {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: "target", MountPath: backupRestoreTargetDir})
+		container.Command = append(container.Command, imageSpec, filepath.Join(backupRestoreTargetDir, backupRestoreTargetImageFile))
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      restoreImporterPodName(claimName),
+			Namespace: restore.Namespace,
+			Labels: map[string]string{
+				v1.AppLabel:        backupRestoreImporterApp,
+				backupRestoreLabel: restore.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(restore, backupv1.VirtualMachineBackupRestoreGroupVersionKind),
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot:   pointer.P(true),
+				RunAsUser:      pointer.P(int64(util.NonRootUID)),
+				FSGroup:        pointer.P(int64(util.NonRootUID)),
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			},
+			Containers: []corev1.Container{container},
+			Volumes:    volumes,
+		},
+	}
+}
+
+func (ctrl *VMBackupController) deleteRestoreImporters(restore *backupv1.VirtualMachineBackupRestore, volumes []backupv1.BackupRestoreVolumeStatus) error {
+	for _, volume := range volumes {
+		podName := restoreImporterPodName(volume.ClaimName)
+		_, exists, err := ctrl.podStore.GetByKey(cacheKeyFunc(restore.Namespace, podName))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		err = ctrl.client.CoreV1().Pods(restore.Namespace).Delete(context.Background(), podName, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreTargetVM recreates the VM from the definition captured at backup
+// time, with its restored volumes backed by the restored PVCs
+func (ctrl *VMBackupController) restoreTargetVM(restore *backupv1.VirtualMachineBackupRestore, captured *v1.VirtualMachine, volumes []backupv1.BackupRestoreVolumeStatus) (string, error) {
+	vmName := restore.Spec.Target.Name
+	obj, exists, err := ctrl.vmStore.GetByKey(cacheKeyFunc(restore.Namespace, vmName))
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		if obj.(*v1.VirtualMachine).Annotations[backupRestoreUIDAnnotation] == string(restore.UID) {
+			return "", nil
+		}
+		return fmt.Sprintf(restoreTargetVMExistsMsg, vmName), nil
+	}
+
+	vm := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        vmName,
+			Namespace:   restore.Namespace,
+			Labels:      captured.Labels,
+			Annotations: captured.Annotations,
+		},
+		Spec: *captured.Spec.DeepCopy(),
+	}
+	if vm.Annotations == nil {
+		vm.Annotations = map[string]string{}
+	}
+	vm.Annotations[backupRestoreUIDAnnotation] = string(restore.UID)
+	for _, volume := range volumes {
+		i := volumeIndex(vm, volume.VolumeName)
+		if i < 0 {
+			continue
+		}
+		vm.Spec.Template.Spec.Volumes[i], vm.Spec.DataVolumeTemplates = withRestoredClaim(vm.Spec.Template.Spec.Volumes[i], vm.Spec.DataVolumeTemplates, volume.ClaimName)
+	}
+
+	_, err = ctrl.client.VirtualMachine(restore.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return "", err
+	}
+	return "", nil
+}
+
+// restoreTargetVolume switches the volume of the existing VM to its
+// restored PVC
+func (ctrl *VMBackupController) restoreTargetVolume(restore *backupv1.VirtualMachineBackupRestore, volume backupv1.BackupRestoreVolumeStatus) (string, error) {
+	vmName := restore.Spec.Target.Name
+	obj, exists, err := ctrl.vmStore.GetByKey(cacheKeyFunc(restore.Namespace, vmName))
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return fmt.Sprintf(restoreTargetVMNotFoundMsg, vmName), nil
+	}
+	vm := obj.(*v1.VirtualMachine)
+	i := volumeIndex(vm, volume.VolumeName)
+	if i < 0 {
+		return fmt.Sprintf(restoreTargetVolumeNotFoundMsg, vmName, volume.VolumeName), nil
+	}
+	current := vm.Spec.Template.Spec.Volumes[i]
+	if current.PersistentVolumeClaim != nil && current.PersistentVolumeClaim.ClaimName == volume.ClaimName {
+		return "", nil
+	}
+	if _, vmiExists, err := ctrl.getVMI(restore.Namespace, vmName); err != nil || vmiExists {
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(restoreTargetVMRunningMsg, vmName, volume.VolumeName), nil
+	}
+
+	restored, templates := withRestoredClaim(current, vm.Spec.DataVolumeTemplates, volume.ClaimName)
+	volumePath := fmt.Sprintf("/spec/template/spec/volumes/%d", i)
+	patchSet := patch.New(
+		patch.WithTest(volumePath, current),
+		patch.WithReplace(volumePath, restored),
+	)
+	if len(templates) != len(vm.Spec.DataVolumeTemplates) {
+		patchSet.AddOption(patch.WithTest("/spec/dataVolumeTemplates", vm.Spec.DataVolumeTemplates))
+		if len(templates) == 0 {
+			patchSet.AddOption(patch.WithRemove("/spec/dataVolumeTemplates"))
+		} else {
+			patchSet.AddOption(patch.WithReplace("/spec/dataVolumeTemplates", templates))
+		}
+	}
+	if vm.Annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{backupRestoreUIDAnnotation: string(restore.UID)}))
+	} else {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations/"+patch.EscapeJSONPointer(backupRestoreUIDAnnotation), string(restore.UID)))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = ctrl.client.VirtualMachine(restore.Namespace).Patch(context.Background(), vmName, k8stypes.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return "", err
+}
+
+// withRestoredClaim returns the volume backed by the restored PVC, and the
+// DataVolume templates without the one the volume was created from
+func withRestoredClaim(volume v1.Volume, templates []v1.DataVolumeTemplateSpec, claimName string) (v1.Volume, []v1.DataVolumeTemplateSpec) {
+	if volume.DataVolume != nil {
+		var remaining []v1.DataVolumeTemplateSpec
+		for _, template := range templates {
+			if template.Name != volume.DataVolume.Name {
+				remaining = append(remaining, template)
+			}
+		}
+		templates = remaining
+	}
+
+	restored := v1.Volume{
+		Name: volume.Name,
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+				},
+			},
+		},
+	}
+	if volume.PersistentVolumeClaim != nil {
+		restored.PersistentVolumeClaim.Hotpluggable = volume.PersistentVolumeClaim.Hotpluggable
+	} else if volume.DataVolume != nil {
+		restored.PersistentVolumeClaim.Hotpluggable = volume.DataVolume.Hotpluggable
+	}
+	return restored, templates
+}
@@ -0,0 +1,515 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Backup restore", func() {
+	const (
+		restoreName   = "restore"
+		restoreUID    = "restore-uid"
+		restoreVMName = "restored-vm"
+		rootDisk      = "rootdisk"
+		dataDisk      = "datadisk"
+	)
+
+	var (
+		controller  *VMBackupController
+		recorder    *record.FakeRecorder
+		vmInterface *kubecli.MockVirtualMachineInterface
+		k8sClient   *fake.Clientset
+	)
+
+	capturedVM := func() *runtime.RawExtension {
+		vm := &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   vmName,
+				Labels: map[string]string{"app": "test"},
+			},
+			Spec: v1.VirtualMachineSpec{
+				DataVolumeTemplates: []v1.DataVolumeTemplateSpec{
+					{ObjectMeta: metav1.ObjectMeta{Name: "root-dv"}},
+				},
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Volumes: []v1.Volume{
+							{
+								Name: rootDisk,
+								VolumeSource: v1.VolumeSource{
+									DataVolume: &v1.DataVolumeSource{Name: "root-dv"},
+								},
+							},
+							{
+								Name: dataDisk,
+								VolumeSource: v1.VolumeSource{
+									PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+										PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+											ClaimName: "data-pvc",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		raw, err := json.Marshal(vm)
+		Expect(err).ToNot(HaveOccurred())
+		return &runtime.RawExtension{Raw: raw}
+	}
+
+	volumeInfo := func(volumeName, claimName string, volumeMode corev1.PersistentVolumeMode) v1.BackupVolumeInfo {
+		return v1.BackupVolumeInfo{
+			VolumeName: volumeName,
+			DiskTarget: "vda",
+			Claim: &v1.BackupVolumeClaim{
+				ClaimName:        claimName,
+				Capacity:         resource.MustParse("10Gi"),
+				StorageClassName: pointer.P("local"),
+				VolumeMode:       pointer.P(volumeMode),
+			},
+		}
+	}
+
+	newChainBackup := func(name, checkpoint string, base *string) *backupv1.VirtualMachineBackup {
+		backupType := backupv1.Full
+		if base != nil {
+			backupType = backupv1.Incremental
+		}
+		return &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(backupv1.SchemeGroupVersion.Group),
+					Kind:     backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind,
+					Name:     backupTrackerName,
+				},
+				Mode:    pointer.P(backupv1.PushMode),
+				PvcName: pointer.P(pvcName),
+			},
+			Status: &backupv1.VirtualMachineBackupStatus{
+				Conditions: []backupv1.Condition{
+					newDoneCondition(corev1.ConditionTrue, backupCompleted),
+				},
+				Type:               backupType,
+				CheckpointName:     pointer.P(checkpoint),
+				BaseCheckpointName: base,
+				VirtualMachine:     capturedVM(),
+				IncludedVolumes: []v1.BackupVolumeInfo{
+					volumeInfo(rootDisk, "root-dv", corev1.PersistentVolumeFilesystem),
+					volumeInfo(dataDisk, "data-pvc", corev1.PersistentVolumeBlock),
+				},
+			},
+		}
+	}
+
+	addChain := func() {
+		controller.backupInformer.GetStore().Add(newChainBackup("full", "full-ckp", nil))
+		controller.backupInformer.GetStore().Add(newChainBackup("inc1", "inc1-ckp", pointer.P("full-ckp")))
+		controller.backupInformer.GetStore().Add(newChainBackup("inc2", "inc2-ckp", pointer.P("inc1-ckp")))
+	}
+
+	newRestore := func(checkpoint string, volumeName *string) *backupv1.VirtualMachineBackupRestore {
+		return &backupv1.VirtualMachineBackupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      restoreName,
+				Namespace: testNamespace,
+				UID:       restoreUID,
+			},
+			Spec: backupv1.VirtualMachineBackupRestoreSpec{
+				Target: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     restoreVMName,
+				},
+				CheckpointName: checkpoint,
+				VolumeName:     volumeName,
+			},
+		}
+	}
+
+	importerPod := func(volumeName string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("restore-%s-%s-importer", restoreUID, volumeName),
+				Namespace: testNamespace,
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	listPods := func() []corev1.Pod {
+		pods, err := k8sClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pods.Items
+	}
+
+	listPVCs := func() []corev1.PersistentVolumeClaim {
+		pvcs, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pvcs.Items
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		k8sClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(testNamespace).Return(vmInterface).AnyTimes()
+
+		backupInformer, _ := testutils.NewFakeInformerWithIndexersFor(
+			&backupv1.VirtualMachineBackup{},
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		)
+		backupRestoreInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupRestore{})
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+		podInformer, _ := testutils.NewFakeInformerFor(&corev1.Pod{})
+
+		recorder = record.NewFakeRecorder(100)
+		controller = &VMBackupController{
+			client:                virtClient,
+			backupInformer:        backupInformer,
+			backupRestoreInformer: backupRestoreInformer,
+			vmStore:               vmInformer.GetStore(),
+			vmiStore:              vmiInformer.GetStore(),
+			pvcStore:              pvcInformer.GetStore(),
+			podStore:              podInformer.GetStore(),
+			launcherImage:         "virt-launcher:test",
+			recorder:              recorder,
+		}
+	})
+
+	Context("resolveBackupChain", func() {
+		It("should return the chain of the checkpoint starting with the full backup", func() {
+			addChain()
+
+			chain, reason := controller.resolveBackupChain(testNamespace, "inc2-ckp")
+			Expect(reason).To(BeEmpty())
+			Expect(chain).To(HaveLen(3))
+			Expect(chain[0].Name).To(Equal("full"))
+			Expect(chain[1].Name).To(Equal("inc1"))
+			Expect(chain[2].Name).To(Equal("inc2"))
+		})
+
+		It("should fail when no completed backup created the checkpoint", func() {
+			addChain()
+
+			_, reason := controller.resolveBackupChain(testNamespace, "unknown-ckp")
+			Expect(reason).To(Equal(fmt.Sprintf(checkpointBackupNotFoundMsg, "unknown-ckp")))
+		})
+
+		It("should fail when a backup of the chain is missing", func() {
+			controller.backupInformer.GetStore().Add(newChainBackup("full", "full-ckp", nil))
+			controller.backupInformer.GetStore().Add(newChainBackup("inc2", "inc2-ckp", pointer.P("inc1-ckp")))
+
+			_, reason := controller.resolveBackupChain(testNamespace, "inc2-ckp")
+			Expect(reason).To(Equal(fmt.Sprintf(incompleteBackupChainMsg, "inc1-ckp")))
+		})
+
+		It("should ignore failed backups", func() {
+			addChain()
+			failedBackup := newChainBackup("inc1", "inc1-ckp", pointer.P("full-ckp"))
+			failedBackup.Status.Conditions = []backupv1.Condition{
+				newDoneCondition(corev1.ConditionTrue, fmt.Sprintf(backupFailed, "error")),
+				newFailureCondition(corev1.ConditionTrue, fmt.Sprintf(backupFailed, "error")),
+			}
+			controller.backupInformer.GetStore().Update(failedBackup)
+
+			_, reason := controller.resolveBackupChain(testNamespace, "inc2-ckp")
+			Expect(reason).To(Equal(fmt.Sprintf(incompleteBackupChainMsg, "inc1-ckp")))
+		})
+
+		It("should fail on pull mode backups", func() {
+			backup := newChainBackup("full", "full-ckp", nil)
+			backup.Spec.Mode = pointer.P(backupv1.PullMode)
+			controller.backupInformer.GetStore().Add(backup)
+
+			_, reason := controller.resolveBackupChain(testNamespace, "full-ckp")
+			Expect(reason).To(Equal(fmt.Sprintf(pullModeBackupRestoreMsg, "full")))
+		})
+	})
+
+	Context("importer pod", func() {
+		It("should read the volume through the backing chain of the backup files", func() {
+			addChain()
+			chain, _ := controller.resolveBackupChain(testNamespace, "inc2-ckp")
+			volume := restoreVolume{
+				name:    rootDisk,
+				claim:   chain[2].Status.IncludedVolumes[0].Claim,
+				backups: chain,
+			}
+
+			pod := newRestoreImporterPod(newRestore("inc2-ckp", nil), vmName, volume, "restored-claim", "virt-launcher:test")
+			Expect(pod.Labels).To(HaveKeyWithValue(v1.AppLabel, backupRestoreImporterApp))
+			Expect(pod.Labels).To(HaveKeyWithValue(backupRestoreLabel, restoreName))
+			Expect(pod.OwnerReferences).To(HaveLen(1))
+			Expect(pod.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+			Expect(pod.Spec.Volumes).To(HaveLen(2))
+			Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(pvcName))
+			Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+
+			container := pod.Spec.Containers[0]
+			Expect(container.Image).To(Equal("virt-launcher:test"))
+			Expect(container.Command[:5]).To(Equal([]string{"qemu-img", "convert", "-p", "-O", "raw"}))
+			Expect(container.Command[6]).To(Equal("/target/disk.img"))
+
+			imageSpec := container.Command[5]
+			Expect(imageSpec).To(HavePrefix("json:"))
+			image := &qcow2Image{}
+			Expect(json.Unmarshal([]byte(imageSpec[len("json:"):]), image)).To(Succeed())
+			Expect(image.File.Filename).To(Equal(fmt.Sprintf("/backups/backup-0/%s/inc2-ckp/inc2-%s.qcow2", vmName, rootDisk)))
+			Expect(image.Backing.File.Filename).To(Equal(fmt.Sprintf("/backups/backup-0/%s/inc1-ckp/inc1-%s.qcow2", vmName, rootDisk)))
+			Expect(image.Backing.Backing.File.Filename).To(Equal(fmt.Sprintf("/backups/backup-0/%s/full-ckp/full-%s.qcow2", vmName, rootDisk)))
+			Expect(image.Backing.Backing.Backing).To(BeNil())
+		})
+
+		It("should write block volumes to the device without creating it", func() {
+			addChain()
+			chain, _ := controller.resolveBackupChain(testNamespace, "full-ckp")
+			volume := restoreVolume{
+				name:    dataDisk,
+				claim:   chain[0].Status.IncludedVolumes[1].Claim,
+				backups: chain,
+			}
+
+			pod := newRestoreImporterPod(newRestore("full-ckp", nil), vmName, volume, "restored-claim", "virt-launcher:test")
+			container := pod.Spec.Containers[0]
+			Expect(container.VolumeDevices).To(Equal([]corev1.VolumeDevice{{Name: "target", DevicePath: backupRestoreTargetDevice}}))
+			Expect(container.Command).To(ContainElement("-n"))
+			Expect(container.Command[len(container.Command)-1]).To(Equal(backupRestoreTargetDevice))
+		})
+	})
+
+	Context("restoring a VM", func() {
+		It("should provision the volumes and start their importers", func() {
+			addChain()
+
+			status, err := controller.syncRestore(newRestore("inc2-ckp", nil))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Backups).To(Equal([]string{"full", "inc1", "inc2"}))
+			Expect(status.Volumes).To(ConsistOf(
+				backupv1.BackupRestoreVolumeStatus{VolumeName: rootDisk, ClaimName: restoreClaimName(newRestore("", nil), rootDisk)},
+				backupv1.BackupRestoreVolumeStatus{VolumeName: dataDisk, ClaimName: restoreClaimName(newRestore("", nil), dataDisk)},
+			))
+			Expect(hasCondition(status.Conditions, backupv1.ConditionProgressing)).To(BeTrue())
+			Expect(hasCondition(status.Conditions, backupv1.ConditionDone)).To(BeFalse())
+
+			pvcs := listPVCs()
+			Expect(pvcs).To(HaveLen(2))
+			for _, pvc := range pvcs {
+				Expect(pvc.Labels).To(HaveKeyWithValue(backupRestoreLabel, restoreName))
+				Expect(pvc.Spec.StorageClassName).To(Equal(pointer.P("local")))
+			}
+			Expect(listPods()).To(HaveLen(2))
+			testutils.ExpectEvent(recorder, backupRestoreProgressingEvent)
+		})
+
+		It("should add the filesystem overhead to filesystem volumes only", func() {
+			addChain()
+
+			_, err := controller.syncRestore(newRestore("inc2-ckp", nil))
+			Expect(err).ToNot(HaveOccurred())
+
+			capacity := resource.MustParse("10Gi")
+			sizeWithOverhead, err := types.GetSizeIncludingDefaultFSOverhead(&capacity)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sizeWithOverhead.Cmp(capacity)).To(Equal(1))
+
+			pvcs := listPVCs()
+			Expect(pvcs).To(HaveLen(2))
+			for _, pvc := range pvcs {
+				switch *pvc.Spec.VolumeMode {
+				case corev1.PersistentVolumeFilesystem:
+					Expect(pvc.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(*sizeWithOverhead))
+				case corev1.PersistentVolumeBlock:
+					Expect(pvc.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(capacity))
+				}
+			}
+		})
+
+		It("should fail when the target VM already exists", func() {
+			addChain()
+			controller.vmStore.Add(&v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: restoreVMName, Namespace: testNamespace},
+			})
+
+			status, err := controller.syncRestore(newRestore("inc2-ckp", nil))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hasCondition(status.Conditions, backupv1.ConditionDone)).To(BeTrue())
+			Expect(status.Conditions[1].Reason).To(Equal(fmt.Sprintf(backupRestoreFailed, fmt.Sprintf(restoreTargetVMExistsMsg, restoreVMName))))
+			Expect(listPVCs()).To(BeEmpty())
+			testutils.ExpectEvent(recorder, backupRestoreFailedEvent)
+		})
+
+		It("should recreate the VM with the restored volumes once the importers succeeded", func() {
+			addChain()
+			restore := newRestore("inc2-ckp", nil)
+			for _, volumeName := range []string{rootDisk, dataDisk} {
+				claimName := restoreClaimName(restore, volumeName)
+				controller.pvcStore.Add(&corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: claimName, Namespace: testNamespace},
+				})
+				pod := importerPod(volumeName, corev1.PodSucceeded)
+				controller.podStore.Add(pod)
+				_, err := k8sClient.CoreV1().Pods(testNamespace).Create(context.Background(), pod, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			vmInterface.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, vm *v1.VirtualMachine, opts metav1.CreateOptions) (*v1.VirtualMachine, error) {
+					Expect(vm.Name).To(Equal(restoreVMName))
+					Expect(vm.Labels).To(HaveKeyWithValue("app", "test"))
+					Expect(vm.Annotations).To(HaveKeyWithValue(backupRestoreUIDAnnotation, restoreUID))
+					Expect(vm.Spec.DataVolumeTemplates).To(BeEmpty())
+					volumes := vm.Spec.Template.Spec.Volumes
+					Expect(volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(restoreClaimName(restore, rootDisk)))
+					Expect(volumes[1].PersistentVolumeClaim.ClaimName).To(Equal(restoreClaimName(restore, dataDisk)))
+					return vm, nil
+				})
+
+			status, err := controller.syncRestore(restore)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Volumes).To(HaveLen(2))
+			Expect(status.Volumes[0].Completed).To(BeTrue())
+			Expect(status.Volumes[1].Completed).To(BeTrue())
+			Expect(hasCondition(status.Conditions, backupv1.ConditionDone)).To(BeTrue())
+			Expect(hasCondition(status.Conditions, backupv1.ConditionProgressing)).To(BeFalse())
+			Expect(listPods()).To(BeEmpty())
+			testutils.ExpectEvent(recorder, backupRestoreCompletedEvent)
+		})
+
+		It("should fail and clean up when an importer fails", func() {
+			addChain()
+			restore := newRestore("inc2-ckp", nil)
+			restore.Status = &backupv1.VirtualMachineBackupRestoreStatus{
+				Volumes: []backupv1.BackupRestoreVolumeStatus{
+					{VolumeName: rootDisk, ClaimName: restoreClaimName(restore, rootDisk)},
+				},
+			}
+			claim := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: restoreClaimName(restore, rootDisk), Namespace: testNamespace},
+			}
+			controller.pvcStore.Add(claim)
+			_, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Create(context.Background(), claim, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			controller.podStore.Add(importerPod(rootDisk, corev1.PodFailed))
+
+			status, err := controller.syncRestore(restore)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hasCondition(status.Conditions, backupv1.ConditionDone)).To(BeTrue())
+			Expect(status.Volumes).To(BeEmpty())
+			Expect(listPVCs()).To(BeEmpty())
+			testutils.ExpectEvent(recorder, backupRestoreFailedEvent)
+		})
+	})
+
+	Context("restoring a single volume", func() {
+		var vm *v1.VirtualMachine
+
+		BeforeEach(func() {
+			addChain()
+			captured, _ := backupVirtualMachine(newChainBackup("full", "full-ckp", nil))
+			vm = &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: restoreVMName, Namespace: testNamespace},
+				Spec:       captured.Spec,
+			}
+			controller.vmStore.Add(vm)
+		})
+
+		It("should fail when the VM is running", func() {
+			controller.vmiStore.Add(&v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: restoreVMName, Namespace: testNamespace},
+			})
+
+			status, err := controller.syncRestore(newRestore("inc1-ckp", pointer.P(rootDisk)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hasCondition(status.Conditions, backupv1.ConditionDone)).To(BeTrue())
+			Expect(status.Conditions[1].Reason).To(ContainSubstring(fmt.Sprintf(restoreTargetVMRunningMsg, restoreVMName, rootDisk)))
+		})
+
+		It("should fail when the volume is not part of the backup", func() {
+			status, err := controller.syncRestore(newRestore("inc1-ckp", pointer.P("unknown")))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Conditions[1].Reason).To(ContainSubstring(fmt.Sprintf(backupVolumeNotFoundMsg, "unknown", "inc1")))
+		})
+
+		It("should only provision the restored volume", func() {
+			status, err := controller.syncRestore(newRestore("inc1-ckp", pointer.P(rootDisk)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Backups).To(Equal([]string{"full", "inc1"}))
+			Expect(status.Volumes).To(HaveLen(1))
+			Expect(listPVCs()).To(HaveLen(1))
+			Expect(listPods()).To(HaveLen(1))
+		})
+
+		It("should switch the volume of the VM to the restored PVC", func() {
+			restore := newRestore("inc1-ckp", pointer.P(rootDisk))
+			controller.pvcStore.Add(&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: restoreClaimName(restore, rootDisk), Namespace: testNamespace},
+			})
+			controller.podStore.Add(importerPod(rootDisk, corev1.PodSucceeded))
+
+			vmInterface.EXPECT().Patch(gomock.Any(), restoreVMName, k8stypes.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, name string, patchType k8stypes.PatchType, patchBytes []byte, opts metav1.PatchOptions, subresources ...string) (*v1.VirtualMachine, error) {
+					Expect(string(patchBytes)).To(ContainSubstring(`"path":"/spec/template/spec/volumes/0"`))
+					Expect(string(patchBytes)).To(ContainSubstring(restoreClaimName(restore, rootDisk)))
+					Expect(string(patchBytes)).To(ContainSubstring(`{"op":"remove","path":"/spec/dataVolumeTemplates"}`))
+					return vm, nil
+				})
+
+			status, err := controller.syncRestore(restore)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hasCondition(status.Conditions, backupv1.ConditionDone)).To(BeTrue())
+			Expect(status.Conditions[1].Reason).To(Equal(backupRestoreCompleted))
+		})
+	})
+})
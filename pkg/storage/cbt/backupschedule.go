@@ -0,0 +1,555 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	backupScheduleLabel = "backup.kubevirt.io/schedule"
+
+	// backupScheduleRetryInterval is how long a due backup which is held
+	// back by a running backup or a concurrency limit waits before retrying
+	backupScheduleRetryInterval = 30 * time.Second
+
+	backupScheduledEvent           = "VirtualMachineBackupScheduled"
+	backupScheduleSkippedEvent     = "VirtualMachineBackupScheduleSkipped"
+	backupScheduleFailedEvent      = "VirtualMachineBackupScheduleFailed"
+	backupScheduleInvalidEvent     = "VirtualMachineBackupScheduleInvalid"
+	backupScheduledMsg             = "Created VirtualMachineBackup %s for VM %s"
+	backupScheduleSkippedMsg       = "Skipped the backup of VM %s scheduled at %s: %s"
+	backupScheduleInvalidMsg       = "Invalid schedule %q: %v"
+	backupScheduleInProgressMsg    = "Waiting for VirtualMachineBackup %s to complete"
+	backupScheduleNodeLimitMsg     = "Waiting for a backup slot on node %s, limit of %d reached"
+	backupScheduleStorageLimitMsg  = "Waiting for a backup slot on storage class %s, limit of %d reached"
+	backupScheduleTrackerNotOwned  = "VirtualMachineBackupTracker %s is not owned by the schedule"
+	backupScheduleBackupNameLayout = "20060102-150405"
+)
+
+// backupConcurrency counts the backups in progress per node and per
+// storage class
+type backupConcurrency struct {
+	perNode         map[string]int
+	perStorageClass map[string]int
+}
+
+func (ctrl *VMBackupController) handleBackupSchedule(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if schedule, ok := obj.(*backupv1.VirtualMachineBackupSchedule); ok {
+		key := cacheKeyFunc(schedule.Namespace, schedule.Name)
+		log.Log.V(3).Infof("enqueued schedule %q for sync", key)
+		ctrl.scheduleQueue.Add(key)
+	}
+}
+
+// enqueueBackupSchedule enqueues the schedule which created the backup, so
+// that its status reflects the outcome of the backup and pending backups
+// can be started once the backup is done
+func (ctrl *VMBackupController) enqueueBackupSchedule(backup *backupv1.VirtualMachineBackup) {
+	scheduleName, ok := backup.Labels[backupScheduleLabel]
+	if !ok || ctrl.scheduleQueue == nil {
+		return
+	}
+	ctrl.scheduleQueue.Add(cacheKeyFunc(backup.Namespace, scheduleName))
+}
+
+func (ctrl *VMBackupController) runScheduleWorker() {
+	for ctrl.ExecuteSchedule() {
+	}
+}
+
+func (ctrl *VMBackupController) ExecuteSchedule() bool {
+	key, quit := ctrl.scheduleQueue.Get()
+	if quit {
+		return false
+	}
+	defer ctrl.scheduleQueue.Done(key)
+
+	err := ctrl.executeSchedule(key)
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineBackupSchedule %v", key)
+		ctrl.scheduleQueue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineBackupSchedule %v", key)
+		ctrl.scheduleQueue.Forget(key)
+	}
+	return true
+}
+
+func (ctrl *VMBackupController) executeSchedule(key string) error {
+	logger := log.Log.With("VirtualMachineBackupSchedule", key)
+	logger.V(3).Infof("Processing VirtualMachineBackupSchedule %s", key)
+
+	storeObj, exists, err := ctrl.backupScheduleInformer.GetStore().GetByKey(key)
+	if err != nil {
+		logger.Errorf("Error getting schedule from store: %v", err)
+		return err
+	}
+	if !exists {
+		logger.V(3).Infof("Schedule %s no longer exists in store", key)
+		return nil
+	}
+
+	schedule, ok := storeObj.(*backupv1.VirtualMachineBackupSchedule)
+	if !ok {
+		logger.Errorf("Unexpected resource type: %T", storeObj)
+		return fmt.Errorf("unexpected resource %+v", storeObj)
+	}
+	if schedule.DeletionTimestamp != nil {
+		return nil
+	}
+
+	cron, err := ParseCronSchedule(schedule.Spec.Schedule)
+	if err != nil {
+		ctrl.recorder.Eventf(schedule, corev1.EventTypeWarning, backupScheduleInvalidEvent, backupScheduleInvalidMsg, schedule.Spec.Schedule, err)
+		return nil
+	}
+
+	now := time.Now().UTC()
+	status, retry, err := ctrl.syncSchedule(schedule, cron, now)
+	if err != nil {
+		return err
+	}
+
+	if !equality.Semantic.DeepEqual(schedule.Status, status) {
+		scheduleCopy := schedule.DeepCopy()
+		scheduleCopy.Status = status
+		_, err = ctrl.client.VirtualMachineBackupSchedule(schedule.Namespace).UpdateStatus(context.Background(), scheduleCopy, metav1.UpdateOptions{})
+		if err != nil {
+			logger.Reason(err).Errorf("Updating the VirtualMachineBackupSchedule status failed")
+			return err
+		}
+	}
+
+	if retry {
+		ctrl.scheduleQueue.AddAfter(key, backupScheduleRetryInterval)
+	}
+	if status.NextScheduleTime != nil {
+		ctrl.scheduleQueue.AddAfter(key, status.NextScheduleTime.Sub(now))
+	}
+	return nil
+}
+
+// syncSchedule creates the backups which are due at the given time and
+// returns the new status of the schedule, and whether some due backups are
+// pending and have to be retried
+func (ctrl *VMBackupController) syncSchedule(schedule *backupv1.VirtualMachineBackupSchedule, cron *CronSchedule, now time.Time) (*backupv1.VirtualMachineBackupScheduleStatus, bool, error) {
+	vmNames, err := ctrl.scheduleSourceVMs(schedule)
+	if err != nil {
+		return nil, false, err
+	}
+
+	previous := map[string]backupv1.BackupScheduleSourceStatus{}
+	if schedule.Status != nil {
+		for _, source := range schedule.Status.Sources {
+			previous[source.VMName] = source
+		}
+	}
+
+	var usage *backupConcurrency
+	retry := false
+	status := &backupv1.VirtualMachineBackupScheduleStatus{}
+	for _, vmName := range vmNames {
+		source := backupv1.BackupScheduleSourceStatus{
+			VMName:           vmName,
+			TrackerName:      scheduleTrackerName(schedule.Name, vmName),
+			LastScheduleTime: previous[vmName].LastScheduleTime,
+		}
+
+		owned, err := ctrl.ensureScheduleTracker(schedule, vmName, source.TrackerName)
+		if err != nil {
+			return nil, false, err
+		}
+		if !owned {
+			source.Message = fmt.Sprintf(backupScheduleTrackerNotOwned, source.TrackerName)
+			status.Sources = append(status.Sources, source)
+			continue
+		}
+
+		inProgress, err := ctrl.updateSourceBackupHistory(schedule.Namespace, &source)
+		if err != nil {
+			return nil, false, err
+		}
+
+		lastRun := schedule.CreationTimestamp.Time
+		if source.LastScheduleTime != nil {
+			lastRun = source.LastScheduleTime.Time
+		}
+		scheduled := cron.Latest(lastRun, now)
+		if schedule.Spec.Suspend || scheduled.IsZero() {
+			status.Sources = append(status.Sources, source)
+			continue
+		}
+
+		if inProgress != "" {
+			source.Message = fmt.Sprintf(backupScheduleInProgressMsg, inProgress)
+			status.Sources = append(status.Sources, source)
+			retry = true
+			continue
+		}
+
+		vmi, exists, err := ctrl.getVMI(schedule.Namespace, vmName)
+		if err != nil {
+			return nil, false, err
+		}
+		if !exists {
+			reason := fmt.Sprintf(vmNotRunningMsg, vmName)
+			ctrl.recorder.Eventf(schedule, corev1.EventTypeWarning, backupScheduleSkippedEvent, backupScheduleSkippedMsg,
+				vmName, scheduled.Format(time.RFC3339), reason)
+			source.LastScheduleTime = &metav1.Time{Time: scheduled}
+			source.Message = reason
+			status.Sources = append(status.Sources, source)
+			continue
+		}
+
+		if schedule.Spec.Concurrency != nil {
+			if usage == nil {
+				usage = ctrl.backupConcurrencyUsage()
+			}
+			if reason := ctrl.concurrencyLimitReason(schedule.Spec.Concurrency, usage, vmi); reason != "" {
+				source.Message = reason
+				status.Sources = append(status.Sources, source)
+				retry = true
+				continue
+			}
+		}
+
+		backup, err := ctrl.createScheduledBackup(schedule, vmName, source.TrackerName, scheduled)
+		if err != nil {
+			ctrl.recorder.Eventf(schedule, corev1.EventTypeWarning, backupScheduleFailedEvent, "Failed to create backup of VM %s: %v", vmName, err)
+			return nil, false, err
+		}
+		ctrl.recorder.Eventf(schedule, corev1.EventTypeNormal, backupScheduledEvent, backupScheduledMsg, backup.Name, vmName)
+		if usage != nil {
+			ctrl.addBackupConcurrencyUsage(usage, vmi)
+		}
+		source.LastScheduleTime = &metav1.Time{Time: scheduled}
+		source.LastBackupName = backup.Name
+		source.Message = ""
+		status.Sources = append(status.Sources, source)
+	}
+
+	for _, source := range status.Sources {
+		if source.LastScheduleTime != nil &&
+			(status.LastScheduleTime == nil || status.LastScheduleTime.Before(source.LastScheduleTime)) {
+			status.LastScheduleTime = source.LastScheduleTime.DeepCopy()
+		}
+	}
+	if !schedule.Spec.Suspend {
+		if next := cron.Next(now); !next.IsZero() {
+			status.NextScheduleTime = &metav1.Time{Time: next}
+		}
+	}
+
+	return status, retry, nil
+}
+
+func scheduleTrackerName(scheduleName, vmName string) string {
+	return fmt.Sprintf("%s-%s", scheduleName, vmName)
+}
+
+func scheduledBackupName(trackerName string, scheduled time.Time) string {
+	return fmt.Sprintf("%s-%s", trackerName, scheduled.UTC().Format(backupScheduleBackupNameLayout))
+}
+
+// scheduleSourceVMs returns the names of the VMs backed up by the schedule
+func (ctrl *VMBackupController) scheduleSourceVMs(schedule *backupv1.VirtualMachineBackupSchedule) ([]string, error) {
+	if schedule.Spec.Source != nil {
+		return []string{schedule.Spec.Source.Name}, nil
+	}
+	if schedule.Spec.Selector == nil {
+		return nil, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(schedule.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+
+	var vmNames []string
+	for _, obj := range ctrl.vmStore.List() {
+		vm, ok := obj.(*v1.VirtualMachine)
+		if !ok || vm.Namespace != schedule.Namespace {
+			continue
+		}
+		if selector.Matches(labels.Set(vm.Labels)) {
+			vmNames = append(vmNames, vm.Name)
+		}
+	}
+	sort.Strings(vmNames)
+	return vmNames, nil
+}
+
+// ensureScheduleTracker creates the backup tracker of the VM if it does
+// not exist yet, and reports whether the tracker is owned by the schedule
+func (ctrl *VMBackupController) ensureScheduleTracker(schedule *backupv1.VirtualMachineBackupSchedule, vmName, trackerName string) (bool, error) {
+	obj, exists, err := ctrl.backupTrackerInformer.GetStore().GetByKey(cacheKeyFunc(schedule.Namespace, trackerName))
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		tracker := obj.(*backupv1.VirtualMachineBackupTracker)
+		if !metav1.IsControlledBy(tracker, schedule) {
+			return false, nil
+		}
+		return true, ctrl.syncScheduleTrackerRetention(schedule, tracker)
+	}
+
+	tracker := &backupv1.VirtualMachineBackupTracker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      trackerName,
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				backupScheduleLabel: schedule.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(schedule, backupv1.VirtualMachineBackupScheduleGroupVersionKind),
+			},
+		},
+		Spec: backupv1.VirtualMachineBackupTrackerSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(v1.VirtualMachineGroupVersionKind.Group),
+				Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+				Name:     vmName,
+			},
+			RetentionPolicy: schedule.Spec.RetentionPolicy.DeepCopy(),
+		},
+	}
+	_, err = ctrl.client.VirtualMachineBackupTracker(schedule.Namespace).Create(context.Background(), tracker, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return false, fmt.Errorf("failed to create BackupTracker %s: %w", trackerName, err)
+	}
+	log.Log.Object(schedule).Infof("Created BackupTracker %s for VM %s", trackerName, vmName)
+	return true, nil
+}
+
+// syncScheduleTrackerRetention keeps the retention policy of the tracker
+// in sync with the one of the schedule owning it
+func (ctrl *VMBackupController) syncScheduleTrackerRetention(schedule *backupv1.VirtualMachineBackupSchedule, tracker *backupv1.VirtualMachineBackupTracker) error {
+	if equality.Semantic.DeepEqual(tracker.Spec.RetentionPolicy, schedule.Spec.RetentionPolicy) {
+		return nil
+	}
+	trackerCopy := tracker.DeepCopy()
+	trackerCopy.Spec.RetentionPolicy = schedule.Spec.RetentionPolicy.DeepCopy()
+	_, err := ctrl.client.VirtualMachineBackupTracker(tracker.Namespace).Update(context.Background(), trackerCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update the retention policy of BackupTracker %s: %w", tracker.Name, err)
+	}
+	log.Log.Object(schedule).Infof("Updated the retention policy of BackupTracker %s", tracker.Name)
+	return nil
+}
+
+// updateSourceBackupHistory fills the outcome of the previous backups of
+// the source and returns the name of its backup in progress, if any
+func (ctrl *VMBackupController) updateSourceBackupHistory(namespace string, source *backupv1.BackupScheduleSourceStatus) (string, error) {
+	objs, err := ctrl.backupInformer.GetIndexer().ByIndex("backupTracker", cacheKeyFunc(namespace, source.TrackerName))
+	if err != nil {
+		return "", err
+	}
+
+	backups := make([]*backupv1.VirtualMachineBackup, 0, len(objs))
+	for _, obj := range objs {
+		backups = append(backups, obj.(*backupv1.VirtualMachineBackup))
+	}
+	// newest first
+	sort.Slice(backups, func(i, j int) bool {
+		ti, tj := backups[i].CreationTimestamp, backups[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return tj.Before(&ti)
+		}
+		return backups[i].Name > backups[j].Name
+	})
+
+	inProgress := ""
+	countingFailures := true
+	for _, backup := range backups {
+		if source.LastBackupName == "" {
+			source.LastBackupName = backup.Name
+		}
+		if !IsBackupDone(backup.Status) {
+			if inProgress == "" {
+				inProgress = backup.Name
+			}
+			continue
+		}
+		if isBackupFailed(backup.Status) {
+			if countingFailures {
+				source.ConsecutiveFailures++
+				if source.LastFailure == "" {
+					source.LastFailure = getCondition(backup.Status.Conditions, backupv1.ConditionFailure).Reason
+				}
+			}
+			continue
+		}
+		countingFailures = false
+		if source.LastSuccessfulTime == nil {
+			done := getCondition(backup.Status.Conditions, backupv1.ConditionDone)
+			source.LastSuccessfulTime = done.LastTransitionTime.DeepCopy()
+		}
+	}
+	return inProgress, nil
+}
+
+func isBackupFailed(status *backupv1.VirtualMachineBackupStatus) bool {
+	cond := getCondition(status.Conditions, backupv1.ConditionFailure)
+	return cond != nil && cond.Status == corev1.ConditionTrue
+}
+
+func getCondition(conditions []backupv1.Condition, condType backupv1.ConditionType) *backupv1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == condType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// backupConcurrencyUsage counts the backups which are not done yet, on the
+// nodes and storage classes of the VMs they back up
+func (ctrl *VMBackupController) backupConcurrencyUsage() *backupConcurrency {
+	usage := &backupConcurrency{
+		perNode:         map[string]int{},
+		perStorageClass: map[string]int{},
+	}
+	for _, obj := range ctrl.backupInformer.GetStore().List() {
+		backup, ok := obj.(*backupv1.VirtualMachineBackup)
+		if !ok || IsBackupDone(backup.Status) {
+			continue
+		}
+		tracker, syncInfo := ctrl.getBackupTracker(backup)
+		if syncInfo != nil {
+			continue
+		}
+		vmi, exists, err := ctrl.getVMI(backup.Namespace, getSourceName(backup, tracker))
+		if err != nil || !exists {
+			continue
+		}
+		ctrl.addBackupConcurrencyUsage(usage, vmi)
+	}
+	return usage
+}
+
+func (ctrl *VMBackupController) addBackupConcurrencyUsage(usage *backupConcurrency, vmi *v1.VirtualMachineInstance) {
+	if vmi.Status.NodeName != "" {
+		usage.perNode[vmi.Status.NodeName]++
+	}
+	for _, storageClass := range ctrl.vmiStorageClasses(vmi) {
+		usage.perStorageClass[storageClass]++
+	}
+}
+
+// vmiStorageClasses returns the storage classes of the volumes of the VMI
+// which are included in backups
+func (ctrl *VMBackupController) vmiStorageClasses(vmi *v1.VirtualMachineInstance) []string {
+	seen := map[string]struct{}{}
+	var storageClasses []string
+	for i := range vmi.Spec.Volumes {
+		volume := &vmi.Spec.Volumes[i]
+		if !IsCBTEligibleVolume(volume) {
+			continue
+		}
+		pvcName := types.PVCNameFromVirtVolume(volume)
+		if pvcName == "" {
+			continue
+		}
+		obj, exists, err := ctrl.pvcStore.GetByKey(cacheKeyFunc(vmi.Namespace, pvcName))
+		if err != nil || !exists {
+			continue
+		}
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+		if pvc.Spec.StorageClassName == nil {
+			continue
+		}
+		if _, ok := seen[*pvc.Spec.StorageClassName]; ok {
+			continue
+		}
+		seen[*pvc.Spec.StorageClassName] = struct{}{}
+		storageClasses = append(storageClasses, *pvc.Spec.StorageClassName)
+	}
+	return storageClasses
+}
+
+// concurrencyLimitReason returns why a backup of the VMI can not start
+// without exceeding the concurrency policy, or an empty string if it can
+func (ctrl *VMBackupController) concurrencyLimitReason(policy *backupv1.BackupConcurrencyPolicy, usage *backupConcurrency, vmi *v1.VirtualMachineInstance) string {
+	if policy.MaxPerNode != nil && vmi.Status.NodeName != "" &&
+		usage.perNode[vmi.Status.NodeName] >= int(*policy.MaxPerNode) {
+		return fmt.Sprintf(backupScheduleNodeLimitMsg, vmi.Status.NodeName, *policy.MaxPerNode)
+	}
+	if policy.MaxPerStorageClass != nil {
+		for _, storageClass := range ctrl.vmiStorageClasses(vmi) {
+			if usage.perStorageClass[storageClass] >= int(*policy.MaxPerStorageClass) {
+				return fmt.Sprintf(backupScheduleStorageLimitMsg, storageClass, *policy.MaxPerStorageClass)
+			}
+		}
+	}
+	return ""
+}
+
+func (ctrl *VMBackupController) createScheduledBackup(schedule *backupv1.VirtualMachineBackupSchedule, vmName, trackerName string, scheduled time.Time) (*backupv1.VirtualMachineBackup, error) {
+	template := schedule.Spec.Template
+	backup := &backupv1.VirtualMachineBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scheduledBackupName(trackerName, scheduled),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				backupScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: backupv1.VirtualMachineBackupSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(backupv1.SchemeGroupVersion.Group),
+				Kind:     backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind,
+				Name:     trackerName,
+			},
+			Mode:        template.Mode,
+			PvcName:     template.PvcName,
+			SkipQuiesce: template.SkipQuiesce,
+			Hooks:       template.Hooks,
+			TTLDuration: template.TTLDuration,
+		},
+	}
+	created, err := ctrl.client.VirtualMachineBackup(schedule.Namespace).Create(context.Background(), backup, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return backup, nil
+	}
+	return created, err
+}
@@ -0,0 +1,480 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Backup schedule", func() {
+	const scheduleName = "nightly"
+
+	var (
+		controller     *VMBackupController
+		recorder       *record.FakeRecorder
+		kubevirtClient *kubevirtfake.Clientset
+		cron           *CronSchedule
+		created        time.Time
+	)
+
+	trackerName := scheduleTrackerName(scheduleName, vmName)
+
+	newSchedule := func() *backupv1.VirtualMachineBackupSchedule {
+		return &backupv1.VirtualMachineBackupSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				UID:               "schedule-uid",
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: backupv1.VirtualMachineBackupScheduleSpec{
+				Schedule: "@hourly",
+				Source: &corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				Template: backupv1.VirtualMachineBackupScheduleTemplate{
+					Mode: pointer.P(backupv1.PullMode),
+				},
+				RetentionPolicy: &backupv1.BackupRetentionPolicy{
+					MaxChainLength: pointer.P(int32(7)),
+				},
+			},
+		}
+	}
+
+	newVMI := func(name, nodeName string, claimNames ...string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				NodeName: nodeName,
+				Phase:    v1.Running,
+			},
+		}
+		for _, claimName := range claimNames {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: claimName,
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: claimName,
+						},
+					},
+				},
+			})
+		}
+		return vmi
+	}
+
+	newPVC := func(name, storageClass string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: pointer.P(storageClass),
+			},
+		}
+	}
+
+	newScheduledBackup := func(name, tracker string, age time.Duration, conditions []backupv1.Condition) *backupv1.VirtualMachineBackup {
+		backup := &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+				Labels:            map[string]string{backupScheduleLabel: scheduleName},
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(backupv1.SchemeGroupVersion.Group),
+					Kind:     backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind,
+					Name:     tracker,
+				},
+			},
+			Status: &backupv1.VirtualMachineBackupStatus{Conditions: conditions},
+		}
+		return backup
+	}
+
+	succeeded := func(at time.Time) []backupv1.Condition {
+		condition := newDoneCondition(corev1.ConditionTrue, backupCompleted)
+		condition.LastTransitionTime = metav1.NewTime(at)
+		return []backupv1.Condition{condition}
+	}
+
+	failed := func(reason string) []backupv1.Condition {
+		return []backupv1.Condition{
+			newDoneCondition(corev1.ConditionTrue, fmt.Sprintf(backupFailed, reason)),
+			newFailureCondition(corev1.ConditionTrue, fmt.Sprintf(backupFailed, reason)),
+		}
+	}
+
+	newTracker := func(name, vm string, owner *backupv1.VirtualMachineBackupSchedule) *backupv1.VirtualMachineBackupTracker {
+		tracker := &backupv1.VirtualMachineBackupTracker{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Spec: backupv1.VirtualMachineBackupTrackerSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     vm,
+				},
+			},
+		}
+		if owner != nil {
+			tracker.OwnerReferences = []metav1.OwnerReference{
+				*metav1.NewControllerRef(owner, backupv1.VirtualMachineBackupScheduleGroupVersionKind),
+			}
+			tracker.Spec.RetentionPolicy = owner.Spec.RetentionPolicy.DeepCopy()
+		}
+		return tracker
+	}
+
+	listBackups := func() []backupv1.VirtualMachineBackup {
+		backups, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return backups.Items
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineBackup(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineBackupTracker(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineBackupSchedule(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackupSchedules(testNamespace)).AnyTimes()
+
+		backupInformer, _ := testutils.NewFakeInformerWithIndexersFor(
+			&backupv1.VirtualMachineBackup{},
+			cache.Indexers{
+				"backupTracker": func(obj interface{}) ([]string, error) {
+					backup := obj.(*backupv1.VirtualMachineBackup)
+					if backup.Spec.Source.Kind == backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind {
+						return []string{cacheKeyFunc(backup.Namespace, backup.Spec.Source.Name)}, nil
+					}
+					return nil, nil
+				},
+			},
+		)
+		backupTrackerInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupTracker{})
+		backupScheduleInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupSchedule{})
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+
+		recorder = record.NewFakeRecorder(100)
+		controller = &VMBackupController{
+			client:                 virtClient,
+			backupInformer:         backupInformer,
+			backupTrackerInformer:  backupTrackerInformer,
+			backupScheduleInformer: backupScheduleInformer,
+			vmStore:                vmInformer.GetStore(),
+			vmiStore:               vmiInformer.GetStore(),
+			pvcStore:               pvcInformer.GetStore(),
+			recorder:               recorder,
+			scheduleQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "test-backup-schedule-queue"},
+			),
+		}
+
+		var err error
+		cron, err = ParseCronSchedule("@hourly")
+		Expect(err).ToNot(HaveOccurred())
+		created = time.Date(2026, 1, 1, 10, 5, 0, 0, time.UTC)
+	})
+
+	It("should create an owned tracker and a backup when the schedule is due", func() {
+		schedule := newSchedule()
+		schedule.Spec.Template.Hooks = &v1.FreezeHooks{
+			PreFreeze: []v1.FreezeHook{{
+				Name:      "flush",
+				GuestExec: &v1.GuestExecHook{Command: []string{"/usr/bin/db-flush"}},
+			}},
+		}
+		controller.vmiStore.Add(newVMI(vmName, "node01"))
+		now := created.Add(time.Hour)
+
+		status, retry, err := controller.syncSchedule(schedule, cron, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeFalse())
+
+		tracker, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(testNamespace).Get(context.Background(), trackerName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(metav1.IsControlledBy(tracker, schedule)).To(BeTrue())
+		Expect(tracker.Spec.Source.Name).To(Equal(vmName))
+		Expect(tracker.Spec.RetentionPolicy).To(Equal(schedule.Spec.RetentionPolicy))
+
+		scheduled := time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC)
+		backups := listBackups()
+		Expect(backups).To(HaveLen(1))
+		Expect(backups[0].Name).To(Equal(scheduledBackupName(trackerName, scheduled)))
+		Expect(backups[0].Labels).To(HaveKeyWithValue(backupScheduleLabel, scheduleName))
+		Expect(backups[0].Spec.Source.Kind).To(Equal(backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind))
+		Expect(backups[0].Spec.Source.Name).To(Equal(trackerName))
+		Expect(backups[0].Spec.Mode).To(HaveValue(Equal(backupv1.PullMode)))
+		Expect(backups[0].Spec.Hooks).To(Equal(schedule.Spec.Template.Hooks))
+		testutils.ExpectEvent(recorder, backupScheduledEvent)
+
+		Expect(status.Sources).To(HaveLen(1))
+		Expect(status.Sources[0].TrackerName).To(Equal(trackerName))
+		Expect(status.Sources[0].LastBackupName).To(Equal(backups[0].Name))
+		Expect(status.Sources[0].LastScheduleTime.Time).To(Equal(scheduled))
+		Expect(status.LastScheduleTime.Time).To(Equal(scheduled))
+		Expect(status.NextScheduleTime.Time).To(Equal(scheduled.Add(time.Hour)))
+	})
+
+	It("should not create a backup before the schedule is due", func() {
+		controller.vmiStore.Add(newVMI(vmName, "node01"))
+
+		status, _, err := controller.syncSchedule(newSchedule(), cron, created.Add(50*time.Minute))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listBackups()).To(BeEmpty())
+		Expect(status.LastScheduleTime).To(BeNil())
+		Expect(status.NextScheduleTime.Time).To(Equal(time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC)))
+	})
+
+	It("should not create backups while suspended", func() {
+		schedule := newSchedule()
+		schedule.Spec.Suspend = true
+		controller.vmiStore.Add(newVMI(vmName, "node01"))
+
+		status, _, err := controller.syncSchedule(schedule, cron, created.Add(2*time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listBackups()).To(BeEmpty())
+		Expect(status.NextScheduleTime).To(BeNil())
+	})
+
+	It("should skip the run when the VM is not running", func() {
+		status, retry, err := controller.syncSchedule(newSchedule(), cron, created.Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeFalse())
+		Expect(listBackups()).To(BeEmpty())
+		testutils.ExpectEvent(recorder, backupScheduleSkippedEvent)
+		Expect(status.Sources[0].Message).To(Equal(fmt.Sprintf(vmNotRunningMsg, vmName)))
+		Expect(status.Sources[0].LastScheduleTime.Time).To(Equal(time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC)))
+	})
+
+	It("should wait for the backup in progress of the VM", func() {
+		schedule := newSchedule()
+		controller.backupTrackerInformer.GetStore().Add(newTracker(trackerName, vmName, schedule))
+		controller.vmiStore.Add(newVMI(vmName, "node01"))
+		controller.backupInformer.GetStore().Add(newScheduledBackup("previous", trackerName, 0, nil))
+
+		status, retry, err := controller.syncSchedule(schedule, cron, created.Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeTrue())
+		Expect(listBackups()).To(BeEmpty())
+		Expect(status.Sources[0].Message).To(Equal(fmt.Sprintf(backupScheduleInProgressMsg, "previous")))
+		Expect(status.Sources[0].LastScheduleTime).To(BeNil())
+	})
+
+	It("should report the consecutive failures of the VM backups", func() {
+		schedule := newSchedule()
+		controller.backupTrackerInformer.GetStore().Add(newTracker(trackerName, vmName, schedule))
+		successTime := created.Add(-3 * time.Hour)
+		controller.backupInformer.GetStore().Add(newScheduledBackup("backup-1", trackerName, 4*time.Hour, failed("old failure")))
+		controller.backupInformer.GetStore().Add(newScheduledBackup("backup-2", trackerName, 3*time.Hour, succeeded(successTime)))
+		controller.backupInformer.GetStore().Add(newScheduledBackup("backup-3", trackerName, 2*time.Hour, failed("first failure")))
+		controller.backupInformer.GetStore().Add(newScheduledBackup("backup-4", trackerName, time.Hour, failed("last failure")))
+
+		status, _, err := controller.syncSchedule(schedule, cron, created)
+		Expect(err).ToNot(HaveOccurred())
+		source := status.Sources[0]
+		Expect(source.LastBackupName).To(Equal("backup-4"))
+		Expect(source.ConsecutiveFailures).To(Equal(int32(2)))
+		Expect(source.LastFailure).To(Equal(fmt.Sprintf(backupFailed, "last failure")))
+		Expect(source.LastSuccessfulTime.Time).To(Equal(successTime))
+	})
+
+	It("should not count a backup with a false Failure condition as failed", func() {
+		schedule := newSchedule()
+		controller.backupTrackerInformer.GetStore().Add(newTracker(trackerName, vmName, schedule))
+		successTime := created.Add(-time.Hour)
+		conditions := append(succeeded(successTime), newFailureCondition(corev1.ConditionFalse, backupCompleted))
+		controller.backupInformer.GetStore().Add(newScheduledBackup("backup-1", trackerName, 2*time.Hour, failed("failure")))
+		controller.backupInformer.GetStore().Add(newScheduledBackup("backup-2", trackerName, time.Hour, conditions))
+
+		status, _, err := controller.syncSchedule(schedule, cron, created)
+		Expect(err).ToNot(HaveOccurred())
+		source := status.Sources[0]
+		Expect(source.ConsecutiveFailures).To(BeZero())
+		Expect(source.LastFailure).To(BeEmpty())
+		Expect(source.LastSuccessfulTime.Time).To(Equal(successTime))
+	})
+
+	It("should sync the retention policy of an owned tracker", func() {
+		schedule := newSchedule()
+		tracker := newTracker(trackerName, vmName, schedule)
+		tracker.Spec.RetentionPolicy = &backupv1.BackupRetentionPolicy{
+			MaxChainLength: pointer.P(int32(3)),
+		}
+		controller.backupTrackerInformer.GetStore().Add(tracker)
+		_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(testNamespace).Create(context.Background(), tracker, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, _, err = controller.syncSchedule(schedule, cron, created)
+		Expect(err).ToNot(HaveOccurred())
+
+		tracker, err = kubevirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(testNamespace).Get(context.Background(), trackerName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tracker.Spec.RetentionPolicy).To(Equal(schedule.Spec.RetentionPolicy))
+	})
+
+	It("should back up the VMs matching the selector", func() {
+		schedule := newSchedule()
+		schedule.Spec.Source = nil
+		schedule.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"backup": "nightly"}}
+		for _, vm := range []*v1.VirtualMachine{
+			{ObjectMeta: metav1.ObjectMeta{Name: "vm-b", Namespace: testNamespace, Labels: map[string]string{"backup": "nightly"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "vm-a", Namespace: testNamespace, Labels: map[string]string{"backup": "nightly"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "vm-c", Namespace: testNamespace}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "vm-d", Namespace: "other", Labels: map[string]string{"backup": "nightly"}}},
+		} {
+			controller.vmStore.Add(vm)
+			controller.vmiStore.Add(newVMI(vm.Name, "node01"))
+		}
+
+		status, _, err := controller.syncSchedule(schedule, cron, created.Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status.Sources).To(HaveLen(2))
+		Expect(status.Sources[0].VMName).To(Equal("vm-a"))
+		Expect(status.Sources[1].VMName).To(Equal("vm-b"))
+		Expect(listBackups()).To(HaveLen(2))
+	})
+
+	It("should store the backups of the source VM in the template PVC", func() {
+		schedule := newSchedule()
+		schedule.Spec.Template = backupv1.VirtualMachineBackupScheduleTemplate{PvcName: pointer.P("backups")}
+		controller.vmiStore.Add(newVMI(vmName, "node01"))
+
+		_, _, err := controller.syncSchedule(schedule, cron, created.Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		backups := listBackups()
+		Expect(backups).To(HaveLen(1))
+		Expect(backups[0].Spec.PvcName).To(Equal(pointer.P("backups")))
+	})
+
+	It("should not take over a tracker it does not own", func() {
+		controller.backupTrackerInformer.GetStore().Add(newTracker(trackerName, vmName, nil))
+		controller.vmiStore.Add(newVMI(vmName, "node01"))
+
+		status, _, err := controller.syncSchedule(newSchedule(), cron, created.Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listBackups()).To(BeEmpty())
+		Expect(status.Sources[0].Message).To(Equal(fmt.Sprintf(backupScheduleTrackerNotOwned, trackerName)))
+	})
+
+	Context("with a concurrency policy", func() {
+		const otherVM = "other-vm"
+
+		BeforeEach(func() {
+			otherTracker := scheduleTrackerName("other", otherVM)
+			controller.backupTrackerInformer.GetStore().Add(newTracker(otherTracker, otherVM, nil))
+			controller.backupInformer.GetStore().Add(newScheduledBackup("other-backup", otherTracker, 0, nil))
+			controller.pvcStore.Add(newPVC("disk-a", "fast"))
+			controller.pvcStore.Add(newPVC("disk-b", "fast"))
+			controller.pvcStore.Add(newPVC("disk-c", "slow"))
+			controller.vmiStore.Add(newVMI(otherVM, "node01", "disk-a"))
+		})
+
+		DescribeTable("should hold back the backup when a limit is reached", func(policy *backupv1.BackupConcurrencyPolicy, vmi *v1.VirtualMachineInstance, expectedMessage string) {
+			schedule := newSchedule()
+			schedule.Spec.Concurrency = policy
+			controller.vmiStore.Add(vmi)
+
+			status, retry, err := controller.syncSchedule(schedule, cron, created.Add(time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Sources[0].Message).To(Equal(expectedMessage))
+			if expectedMessage != "" {
+				Expect(retry).To(BeTrue())
+				Expect(listBackups()).To(BeEmpty())
+			} else {
+				Expect(listBackups()).To(HaveLen(1))
+			}
+		},
+			Entry("per node",
+				&backupv1.BackupConcurrencyPolicy{MaxPerNode: pointer.P(int32(1))},
+				newVMI(vmName, "node01", "disk-c"),
+				fmt.Sprintf(backupScheduleNodeLimitMsg, "node01", 1),
+			),
+			Entry("per storage class",
+				&backupv1.BackupConcurrencyPolicy{MaxPerStorageClass: pointer.P(int32(1))},
+				newVMI(vmName, "node02", "disk-b"),
+				fmt.Sprintf(backupScheduleStorageLimitMsg, "fast", 1),
+			),
+			Entry("unless there are free slots",
+				&backupv1.BackupConcurrencyPolicy{MaxPerNode: pointer.P(int32(2)), MaxPerStorageClass: pointer.P(int32(1))},
+				newVMI(vmName, "node01", "disk-c"),
+				"",
+			),
+		)
+	})
+
+	It("should update the schedule status", func() {
+		schedule := newSchedule()
+		schedule.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupSchedules(testNamespace).Create(context.Background(), schedule, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		controller.backupScheduleInformer.GetStore().Add(schedule)
+		controller.vmiStore.Add(newVMI(vmName, "node01"))
+
+		Expect(controller.executeSchedule(cacheKeyFunc(testNamespace, scheduleName))).To(Succeed())
+
+		updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupSchedules(testNamespace).Get(context.Background(), scheduleName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.Status).ToNot(BeNil())
+		Expect(updated.Status.LastScheduleTime).ToNot(BeNil())
+		Expect(updated.Status.NextScheduleTime.After(time.Now())).To(BeTrue())
+		Expect(updated.Status.Sources).To(HaveLen(1))
+		Expect(listBackups()).To(HaveLen(1))
+	})
+})
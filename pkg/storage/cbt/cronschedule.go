@@ -0,0 +1,225 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchLimit bounds the search for the next activation of a schedule,
+// a schedule that never fires within it (e.g. "0 0 31 2 *") has no next run
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteField     = cronField{name: "minute", min: 0, max: 59}
+	hourField       = cronField{name: "hour", min: 0, max: 23}
+	dayOfMonthField = cronField{name: "day of month", min: 1, max: 31}
+	monthField      = cronField{name: "month", min: 1, max: 12}
+	dayOfWeekField  = cronField{name: "day of week", min: 0, max: 7}
+)
+
+// CronSchedule is a parsed standard 5-field cron expression,
+// evaluated in UTC
+type CronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// when either day field is restricted and the other is not, only the
+	// restricted one is considered, otherwise a day matches any of them
+	dayOfMonthAny, dayOfWeekAny bool
+}
+
+// ParseCronSchedule parses a cron expression made of the minute, hour,
+// day of month, month and day of week fields, or one of the @yearly,
+// @monthly, @weekly, @daily and @hourly macros
+func ParseCronSchedule(spec string) (*CronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if macro, ok := cronMacros[spec]; ok {
+		spec = macro
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in cron schedule %q, found %d", spec, len(fields))
+	}
+
+	schedule := &CronSchedule{
+		dayOfMonthAny: fields[2] == "*" || fields[2] == "?",
+		dayOfWeekAny:  fields[4] == "*" || fields[4] == "?",
+	}
+	var err error
+	if schedule.minute, err = parseCronField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if schedule.hour, err = parseCronField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfMonth, err = parseCronField(fields[2], dayOfMonthField); err != nil {
+		return nil, err
+	}
+	if schedule.month, err = parseCronField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfWeek, err = parseCronField(fields[4], dayOfWeekField); err != nil {
+		return nil, err
+	}
+	// Sunday can be written as both 0 and 7
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	return schedule, nil
+}
+
+func parseCronField(expr string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(expr, ",") {
+		itemBits, err := parseCronItem(item, field)
+		if err != nil {
+			return 0, err
+		}
+		bits |= itemBits
+	}
+	return bits, nil
+}
+
+// parseCronItem parses a single element of a cron field list, which is
+// either "*", a value or a range, optionally followed by a "/step"
+func parseCronItem(item string, field cronField) (uint64, error) {
+	rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepExpr)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step %q in %s field", stepExpr, field.name)
+		}
+	}
+
+	start, end := field.min, field.max
+	switch {
+	case rangeExpr == "*" || rangeExpr == "?":
+	case strings.Contains(rangeExpr, "-"):
+		low, high, _ := strings.Cut(rangeExpr, "-")
+		var err error
+		if start, err = parseCronValue(low, field); err != nil {
+			return 0, err
+		}
+		if end, err = parseCronValue(high, field); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q in %s field", rangeExpr, field.name)
+		}
+	default:
+		value, err := parseCronValue(rangeExpr, field)
+		if err != nil {
+			return 0, err
+		}
+		start = value
+		if !hasStep {
+			end = value
+		}
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << uint(i)
+	}
+	return bits, nil
+}
+
+func parseCronValue(expr string, field cronField) (int, error) {
+	value, err := strconv.Atoi(expr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", expr, field.name)
+	}
+	if value < field.min || value > field.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d] in %s field", value, field.min, field.max, field.name)
+	}
+	return value, nil
+}
+
+// Next returns the first activation of the schedule strictly after t, or the
+// zero time if the schedule does not fire in the next few years
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// Latest returns the last activation of the schedule after `after` and not
+// later than `now`, or the zero time if the schedule did not fire in between
+func (s *CronSchedule) Latest(after, now time.Time) time.Time {
+	var latest time.Time
+	for t := s.Next(after); !t.IsZero() && !t.After(now); t = s.Next(t) {
+		latest = t
+	}
+	return latest
+}
+
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.dayOfMonthAny && s.dayOfWeekAny:
+		return true
+	case s.dayOfMonthAny:
+		return dowMatch
+	case s.dayOfWeekAny:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
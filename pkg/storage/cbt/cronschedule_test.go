@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron schedule", func() {
+	date := func(value string) time.Time {
+		t, err := time.Parse(time.RFC3339, value)
+		Expect(err).ToNot(HaveOccurred())
+		return t
+	}
+
+	DescribeTable("should reject invalid schedules", func(spec string) {
+		_, err := ParseCronSchedule(spec)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("with an empty schedule", ""),
+		Entry("with too many fields", "0 0 * * * *"),
+		Entry("with an out of range minute", "60 * * * *"),
+		Entry("with an out of range month", "0 0 1 13 *"),
+		Entry("with a reversed range", "0 5-2 * * *"),
+		Entry("with an invalid step", "*/0 * * * *"),
+		Entry("with a non numeric value", "0 noon * * *"),
+	)
+
+	DescribeTable("Next should return the following activation", func(spec, from, expected string) {
+		schedule, err := ParseCronSchedule(spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(date(from))).To(Equal(date(expected)))
+	},
+		Entry("every minute", "* * * * *", "2026-01-01T10:00:30Z", "2026-01-01T10:01:00Z"),
+		Entry("hourly macro", "@hourly", "2026-01-01T10:00:00Z", "2026-01-01T11:00:00Z"),
+		Entry("daily macro across a month", "@daily", "2026-01-31T01:00:00Z", "2026-02-01T00:00:00Z"),
+		Entry("with steps", "*/15 * * * *", "2026-01-01T10:16:00Z", "2026-01-01T10:30:00Z"),
+		Entry("with a list of hours", "30 2,14 * * *", "2026-01-01T03:00:00Z", "2026-01-01T14:30:00Z"),
+		Entry("on weekdays", "0 1 * * 1-5", "2026-01-02T02:00:00Z", "2026-01-05T01:00:00Z"),
+		Entry("with Sunday as 7", "0 0 * * 7", "2026-01-01T00:00:00Z", "2026-01-04T00:00:00Z"),
+		Entry("with day of month or day of week", "0 0 15 * 1", "2026-01-06T00:00:00Z", "2026-01-12T00:00:00Z"),
+		Entry("on a leap day", "0 0 29 2 *", "2026-01-01T00:00:00Z", "2028-02-29T00:00:00Z"),
+		Entry("yearly macro", "@yearly", "2026-06-01T00:00:00Z", "2027-01-01T00:00:00Z"),
+	)
+
+	It("Next should return the zero time when the schedule never fires", func() {
+		schedule, err := ParseCronSchedule("0 0 31 2 *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(date("2026-01-01T00:00:00Z")).IsZero()).To(BeTrue())
+	})
+
+	DescribeTable("Latest should return the last activation in the interval", func(after, now, expected string) {
+		schedule, err := ParseCronSchedule("@hourly")
+		Expect(err).ToNot(HaveOccurred())
+		latest := schedule.Latest(date(after), date(now))
+		if expected == "" {
+			Expect(latest.IsZero()).To(BeTrue())
+		} else {
+			Expect(latest).To(Equal(date(expected)))
+		}
+	},
+		Entry("when no activation was missed", "2026-01-01T10:00:00Z", "2026-01-01T10:59:59Z", ""),
+		Entry("when one activation is due", "2026-01-01T10:00:00Z", "2026-01-01T11:00:00Z", "2026-01-01T11:00:00Z"),
+		Entry("when several activations were missed", "2026-01-01T10:00:00Z", "2026-01-01T13:20:00Z", "2026-01-01T13:00:00Z"),
+	)
+})
@@ -0,0 +1,172 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	backupExportBitmapPrefix = "backup"
+	backupExportSubresource  = "backup"
+
+	backupExportExpiredMsg = "Backup export of %s has expired"
+)
+
+const (
+	// BackupExportMapSubresource serves the extents of a range of a pull
+	// mode backup export, under the reported export endpoint
+	BackupExportMapSubresource = backupExportSubresource + "/map"
+	// BackupExportReadSubresource serves the data of a range of a pull
+	// mode backup export, under the reported export endpoint
+	BackupExportReadSubresource = backupExportSubresource + "/read"
+)
+
+const (
+	BackupExportNameParam   = "exportName"
+	BackupExportBitmapParam = "bitmapName"
+	BackupExportOffsetParam = "offset"
+	BackupExportLengthParam = "length"
+
+	// MaxBackupExportReadLength is the largest range that can be read from
+	// a pull mode backup export in a single request
+	MaxBackupExportReadLength = 64 * 1024 * 1024
+)
+
+// BackupExportRequest describes a range of a pull mode backup export
+type BackupExportRequest struct {
+	ExportName string
+	BitmapName string
+	Offset     uint64
+	Length     uint64
+}
+
+// BackupNBDSocketPath is the unix socket on which libvirt serves the NBD
+// export of a pull mode backup inside virt-launcher
+var BackupNBDSocketPath = filepath.Join(util.VirtPrivateDir, "backup", "nbd.sock")
+
+// BackupExportName returns the NBD export name of a volume in a pull mode backup
+func BackupExportName(volumeName string) string {
+	return volumeName
+}
+
+// BackupExportBitmapName returns the name of the dirty bitmap exported with
+// a volume in an incremental pull mode backup
+func BackupExportBitmapName(volumeName string) string {
+	return fmt.Sprintf("%s-%s", backupExportBitmapPrefix, volumeName)
+}
+
+func isPullMode(backup *backupv1.VirtualMachineBackup) bool {
+	return backup.Spec.Mode != nil && *backup.Spec.Mode == backupv1.PullMode
+}
+
+// BackupExportEndpoint returns the path, relative to the API server, under
+// which the map and read requests of a pull mode backup export are served
+func BackupExportEndpoint(namespace, vmiName string) string {
+	return fmt.Sprintf("/apis/%s/%s/namespaces/%s/virtualmachineinstances/%s/%s",
+		v1.SubresourceGroupName, v1.ApiLatestVersion, namespace, vmiName, backupExportSubresource)
+}
+
+func newBackupExport(backup *backupv1.VirtualMachineBackup, vmi *v1.VirtualMachineInstance, volumes []v1.BackupVolumeInfo) *backupv1.BackupExport {
+	export := &backupv1.BackupExport{
+		Endpoint: BackupExportEndpoint(vmi.Namespace, vmi.Name),
+	}
+	if backup.Spec.TTLDuration != nil {
+		export.ExpirationTime = pointer.P(metav1.NewTime(time.Now().Add(backup.Spec.TTLDuration.Duration)))
+	}
+
+	incremental := backup.Status != nil && backup.Status.Type == backupv1.Incremental
+	for _, volume := range volumes {
+		exportVolume := backupv1.BackupExportVolume{
+			VolumeName: volume.VolumeName,
+			ExportName: BackupExportName(volume.VolumeName),
+		}
+		if incremental {
+			exportVolume.DirtyBitmap = pointer.P(BackupExportBitmapName(volume.VolumeName))
+		}
+		export.Volumes = append(export.Volumes, exportVolume)
+	}
+	return export
+}
+
+// backupExportTimeLeft returns how long the export of a pull mode backup
+// remains available, or nil if it does not expire
+func backupExportTimeLeft(backup *backupv1.VirtualMachineBackup) *time.Duration {
+	if backup.Status == nil || backup.Status.Export == nil || backup.Status.Export.ExpirationTime == nil {
+		return nil
+	}
+	return pointer.P(time.Until(backup.Status.Export.ExpirationTime.Time))
+}
+
+func isBackupExportExpired(backup *backupv1.VirtualMachineBackup) bool {
+	timeLeft := backupExportTimeLeft(backup)
+	return timeLeft != nil && *timeLeft <= 0
+}
+
+// ParseBackupExportRequest parses the query parameters of a map or read
+// request against a pull mode backup export
+func ParseBackupExportRequest(query url.Values, maxLength uint64) (*BackupExportRequest, error) {
+	req := &BackupExportRequest{
+		ExportName: query.Get(BackupExportNameParam),
+		BitmapName: query.Get(BackupExportBitmapParam),
+	}
+	if req.ExportName == "" {
+		return nil, fmt.Errorf("%s parameter is required", BackupExportNameParam)
+	}
+
+	var err error
+	if req.Offset, err = parseUintParam(query, BackupExportOffsetParam); err != nil {
+		return nil, err
+	}
+	if req.Length, err = parseUintParam(query, BackupExportLengthParam); err != nil {
+		return nil, err
+	}
+	if req.Length == 0 {
+		return nil, fmt.Errorf("%s parameter must be greater than 0", BackupExportLengthParam)
+	}
+	if maxLength > 0 && req.Length > maxLength {
+		return nil, fmt.Errorf("%s parameter must not exceed %d", BackupExportLengthParam, maxLength)
+	}
+	return req, nil
+}
+
+func parseUintParam(query url.Values, name string) (uint64, error) {
+	value := query.Get(name)
+	if value == "" {
+		return 0, fmt.Errorf("%s parameter is required", name)
+	}
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s parameter %q: %w", name, value, err)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pull mode backup export", func() {
+	Context("ParseBackupExportRequest", func() {
+		It("should parse a valid request", func() {
+			query := url.Values{
+				BackupExportNameParam:   []string{"disk0"},
+				BackupExportBitmapParam: []string{"backup-disk0"},
+				BackupExportOffsetParam: []string{"4096"},
+				BackupExportLengthParam: []string{"65536"},
+			}
+
+			req, err := ParseBackupExportRequest(query, MaxBackupExportReadLength)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(req).To(Equal(&BackupExportRequest{
+				ExportName: "disk0",
+				BitmapName: "backup-disk0",
+				Offset:     4096,
+				Length:     65536,
+			}))
+		})
+
+		DescribeTable("should reject an invalid request", func(query url.Values, expectedErr string) {
+			_, err := ParseBackupExportRequest(query, MaxBackupExportReadLength)
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("without an export name",
+				url.Values{BackupExportOffsetParam: []string{"0"}, BackupExportLengthParam: []string{"1"}},
+				"exportName parameter is required"),
+			Entry("without an offset",
+				url.Values{BackupExportNameParam: []string{"disk0"}, BackupExportLengthParam: []string{"1"}},
+				"offset parameter is required"),
+			Entry("with an invalid offset",
+				url.Values{BackupExportNameParam: []string{"disk0"}, BackupExportOffsetParam: []string{"-1"}, BackupExportLengthParam: []string{"1"}},
+				"invalid offset parameter"),
+			Entry("with a zero length",
+				url.Values{BackupExportNameParam: []string{"disk0"}, BackupExportOffsetParam: []string{"0"}, BackupExportLengthParam: []string{"0"}},
+				"length parameter must be greater than 0"),
+			Entry("with a length above the maximum",
+				url.Values{BackupExportNameParam: []string{"disk0"}, BackupExportOffsetParam: []string{"0"}, BackupExportLengthParam: []string{"67108865"}},
+				"length parameter must not exceed"),
+		)
+	})
+})
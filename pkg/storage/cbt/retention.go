@@ -0,0 +1,176 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	retentionFullBackupRequiredMsg  = "Retention policy of BackupTracker %s requires a full backup: %s"
+	noFullBackupInChainReason       = "no full backup is left in the checkpoint chain"
+	maxIncrementalsReachedReason    = "%d incremental backups were taken since the last full backup"
+	fullBackupIntervalElapsedReason = "the last full backup is older than %s"
+)
+
+// checkpointHistory returns the checkpoints tracked by the backup tracker,
+// ordered from the oldest to the latest. Trackers that only recorded their
+// latest checkpoint are treated as having a chain of that single checkpoint.
+func checkpointHistory(tracker *backupv1.VirtualMachineBackupTracker) []backupv1.BackupCheckpoint {
+	if tracker == nil || tracker.Status == nil {
+		return nil
+	}
+	if len(tracker.Status.Checkpoints) > 0 {
+		return tracker.Status.Checkpoints
+	}
+	if tracker.Status.LatestCheckpoint != nil && tracker.Status.LatestCheckpoint.Name != "" {
+		return []backupv1.BackupCheckpoint{*tracker.Status.LatestCheckpoint}
+	}
+	return nil
+}
+
+// appendCheckpoint adds the checkpoint at the end of the history, unless it
+// was already recorded by a previous reconciliation
+func appendCheckpoint(history []backupv1.BackupCheckpoint, checkpoint backupv1.BackupCheckpoint) []backupv1.BackupCheckpoint {
+	for _, c := range history {
+		if c.Name == checkpoint.Name {
+			return history
+		}
+	}
+	newHistory := make([]backupv1.BackupCheckpoint, 0, len(history)+1)
+	newHistory = append(newHistory, history...)
+	return append(newHistory, checkpoint)
+}
+
+// fullBackupRequiredReason returns why the retention policy of the tracker
+// forces the next backup to be a full one, or an empty string if it does not
+func fullBackupRequiredReason(tracker *backupv1.VirtualMachineBackupTracker, now time.Time) string {
+	if tracker == nil || tracker.Spec.RetentionPolicy == nil {
+		return ""
+	}
+	policy := tracker.Spec.RetentionPolicy
+	history := checkpointHistory(tracker)
+
+	lastFull := -1
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Type == backupv1.Full {
+			lastFull = i
+			break
+		}
+	}
+	if lastFull < 0 {
+		return noFullBackupInChainReason
+	}
+
+	incrementals := len(history) - 1 - lastFull
+	if policy.MaxIncrementals != nil && incrementals >= int(*policy.MaxIncrementals) {
+		return fmt.Sprintf(maxIncrementalsReachedReason, incrementals)
+	}
+
+	creationTime := history[lastFull].CreationTime
+	if policy.FullBackupInterval != nil && creationTime != nil &&
+		now.Sub(creationTime.Time) >= policy.FullBackupInterval.Duration {
+		return fmt.Sprintf(fullBackupIntervalElapsedReason, policy.FullBackupInterval.Duration)
+	}
+
+	return ""
+}
+
+// pruneCheckpoints splits the checkpoint history into the checkpoints kept and
+// the checkpoints pruned by the retention policy. Checkpoints are pruned from
+// the oldest one, whole chains at a time, so the incrementals that are kept
+// can always be restored from their full checkpoint. The chain of the latest
+// checkpoint is always kept.
+func pruneCheckpoints(policy *backupv1.BackupRetentionPolicy, history []backupv1.BackupCheckpoint, now time.Time) (kept, pruned []backupv1.BackupCheckpoint) {
+	if policy == nil || len(history) == 0 {
+		return history, nil
+	}
+
+	start := 0
+	if policy.MaxChainLength != nil && len(history) > int(*policy.MaxChainLength) {
+		start = len(history) - int(*policy.MaxChainLength)
+	}
+	if policy.MaxAge != nil {
+		for start < len(history)-1 && isCheckpointExpired(history[start], policy.MaxAge.Duration, now) {
+			start++
+		}
+	}
+	start = chainStart(history, start)
+	return history[start:], history[:start]
+}
+
+// chainStart moves the first kept checkpoint to the start of a chain. It
+// advances to the next full checkpoint, and when there is none it goes back
+// to the full checkpoint the incrementals after it depend on.
+func chainStart(history []backupv1.BackupCheckpoint, start int) int {
+	if start == 0 || history[start].Type == backupv1.Full {
+		return start
+	}
+	for i := start + 1; i < len(history); i++ {
+		if history[i].Type == backupv1.Full {
+			return i
+		}
+	}
+	for i := start - 1; i > 0; i-- {
+		if history[i].Type == backupv1.Full {
+			return i
+		}
+	}
+	return 0
+}
+
+func isCheckpointExpired(checkpoint backupv1.BackupCheckpoint, maxAge time.Duration, now time.Time) bool {
+	return checkpoint.CreationTime != nil && now.Sub(checkpoint.CreationTime.Time) > maxAge
+}
+
+// deletePrunedCheckpoints removes the pruned checkpoints from the running VMI
+func (ctrl *VMBackupController) deletePrunedCheckpoints(namespace, vmiName string, pruned []backupv1.BackupCheckpoint) error {
+	for i := range pruned {
+		err := ctrl.client.VirtualMachineInstance(namespace).DeleteCheckpoint(context.Background(), vmiName, &pruned[i])
+		if err != nil {
+			return fmt.Errorf("failed to delete checkpoint %s: %w", pruned[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// deletePrunedBackups garbage collects the VirtualMachineBackups which created
+// the pruned checkpoints
+func (ctrl *VMBackupController) deletePrunedBackups(namespace string, pruned []backupv1.BackupCheckpoint) error {
+	for _, checkpoint := range pruned {
+		if checkpoint.BackupName == "" {
+			continue
+		}
+		err := ctrl.client.VirtualMachineBackup(namespace).Delete(context.Background(), checkpoint.BackupName, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete backup %s: %w", checkpoint.BackupName, err)
+		}
+		log.Log.Infof("Deleted backup %s/%s of pruned checkpoint %s", namespace, checkpoint.BackupName, checkpoint.Name)
+	}
+	return nil
+}
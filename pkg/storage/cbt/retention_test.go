@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Backup retention policy", func() {
+	now := time.Now()
+
+	checkpoint := func(name string, backupType backupv1.BackupType, age time.Duration) backupv1.BackupCheckpoint {
+		return backupv1.BackupCheckpoint{
+			Name:         name,
+			Type:         backupType,
+			CreationTime: pointer.P(metav1.NewTime(now.Add(-age))),
+		}
+	}
+
+	names := func(checkpoints []backupv1.BackupCheckpoint) []string {
+		var result []string
+		for _, c := range checkpoints {
+			result = append(result, c.Name)
+		}
+		return result
+	}
+
+	trackerWith := func(policy *backupv1.BackupRetentionPolicy, history ...backupv1.BackupCheckpoint) *backupv1.VirtualMachineBackupTracker {
+		tracker := &backupv1.VirtualMachineBackupTracker{
+			Spec:   backupv1.VirtualMachineBackupTrackerSpec{RetentionPolicy: policy},
+			Status: &backupv1.VirtualMachineBackupTrackerStatus{Checkpoints: history},
+		}
+		if len(history) > 0 {
+			tracker.Status.LatestCheckpoint = &history[len(history)-1]
+		}
+		return tracker
+	}
+
+	Context("checkpointHistory", func() {
+		It("should fall back to the latest checkpoint when no history is recorded", func() {
+			tracker := trackerWith(nil)
+			tracker.Status.LatestCheckpoint = pointer.P(checkpoint("cp1", "", 0))
+			Expect(names(checkpointHistory(tracker))).To(Equal([]string{"cp1"}))
+		})
+
+		It("should not append a checkpoint twice", func() {
+			history := []backupv1.BackupCheckpoint{checkpoint("cp1", backupv1.Full, 0)}
+			history = appendCheckpoint(history, checkpoint("cp2", backupv1.Incremental, 0))
+			history = appendCheckpoint(history, checkpoint("cp2", backupv1.Incremental, 0))
+			Expect(names(history)).To(Equal([]string{"cp1", "cp2"}))
+		})
+	})
+
+	DescribeTable("fullBackupRequiredReason", func(tracker *backupv1.VirtualMachineBackupTracker, expectedReason string) {
+		Expect(fullBackupRequiredReason(tracker, now)).To(Equal(expectedReason))
+	},
+		Entry("without a retention policy",
+			trackerWith(nil, checkpoint("cp1", backupv1.Incremental, 0)),
+			""),
+		Entry("when no full backup is left in the chain",
+			trackerWith(&backupv1.BackupRetentionPolicy{}, checkpoint("cp1", backupv1.Incremental, 0)),
+			noFullBackupInChainReason),
+		Entry("when the maximum of incrementals is not reached",
+			trackerWith(&backupv1.BackupRetentionPolicy{MaxIncrementals: pointer.P(int32(2))},
+				checkpoint("cp1", backupv1.Full, 0), checkpoint("cp2", backupv1.Incremental, 0)),
+			""),
+		Entry("when the maximum of incrementals is reached",
+			trackerWith(&backupv1.BackupRetentionPolicy{MaxIncrementals: pointer.P(int32(1))},
+				checkpoint("cp1", backupv1.Full, 0), checkpoint("cp2", backupv1.Incremental, 0)),
+			"1 incremental backups were taken since the last full backup"),
+		Entry("when the full backup interval has not elapsed",
+			trackerWith(&backupv1.BackupRetentionPolicy{FullBackupInterval: &metav1.Duration{Duration: 24 * time.Hour}},
+				checkpoint("cp1", backupv1.Full, time.Hour)),
+			""),
+		Entry("when the full backup interval has elapsed",
+			trackerWith(&backupv1.BackupRetentionPolicy{FullBackupInterval: &metav1.Duration{Duration: 24 * time.Hour}},
+				checkpoint("cp1", backupv1.Full, 25*time.Hour), checkpoint("cp2", backupv1.Incremental, time.Hour)),
+			"the last full backup is older than 24h0m0s"),
+	)
+
+	DescribeTable("pruneCheckpoints", func(policy *backupv1.BackupRetentionPolicy, expectedKept, expectedPruned []string) {
+		history := []backupv1.BackupCheckpoint{
+			checkpoint("cp1", backupv1.Full, 120*time.Hour),
+			checkpoint("cp2", backupv1.Incremental, 96*time.Hour),
+			checkpoint("cp3", backupv1.Full, 72*time.Hour),
+			checkpoint("cp4", backupv1.Incremental, 48*time.Hour),
+			checkpoint("cp5", backupv1.Incremental, 24*time.Hour),
+			checkpoint("cp6", backupv1.Incremental, 0),
+		}
+		kept, pruned := pruneCheckpoints(policy, history, now)
+		Expect(names(kept)).To(Equal(expectedKept))
+		Expect(names(pruned)).To(Equal(expectedPruned))
+	},
+		Entry("without a retention policy",
+			nil, []string{"cp1", "cp2", "cp3", "cp4", "cp5", "cp6"}, nil),
+		Entry("with a chain length that is not exceeded",
+			&backupv1.BackupRetentionPolicy{MaxChainLength: pointer.P(int32(6))},
+			[]string{"cp1", "cp2", "cp3", "cp4", "cp5", "cp6"}, nil),
+		Entry("with a chain length that is exceeded at a full checkpoint",
+			&backupv1.BackupRetentionPolicy{MaxChainLength: pointer.P(int32(4))},
+			[]string{"cp3", "cp4", "cp5", "cp6"}, []string{"cp1", "cp2"}),
+		Entry("with a chain length that is exceeded within a chain followed by a full checkpoint",
+			&backupv1.BackupRetentionPolicy{MaxChainLength: pointer.P(int32(5))},
+			[]string{"cp3", "cp4", "cp5", "cp6"}, []string{"cp1", "cp2"}),
+		Entry("with a chain length that is exceeded within the latest chain",
+			&backupv1.BackupRetentionPolicy{MaxChainLength: pointer.P(int32(2))},
+			[]string{"cp3", "cp4", "cp5", "cp6"}, []string{"cp1", "cp2"}),
+		Entry("with a maximum age",
+			&backupv1.BackupRetentionPolicy{MaxAge: &metav1.Duration{Duration: 84 * time.Hour}},
+			[]string{"cp3", "cp4", "cp5", "cp6"}, []string{"cp1", "cp2"}),
+		Entry("with a maximum age that expires a full checkpoint with kept incrementals",
+			&backupv1.BackupRetentionPolicy{MaxAge: &metav1.Duration{Duration: 36 * time.Hour}},
+			[]string{"cp3", "cp4", "cp5", "cp6"}, []string{"cp1", "cp2"}),
+		Entry("with a maximum age that expires every checkpoint but the latest",
+			&backupv1.BackupRetentionPolicy{MaxAge: &metav1.Duration{Duration: time.Minute}},
+			[]string{"cp3", "cp4", "cp5", "cp6"}, []string{"cp1", "cp2"}),
+		Entry("with both a chain length and a maximum age",
+			&backupv1.BackupRetentionPolicy{MaxChainLength: pointer.P(int32(6)), MaxAge: &metav1.Duration{Duration: 108 * time.Hour}},
+			[]string{"cp3", "cp4", "cp5", "cp6"}, []string{"cp1", "cp2"}),
+	)
+
+	It("should keep every checkpoint when the only full checkpoint is expired", func() {
+		history := []backupv1.BackupCheckpoint{
+			checkpoint("cp1", backupv1.Full, 72*time.Hour),
+			checkpoint("cp2", backupv1.Incremental, 48*time.Hour),
+			checkpoint("cp3", backupv1.Incremental, 0),
+		}
+		kept, pruned := pruneCheckpoints(&backupv1.BackupRetentionPolicy{MaxAge: &metav1.Duration{Duration: time.Hour}}, history, now)
+		Expect(names(kept)).To(Equal([]string{"cp1", "cp2", "cp3"}))
+		Expect(pruned).To(BeEmpty())
+	})
+
+	It("should prune whole chains when a newer chain follows", func() {
+		history := []backupv1.BackupCheckpoint{
+			checkpoint("cp1", backupv1.Full, 0),
+			checkpoint("cp2", backupv1.Incremental, 0),
+			checkpoint("cp3", backupv1.Full, 0),
+			checkpoint("cp4", backupv1.Incremental, 0),
+			checkpoint("cp5", backupv1.Full, 0),
+		}
+		kept, pruned := pruneCheckpoints(&backupv1.BackupRetentionPolicy{MaxChainLength: pointer.P(int32(2))}, history, now)
+		Expect(names(kept)).To(Equal([]string{"cp5"}))
+		Expect(names(pruned)).To(Equal([]string{"cp1", "cp2", "cp3", "cp4"}))
+	})
+})
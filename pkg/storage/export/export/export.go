@@ -130,6 +130,7 @@ const (
 	manifestData           = "manifest-data"
 	manifestsPath          = "/manifests/all"
 	secretManifestPath     = "/manifests/secret"
+	ovaManifestPath        = "/manifests/ova"
 	externalHostKey        = "external_host"
 	internalHostKey        = "internal_host"
 	externalCaConfigMapKey = "external_ca_cm"
@@ -165,6 +166,18 @@ func rawGzipURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.img.gz", urlBasePath, pvc.Name))
 }
 
+func qcow2URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2", urlBasePath, pvc.Name))
+}
+
+func qcow2ZstdURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.zstd.qcow2", urlBasePath, pvc.Name))
+}
+
+func sha256URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.img.sha256", urlBasePath, pvc.Name))
+}
+
 func archiveURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.tar.gz", urlBasePath, pvc.Name))
 }
@@ -281,6 +294,24 @@ func (sv *sourceVolumes) populateLink(
 				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawGzURI),
 			})
 		}
+		if volumeInfo.Qcow2URI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.Qcow2,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.Qcow2URI),
+			})
+		}
+		if volumeInfo.Qcow2ZstdURI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.Qcow2Zstd,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.Qcow2ZstdURI),
+			})
+		}
+		if volumeInfo.SHA256URI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.SHA256,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.SHA256URI),
+			})
+		}
 		if volumeInfo.DirURI != "" {
 			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.Dir,
@@ -321,6 +352,7 @@ type VMExportController struct {
 	PVCInformer                 cache.SharedIndexInformer
 	VMSnapshotInformer          cache.SharedIndexInformer
 	VMSnapshotContentInformer   cache.SharedIndexInformer
+	VMBackupInformer            cache.SharedIndexInformer
 	PodInformer                 cache.SharedIndexInformer
 	DataVolumeInformer          cache.SharedIndexInformer
 	ConfigMapInformer           cache.SharedIndexInformer
@@ -441,6 +473,16 @@ func (ctrl *VMExportController) Init() error {
 	if err != nil {
 		return err
 	}
+	_, err = ctrl.VMBackupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMBackup(newObj) },
+			DeleteFunc: ctrl.handleVMBackup,
+		},
+	)
+	if err != nil {
+		return err
+	}
 	_, err = ctrl.VMIInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handleVMI,
@@ -509,6 +551,7 @@ func (ctrl *VMExportController) Run(threadiness int, stopCh <-chan struct{}) err
 		ctrl.SecretInformer.HasSynced,
 		ctrl.VMSnapshotInformer.HasSynced,
 		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMBackupInformer.HasSynced,
 		ctrl.VMInformer.HasSynced,
 		ctrl.VMIInformer.HasSynced,
 		ctrl.CRDInformer.HasSynced,
@@ -673,6 +716,13 @@ func (ctrl *VMExportController) updateVMExport(vmExport *exportv1.VirtualMachine
 		}
 		return ctrl.handleSource(vmExport, NewVMSource(sourceVolumes))
 	}
+	if ctrl.isSourceVMBackup(&vmExport.Spec) {
+		source, err := ctrl.getVMBackupSource(vmExport)
+		if err != nil {
+			return 0, err
+		}
+		return ctrl.handleSource(vmExport, source)
+	}
 
 	return 0, nil
 }
@@ -1296,6 +1346,15 @@ func addVolumeEnvironmentVariables(exportContainer *corev1.Container, pvc *corev
 		}, corev1.EnvVar{
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 			Value: rawGzipURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+			Value: qcow2URI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_ZSTD_URI", index),
+			Value: qcow2ZstdURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_SHA256_URI", index),
+			Value: sha256URI(pvc),
 		})
 	} else {
 		if isKubevirt {
@@ -1305,6 +1364,15 @@ func addVolumeEnvironmentVariables(exportContainer *corev1.Container, pvc *corev
 			}, corev1.EnvVar{
 				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 				Value: rawGzipURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+				Value: qcow2URI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_ZSTD_URI", index),
+				Value: qcow2ZstdURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_SHA256_URI", index),
+				Value: sha256URI(pvc),
 			})
 		} else {
 			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
@@ -54,6 +54,7 @@ import (
 
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	framework "k8s.io/client-go/tools/cache/testing"
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	virtv1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -118,6 +119,7 @@ var _ = Describe("Export controller", func() {
 		dvInformer                  cache.SharedIndexInformer
 		vmSnapshotInformer          cache.SharedIndexInformer
 		vmSnapshotContentInformer   cache.SharedIndexInformer
+		vmBackupInformer            cache.SharedIndexInformer
 		secretInformer              cache.SharedIndexInformer
 		vmInformer                  cache.SharedIndexInformer
 		vmiInformer                 cache.SharedIndexInformer
@@ -151,6 +153,7 @@ var _ = Describe("Export controller", func() {
 		go secretInformer.Run(stop)
 		go vmSnapshotInformer.Run(stop)
 		go vmSnapshotContentInformer.Run(stop)
+		go vmBackupInformer.Run(stop)
 		go vmInformer.Run(stop)
 		go vmiInformer.Run(stop)
 		go crdInformer.Run(stop)
@@ -173,6 +176,7 @@ var _ = Describe("Export controller", func() {
 			secretInformer.HasSynced,
 			vmSnapshotInformer.HasSynced,
 			vmSnapshotContentInformer.HasSynced,
+			vmBackupInformer.HasSynced,
 			vmInformer.HasSynced,
 			vmiInformer.HasSynced,
 			crdInformer.HasSynced,
@@ -199,6 +203,7 @@ var _ = Describe("Export controller", func() {
 		dvInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmBackupInformer, _ = testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
 		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
 		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
 		routeInformer, _ := testutils.NewFakeInformerFor(&routev1.Route{})
@@ -247,6 +252,7 @@ var _ = Describe("Export controller", func() {
 			SecretInformer:              secretInformer,
 			VMSnapshotInformer:          vmSnapshotInformer,
 			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
 			VolumeSnapshotProvider:      fakeVolumeSnapshotProvider,
 			VMInformer:                  vmInformer,
 			VMIInformer:                 vmiInformer,
@@ -1570,48 +1576,55 @@ func verifyLinksInternal(vmExport *exportv1.VirtualMachineExport, expectedVolume
 	Expect(vmExport.Status.Links).ToNot(BeNil())
 	Expect(vmExport.Status.Links.Internal).NotTo(BeNil())
 	Expect(vmExport.Status.Links.Internal.Cert).NotTo(BeEmpty())
-	Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 2))
+	var formats []exportv1.VirtualMachineExportVolumeFormat
 	for _, volume := range vmExport.Status.Links.Internal.Volumes {
-		Expect(volume.Formats).To(HaveLen(2))
-		Expect(expectedVolumeFormats).To(ContainElements(volume.Formats))
+		formats = append(formats, volume.Formats...)
 	}
+	Expect(formats).To(ConsistOf(expectedVolumeFormats))
 }
 
-func verifyLinksExternal(vmExport *exportv1.VirtualMachineExport, link1Format exportv1.ExportVolumeFormat, link1Url string, link2Format exportv1.ExportVolumeFormat, link2Url string) {
+func verifyLinksExternal(vmExport *exportv1.VirtualMachineExport, expectedVolumeFormats ...exportv1.VirtualMachineExportVolumeFormat) {
 	Expect(vmExport.Status.Links.External).ToNot(BeNil())
 	Expect(vmExport.Status.Links.External.Cert).To(BeEmpty())
 	Expect(vmExport.Status.Links.External.Volumes).To(HaveLen(1))
-	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(HaveLen(2))
-	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(ContainElements(exportv1.VirtualMachineExportVolumeFormat{
-		Format: link1Format,
-		Url:    link1Url,
-	}, exportv1.VirtualMachineExportVolumeFormat{
-		Format: link2Format,
-		Url:    link2Url,
-	}))
+	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(ConsistOf(expectedVolumeFormats))
+}
+
+func kubevirtVolumeFormats(baseURL string) []exportv1.VirtualMachineExportVolumeFormat {
+	return []exportv1.VirtualMachineExportVolumeFormat{
+		{
+			Format: exportv1.KubeVirtRaw,
+			Url:    baseURL + "/disk.img",
+		},
+		{
+			Format: exportv1.KubeVirtGz,
+			Url:    baseURL + "/disk.img.gz",
+		},
+		{
+			Format: exportv1.Qcow2,
+			Url:    baseURL + "/disk.qcow2",
+		},
+		{
+			Format: exportv1.Qcow2Zstd,
+			Url:    baseURL + "/disk.zstd.qcow2",
+		},
+		{
+			Format: exportv1.SHA256,
+			Url:    baseURL + "/disk.img.sha256",
+		},
+	}
 }
 
 func verifyKubevirtInternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace string, volumeNames ...string) {
 	exportVolumeFormats := make([]exportv1.VirtualMachineExportVolumeFormat, 0)
 	for _, volumeName := range volumeNames {
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtRaw,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-		})
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtGz,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-		})
+		exportVolumeFormats = append(exportVolumeFormats, kubevirtVolumeFormats(fmt.Sprintf("https://%s.%s.svc/volumes/%s", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName))...)
 	}
 	verifyLinksInternal(vmExport, exportVolumeFormats...)
 }
 
 func verifyKubevirtExternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
-	verifyLinksExternal(vmExport,
-		exportv1.KubeVirtRaw,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.img", currentVersion, namespace, exportName, volumeName),
-		exportv1.KubeVirtGz,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.img.gz", currentVersion, namespace, exportName, volumeName))
+	verifyLinksExternal(vmExport, kubevirtVolumeFormats(fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s", currentVersion, namespace, exportName, volumeName))...)
 }
 
 func verifyArchiveInternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
@@ -1687,10 +1700,13 @@ func ingressToHost() *networkingv1.Ingress {
 
 func verifyArchiveExternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
 	verifyLinksExternal(vmExport,
-		exportv1.Dir,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/dir", currentVersion, namespace, exportName, volumeName),
-		exportv1.ArchiveGz,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.tar.gz", currentVersion, namespace, exportName, volumeName))
+		exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.Dir,
+			Url:    fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/dir", currentVersion, namespace, exportName, volumeName),
+		}, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.ArchiveGz,
+			Url:    fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.tar.gz", currentVersion, namespace, exportName, volumeName),
+		})
 }
 
 func createVMExportMeta(name string) metav1.ObjectMeta {
@@ -96,6 +96,12 @@ func (ctrl *VMExportController) getLinks(exporterPod *corev1.Pod, export *export
 			Url:  scheme + path.Join(hostAndBase, linkType, paths.SecretURI),
 		})
 	}
+	if paths.OVAURI != "" {
+		exportLink.Manifests = append(exportLink.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.OVA,
+			Url:  scheme + path.Join(hostAndBase, linkType, paths.OVAURI),
+		})
+	}
 
 	source.ConfigureExportLink(exportLink, paths, export, exporterPod, hostAndBase, scheme)
 
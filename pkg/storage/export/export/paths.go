@@ -29,17 +29,26 @@ import (
 
 // VolumeInfo contains paths for a volume
 type VolumeInfo struct {
-	Path       string
-	ArchiveURI string
-	DirURI     string
-	RawURI     string
-	RawGzURI   string
+	Path         string
+	ArchiveURI   string
+	DirURI       string
+	RawURI       string
+	RawGzURI     string
+	Qcow2URI     string
+	Qcow2ZstdURI string
+	SHA256URI    string
+	// ChangedExtentsURI and ChangedDataURI are only set for the volumes of a VirtualMachineBackup
+	ChangedExtentsURI string
+	ChangedDataURI    string
+	// ClaimName is only set for the volumes of a VirtualMachine
+	ClaimName string
 }
 
 // ServerPaths contains static paths and per-volume paths
 type ServerPaths struct {
 	VMURI     string
 	SecretURI string
+	OVAURI    string
 	Volumes   []VolumeInfo
 }
 
@@ -67,16 +76,23 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 	result := &ServerPaths{
 		VMURI:     env["EXPORT_VM_DEF_URI"],
 		SecretURI: env["EXPORT_SECRET_DEF_URI"],
+		OVAURI:    env["EXPORT_VM_OVA_URI"],
 	}
 	for k, v := range env {
 		if strings.HasSuffix(k, "_EXPORT_PATH") {
 			envPrefix := strings.TrimSuffix(k, "_EXPORT_PATH")
 			vi := VolumeInfo{
-				Path:       v,
-				ArchiveURI: env[envPrefix+"_EXPORT_ARCHIVE_URI"],
-				DirURI:     env[envPrefix+"_EXPORT_DIR_URI"],
-				RawURI:     env[envPrefix+"_EXPORT_RAW_URI"],
-				RawGzURI:   env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				Path:              v,
+				ArchiveURI:        env[envPrefix+"_EXPORT_ARCHIVE_URI"],
+				DirURI:            env[envPrefix+"_EXPORT_DIR_URI"],
+				RawURI:            env[envPrefix+"_EXPORT_RAW_URI"],
+				RawGzURI:          env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				Qcow2URI:          env[envPrefix+"_EXPORT_QCOW2_URI"],
+				Qcow2ZstdURI:      env[envPrefix+"_EXPORT_QCOW2_ZSTD_URI"],
+				SHA256URI:         env[envPrefix+"_EXPORT_SHA256_URI"],
+				ChangedExtentsURI: env[envPrefix+"_EXPORT_CHANGED_EXTENTS_URI"],
+				ChangedDataURI:    env[envPrefix+"_EXPORT_CHANGED_DATA_URI"],
+				ClaimName:         env[envPrefix+"_EXPORT_CLAIM_NAME"],
 			}
 			result.Volumes = append(result.Volumes, vi)
 		}
@@ -68,8 +68,21 @@ func (s *VMSource) ServicePorts() []corev1.ServicePort {
 	return []corev1.ServicePort{exportPort()}
 }
 
+// ConfigurePod also serves the VM as an OVA, the claim names let the exporter
+// match the disks of the VM with the exported volumes
 func (s *VMSource) ConfigurePod(pod *corev1.Pod) {
 	s.sourceVolumes.configurePodVolumes(pod)
+	container := &pod.Spec.Containers[0]
+	for i, volume := range s.sourceVolumes.volumes {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_CLAIM_NAME", i),
+			Value: volume.pvc.Name,
+		})
+	}
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  "EXPORT_VM_OVA_URI",
+		Value: ovaManifestPath,
+	})
 }
 
 func (s *VMSource) ConfigureExportLink(exportLink *exportv1.VirtualMachineExportLink, paths *ServerPaths, vmExport *exportv1.VirtualMachineExport, pod *corev1.Pod, hostAndBase, scheme string) {
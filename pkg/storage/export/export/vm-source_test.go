@@ -39,6 +39,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
@@ -73,6 +74,7 @@ var _ = Describe("PVC source", func() {
 		dvInformer                  cache.SharedIndexInformer
 		vmSnapshotInformer          cache.SharedIndexInformer
 		vmSnapshotContentInformer   cache.SharedIndexInformer
+		vmBackupInformer            cache.SharedIndexInformer
 		secretInformer              cache.SharedIndexInformer
 		vmInformer                  cache.SharedIndexInformer
 		vmiInformer                 cache.SharedIndexInformer
@@ -105,6 +107,7 @@ var _ = Describe("PVC source", func() {
 		dvInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmBackupInformer, _ = testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
 		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
 		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
 		routeInformer, _ := testutils.NewFakeInformerFor(&routev1.Route{})
@@ -153,6 +156,7 @@ var _ = Describe("PVC source", func() {
 			SecretInformer:              secretInformer,
 			VMSnapshotInformer:          vmSnapshotInformer,
 			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
 			VolumeSnapshotProvider:      fakeVolumeSnapshotProvider,
 			VMInformer:                  vmInformer,
 			VMIInformer:                 vmiInformer,
@@ -360,15 +364,7 @@ var _ = Describe("PVC source", func() {
 	}
 
 	verifyMixedInternal := func(vmExport *exportv1.VirtualMachineExport, exportName, namespace string, volumeNames ...string) {
-		exportVolumeFormats := make([]exportv1.VirtualMachineExportVolumeFormat, 0)
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtRaw,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
-		})
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtGz,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
-		})
+		exportVolumeFormats := kubevirtVolumeFormats(fmt.Sprintf("https://%s.%s.svc/volumes/%s", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]))
 		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
 			Format: exportv1.Dir,
 			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/dir", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[1]),
@@ -410,6 +406,42 @@ var _ = Describe("PVC source", func() {
 		Entry("Memorydump and pvc", createVMWithPVCandMemoryDump, "kubevirt", "archive", verifyMixedInternal),
 	)
 
+	It("Should serve the VM as an OVA", func() {
+		testVMExport := createVMVMExport()
+		controller.VMInformer.GetStore().Add(createVMWithDataVolumes())
+		controller.PVCInformer.GetStore().Add(createPVC("volume1", "kubevirt"))
+		controller.PVCInformer.GetStore().Add(createPVC("volume2", "kubevirt"))
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			exportPod, ok := create.GetObject().(*k8sv1.Pod)
+			Expect(ok).To(BeTrue())
+			Expect(exportPod.Spec.Containers[0].Env).To(ContainElements(
+				k8sv1.EnvVar{Name: "VOLUME0_EXPORT_CLAIM_NAME", Value: "volume1"},
+				k8sv1.EnvVar{Name: "VOLUME1_EXPORT_CLAIM_NAME", Value: "volume2"},
+				k8sv1.EnvVar{Name: "EXPORT_VM_OVA_URI", Value: ovaManifestPath},
+			))
+			exportPod.Status = k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+			}
+			return true, exportPod, nil
+		})
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			Expect(vmExport.Status.Links.Internal.Manifests).To(ContainElement(exportv1.VirtualMachineExportManifest{
+				Type: exportv1.OVA,
+				Url:  fmt.Sprintf("https://%s-%s.%s.svc/internal/manifests/ova", exportPrefix, vmExport.Name, testNamespace),
+			}))
+			return true, vmExport, nil
+		})
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	})
+
 	It("Should create VM export, when VM is using backend storage", func() {
 		testVMExport := createVMVMExport()
 		vm := createVMWithBackendPVC()
@@ -0,0 +1,274 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
+)
+
+const (
+	vmBackupNotFoundReason = "VMBackupNotFound"
+	vmBackupNotPushReason  = "VMBackupNotPushMode"
+	vmBackupNotDoneReason  = "VMBackupNotDone"
+	vmBackupNoVolumeReason = "VMBackupNoVolumes"
+	vmBackupDoneReason     = "VMBackupDone"
+)
+
+// VMBackupSource exports the volumes of a push mode VirtualMachineBackup.
+// Only the extents changed since the base checkpoint of the backup are
+// served, or every allocated extent for a full backup.
+type VMBackupSource struct {
+	sourceVolumes *sourceVolumes
+	backup        *backupv1.VirtualMachineBackup
+	vmName        string
+}
+
+func NewVMBackupSource(sourceVolumes *sourceVolumes, backup *backupv1.VirtualMachineBackup, vmName string) *VMBackupSource {
+	return &VMBackupSource{
+		sourceVolumes: sourceVolumes,
+		backup:        backup,
+		vmName:        vmName,
+	}
+}
+
+func changedExtentsURI(volumeName string) string {
+	return path.Join(fmt.Sprintf("%s/%s/changed-extents.json", urlBasePath, volumeName))
+}
+
+func changedDataURI(volumeName string) string {
+	return path.Join(fmt.Sprintf("%s/%s/changed.img", urlBasePath, volumeName))
+}
+
+// backupFileName returns the name of the file a push mode backup writes the
+// data of a volume to
+func backupFileName(backupName, volumeName string) string {
+	return fmt.Sprintf("%s-%s.qcow2", backupName, volumeName)
+}
+
+func (s *VMBackupSource) IsSourceAvailable() bool {
+	return s.sourceVolumes.isSourceAvailable()
+}
+
+func (s *VMBackupSource) HasContent() bool {
+	return s.backup != nil && len(s.backup.Status.IncludedVolumes) > 0 && s.sourceVolumes.hasContent()
+}
+
+func (s *VMBackupSource) SourceCondition() exportv1.Condition {
+	return s.sourceVolumes.sourceCondition
+}
+
+func (s *VMBackupSource) ReadyCondition() exportv1.Condition {
+	return s.sourceVolumes.readyCondition
+}
+
+func (s *VMBackupSource) ServicePorts() []corev1.ServicePort {
+	return []corev1.ServicePort{exportPort()}
+}
+
+// ConfigurePod mounts the PVC holding the backup, every volume of the
+// backup is served from its qcow2 file
+func (s *VMBackupSource) ConfigurePod(pod *corev1.Pod) {
+	pvc := s.sourceVolumes.volumes[0].pvc
+	volumeName := getExportPodVolumeName(pvc)
+	mountPoint := fmt.Sprintf("%s/%s", fileSystemMountPath, volumeName)
+	container := &pod.Spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		ReadOnly:  true,
+		MountPath: mountPoint,
+	})
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: pvc.Name,
+				ReadOnly:  true,
+			},
+		},
+	})
+
+	backupDir := filepath.Join(mountPoint, s.vmName, *s.backup.Status.CheckpointName)
+	for i, volume := range s.backup.Status.IncludedVolumes {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_PATH", i),
+			Value: filepath.Join(backupDir, backupFileName(s.backup.Name, volume.VolumeName)),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_CHANGED_EXTENTS_URI", i),
+			Value: changedExtentsURI(volume.VolumeName),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_CHANGED_DATA_URI", i),
+			Value: changedDataURI(volume.VolumeName),
+		})
+	}
+}
+
+func (s *VMBackupSource) ConfigureExportLink(exportLink *exportv1.VirtualMachineExportLink, paths *ServerPaths, vmExport *exportv1.VirtualMachineExport, pod *corev1.Pod, hostAndBase, scheme string) {
+	if pod.Status.Phase != corev1.PodRunning || s.backup == nil {
+		return
+	}
+
+	for _, volume := range s.backup.Status.IncludedVolumes {
+		volumeInfo := paths.GetVolumeInfo(backupFileName(s.backup.Name, volume.VolumeName))
+		if volumeInfo == nil {
+			log.Log.Warningf("Volume %s not found in paths", volume.VolumeName)
+			continue
+		}
+		exportLink.Volumes = append(exportLink.Volumes, exportv1.VirtualMachineExportVolume{
+			Name: volume.VolumeName,
+			Formats: []exportv1.VirtualMachineExportVolumeFormat{
+				{
+					Format: exportv1.ChangedExtents,
+					Url:    scheme + path.Join(hostAndBase, volumeInfo.ChangedExtentsURI),
+				},
+				{
+					Format: exportv1.ChangedData,
+					Url:    scheme + path.Join(hostAndBase, volumeInfo.ChangedDataURI),
+				},
+			},
+		})
+	}
+}
+
+func (s *VMBackupSource) UpdateStatus(vmExport *exportv1.VirtualMachineExport, pod *corev1.Pod, svc *corev1.Service) (time.Duration, error) {
+	if s.vmName != "" {
+		vmExport.Status.VirtualMachineName = pointer.P(s.vmName)
+	}
+	vmExport.Status.Conditions = updateCondition(vmExport.Status.Conditions, s.SourceCondition())
+
+	if !s.HasContent() {
+		switch s.SourceCondition().Reason {
+		case vmBackupNotFoundReason, vmBackupNotPushReason, vmBackupNoVolumeReason:
+			vmExport.Status.Phase = exportv1.Skipped
+		}
+		return 0, nil
+	}
+	if !s.IsSourceAvailable() {
+		log.Log.V(4).Infof("Source is not available %s, requeuing", s.ReadyCondition().Message)
+		return requeueTime, nil
+	}
+	return 0, nil
+}
+
+func (ctrl *VMExportController) handleVMBackup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if backup, ok := obj.(*backupv1.VirtualMachineBackup); ok {
+		backupKey, _ := cache.MetaNamespaceKeyFunc(backup)
+		keys, err := ctrl.VMExportInformer.GetIndexer().IndexKeys("vmbackup", backupKey)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+		for _, key := range keys {
+			log.Log.V(3).Infof("Adding VMExport due to VMBackup %s", backupKey)
+			ctrl.vmExportQueue.Add(key)
+		}
+	}
+}
+
+func (ctrl *VMExportController) getVMBackupSource(vmExport *exportv1.VirtualMachineExport) (*VMBackupSource, error) {
+	unavailable := func(reason, message string) *VMBackupSource {
+		return NewVMBackupSource(&sourceVolumes{
+			readyCondition:  newReadyCondition(corev1.ConditionFalse, reason, message),
+			sourceCondition: newVolumesCreatedCondition(corev1.ConditionFalse, reason, message),
+		}, nil, "")
+	}
+
+	backup, exists, err := ctrl.getVMBackup(vmExport.Namespace, vmExport.Spec.Source.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return unavailable(vmBackupNotFoundReason, fmt.Sprintf("VirtualMachineBackup %s/%s not found", vmExport.Namespace, vmExport.Spec.Source.Name)), nil
+	}
+	if backup.Spec.PvcName == nil {
+		return unavailable(vmBackupNotPushReason, fmt.Sprintf("VirtualMachineBackup %s/%s is not a push mode backup", backup.Namespace, backup.Name)), nil
+	}
+	vmName := backupVMName(backup)
+	if !cbt.IsBackupDone(backup.Status) || backup.Status.CheckpointName == nil || vmName == "" {
+		return unavailable(vmBackupNotDoneReason, fmt.Sprintf("VirtualMachineBackup %s/%s has not completed successfully", backup.Namespace, backup.Name)), nil
+	}
+	if len(backup.Status.IncludedVolumes) == 0 {
+		return unavailable(vmBackupNoVolumeReason, fmt.Sprintf("VirtualMachineBackup %s/%s does not contain any volumes", backup.Namespace, backup.Name)), nil
+	}
+
+	pvc, exists, err := ctrl.getPvc(backup.Namespace, *backup.Spec.PvcName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return unavailable(pvcNotFoundReason, fmt.Sprintf("PersistentVolumeClaim %s/%s not found", backup.Namespace, *backup.Spec.PvcName)), nil
+	}
+	sourceVolumes, err := ctrl.isSourceAvailablePVC(vmExport, pvc)
+	if err != nil {
+		return nil, err
+	}
+	sourceVolumes.sourceCondition = newVolumesCreatedCondition(corev1.ConditionTrue, vmBackupDoneReason, "")
+	return NewVMBackupSource(sourceVolumes, backup, vmName), nil
+}
+
+// backupVMName returns the name of the VM the backup was taken of, the
+// backups taken through a tracker only record it in their captured VM
+func backupVMName(backup *backupv1.VirtualMachineBackup) string {
+	if backup.Spec.Source.Kind == "VirtualMachine" {
+		return backup.Spec.Source.Name
+	}
+	if backup.Status == nil || backup.Status.VirtualMachine == nil {
+		return ""
+	}
+	vm := &metav1.PartialObjectMetadata{}
+	if err := json.Unmarshal(backup.Status.VirtualMachine.Raw, vm); err != nil {
+		log.Log.Object(backup).Reason(err).Error("Failed to read the VM captured by the backup")
+		return ""
+	}
+	return vm.Name
+}
+
+func (ctrl *VMExportController) isSourceVMBackup(source *exportv1.VirtualMachineExportSpec) bool {
+	return source != nil && source.Source.APIGroup != nil && *source.Source.APIGroup == backupv1.SchemeGroupVersion.Group && source.Source.Kind == "VirtualMachineBackup"
+}
+
+func (ctrl *VMExportController) getVMBackup(namespace, name string) (*backupv1.VirtualMachineBackup, bool, error) {
+	key := controller.NamespacedKey(namespace, name)
+	obj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	return obj.(*backupv1.VirtualMachineBackup).DeepCopy(), true, nil
+}
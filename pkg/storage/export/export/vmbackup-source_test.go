@@ -0,0 +1,433 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	routev1 "github.com/openshift/api/route/v1"
+	"go.uber.org/mock/gomock"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+const (
+	testVMBackupName = "test-vmbackup"
+	testBackupPVC    = "test-backup-pvc"
+)
+
+var _ = Describe("VMBackup source", func() {
+	var (
+		ctrl                        *gomock.Controller
+		controller                  *VMExportController
+		recorder                    *record.FakeRecorder
+		pvcInformer                 cache.SharedIndexInformer
+		podInformer                 cache.SharedIndexInformer
+		cmInformer                  cache.SharedIndexInformer
+		vmExportInformer            cache.SharedIndexInformer
+		serviceInformer             cache.SharedIndexInformer
+		dvInformer                  cache.SharedIndexInformer
+		vmSnapshotInformer          cache.SharedIndexInformer
+		vmSnapshotContentInformer   cache.SharedIndexInformer
+		vmBackupInformer            cache.SharedIndexInformer
+		secretInformer              cache.SharedIndexInformer
+		vmInformer                  cache.SharedIndexInformer
+		vmiInformer                 cache.SharedIndexInformer
+		kvInformer                  cache.SharedIndexInformer
+		crdInformer                 cache.SharedIndexInformer
+		instancetypeInformer        cache.SharedIndexInformer
+		clusterInstancetypeInformer cache.SharedIndexInformer
+		preferenceInformer          cache.SharedIndexInformer
+		clusterPreferenceInformer   cache.SharedIndexInformer
+		controllerRevisionInformer  cache.SharedIndexInformer
+		rqInformer                  cache.SharedIndexInformer
+		nsInformer                  cache.SharedIndexInformer
+		k8sClient                   *k8sfake.Clientset
+		vmExportClient              *kubevirtfake.Clientset
+		fakeVolumeSnapshotProvider  *MockVolumeSnapshotProvider
+		fakeCertManager             *MockCertManager
+		mockVMExportQueue           *testutils.MockWorkQueue[string]
+		routeCache                  cache.Store
+		ingressCache                cache.Store
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		var err error
+		Expect(err).ToNot(HaveOccurred())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		cmInformer, _ = testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
+		serviceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Service{})
+		vmExportInformer, _ = testutils.NewFakeInformerWithIndexersFor(&exportv1.VirtualMachineExport{}, virtcontroller.GetVirtualMachineExportInformerIndexers())
+		dvInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmBackupInformer, _ = testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		routeInformer, _ := testutils.NewFakeInformerFor(&routev1.Route{})
+		routeCache = routeInformer.GetStore()
+		ingressInformer, _ := testutils.NewFakeInformerFor(&networkingv1.Ingress{})
+		ingressCache = ingressInformer.GetStore()
+		secretInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Secret{})
+		kvInformer, _ = testutils.NewFakeInformerFor(&virtv1.KubeVirt{})
+		crdInformer, _ = testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
+		instancetypeInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
+		clusterInstancetypeInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
+		preferenceInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachinePreference{})
+		clusterPreferenceInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterPreference{})
+		controllerRevisionInformer, _ = testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+		rqInformer, _ = testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		nsInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		fakeVolumeSnapshotProvider = &MockVolumeSnapshotProvider{
+			volumeSnapshots: []*vsv1.VolumeSnapshot{},
+		}
+		fakeCertManager = &MockCertManager{}
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+		k8sClient = k8sfake.NewSimpleClientset()
+		vmExportClient = kubevirtfake.NewSimpleClientset()
+		recorder = record.NewFakeRecorder(100)
+
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineExport(testNamespace).
+			Return(vmExportClient.ExportV1beta1().VirtualMachineExports(testNamespace)).AnyTimes()
+
+		controller = &VMExportController{
+			Client:                      virtClient,
+			Recorder:                    recorder,
+			PVCInformer:                 pvcInformer,
+			PodInformer:                 podInformer,
+			ConfigMapInformer:           cmInformer,
+			VMExportInformer:            vmExportInformer,
+			ServiceInformer:             serviceInformer,
+			DataVolumeInformer:          dvInformer,
+			KubevirtNamespace:           "kubevirt",
+			ManifestRenderer:            services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", rqInformer.GetStore(), nsInformer.GetStore()),
+			caCertManager:               fakeCertManager,
+			RouteCache:                  routeCache,
+			IngressCache:                ingressCache,
+			RouteConfigMapInformer:      cmInformer,
+			SecretInformer:              secretInformer,
+			VMSnapshotInformer:          vmSnapshotInformer,
+			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
+			VolumeSnapshotProvider:      fakeVolumeSnapshotProvider,
+			VMInformer:                  vmInformer,
+			VMIInformer:                 vmiInformer,
+			CRDInformer:                 crdInformer,
+			KubeVirtInformer:            kvInformer,
+			InstancetypeInformer:        instancetypeInformer,
+			ClusterInstancetypeInformer: clusterInstancetypeInformer,
+			PreferenceInformer:          preferenceInformer,
+			ClusterPreferenceInformer:   clusterPreferenceInformer,
+			ControllerRevisionInformer:  controllerRevisionInformer,
+		}
+		initCert = func(ctrl *VMExportController) {
+			ctrl.caCertManager.Start()
+			Expect(ctrl.caCertManager.Current()).ToNot(BeNil())
+		}
+
+		controller.Init()
+		mockVMExportQueue = testutils.NewMockWorkQueue(controller.vmExportQueue)
+		controller.vmExportQueue = mockVMExportQueue
+
+		Expect(
+			cmInformer.GetStore().Add(&k8sv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: controller.KubevirtNamespace,
+					Name:      components.KubeVirtExportCASecretName,
+				},
+				Data: map[string]string{
+					"ca-bundle": "replace me with ca cert",
+				},
+			}),
+		).To(Succeed())
+
+		Expect(
+			kvInformer.GetStore().Add(&virtv1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: controller.KubevirtNamespace,
+					Name:      "kv",
+				},
+				Spec: virtv1.KubeVirtSpec{
+					CertificateRotationStrategy: virtv1.KubeVirtCertificateRotateStrategy{
+						SelfSigned: &virtv1.KubeVirtSelfSignConfiguration{
+							CA: &virtv1.CertConfig{
+								Duration:    &metav1.Duration{Duration: 24 * time.Hour},
+								RenewBefore: &metav1.Duration{Duration: 3 * time.Hour},
+							},
+							Server: &virtv1.CertConfig{
+								Duration:    &metav1.Duration{Duration: 2 * time.Hour},
+								RenewBefore: &metav1.Duration{Duration: 1 * time.Hour},
+							},
+						},
+					},
+				},
+				Status: virtv1.KubeVirtStatus{
+					Phase: virtv1.KubeVirtPhaseDeployed,
+				},
+			}),
+		).To(Succeed())
+	})
+	createBackupVMExport := func() *exportv1.VirtualMachineExport {
+		return &exportv1.VirtualMachineExport{
+			ObjectMeta: createVMExportMeta(vmExportName),
+			Spec: exportv1.VirtualMachineExportSpec{
+				Source: k8sv1.TypedLocalObjectReference{
+					APIGroup: &backupv1.SchemeGroupVersion.Group,
+					Kind:     "VirtualMachineBackup",
+					Name:     testVMBackupName,
+				},
+				TokenSecretRef: &tokenSecretName,
+			},
+		}
+	}
+
+	createTestVMBackup := func(done bool) *backupv1.VirtualMachineBackup {
+		backup := &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testVMBackupName,
+				Namespace: testNamespace,
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: k8sv1.TypedLocalObjectReference{
+					APIGroup: &virtv1.SchemeGroupVersion.Group,
+					Kind:     "VirtualMachine",
+					Name:     testVmName,
+				},
+				PvcName: pointer.P(testBackupPVC),
+			},
+			Status: &backupv1.VirtualMachineBackupStatus{
+				Type: backupv1.Incremental,
+				IncludedVolumes: []virtv1.BackupVolumeInfo{
+					{VolumeName: "rootdisk", DiskTarget: "vda"},
+					{VolumeName: "datadisk", DiskTarget: "vdb"},
+				},
+			},
+		}
+		if done {
+			backup.Status.CheckpointName = pointer.P(testVMBackupName + "-checkpoint")
+			backup.Status.Conditions = []backupv1.Condition{
+				{Type: backupv1.ConditionDone, Status: k8sv1.ConditionTrue},
+			}
+		}
+		return backup
+	}
+
+	changedFormats := func(volumeName string) []exportv1.VirtualMachineExportVolumeFormat {
+		base := fmt.Sprintf("https://%s-%s.%s.svc/volumes/%s", exportPrefix, vmExportName, testNamespace, volumeName)
+		return []exportv1.VirtualMachineExportVolumeFormat{
+			{Format: exportv1.ChangedExtents, Url: base + "/changed-extents.json"},
+			{Format: exportv1.ChangedData, Url: base + "/changed.img"},
+		}
+	}
+
+	expectSourceCondition := func(vmExport *exportv1.VirtualMachineExport, status k8sv1.ConditionStatus, reason string) {
+		volumeCreateConditionSet := false
+		for _, condition := range vmExport.Status.Conditions {
+			if condition.Type == exportv1.ConditionVolumesCreated {
+				volumeCreateConditionSet = true
+				Expect(condition.Status).To(Equal(status))
+				Expect(condition.Reason).To(Equal(reason))
+			}
+		}
+		Expect(volumeCreateConditionSet).To(BeTrue())
+	}
+
+	DescribeTable("Should skip the export of a VMBackup", func(backup *backupv1.VirtualMachineBackup, reason string) {
+		testVMExport := createBackupVMExport()
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			verifyLinksEmpty(vmExport)
+			expectSourceCondition(vmExport, k8sv1.ConditionFalse, reason)
+			Expect(vmExport.Status.Phase).To(Equal(exportv1.Skipped))
+			return true, vmExport, nil
+		})
+		if backup != nil {
+			Expect(vmBackupInformer.GetStore().Add(backup)).To(Succeed())
+		}
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	},
+		Entry("that does not exist", nil, vmBackupNotFoundReason),
+		Entry("in pull mode", func() *backupv1.VirtualMachineBackup {
+			backup := createTestVMBackup(true)
+			backup.Spec.PvcName = nil
+			return backup
+		}(), vmBackupNotPushReason),
+		Entry("without volumes", func() *backupv1.VirtualMachineBackup {
+			backup := createTestVMBackup(true)
+			backup.Status.IncludedVolumes = nil
+			return backup
+		}(), vmBackupNoVolumeReason),
+	)
+
+	It("Should wait for the VMBackup to complete", func() {
+		testVMExport := createBackupVMExport()
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			verifyLinksEmpty(vmExport)
+			expectSourceCondition(vmExport, k8sv1.ConditionFalse, vmBackupNotDoneReason)
+			Expect(vmExport.Status.Phase).To(Equal(exportv1.Pending))
+			return true, vmExport, nil
+		})
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Fail("the exporter pod should not be created")
+			return true, nil, nil
+		})
+		Expect(vmBackupInformer.GetStore().Add(createTestVMBackup(false))).To(Succeed())
+		Expect(pvcInformer.GetStore().Add(createPVC(testBackupPVC, "kubevirt"))).To(Succeed())
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	})
+
+	It("Should mount the backup PVC read only in the exporter pod", func() {
+		testVMExport := createBackupVMExport()
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			expectSourceCondition(vmExport, k8sv1.ConditionTrue, vmBackupDoneReason)
+			Expect(vmExport.Status.VirtualMachineName).To(HaveValue(Equal(testVmName)))
+			return true, vmExport, nil
+		})
+		podCreated := false
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			pod, ok := create.GetObject().(*k8sv1.Pod)
+			Expect(ok).To(BeTrue())
+			Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+				Name: testBackupPVC,
+				VolumeSource: k8sv1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: testBackupPVC,
+						ReadOnly:  true,
+					},
+				},
+			}))
+			container := pod.Spec.Containers[0]
+			Expect(container.VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+				Name:      testBackupPVC,
+				ReadOnly:  true,
+				MountPath: fmt.Sprintf("%s/%s", fileSystemMountPath, testBackupPVC),
+			}))
+			backupDir := fmt.Sprintf("%s/%s/%s/%s-checkpoint", fileSystemMountPath, testBackupPVC, testVmName, testVMBackupName)
+			Expect(container.Env).To(ContainElements(
+				k8sv1.EnvVar{Name: "VOLUME0_EXPORT_PATH", Value: backupDir + "/" + testVMBackupName + "-rootdisk.qcow2"},
+				k8sv1.EnvVar{Name: "VOLUME0_EXPORT_CHANGED_EXTENTS_URI", Value: "/volumes/rootdisk/changed-extents.json"},
+				k8sv1.EnvVar{Name: "VOLUME0_EXPORT_CHANGED_DATA_URI", Value: "/volumes/rootdisk/changed.img"},
+				k8sv1.EnvVar{Name: "VOLUME1_EXPORT_PATH", Value: backupDir + "/" + testVMBackupName + "-datadisk.qcow2"},
+				k8sv1.EnvVar{Name: "VOLUME1_EXPORT_CHANGED_EXTENTS_URI", Value: "/volumes/datadisk/changed-extents.json"},
+				k8sv1.EnvVar{Name: "VOLUME1_EXPORT_CHANGED_DATA_URI", Value: "/volumes/datadisk/changed.img"},
+			))
+			podCreated = true
+			pod.Status = k8sv1.PodStatus{Phase: k8sv1.PodPending}
+			return true, pod, nil
+		})
+		Expect(vmBackupInformer.GetStore().Add(createTestVMBackup(true))).To(Succeed())
+		Expect(pvcInformer.GetStore().Add(createPVC(testBackupPVC, "kubevirt"))).To(Succeed())
+
+		_, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(podCreated).To(BeTrue())
+	})
+
+	It("Should update status with the changed extents links of the VMBackup volumes", func() {
+		testVMExport := createBackupVMExport()
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			verifyLinksInternal(vmExport, append(changedFormats("rootdisk"), changedFormats("datadisk")...)...)
+			Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(2))
+			Expect(vmExport.Status.Links.Internal.Volumes[0].Name).To(Equal("rootdisk"))
+			Expect(vmExport.Status.Links.Internal.Volumes[1].Name).To(Equal("datadisk"))
+			return true, vmExport, nil
+		})
+		expectExporterCreate(k8sClient, k8sv1.PodRunning)
+		controller.RouteCache.Add(routeToHostAndService(components.VirtExportProxyServiceName))
+		Expect(vmBackupInformer.GetStore().Add(createTestVMBackup(true))).To(Succeed())
+		Expect(pvcInformer.GetStore().Add(createPVC(testBackupPVC, "kubevirt"))).To(Succeed())
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	})
+
+	It("Should read the VM name of a tracker backup from the captured VM", func() {
+		backup := createTestVMBackup(true)
+		backup.Spec.Source = k8sv1.TypedLocalObjectReference{
+			APIGroup: &backupv1.SchemeGroupVersion.Group,
+			Kind:     "VirtualMachineBackupTracker",
+			Name:     "tracker",
+		}
+		Expect(backupVMName(backup)).To(BeEmpty())
+
+		vm, err := json.Marshal(&virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: testVmName, Namespace: testNamespace},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		backup.Status.VirtualMachine = &runtime.RawExtension{Raw: vm}
+		Expect(backupVMName(backup)).To(Equal(testVmName))
+	})
+})
@@ -0,0 +1,341 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	qcow2MinClusterBits = 9
+	qcow2MaxClusterBits = 21
+	qcow2V3HeaderLength = 104
+
+	qcow2IncompatibleDirty = 1 << 0
+
+	qcow2OffsetMask = 0x00fffffffffffe00
+	qcow2OflagZero  = 1
+
+	// Flags of the zero extents, as reported by the base:allocation
+	// context of NBD for the pull mode backups
+	extentFlagsZero = 2
+)
+
+// qcow2Run maps a range of the disk to a contiguous range of the image, the
+// host offset is -1 for ranges reading back as zeros
+type qcow2Run struct {
+	offset     int64
+	length     int64
+	hostOffset int64
+}
+
+// qcow2Map holds the ranges of the disk allocated in a qcow2 image. A push
+// mode backup only allocates the clusters changed since its base checkpoint,
+// or every allocated cluster of the disk for a full backup.
+type qcow2Map struct {
+	size int64
+	runs []qcow2Run
+}
+
+// qcow2MapLoader reads the map of a qcow2 image once, the images of
+// completed backups do not change
+type qcow2MapLoader struct {
+	filePath string
+	mutex    sync.Mutex
+	qcow2Map *qcow2Map
+}
+
+func readQcow2Map(r io.ReaderAt) (*qcow2Map, error) {
+	be := binary.BigEndian
+	header := make([]byte, qcow2V3HeaderLength)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, fmt.Errorf("failed to read qcow2 header: %w", err)
+	}
+	if be.Uint32(header[0:]) != qcow2Magic {
+		return nil, fmt.Errorf("not a qcow2 image")
+	}
+	version := be.Uint32(header[4:])
+	if version != 2 && version != 3 {
+		return nil, fmt.Errorf("unsupported qcow2 version %d", version)
+	}
+	clusterBits := be.Uint32(header[20:])
+	if clusterBits < qcow2MinClusterBits || clusterBits > qcow2MaxClusterBits {
+		return nil, fmt.Errorf("invalid qcow2 cluster bits %d", clusterBits)
+	}
+	if be.Uint32(header[32:]) != 0 {
+		return nil, fmt.Errorf("encrypted qcow2 images are not supported")
+	}
+	// Compressed clusters are rejected when they are met
+	if incompatible := be.Uint64(header[72:]); version == 3 && incompatible&^(qcow2IncompatibleDirty|qcow2IncompatibleCompressionType) != 0 {
+		return nil, fmt.Errorf("unsupported qcow2 incompatible features %#x", incompatible)
+	}
+
+	m := &qcow2Map{size: int64(be.Uint64(header[24:]))}
+	clusterSize := int64(1) << clusterBits
+	l2Entries := clusterSize / 8
+	l1Size := ceilDiv(ceilDiv(m.size, clusterSize), l2Entries)
+	if l1Size > int64(be.Uint32(header[36:])) {
+		return nil, fmt.Errorf("qcow2 L1 table is too small for %d bytes", m.size)
+	}
+
+	l1 := make([]byte, l1Size*8)
+	if _, err := r.ReadAt(l1, int64(be.Uint64(header[40:]))); err != nil {
+		return nil, fmt.Errorf("failed to read qcow2 L1 table: %w", err)
+	}
+	l2 := make([]byte, clusterSize)
+	for l1Index := int64(0); l1Index < l1Size; l1Index++ {
+		l2Offset := int64(be.Uint64(l1[l1Index*8:]) & qcow2OffsetMask)
+		if l2Offset == 0 {
+			continue
+		}
+		if _, err := r.ReadAt(l2, l2Offset); err != nil {
+			return nil, fmt.Errorf("failed to read qcow2 L2 table: %w", err)
+		}
+		for l2Index := int64(0); l2Index < l2Entries; l2Index++ {
+			offset := (l1Index*l2Entries + l2Index) * clusterSize
+			if offset >= m.size {
+				break
+			}
+			length := min(clusterSize, m.size-offset)
+			entry := be.Uint64(l2[l2Index*8:])
+			switch {
+			case entry&qcow2OflagCompressed != 0:
+				return nil, fmt.Errorf("compressed qcow2 clusters are not supported")
+			case version == 3 && entry&qcow2OflagZero != 0:
+				m.add(offset, length, -1)
+			case entry&qcow2OffsetMask != 0:
+				m.add(offset, length, int64(entry&qcow2OffsetMask))
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m *qcow2Map) add(offset, length, hostOffset int64) {
+	if n := len(m.runs); n > 0 {
+		last := &m.runs[n-1]
+		contiguous := last.offset+last.length == offset
+		if contiguous && last.hostOffset < 0 && hostOffset < 0 {
+			last.length += length
+			return
+		}
+		if contiguous && last.hostOffset >= 0 && last.hostOffset+last.length == hostOffset {
+			last.length += length
+			return
+		}
+	}
+	m.runs = append(m.runs, qcow2Run{offset: offset, length: length, hostOffset: hostOffset})
+}
+
+// extents returns the allocated ranges of the disk, adjacent ranges of the
+// same kind are merged
+func (m *qcow2Map) extents() []backupv1.BackupExportExtent {
+	extents := []backupv1.BackupExportExtent{}
+	for _, run := range m.runs {
+		flags, description := uint64(0), "data"
+		if run.hostOffset < 0 {
+			flags, description = extentFlagsZero, "zero"
+		}
+		if n := len(extents); n > 0 && extents[n-1].Offset+extents[n-1].Length == uint64(run.offset) && extents[n-1].Flags == flags {
+			extents[n-1].Length += uint64(run.length)
+			continue
+		}
+		extents = append(extents, backupv1.BackupExportExtent{
+			Offset:      uint64(run.offset),
+			Length:      uint64(run.length),
+			Flags:       flags,
+			Description: description,
+		})
+	}
+	return extents
+}
+
+// forEachRun calls fn with the part of every run within the range, it fails
+// when part of the range is not allocated
+func (m *qcow2Map) forEachRun(start, length int64, fn func(run qcow2Run) error) error {
+	i := sort.Search(len(m.runs), func(i int) bool {
+		return m.runs[i].offset+m.runs[i].length > start
+	})
+	end := start + length
+	for offset := start; offset < end; i++ {
+		if i == len(m.runs) || m.runs[i].offset > offset {
+			return fmt.Errorf("range %d-%d is not within the changed extents", start, end-1)
+		}
+		run := m.runs[i]
+		part := qcow2Run{
+			offset: offset,
+			length: min(run.offset+run.length, end) - offset,
+		}
+		part.hostOffset = -1
+		if run.hostOffset >= 0 {
+			part.hostOffset = run.hostOffset + offset - run.offset
+		}
+		if err := fn(part); err != nil {
+			return err
+		}
+		offset += part.length
+	}
+	return nil
+}
+
+func (m *qcow2Map) covers(start, length int64) error {
+	return m.forEachRun(start, length, func(qcow2Run) error { return nil })
+}
+
+func (m *qcow2Map) copyRange(w io.Writer, r io.ReaderAt, start, length int64) error {
+	return m.forEachRun(start, length, func(run qcow2Run) error {
+		if run.hostOffset >= 0 {
+			_, err := io.Copy(w, io.NewSectionReader(r, run.hostOffset, run.length))
+			return err
+		}
+		for remaining := run.length; remaining > 0; {
+			n, err := w.Write(zeroCluster[:min(remaining, qcow2ClusterSize)])
+			if err != nil {
+				return err
+			}
+			remaining -= int64(n)
+		}
+		return nil
+	})
+}
+
+func (l *qcow2MapLoader) load() (*qcow2Map, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.qcow2Map != nil {
+		return l.qcow2Map, nil
+	}
+	f, err := os.Open(l.filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	m, err := readQcow2Map(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the map of %s: %w", l.filePath, err)
+	}
+	l.qcow2Map = m
+	return m, nil
+}
+
+// parseSingleRange parses a Range header holding a single byte range, it
+// returns the start and the length of the range
+func parseSingleRange(header string, size int64) (int64, int64, error) {
+	spec, ok := strings.CutPrefix(header, "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return 0, 0, fmt.Errorf("a single byte range is required")
+	}
+	first, last, ok := strings.Cut(spec, "-")
+	if !ok || first == "" {
+		return 0, 0, fmt.Errorf("invalid range %q", spec)
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 || start >= size {
+		return 0, 0, fmt.Errorf("invalid range %q", spec)
+	}
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return 0, 0, fmt.Errorf("invalid range %q", spec)
+		}
+		end = min(end, size-1)
+	}
+	return start, end - start + 1, nil
+}
+
+func changedExtentsHandler(filePath string) http.Handler {
+	loader := &qcow2MapLoader{filePath: filePath}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m, err := loader.load()
+		if err != nil {
+			log.Log.Reason(err).Error("error reading changed extents")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(m.extents()); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+	})
+}
+
+// changedDataHandler serves ranged reads of the changed extents of a backup,
+// the data outside of them is not part of the backup
+func changedDataHandler(filePath string) http.Handler {
+	loader := &qcow2MapLoader{filePath: filePath}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m, err := loader.load()
+		if err != nil {
+			log.Log.Reason(err).Error("error reading changed extents")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		rangeHeader := req.Header.Get("Range")
+		if rangeHeader == "" {
+			http.Error(w, "a Range header within the changed extents is required", http.StatusBadRequest)
+			return
+		}
+		start, length, err := parseSingleRange(rangeHeader, m.size)
+		if err == nil {
+			err = m.covers(start, length)
+		}
+		if err != nil {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", m.size))
+			http.Error(w, err.Error(), http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, start+length-1, m.size))
+		w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+		w.WriteHeader(http.StatusPartialContent)
+		if err := m.copyRange(w, f, start, length); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+	})
+}
@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+)
+
+var _ = Describe("changed extents", func() {
+	const (
+		diskSize = qcow2L2Entries*qcow2ClusterSize + 10*qcow2ClusterSize + 1000
+		lastData = diskSize / qcow2ClusterSize * qcow2ClusterSize
+	)
+
+	var (
+		raw       []byte
+		imagePath string
+	)
+
+	// writeImage writes the qcow2 image of the raw disk, the L2 entry of
+	// zeroCluster is replaced by a zero cluster entry. The raw disk is
+	// written sparse so only its data clusters are mapped.
+	writeImage := func(encoder *zstd.Encoder, zeroCluster int64) {
+		dir := GinkgoT().TempDir()
+		rawPath := filepath.Join(dir, "disk.img")
+		f, err := os.Create(rawPath)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(f.Truncate(int64(len(raw)))).To(Succeed())
+		for offset := 0; offset < len(raw); offset += qcow2ClusterSize {
+			cluster := raw[offset:min(offset+qcow2ClusterSize, len(raw))]
+			if bytes.Count(cluster, []byte{0}) < len(cluster) {
+				_, err = f.WriteAt(cluster, int64(offset))
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+
+		img, err := newQcow2Image(f, encoder)
+		Expect(err).ToNot(HaveOccurred())
+		image := &bytes.Buffer{}
+		_, err = img.WriteTo(image)
+		Expect(err).ToNot(HaveOccurred())
+
+		data := image.Bytes()
+		if zeroCluster >= 0 {
+			l2Offset := int64(binary.BigEndian.Uint64(data[qcow2ClusterSize:]) &^ qcow2OflagCopied)
+			binary.BigEndian.PutUint64(data[l2Offset+zeroCluster*8:], qcow2OflagZero)
+		}
+		imagePath = filepath.Join(dir, "backup.qcow2")
+		Expect(os.WriteFile(imagePath, data, 0600)).To(Succeed())
+	}
+
+	get := func(handler http.Handler, rangeHeader string) *http.Response {
+		server := httptest.NewServer(handler)
+		DeferCleanup(server.Close)
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+		if rangeHeader != "" {
+			req.Header.Set("Range", rangeHeader)
+		}
+		res, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(res.Body.Close)
+		return res
+	}
+
+	BeforeEach(func() {
+		raw = make([]byte, diskSize)
+		_, err := rand.Read(raw[:2*qcow2ClusterSize])
+		Expect(err).ToNot(HaveOccurred())
+		copy(raw[3*qcow2ClusterSize:], bytes.Repeat([]byte{1}, qcow2ClusterSize))
+		copy(raw[5*qcow2ClusterSize:], bytes.Repeat([]byte{2}, qcow2ClusterSize))
+		copy(raw[(qcow2L2Entries+3)*qcow2ClusterSize:], bytes.Repeat([]byte{3}, qcow2ClusterSize))
+		copy(raw[lastData:], bytes.Repeat([]byte{4}, diskSize-lastData))
+		writeImage(nil, 3)
+		// The guest sees zeros in the zero cluster
+		copy(raw[3*qcow2ClusterSize:], zeroCluster)
+	})
+
+	It("should list the extents allocated in the backup", func() {
+		res := get(changedExtentsHandler(imagePath), "")
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(res.Header.Get("Content-Type")).To(Equal("application/json"))
+		var extents []backupv1.BackupExportExtent
+		Expect(json.NewDecoder(res.Body).Decode(&extents)).To(Succeed())
+		Expect(extents).To(Equal([]backupv1.BackupExportExtent{
+			{Offset: 0, Length: 2 * qcow2ClusterSize, Flags: 0, Description: "data"},
+			{Offset: 3 * qcow2ClusterSize, Length: qcow2ClusterSize, Flags: extentFlagsZero, Description: "zero"},
+			{Offset: 5 * qcow2ClusterSize, Length: qcow2ClusterSize, Flags: 0, Description: "data"},
+			{Offset: (qcow2L2Entries + 3) * qcow2ClusterSize, Length: qcow2ClusterSize, Flags: 0, Description: "data"},
+			{Offset: lastData, Length: diskSize - lastData, Flags: 0, Description: "data"},
+		}))
+	})
+
+	DescribeTable("should serve ranged reads of the changed extents", func(start, end int64, rangeHeader string) {
+		res := get(changedDataHandler(imagePath), rangeHeader)
+		Expect(res.StatusCode).To(Equal(http.StatusPartialContent))
+		Expect(res.Header.Get("Content-Range")).To(Equal(fmt.Sprintf("bytes %d-%d/%d", start, end, diskSize)))
+		data, err := io.ReadAll(res.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal(raw[start : end+1]))
+	},
+		Entry("within a data extent", int64(100), int64(2*qcow2ClusterSize-1), fmt.Sprintf("bytes=100-%d", 2*qcow2ClusterSize-1)),
+		Entry("of a zero extent", int64(3*qcow2ClusterSize), int64(4*qcow2ClusterSize-1), fmt.Sprintf("bytes=%d-%d", 3*qcow2ClusterSize, 4*qcow2ClusterSize-1)),
+		Entry("until the end of the disk", int64(lastData), int64(diskSize-1), fmt.Sprintf("bytes=%d-", lastData)),
+		Entry("past the end of the disk", int64(lastData+10), int64(diskSize-1), fmt.Sprintf("bytes=%d-%d", lastData+10, diskSize+100)),
+	)
+
+	DescribeTable("should reject reads", func(rangeHeader string, statusCode int) {
+		res := get(changedDataHandler(imagePath), rangeHeader)
+		Expect(res.StatusCode).To(Equal(statusCode))
+	},
+		Entry("without a range", "", http.StatusBadRequest),
+		Entry("of unchanged data", fmt.Sprintf("bytes=0-%d", 3*qcow2ClusterSize), http.StatusRequestedRangeNotSatisfiable),
+		Entry("of several ranges", "bytes=0-10,20-30", http.StatusRequestedRangeNotSatisfiable),
+		Entry("of a suffix range", "bytes=-10", http.StatusRequestedRangeNotSatisfiable),
+		Entry("past the end of the disk", fmt.Sprintf("bytes=%d-", diskSize), http.StatusRequestedRangeNotSatisfiable),
+	)
+
+	It("should reject images with compressed clusters", func() {
+		encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		Expect(err).ToNot(HaveOccurred())
+		defer encoder.Close()
+		writeImage(encoder, -1)
+
+		res := get(changedExtentsHandler(imagePath), "")
+		Expect(res.StatusCode).To(Equal(http.StatusInternalServerError))
+	})
+
+	It("should reject requests other than GET", func() {
+		server := httptest.NewServer(changedDataHandler(imagePath))
+		defer server.Close()
+
+		res, err := http.Post(server.URL, "application/octet-stream", nil)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
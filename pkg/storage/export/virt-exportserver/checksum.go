@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"kubevirt.io/client-go/log"
+)
+
+// sha256RetryAfter is the number of seconds clients are asked to wait for
+// the digest to be computed
+const sha256RetryAfter = "10"
+
+// sha256Digest computes the digest of a volume once in the background,
+// exported volumes are not written to while the export is running
+type sha256Digest struct {
+	done   chan struct{}
+	digest string
+	err    error
+}
+
+func newSha256Digest(filePath string) *sha256Digest {
+	d := &sha256Digest{done: make(chan struct{})}
+	go func() {
+		defer close(d.done)
+		d.digest, d.err = computeSha256(filePath)
+	}()
+	return d
+}
+
+func computeSha256(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// get returns the digest once it is computed, ready is false until then
+func (d *sha256Digest) get() (digest string, ready bool, err error) {
+	select {
+	case <-d.done:
+		return d.digest, true, d.err
+	default:
+		return "", false, nil
+	}
+}
+
+// sha256Handler serves the SHA-256 digest of the raw volume in the format
+// of sha256sum. The digest is computed when the server starts, requests are
+// asked to retry later until it is ready.
+func sha256Handler(filePath string) http.Handler {
+	return newSha256Handler(filePath, newSha256Digest(filePath))
+}
+
+func newSha256Handler(filePath string, digest *sha256Digest) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sum, ready, err := digest.get()
+		if !ready {
+			w.Header().Set("Retry-After", sha256RetryAfter)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if err != nil {
+			log.Log.Reason(err).Errorf("error computing the digest of %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		if _, err := fmt.Fprintf(w, "%s  %s\n", sum, filepath.Base(filePath)); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+	})
+}
@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("checksum", func() {
+	var (
+		raw      []byte
+		diskPath string
+	)
+
+	BeforeEach(func() {
+		raw = make([]byte, 3*1024*1024+17)
+		_, err := rand.Read(raw)
+		Expect(err).ToNot(HaveOccurred())
+		diskPath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+		Expect(os.WriteFile(diskPath, raw, 0600)).To(Succeed())
+	})
+
+	do := func(handler http.Handler, method, rangeHeader string) (*http.Response, []byte) {
+		server := httptest.NewServer(handler)
+		DeferCleanup(server.Close)
+		req, err := http.NewRequest(method, server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+		if rangeHeader != "" {
+			req.Header.Set("Range", rangeHeader)
+		}
+		res, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return res, body
+	}
+
+	It("should serve the SHA-256 digest of the volume in the format of sha256sum", func() {
+		sum := sha256.Sum256(raw)
+		handler := sha256Handler(diskPath)
+		Eventually(func() int {
+			res, _ := do(handler, http.MethodGet, "")
+			return res.StatusCode
+		}).Should(Equal(http.StatusOK))
+		res, body := do(handler, http.MethodGet, "")
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(string(body)).To(Equal(hex.EncodeToString(sum[:]) + "  disk.img\n"))
+
+		By("Serving the digest computed when the handler was created")
+		Expect(os.Remove(diskPath)).To(Succeed())
+		res, body = do(handler, http.MethodGet, "")
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(string(body)).To(Equal(hex.EncodeToString(sum[:]) + "  disk.img\n"))
+	})
+
+	It("should ask to retry later until the digest is computed", func() {
+		digest := &sha256Digest{done: make(chan struct{})}
+		handler := newSha256Handler(diskPath, digest)
+		res, _ := do(handler, http.MethodGet, "")
+		Expect(res.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		Expect(res.Header.Get("Retry-After")).To(Equal(sha256RetryAfter))
+
+		digest.digest = "digest"
+		close(digest.done)
+		res, body := do(handler, http.MethodGet, "")
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(string(body)).To(Equal("digest  disk.img\n"))
+	})
+
+	It("should return an error when the volume cannot be read", func() {
+		handler := sha256Handler(filepath.Join(GinkgoT().TempDir(), "missing.img"))
+		Eventually(func() int {
+			res, _ := do(handler, http.MethodGet, "")
+			return res.StatusCode
+		}).Should(Equal(http.StatusInternalServerError))
+	})
+
+	It("should only accept GET", func() {
+		res, _ := do(sha256Handler(diskPath), http.MethodPost, "")
+		Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should serve ranged reads of the raw volume", func() {
+		res, body := do(fileHandler(diskPath), http.MethodGet, "bytes=1048576-2097151")
+		Expect(res.StatusCode).To(Equal(http.StatusPartialContent))
+		Expect(res.Header.Get("Accept-Ranges")).To(Equal("bytes"))
+		Expect(res.Header.Get("Content-Range")).To(Equal("bytes 1048576-2097151/3145745"))
+		Expect(body).To(Equal(raw[1048576:2097152]))
+	})
+})
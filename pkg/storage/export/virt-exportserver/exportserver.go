@@ -90,7 +90,13 @@ type ExportServerConfig struct {
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	Qcow2Handler       func(string) http.Handler
+	Qcow2ZstdHandler   func(string) http.Handler
+	Sha256Handler      func(string) http.Handler
+	ExtentsHandler     func(string) http.Handler
+	ChangedDataHandler func(string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
+	OvaHandler         func([]export.VolumeInfo) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
 
 	PermissionChecker func(string) bool
@@ -140,6 +146,10 @@ func (s *exportServer) initHandler() {
 		mux.Handle(filepath.Join(internal, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getInternalBasePath, getInternalCAConfigMap)))
 		mux.Handle(filepath.Join(external, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getExternalBasePath, getExternalCAConfigMap)))
 	}
+	if s.Paths.OVAURI != "" {
+		mux.Handle(filepath.Join(internal, s.Paths.OVAURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes)))
+		mux.Handle(filepath.Join(external, s.Paths.OVAURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes)))
+	}
 	if s.Paths.SecretURI != "" {
 		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
@@ -188,6 +198,26 @@ func (s *exportServer) getHandlerMap(vi export.VolumeInfo) map[string]http.Handl
 		result[vi.RawGzURI] = s.GzipHandler(p)
 	}
 
+	if vi.Qcow2URI != "" {
+		result[vi.Qcow2URI] = s.Qcow2Handler(p)
+	}
+
+	if vi.Qcow2ZstdURI != "" {
+		result[vi.Qcow2ZstdURI] = s.Qcow2ZstdHandler(p)
+	}
+
+	if vi.SHA256URI != "" {
+		result[vi.SHA256URI] = s.Sha256Handler(p)
+	}
+
+	if vi.ChangedExtentsURI != "" {
+		result[vi.ChangedExtentsURI] = s.ExtentsHandler(p)
+	}
+
+	if vi.ChangedDataURI != "" {
+		result[vi.ChangedDataURI] = s.ChangedDataHandler(p)
+	}
+
 	return result
 }
 
@@ -247,10 +277,34 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.Qcow2Handler == nil {
+		es.Qcow2Handler = qcow2Handler
+	}
+
+	if es.Qcow2ZstdHandler == nil {
+		es.Qcow2ZstdHandler = qcow2ZstdHandler
+	}
+
+	if es.Sha256Handler == nil {
+		es.Sha256Handler = sha256Handler
+	}
+
+	if es.ExtentsHandler == nil {
+		es.ExtentsHandler = changedExtentsHandler
+	}
+
+	if es.ChangedDataHandler == nil {
+		es.ChangedDataHandler = changedDataHandler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
 
+	if es.OvaHandler == nil {
+		es.OvaHandler = ovaHandler
+	}
+
 	if es.TokenSecretHandler == nil {
 		es.TokenSecretHandler = secretHandler
 	}
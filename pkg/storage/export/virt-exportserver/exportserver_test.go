@@ -63,9 +63,27 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		Qcow2Handler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		Qcow2ZstdHandler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		Sha256Handler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ExtentsHandler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ChangedDataHandler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func([]export.VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		OvaHandler: func([]export.VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		TokenSecretHandler: func(tgf TokenGetterFunc) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
@@ -125,6 +143,31 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("qcow2 zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2ZstdURI: "/volume/v1/disk.zstd.qcow2"},
+			"/volume/v1/disk.zstd.qcow2",
+		),
+		Entry("sha256 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", SHA256URI: "/volume/v1/disk.img.sha256"},
+			"/volume/v1/disk.img.sha256",
+		),
+		Entry("changed extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ChangedExtentsURI: "/volume/v1/changed-extents.json"},
+			"/volume/v1/changed-extents.json",
+		),
+		Entry("changed data URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ChangedDataURI: "/volume/v1/changed.img"},
+			"/volume/v1/changed.img",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -180,6 +223,31 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("qcow2 zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2ZstdURI: "/volume/v1/disk.zstd.qcow2"},
+			"/volume/v1/disk.zstd.qcow2",
+		),
+		Entry("sha256 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", SHA256URI: "/volume/v1/disk.img.sha256"},
+			"/volume/v1/disk.img.sha256",
+		),
+		Entry("changed extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ChangedExtentsURI: "/volume/v1/changed-extents.json"},
+			"/volume/v1/changed-extents.json",
+		),
+		Entry("changed data URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ChangedDataURI: "/volume/v1/changed.img"},
+			"/volume/v1/changed.img",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -232,6 +300,31 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("qcow2 zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2ZstdURI: "/volume/v1/disk.zstd.qcow2"},
+			"/volume/v1/disk.zstd.qcow2",
+		),
+		Entry("sha256 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", SHA256URI: "/volume/v1/disk.img.sha256"},
+			"/volume/v1/disk.img.sha256",
+		),
+		Entry("changed extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ChangedExtentsURI: "/volume/v1/changed-extents.json"},
+			"/volume/v1/changed-extents.json",
+		),
+		Entry("changed data URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ChangedDataURI: "/volume/v1/changed.img"},
+			"/volume/v1/changed.img",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -283,6 +376,31 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("qcow2 zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2ZstdURI: "/volume/v1/disk.zstd.qcow2"},
+			"/volume/v1/disk.zstd.qcow2",
+		),
+		Entry("sha256 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", SHA256URI: "/volume/v1/disk.img.sha256"},
+			"/volume/v1/disk.img.sha256",
+		),
+		Entry("changed extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ChangedExtentsURI: "/volume/v1/changed-extents.json"},
+			"/volume/v1/changed-extents.json",
+		),
+		Entry("changed data URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ChangedDataURI: "/volume/v1/changed.img"},
+			"/volume/v1/changed.img",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -295,6 +413,27 @@ var _ = Describe("exportserver", func() {
 		),
 	)
 
+	DescribeTable("should handle the OVA URI", func(uri string, token string, statusCode int) {
+		es := newTestServer("foo")
+		es.Paths = &export.ServerPaths{OVAURI: "/manifests/ova"}
+		es.initHandler()
+
+		httpServer := httptest.NewServer(es.handler)
+		defer httpServer.Close()
+
+		req, err := http.NewRequest("GET", httpServer.URL+uri, nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("x-kubevirt-export-token", token)
+		res, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(statusCode))
+	},
+		Entry("internal", "/internal/manifests/ova", "foo", http.StatusOK),
+		Entry("external", "/external/manifests/ova", "foo", http.StatusOK),
+		Entry("with a bad token", "/internal/manifests/ova", "bar", http.StatusUnauthorized),
+	)
+
 	Context("Vm handler", func() {
 		var (
 			orgGetExportName       = getExportName
@@ -0,0 +1,606 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+const (
+	ovfNamespace     = "http://schemas.dmtf.org/ovf/envelope/1"
+	rasdNamespace    = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+	vssdNamespace    = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData"
+	vmwNamespace     = "http://www.vmware.com/schema/ovf"
+	ovfQcow2Format   = "http://www.gnome.org/~markmc/qcow-image-format.html"
+	ovfOtherOSID     = 1
+	ovfMemoryUnits   = "byte * 2^20"
+	ovfCPUUnits      = "hertz * 10^6"
+	ovfSystemType    = "kubevirt"
+	ovfFirmwareKey   = "firmware"
+	ovfSecureBootKey = "uefi.secureBoot.enabled"
+
+	// CIM resource types of the hardware items
+	resourceTypeCPU            = 3
+	resourceTypeMemory         = 4
+	resourceTypeSCSIController = 6
+	resourceTypeEthernet       = 10
+	resourceTypeCDDrive        = 15
+	resourceTypeDisk           = 17
+	resourceTypeStorage        = 20
+	resourceTypeUSBController  = 23
+)
+
+var errOVADiskNotExported = errors.New("the disk is not exported as a raw image")
+
+type ovfEnvelope struct {
+	XMLName        xml.Name          `xml:"Envelope"`
+	Namespace      string            `xml:"xmlns,attr"`
+	OvfNamespace   string            `xml:"xmlns:ovf,attr"`
+	RasdNamespace  string            `xml:"xmlns:rasd,attr"`
+	VssdNamespace  string            `xml:"xmlns:vssd,attr"`
+	VmwNamespace   string            `xml:"xmlns:vmw,attr"`
+	References     []ovfFile         `xml:"References>File"`
+	DiskSection    ovfDiskSection    `xml:"DiskSection"`
+	NetworkSection ovfNetworkSection `xml:"NetworkSection"`
+	VirtualSystem  ovfVirtualSystem  `xml:"VirtualSystem"`
+}
+
+type ovfFile struct {
+	Href string `xml:"ovf:href,attr"`
+	ID   string `xml:"ovf:id,attr"`
+	Size int64  `xml:"ovf:size,attr"`
+}
+
+type ovfDiskSection struct {
+	Info  string    `xml:"Info"`
+	Disks []ovfDisk `xml:"Disk"`
+}
+
+type ovfDisk struct {
+	Capacity                int64  `xml:"ovf:capacity,attr"`
+	CapacityAllocationUnits string `xml:"ovf:capacityAllocationUnits,attr"`
+	DiskID                  string `xml:"ovf:diskId,attr"`
+	FileRef                 string `xml:"ovf:fileRef,attr"`
+	Format                  string `xml:"ovf:format,attr"`
+	PopulatedSize           int64  `xml:"ovf:populatedSize,attr"`
+}
+
+type ovfNetworkSection struct {
+	Info     string       `xml:"Info"`
+	Networks []ovfNetwork `xml:"Network"`
+}
+
+type ovfNetwork struct {
+	Name        string `xml:"ovf:name,attr"`
+	Description string `xml:"Description"`
+}
+
+type ovfVirtualSystem struct {
+	ID                     string                    `xml:"ovf:id,attr"`
+	Info                   string                    `xml:"Info"`
+	Name                   string                    `xml:"Name"`
+	OperatingSystemSection ovfOperatingSystemSection `xml:"OperatingSystemSection"`
+	VirtualHardwareSection ovfVirtualHardwareSection `xml:"VirtualHardwareSection"`
+}
+
+type ovfOperatingSystemSection struct {
+	ID          int    `xml:"ovf:id,attr"`
+	Info        string `xml:"Info"`
+	Description string `xml:"Description"`
+}
+
+type ovfVirtualHardwareSection struct {
+	Info   string      `xml:"Info"`
+	System ovfSystem   `xml:"System"`
+	Items  []ovfItem   `xml:"Item"`
+	Config []ovfConfig `xml:"vmw:Config"`
+}
+
+type ovfSystem struct {
+	ElementName             string `xml:"vssd:ElementName"`
+	InstanceID              int    `xml:"vssd:InstanceID"`
+	VirtualSystemIdentifier string `xml:"vssd:VirtualSystemIdentifier"`
+	VirtualSystemType       string `xml:"vssd:VirtualSystemType"`
+}
+
+// ovfItem is a hardware item of the virtual system, the RASD elements have
+// to be kept in alphabetical order
+type ovfItem struct {
+	Address             string             `xml:"rasd:Address,omitempty"`
+	AddressOnParent     string             `xml:"rasd:AddressOnParent,omitempty"`
+	AllocationUnits     string             `xml:"rasd:AllocationUnits,omitempty"`
+	AutomaticAllocation *bool              `xml:"rasd:AutomaticAllocation,omitempty"`
+	Connection          string             `xml:"rasd:Connection,omitempty"`
+	Description         string             `xml:"rasd:Description,omitempty"`
+	ElementName         string             `xml:"rasd:ElementName"`
+	HostResource        string             `xml:"rasd:HostResource,omitempty"`
+	InstanceID          int                `xml:"rasd:InstanceID"`
+	Parent              int                `xml:"rasd:Parent,omitempty"`
+	ResourceSubType     string             `xml:"rasd:ResourceSubType,omitempty"`
+	ResourceType        int                `xml:"rasd:ResourceType"`
+	VirtualQuantity     int64              `xml:"rasd:VirtualQuantity,omitempty"`
+	CoresPerSocket      *ovfCoresPerSocket `xml:"vmw:CoresPerSocket,omitempty"`
+}
+
+type ovfCoresPerSocket struct {
+	Required bool   `xml:"ovf:required,attr"`
+	Value    uint32 `xml:",chardata"`
+}
+
+type ovfConfig struct {
+	Required bool   `xml:"ovf:required,attr"`
+	Key      string `xml:"vmw:key,attr"`
+	Value    string `xml:"vmw:value,attr"`
+}
+
+// ovaDisk is a disk of the VM packaged in the OVA as a qcow2 image
+type ovaDisk struct {
+	name     string
+	fileName string
+	bus      virtv1.DiskBus
+	cdrom    bool
+	file     *os.File
+	image    *qcow2Image
+}
+
+// ovaControllers holds the storage controllers of the disks, one for every
+// bus in use
+type ovaControllers struct {
+	items []ovfItem
+	ids   map[virtv1.DiskBus]int
+	units map[virtv1.DiskBus]int
+}
+
+// openOVADisks opens the exported volumes backing the disks of the VM. The
+// disks of volumes generated from the spec of the VM are skipped, any other
+// disk without an exported raw image like a container disk fails the export.
+func openOVADisks(vm *virtv1.VirtualMachine, vi []export.VolumeInfo) ([]*ovaDisk, error) {
+	var disks []*ovaDisk
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		volume := ovaVolume(vm, disk.Name)
+		if volume != nil && isGeneratedVolume(volume) {
+			log.Log.V(1).Infof("Disk %s is generated from the VM, skipping", disk.Name)
+			continue
+		}
+		info := ovaVolumeInfo(volume, vi)
+		if info == nil {
+			closeOVADisks(disks)
+			return nil, fmt.Errorf("%w: %s", errOVADiskNotExported, disk.Name)
+		}
+		filePath := info.Path
+		if fi, err := os.Stat(filePath); err != nil {
+			closeOVADisks(disks)
+			return nil, err
+		} else if fi.IsDir() {
+			filePath = filepath.Join(filePath, "disk.img")
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			closeOVADisks(disks)
+			return nil, err
+		}
+		ovaDisk := &ovaDisk{
+			name:     disk.Name,
+			fileName: fmt.Sprintf("%s-%s.qcow2", vm.Name, disk.Name),
+			bus:      diskBus(disk),
+			cdrom:    disk.CDRom != nil,
+			file:     f,
+		}
+		disks = append(disks, ovaDisk)
+		if ovaDisk.image, err = newQcow2Image(f, nil); err != nil {
+			closeOVADisks(disks)
+			return nil, err
+		}
+	}
+	return disks, nil
+}
+
+func closeOVADisks(disks []*ovaDisk) {
+	for _, disk := range disks {
+		disk.file.Close()
+	}
+}
+
+// ovaVolume returns the volume of a disk
+func ovaVolume(vm *virtv1.VirtualMachine, diskName string) *virtv1.Volume {
+	for i, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.Name == diskName {
+			return &vm.Spec.Template.Spec.Volumes[i]
+		}
+	}
+	return nil
+}
+
+// isGeneratedVolume tells whether the content of a volume is generated from
+// the spec of the VM when it starts, there is nothing to package for it
+func isGeneratedVolume(volume *virtv1.Volume) bool {
+	return volume.CloudInitNoCloud != nil || volume.CloudInitConfigDrive != nil ||
+		volume.ConfigMap != nil || volume.Secret != nil || volume.ServiceAccount != nil ||
+		volume.DownwardAPI != nil || volume.DownwardMetrics != nil || volume.Sysprep != nil ||
+		volume.EmptyDisk != nil
+}
+
+// ovaVolumeInfo returns the exported volume backing a disk, only the volumes
+// exported as raw images can be packaged
+func ovaVolumeInfo(volume *virtv1.Volume, vi []export.VolumeInfo) *export.VolumeInfo {
+	claimName := ""
+	if volume == nil {
+		return nil
+	} else if volume.DataVolume != nil {
+		claimName = volume.DataVolume.Name
+	} else if volume.PersistentVolumeClaim != nil {
+		claimName = volume.PersistentVolumeClaim.ClaimName
+	}
+	if claimName == "" {
+		return nil
+	}
+	for i := range vi {
+		if vi[i].ClaimName == claimName && vi[i].Qcow2URI != "" {
+			return &vi[i]
+		}
+	}
+	return nil
+}
+
+func diskBus(disk virtv1.Disk) virtv1.DiskBus {
+	var bus virtv1.DiskBus
+	switch {
+	case disk.Disk != nil:
+		bus = disk.Disk.Bus
+	case disk.LUN != nil:
+		bus = disk.LUN.Bus
+	case disk.CDRom != nil:
+		bus = disk.CDRom.Bus
+	}
+	if bus == "" {
+		bus = virtv1.DiskBusVirtio
+	}
+	return bus
+}
+
+// controller returns the instance ID of the controller of the bus and the
+// address of the next disk on it
+func (c *ovaControllers) controller(bus virtv1.DiskBus, nextID func() int) (int, int) {
+	id, ok := c.ids[bus]
+	if !ok {
+		id = nextID()
+		item := ovfItem{
+			ElementName:     fmt.Sprintf("%s controller", bus),
+			InstanceID:      id,
+			ResourceSubType: string(bus),
+			ResourceType:    resourceTypeStorage,
+		}
+		switch bus {
+		case virtv1.DiskBusSCSI:
+			item.ResourceSubType = "VirtualSCSI"
+			item.ResourceType = resourceTypeSCSIController
+		case virtv1.DiskBusSATA:
+			item.ResourceSubType = "AHCI"
+		case virtv1.DiskBusUSB:
+			item.ResourceType = resourceTypeUSBController
+		}
+		c.items = append(c.items, item)
+		c.ids[bus] = id
+	}
+	unit := c.units[bus]
+	c.units[bus]++
+	return id, unit
+}
+
+func vmVCPUs(spec *virtv1.VirtualMachineInstanceSpec) int64 {
+	if cpu := spec.Domain.CPU; cpu != nil {
+		vCPUs := int64(max(cpu.Sockets, 1)) * int64(max(cpu.Cores, 1)) * int64(max(cpu.Threads, 1))
+		if cpu.Sockets != 0 || cpu.Cores != 0 || cpu.Threads != 0 {
+			return vCPUs
+		}
+	}
+	for _, resources := range []corev1.ResourceList{spec.Domain.Resources.Limits, spec.Domain.Resources.Requests} {
+		if quantity, ok := resources[corev1.ResourceCPU]; ok {
+			return max(quantity.Value(), 1)
+		}
+	}
+	return 1
+}
+
+func vmMemoryMiB(spec *virtv1.VirtualMachineInstanceSpec) (int64, error) {
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return ceilDiv(spec.Domain.Memory.Guest.Value(), 1<<20), nil
+	}
+	if quantity, ok := spec.Domain.Resources.Requests[corev1.ResourceMemory]; ok {
+		return ceilDiv(quantity.Value(), 1<<20), nil
+	}
+	return 0, fmt.Errorf("the memory of the VM is not set")
+}
+
+// ovfNetworkName returns the name of the network an interface is connected to
+func ovfNetworkName(spec *virtv1.VirtualMachineInstanceSpec, iface virtv1.Interface) string {
+	for _, network := range spec.Networks {
+		if network.Name != iface.Name {
+			continue
+		}
+		if network.Multus != nil {
+			return network.Multus.NetworkName
+		}
+		if network.Pod != nil {
+			return "pod"
+		}
+	}
+	return iface.Name
+}
+
+// ovfDescriptor derives the OVF descriptor of the VM from its spec, the
+// disks are described as qcow2 images
+func ovfDescriptor(vm *virtv1.VirtualMachine, disks []*ovaDisk) ([]byte, error) {
+	spec := &vm.Spec.Template.Spec
+	memory, err := vmMemoryMiB(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := &ovfEnvelope{
+		Namespace:     ovfNamespace,
+		OvfNamespace:  ovfNamespace,
+		RasdNamespace: rasdNamespace,
+		VssdNamespace: vssdNamespace,
+		VmwNamespace:  vmwNamespace,
+		References:    []ovfFile{},
+		DiskSection: ovfDiskSection{
+			Info: "Virtual disk information",
+		},
+		NetworkSection: ovfNetworkSection{
+			Info: "The list of logical networks",
+		},
+		VirtualSystem: ovfVirtualSystem{
+			ID:   vm.Name,
+			Info: "A virtual machine",
+			Name: vm.Name,
+			OperatingSystemSection: ovfOperatingSystemSection{
+				ID:          ovfOtherOSID,
+				Info:        "The kind of installed guest operating system",
+				Description: "Other",
+			},
+			VirtualHardwareSection: ovfVirtualHardwareSection{
+				Info: "Virtual hardware requirements",
+				System: ovfSystem{
+					ElementName:             "Virtual Hardware Family",
+					VirtualSystemIdentifier: vm.Name,
+					VirtualSystemType:       ovfSystemType,
+				},
+			},
+		},
+	}
+	hardware := &envelope.VirtualSystem.VirtualHardwareSection
+
+	instanceID := 0
+	nextID := func() int {
+		instanceID++
+		return instanceID
+	}
+
+	vCPUs := vmVCPUs(spec)
+	cpuItem := ovfItem{
+		AllocationUnits: ovfCPUUnits,
+		Description:     "Number of Virtual CPUs",
+		ElementName:     fmt.Sprintf("%d virtual CPU(s)", vCPUs),
+		InstanceID:      nextID(),
+		ResourceType:    resourceTypeCPU,
+		VirtualQuantity: vCPUs,
+	}
+	if cpu := spec.Domain.CPU; cpu != nil && cpu.Cores > 0 {
+		cpuItem.CoresPerSocket = &ovfCoresPerSocket{Value: cpu.Cores * max(cpu.Threads, 1)}
+	}
+	hardware.Items = append(hardware.Items, cpuItem, ovfItem{
+		AllocationUnits: ovfMemoryUnits,
+		Description:     "Memory Size",
+		ElementName:     fmt.Sprintf("%dMB of memory", memory),
+		InstanceID:      nextID(),
+		ResourceType:    resourceTypeMemory,
+		VirtualQuantity: memory,
+	})
+
+	controllers := &ovaControllers{ids: map[virtv1.DiskBus]int{}, units: map[virtv1.DiskBus]int{}}
+	var diskItems []ovfItem
+	for _, disk := range disks {
+		fileID := "file-" + disk.name
+		envelope.References = append(envelope.References, ovfFile{
+			Href: disk.fileName,
+			ID:   fileID,
+			Size: disk.image.length,
+		})
+		envelope.DiskSection.Disks = append(envelope.DiskSection.Disks, ovfDisk{
+			Capacity:                disk.image.size,
+			CapacityAllocationUnits: "byte",
+			DiskID:                  disk.name,
+			FileRef:                 fileID,
+			Format:                  ovfQcow2Format,
+			PopulatedSize:           disk.image.populatedSize(),
+		})
+		parent, unit := controllers.controller(disk.bus, nextID)
+		item := ovfItem{
+			AddressOnParent: strconv.Itoa(unit),
+			ElementName:     disk.name,
+			HostResource:    "ovf:/disk/" + disk.name,
+			InstanceID:      nextID(),
+			Parent:          parent,
+			ResourceType:    resourceTypeDisk,
+		}
+		if disk.cdrom {
+			item.ResourceType = resourceTypeCDDrive
+		}
+		diskItems = append(diskItems, item)
+	}
+	hardware.Items = append(hardware.Items, controllers.items...)
+	hardware.Items = append(hardware.Items, diskItems...)
+
+	networks := map[string]bool{}
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		network := ovfNetworkName(spec, iface)
+		if !networks[network] {
+			networks[network] = true
+			envelope.NetworkSection.Networks = append(envelope.NetworkSection.Networks, ovfNetwork{
+				Name:        network,
+				Description: fmt.Sprintf("The %s network", network),
+			})
+		}
+		model := iface.Model
+		if model == "" {
+			model = virtv1.VirtIO
+		}
+		hardware.Items = append(hardware.Items, ovfItem{
+			Address:             iface.MacAddress,
+			AutomaticAllocation: pointer.P(true),
+			Connection:          network,
+			ElementName:         iface.Name,
+			InstanceID:          nextID(),
+			ResourceSubType:     model,
+			ResourceType:        resourceTypeEthernet,
+		})
+	}
+
+	firmware := ovfConfig{Key: ovfFirmwareKey, Value: "bios"}
+	if fw := spec.Domain.Firmware; fw != nil && fw.Bootloader != nil && fw.Bootloader.EFI != nil {
+		firmware.Value = "efi"
+		secureBoot := fw.Bootloader.EFI.SecureBoot == nil || *fw.Bootloader.EFI.SecureBoot
+		hardware.Config = append(hardware.Config, firmware, ovfConfig{
+			Key:   ovfSecureBootKey,
+			Value: strconv.FormatBool(secureBoot),
+		})
+	} else {
+		hardware.Config = append(hardware.Config, firmware)
+	}
+
+	descriptor, err := xml.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), descriptor...), nil
+}
+
+// writeOVA writes the OVA archive of the VM. The descriptor comes first and
+// the manifest holding the digests of the files second as required, so the
+// disks are read once to compute their digests before they are written.
+func writeOVA(w io.Writer, vmName string, descriptor []byte, disks []*ovaDisk) error {
+	manifest := &bytes.Buffer{}
+	descriptorDigest := sha256.Sum256(descriptor)
+	fmt.Fprintf(manifest, "SHA256(%s.ovf)= %s\n", vmName, hex.EncodeToString(descriptorDigest[:]))
+	for _, disk := range disks {
+		digest := sha256.New()
+		if _, err := disk.image.WriteTo(digest); err != nil {
+			return err
+		}
+		fmt.Fprintf(manifest, "SHA256(%s)= %s\n", disk.fileName, hex.EncodeToString(digest.Sum(nil)))
+	}
+
+	tw := tar.NewWriter(w)
+	modTime := time.Now()
+	writeFile := func(name string, size int64, writeTo func(io.Writer) error) error {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    size,
+			ModTime: modTime,
+		}); err != nil {
+			return err
+		}
+		return writeTo(tw)
+	}
+	writeBytes := func(data []byte) func(io.Writer) error {
+		return func(w io.Writer) error {
+			_, err := w.Write(data)
+			return err
+		}
+	}
+
+	if err := writeFile(vmName+".ovf", int64(len(descriptor)), writeBytes(descriptor)); err != nil {
+		return err
+	}
+	if err := writeFile(vmName+".mf", int64(manifest.Len()), writeBytes(manifest.Bytes())); err != nil {
+		return err
+	}
+	for _, disk := range disks {
+		err := writeFile(disk.fileName, disk.image.length, func(w io.Writer) error {
+			_, err := disk.image.WriteTo(w)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// ovaHandler serves the VM along with its disks as an OVA archive
+func ovaHandler(vi []export.VolumeInfo) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		vm := getExpandedVM()
+		if vm == nil {
+			log.Log.Error("error getting VM definition")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		disks, err := openOVADisks(vm, vi)
+		if errors.Is(err, errOVADiskNotExported) {
+			log.Log.Reason(err).Error("error packaging the disks of the VM")
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			log.Log.Reason(err).Error("error reading the disks of the VM")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer closeOVADisks(disks)
+		descriptor, err := ovfDescriptor(vm, disks)
+		if err != nil {
+			log.Log.Reason(err).Error("error generating the OVF descriptor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-tar")
+		if err := writeOVA(w, vm.Name, descriptor, disks); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+	})
+}
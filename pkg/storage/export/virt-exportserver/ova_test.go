@@ -0,0 +1,325 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+// testOVF holds the parts of the OVF descriptor checked by the tests
+type testOVF struct {
+	Files []struct {
+		Href string `xml:"href,attr"`
+		Size int64  `xml:"size,attr"`
+	} `xml:"References>File"`
+	Disks []struct {
+		Capacity int64  `xml:"capacity,attr"`
+		DiskID   string `xml:"diskId,attr"`
+		Format   string `xml:"format,attr"`
+	} `xml:"DiskSection>Disk"`
+	Networks []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"NetworkSection>Network"`
+	Items []struct {
+		Address         string `xml:"Address"`
+		AddressOnParent string `xml:"AddressOnParent"`
+		Connection      string `xml:"Connection"`
+		ElementName     string `xml:"ElementName"`
+		HostResource    string `xml:"HostResource"`
+		InstanceID      int    `xml:"InstanceID"`
+		Parent          int    `xml:"Parent"`
+		ResourceSubType string `xml:"ResourceSubType"`
+		ResourceType    int    `xml:"ResourceType"`
+		VirtualQuantity int64  `xml:"VirtualQuantity"`
+	} `xml:"VirtualSystem>VirtualHardwareSection>Item"`
+	Config []struct {
+		Key   string `xml:"key,attr"`
+		Value string `xml:"value,attr"`
+	} `xml:"VirtualSystem>VirtualHardwareSection>Config"`
+}
+
+var _ = Describe("ova", func() {
+	const diskSize = 10*qcow2ClusterSize + 100
+
+	var (
+		orgGetExpandedVM = getExpandedVM
+		vm               *virtv1.VirtualMachine
+		volumes          []export.VolumeInfo
+		expected         map[int64][]byte
+	)
+
+	createDisk := func(claimName string, data []byte) export.VolumeInfo {
+		dir := filepath.Join(GinkgoT().TempDir(), claimName)
+		Expect(os.Mkdir(dir, 0700)).To(Succeed())
+		f, err := os.Create(filepath.Join(dir, "disk.img"))
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(f.Truncate(diskSize)).To(Succeed())
+		_, err = f.WriteAt(data, 0)
+		Expect(err).ToNot(HaveOccurred())
+		return export.VolumeInfo{
+			Path:      dir,
+			Qcow2URI:  fmt.Sprintf("/volumes/%s/disk.qcow2", claimName),
+			ClaimName: claimName,
+		}
+	}
+
+	// readOVA returns the files of the archive in order
+	readOVA := func(handler http.Handler) ([]string, map[string][]byte) {
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		res, err := http.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(res.Header.Get("Content-Type")).To(Equal("application/x-tar"))
+
+		var names []string
+		files := make(map[string][]byte)
+		tr := tar.NewReader(res.Body)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).ToNot(HaveOccurred())
+			data, err := io.ReadAll(tr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(HaveLen(int(hdr.Size)))
+			names = append(names, hdr.Name)
+			files[hdr.Name] = data
+		}
+		return names, files
+	}
+
+	BeforeEach(func() {
+		vm = &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvm",
+				Namespace: testNamespace,
+			},
+			Spec: virtv1.VirtualMachineSpec{
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+					Spec: virtv1.VirtualMachineInstanceSpec{
+						Domain: virtv1.DomainSpec{
+							CPU: &virtv1.CPU{Sockets: 2, Cores: 2},
+							Memory: &virtv1.Memory{
+								Guest: pointer.P(resource.MustParse("2Gi")),
+							},
+							Firmware: &virtv1.Firmware{
+								Bootloader: &virtv1.Bootloader{
+									EFI: &virtv1.EFI{SecureBoot: pointer.P(false)},
+								},
+							},
+							Devices: virtv1.Devices{
+								Disks: []virtv1.Disk{
+									{Name: "rootdisk", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio}}},
+									{Name: "datadisk", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusSATA}}},
+									{Name: "cloudinit", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio}}},
+								},
+								Interfaces: []virtv1.Interface{
+									{Name: "default", MacAddress: "02:00:00:00:00:01"},
+									{Name: "secondary", Model: "e1000e"},
+								},
+							},
+						},
+						Networks: []virtv1.Network{
+							{Name: "default", NetworkSource: virtv1.NetworkSource{Pod: &virtv1.PodNetwork{}}},
+							{Name: "secondary", NetworkSource: virtv1.NetworkSource{Multus: &virtv1.MultusNetwork{NetworkName: "vlan10"}}},
+						},
+						Volumes: []virtv1.Volume{
+							{Name: "rootdisk", VolumeSource: virtv1.VolumeSource{DataVolume: &virtv1.DataVolumeSource{Name: "root-dv"}}},
+							{Name: "datadisk", VolumeSource: virtv1.VolumeSource{PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "data-pvc"},
+							}}},
+							{Name: "cloudinit", VolumeSource: virtv1.VolumeSource{CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{UserData: "#cloud-config"}}},
+						},
+					},
+				},
+			},
+		}
+		getExpandedVM = func() *virtv1.VirtualMachine {
+			return vm.DeepCopy()
+		}
+
+		rootData := bytes.Repeat([]byte("kubevirt"), qcow2ClusterSize/8)
+		expected = map[int64][]byte{0: rootData}
+		volumes = []export.VolumeInfo{
+			createDisk("root-dv", rootData),
+			createDisk("data-pvc", nil),
+		}
+	})
+
+	AfterEach(func() {
+		getExpandedVM = orgGetExpandedVM
+	})
+
+	It("should package the VM and its disks", func() {
+		names, files := readOVA(ovaHandler(volumes))
+		Expect(names).To(Equal([]string{"testvm.ovf", "testvm.mf", "testvm-rootdisk.qcow2", "testvm-datadisk.qcow2"}))
+
+		By("Checking the manifest holds the digests of the files")
+		var manifest []string
+		for _, name := range []string{"testvm.ovf", "testvm-rootdisk.qcow2", "testvm-datadisk.qcow2"} {
+			digest := sha256.Sum256(files[name])
+			manifest = append(manifest, fmt.Sprintf("SHA256(%s)= %s", name, hex.EncodeToString(digest[:])))
+		}
+		Expect(strings.Split(strings.TrimSpace(string(files["testvm.mf"])), "\n")).To(Equal(manifest))
+
+		By("Checking the disks")
+		Expect(readQcow2(files["testvm-rootdisk.qcow2"])).To(Equal(expected))
+		Expect(readQcow2(files["testvm-datadisk.qcow2"])).To(BeEmpty())
+
+		By("Checking the descriptor")
+		ovf := &testOVF{}
+		Expect(xml.Unmarshal(files["testvm.ovf"], ovf)).To(Succeed())
+		Expect(ovf.Files).To(HaveLen(2))
+		Expect(ovf.Files[0].Href).To(Equal("testvm-rootdisk.qcow2"))
+		Expect(ovf.Files[0].Size).To(BeEquivalentTo(len(files["testvm-rootdisk.qcow2"])))
+		Expect(ovf.Files[1].Href).To(Equal("testvm-datadisk.qcow2"))
+		Expect(ovf.Disks).To(HaveLen(2))
+		for _, disk := range ovf.Disks {
+			Expect(disk.Capacity).To(BeEquivalentTo(diskSize))
+			Expect(disk.Format).To(Equal(ovfQcow2Format))
+		}
+		Expect(ovf.Networks).To(HaveLen(2))
+		Expect(ovf.Networks[0].Name).To(Equal("pod"))
+		Expect(ovf.Networks[1].Name).To(Equal("vlan10"))
+
+		items := make(map[string]int)
+		for i, item := range ovf.Items {
+			items[item.ElementName] = i
+		}
+		cpu := ovf.Items[items["4 virtual CPU(s)"]]
+		Expect(cpu.ResourceType).To(Equal(resourceTypeCPU))
+		Expect(cpu.VirtualQuantity).To(BeEquivalentTo(4))
+		memory := ovf.Items[items["2048MB of memory"]]
+		Expect(memory.ResourceType).To(Equal(resourceTypeMemory))
+		Expect(memory.VirtualQuantity).To(BeEquivalentTo(2048))
+
+		rootDisk := ovf.Items[items["rootdisk"]]
+		Expect(rootDisk.ResourceType).To(Equal(resourceTypeDisk))
+		Expect(rootDisk.HostResource).To(Equal("ovf:/disk/rootdisk"))
+		Expect(ovf.Items[items["virtio controller"]].InstanceID).To(Equal(rootDisk.Parent))
+		dataDisk := ovf.Items[items["datadisk"]]
+		Expect(dataDisk.AddressOnParent).To(Equal("0"))
+		sataController := ovf.Items[items["sata controller"]]
+		Expect(sataController.InstanceID).To(Equal(dataDisk.Parent))
+		Expect(sataController.ResourceSubType).To(Equal("AHCI"))
+
+		nic := ovf.Items[items["default"]]
+		Expect(nic.ResourceType).To(Equal(resourceTypeEthernet))
+		Expect(nic.ResourceSubType).To(Equal(virtv1.VirtIO))
+		Expect(nic.Connection).To(Equal("pod"))
+		Expect(nic.Address).To(Equal("02:00:00:00:00:01"))
+		secondary := ovf.Items[items["secondary"]]
+		Expect(secondary.ResourceSubType).To(Equal("e1000e"))
+		Expect(secondary.Connection).To(Equal("vlan10"))
+
+		Expect(ovf.Config).To(HaveLen(2))
+		Expect(ovf.Config[0].Key).To(Equal(ovfFirmwareKey))
+		Expect(ovf.Config[0].Value).To(Equal("efi"))
+		Expect(ovf.Config[1].Key).To(Equal(ovfSecureBootKey))
+		Expect(ovf.Config[1].Value).To(Equal("false"))
+	})
+
+	It("should derive the vCPUs and memory from the resources of the VM", func() {
+		domain := &vm.Spec.Template.Spec.Domain
+		domain.CPU = nil
+		domain.Memory = nil
+		domain.Firmware = nil
+		domain.Resources.Requests = k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("3"),
+			k8sv1.ResourceMemory: resource.MustParse("1000Mi"),
+		}
+
+		_, files := readOVA(ovaHandler(volumes))
+		ovf := &testOVF{}
+		Expect(xml.Unmarshal(files["testvm.ovf"], ovf)).To(Succeed())
+		Expect(ovf.Items[0].VirtualQuantity).To(BeEquivalentTo(3))
+		Expect(ovf.Items[1].VirtualQuantity).To(BeEquivalentTo(1000))
+		Expect(ovf.Config).To(HaveLen(1))
+		Expect(ovf.Config[0].Value).To(Equal("bios"))
+	})
+
+	It("should fail without the memory of the VM", func() {
+		vm.Spec.Template.Spec.Domain.Memory = nil
+		server := httptest.NewServer(ovaHandler(volumes))
+		defer server.Close()
+
+		res, err := http.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusInternalServerError))
+	})
+
+	It("should fail when a disk is not exported", func() {
+		vm.Spec.Template.Spec.Domain.Devices.Disks = append(vm.Spec.Template.Spec.Domain.Devices.Disks,
+			virtv1.Disk{Name: "containerdisk"})
+		vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, virtv1.Volume{
+			Name:         "containerdisk",
+			VolumeSource: virtv1.VolumeSource{ContainerDisk: &virtv1.ContainerDiskSource{Image: "disk:latest"}},
+		})
+		server := httptest.NewServer(ovaHandler(volumes))
+		defer server.Close()
+
+		res, err := http.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+		body, err := io.ReadAll(res.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring("containerdisk"))
+	})
+
+	It("should reject requests other than GET", func() {
+		server := httptest.NewServer(ovaHandler(volumes))
+		defer server.Close()
+
+		res, err := http.Post(server.URL, "application/octet-stream", nil)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,533 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/sys/unix"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	qcow2ClusterBits = 16
+	qcow2ClusterSize = 1 << qcow2ClusterBits
+	// Entries of an L2 table and of a refcount block with 16 bit refcounts
+	qcow2L2Entries       = qcow2ClusterSize / 8
+	qcow2RefcountEntries = qcow2ClusterSize / 2
+	qcow2SectorSize      = 512
+
+	qcow2Magic         = 0x514649fb
+	qcow2Version       = 3
+	qcow2RefcountOrder = 4
+	qcow2HeaderLength  = 112
+
+	qcow2CompressionTypeZstd         = 1
+	qcow2IncompatibleCompressionType = 1 << 3
+
+	qcow2OflagCopied            = 1 << 63
+	qcow2OflagCompressed        = 1 << 62
+	qcow2CompressedSectorsShift = 62 - (qcow2ClusterBits - 8)
+
+	// Encoded size recorded for the clusters stored as is, zero clusters are
+	// recorded with 0 and compressed clusters with their compressed size
+	qcow2RawCluster = math.MaxUint16
+)
+
+var (
+	zeroCluster = make([]byte, qcow2ClusterSize)
+
+	errQcow2DiskChanged = errors.New("disk changed while it was exported")
+)
+
+// qcow2Image is a qcow2 image of a raw disk, streamed without any scratch
+// space. Only the data extents reported by the filesystem are mapped. As the
+// metadata precedes the data, the size every cluster takes is recorded to lay
+// the image out before anything is sent, writing the image then follows the
+// recorded sizes instead of laying it out again. Clusters filled with zeros
+// are not allocated and, when an encoder is passed, compressed clusters are
+// packed at byte granularity. Each L2 table follows the data clusters it
+// maps.
+type qcow2Image struct {
+	file    *os.File
+	size    int64
+	encoder *zstd.Encoder
+
+	// extents holds the ranges of disk clusters containing data, split at
+	// the boundaries of the L2 tables
+	extents []qcow2Extent
+	// encodedSizes holds the size recorded for every mapped cluster, in the
+	// order of the extents
+	encodedSizes []uint16
+	// tables holds the L2 tables placed by the layout, their offsets are
+	// relative to the data area
+	tables []qcow2Table
+	// dataRefcounts holds the refcounts of the host clusters of the data area
+	dataRefcounts     []uint16
+	populatedClusters int64
+
+	l1Size                int64
+	refcountTableClusters int64
+	refcountBlocks        int64
+	l1Offset              int64
+	refcountTableOffset   int64
+	refcountBlocksOffset  int64
+	dataOffset            int64
+	length                int64
+}
+
+// qcow2Extent is the range of disk clusters [start, end)
+type qcow2Extent struct {
+	start, end int64
+}
+
+// qcow2Table is an L2 table placed at offset along with the extents it maps
+type qcow2Table struct {
+	l1Index int64
+	offset  int64
+	extents []qcow2Extent
+}
+
+type qcow2Writer struct {
+	w      *bufio.Writer
+	offset int64
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+func alignCluster(offset int64) int64 {
+	return ceilDiv(offset, qcow2ClusterSize) * qcow2ClusterSize
+}
+
+// newQcow2Image finds the data extents of the disk and lays the image out
+func newQcow2Image(file *os.File, encoder *zstd.Encoder) (*qcow2Image, error) {
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	img := &qcow2Image{
+		file:    file,
+		size:    size,
+		encoder: encoder,
+	}
+
+	err = forEachDataExtent(file, size, func(start, end int64) error {
+		img.addExtent(start/qcow2ClusterSize, ceilDiv(end, qcow2ClusterSize))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := img.encodeClusters(); err != nil {
+		return nil, err
+	}
+
+	dataLength, err := img.packTables(0, func(_, hostOffset int64, _ uint16) error {
+		img.reference(hostOffset)
+		img.populatedClusters++
+		return nil
+	}, func(table *qcow2Table, _ []uint64) error {
+		img.tables = append(img.tables, *table)
+		img.reference(table.offset)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	img.layout(dataLength)
+	return img, nil
+}
+
+// addExtent maps the clusters [start, end), extents are reported in order
+// but may share a cluster with the previous one
+func (img *qcow2Image) addExtent(start, end int64) {
+	if n := len(img.extents); n > 0 {
+		start = max(start, img.extents[n-1].end)
+	}
+	for start < end {
+		tableEnd := (start/qcow2L2Entries + 1) * qcow2L2Entries
+		img.extents = append(img.extents, qcow2Extent{start: start, end: min(end, tableEnd)})
+		start = min(end, tableEnd)
+	}
+}
+
+// reference counts a reference to the host cluster of the data area holding
+// offset
+func (img *qcow2Image) reference(offset int64) {
+	cluster := offset / qcow2ClusterSize
+	for int64(len(img.dataRefcounts)) <= cluster {
+		img.dataRefcounts = append(img.dataRefcounts, 0)
+	}
+	img.dataRefcounts[cluster]++
+}
+
+// populatedSize returns the size of the clusters of the disk holding data
+// other than zeros
+func (img *qcow2Image) populatedSize() int64 {
+	return img.populatedClusters * qcow2ClusterSize
+}
+
+// forEachDataExtent calls fn with the extents of the file containing data,
+// as reported by the filesystem
+func forEachDataExtent(file *os.File, size int64, fn func(start, end int64) error) error {
+	for offset := int64(0); offset < size; {
+		start, err := file.Seek(offset, unix.SEEK_DATA)
+		if errors.Is(err, unix.ENXIO) {
+			return nil
+		}
+		if errors.Is(err, unix.EINVAL) {
+			// Holes are not reported, all the remaining data is mapped
+			return fn(offset, size)
+		}
+		if err != nil {
+			return err
+		}
+		end, err := file.Seek(start, unix.SEEK_HOLE)
+		if err != nil {
+			return err
+		}
+		if err := fn(start, min(end, size)); err != nil {
+			return err
+		}
+		offset = end
+	}
+	return nil
+}
+
+func (img *qcow2Image) readCluster(cluster int64, buf []byte) error {
+	n, err := img.file.ReadAt(buf, cluster*qcow2ClusterSize)
+	if err == io.EOF {
+		// The last cluster is padded with zeros
+		clear(buf[n:])
+		return nil
+	}
+	return err
+}
+
+// encodeClusters reads the mapped clusters to record the size each of them
+// takes in the image
+func (img *qcow2Image) encodeClusters() error {
+	buf := make([]byte, qcow2ClusterSize)
+	var compressed []byte
+	for _, extent := range img.extents {
+		for cluster := extent.start; cluster < extent.end; cluster++ {
+			if err := img.readCluster(cluster, buf); err != nil {
+				return err
+			}
+			data, isCompressed := img.encodeCluster(buf, &compressed)
+			switch {
+			case data == nil:
+				img.encodedSizes = append(img.encodedSizes, 0)
+			case isCompressed:
+				img.encodedSizes = append(img.encodedSizes, uint16(len(data)))
+			default:
+				img.encodedSizes = append(img.encodedSizes, qcow2RawCluster)
+			}
+		}
+	}
+	return nil
+}
+
+// layout places the header, the L1 table and the refcount structures ahead
+// of the data area of dataLength bytes
+func (img *qcow2Image) layout(dataLength int64) {
+	img.l1Size = ceilDiv(ceilDiv(img.size, qcow2ClusterSize), qcow2L2Entries)
+	l1Clusters := ceilDiv(img.l1Size*8, qcow2ClusterSize)
+	dataClusters := dataLength / qcow2ClusterSize
+
+	// The refcount blocks have to cover themselves as well
+	img.refcountTableClusters, img.refcountBlocks = 1, 1
+	for {
+		total := 1 + l1Clusters + img.refcountTableClusters + img.refcountBlocks + dataClusters
+		refcountBlocks := ceilDiv(total, qcow2RefcountEntries)
+		refcountTableClusters := ceilDiv(refcountBlocks*8, qcow2ClusterSize)
+		if refcountBlocks == img.refcountBlocks && refcountTableClusters == img.refcountTableClusters {
+			break
+		}
+		img.refcountBlocks, img.refcountTableClusters = refcountBlocks, refcountTableClusters
+	}
+
+	img.l1Offset = qcow2ClusterSize
+	img.refcountTableOffset = img.l1Offset + l1Clusters*qcow2ClusterSize
+	img.refcountBlocksOffset = img.refcountTableOffset + img.refcountTableClusters*qcow2ClusterSize
+	img.dataOffset = img.refcountBlocksOffset + img.refcountBlocks*qcow2ClusterSize
+	img.length = img.dataOffset + dataLength
+}
+
+// packTables packs the mapped clusters starting at offset following their
+// recorded sizes. Compressed clusters are packed at byte granularity without
+// crossing host clusters, the other clusters and the L2 tables take host
+// clusters of their own and zero clusters are not allocated. place is called
+// with every allocated cluster and placeTable with every L2 table once its
+// clusters are placed, tables mapping only zero clusters are left out. It
+// returns the offset following the last table.
+func (img *qcow2Image) packTables(offset int64,
+	place func(cluster, hostOffset int64, encodedSize uint16) error,
+	placeTable func(table *qcow2Table, entries []uint64) error) (int64, error) {
+	entries := make([]uint64, qcow2L2Entries)
+	next := 0
+	for first := 0; first < len(img.extents); {
+		table := &qcow2Table{l1Index: img.extents[first].start / qcow2L2Entries}
+		last := first
+		for last < len(img.extents) && img.extents[last].start/qcow2L2Entries == table.l1Index {
+			last++
+		}
+		table.extents, first = img.extents[first:last], last
+
+		clear(entries)
+		allocated := false
+		for _, extent := range table.extents {
+			for cluster := extent.start; cluster < extent.end; cluster++ {
+				encodedSize := img.encodedSizes[next]
+				next++
+				dataSize := int64(encodedSize)
+				switch encodedSize {
+				case 0:
+					entries[cluster%qcow2L2Entries] = qcow2OflagZero
+					continue
+				case qcow2RawCluster:
+					dataSize = qcow2ClusterSize
+					offset = alignCluster(offset)
+					entries[cluster%qcow2L2Entries] = uint64(offset) | qcow2OflagCopied
+				default:
+					if offset%qcow2ClusterSize+dataSize > qcow2ClusterSize {
+						offset = alignCluster(offset)
+					}
+					sectors := (offset+dataSize-1)/qcow2SectorSize - offset/qcow2SectorSize
+					entries[cluster%qcow2L2Entries] = uint64(offset) | qcow2OflagCompressed | uint64(sectors)<<qcow2CompressedSectorsShift
+				}
+				if err := place(cluster, offset, encodedSize); err != nil {
+					return offset, err
+				}
+				offset += dataSize
+				allocated = true
+			}
+		}
+		if !allocated {
+			continue
+		}
+
+		offset = alignCluster(offset)
+		table.offset = offset
+		if err := placeTable(table, entries); err != nil {
+			return offset, err
+		}
+		offset += qcow2ClusterSize
+	}
+	return offset, nil
+}
+
+func (img *qcow2Image) header() []byte {
+	header := make([]byte, qcow2HeaderLength)
+	binary.BigEndian.PutUint32(header[0:], qcow2Magic)
+	binary.BigEndian.PutUint32(header[4:], qcow2Version)
+	binary.BigEndian.PutUint32(header[20:], qcow2ClusterBits)
+	binary.BigEndian.PutUint64(header[24:], uint64(img.size))
+	binary.BigEndian.PutUint32(header[36:], uint32(img.l1Size))
+	binary.BigEndian.PutUint64(header[40:], uint64(img.l1Offset))
+	binary.BigEndian.PutUint64(header[48:], uint64(img.refcountTableOffset))
+	binary.BigEndian.PutUint32(header[56:], uint32(img.refcountTableClusters))
+	if img.encoder != nil {
+		binary.BigEndian.PutUint64(header[72:], qcow2IncompatibleCompressionType)
+		header[104] = qcow2CompressionTypeZstd
+	}
+	binary.BigEndian.PutUint32(header[96:], qcow2RefcountOrder)
+	binary.BigEndian.PutUint32(header[100:], qcow2HeaderLength)
+	return header
+}
+
+// WriteTo writes the image following the layout. The allocated clusters are
+// read again, a compressed cluster no longer fitting the size recorded for it
+// fails the export.
+func (img *qcow2Image) WriteTo(w io.Writer) (int64, error) {
+	qw := &qcow2Writer{w: bufio.NewWriterSize(w, qcow2ClusterSize)}
+
+	l1 := make([]uint64, img.l1Size)
+	for _, table := range img.tables {
+		l1[table.l1Index] = uint64(img.dataOffset+table.offset) | qcow2OflagCopied
+	}
+	// The metadata clusters are used once
+	refcounts := make([]uint16, img.dataOffset/qcow2ClusterSize, img.length/qcow2ClusterSize)
+	for i := range refcounts {
+		refcounts[i] = 1
+	}
+	refcounts = append(refcounts, img.dataRefcounts...)
+	refcountTable := make([]uint64, img.refcountBlocks)
+	for i := range refcountTable {
+		refcountTable[i] = uint64(img.refcountBlocksOffset + int64(i)*qcow2ClusterSize)
+	}
+
+	if err := qw.write(img.header()); err != nil {
+		return qw.offset, err
+	}
+	if err := qw.writeAt(img.l1Offset, l1); err != nil {
+		return qw.offset, err
+	}
+	if err := qw.writeAt(img.refcountTableOffset, refcountTable); err != nil {
+		return qw.offset, err
+	}
+	if err := qw.writeAt(img.refcountBlocksOffset, refcounts); err != nil {
+		return qw.offset, err
+	}
+
+	buf := make([]byte, qcow2ClusterSize)
+	var compressed []byte
+	_, err := img.packTables(img.dataOffset, func(cluster, hostOffset int64, encodedSize uint16) error {
+		if err := img.readCluster(cluster, buf); err != nil {
+			return err
+		}
+		data := buf
+		if encodedSize != qcow2RawCluster {
+			// A cluster compressing better than before is padded up to the
+			// next one
+			compressed = img.encoder.EncodeAll(buf, compressed[:0])
+			if len(compressed) > int(encodedSize) {
+				return errQcow2DiskChanged
+			}
+			data = compressed
+		}
+		if err := qw.padTo(hostOffset); err != nil {
+			return err
+		}
+		return qw.write(data)
+	}, func(table *qcow2Table, entries []uint64) error {
+		return qw.writeAt(table.offset, entries)
+	})
+	if err != nil {
+		return qw.offset, err
+	}
+
+	// The refcount blocks are padded when there are no data clusters
+	if err := qw.padTo(img.length); err != nil {
+		return qw.offset, err
+	}
+	return qw.offset, qw.w.Flush()
+}
+
+// encodeCluster returns the data to store for a cluster, nil for a zero
+// cluster. Clusters are compressed when it saves space.
+func (img *qcow2Image) encodeCluster(buf []byte, compressed *[]byte) ([]byte, bool) {
+	if bytes.Equal(buf, zeroCluster) {
+		return nil, false
+	}
+	if img.encoder != nil {
+		*compressed = img.encoder.EncodeAll(buf, (*compressed)[:0])
+		if len(*compressed) < qcow2RawCluster {
+			return *compressed, true
+		}
+	}
+	return buf, false
+}
+
+func (qw *qcow2Writer) write(data []byte) error {
+	n, err := qw.w.Write(data)
+	qw.offset += int64(n)
+	return err
+}
+
+// padTo writes zeros up to offset
+func (qw *qcow2Writer) padTo(offset int64) error {
+	if offset < qw.offset {
+		return fmt.Errorf("cannot write at offset %d, already at %d", offset, qw.offset)
+	}
+	for offset > qw.offset {
+		if err := qw.write(zeroCluster[:min(offset-qw.offset, qcow2ClusterSize)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeAt writes big endian data at offset
+func (qw *qcow2Writer) writeAt(offset int64, data any) error {
+	if err := qw.padTo(offset); err != nil {
+		return err
+	}
+	if err := binary.Write(qw.w, binary.BigEndian, data); err != nil {
+		return err
+	}
+	qw.offset += int64(binary.Size(data))
+	return nil
+}
+
+func qcow2Handler(filePath string) http.Handler {
+	return newQcow2Handler(filePath, false)
+}
+
+func qcow2ZstdHandler(filePath string) http.Handler {
+	return newQcow2Handler(filePath, true)
+}
+
+func newQcow2Handler(filePath string, compress bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+
+		var encoder *zstd.Encoder
+		if compress {
+			encoder, err = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithEncoderCRC(false))
+			if err != nil {
+				log.Log.Reason(err).Error("error creating zstd encoder")
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			defer encoder.Close()
+		}
+
+		img, err := newQcow2Image(f, encoder)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error reading %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.FormatInt(img.length, 10))
+		n, err := img.WriteTo(w)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
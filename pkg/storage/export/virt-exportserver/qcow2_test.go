@@ -0,0 +1,299 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/klauspost/compress/zstd"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// readQcow2 returns the data clusters mapped by the image, it checks the
+// refcount of every cluster of the image along the way
+func readQcow2(image []byte) map[int64][]byte {
+	be := binary.BigEndian
+	Expect(be.Uint32(image[0:])).To(Equal(uint32(qcow2Magic)))
+	Expect(be.Uint32(image[4:])).To(Equal(uint32(qcow2Version)))
+	Expect(be.Uint32(image[20:])).To(Equal(uint32(qcow2ClusterBits)))
+	Expect(be.Uint32(image[96:])).To(Equal(uint32(qcow2RefcountOrder)))
+	Expect(len(image) % qcow2ClusterSize).To(BeZero())
+
+	zstdCompressed := image[104] == qcow2CompressionTypeZstd
+	if zstdCompressed {
+		Expect(be.Uint64(image[72:])).To(Equal(uint64(qcow2IncompatibleCompressionType)))
+	} else {
+		Expect(be.Uint64(image[72:])).To(BeZero())
+	}
+
+	references := make(map[int64]int)
+	reference := func(offset, clusters int64) {
+		for i := int64(0); i < clusters; i++ {
+			references[offset/qcow2ClusterSize+i]++
+		}
+	}
+
+	l1Size := int64(be.Uint32(image[36:]))
+	l1Offset := int64(be.Uint64(image[40:]))
+	refcountTableOffset := int64(be.Uint64(image[48:]))
+	refcountTableClusters := int64(be.Uint32(image[56:]))
+	reference(0, 1)
+	reference(l1Offset, ceilDiv(l1Size*8, qcow2ClusterSize))
+	reference(refcountTableOffset, refcountTableClusters)
+
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	Expect(err).ToNot(HaveOccurred())
+	defer decoder.Close()
+
+	clusters := make(map[int64][]byte)
+	for l1Index := int64(0); l1Index < l1Size; l1Index++ {
+		l1Entry := be.Uint64(image[l1Offset+l1Index*8:])
+		if l1Entry == 0 {
+			continue
+		}
+		l2Offset := int64(l1Entry &^ qcow2OflagCopied)
+		reference(l2Offset, 1)
+		for l2Index := int64(0); l2Index < qcow2L2Entries; l2Index++ {
+			l2Entry := be.Uint64(image[l2Offset+l2Index*8:])
+			if l2Entry == 0 {
+				continue
+			}
+			cluster := l1Index*qcow2L2Entries + l2Index
+			// Compressed clusters use bit 0 for their offset
+			if l2Entry&qcow2OflagCompressed == 0 && l2Entry&qcow2OflagZero != 0 {
+				Expect(l2Entry).To(Equal(uint64(qcow2OflagZero)), "zero cluster %d should not be allocated", cluster)
+				continue
+			}
+			if l2Entry&qcow2OflagCompressed == 0 {
+				offset := int64(l2Entry &^ qcow2OflagCopied)
+				reference(offset, 1)
+				clusters[cluster] = image[offset : offset+qcow2ClusterSize]
+				continue
+			}
+			Expect(zstdCompressed).To(BeTrue())
+			offset := int64(l2Entry & (1<<qcow2CompressedSectorsShift - 1))
+			sectors := int64(l2Entry>>qcow2CompressedSectorsShift&0xff) + 1
+			compressedSize := sectors*qcow2SectorSize - offset%qcow2SectorSize
+			Expect(offset/qcow2ClusterSize).To(Equal((offset+compressedSize-1)/qcow2ClusterSize), "compressed cluster should not cross host clusters")
+			Expect(decoder.Reset(bytes.NewReader(image[offset : offset+compressedSize]))).To(Succeed())
+			data := make([]byte, qcow2ClusterSize)
+			_, err := io.ReadFull(decoder, data)
+			Expect(err).ToNot(HaveOccurred())
+			clusters[cluster] = data
+			reference(offset, 1)
+		}
+	}
+
+	for i := int64(0); i < refcountTableClusters*qcow2ClusterSize/8; i++ {
+		refcountBlockOffset := int64(be.Uint64(image[refcountTableOffset+i*8:]))
+		if refcountBlockOffset != 0 {
+			reference(refcountBlockOffset, 1)
+		}
+	}
+	for i := int64(0); i < int64(len(image))/qcow2ClusterSize; i++ {
+		refcountBlockOffset := int64(be.Uint64(image[refcountTableOffset+i/qcow2RefcountEntries*8:]))
+		Expect(refcountBlockOffset).ToNot(BeZero())
+		refcount := be.Uint16(image[refcountBlockOffset+i%qcow2RefcountEntries*2:])
+		Expect(int(refcount)).To(Equal(references[i]), "refcount of cluster %d", i)
+	}
+
+	return clusters
+}
+
+var _ = Describe("qcow2", func() {
+	const (
+		// Spans two L2 tables
+		diskSize = qcow2L2Entries*qcow2ClusterSize + 100*qcow2ClusterSize + 1000
+	)
+
+	var (
+		diskPath string
+		expected map[int64][]byte
+	)
+
+	writeCluster := func(f *os.File, cluster int64, data []byte) {
+		_, err := f.WriteAt(data, cluster*qcow2ClusterSize)
+		Expect(err).ToNot(HaveOccurred())
+		if cluster*qcow2ClusterSize+qcow2ClusterSize > diskSize {
+			data = append(data, make([]byte, qcow2ClusterSize-len(data))...)
+		}
+		expected[cluster] = data
+	}
+
+	BeforeEach(func() {
+		expected = make(map[int64][]byte)
+		diskPath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+		f, err := os.Create(diskPath)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(f.Truncate(diskSize)).To(Succeed())
+
+		random := make([]byte, qcow2ClusterSize)
+		_, err = rand.Read(random)
+		Expect(err).ToNot(HaveOccurred())
+		writeCluster(f, 0, random)
+		writeCluster(f, 1, bytes.Repeat([]byte("kubevirt"), qcow2ClusterSize/8))
+		// Written zeros are not exported
+		_, err = f.WriteAt(make([]byte, 2*qcow2ClusterSize), 2*qcow2ClusterSize)
+		Expect(err).ToNot(HaveOccurred())
+		writeCluster(f, qcow2L2Entries+3, bytes.Repeat([]byte{1}, qcow2ClusterSize))
+		writeCluster(f, diskSize/qcow2ClusterSize, bytes.Repeat([]byte{2}, diskSize%qcow2ClusterSize))
+	})
+
+	export := func(handler func(string) http.Handler) []byte {
+		server := httptest.NewServer(handler(diskPath))
+		defer server.Close()
+
+		res, err := http.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		image, err := io.ReadAll(res.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Header.Get("Content-Length")).To(Equal(strconv.Itoa(len(image))))
+		return image
+	}
+
+	DescribeTable("should export the disk without its zero clusters", func(handler func(string) http.Handler, compressed bool, clusters int) {
+		image := export(handler)
+
+		Expect(binary.BigEndian.Uint64(image[24:])).To(Equal(uint64(diskSize)))
+		// The header, the L1 table, the refcount table and block, the data
+		// clusters and the two L2 tables
+		Expect(len(image)).To(Equal(clusters * qcow2ClusterSize))
+		Expect(readQcow2(image)).To(Equal(expected))
+
+		compressedClusters := 0
+		for _, offset := range []int64{0, 1, qcow2L2Entries + 3, diskSize / qcow2ClusterSize} {
+			l1Entry := binary.BigEndian.Uint64(image[qcow2ClusterSize+offset/qcow2L2Entries*8:])
+			l2Offset := int64(l1Entry &^ qcow2OflagCopied)
+			if binary.BigEndian.Uint64(image[l2Offset+offset%qcow2L2Entries*8:])&qcow2OflagCompressed != 0 {
+				compressedClusters++
+			}
+		}
+		if compressed {
+			// The random cluster is stored uncompressed
+			Expect(compressedClusters).To(Equal(3))
+		} else {
+			Expect(compressedClusters).To(BeZero())
+		}
+	},
+		Entry("qcow2", qcow2Handler, false, 4+4+2),
+		// The compressed clusters are packed, the random cluster takes a
+		// host cluster of its own
+		Entry("qcow2 with zstd compressed clusters", qcow2ZstdHandler, true, 4+3+2),
+	)
+
+	It("should export a smaller image when compressing clusters", func() {
+		Expect(len(export(qcow2ZstdHandler))).To(BeNumerically("<", len(export(qcow2Handler))))
+	})
+
+	It("should not allocate clusters for written zeros", func() {
+		f, err := os.OpenFile(diskPath, os.O_WRONLY, 0)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		_, err = f.WriteAt(make([]byte, diskSize), 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, handler := range []func(string) http.Handler{qcow2Handler, qcow2ZstdHandler} {
+			image := export(handler)
+			// Only the header, the L1 table and the refcount table and block
+			Expect(len(image)).To(Equal(4 * qcow2ClusterSize))
+			Expect(readQcow2(image)).To(BeEmpty())
+		}
+	})
+
+	It("should write the clusters in the places recorded when laying the image out", func() {
+		f, err := os.OpenFile(diskPath, os.O_RDWR, 0)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithEncoderCRC(false))
+		Expect(err).ToNot(HaveOccurred())
+		defer encoder.Close()
+		img, err := newQcow2Image(f, encoder)
+		Expect(err).ToNot(HaveOccurred())
+
+		// The zero clusters are not read again and a cluster compressing
+		// better keeps its place
+		_, err = f.WriteAt(bytes.Repeat([]byte{3}, qcow2ClusterSize), 2*qcow2ClusterSize)
+		Expect(err).ToNot(HaveOccurred())
+		writeCluster(f, 1, make([]byte, qcow2ClusterSize))
+
+		image := &bytes.Buffer{}
+		n, err := img.WriteTo(image)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(img.length))
+		Expect(readQcow2(image.Bytes())).To(Equal(expected))
+	})
+
+	It("should fail the export when a compressed cluster no longer fits its place", func() {
+		f, err := os.OpenFile(diskPath, os.O_RDWR, 0)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithEncoderCRC(false))
+		Expect(err).ToNot(HaveOccurred())
+		defer encoder.Close()
+		img, err := newQcow2Image(f, encoder)
+		Expect(err).ToNot(HaveOccurred())
+
+		random := make([]byte, qcow2ClusterSize)
+		_, err = rand.Read(random)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.WriteAt(random, qcow2ClusterSize)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = img.WriteTo(io.Discard)
+		Expect(err).To(MatchError(errQcow2DiskChanged))
+	})
+
+	It("should export an empty disk", func() {
+		Expect(os.Truncate(diskPath, 0)).To(Succeed())
+		Expect(os.Truncate(diskPath, diskSize)).To(Succeed())
+
+		server := httptest.NewServer(qcow2Handler(diskPath))
+		defer server.Close()
+
+		res, err := http.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		image, err := io.ReadAll(res.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readQcow2(image)).To(BeEmpty())
+	})
+
+	It("should reject requests other than GET", func() {
+		server := httptest.NewServer(qcow2Handler(diskPath))
+		defer server.Close()
+
+		res, err := http.Post(server.URL, "application/octet-stream", nil)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
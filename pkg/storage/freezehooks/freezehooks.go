@@ -0,0 +1,266 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package freezehooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	defaultHookTimeout = 30 * time.Second
+	maxResponseMessage = 256
+
+	// HTTPHookTargetLabel must be set to "true" on the pods receiving HTTP
+	// hooks, so that virt-controller only sends requests to pods which opted in
+	HTTPHookTargetLabel = "kubevirt.io/freeze-hook-target"
+)
+
+// Runner executes the pre-freeze and post-thaw hooks of a snapshot or backup
+// against the guest agent of a VMI or against pods in the same namespace.
+type Runner struct {
+	client     kubecli.KubevirtClient
+	httpClient *http.Client
+}
+
+func NewRunner(client kubecli.KubevirtClient) *Runner {
+	return &Runner{
+		client: client,
+		httpClient: &http.Client{
+			// A redirect could point the request away from the hook pod
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Run executes the given hooks in order and returns a result for every hook
+// that ran. It stops and returns an error at the first failing hook whose
+// failure policy is Fail; failures of hooks with the Continue policy are only
+// recorded in the results.
+func (r *Runner) Run(namespace, vmiName string, stage v1.FreezeHookStage, hooks []v1.FreezeHook) ([]v1.FreezeHookResult, error) {
+	var results []v1.FreezeHookResult
+	for _, hook := range hooks {
+		err := r.runHook(namespace, vmiName, hook)
+		result := v1.FreezeHookResult{
+			Name:      hook.Name,
+			Stage:     stage,
+			Succeeded: err == nil,
+		}
+		if err != nil {
+			result.Message = err.Error()
+		}
+		results = append(results, result)
+		if err == nil {
+			continue
+		}
+		log.Log.Warningf("%s hook %s for vmi %s/%s failed: %v", stage, hook.Name, namespace, vmiName, err)
+		if IsFailPolicy(hook) {
+			return results, fmt.Errorf("%s hook %s failed: %w", stage, hook.Name, err)
+		}
+	}
+	return results, nil
+}
+
+// AsyncRunner runs hooks in the background so that a controller worker is not
+// blocked for the duration of the hook timeouts. Runs are identified by the key
+// of the object they belong to and the stage; onDone is called with the key
+// once a run finished so the object can be requeued.
+type AsyncRunner struct {
+	runner *Runner
+	onDone func(key string)
+
+	lock sync.Mutex
+	runs map[string]*asyncRun
+}
+
+type asyncRun struct {
+	done    bool
+	results []v1.FreezeHookResult
+	err     error
+}
+
+func NewAsyncRunner(client kubecli.KubevirtClient, onDone func(key string)) *AsyncRunner {
+	return &AsyncRunner{
+		runner: NewRunner(client),
+		onDone: onDone,
+		runs:   map[string]*asyncRun{},
+	}
+}
+
+// Run starts the hooks of the given stage for key unless they are already
+// running. It returns done=false while the hooks are still running; once they
+// finished it returns their results and forgets the run, so a later call
+// starts the hooks again.
+func (r *AsyncRunner) Run(key, namespace, vmiName string, stage v1.FreezeHookStage, hooks []v1.FreezeHook) ([]v1.FreezeHookResult, bool, error) {
+	runKey := asyncRunKey(key, stage)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	run, exists := r.runs[runKey]
+	if !exists {
+		run = &asyncRun{}
+		r.runs[runKey] = run
+		go func() {
+			results, err := r.runner.Run(namespace, vmiName, stage, hooks)
+			r.lock.Lock()
+			run.results, run.err, run.done = results, err, true
+			r.lock.Unlock()
+			r.onDone(key)
+		}()
+		return nil, false, nil
+	}
+	if !run.done {
+		return nil, false, nil
+	}
+	delete(r.runs, runKey)
+	return run.results, true, run.err
+}
+
+// Started reports whether hooks of the given stage were started for key and
+// their results were not collected yet.
+func (r *AsyncRunner) Started(key string, stage v1.FreezeHookStage) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, exists := r.runs[asyncRunKey(key, stage)]
+	return exists
+}
+
+func asyncRunKey(key string, stage v1.FreezeHookStage) string {
+	return key + "/" + string(stage)
+}
+
+// IsFailPolicy reports whether a failure of the hook must fail the operation.
+func IsFailPolicy(hook v1.FreezeHook) bool {
+	return hook.OnFailure == nil || *hook.OnFailure == v1.FreezeHookFail
+}
+
+// MergeResults replaces the results of the given stage with the new results,
+// keeping the results of the other stages.
+func MergeResults(existing, results []v1.FreezeHookResult, stage v1.FreezeHookStage) []v1.FreezeHookResult {
+	var merged []v1.FreezeHookResult
+	for _, result := range existing {
+		if result.Stage != stage {
+			merged = append(merged, result)
+		}
+	}
+	return append(merged, results...)
+}
+
+func hookTimeout(hook v1.FreezeHook) time.Duration {
+	if hook.Timeout == nil || hook.Timeout.Duration <= 0 {
+		return defaultHookTimeout
+	}
+	return hook.Timeout.Duration
+}
+
+func (r *Runner) runHook(namespace, vmiName string, hook v1.FreezeHook) error {
+	timeout := hookTimeout(hook)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	switch {
+	case hook.GuestExec != nil:
+		return r.runGuestExec(ctx, namespace, vmiName, hook.GuestExec, timeout)
+	case hook.HTTP != nil:
+		return r.runHTTP(ctx, namespace, hook.HTTP)
+	default:
+		return fmt.Errorf("hook %s has no action", hook.Name)
+	}
+}
+
+func (r *Runner) runGuestExec(ctx context.Context, namespace, vmiName string, hook *v1.GuestExecHook, timeout time.Duration) error {
+	if len(hook.Command) == 0 {
+		return fmt.Errorf("guest exec hook has an empty command")
+	}
+	opts := &v1.GuestExecOptions{
+		Command:        hook.Command,
+		TimeoutSeconds: int32(timeout.Seconds()),
+	}
+	return r.client.VirtualMachineInstance(namespace).GuestExec(ctx, vmiName, opts)
+}
+
+func (r *Runner) runHTTP(ctx context.Context, namespace string, hook *v1.HTTPHook) error {
+	pod, err := r.client.CoreV1().Pods(namespace).Get(ctx, hook.PodName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := validateHTTPHookPod(pod, hook.Port); err != nil {
+		return err
+	}
+	if pod.Status.PodIP == "" {
+		return fmt.Errorf("pod %s has no IP address", hook.PodName)
+	}
+
+	path := hook.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	url := fmt.Sprintf("http://%s%s", net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(hook.Port))), path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseMessage))
+		return fmt.Errorf("%s returned %s: %s", url, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// validateHTTPHookPod only lets virt-controller reach a port the pod declares,
+// on a pod which opted in to receive hooks and does not share the node network.
+func validateHTTPHookPod(pod *k8sv1.Pod, port int32) error {
+	if pod.Labels[HTTPHookTargetLabel] != "true" {
+		return fmt.Errorf("pod %s is not labeled %s=true", pod.Name, HTTPHookTargetLabel)
+	}
+	if pod.Spec.HostNetwork {
+		return fmt.Errorf("pod %s uses the host network", pod.Name)
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.ContainerPort == port {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("pod %s does not declare port %d", pod.Name, port)
+}
@@ -0,0 +1,310 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package freezehooks_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/freezehooks"
+)
+
+const (
+	vmiName     = "testvmi"
+	hookPodName = "db-pod"
+)
+
+var _ = Describe("Freeze hooks", func() {
+	var (
+		virtClient *kubecli.MockKubevirtClient
+		vmiClient  *kubecli.MockVirtualMachineInstanceInterface
+		k8sClient  *k8sfake.Clientset
+		runner     *freezehooks.Runner
+	)
+
+	guestExecHook := func(name string, policy v1.FreezeHookFailurePolicy) v1.FreezeHook {
+		return v1.FreezeHook{
+			Name:      name,
+			GuestExec: &v1.GuestExecHook{Command: []string{"/usr/bin/flush", "--all"}},
+			OnFailure: pointer.P(policy),
+		}
+	}
+
+	newHookPod := func(server *httptest.Server) *k8sv1.Pod {
+		host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		p, err := strconv.Atoi(port)
+		Expect(err).ToNot(HaveOccurred())
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      hookPodName,
+				Namespace: metav1.NamespaceDefault,
+				Labels:    map[string]string{freezehooks.HTTPHookTargetLabel: "true"},
+			},
+			Spec: k8sv1.PodSpec{Containers: []k8sv1.Container{{
+				Name:  "db",
+				Ports: []k8sv1.ContainerPort{{ContainerPort: int32(p)}},
+			}}},
+			Status: k8sv1.PodStatus{PodIP: host},
+		}
+	}
+
+	httpHookForPod := func(pod *k8sv1.Pod, path string) v1.FreezeHook {
+		_, err := k8sClient.CoreV1().Pods(metav1.NamespaceDefault).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return v1.FreezeHook{
+			Name: "http",
+			HTTP: &v1.HTTPHook{PodName: pod.Name, Port: pod.Spec.Containers[0].Ports[0].ContainerPort, Path: path},
+		}
+	}
+
+	httpHook := func(server *httptest.Server, path string) v1.FreezeHook {
+		return httpHookForPod(newHookPod(server), path)
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		runner = freezehooks.NewRunner(virtClient)
+	})
+
+	It("should run guest exec hooks in order and record their results", func() {
+		hooks := []v1.FreezeHook{
+			guestExecHook("first", v1.FreezeHookFail),
+			guestExecHook("second", v1.FreezeHookFail),
+		}
+		gomock.InOrder(
+			vmiClient.EXPECT().GuestExec(gomock.Any(), vmiName, &v1.GuestExecOptions{
+				Command:        []string{"/usr/bin/flush", "--all"},
+				TimeoutSeconds: 30,
+			}).Return(nil),
+			vmiClient.EXPECT().GuestExec(gomock.Any(), vmiName, gomock.Any()).Return(nil),
+		)
+
+		results, err := runner.Run(metav1.NamespaceDefault, vmiName, v1.PreFreezeStage, hooks)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]v1.FreezeHookResult{
+			{Name: "first", Stage: v1.PreFreezeStage, Succeeded: true},
+			{Name: "second", Stage: v1.PreFreezeStage, Succeeded: true},
+		}))
+	})
+
+	It("should pass the hook timeout to the guest agent", func() {
+		hook := guestExecHook("flush", v1.FreezeHookFail)
+		hook.Timeout = &metav1.Duration{Duration: 90 * time.Second}
+		vmiClient.EXPECT().GuestExec(gomock.Any(), vmiName, &v1.GuestExecOptions{
+			Command:        hook.GuestExec.Command,
+			TimeoutSeconds: 90,
+		}).Return(nil)
+
+		_, err := runner.Run(metav1.NamespaceDefault, vmiName, v1.PreFreezeStage, []v1.FreezeHook{hook})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should stop at the first failing hook with the Fail policy", func() {
+		hooks := []v1.FreezeHook{
+			guestExecHook("first", v1.FreezeHookFail),
+			guestExecHook("second", v1.FreezeHookFail),
+		}
+		vmiClient.EXPECT().GuestExec(gomock.Any(), vmiName, gomock.Any()).Return(fmt.Errorf("exit code 1")).Times(1)
+
+		results, err := runner.Run(metav1.NamespaceDefault, vmiName, v1.PreFreezeStage, hooks)
+		Expect(err).To(MatchError(ContainSubstring("PreFreeze hook first failed")))
+		Expect(results).To(Equal([]v1.FreezeHookResult{
+			{Name: "first", Stage: v1.PreFreezeStage, Message: "exit code 1"},
+		}))
+	})
+
+	It("should treat a hook without a policy as Fail", func() {
+		hook := guestExecHook("flush", v1.FreezeHookFail)
+		hook.OnFailure = nil
+		vmiClient.EXPECT().GuestExec(gomock.Any(), vmiName, gomock.Any()).Return(fmt.Errorf("boom"))
+
+		_, err := runner.Run(metav1.NamespaceDefault, vmiName, v1.PostThawStage, []v1.FreezeHook{hook})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should record failures and continue for hooks with the Continue policy", func() {
+		hooks := []v1.FreezeHook{
+			guestExecHook("first", v1.FreezeHookContinue),
+			guestExecHook("second", v1.FreezeHookFail),
+		}
+		gomock.InOrder(
+			vmiClient.EXPECT().GuestExec(gomock.Any(), vmiName, gomock.Any()).Return(fmt.Errorf("boom")),
+			vmiClient.EXPECT().GuestExec(gomock.Any(), vmiName, gomock.Any()).Return(nil),
+		)
+
+		results, err := runner.Run(metav1.NamespaceDefault, vmiName, v1.PostThawStage, hooks)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]v1.FreezeHookResult{
+			{Name: "first", Stage: v1.PostThawStage, Message: "boom"},
+			{Name: "second", Stage: v1.PostThawStage, Succeeded: true},
+		}))
+	})
+
+	Context("HTTP hooks", func() {
+		var (
+			server     *httptest.Server
+			statusCode int
+			requests   []*http.Request
+		)
+
+		BeforeEach(func() {
+			statusCode = http.StatusOK
+			requests = nil
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r)
+				w.WriteHeader(statusCode)
+				_, _ = w.Write([]byte("flush failed"))
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		It("should POST to the pod", func() {
+			results, err := runner.Run(metav1.NamespaceDefault, vmiName, v1.PreFreezeStage,
+				[]v1.FreezeHook{httpHook(server, "flush")})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Succeeded).To(BeTrue())
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Method).To(Equal(http.MethodPost))
+			Expect(requests[0].URL.Path).To(Equal("/flush"))
+		})
+
+		It("should fail on a non 2xx response", func() {
+			statusCode = http.StatusInternalServerError
+			results, err := runner.Run(metav1.NamespaceDefault, vmiName, v1.PreFreezeStage,
+				[]v1.FreezeHook{httpHook(server, "/flush")})
+			Expect(err).To(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Succeeded).To(BeFalse())
+			Expect(results[0].Message).To(ContainSubstring("500 Internal Server Error: flush failed"))
+		})
+
+		DescribeTable("should not send the request", func(modify func(*k8sv1.Pod), expectedErr string) {
+			pod := newHookPod(server)
+			hook := httpHookForPod(pod, "/flush")
+			modify(pod)
+			_, err := k8sClient.CoreV1().Pods(metav1.NamespaceDefault).Update(context.Background(), pod, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = runner.Run(metav1.NamespaceDefault, vmiName, v1.PreFreezeStage, []v1.FreezeHook{hook})
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			Expect(requests).To(BeEmpty())
+		},
+			Entry("to a pod which did not opt in", func(pod *k8sv1.Pod) {
+				pod.Labels = nil
+			}, "is not labeled kubevirt.io/freeze-hook-target=true"),
+			Entry("to a pod using the host network", func(pod *k8sv1.Pod) {
+				pod.Spec.HostNetwork = true
+			}, "uses the host network"),
+			Entry("to a port the pod does not declare", func(pod *k8sv1.Pod) {
+				pod.Spec.Containers[0].Ports[0].ContainerPort++
+			}, "does not declare port"),
+		)
+
+		It("should not follow redirects", func() {
+			statusCode = http.StatusFound
+			_, err := runner.Run(metav1.NamespaceDefault, vmiName, v1.PreFreezeStage,
+				[]v1.FreezeHook{httpHook(server, "/flush")})
+			Expect(err).To(MatchError(ContainSubstring("302 Found")))
+			Expect(requests).To(HaveLen(1))
+		})
+
+		It("should fail when the pod does not exist", func() {
+			hook := v1.FreezeHook{
+				Name: "http",
+				HTTP: &v1.HTTPHook{PodName: "missing", Port: 8080},
+			}
+			_, err := runner.Run(metav1.NamespaceDefault, vmiName, v1.PreFreezeStage, []v1.FreezeHook{hook})
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+		})
+	})
+
+	Context("asynchronously", func() {
+		const key = "default/content"
+
+		It("should report the hooks as running until they finished", func() {
+			release := make(chan struct{})
+			done := make(chan string, 1)
+			vmiClient.EXPECT().GuestExec(gomock.Any(), vmiName, gomock.Any()).DoAndReturn(
+				func(context.Context, string, *v1.GuestExecOptions) error {
+					<-release
+					return fmt.Errorf("exit code 1")
+				})
+			asyncRunner := freezehooks.NewAsyncRunner(virtClient, func(key string) { done <- key })
+			hooks := []v1.FreezeHook{guestExecHook("flush", v1.FreezeHookFail)}
+
+			_, finished, err := asyncRunner.Run(key, metav1.NamespaceDefault, vmiName, v1.PreFreezeStage, hooks)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(finished).To(BeFalse())
+			Expect(asyncRunner.Started(key, v1.PreFreezeStage)).To(BeTrue())
+			Expect(asyncRunner.Started(key, v1.PostThawStage)).To(BeFalse())
+
+			_, finished, err = asyncRunner.Run(key, metav1.NamespaceDefault, vmiName, v1.PreFreezeStage, hooks)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(finished).To(BeFalse())
+
+			close(release)
+			Eventually(done).Should(Receive(Equal(key)))
+
+			results, finished, err := asyncRunner.Run(key, metav1.NamespaceDefault, vmiName, v1.PreFreezeStage, hooks)
+			Expect(err).To(MatchError(ContainSubstring("PreFreeze hook flush failed")))
+			Expect(finished).To(BeTrue())
+			Expect(results).To(Equal([]v1.FreezeHookResult{
+				{Name: "flush", Stage: v1.PreFreezeStage, Message: "exit code 1"},
+			}))
+			Expect(asyncRunner.Started(key, v1.PreFreezeStage)).To(BeFalse())
+		})
+	})
+
+	It("should replace only the results of the given stage", func() {
+		existing := []v1.FreezeHookResult{
+			{Name: "pre", Stage: v1.PreFreezeStage, Succeeded: true},
+			{Name: "post", Stage: v1.PostThawStage, Message: "boom"},
+		}
+		merged := freezehooks.MergeResults(existing, []v1.FreezeHookResult{
+			{Name: "post", Stage: v1.PostThawStage, Succeeded: true},
+		}, v1.PostThawStage)
+		Expect(merged).To(Equal([]v1.FreezeHookResult{
+			{Name: "pre", Stage: v1.PreFreezeStage, Succeeded: true},
+			{Name: "post", Stage: v1.PostThawStage, Succeeded: true},
+		}))
+	})
+})
@@ -55,9 +55,11 @@ func patchHotplugVolumes(client kubecli.KubevirtClient, vm *virtv1.VirtualMachin
 
 	newVmiVolumes := append(filterHotplugVMIVolumes(vm, vmi), getNewHotplugVMVolumes(vm, vmi)...)
 	newVmiDisks := append(filterHotplugVMIDisks(vm, vmi, newVmiVolumes), getNewHotplugVMDisks(vm, vmi, newVmiVolumes)...)
+	newVmiFilesystems := append(filterHotplugVMIFilesystems(vmi, newVmiVolumes), getNewHotplugVMFilesystems(vm, vmi, newVmiVolumes)...)
+	filesystemsChanged := !equality.Semantic.DeepEqual(vmi.Spec.Domain.Devices.Filesystems, newVmiFilesystems)
 
 	if equality.Semantic.DeepEqual(vmi.Spec.Volumes, newVmiVolumes) &&
-		equality.Semantic.DeepEqual(vmi.Spec.Domain.Devices.Disks, newVmiDisks) {
+		equality.Semantic.DeepEqual(vmi.Spec.Domain.Devices.Disks, newVmiDisks) && !filesystemsChanged {
 		log.Log.Object(vm).V(3).Info("No hotplug volumes to patch")
 		return nil
 	}
@@ -79,6 +81,15 @@ func patchHotplugVolumes(client kubecli.KubevirtClient, vm *virtv1.VirtualMachin
 		patchSet.AddOption(patch.WithAdd("/spec/domain/devices/disks", newVmiDisks))
 	}
 
+	if filesystemsChanged {
+		patchSet.AddOption(patch.WithTest("/spec/domain/devices/filesystems", vmi.Spec.Domain.Devices.Filesystems))
+		if len(vmi.Spec.Domain.Devices.Filesystems) > 0 {
+			patchSet.AddOption(patch.WithReplace("/spec/domain/devices/filesystems", newVmiFilesystems))
+		} else {
+			patchSet.AddOption(patch.WithAdd("/spec/domain/devices/filesystems", newVmiFilesystems))
+		}
+	}
+
 	patchBytes, err := patchSet.GeneratePayload()
 	if err != nil {
 		return err
@@ -128,6 +139,9 @@ func getNewHotplugVMVolumes(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 		volumesWithStatus[vs.Name] = struct{}{}
 	}
 
+	vmFilesystemsByName := filesystemsByName(vm.Spec.Template.Spec.Domain.Devices.Filesystems)
+	_, filesystemHotplugSupported := vmi.Annotations[virtv1.HotplugFilesystemsAnnotation]
+
 	for _, vmVolume := range vm.Spec.Template.Spec.Volumes {
 		if storagetypes.IsDeclarativeHotplugVolume(&vmVolume) {
 			_, vmiVolumeExists := vmiVolumesByName[vmVolume.Name]
@@ -137,6 +151,10 @@ func getNewHotplugVMVolumes(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 			_, vmiVolumeHasStatus := volumesWithStatus[vmVolume.Name]
 
 			if !vmiVolumeExists && !vmiVolumeHasStatus {
+				if _, isFilesystem := vmFilesystemsByName[vmVolume.Name]; isFilesystem && !filesystemHotplugSupported {
+					log.Log.Object(vm).V(3).Infof("Not adding hotplug volume %s to VMI, it doesn't support filesystem hotplug", vmVolume.Name)
+					continue
+				}
 				log.Log.Object(vm).Infof("Adding hotplug volume %s to VMI", vmVolume.Name)
 				volumes = append(volumes, *vmVolume.DeepCopy())
 			}
@@ -203,3 +221,43 @@ func getNewHotplugVMDisks(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 
 	return disks
 }
+
+func filesystemsByName(filesystems []virtv1.Filesystem) map[string]*virtv1.Filesystem {
+	filesystemMap := make(map[string]*virtv1.Filesystem)
+	for i := range filesystems {
+		filesystemMap[filesystems[i].Name] = &filesystems[i]
+	}
+	return filesystemMap
+}
+
+func filterHotplugVMIFilesystems(vmi *virtv1.VirtualMachineInstance, vmiNewVolumes []virtv1.Volume) []virtv1.Filesystem {
+	var filesystems []virtv1.Filesystem
+	vmiNewVolumesByName := volumesByName(vmiNewVolumes)
+
+	// a filesystem is always backed by a volume, drop it together with its volume
+	for _, vmiFilesystem := range vmi.Spec.Domain.Devices.Filesystems {
+		if _, vmiVolumeExists := vmiNewVolumesByName[vmiFilesystem.Name]; vmiVolumeExists {
+			filesystems = append(filesystems, *vmiFilesystem.DeepCopy())
+		}
+	}
+
+	return filesystems
+}
+
+func getNewHotplugVMFilesystems(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, vmiNewVolumes []virtv1.Volume) []virtv1.Filesystem {
+	var filesystems []virtv1.Filesystem
+	vmiNewVolumesByName := volumesByName(vmiNewVolumes)
+	vmiFilesystemsByName := filesystemsByName(vmi.Spec.Domain.Devices.Filesystems)
+
+	for _, vmFilesystem := range vm.Spec.Template.Spec.Domain.Devices.Filesystems {
+		vmVolume, vmVolumeExists := vmiNewVolumesByName[vmFilesystem.Name]
+		_, vmiFilesystemExists := vmiFilesystemsByName[vmFilesystem.Name]
+
+		if vmVolumeExists && storagetypes.IsDeclarativeHotplugVolume(vmVolume) && !vmiFilesystemExists {
+			log.Log.Object(vm).Infof("Adding hotplug filesystem %s to VMI", vmFilesystem.Name)
+			filesystems = append(filesystems, *vmFilesystem.DeepCopy())
+		}
+	}
+
+	return filesystems
+}
@@ -211,6 +211,40 @@ var _ = Describe("Volume Hotplug", func() {
 			Expect(result.Spec.Volumes[0].Name).To(Equal("perm"))
 		})
 
+		DescribeTable("should add hotplug filesystems to VMI", func(annotations map[string]string, expectAdded bool) {
+			opts := []libvmi.Option{
+				libvmi.WithDataVolume("perm", "perm"),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+			}
+			origVMI := libvmi.New(opts...)
+			origVMI.Annotations = annotations
+			postVMI := libvmi.New(append(opts, libvmi.WithName(origVMI.Name), libvmi.WithHotplugFilesystemPVC("hotplugfs"))...)
+			vm := libvmi.NewVirtualMachine(postVMI)
+			result := handle(vm, origVMI)
+			if expectAdded {
+				Expect(result.Spec).To(Equal(postVMI.Spec))
+			} else {
+				Expect(result.Spec).To(Equal(origVMI.Spec))
+			}
+		},
+			Entry("when the VMI supports filesystem hotplug", map[string]string{v1.HotplugFilesystemsAnnotation: ""}, true),
+			Entry("not when the VMI doesn't support filesystem hotplug", nil, false),
+		)
+
+		It("should remove hotplug filesystems from VMI", func() {
+			opts := []libvmi.Option{
+				libvmi.WithDataVolume("perm", "perm"),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+			}
+			origVMI := libvmi.New(append(opts, libvmi.WithHotplugFilesystemPVC("hotplugfs"))...)
+			postVMI := libvmi.New(append(opts, libvmi.WithName(origVMI.Name))...)
+			vm := libvmi.NewVirtualMachine(postVMI)
+			result := handle(vm, origVMI)
+			Expect(result.Spec.Domain.Devices.Filesystems).To(BeEmpty())
+			Expect(result.Spec.Volumes).To(HaveLen(1))
+			Expect(result.Spec.Domain.Devices.Disks).To(HaveLen(1))
+		})
+
 		It("should not add hotplug volume to VMI with migration updatestrategy", func() {
 			opts := []libvmi.Option{
 				libvmi.WithDataVolume("perm", "perm"),
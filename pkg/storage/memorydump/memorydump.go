@@ -56,6 +56,26 @@ func RemoveMemoryDumpVolumeFromVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, c
 	return vmiSpec
 }
 
+// HandleSavedState removes the saved state volume from the VM template
+// once the VMI was started from it, so that following starts boot the VM
+func HandleSavedState(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
+	if vmi == nil {
+		return
+	}
+
+	vmiVolumes := storagetypes.GetVolumesByName(&vmi.Spec)
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if !storagetypes.IsSavedStateVolume(&volume) {
+			continue
+		}
+		if _, exists := vmiVolumes[volume.Name]; exists {
+			log.Log.Object(vm).V(3).Infof("VMI started from saved state volume %s, removing it from the template", volume.Name)
+			vm.Spec.Template.Spec = *RemoveMemoryDumpVolumeFromVMISpec(&vm.Spec.Template.Spec, volume.Name)
+			return
+		}
+	}
+}
+
 func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, pvcStore cache.Store) error {
 	if vm.Status.MemoryDumpRequest == nil {
 		return nil
@@ -75,7 +95,7 @@ func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1
 		// When in state associating we want to add the memory dump pvc
 		// as a volume in the vm and in the vmi to trigger the mount
 		// to virt launcher and the memory dump
-		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest.ClaimName)
+		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest)
 		if _, exists := vmiVolumeMap[vm.Status.MemoryDumpRequest.ClaimName]; exists {
 			return nil
 		}
@@ -214,7 +234,7 @@ func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.Vir
 
 	vmiCopy := vmi.DeepCopy()
 	if addVolume {
-		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request.ClaimName)
+		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request)
 	} else {
 		vmiCopy.Spec = *RemoveMemoryDumpVolumeFromVMISpec(&vmiCopy.Spec, request.ClaimName)
 	}
@@ -235,9 +255,9 @@ func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.Vir
 	return err
 }
 
-func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, claimName string) *v1.VirtualMachineInstanceSpec {
+func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineMemoryDumpRequest) *v1.VirtualMachineInstanceSpec {
 	for _, volume := range vmiSpec.Volumes {
-		if volume.Name == claimName {
+		if volume.Name == request.ClaimName {
 			return vmiSpec
 		}
 	}
@@ -245,14 +265,15 @@ func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpe
 	memoryDumpVol := &v1.MemoryDumpVolumeSource{
 		PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
 			PersistentVolumeClaimVolumeSource: k8score.PersistentVolumeClaimVolumeSource{
-				ClaimName: claimName,
+				ClaimName: request.ClaimName,
 			},
 			Hotpluggable: true,
 		},
+		Format: request.Format,
 	}
 
 	newVolume := v1.Volume{
-		Name: claimName,
+		Name: request.ClaimName,
 	}
 	newVolume.VolumeSource.MemoryDump = memoryDumpVol
 
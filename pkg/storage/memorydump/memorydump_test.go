@@ -203,6 +203,24 @@ var _ = Describe("MemoryDump", func() {
 		Entry("when phase is Unmounting", v1.MemoryDumpUnmounting, targetFileName),
 		Entry("when phase is Failed", v1.MemoryDumpFailed, "Memory dump failed"),
 	)
+
+	DescribeTable("HandleSavedState", func(inVMI bool, expectedVolumes int) {
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpCompleted)
+		vm.Status.MemoryDumpRequest = nil
+		ApplyVMIMemoryDumpVol(&vm.Spec.Template.Spec)
+		memoryDump := vm.Spec.Template.Spec.Volumes[0].MemoryDump
+		memoryDump.Hotpluggable = false
+		memoryDump.Format = v1.MemoryDumpFormatSavedState
+		if inVMI {
+			vmi.Spec = *vm.Spec.Template.Spec.DeepCopy()
+		}
+
+		HandleSavedState(vm, vmi)
+		Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(expectedVolumes))
+	},
+		Entry("should remove the saved state volume once the VMI started from it", true, 0),
+		Entry("should keep the saved state volume until the VMI starts from it", false, 1),
+	)
 })
 
 func ApplyVMIMemoryDumpVol(spec *v1.VirtualMachineInstanceSpec) {
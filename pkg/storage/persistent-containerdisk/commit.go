@@ -0,0 +1,586 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package persistentcontainerdisk
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// ContainerDiskCommitStarted is the event recorded when the commit pod of a persistent containerDisk is created
+	ContainerDiskCommitStarted = "ContainerDiskCommitStarted"
+	// ContainerDiskCommitCompleted is the event recorded when a persistent containerDisk was committed
+	ContainerDiskCommitCompleted = "ContainerDiskCommitCompleted"
+	// ContainerDiskCommitFailed is the event recorded when a persistent containerDisk could not be committed
+	ContainerDiskCommitFailed = "ContainerDiskCommitFailed"
+
+	commitPodApp           = "containerdisk-commit"
+	commitContainerName    = "commit"
+	commitOverlayDir       = "/overlay"
+	commitContainerDiskDir = "/containerdisk"
+	commitTargetDir        = "/target"
+	commitTargetDevice     = "/dev/target"
+	commitTargetImageFile  = "disk.img"
+	commitOverlayImageFile = "disk.qcow2"
+
+	imageVolumeDisabledMsg = "committing a containerDisk requires the ImageVolume feature gate"
+	overlayNotFoundMsg     = "overlay PVC %s does not exist, the VM was never started"
+	volumeNotFoundMsg      = "volume %s is not a persistent containerDisk of the VM"
+	commitPodNotFoundMsg   = "commit pod %s disappeared"
+	commitPodFailedMsg     = "commit pod failed: %s"
+
+	// commitScript resolves the disk of the containerDisk image, which is the single
+	// file in the disk directory when no path is set, and flattens the overlay on
+	// top of it into the target. The backing format is the one recorded in the overlay
+	commitScript = `set -e
+image="${IMAGE_PATH}"
+if [ -z "${image}" ]; then
+  images=(%[1]s/*)
+  if [ "${#images[@]}" -ne 1 ] || [ ! -e "${images[0]}" ]; then
+    echo "expected exactly one disk in %[1]s" >&2
+    exit 1
+  fi
+  image="${images[0]}"
+fi
+exec qemu-img convert -p -O raw %[2]s "json:{\"driver\":\"qcow2\",\"file\":{\"driver\":\"file\",\"filename\":\"%[3]s\"},\"backing\":{\"file\":{\"driver\":\"file\",\"filename\":\"${image}\"}}}" %[4]s
+`
+
+	unknownTypeErrFmt = "containerDisk commit controller expected object of type %s but found object of unknown type"
+)
+
+// CommitController flattens the writable overlay of a persistent containerDisk and
+// its image into the PVC requested through the commitcontainerdisk VM subresource
+type CommitController struct {
+	client        kubecli.KubevirtClient
+	vmIndexer     cache.Indexer
+	vmiStore      cache.Store
+	pvcStore      cache.Store
+	dvStore       cache.Store
+	podStore      cache.Store
+	launcherImage string
+	clusterConfig *virtconfig.ClusterConfig
+	recorder      record.EventRecorder
+
+	podExpectations *controller.ControllerExpectations
+
+	queue     workqueue.TypedRateLimitingInterface[string]
+	hasSynced func() bool
+}
+
+func NewCommitController(client kubecli.KubevirtClient, vmInformer, vmiInformer, pvcInformer, dvInformer, podInformer cache.SharedIndexInformer, launcherImage string, clusterConfig *virtconfig.ClusterConfig, recorder record.EventRecorder) (*CommitController, error) {
+	c := &CommitController{
+		client:          client,
+		vmIndexer:       vmInformer.GetIndexer(),
+		vmiStore:        vmiInformer.GetStore(),
+		pvcStore:        pvcInformer.GetStore(),
+		dvStore:         dvInformer.GetStore(),
+		podStore:        podInformer.GetStore(),
+		launcherImage:   launcherImage,
+		clusterConfig:   clusterConfig,
+		recorder:        recorder,
+		podExpectations: controller.NewControllerExpectations(),
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-containerdisk-commit"},
+		),
+	}
+
+	c.hasSynced = func() bool {
+		return vmInformer.HasSynced() && vmiInformer.HasSynced() && pvcInformer.HasSynced() &&
+			dvInformer.HasSynced() && podInformer.HasSynced()
+	}
+
+	_, err := vmInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleVM,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleVM(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, informer := range []cache.SharedIndexInformer{vmiInformer, pvcInformer, dvInformer} {
+		_, err = informer.AddEventHandler(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    c.handleNamespacedObject,
+				UpdateFunc: func(oldObj, newObj interface{}) { c.handleNamespacedObject(newObj) },
+				DeleteFunc: c.handleNamespacedObject,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = podInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addCommitPod,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleCommitPod(newObj) },
+			DeleteFunc: c.handleCommitPod,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *CommitController) handleVM(obj interface{}) {
+	vm, ok := obj.(*v1.VirtualMachine)
+	if !ok {
+		log.Log.Errorf(unknownTypeErrFmt, "virtualmachine")
+		return
+	}
+	if !IsCommitInProgress(vm) {
+		return
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(vm)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("cannot get VM key")
+		return
+	}
+	c.queue.Add(key)
+}
+
+// handleNamespacedObject enqueues the VMs of the namespace of a changed object which wait
+// for the VMI to go away or for the target claim to be ready
+func (c *CommitController) handleNamespacedObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		log.Log.Reason(fmt.Errorf("unexpected obj %#v", obj)).Error("Failed to process notification")
+		return
+	}
+
+	vms, err := c.vmIndexer.ByIndex(cache.NamespaceIndex, o.GetNamespace())
+	if err != nil {
+		log.Log.Reason(err).Error("cannot get VMs from namespace indexer")
+		return
+	}
+	for _, obj := range vms {
+		vm := obj.(*v1.VirtualMachine)
+		if vm.Status.ContainerDiskCommitRequest == nil || vm.Status.ContainerDiskCommitRequest.Phase != v1.ContainerDiskCommitPending {
+			continue
+		}
+		c.handleVM(vm)
+	}
+}
+
+// addCommitPod observes the creation of a commit pod before enqueueing its VM
+func (c *CommitController) addCommitPod(obj interface{}) {
+	if key, ok := commitPodVMKey(obj); ok {
+		c.podExpectations.CreationObserved(key)
+		c.queue.Add(key)
+	}
+}
+
+// handleCommitPod enqueues the VM a commit pod belongs to
+func (c *CommitController) handleCommitPod(obj interface{}) {
+	if key, ok := commitPodVMKey(obj); ok {
+		c.queue.Add(key)
+	}
+}
+
+func commitPodVMKey(obj interface{}) (string, bool) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	pod, ok := obj.(*k8sv1.Pod)
+	if !ok || pod.Labels[v1.AppLabel] != commitPodApp {
+		return "", false
+	}
+	vmName, ok := pod.Labels[v1.VirtualMachineLabel]
+	if !ok {
+		return "", false
+	}
+	return cacheKey(pod.Namespace, vmName), true
+}
+
+func (c *CommitController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting containerDisk commit controller")
+	defer log.Log.Info("Shutting down containerDisk commit controller")
+
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *CommitController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *CommitController) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing containerDisk commit of VM %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed containerDisk commit of VM %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *CommitController) execute(key string) error {
+	obj, exists, err := c.vmIndexer.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	vm := obj.(*v1.VirtualMachine)
+	if !IsCommitInProgress(vm) {
+		c.podExpectations.DeleteExpectations(key)
+		return nil
+	}
+	// The informer may not have seen the commit pod created by the previous round yet
+	if !c.podExpectations.SatisfiedExpectations(key) {
+		return nil
+	}
+
+	req := vm.Status.ContainerDiskCommitRequest
+	var updated *v1.VirtualMachineContainerDiskCommitRequest
+	switch req.Phase {
+	case v1.ContainerDiskCommitPending:
+		updated, err = c.startCommit(key, vm)
+	case v1.ContainerDiskCommitInProgress:
+		updated, err = c.checkCommit(vm)
+	}
+	if err != nil || updated == nil {
+		return err
+	}
+
+	return c.updateRequest(vm, updated)
+}
+
+// startCommit creates the commit pod once the VM is down and the target claim is ready
+func (c *CommitController) startCommit(key string, vm *v1.VirtualMachine) (*v1.VirtualMachineContainerDiskCommitRequest, error) {
+	req := vm.Status.ContainerDiskCommitRequest
+
+	// The gate may have been disabled since the request was accepted
+	if !c.clusterConfig.ImageVolumeEnabled() {
+		return failRequest(req, imageVolumeDisabledMsg), nil
+	}
+
+	_, vmiExists, err := c.vmiStore.GetByKey(cacheKey(vm.Namespace, vm.Name))
+	if err != nil {
+		return nil, err
+	}
+	if vmiExists {
+		log.Log.Object(vm).V(3).Infof("Waiting for the VMI to go away before committing containerDisk %s", req.VolumeName)
+		return nil, nil
+	}
+
+	volume := persistentContainerDiskVolume(vm, req.VolumeName)
+	if volume == nil {
+		return failRequest(req, fmt.Sprintf(volumeNotFoundMsg, req.VolumeName)), nil
+	}
+
+	overlayClaimName := storagetypes.PersistentContainerDiskClaimName(vm.Name, volume.Name)
+	overlay, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, overlayClaimName, c.pvcStore)
+	if err != nil {
+		return nil, err
+	}
+	if overlay == nil {
+		return failRequest(req, fmt.Sprintf(overlayNotFoundMsg, overlayClaimName)), nil
+	}
+
+	ready, err := c.isTargetReady(vm.Namespace, req.ClaimName)
+	if err != nil || !ready {
+		return nil, err
+	}
+	target, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, req.ClaimName, c.pvcStore)
+	if err != nil {
+		return nil, err
+	}
+
+	pod := newCommitPod(vm, volume, overlay, target, c.launcherImage)
+	c.podExpectations.ExpectCreations(key, 1)
+	_, err = c.client.CoreV1().Pods(vm.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+	if err != nil {
+		// No add event follows for a pod which was not created by this call
+		c.podExpectations.CreationObserved(key)
+		if !k8serrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+	}
+	c.recorder.Eventf(vm, k8sv1.EventTypeNormal, ContainerDiskCommitStarted, "Committing containerDisk %s into PVC %s", volume.Name, req.ClaimName)
+
+	updated := req.DeepCopy()
+	updated.Phase = v1.ContainerDiskCommitInProgress
+	updated.StartTimestamp = pointer.P(metav1.Now())
+	return updated, nil
+}
+
+// isTargetReady returns true once the target claim exists and its DataVolume, if any, succeeded
+func (c *CommitController) isTargetReady(namespace, claimName string) (bool, error) {
+	dv, err := storagetypes.GetDataVolumeFromCache(namespace, claimName, c.dvStore)
+	if err != nil {
+		return false, err
+	}
+	if dv != nil && dv.Status.Phase != cdiv1.Succeeded {
+		log.Log.V(3).Infof("Waiting for DataVolume %s/%s to succeed before committing into it", namespace, claimName)
+		return false, nil
+	}
+	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(namespace, claimName, c.pvcStore)
+	if err != nil {
+		return false, err
+	}
+	return pvc != nil, nil
+}
+
+// checkCommit follows the commit pod and removes it once it terminated
+func (c *CommitController) checkCommit(vm *v1.VirtualMachine) (*v1.VirtualMachineContainerDiskCommitRequest, error) {
+	req := vm.Status.ContainerDiskCommitRequest
+	podName := commitPodName(vm.Name, req.VolumeName)
+
+	obj, exists, err := c.podStore.GetByKey(cacheKey(vm.Namespace, podName))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, ContainerDiskCommitFailed, commitPodNotFoundMsg, podName)
+		return failRequest(req, fmt.Sprintf(commitPodNotFoundMsg, podName)), nil
+	}
+
+	pod := obj.(*k8sv1.Pod)
+	var updated *v1.VirtualMachineContainerDiskCommitRequest
+	switch pod.Status.Phase {
+	case k8sv1.PodSucceeded:
+		c.recorder.Eventf(vm, k8sv1.EventTypeNormal, ContainerDiskCommitCompleted, "Committed containerDisk %s into PVC %s", req.VolumeName, req.ClaimName)
+		updated = req.DeepCopy()
+		updated.Phase = v1.ContainerDiskCommitCompleted
+		updated.EndTimestamp = pointer.P(metav1.Now())
+	case k8sv1.PodFailed:
+		message := fmt.Sprintf(commitPodFailedMsg, terminationMessage(pod))
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, ContainerDiskCommitFailed, message)
+		updated = failRequest(req, message)
+	default:
+		return nil, nil
+	}
+
+	err = c.client.CoreV1().Pods(vm.Namespace).Delete(context.Background(), podName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (c *CommitController) updateRequest(vm *v1.VirtualMachine, req *v1.VirtualMachineContainerDiskCommitRequest) error {
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/containerDiskCommitRequest", vm.Status.ContainerDiskCommitRequest),
+		patch.WithReplace("/status/containerDiskCommitRequest", req),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func failRequest(req *v1.VirtualMachineContainerDiskCommitRequest, message string) *v1.VirtualMachineContainerDiskCommitRequest {
+	updated := req.DeepCopy()
+	updated.Phase = v1.ContainerDiskCommitFailed
+	updated.EndTimestamp = pointer.P(metav1.Now())
+	updated.Message = message
+	return updated
+}
+
+func terminationMessage(pod *k8sv1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == commitContainerName && status.State.Terminated != nil {
+			return status.State.Terminated.Message
+		}
+	}
+	return ""
+}
+
+func persistentContainerDiskVolume(vm *v1.VirtualMachine, volumeName string) *v1.Volume {
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	for i := range vm.Spec.Template.Spec.Volumes {
+		volume := &vm.Spec.Template.Spec.Volumes[i]
+		if volume.Name == volumeName && storagetypes.IsPersistentContainerDisk(volume) {
+			return volume
+		}
+	}
+	return nil
+}
+
+func commitPodName(vmName, volumeName string) string {
+	return fmt.Sprintf("%s-%s-commit", vmName, volumeName)
+}
+
+func cacheKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+// newCommitPod returns the pod flattening the overlay into the target. The image of the
+// containerDisk is pinned to the digest recorded on the overlay, the one it was written on top of
+func newCommitPod(vm *v1.VirtualMachine, volume *v1.Volume, overlay, target *k8sv1.PersistentVolumeClaim, launcherImage string) *k8sv1.Pod {
+	container := k8sv1.Container{
+		Name:                     commitContainerName,
+		Image:                    launcherImage,
+		ImagePullPolicy:          k8sv1.PullIfNotPresent,
+		TerminationMessagePolicy: k8sv1.TerminationMessageFallbackToLogsOnError,
+		SecurityContext: &k8sv1.SecurityContext{
+			AllowPrivilegeEscalation: pointer.P(false),
+			Capabilities: &k8sv1.Capabilities{
+				Drop: []k8sv1.Capability{"ALL"},
+			},
+		},
+		VolumeMounts: []k8sv1.VolumeMount{
+			{Name: "overlay", MountPath: commitOverlayDir, ReadOnly: true},
+			{Name: "containerdisk", MountPath: commitContainerDiskDir, ReadOnly: true},
+		},
+	}
+
+	imagePath := ""
+	if volume.ContainerDisk.Path != "" {
+		imagePath = filepath.Join(commitContainerDiskDir, volume.ContainerDisk.Path)
+	}
+	container.Env = []k8sv1.EnvVar{{Name: "IMAGE_PATH", Value: imagePath}}
+
+	targetArgs, targetPath := "", filepath.Join(commitTargetDir, commitTargetImageFile)
+	if storagetypes.IsPVCBlock(target.Spec.VolumeMode) {
+		targetArgs, targetPath = "-n", commitTargetDevice
+		container.VolumeDevices = []k8sv1.VolumeDevice{{Name: "target", DevicePath: commitTargetDevice}}
+	} else {
+		container.VolumeMounts = append(container.VolumeMounts, k8sv1.VolumeMount{Name: "target", MountPath: commitTargetDir})
+	}
+	container.Command = []string{"/bin/bash", "-c", fmt.Sprintf(commitScript,
+		filepath.Join(commitContainerDiskDir, osdisk.DiskSourceFallbackPath),
+		targetArgs,
+		filepath.Join(commitOverlayDir, commitOverlayImageFile),
+		targetPath,
+	)}
+
+	image := volume.ContainerDisk.Image
+	if imageID, ok := overlay.Annotations[ImageIDAnnotation]; ok {
+		image = imageID
+	}
+
+	var imagePullSecrets []k8sv1.LocalObjectReference
+	if volume.ContainerDisk.ImagePullSecret != "" {
+		imagePullSecrets = append(imagePullSecrets, k8sv1.LocalObjectReference{Name: volume.ContainerDisk.ImagePullSecret})
+	}
+
+	return &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      commitPodName(vm.Name, volume.Name),
+			Namespace: vm.Namespace,
+			Labels: map[string]string{
+				v1.AppLabel:            commitPodApp,
+				v1.VirtualMachineLabel: vm.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			RestartPolicy: k8sv1.RestartPolicyNever,
+			SecurityContext: &k8sv1.PodSecurityContext{
+				RunAsNonRoot:   pointer.P(true),
+				RunAsUser:      pointer.P(int64(util.NonRootUID)),
+				FSGroup:        pointer.P(int64(util.NonRootUID)),
+				SeccompProfile: &k8sv1.SeccompProfile{Type: k8sv1.SeccompProfileTypeRuntimeDefault},
+			},
+			ImagePullSecrets: imagePullSecrets,
+			Containers:       []k8sv1.Container{container},
+			Volumes: []k8sv1.Volume{
+				{
+					Name: "overlay",
+					VolumeSource: k8sv1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: storagetypes.PersistentContainerDiskClaimName(vm.Name, volume.Name),
+							ReadOnly:  true,
+						},
+					},
+				},
+				{
+					Name: "containerdisk",
+					VolumeSource: k8sv1.VolumeSource{
+						Image: &k8sv1.ImageVolumeSource{
+							Reference:  image,
+							PullPolicy: volume.ContainerDisk.ImagePullPolicy,
+						},
+					},
+				},
+				{
+					Name: "target",
+					VolumeSource: k8sv1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: target.Name,
+						},
+					},
+				},
+			},
+		},
+	}
+}
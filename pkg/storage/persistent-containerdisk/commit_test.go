@@ -0,0 +1,396 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package persistentcontainerdisk
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Persistent containerDisk", func() {
+	const (
+		ns         = "test"
+		vmName     = "vm"
+		volumeName = "disk0"
+		overlay    = "vm-disk0-overlay"
+		target     = "target"
+	)
+
+	var (
+		kubevirtClient *kubevirtfake.Clientset
+		k8sClient      *k8sfake.Clientset
+		virtClient     *kubecli.MockKubevirtClient
+		recorder       *record.FakeRecorder
+		vmStore        cache.Store
+		vmiStore       cache.Store
+		pvcStore       cache.Store
+		dvStore        cache.Store
+		podStore       cache.Store
+		kvStore        cache.Store
+		ctrl           *CommitController
+	)
+
+	newVM := func(req *v1.VirtualMachineContainerDiskCommitRequest) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithNamespace(ns), libvmi.WithName(vmName),
+			libvmi.WithContainerDisk(volumeName, "registry:5000/disk"),
+		))
+		vm.Spec.Template.Spec.Volumes[0].ContainerDisk.Persistent = &v1.PersistentContainerDisk{
+			Capacity: resource.MustParse("10Gi"),
+		}
+		vm.Status.ContainerDiskCommitRequest = req
+		return vm
+	}
+
+	addVM := func(vm *v1.VirtualMachine) {
+		Expect(vmStore.Add(vm)).To(Succeed())
+		_, err := kubevirtClient.KubevirtV1().VirtualMachines(ns).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addPVC := func(name string, volumeMode k8sv1.PersistentVolumeMode) {
+		Expect(pvcStore.Add(&k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec:       k8sv1.PersistentVolumeClaimSpec{VolumeMode: pointer.P(volumeMode)},
+		})).To(Succeed())
+	}
+
+	addOverlay := func(annotations map[string]string) {
+		Expect(pvcStore.Add(&k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: overlay, Namespace: ns, Annotations: annotations},
+			Spec:       k8sv1.PersistentVolumeClaimSpec{VolumeMode: pointer.P(k8sv1.PersistentVolumeFilesystem)},
+		})).To(Succeed())
+		_, err := k8sClient.CoreV1().PersistentVolumeClaims(ns).Create(context.Background(), &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: overlay, Namespace: ns, Annotations: annotations},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addPod := func(pod *k8sv1.Pod) {
+		Expect(podStore.Add(pod)).To(Succeed())
+		_, err := k8sClient.CoreV1().Pods(ns).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	pendingRequest := func() *v1.VirtualMachineContainerDiskCommitRequest {
+		return &v1.VirtualMachineContainerDiskCommitRequest{
+			VolumeName: volumeName,
+			ClaimName:  target,
+			Phase:      v1.ContainerDiskCommitPending,
+		}
+	}
+
+	inProgressRequest := func() *v1.VirtualMachineContainerDiskCommitRequest {
+		req := pendingRequest()
+		req.Phase = v1.ContainerDiskCommitInProgress
+		return req
+	}
+
+	execute := func() *v1.VirtualMachineContainerDiskCommitRequest {
+		Expect(ctrl.execute(controller.NamespacedKey(ns, vmName))).To(Succeed())
+		vm, err := kubevirtClient.KubevirtV1().VirtualMachines(ns).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm.Status.ContainerDiskCommitRequest
+	}
+
+	commitPod := func() (*k8sv1.Pod, error) {
+		return k8sClient.CoreV1().Pods(ns).Get(context.Background(), "vm-disk0-commit", metav1.GetOptions{})
+	}
+
+	BeforeEach(func() {
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachine(ns).Return(kubevirtClient.KubevirtV1().VirtualMachines(ns)).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		vmStore = vmInformer.GetStore()
+		vmiStore = vmiInformer.GetStore()
+		pvcStore = pvcInformer.GetStore()
+		dvStore = dvInformer.GetStore()
+		podStore = podInformer.GetStore()
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		var clusterConfig *virtconfig.ClusterConfig
+		clusterConfig, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.PersistentContainerDiskGate, featuregate.ImageVolume},
+			},
+		})
+
+		var err error
+		ctrl, err = NewCommitController(virtClient, vmInformer, vmiInformer, pvcInformer, dvInformer, podInformer, "launcher:latest", clusterConfig, recorder)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("overlay PVCs", func() {
+		It("should create the missing overlay PVC owned by the VM", func() {
+			vm := newVM(nil)
+			Expect(CreateOverlayPVCs(virtClient, vm, pvcStore)).To(Succeed())
+
+			pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(ns).Get(context.Background(), overlay, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Spec.AccessModes).To(ConsistOf(k8sv1.ReadWriteOnce))
+			Expect(*pvc.Spec.VolumeMode).To(Equal(k8sv1.PersistentVolumeFilesystem))
+			Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("10Gi"))
+			Expect(pvc.OwnerReferences).To(HaveLen(1))
+			Expect(pvc.OwnerReferences[0].Kind).To(Equal("VirtualMachine"))
+			Expect(pvc.OwnerReferences[0].Name).To(Equal(vmName))
+			Expect(pvc.Annotations).To(HaveKeyWithValue(ImageAnnotation, "registry:5000/disk"))
+		})
+
+		It("should keep the existing overlay PVC", func() {
+			addPVC(overlay, k8sv1.PersistentVolumeFilesystem)
+			Expect(CreateOverlayPVCs(virtClient, newVM(nil), pvcStore)).To(Succeed())
+
+			_, err := k8sClient.CoreV1().PersistentVolumeClaims(ns).Get(context.Background(), overlay, metav1.GetOptions{})
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		DescribeTable("should check the image the overlay was created on top of", func(annotations map[string]string, matcher types.GomegaMatcher) {
+			addOverlay(annotations)
+			Expect(CheckOverlayImages(newVM(nil), pvcStore)).To(matcher)
+		},
+			Entry("when nothing was recorded", nil, Succeed()),
+			Entry("when the image is the same", map[string]string{ImageAnnotation: "registry:5000/disk"}, Succeed()),
+			Entry("when the image is the recorded digest", map[string]string{
+				ImageAnnotation:   "registry:5000/other",
+				ImageIDAnnotation: "registry:5000/disk",
+			}, Succeed()),
+			Entry("when the image changed", map[string]string{ImageAnnotation: "registry:5000/other"},
+				MatchError(ContainSubstring("was created on top of image registry:5000/other"))),
+		)
+
+		It("should pin the containerDisk to the recorded digest", func() {
+			addOverlay(map[string]string{ImageIDAnnotation: "registry:5000/disk@sha256:1234"})
+			vmi := newVM(nil).Spec.Template
+			imageIDs, err := OverlayImageIDs(&v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: vmName, Namespace: ns},
+				Spec:       vmi.Spec,
+			}, pvcStore)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(imageIDs).To(Equal(map[string]string{volumeName: "registry:5000/disk@sha256:1234"}))
+		})
+
+		It("should record the pulled digest on the overlay once", func() {
+			addOverlay(map[string]string{ImageAnnotation: "registry:5000/disk"})
+			vmi := &v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: vmName, Namespace: ns},
+				Spec:       newVM(nil).Spec.Template.Spec,
+			}
+			Expect(RecordOverlayImageIDs(virtClient, vmi, pvcStore, map[string]string{volumeName: "registry:5000/disk@sha256:1234"})).To(Succeed())
+
+			pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(ns).Get(context.Background(), overlay, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Annotations).To(HaveKeyWithValue(ImageIDAnnotation, "registry:5000/disk@sha256:1234"))
+
+			By("Keeping the recorded digest")
+			Expect(pvcStore.Update(pvc)).To(Succeed())
+			Expect(RecordOverlayImageIDs(virtClient, vmi, pvcStore, map[string]string{volumeName: "registry:5000/disk@sha256:5678"})).To(Succeed())
+			pvc, err = k8sClient.CoreV1().PersistentVolumeClaims(ns).Get(context.Background(), overlay, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Annotations).To(HaveKeyWithValue(ImageIDAnnotation, "registry:5000/disk@sha256:1234"))
+		})
+	})
+
+	Context("commit", func() {
+		It("should wait for the VMI to go away", func() {
+			addVM(newVM(pendingRequest()))
+			addPVC(overlay, k8sv1.PersistentVolumeFilesystem)
+			addPVC(target, k8sv1.PersistentVolumeFilesystem)
+			Expect(vmiStore.Add(libvmi.New(libvmi.WithNamespace(ns), libvmi.WithName(vmName)))).To(Succeed())
+
+			Expect(execute().Phase).To(Equal(v1.ContainerDiskCommitPending))
+			_, err := commitPod()
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should wait for the DataVolume of the target to succeed", func() {
+			addVM(newVM(pendingRequest()))
+			addPVC(overlay, k8sv1.PersistentVolumeFilesystem)
+			Expect(dvStore.Add(&cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: target, Namespace: ns},
+				Status:     cdiv1.DataVolumeStatus{Phase: cdiv1.ImportInProgress},
+			})).To(Succeed())
+
+			Expect(execute().Phase).To(Equal(v1.ContainerDiskCommitPending))
+		})
+
+		It("should fail when the VM was never started", func() {
+			addVM(newVM(pendingRequest()))
+			addPVC(target, k8sv1.PersistentVolumeFilesystem)
+
+			req := execute()
+			Expect(req.Phase).To(Equal(v1.ContainerDiskCommitFailed))
+			Expect(req.Message).To(ContainSubstring("overlay PVC vm-disk0-overlay does not exist"))
+		})
+
+		DescribeTable("should start the commit pod", func(volumeMode k8sv1.PersistentVolumeMode, expectedTarget string) {
+			addVM(newVM(pendingRequest()))
+			addPVC(overlay, k8sv1.PersistentVolumeFilesystem)
+			addPVC(target, volumeMode)
+
+			req := execute()
+			Expect(req.Phase).To(Equal(v1.ContainerDiskCommitInProgress))
+			Expect(req.StartTimestamp).ToNot(BeNil())
+			Expect(recorder.Events).To(Receive(ContainSubstring(ContainerDiskCommitStarted)))
+
+			pod, err := commitPod()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Labels).To(HaveKeyWithValue(v1.AppLabel, commitPodApp))
+			Expect(pod.Labels).To(HaveKeyWithValue(v1.VirtualMachineLabel, vmName))
+			Expect(pod.Spec.Volumes).To(HaveLen(3))
+			Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(overlay))
+			Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+			Expect(pod.Spec.Volumes[1].Image.Reference).To(Equal("registry:5000/disk"))
+			Expect(pod.Spec.Volumes[2].PersistentVolumeClaim.ClaimName).To(Equal(target))
+			container := pod.Spec.Containers[0]
+			Expect(container.Image).To(Equal("launcher:latest"))
+			Expect(container.Command[2]).To(ContainSubstring(`\"filename\":\"/overlay/disk.qcow2\"`))
+			Expect(container.Command[2]).To(ContainSubstring(expectedTarget))
+		},
+			Entry("into a filesystem claim", k8sv1.PersistentVolumeFilesystem, `}}}" /target/disk.img`),
+			Entry("into a block claim", k8sv1.PersistentVolumeBlock, `-O raw -n "json:`),
+		)
+
+		It("should use the digest recorded on the overlay for the commit pod", func() {
+			addVM(newVM(pendingRequest()))
+			addOverlay(map[string]string{ImageIDAnnotation: "registry:5000/disk@sha256:1234"})
+			addPVC(target, k8sv1.PersistentVolumeFilesystem)
+
+			Expect(execute().Phase).To(Equal(v1.ContainerDiskCommitInProgress))
+			pod, err := commitPod()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Spec.Volumes[1].Image.Reference).To(Equal("registry:5000/disk@sha256:1234"))
+		})
+
+		It("should fail the request when the ImageVolume feature gate was disabled", func() {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kv.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.PersistentContainerDiskGate}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			addVM(newVM(pendingRequest()))
+			addPVC(overlay, k8sv1.PersistentVolumeFilesystem)
+			addPVC(target, k8sv1.PersistentVolumeFilesystem)
+
+			req := execute()
+			Expect(req.Phase).To(Equal(v1.ContainerDiskCommitFailed))
+			Expect(req.Message).To(Equal(imageVolumeDisabledMsg))
+			_, err := commitPod()
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should wait for the informer to observe the commit pod", func() {
+			addVM(newVM(pendingRequest()))
+			addPVC(overlay, k8sv1.PersistentVolumeFilesystem)
+			addPVC(target, k8sv1.PersistentVolumeFilesystem)
+
+			req := execute()
+			Expect(req.Phase).To(Equal(v1.ContainerDiskCommitInProgress))
+			vm := newVM(req)
+			Expect(vmStore.Update(vm)).To(Succeed())
+
+			By("Not failing the request while the pod is not in the cache")
+			Expect(execute().Phase).To(Equal(v1.ContainerDiskCommitInProgress))
+
+			By("Following the pod once it was observed")
+			pod, err := commitPod()
+			Expect(err).ToNot(HaveOccurred())
+			pod.Status.Phase = k8sv1.PodSucceeded
+			Expect(podStore.Add(pod)).To(Succeed())
+			ctrl.addCommitPod(pod)
+			Expect(execute().Phase).To(Equal(v1.ContainerDiskCommitCompleted))
+		})
+
+		It("should complete the request and remove the pod once it succeeded", func() {
+			addVM(newVM(inProgressRequest()))
+			addPod(&k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm-disk0-commit", Namespace: ns},
+				Status:     k8sv1.PodStatus{Phase: k8sv1.PodSucceeded},
+			})
+
+			req := execute()
+			Expect(req.Phase).To(Equal(v1.ContainerDiskCommitCompleted))
+			Expect(req.EndTimestamp).ToNot(BeNil())
+			Expect(recorder.Events).To(Receive(ContainSubstring(ContainerDiskCommitCompleted)))
+			_, err := commitPod()
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should fail the request with the termination message of the pod", func() {
+			addVM(newVM(inProgressRequest()))
+			addPod(&k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm-disk0-commit", Namespace: ns},
+				Status: k8sv1.PodStatus{
+					Phase: k8sv1.PodFailed,
+					ContainerStatuses: []k8sv1.ContainerStatus{{
+						Name: commitContainerName,
+						State: k8sv1.ContainerState{
+							Terminated: &k8sv1.ContainerStateTerminated{Message: "no space left on device"},
+						},
+					}},
+				},
+			})
+
+			req := execute()
+			Expect(req.Phase).To(Equal(v1.ContainerDiskCommitFailed))
+			Expect(req.Message).To(Equal("commit pod failed: no space left on device"))
+			Expect(recorder.Events).To(Receive(ContainSubstring(ContainerDiskCommitFailed)))
+		})
+
+		It("should fail the request when the commit pod disappeared", func() {
+			addVM(newVM(inProgressRequest()))
+
+			req := execute()
+			Expect(req.Phase).To(Equal(v1.ContainerDiskCommitFailed))
+			Expect(req.Message).To(Equal("commit pod vm-disk0-commit disappeared"))
+		})
+	})
+})
@@ -0,0 +1,216 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package persistentcontainerdisk
+
+import (
+	"context"
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	ErrorReason = "PersistentContainerDiskError"
+
+	// ImageAnnotation records the image of the containerDisk the overlay was created on top of
+	ImageAnnotation = "kubevirt.io/containerdisk-image"
+	// ImageIDAnnotation records the digest the container runtime pulled for the image of the
+	// containerDisk when the overlay was first used, later launches are pinned to it
+	ImageIDAnnotation = "kubevirt.io/containerdisk-image-id"
+
+	imageMismatchErrFmt = "overlay PVC %s of containerDisk %s was created on top of image %s, commit it or delete the PVC before switching to image %s"
+)
+
+// IsCommitInProgress returns true while a commit of a persistent containerDisk
+// reads the overlays of the VM, the VM must not be started meanwhile
+func IsCommitInProgress(vm *v1.VirtualMachine) bool {
+	req := vm.Status.ContainerDiskCommitRequest
+	return req != nil && (req.Phase == v1.ContainerDiskCommitPending || req.Phase == v1.ContainerDiskCommitInProgress)
+}
+
+// NewOverlayPVC returns the PVC holding the writable overlay of a persistent containerDisk.
+// The PVC is owned by the VM, so that it is removed together with the VM
+func NewOverlayPVC(vm *v1.VirtualMachine, volume *v1.Volume) *k8sv1.PersistentVolumeClaim {
+	persistent := volume.ContainerDisk.Persistent
+	accessModes := persistent.AccessModes
+	if len(accessModes) == 0 {
+		accessModes = []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce}
+	}
+
+	return &k8sv1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      storagetypes.PersistentContainerDiskClaimName(vm.Name, volume.Name),
+			Namespace: vm.Namespace,
+			Labels: map[string]string{
+				v1.VirtualMachineLabel: vm.Name,
+			},
+			Annotations: map[string]string{
+				ImageAnnotation: volume.ContainerDisk.Image,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Spec: k8sv1.PersistentVolumeClaimSpec{
+			AccessModes:      accessModes,
+			StorageClassName: persistent.StorageClassName,
+			VolumeMode:       pointer.P(k8sv1.PersistentVolumeFilesystem),
+			Resources: k8sv1.VolumeResourceRequirements{
+				Requests: k8sv1.ResourceList{
+					k8sv1.ResourceStorage: persistent.Capacity,
+				},
+			},
+		},
+	}
+}
+
+// CreateOverlayPVCs creates the missing overlay PVCs of the persistent containerDisks of a VM
+func CreateOverlayPVCs(client kubecli.KubevirtClient, vm *v1.VirtualMachine, pvcStore cache.Store) error {
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	for i := range vm.Spec.Template.Spec.Volumes {
+		volume := &vm.Spec.Template.Spec.Volumes[i]
+		if !storagetypes.IsPersistentContainerDisk(volume) {
+			continue
+		}
+		claimName := storagetypes.PersistentContainerDiskClaimName(vm.Name, volume.Name)
+		pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, claimName, pvcStore)
+		if err != nil {
+			return err
+		}
+		if pvc != nil {
+			continue
+		}
+		_, err = client.CoreV1().PersistentVolumeClaims(vm.Namespace).Create(context.Background(), NewOverlayPVC(vm, volume), metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create overlay PVC %s: %v", claimName, err)
+		}
+		log.Log.Object(vm).V(3).Infof("Created overlay PVC %s for persistent containerDisk %s", claimName, volume.Name)
+	}
+	return nil
+}
+
+// CheckOverlayImages returns an error when the image of a persistent containerDisk changed since
+// its overlay was created. The overlay only holds the blocks written on top of its image, it can't
+// be used on top of a different one
+func CheckOverlayImages(vm *v1.VirtualMachine, pvcStore cache.Store) error {
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	for i := range vm.Spec.Template.Spec.Volumes {
+		volume := &vm.Spec.Template.Spec.Volumes[i]
+		if !storagetypes.IsPersistentContainerDisk(volume) {
+			continue
+		}
+		claimName := storagetypes.PersistentContainerDiskClaimName(vm.Name, volume.Name)
+		pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, claimName, pvcStore)
+		if err != nil {
+			return err
+		}
+		if pvc == nil {
+			continue
+		}
+		image, ok := pvc.Annotations[ImageAnnotation]
+		if !ok || image == volume.ContainerDisk.Image || pvc.Annotations[ImageIDAnnotation] == volume.ContainerDisk.Image {
+			continue
+		}
+		return fmt.Errorf(imageMismatchErrFmt, claimName, volume.Name, image, volume.ContainerDisk.Image)
+	}
+	return nil
+}
+
+// OverlayImageIDs returns the digests recorded on the overlay PVCs of the persistent containerDisks
+// of a VMI, so that a tag moved to a new image since the overlay was created is not pulled
+func OverlayImageIDs(vmi *v1.VirtualMachineInstance, pvcStore cache.Store) (map[string]string, error) {
+	imageIDs := map[string]string{}
+	for i := range vmi.Spec.Volumes {
+		volume := &vmi.Spec.Volumes[i]
+		if !storagetypes.IsPersistentContainerDisk(volume) {
+			continue
+		}
+		claimName := storagetypes.PersistentContainerDiskClaimName(vmi.Name, volume.Name)
+		pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vmi.Namespace, claimName, pvcStore)
+		if err != nil {
+			return nil, err
+		}
+		if pvc == nil {
+			continue
+		}
+		if imageID, ok := pvc.Annotations[ImageIDAnnotation]; ok {
+			imageIDs[volume.Name] = imageID
+		}
+	}
+	return imageIDs, nil
+}
+
+// RecordOverlayImageIDs records the digests the container runtime pulled for the persistent
+// containerDisks of a VMI on their overlay PVCs, unless a digest was already recorded
+func RecordOverlayImageIDs(client kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance, pvcStore cache.Store, imageIDs map[string]string) error {
+	for i := range vmi.Spec.Volumes {
+		volume := &vmi.Spec.Volumes[i]
+		imageID, ok := imageIDs[volume.Name]
+		if !ok || !storagetypes.IsPersistentContainerDisk(volume) {
+			continue
+		}
+		claimName := storagetypes.PersistentContainerDiskClaimName(vmi.Name, volume.Name)
+		pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vmi.Namespace, claimName, pvcStore)
+		if err != nil {
+			return err
+		}
+		if pvc == nil {
+			continue
+		}
+		if _, ok := pvc.Annotations[ImageIDAnnotation]; ok {
+			continue
+		}
+
+		patchSet := patch.New()
+		if pvc.Annotations == nil {
+			patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{}))
+		}
+		if _, ok := pvc.Annotations[ImageAnnotation]; !ok {
+			patchSet.AddOption(patch.WithAdd("/metadata/annotations/"+patch.EscapeJSONPointer(ImageAnnotation), volume.ContainerDisk.Image))
+		}
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations/"+patch.EscapeJSONPointer(ImageIDAnnotation), imageID))
+		patchBytes, err := patchSet.GeneratePayload()
+		if err != nil {
+			return err
+		}
+		_, err = client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Patch(context.Background(), claimName, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to record the image digest on overlay PVC %s: %v", claimName, err)
+		}
+		log.Log.Object(vmi).V(3).Infof("Recorded image %s on overlay PVC %s", imageID, claimName)
+	}
+	return nil
+}
@@ -0,0 +1,426 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	// snapshotGroupLabel marks the VirtualMachineSnapshots taken for the members of a group
+	snapshotGroupLabel = "snapshot.kubevirt.io/snapshot-group"
+
+	snapshotGroupMemberCreatedEvent = "VirtualMachineSnapshotGroupMemberCreated"
+	snapshotGroupFrozenEvent        = "VirtualMachineSnapshotGroupFrozen"
+	snapshotGroupThawedEvent        = "VirtualMachineSnapshotGroupThawed"
+	snapshotGroupFailedEvent        = "VirtualMachineSnapshotGroupFailed"
+)
+
+func snapshotGroupFrozen(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && group.Status.FreezeTime != nil && group.Status.Phase != snapshotv1.Failed
+}
+
+func snapshotGroupDone(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && (group.Status.Phase == snapshotv1.Succeeded || group.Status.Phase == snapshotv1.Failed)
+}
+
+func snapshotGroupDeadlineExceeded(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	failureDeadline := snapshotv1.DefaultFailureDeadline
+	if group.Spec.FailureDeadline != nil {
+		failureDeadline = group.Spec.FailureDeadline.Duration
+	}
+	// No Deadline set by user
+	if failureDeadline == 0 {
+		return false
+	}
+	return time.Until(group.CreationTimestamp.Add(failureDeadline)) < 0
+}
+
+func snapshotGroupMemberName(group *snapshotv1.VirtualMachineSnapshotGroup, vmName string) string {
+	return fmt.Sprintf("%s-%s", group.Name, vmName)
+}
+
+func (ctrl *VMSnapshotController) vmSnapshotGroupWorker() {
+	for ctrl.processVMSnapshotGroupWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotController) processVMSnapshotGroupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotGroupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotGroup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		group, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotGroup)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMSnapshotGroup(group.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotController) handleVMSnapshotGroup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if group, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(group)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, group)
+			return
+		}
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmSnapshotGroupQueue.Add(objName)
+
+		// The contents of the members wait for the group to freeze all of them
+		if group.Status == nil {
+			return
+		}
+		for _, member := range group.Status.Members {
+			vmSnapshot, err := ctrl.getVMSnapshotByName(group.Namespace, member.VirtualMachineSnapshotName)
+			if err != nil || vmSnapshot == nil {
+				continue
+			}
+			ctrl.vmSnapshotContentQueue.Add(cacheKeyFunc(group.Namespace, GetVMSnapshotContentName(vmSnapshot)))
+		}
+	}
+}
+
+// enqueueSnapshotGroup enqueues the group which created the VirtualMachineSnapshot
+func (ctrl *VMSnapshotController) enqueueSnapshotGroup(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+	groupName, ok := vmSnapshot.Labels[snapshotGroupLabel]
+	if !ok {
+		return
+	}
+	ctrl.vmSnapshotGroupQueue.Add(cacheKeyFunc(vmSnapshot.Namespace, groupName))
+}
+
+// getSnapshotGroup returns the group a VirtualMachineSnapshot was taken for, if any
+func (ctrl *VMSnapshotController) getSnapshotGroup(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (*snapshotv1.VirtualMachineSnapshotGroup, error) {
+	if vmSnapshot == nil {
+		return nil, nil
+	}
+	groupName, ok := vmSnapshot.Labels[snapshotGroupLabel]
+	if !ok {
+		return nil, nil
+	}
+
+	obj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(cacheKeyFunc(vmSnapshot.Namespace, groupName))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*snapshotv1.VirtualMachineSnapshotGroup).DeepCopy(), nil
+}
+
+func (ctrl *VMSnapshotController) getVMSnapshotByName(namespace, name string) (*snapshotv1.VirtualMachineSnapshot, error) {
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*snapshotv1.VirtualMachineSnapshot).DeepCopy(), nil
+}
+
+// updateVMSnapshotGroup takes a VirtualMachineSnapshot of every member of the group.
+// Once all members locked their source, the group freezes all of them at once, the
+// members take their volume snapshots and the group thaws them when every volume
+// snapshot was taken.
+func (ctrl *VMSnapshotController) updateVMSnapshotGroup(group *snapshotv1.VirtualMachineSnapshotGroup) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotGroup %s/%s", group.Namespace, group.Name)
+
+	if group.DeletionTimestamp != nil || snapshotGroupDone(group) {
+		// The members are owned by the group, deleting them unfreezes their source
+		return 0, nil
+	}
+
+	groupCpy := group.DeepCopy()
+	if groupCpy.Status == nil {
+		groupCpy.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.P(false),
+		}
+		updateSnapshotGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionTrue, "Resolving the members of the group"))
+		updateSnapshotGroupCondition(groupCpy, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+		return 0, ctrl.updateSnapshotGroupStatus(group, groupCpy)
+	}
+
+	if len(groupCpy.Status.Members) == 0 {
+		members, err := ctrl.resolveSnapshotGroupMembers(groupCpy)
+		if err != nil {
+			return 0, err
+		}
+		if len(members) == 0 {
+			return 0, ctrl.failSnapshotGroup(group, groupCpy, "No VirtualMachines match the selector of the group")
+		}
+		groupCpy.Status.Members = members
+		return 0, ctrl.updateSnapshotGroupStatus(group, groupCpy)
+	}
+
+	if snapshotGroupDeadlineExceeded(groupCpy) {
+		return 0, ctrl.failSnapshotGroup(group, groupCpy, "Failed to create the snapshot group within the failure deadline")
+	}
+
+	var vmSnapshots []*snapshotv1.VirtualMachineSnapshot
+	for i, member := range groupCpy.Status.Members {
+		vmSnapshot, err := ctrl.getVMSnapshotByName(groupCpy.Namespace, member.VirtualMachineSnapshotName)
+		if err != nil {
+			return 0, err
+		}
+		if vmSnapshot == nil {
+			if err := ctrl.createSnapshotGroupMember(groupCpy, member); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if vmSnapshotFailed(vmSnapshot) {
+			return 0, ctrl.failSnapshotGroup(group, groupCpy, fmt.Sprintf("VirtualMachineSnapshot %s failed", vmSnapshot.Name))
+		}
+		groupCpy.Status.Members[i].ReadyToUse = pointer.P(VmSnapshotReady(vmSnapshot))
+		vmSnapshots = append(vmSnapshots, vmSnapshot)
+	}
+	if len(vmSnapshots) < len(groupCpy.Status.Members) {
+		return snapshotRetryInterval, ctrl.updateSnapshotGroupStatus(group, groupCpy)
+	}
+
+	var contents []*snapshotv1.VirtualMachineSnapshotContent
+	for _, vmSnapshot := range vmSnapshots {
+		content, err := ctrl.getContent(vmSnapshot)
+		if err != nil {
+			return 0, err
+		}
+		if content != nil {
+			contents = append(contents, content)
+		}
+	}
+	if len(contents) < len(vmSnapshots) {
+		updateSnapshotGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionTrue, "Waiting for all members to lock their source"))
+		return snapshotRetryInterval, ctrl.updateSnapshotGroupStatus(group, groupCpy)
+	}
+
+	if groupCpy.Status.FreezeTime == nil {
+		if err := ctrl.freezeSnapshotGroup(vmSnapshots); err != nil {
+			groupCpy.Status.Error = &snapshotv1.Error{
+				Time:    currentTime(),
+				Message: pointer.P(err.Error()),
+			}
+			// Retry again in 5 seconds
+			return snapshotRetryInterval, ctrl.updateSnapshotGroupStatus(group, groupCpy)
+		}
+		groupCpy.Status.FreezeTime = currentTime()
+		groupCpy.Status.Error = nil
+		updateSnapshotGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionTrue, "Taking the volume snapshots of all members"))
+		ctrl.Recorder.Eventf(groupCpy, corev1.EventTypeNormal, snapshotGroupFrozenEvent, "Froze %d VirtualMachines", len(vmSnapshots))
+		return 0, ctrl.updateSnapshotGroupStatus(group, groupCpy)
+	}
+
+	if groupCpy.Status.CreationTime == nil {
+		for _, content := range contents {
+			if !vmSnapshotContentCreated(content) {
+				return 0, ctrl.updateSnapshotGroupStatus(group, groupCpy)
+			}
+		}
+		if err := ctrl.thawSnapshotGroup(vmSnapshots); err != nil {
+			return 0, err
+		}
+		groupCpy.Status.CreationTime = currentTime()
+		ctrl.Recorder.Eventf(groupCpy, corev1.EventTypeNormal, snapshotGroupThawedEvent, "Thawed %d VirtualMachines", len(vmSnapshots))
+	}
+
+	ready := true
+	for _, member := range groupCpy.Status.Members {
+		if member.ReadyToUse == nil || !*member.ReadyToUse {
+			ready = false
+		}
+	}
+	if ready {
+		groupCpy.Status.Phase = snapshotv1.Succeeded
+		groupCpy.Status.ReadyToUse = pointer.P(true)
+		updateSnapshotGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+		updateSnapshotGroupCondition(groupCpy, newReadyCondition(corev1.ConditionTrue, "Ready"))
+	} else {
+		updateSnapshotGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionTrue, "Waiting for the volume snapshots of all members to be ready"))
+	}
+
+	return 0, ctrl.updateSnapshotGroupStatus(group, groupCpy)
+}
+
+// resolveSnapshotGroupMembers lists the VMs matching the selector of the group
+func (ctrl *VMSnapshotController) resolveSnapshotGroupMembers(group *snapshotv1.VirtualMachineSnapshotGroup) ([]snapshotv1.VirtualMachineSnapshotGroupMember, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, group.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []snapshotv1.VirtualMachineSnapshotGroupMember
+	for _, obj := range objs {
+		vm, ok := obj.(*kubevirtv1.VirtualMachine)
+		if !ok || vm.DeletionTimestamp != nil || !selector.Matches(labels.Set(vm.Labels)) {
+			continue
+		}
+		members = append(members, snapshotv1.VirtualMachineSnapshotGroupMember{
+			VirtualMachineName:         vm.Name,
+			VirtualMachineSnapshotName: snapshotGroupMemberName(group, vm.Name),
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].VirtualMachineName < members[j].VirtualMachineName
+	})
+
+	return members, nil
+}
+
+func (ctrl *VMSnapshotController) createSnapshotGroupMember(group *snapshotv1.VirtualMachineSnapshotGroup, member snapshotv1.VirtualMachineSnapshotGroupMember) error {
+	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      member.VirtualMachineSnapshotName,
+			Namespace: group.Namespace,
+			Labels: map[string]string{
+				snapshotGroupLabel: group.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(group, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotGroup")),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     member.VirtualMachineName,
+			},
+			DeletionPolicy:  group.Spec.DeletionPolicy,
+			FailureDeadline: group.Spec.FailureDeadline,
+		},
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshot(group.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	ctrl.Recorder.Eventf(group, corev1.EventTypeNormal, snapshotGroupMemberCreatedEvent, "Created VirtualMachineSnapshot %s for VM %s", vmSnapshot.Name, member.VirtualMachineName)
+	return nil
+}
+
+// freezeSnapshotGroup freezes the source of every member, if any of them fails to freeze
+// the members frozen so far are thawed again
+func (ctrl *VMSnapshotController) freezeSnapshotGroup(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) error {
+	for i, vmSnapshot := range vmSnapshots {
+		source, err := ctrl.getSnapshotSource(vmSnapshot)
+		if err == nil && source == nil {
+			err = fmt.Errorf("unable to get snapshot source of %s", vmSnapshot.Name)
+		}
+		if err == nil {
+			err = source.Freeze()
+		}
+		if err != nil {
+			if thawErr := ctrl.thawSnapshotGroup(vmSnapshots[:i]); thawErr != nil {
+				log.Log.Warningf("Failed to thaw the members of the snapshot group: %v", thawErr)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctrl *VMSnapshotController) thawSnapshotGroup(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) error {
+	var errs []error
+	for _, vmSnapshot := range vmSnapshots {
+		if err := ctrl.unfreezeSource(vmSnapshot); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to thaw %d members: %v", len(errs), errs)
+	}
+	return nil
+}
+
+func (ctrl *VMSnapshotController) failSnapshotGroup(group, groupCpy *snapshotv1.VirtualMachineSnapshotGroup, reason string) error {
+	if groupCpy.Status.FreezeTime != nil && groupCpy.Status.CreationTime == nil {
+		var vmSnapshots []*snapshotv1.VirtualMachineSnapshot
+		for _, member := range groupCpy.Status.Members {
+			vmSnapshot, err := ctrl.getVMSnapshotByName(groupCpy.Namespace, member.VirtualMachineSnapshotName)
+			if err != nil {
+				return err
+			}
+			if vmSnapshot != nil {
+				vmSnapshots = append(vmSnapshots, vmSnapshot)
+			}
+		}
+		if err := ctrl.thawSnapshotGroup(vmSnapshots); err != nil {
+			return err
+		}
+	}
+
+	groupCpy.Status.Phase = snapshotv1.Failed
+	groupCpy.Status.ReadyToUse = pointer.P(false)
+	groupCpy.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: pointer.P(reason),
+	}
+	updateSnapshotGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	updateSnapshotGroupCondition(groupCpy, newFailureCondition(corev1.ConditionTrue, reason))
+	ctrl.Recorder.Event(groupCpy, corev1.EventTypeWarning, snapshotGroupFailedEvent, reason)
+
+	return ctrl.updateSnapshotGroupStatus(group, groupCpy)
+}
+
+func (ctrl *VMSnapshotController) updateSnapshotGroupStatus(oldGroup, newGroup *snapshotv1.VirtualMachineSnapshotGroup) error {
+	if !equality.Semantic.DeepEqual(oldGroup.Status, newGroup.Status) {
+		if _, err := ctrl.Client.VirtualMachineSnapshotGroup(newGroup.Namespace).UpdateStatus(context.Background(), newGroup, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateSnapshotGroupCondition(group *snapshotv1.VirtualMachineSnapshotGroup, c snapshotv1.Condition) {
+	group.Status.Conditions = updateCondition(group.Status.Conditions, c)
+}
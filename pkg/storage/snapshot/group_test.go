@@ -0,0 +1,593 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot groups", func() {
+	const groupName = "group"
+
+	var (
+		kubevirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+
+		vmSnapshotStore        cache.Store
+		vmSnapshotContentStore cache.Store
+		vmSnapshotGroupStore   cache.Store
+		vmStore                cache.Store
+	)
+
+	newGroupVM := func(name string, labels map[string]string) *v1.VirtualMachine {
+		return &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    labels,
+			},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+	}
+
+	newSnapshotGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              groupName,
+				Namespace:         testNamespace,
+				UID:               "group-uid",
+				CreationTimestamp: metav1.Now(),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+			},
+		}
+	}
+
+	newMemberSnapshot := func(vmName string, ready bool) *snapshotv1.VirtualMachineSnapshot {
+		return &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      groupName + "-" + vmName,
+				Namespace: testNamespace,
+				UID:       types.UID("uid-" + vmName),
+				Labels:    map[string]string{snapshotGroupLabel: groupName},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				ReadyToUse: pointer.P(ready),
+				Phase:      snapshotv1.InProgress,
+			},
+		}
+	}
+
+	getGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		group, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace).Get(context.Background(), groupName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return group
+	}
+
+	Context("VirtualMachineSnapshotGroup", func() {
+		var controller *VMSnapshotController
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+			virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+				Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+			virtClient.EXPECT().VirtualMachineSnapshotGroup(testNamespace).
+				Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace)).AnyTimes()
+
+			vmSnapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+			vmSnapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+			vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+			vmInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+			vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+			podInformer, _ := testutils.NewFakeInformerFor(&corev1.Pod{})
+			storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
+			storageProfileInformer, _ := testutils.NewFakeInformerFor(&cdiv1.StorageProfile{})
+			pvcInformer, _ := testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+			crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
+			dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			crInformer, _ := testutils.NewFakeInformerWithIndexersFor(&appsv1.ControllerRevision{}, virtcontroller.GetControllerRevisionInformerIndexers())
+
+			vmSnapshotStore = vmSnapshotInformer.GetStore()
+			vmSnapshotContentStore = vmSnapshotContentInformer.GetStore()
+			vmSnapshotGroupStore = vmSnapshotGroupInformer.GetStore()
+			vmStore = vmInformer.GetStore()
+
+			recorder = record.NewFakeRecorder(100)
+			recorder.IncludeObject = true
+
+			controller = &VMSnapshotController{
+				Client:                    virtClient,
+				VMSnapshotInformer:        vmSnapshotInformer,
+				VMSnapshotContentInformer: vmSnapshotContentInformer,
+				VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
+				VMInformer:                vmInformer,
+				VMIInformer:               vmiInformer,
+				PodInformer:               podInformer,
+				StorageClassInformer:      storageClassInformer,
+				StorageProfileInformer:    storageProfileInformer,
+				PVCInformer:               pvcInformer,
+				CRDInformer:               crdInformer,
+				DVInformer:                dvInformer,
+				CRInformer:                crInformer,
+				Recorder:                  recorder,
+				ResyncPeriod:              60 * time.Second,
+			}
+			Expect(controller.Init()).To(Succeed())
+		})
+
+		createGroup := func(group *snapshotv1.VirtualMachineSnapshotGroup) {
+			_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace).Create(context.Background(), group, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should initialize the status", func() {
+			group := newSnapshotGroup()
+			createGroup(group)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := getGroup()
+			Expect(updated.Status).ToNot(BeNil())
+			Expect(updated.Status.Phase).To(Equal(snapshotv1.InProgress))
+			Expect(updated.Status.ReadyToUse).To(HaveValue(BeFalse()))
+		})
+
+		It("should resolve the members matching the selector", func() {
+			Expect(vmStore.Add(newGroupVM("vm-b", map[string]string{"app": "db"}))).To(Succeed())
+			Expect(vmStore.Add(newGroupVM("vm-a", map[string]string{"app": "db"}))).To(Succeed())
+			Expect(vmStore.Add(newGroupVM("vm-c", map[string]string{"app": "web"}))).To(Succeed())
+
+			group := newSnapshotGroup()
+			group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{Phase: snapshotv1.InProgress}
+			createGroup(group)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := getGroup()
+			Expect(updated.Status.Members).To(Equal([]snapshotv1.VirtualMachineSnapshotGroupMember{
+				{VirtualMachineName: "vm-a", VirtualMachineSnapshotName: "group-vm-a"},
+				{VirtualMachineName: "vm-b", VirtualMachineSnapshotName: "group-vm-b"},
+			}))
+		})
+
+		It("should fail when no VirtualMachine matches the selector", func() {
+			Expect(vmStore.Add(newGroupVM("vm-c", map[string]string{"app": "web"}))).To(Succeed())
+
+			group := newSnapshotGroup()
+			group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{Phase: snapshotv1.InProgress}
+			createGroup(group)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := getGroup()
+			Expect(updated.Status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(updated.Status.Error).ToNot(BeNil())
+			testutils.ExpectEvent(recorder, snapshotGroupFailedEvent)
+		})
+
+		It("should create a VirtualMachineSnapshot per member", func() {
+			group := newSnapshotGroup()
+			group.Spec.DeletionPolicy = pointer.P(snapshotv1.VirtualMachineSnapshotContentRetain)
+			group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+				Phase: snapshotv1.InProgress,
+				Members: []snapshotv1.VirtualMachineSnapshotGroupMember{
+					{VirtualMachineName: "vm-a", VirtualMachineSnapshotName: "group-vm-a"},
+				},
+			}
+			createGroup(group)
+
+			retry, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(Equal(snapshotRetryInterval))
+
+			vmSnapshot, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Get(context.Background(), "group-vm-a", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotGroupLabel, groupName))
+			Expect(vmSnapshot.Spec.Source.Name).To(Equal("vm-a"))
+			Expect(vmSnapshot.Spec.DeletionPolicy).To(Equal(group.Spec.DeletionPolicy))
+			Expect(vmSnapshot.OwnerReferences).To(HaveLen(1))
+			Expect(vmSnapshot.OwnerReferences[0].Kind).To(Equal("VirtualMachineSnapshotGroup"))
+			testutils.ExpectEvent(recorder, snapshotGroupMemberCreatedEvent)
+		})
+
+		It("should fail when a member snapshot failed", func() {
+			vmSnapshot := newMemberSnapshot("vm-a", false)
+			vmSnapshot.Status.Phase = snapshotv1.Failed
+			Expect(vmSnapshotStore.Add(vmSnapshot)).To(Succeed())
+
+			group := newSnapshotGroup()
+			group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+				Phase: snapshotv1.InProgress,
+				Members: []snapshotv1.VirtualMachineSnapshotGroupMember{
+					{VirtualMachineName: "vm-a", VirtualMachineSnapshotName: "group-vm-a"},
+				},
+			}
+			createGroup(group)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getGroup().Status.Phase).To(Equal(snapshotv1.Failed))
+		})
+
+		It("should succeed once all members are ready", func() {
+			for _, vmName := range []string{"vm-a", "vm-b"} {
+				vmSnapshot := newMemberSnapshot(vmName, true)
+				Expect(vmSnapshotStore.Add(vmSnapshot)).To(Succeed())
+				Expect(vmSnapshotContentStore.Add(&snapshotv1.VirtualMachineSnapshotContent{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      GetVMSnapshotContentName(vmSnapshot),
+						Namespace: testNamespace,
+					},
+					Status: &snapshotv1.VirtualMachineSnapshotContentStatus{
+						CreationTime: currentTime(),
+						ReadyToUse:   pointer.P(true),
+					},
+				})).To(Succeed())
+			}
+
+			group := newSnapshotGroup()
+			group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+				Phase:        snapshotv1.InProgress,
+				FreezeTime:   currentTime(),
+				CreationTime: currentTime(),
+				Members: []snapshotv1.VirtualMachineSnapshotGroupMember{
+					{VirtualMachineName: "vm-a", VirtualMachineSnapshotName: "group-vm-a"},
+					{VirtualMachineName: "vm-b", VirtualMachineSnapshotName: "group-vm-b"},
+				},
+			}
+			createGroup(group)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := getGroup()
+			Expect(updated.Status.Phase).To(Equal(snapshotv1.Succeeded))
+			Expect(updated.Status.ReadyToUse).To(HaveValue(BeTrue()))
+			for _, member := range updated.Status.Members {
+				Expect(member.ReadyToUse).To(HaveValue(BeTrue()))
+			}
+		})
+
+		It("should not take volume snapshots of a member before the group froze it", func() {
+			vmSnapshot := newMemberSnapshot("vm-a", false)
+			Expect(vmSnapshotStore.Add(vmSnapshot)).To(Succeed())
+
+			group := newSnapshotGroup()
+			group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{Phase: snapshotv1.InProgress}
+			Expect(vmSnapshotGroupStore.Add(group)).To(Succeed())
+
+			content := &snapshotv1.VirtualMachineSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      GetVMSnapshotContentName(vmSnapshot),
+					Namespace: testNamespace,
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+					VirtualMachineSnapshotName: pointer.P(vmSnapshot.Name),
+					VolumeBackups: []snapshotv1.VolumeBackup{
+						{
+							VolumeName:         diskName,
+							VolumeSnapshotName: pointer.P("vmsnapshot-vm-a-volume-disk1"),
+						},
+					},
+				},
+			}
+
+			// No client call is expected while the group did not freeze the member
+			retry, err := controller.updateVMSnapshotContent(content)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(BeZero())
+		})
+	})
+
+	Context("VirtualMachineRestoreGroup", func() {
+		const restoreGroupName = "restore"
+
+		var (
+			controller          *VMRestoreController
+			vmRestoreStore      cache.Store
+			vmRestoreGroupStore cache.Store
+		)
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+			virtClient.EXPECT().VirtualMachineRestore(testNamespace).
+				Return(kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace)).AnyTimes()
+			virtClient.EXPECT().VirtualMachineRestoreGroup(testNamespace).
+				Return(kubevirtClient.SnapshotV1beta1().VirtualMachineRestoreGroups(testNamespace)).AnyTimes()
+			virtClient.EXPECT().VirtualMachine(testNamespace).
+				Return(kubevirtClient.KubevirtV1().VirtualMachines(testNamespace)).AnyTimes()
+
+			vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+			vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+			vmRestoreGroupInformer, _ := testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineRestoreGroup{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+
+			vmStore = vmInformer.GetStore()
+			vmRestoreStore = vmRestoreInformer.GetStore()
+			vmRestoreGroupStore = vmRestoreGroupInformer.GetStore()
+			vmSnapshotGroupStore = vmSnapshotGroupInformer.GetStore()
+
+			recorder = record.NewFakeRecorder(100)
+			recorder.IncludeObject = true
+
+			controller = &VMRestoreController{
+				Client:                  virtClient,
+				VMInformer:              vmInformer,
+				VMRestoreInformer:       vmRestoreInformer,
+				VMRestoreGroupInformer:  vmRestoreGroupInformer,
+				VMSnapshotGroupInformer: vmSnapshotGroupInformer,
+				Recorder:                recorder,
+			}
+			controller.vmRestoreGroupQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-restore-vmrestoregroup"},
+			)
+		})
+
+		newRestoreGroup := func() *snapshotv1.VirtualMachineRestoreGroup {
+			return &snapshotv1.VirtualMachineRestoreGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      restoreGroupName,
+					Namespace: testNamespace,
+					UID:       "restore-group-uid",
+				},
+				Spec: snapshotv1.VirtualMachineRestoreGroupSpec{
+					VirtualMachineSnapshotGroupName: groupName,
+				},
+			}
+		}
+
+		readySnapshotGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+			group := newSnapshotGroup()
+			group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+				Phase:      snapshotv1.Succeeded,
+				ReadyToUse: pointer.P(true),
+				Members: []snapshotv1.VirtualMachineSnapshotGroupMember{
+					{VirtualMachineName: "vm-a", VirtualMachineSnapshotName: "group-vm-a", ReadyToUse: pointer.P(true)},
+					{VirtualMachineName: "vm-b", VirtualMachineSnapshotName: "group-vm-b", ReadyToUse: pointer.P(true)},
+				},
+			}
+			return group
+		}
+
+		// addRestoringVM adds a member VM held back by its restore
+		addRestoringVM := func(vmName string) {
+			vm := newGroupVM(vmName, nil)
+			vm.Status.RestoreInProgress = pointer.P(restoreGroupMemberName(newRestoreGroup(), vmName))
+			Expect(vmStore.Add(vm)).To(Succeed())
+			_, err := kubevirtClient.KubevirtV1().VirtualMachines(testNamespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		getRestoreInProgress := func(vmName string) *string {
+			vm, err := kubevirtClient.KubevirtV1().VirtualMachines(testNamespace).Get(context.Background(), vmName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return vm.Status.RestoreInProgress
+		}
+
+		createRestoreGroup := func(group *snapshotv1.VirtualMachineRestoreGroup) {
+			_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestoreGroups(testNamespace).Create(context.Background(), group, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		getRestoreGroup := func() *snapshotv1.VirtualMachineRestoreGroup {
+			group, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestoreGroups(testNamespace).Get(context.Background(), restoreGroupName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return group
+		}
+
+		It("should wait for the snapshot group to be ready", func() {
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Status = &snapshotv1.VirtualMachineRestoreGroupStatus{Complete: pointer.P(false)}
+			createRestoreGroup(restoreGroup)
+
+			_, err := controller.updateVMRestoreGroup(restoreGroup)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getRestoreGroup().Status.Members).To(BeEmpty())
+		})
+
+		It("should resolve the members from the snapshot group", func() {
+			Expect(vmSnapshotGroupStore.Add(readySnapshotGroup())).To(Succeed())
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Status = &snapshotv1.VirtualMachineRestoreGroupStatus{Complete: pointer.P(false)}
+			createRestoreGroup(restoreGroup)
+
+			_, err := controller.updateVMRestoreGroup(restoreGroup)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getRestoreGroup().Status.Members).To(Equal([]snapshotv1.VirtualMachineRestoreGroupMember{
+				{VirtualMachineName: "vm-a", VirtualMachineRestoreName: "restore-vm-a"},
+				{VirtualMachineName: "vm-b", VirtualMachineRestoreName: "restore-vm-b"},
+			}))
+		})
+
+		It("should create a VirtualMachineRestore per member", func() {
+			Expect(vmSnapshotGroupStore.Add(readySnapshotGroup())).To(Succeed())
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Spec.TargetReadinessPolicy = pointer.P(snapshotv1.VirtualMachineRestoreStopTarget)
+			restoreGroup.Status = &snapshotv1.VirtualMachineRestoreGroupStatus{
+				Complete: pointer.P(false),
+				Members: []snapshotv1.VirtualMachineRestoreGroupMember{
+					{VirtualMachineName: "vm-a", VirtualMachineRestoreName: "restore-vm-a"},
+					{VirtualMachineName: "vm-b", VirtualMachineRestoreName: "restore-vm-b"},
+				},
+			}
+			createRestoreGroup(restoreGroup)
+
+			_, err := controller.updateVMRestoreGroup(restoreGroup)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, vmName := range []string{"vm-a", "vm-b"} {
+				vmRestore, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace).Get(context.Background(), "restore-"+vmName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmRestore.Labels).To(HaveKeyWithValue(restoreGroupLabel, restoreGroupName))
+				Expect(vmRestore.Spec.Target.Name).To(Equal(vmName))
+				Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal("group-" + vmName))
+				Expect(vmRestore.Spec.TargetReadinessPolicy).To(Equal(restoreGroup.Spec.TargetReadinessPolicy))
+			}
+			Expect(getRestoreGroup().Status.Complete).To(HaveValue(BeFalse()))
+		})
+
+		It("should hold the restored members back until all member restores completed", func() {
+			Expect(vmSnapshotGroupStore.Add(readySnapshotGroup())).To(Succeed())
+			addRestoringVM("vm-a")
+			addRestoringVM("vm-b")
+			Expect(vmRestoreStore.Add(&snapshotv1.VirtualMachineRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "restore-vm-a",
+					Namespace: testNamespace,
+				},
+				Status: &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(true),
+				},
+			})).To(Succeed())
+			Expect(vmRestoreStore.Add(&snapshotv1.VirtualMachineRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "restore-vm-b",
+					Namespace: testNamespace,
+				},
+				Status: &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(false),
+				},
+			})).To(Succeed())
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Status = &snapshotv1.VirtualMachineRestoreGroupStatus{
+				Complete: pointer.P(false),
+				Members: []snapshotv1.VirtualMachineRestoreGroupMember{
+					{VirtualMachineName: "vm-a", VirtualMachineRestoreName: "restore-vm-a"},
+					{VirtualMachineName: "vm-b", VirtualMachineRestoreName: "restore-vm-b"},
+				},
+			}
+			createRestoreGroup(restoreGroup)
+
+			_, err := controller.updateVMRestoreGroup(restoreGroup)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getRestoreGroup().Status.Complete).To(HaveValue(BeFalse()))
+			Expect(getRestoreInProgress("vm-a")).To(HaveValue(Equal("restore-vm-a")))
+			Expect(getRestoreInProgress("vm-b")).To(HaveValue(Equal("restore-vm-b")))
+		})
+
+		It("should complete and release the members once all member restores completed", func() {
+			Expect(vmSnapshotGroupStore.Add(readySnapshotGroup())).To(Succeed())
+			for _, vmName := range []string{"vm-a", "vm-b"} {
+				addRestoringVM(vmName)
+				Expect(vmRestoreStore.Add(&snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore-" + vmName,
+						Namespace: testNamespace,
+					},
+					Status: &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(true),
+					},
+				})).To(Succeed())
+			}
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Status = &snapshotv1.VirtualMachineRestoreGroupStatus{
+				Complete: pointer.P(false),
+				Members: []snapshotv1.VirtualMachineRestoreGroupMember{
+					{VirtualMachineName: "vm-a", VirtualMachineRestoreName: "restore-vm-a"},
+					{VirtualMachineName: "vm-b", VirtualMachineRestoreName: "restore-vm-b"},
+				},
+			}
+			createRestoreGroup(restoreGroup)
+
+			_, err := controller.updateVMRestoreGroup(restoreGroup)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := getRestoreGroup()
+			Expect(updated.Status.Complete).To(HaveValue(BeTrue()))
+			Expect(updated.Status.RestoreTime).ToNot(BeNil())
+			Expect(getRestoreInProgress("vm-a")).To(BeNil())
+			Expect(getRestoreInProgress("vm-b")).To(BeNil())
+			testutils.ExpectEvent(recorder, restoreGroupCompleteEvent)
+		})
+
+		It("should fail and release the members when a member restore failed", func() {
+			Expect(vmSnapshotGroupStore.Add(readySnapshotGroup())).To(Succeed())
+			addRestoringVM("vm-a")
+			Expect(vmRestoreStore.Add(&snapshotv1.VirtualMachineRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "restore-vm-a",
+					Namespace: testNamespace,
+				},
+				Status: &snapshotv1.VirtualMachineRestoreStatus{
+					Conditions: []snapshotv1.Condition{newFailureCondition(corev1.ConditionTrue, "failed")},
+				},
+			})).To(Succeed())
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Status = &snapshotv1.VirtualMachineRestoreGroupStatus{
+				Complete: pointer.P(false),
+				Members: []snapshotv1.VirtualMachineRestoreGroupMember{
+					{VirtualMachineName: "vm-a", VirtualMachineRestoreName: "restore-vm-a"},
+				},
+			}
+			createRestoreGroup(restoreGroup)
+
+			_, err := controller.updateVMRestoreGroup(restoreGroup)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmRestoreGroupDone(getRestoreGroup())).To(BeTrue())
+			Expect(getRestoreInProgress("vm-a")).To(BeNil())
+			testutils.ExpectEvent(recorder, restoreGroupFailedEvent)
+		})
+
+		It("should enqueue the restore groups of a snapshot group", func() {
+			Expect(vmRestoreGroupStore.Add(newRestoreGroup())).To(Succeed())
+
+			controller.handleVMSnapshotGroup(readySnapshotGroup())
+			Expect(controller.vmRestoreGroupQueue.Len()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,268 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	typesutil "kubevirt.io/kubevirt/pkg/storage/types"
+	kutil "kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	memoryStateSavedEvent = "MemoryStateSaved"
+)
+
+func includeMemory(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return vmSnapshot.Spec.IncludeMemory != nil && *vmSnapshot.Spec.IncludeMemory
+}
+
+// memoryStateClaimName returns the name of the PVC holding the memory state,
+// which is also the name of its memory dump volume
+func memoryStateClaimName(vmSnapshot *snapshotv1.VirtualMachineSnapshot) string {
+	return fmt.Sprintf("vmsnapshot-%s-memory", vmSnapshot.UID)
+}
+
+// createMemoryStateBackup creates the PVC the memory state of the source is
+// saved to and returns its volume backup. The PVC uses the storage class of
+// the source volumes so it can be snapshotted along with them.
+func (ctrl *VMSnapshotController) createMemoryStateBackup(vmSnapshot *snapshotv1.VirtualMachineSnapshot, volumeBackups []snapshotv1.VolumeBackup) (*snapshotv1.VolumeBackup, error) {
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	if vm == nil {
+		return nil, fmt.Errorf("source VM %s/%s does not exist", vmSnapshot.Namespace, vmSnapshot.Spec.Source.Name)
+	}
+	vmi, exists, err := ctrl.getVMI(vm)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("source VM %s/%s is not running", vm.Namespace, vm.Name)
+	}
+
+	size, err := typesutil.GetSizeIncludingDefaultFSOverhead(kutil.CalcExpectedMemoryDumpSize(vmi))
+	if err != nil {
+		return nil, err
+	}
+
+	claimName := memoryStateClaimName(vmSnapshot)
+	volumeMode := corev1.PersistentVolumeFilesystem
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claimName,
+			Namespace: vmSnapshot.Namespace,
+			Labels: map[string]string{
+				typesutil.LabelApplyStorageProfile: "true",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vmSnapshot, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshot")),
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			VolumeMode: &volumeMode,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: *size,
+				},
+			},
+		},
+	}
+	if len(volumeBackups) > 0 {
+		pvc.Spec.StorageClassName = volumeBackups[0].PersistentVolumeClaim.Spec.StorageClassName
+	}
+
+	pvc, err = ctrl.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		pvc, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmSnapshot.Namespace).Get(context.Background(), claimName, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	volumeSnapshotName := fmt.Sprintf("vmsnapshot-%s-volume-%s", vmSnapshot.UID, claimName)
+	return &snapshotv1.VolumeBackup{
+		VolumeName: claimName,
+		PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+			ObjectMeta: *getSimplifiedMetaObject(pvc.ObjectMeta),
+			Spec:       *pvc.Spec.DeepCopy(),
+		},
+		VolumeSnapshotName: &volumeSnapshotName,
+	}, nil
+}
+
+// addMemoryStateVolume adds the memory state volume to the captured VM, so a
+// restore can start the VM from it
+func addMemoryStateVolume(vm *snapshotv1.VirtualMachine, claimName string) {
+	vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, kubevirtv1.Volume{
+		Name: claimName,
+		VolumeSource: kubevirtv1.VolumeSource{
+			MemoryDump: &kubevirtv1.MemoryDumpVolumeSource{
+				PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claimName,
+					},
+				},
+				Format: kubevirtv1.MemoryDumpFormatSavedState,
+			},
+		},
+	})
+}
+
+// saveMemoryState pauses the source and saves its memory state through a
+// memory dump request. It returns true once the memory state is saved,
+// the source then stays paused until its volume snapshots are taken.
+func (ctrl *VMSnapshotController) saveMemoryState(vmSnapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) (bool, error) {
+	if content.Status.MemoryState == nil {
+		content.Status.MemoryState = &snapshotv1.MemoryStateStatus{}
+	}
+	status := content.Status.MemoryState
+	if status.FileName != nil {
+		return true, nil
+	}
+
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil {
+		return false, err
+	}
+	if vm == nil {
+		return false, fmt.Errorf("source VM %s/%s does not exist", vmSnapshot.Namespace, vmSnapshot.Spec.Source.Name)
+	}
+	vmi, exists, err := ctrl.getVMI(vm)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, fmt.Errorf("source VM %s/%s stopped before its memory state was saved", vm.Namespace, vm.Name)
+	}
+
+	claimName := content.Spec.MemoryState.VolumeName
+	request := vm.Status.MemoryDumpRequest
+	if request != nil && request.ClaimName != claimName {
+		return false, fmt.Errorf("memory dump to %s must be removed before the memory state can be saved", request.ClaimName)
+	}
+
+	if request == nil {
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if !condManager.HasConditionWithStatus(vmi, kubevirtv1.VirtualMachineInstancePaused, corev1.ConditionTrue) {
+			if !status.SourcePaused {
+				// Record the pause before issuing it so the source
+				// is unpaused even if the controller restarts
+				status.SourcePaused = true
+				return false, nil
+			}
+			log.Log.Object(vm).V(3).Info("Pausing source to save its memory state")
+			if err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).Pause(context.Background(), vmi.Name, &kubevirtv1.PauseOptions{}); err != nil {
+				return false, err
+			}
+		}
+
+		vmCopy := vm.DeepCopy()
+		vmCopy.Status.MemoryDumpRequest = &kubevirtv1.VirtualMachineMemoryDumpRequest{
+			ClaimName: claimName,
+			Phase:     kubevirtv1.MemoryDumpAssociating,
+			Format:    kubevirtv1.MemoryDumpFormatSavedState,
+		}
+		_, err := ctrl.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{})
+		return false, err
+	}
+
+	switch request.Phase {
+	case kubevirtv1.MemoryDumpFailed:
+		return false, fmt.Errorf("failed to save the memory state: %s", request.Message)
+	case kubevirtv1.MemoryDumpCompleted:
+		status.FileName = request.FileName
+		ctrl.Recorder.Eventf(
+			content,
+			corev1.EventTypeNormal,
+			memoryStateSavedEvent,
+			"Successfully saved the memory state of %s to %s",
+			vm.Name,
+			claimName,
+		)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// dissociateMemoryState removes the memory state volume from the source
+func (ctrl *VMSnapshotController) dissociateMemoryState(vmSnapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) error {
+	if content == nil || content.Spec.MemoryState == nil {
+		return nil
+	}
+
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil || vm == nil {
+		return err
+	}
+
+	request := vm.Status.MemoryDumpRequest
+	if request == nil || request.ClaimName != content.Spec.MemoryState.VolumeName || request.Remove {
+		return nil
+	}
+
+	vmCopy := vm.DeepCopy()
+	vmCopy.Status.MemoryDumpRequest.Remove = true
+	_, err = ctrl.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// unpauseMemoryStateSource unpauses the source if it was paused to save its
+// memory state. It is called once the source is unlocked, as a VM cannot be
+// unpaused while a snapshot is in progress.
+func (ctrl *VMSnapshotController) unpauseMemoryStateSource(vmSnapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) error {
+	if content == nil || content.Status == nil || content.Status.MemoryState == nil || !content.Status.MemoryState.SourcePaused {
+		return nil
+	}
+
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil {
+		return err
+	}
+	if vm != nil {
+		vmi, exists, err := ctrl.getVMI(vm)
+		if err != nil {
+			return err
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if exists && condManager.HasConditionWithStatus(vmi, kubevirtv1.VirtualMachineInstancePaused, corev1.ConditionTrue) {
+			log.Log.Object(vm).V(3).Info("Unpausing source after saving its memory state")
+			if err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).Unpause(context.Background(), vmi.Name, &kubevirtv1.UnpauseOptions{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	contentCpy := content.DeepCopy()
+	contentCpy.Status.MemoryState.SourcePaused = false
+	return ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+}
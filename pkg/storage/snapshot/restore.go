@@ -39,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	validation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -195,6 +196,20 @@ func vmRestoreDeleting(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	return vmRestore != nil && vmRestore.DeletionTimestamp != nil
 }
 
+// getVMSnapshotNamespace returns the namespace of the VirtualMachineSnapshot to restore
+func getVMSnapshotNamespace(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	if vmRestore.Spec.VirtualMachineSnapshotNamespace != "" {
+		return vmRestore.Spec.VirtualMachineSnapshotNamespace
+	}
+	return vmRestore.Namespace
+}
+
+// isCrossNamespaceRestore determines if the VirtualMachineSnapshot is restored into another namespace.
+// The volumes are then copied by DataVolumes instead of being restored from the VolumeSnapshots directly.
+func isCrossNamespaceRestore(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return getVMSnapshotNamespace(vmRestore) != vmRestore.Namespace
+}
+
 func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.VirtualMachineRestore) (time.Duration, error) {
 	logger := log.Log.Object(vmRestoreIn)
 	logger.V(1).Infof("Updating VirtualMachineRestore")
@@ -296,10 +311,13 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		return 0, ctrl.doUpdateError(vmRestoreIn, err)
 	}
 
-	err = target.UpdateDoneRestore()
-	if err != nil {
-		logger.Reason(err).Error("Error updating done restore")
-		return 0, ctrl.doUpdateError(vmRestoreIn, err)
+	// the members of a group are released by the group once all of them are restored
+	if !isRestoreGroupMember(vmRestoreOut) {
+		err = target.UpdateDoneRestore()
+		if err != nil {
+			logger.Reason(err).Error("Error updating done restore")
+			return 0, ctrl.doUpdateError(vmRestoreIn, err)
+		}
 	}
 
 	ctrl.Recorder.Eventf(
@@ -457,7 +475,7 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 		return false, err
 	}
 
-	noRestore, err := ctrl.volumesNotForRestore(content)
+	noRestore, err := ctrl.volumesNotForRestore(vmRestore, content)
 	if err != nil {
 		return false, err
 	}
@@ -514,6 +532,18 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 		}
 
 		if pvc == nil {
+			if isCrossNamespaceRestore(vmRestore) {
+				dv, err := ctrl.getDV(vmRestore.Namespace, restore.PersistentVolumeClaimName)
+				if err != nil {
+					return false, err
+				}
+				if dv != nil {
+					// The DataVolume copying the volume did not create its PVC yet
+					waitingPVC = true
+					continue
+				}
+			}
+
 			backup, err := getRestoreVolumeBackup(restore.VolumeName, content)
 			if err != nil {
 				return false, err
@@ -564,6 +594,12 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 			}
 
 			deletedPVC = true
+		} else if isCrossNamespaceRestore(vmRestore) {
+			inProgress, err := ctrl.restoreDataVolumeInProgress(vmRestore.Namespace, pvc.Name)
+			if err != nil {
+				return false, err
+			}
+			waitingPVC = waitingPVC || inProgress
 		} else if pvc.Status.Phase == corev1.ClaimPending {
 			bindingMode, err := ctrl.getBindingMode(pvc)
 			if err != nil {
@@ -580,6 +616,23 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 	return createdPVC || deletedPVC || waitingPVC || waitingDVNameUpdate, nil
 }
 
+// restoreDataVolumeInProgress returns true while the DataVolume copying a volume
+// from another namespace did not populate its PVC yet
+func (ctrl *VMRestoreController) restoreDataVolumeInProgress(namespace, name string) (bool, error) {
+	dv, err := ctrl.getDV(namespace, name)
+	if err != nil || dv == nil {
+		return false, err
+	}
+
+	switch dv.Status.Phase {
+	case cdiv1.Succeeded, cdiv1.WaitForFirstConsumer, cdiv1.PendingPopulation:
+		return false, nil
+	case cdiv1.Failed:
+		return false, fmt.Errorf("DataVolume %s/%s failed to copy the volume", namespace, name)
+	}
+	return true, nil
+}
+
 func (ctrl *VMRestoreController) getBindingMode(pvc *corev1.PersistentVolumeClaim) (*storagev1.VolumeBindingMode, error) {
 	if pvc.Spec.StorageClassName == nil {
 		return nil, nil
@@ -944,8 +997,21 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 				}
 			}
 		} else if nv.MemoryDump != nil {
-			// don't restore memory dump volume in the new spec
-			continue
+			// don't restore memory dump volume in the new spec, unless
+			// it holds the memory state to start the VM from
+			if !restoreMemoryState(t.vmRestore) || !typesutil.IsSavedStateVolume(nv) {
+				continue
+			}
+			restored := false
+			for _, vr := range t.vmRestore.Status.Restores {
+				if vr.VolumeName == nv.Name {
+					nv.MemoryDump.ClaimName = vr.PersistentVolumeClaimName
+					restored = true
+				}
+			}
+			if !restored {
+				continue
+			}
 		}
 		newVolumes = append(newVolumes, *nv)
 	}
@@ -1003,7 +1069,7 @@ func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (
 	}
 
 	if !t.Exists() {
-		restoredVM, err = patchVM(restoredVM, t.vmRestore.Spec.Patches)
+		restoredVM, err = PatchVM(restoredVM, t.vmRestore.Spec.Patches)
 		if err != nil {
 			return false, fmt.Errorf("error patching VM %s: %v", restoredVM.Name, err)
 		}
@@ -1070,6 +1136,11 @@ func findDVTemplateIndex(dvName string, vm *snapshotv1.VirtualMachine) int {
 }
 
 func (t *vmRestoreTarget) updatePVCPopulatedForAnnotation(pvc *corev1.PersistentVolumeClaim, dvName string) error {
+	if ownerReference := metav1.GetControllerOf(pvc); ownerReference != nil && ownerReference.Kind == "DataVolume" && ownerReference.Name == dvName {
+		// The PVC was populated by the DataVolume copying it from another namespace
+		return nil
+	}
+
 	updatePVC := pvc.DeepCopy()
 	if updatePVC.Annotations[populatedForPVCAnnotation] != dvName {
 		if updatePVC.Annotations == nil {
@@ -1152,7 +1223,7 @@ func (t *vmRestoreTarget) getControllerRevision(namespace, name string) (*appsv1
 }
 
 func (t *vmRestoreTarget) restoreInstancetypeControllerRevision(vmSnapshotRevisionName, vmSnapshotName string, vm *kubevirtv1.VirtualMachine) (*appsv1.ControllerRevision, error) {
-	snapshotCR, err := t.getControllerRevision(vm.Namespace, vmSnapshotRevisionName)
+	snapshotCR, err := t.getControllerRevision(getVMSnapshotNamespace(t.vmRestore), vmSnapshotRevisionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1368,7 +1439,13 @@ func sourceAndTargetAreDifferent(target restoreTarget, vmSnapshot *snapshotv1.Vi
 }
 
 func (ctrl *VMRestoreController) getVMSnapshot(vmRestore *snapshotv1.VirtualMachineRestore) (*snapshotv1.VirtualMachineSnapshot, error) {
-	objKey := cacheKeyFunc(vmRestore.Namespace, vmRestore.Spec.VirtualMachineSnapshotName)
+	if isCrossNamespaceRestore(vmRestore) {
+		if err := ctrl.verifyVMSnapshotGranted(vmRestore); err != nil {
+			return nil, err
+		}
+	}
+
+	objKey := cacheKeyFunc(getVMSnapshotNamespace(vmRestore), vmRestore.Spec.VirtualMachineSnapshotName)
 	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(objKey)
 	if err != nil {
 		return nil, err
@@ -1391,6 +1468,26 @@ func (ctrl *VMRestoreController) getVMSnapshot(vmRestore *snapshotv1.VirtualMach
 	return vmSnapshot, nil
 }
 
+// verifyVMSnapshotGranted checks that a VirtualMachineSnapshotGrant in the namespace of the
+// VirtualMachineSnapshot allows the namespace of the VirtualMachineRestore to restore it
+func (ctrl *VMRestoreController) verifyVMSnapshotGranted(vmRestore *snapshotv1.VirtualMachineRestore) error {
+	snapshotNamespace := getVMSnapshotNamespace(vmRestore)
+	objs, err := ctrl.VMSnapshotGrantInformer.GetIndexer().ByIndex(cache.NamespaceIndex, snapshotNamespace)
+	if err != nil {
+		return err
+	}
+
+	grants := make([]*snapshotv1.VirtualMachineSnapshotGrant, 0, len(objs))
+	for _, obj := range objs {
+		grants = append(grants, obj.(*snapshotv1.VirtualMachineSnapshotGrant))
+	}
+
+	if !typesutil.IsVirtualMachineSnapshotGranted(grants, vmRestore.Spec.VirtualMachineSnapshotName, vmRestore.Namespace) {
+		return fmt.Errorf("VMSnapshot %s/%s is not granted to namespace %s", snapshotNamespace, vmRestore.Spec.VirtualMachineSnapshotName, vmRestore.Namespace)
+	}
+	return nil
+}
+
 func (ctrl *VMRestoreController) getSnapshotContent(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (*snapshotv1.VirtualMachineSnapshotContent, error) {
 	objKey := cacheKeyFunc(vmSnapshot.Namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName)
 	obj, exists, err := ctrl.VMSnapshotContentInformer.GetStore().GetByKey(objKey)
@@ -1420,7 +1517,8 @@ func (ctrl *VMRestoreController) getVM(namespace, name string) (vm *kubevirtv1.V
 	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
 }
 
-func patchVM(vm *kubevirtv1.VirtualMachine, patches []string) (*kubevirtv1.VirtualMachine, error) {
+// PatchVM applies the given JSON patches to the VM
+func PatchVM(vm *kubevirtv1.VirtualMachine, patches []string) (*kubevirtv1.VirtualMachine, error) {
 	if len(patches) == 0 {
 		return vm, nil
 	}
@@ -1520,7 +1618,7 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	if vmRestore == nil {
 		return fmt.Errorf("missing vmRestore")
 	}
-	volumeSnapshot, err := ctrl.VolumeSnapshotProvider.GetVolumeSnapshot(vmRestore.Namespace, *volumeBackup.VolumeSnapshotName)
+	volumeSnapshot, err := ctrl.VolumeSnapshotProvider.GetVolumeSnapshot(getVMSnapshotNamespace(vmRestore), *volumeBackup.VolumeSnapshotName)
 	if err != nil {
 		return err
 	}
@@ -1539,6 +1637,10 @@ func (ctrl *VMRestoreController) createRestorePVC(
 		pvc.Annotations = make(map[string]string)
 	}
 
+	if isCrossNamespaceRestore(vmRestore) {
+		return ctrl.createRestoreDataVolume(vmRestore, target, pvc, volumeSnapshot)
+	}
+
 	if dvOwner != "" { // PVC is owned by a DV
 		// By setting this annotation, the CDI will set ownership of the PVC to the DV
 		pvc.Annotations[populatedForPVCAnnotation] = dvOwner
@@ -1558,6 +1660,45 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	return nil
 }
 
+// createRestoreDataVolume copies a VolumeSnapshot of another namespace with a DataVolume.
+// CDI populates the PVC with a CSI cross-namespace restore when the storage allows it,
+// and falls back to a host-assisted copy otherwise.
+func (ctrl *VMRestoreController) createRestoreDataVolume(
+	vmRestore *snapshotv1.VirtualMachineRestore,
+	target restoreTarget,
+	pvc *corev1.PersistentVolumeClaim,
+	volumeSnapshot *vsv1.VolumeSnapshot,
+) error {
+	dv := &cdiv1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        pvc.Name,
+			Namespace:   vmRestore.Namespace,
+			Labels:      pvc.Labels,
+			Annotations: pvc.Annotations,
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				Snapshot: &cdiv1.DataVolumeSourceSnapshot{
+					Namespace: getVMSnapshotNamespace(vmRestore),
+					Name:      volumeSnapshot.Name,
+				},
+			},
+			PVC: &corev1.PersistentVolumeClaimSpec{
+				AccessModes:      pvc.Spec.AccessModes,
+				Resources:        pvc.Spec.Resources,
+				StorageClassName: pvc.Spec.StorageClassName,
+				VolumeMode:       pvc.Spec.VolumeMode,
+			},
+		},
+	}
+	if !isVolumeOwnershipPolicyNone(vmRestore) {
+		target.Own(dv)
+	}
+
+	_, err := ctrl.Client.CdiClient().CdiV1beta1().DataVolumes(vmRestore.Namespace).Create(context.Background(), dv, metav1.CreateOptions{})
+	return err
+}
+
 func sourcePVCOwnedBySourceVM(volumeBackup *snapshotv1.VolumeBackup, sourceVm *snapshotv1.VirtualMachine) bool {
 	ownerReferences := volumeBackup.PersistentVolumeClaim.OwnerReferences
 	owned := false
@@ -1685,8 +1826,9 @@ func updateRestoreCondition(r *snapshotv1.VirtualMachineRestore, c snapshotv1.Co
 }
 
 // Returns a set of volumes not for restore
-// Currently only memory dump volumes should not be restored
-func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
+// Currently only memory dump volumes should not be restored, unless the
+// memory state of the snapshot is requested
+func (ctrl *VMRestoreController) volumesNotForRestore(vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
 	noRestore := sets.NewString()
 
 	volumes, err := storageutils.GetVolumes(content.Spec.Source.VirtualMachine, ctrl.Client)
@@ -1695,7 +1837,7 @@ func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.Virtua
 	}
 
 	for _, volume := range volumes {
-		if volume.MemoryDump != nil {
+		if volume.MemoryDump != nil && !restoresMemoryState(vmRestore, content, volume.Name) {
 			noRestore.Insert(volume.Name)
 		}
 	}
@@ -1703,6 +1845,14 @@ func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.Virtua
 	return noRestore, nil
 }
 
+func restoreMemoryState(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return vmRestore.Spec.RestoreMemoryState != nil && *vmRestore.Spec.RestoreMemoryState
+}
+
+func restoresMemoryState(vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent, volumeName string) bool {
+	return restoreMemoryState(vmRestore) && content.Spec.MemoryState != nil && content.Spec.MemoryState.VolumeName == volumeName
+}
+
 func getRestoreVolumeBackup(volName string, content *snapshotv1.VirtualMachineSnapshotContent) (*snapshotv1.VolumeBackup, error) {
 	for _, vb := range content.Spec.VolumeBackups {
 		if vb.VolumeName == volName {
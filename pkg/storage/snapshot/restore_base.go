@@ -45,7 +45,10 @@ type VMRestoreController struct {
 	Client kubecli.KubevirtClient
 
 	VMRestoreInformer         cache.SharedIndexInformer
+	VMRestoreGroupInformer    cache.SharedIndexInformer
 	VMSnapshotInformer        cache.SharedIndexInformer
+	VMSnapshotGroupInformer   cache.SharedIndexInformer
+	VMSnapshotGrantInformer   cache.SharedIndexInformer
 	VMSnapshotContentInformer cache.SharedIndexInformer
 	VMInformer                cache.SharedIndexInformer
 	VMIInformer               cache.SharedIndexInformer
@@ -58,7 +61,8 @@ type VMRestoreController struct {
 
 	Recorder record.EventRecorder
 
-	vmRestoreQueue workqueue.TypedRateLimitingInterface[string]
+	vmRestoreQueue      workqueue.TypedRateLimitingInterface[string]
+	vmRestoreGroupQueue workqueue.TypedRateLimitingInterface[string]
 }
 
 // Init initializes the restore controller
@@ -67,6 +71,10 @@ func (ctrl *VMRestoreController) Init() error {
 		workqueue.DefaultTypedControllerRateLimiter[string](),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-restore-vmrestore"},
 	)
+	ctrl.vmRestoreGroupQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-restore-vmrestoregroup"},
+	)
 
 	_, err := ctrl.VMRestoreInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
@@ -80,6 +88,26 @@ func (ctrl *VMRestoreController) Init() error {
 		return err
 	}
 
+	_, err = ctrl.VMRestoreGroupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMRestoreGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMRestoreGroup(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotGroupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroup(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
 	_, err = ctrl.DataVolumeInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handleDataVolume,
@@ -117,6 +145,7 @@ func (ctrl *VMRestoreController) Init() error {
 func (ctrl *VMRestoreController) Run(threadiness int, stopCh <-chan struct{}) error {
 	defer utilruntime.HandleCrash()
 	defer ctrl.vmRestoreQueue.ShutDown()
+	defer ctrl.vmRestoreGroupQueue.ShutDown()
 
 	log.Log.Info("Starting restore controller.")
 	defer log.Log.Info("Shutting down restore controller.")
@@ -124,7 +153,10 @@ func (ctrl *VMRestoreController) Run(threadiness int, stopCh <-chan struct{}) er
 	if !cache.WaitForCacheSync(
 		stopCh,
 		ctrl.VMRestoreInformer.HasSynced,
+		ctrl.VMRestoreGroupInformer.HasSynced,
 		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotGroupInformer.HasSynced,
+		ctrl.VMSnapshotGrantInformer.HasSynced,
 		ctrl.VMSnapshotContentInformer.HasSynced,
 		ctrl.VMInformer.HasSynced,
 		ctrl.VMIInformer.HasSynced,
@@ -136,6 +168,7 @@ func (ctrl *VMRestoreController) Run(threadiness int, stopCh <-chan struct{}) er
 
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(ctrl.vmRestoreWorker, time.Second, stopCh)
+		go wait.Until(ctrl.vmRestoreGroupWorker, time.Second, stopCh)
 	}
 
 	<-stopCh
@@ -180,6 +213,7 @@ func (ctrl *VMRestoreController) handleVMRestore(obj interface{}) {
 
 		log.Log.V(3).Infof("enqueued %q for sync", objName)
 		ctrl.vmRestoreQueue.Add(objName)
+		ctrl.enqueueRestoreGroup(vmRestore)
 	}
 }
 
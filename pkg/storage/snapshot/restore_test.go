@@ -40,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
@@ -273,6 +274,9 @@ var _ = Describe("Restore controller", func() {
 			dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 			pvcInformer, _ := testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
 			storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
+			vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+			vmRestoreGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestoreGroup{})
+			vmSnapshotGrantInformer, _ := testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshotGrant{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
 			crInformer, _ := testutils.NewFakeInformerWithIndexersFor(&appsv1.ControllerRevision{}, virtcontroller.GetControllerRevisionInformerIndexers())
 
 			recorder = record.NewFakeRecorder(100)
@@ -285,7 +289,10 @@ var _ = Describe("Restore controller", func() {
 			controller = &VMRestoreController{
 				Client:                    virtClient,
 				VMRestoreInformer:         vmRestoreInformer,
+				VMRestoreGroupInformer:    vmRestoreGroupInformer,
 				VMSnapshotInformer:        vmSnapshotInformer,
+				VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
+				VMSnapshotGrantInformer:   vmSnapshotGrantInformer,
 				VMSnapshotContentInformer: vmSnapshotContentInformer,
 				VMInformer:                vmInformer,
 				VMIInformer:               vmiInformer,
@@ -591,6 +598,105 @@ var _ = Describe("Restore controller", func() {
 				Expect(*calls).To(Equal(1))
 			})
 
+			Context("from another namespace", func() {
+				const sourceNamespace = "production"
+
+				var r *snapshotv1.VirtualMachineRestore
+
+				BeforeEach(func() {
+					sourceSnapshot := s.DeepCopy()
+					sourceSnapshot.Namespace = sourceNamespace
+					sourceContent := sc.DeepCopy()
+					sourceContent.Namespace = sourceNamespace
+					Expect(controller.VMSnapshotInformer.GetStore().Add(sourceSnapshot)).To(Succeed())
+					Expect(controller.VMSnapshotContentInformer.GetStore().Add(sourceContent)).To(Succeed())
+
+					r = createRestoreWithOwner()
+					r.Spec.VirtualMachineSnapshotNamespace = sourceNamespace
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+							newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+						},
+					}
+					addVolumeRestores(r)
+					Expect(controller.VMInformer.GetStore().Add(createRestoreInProgressVM())).To(Succeed())
+				})
+
+				addGrant := func(to ...snapshotv1.VirtualMachineSnapshotGrantTo) {
+					Expect(controller.VMSnapshotGrantInformer.GetStore().Add(&snapshotv1.VirtualMachineSnapshotGrant{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "grant",
+							Namespace: sourceNamespace,
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotGrantSpec{
+							From: []snapshotv1.VirtualMachineSnapshotGrantFrom{{Namespace: testNamespace}},
+							To:   to,
+						},
+					})).To(Succeed())
+				}
+
+				It("should error if the snapshot is not granted", func() {
+					addGrant(snapshotv1.VirtualMachineSnapshotGrantTo{Name: "other-snapshot"})
+					expectedError := fmt.Sprintf("VMSnapshot %s/%s is not granted to namespace %s", sourceNamespace, vmSnapshotName, testNamespace)
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, expectedError),
+						newReadyCondition(corev1.ConditionFalse, expectedError),
+					}
+					updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					testutils.ExpectEvent(recorder, "VirtualMachineRestoreError")
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should copy the volume snapshots with DataVolumes", func() {
+					addGrant(snapshotv1.VirtualMachineSnapshotGrantTo{Name: vmSnapshotName})
+					r.Spec.VolumeRestoreOverrides = []snapshotv1.VolumeRestoreOverride{{
+						VolumeName: diskName,
+						Labels:     map[string]string{"sandbox": "true"},
+					}}
+					pvcSize := resource.MustParse("2Gi")
+					fakeVolumeSnapshotProvider.Add(createVolumeSnapshot(r.Status.Restores[0].VolumeSnapshotName, pvcSize))
+
+					createCalls := 0
+					cdiClient.Fake.PrependReactor("create", "datavolumes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						dv := action.(testing.CreateAction).GetObject().(*cdiv1.DataVolume)
+						Expect(dv.Namespace).To(Equal(testNamespace))
+						Expect(dv.Name).To(Equal(r.Status.Restores[0].PersistentVolumeClaimName))
+						Expect(dv.Labels).To(HaveKeyWithValue("sandbox", "true"))
+						Expect(dv.Spec.Source.Snapshot).To(Equal(&cdiv1.DataVolumeSourceSnapshot{
+							Namespace: sourceNamespace,
+							Name:      r.Status.Restores[0].VolumeSnapshotName,
+						}))
+						Expect(dv.Spec.PVC.Resources.Requests[corev1.ResourceStorage]).To(Equal(pvcSize))
+						createCalls++
+						return true, dv, nil
+					})
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					Expect(createCalls).To(Equal(1))
+				})
+
+				It("should wait for the DataVolume copying the volume", func() {
+					addGrant()
+					Expect(controller.DataVolumeInformer.GetStore().Add(&cdiv1.DataVolume{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      r.Status.Restores[0].PersistentVolumeClaimName,
+							Namespace: testNamespace,
+						},
+						Status: cdiv1.DataVolumeStatus{Phase: cdiv1.SnapshotForSmartCloneInProgress},
+					})).To(Succeed())
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					Expect(cdiClient.Actions()).To(BeEmpty())
+					Expect(kubevirtClient.Actions()).To(BeEmpty())
+				})
+			})
+
 			It("should create pvcs for both datavolume and pvc restore volumes", func() {
 				r := createRestoreWithOwner()
 				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
@@ -1109,6 +1215,51 @@ var _ = Describe("Restore controller", func() {
 				Expect(*updateStatusCalls).To(Equal(1))
 			})
 
+			Context("with memory state", func() {
+				const memoryVolumeName = "memory"
+
+				BeforeEach(func() {
+					addMemoryStateVolume(sc.Spec.Source.VirtualMachine, memoryVolumeName)
+					sc.Spec.MemoryState = &snapshotv1.MemoryState{VolumeName: memoryVolumeName}
+				})
+
+				DescribeTable("should restore the memory state volume", func(restoreMemoryState *bool, expectRestore bool) {
+					r := createRestoreWithOwner()
+					r.Spec.RestoreMemoryState = restoreMemoryState
+
+					noRestore, err := controller.volumesNotForRestore(r, sc)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(noRestore.Has(memoryVolumeName)).To(Equal(!expectRestore))
+
+					snapshotVM := sc.Spec.Source.VirtualMachine.DeepCopy()
+					snapshotVM.Spec.DataVolumeTemplates = nil
+					snapshotVM.Spec.Template.Spec.Volumes = snapshotVM.Spec.Template.Spec.Volumes[len(snapshotVM.Spec.Template.Spec.Volumes)-1:]
+					if expectRestore {
+						r.Status.Restores = []snapshotv1.VolumeRestore{{
+							VolumeName:                memoryVolumeName,
+							PersistentVolumeClaimName: "restore-uid-memory",
+						}}
+					}
+					target := &vmRestoreTarget{controller: controller, vmRestore: r}
+					restoredVM, err := target.generateRestoredVMSpec(snapshotVM)
+					Expect(err).ToNot(HaveOccurred())
+					if !expectRestore {
+						Expect(restoredVM.Spec.Template.Spec.Volumes).To(BeEmpty())
+						return
+					}
+					Expect(restoredVM.Spec.Template.Spec.Volumes).To(HaveLen(1))
+					memoryDump := restoredVM.Spec.Template.Spec.Volumes[0].MemoryDump
+					Expect(memoryDump).ToNot(BeNil())
+					Expect(memoryDump.ClaimName).To(Equal("restore-uid-memory"))
+					Expect(memoryDump.Hotpluggable).To(BeFalse())
+					Expect(memoryDump.Format).To(Equal(kubevirtv1.MemoryDumpFormatSavedState))
+				},
+					Entry("only when requested", pointer.P(true), true),
+					Entry("not when disabled", pointer.P(false), false),
+					Entry("not by default", nil, false),
+				)
+			})
+
 			It("should override pvcs and volumes", func() {
 				r := createRestoreWithOwner()
 				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
@@ -1392,6 +1543,47 @@ var _ = Describe("Restore controller", func() {
 				Expect(*dvDeleteCalls).To(Equal(len(r.Status.DeletedDataVolumes)))
 			})
 
+			It("should keep a restored group member vm locked", func() {
+				r := createRestoreWithOwner()
+				r.Labels = map[string]string{restoreGroupLabel: "group"}
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(false),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					},
+				}
+				addVolumeRestores(r)
+				for i := range r.Status.Restores {
+					r.Status.Restores[i].DataVolumeName = &r.Status.Restores[i].PersistentVolumeClaimName
+				}
+				addVirtualMachineRestore(r)
+				for _, pvc := range getRestorePVCs(r) {
+					pvc.Annotations["cdi.kubevirt.io/storage.populatedFor"] = pvc.Name
+					pvc.Status.Phase = corev1.ClaimBound
+					Expect(controller.PVCInformer.GetStore().Add(&pvc)).To(Succeed())
+				}
+
+				vm := createRestoreInProgressVM()
+				vm.Annotations = map[string]string{lastRestoreAnnotation: "restore-uid"}
+				Expect(controller.VMInformer.GetStore().Add(vm)).To(Succeed())
+
+				ur := r.DeepCopy()
+				ur.ResourceVersion = "1"
+				ur.Status.Complete = pointer.P(true)
+				ur.Status.RestoreTime = timeFunc()
+				ur.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionFalse, "Operation complete"),
+					newReadyCondition(corev1.ConditionTrue, "Operation complete"),
+				}
+
+				updateVMStatusCalls := expectVMUpdateStatus(kubevirtClient, vm)
+				updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, ur)
+				controller.processVMRestoreWorkItem()
+				Expect(*updateStatusCalls).To(Equal(1))
+				Expect(*updateVMStatusCalls).To(BeZero())
+			})
+
 			It("should complete restore", func() {
 				r := createRestoreWithOwner()
 				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
@@ -0,0 +1,337 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	// restoreGroupLabel marks the VirtualMachineRestores created for the members of a group
+	restoreGroupLabel = "snapshot.kubevirt.io/restore-group"
+
+	restoreGroupMemberCreatedEvent = "VirtualMachineRestoreGroupMemberCreated"
+	restoreGroupCompleteEvent      = "VirtualMachineRestoreGroupComplete"
+	restoreGroupFailedEvent        = "VirtualMachineRestoreGroupFailed"
+)
+
+func vmRestoreGroupDone(group *snapshotv1.VirtualMachineRestoreGroup) bool {
+	return group.Status != nil &&
+		((group.Status.Complete != nil && *group.Status.Complete) ||
+			hasConditionType(group.Status.Conditions, snapshotv1.ConditionFailure))
+}
+
+func restoreGroupMemberName(group *snapshotv1.VirtualMachineRestoreGroup, vmName string) string {
+	return fmt.Sprintf("%s-%s", group.Name, vmName)
+}
+
+func (ctrl *VMRestoreController) vmRestoreGroupWorker() {
+	for ctrl.processVMRestoreGroupWorkItem() {
+	}
+}
+
+func (ctrl *VMRestoreController) processVMRestoreGroupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmRestoreGroupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmRestoreGroup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMRestoreGroupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		group, ok := storeObj.(*snapshotv1.VirtualMachineRestoreGroup)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return ctrl.updateVMRestoreGroup(group.DeepCopy())
+	})
+}
+
+func (ctrl *VMRestoreController) handleVMRestoreGroup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if group, ok := obj.(*snapshotv1.VirtualMachineRestoreGroup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(group)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, group)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmRestoreGroupQueue.Add(objName)
+	}
+}
+
+// handleVMSnapshotGroup enqueues the restore groups waiting for the snapshot group
+func (ctrl *VMRestoreController) handleVMSnapshotGroup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if snapshotGroup, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroup); ok {
+		objs, err := ctrl.VMRestoreGroupInformer.GetIndexer().ByIndex(cache.NamespaceIndex, snapshotGroup.Namespace)
+		if err != nil {
+			log.Log.Errorf("failed to list restore groups of %s: %v", snapshotGroup.Namespace, err)
+			return
+		}
+
+		for _, obj := range objs {
+			group, ok := obj.(*snapshotv1.VirtualMachineRestoreGroup)
+			if !ok || group.Spec.VirtualMachineSnapshotGroupName != snapshotGroup.Name {
+				continue
+			}
+			ctrl.vmRestoreGroupQueue.Add(cacheKeyFunc(group.Namespace, group.Name))
+		}
+	}
+}
+
+func isRestoreGroupMember(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	_, ok := vmRestore.Labels[restoreGroupLabel]
+	return ok
+}
+
+// enqueueRestoreGroup enqueues the group which created the VirtualMachineRestore
+func (ctrl *VMRestoreController) enqueueRestoreGroup(vmRestore *snapshotv1.VirtualMachineRestore) {
+	groupName, ok := vmRestore.Labels[restoreGroupLabel]
+	if !ok {
+		return
+	}
+	ctrl.vmRestoreGroupQueue.Add(cacheKeyFunc(vmRestore.Namespace, groupName))
+}
+
+// updateVMRestoreGroup restores every member of a ready snapshot group through a
+// VirtualMachineRestore per VM and completes once all of them completed
+func (ctrl *VMRestoreController) updateVMRestoreGroup(group *snapshotv1.VirtualMachineRestoreGroup) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineRestoreGroup %s/%s", group.Namespace, group.Name)
+
+	if group.DeletionTimestamp != nil || vmRestoreGroupDone(group) {
+		return 0, nil
+	}
+
+	groupCpy := group.DeepCopy()
+	if groupCpy.Status == nil {
+		groupCpy.Status = &snapshotv1.VirtualMachineRestoreGroupStatus{
+			Complete: pointer.P(false),
+		}
+		updateRestoreGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestoreGroup"))
+		updateRestoreGroupCondition(groupCpy, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestoreGroup"))
+		return 0, ctrl.updateRestoreGroupStatus(group, groupCpy)
+	}
+
+	if len(groupCpy.Status.Members) == 0 {
+		snapshotGroup, err := ctrl.getVMSnapshotGroup(groupCpy.Namespace, groupCpy.Spec.VirtualMachineSnapshotGroupName)
+		if err != nil {
+			return 0, err
+		}
+		if snapshotGroup == nil || snapshotGroup.Status == nil ||
+			snapshotGroup.Status.ReadyToUse == nil || !*snapshotGroup.Status.ReadyToUse {
+			updateRestoreGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionFalse,
+				fmt.Sprintf("VirtualMachineSnapshotGroup %s not ready", groupCpy.Spec.VirtualMachineSnapshotGroupName)))
+			return 0, ctrl.updateRestoreGroupStatus(group, groupCpy)
+		}
+
+		for _, member := range snapshotGroup.Status.Members {
+			groupCpy.Status.Members = append(groupCpy.Status.Members, snapshotv1.VirtualMachineRestoreGroupMember{
+				VirtualMachineName:        member.VirtualMachineName,
+				VirtualMachineRestoreName: restoreGroupMemberName(groupCpy, member.VirtualMachineName),
+			})
+		}
+		updateRestoreGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionTrue, "Restoring the members of the group"))
+		return 0, ctrl.updateRestoreGroupStatus(group, groupCpy)
+	}
+
+	snapshotGroup, err := ctrl.getVMSnapshotGroup(groupCpy.Namespace, groupCpy.Spec.VirtualMachineSnapshotGroupName)
+	if err != nil {
+		return 0, err
+	}
+	if snapshotGroup == nil || snapshotGroup.Status == nil {
+		return 0, ctrl.failRestoreGroup(group, groupCpy,
+			fmt.Sprintf("VirtualMachineSnapshotGroup %s no longer exists", groupCpy.Spec.VirtualMachineSnapshotGroupName))
+	}
+	snapshotNames := map[string]string{}
+	for _, member := range snapshotGroup.Status.Members {
+		snapshotNames[member.VirtualMachineName] = member.VirtualMachineSnapshotName
+	}
+
+	complete := true
+	for i, member := range groupCpy.Status.Members {
+		vmRestore, err := ctrl.getVMRestoreByName(groupCpy.Namespace, member.VirtualMachineRestoreName)
+		if err != nil {
+			return 0, err
+		}
+		if vmRestore == nil {
+			if err := ctrl.createRestoreGroupMember(groupCpy, member, snapshotNames[member.VirtualMachineName]); err != nil {
+				return 0, err
+			}
+			complete = false
+			continue
+		}
+		if vmRestoreFailed(vmRestore) {
+			return 0, ctrl.failRestoreGroup(group, groupCpy, fmt.Sprintf("VirtualMachineRestore %s failed", vmRestore.Name))
+		}
+		memberComplete := vmRestoreCompleted(vmRestore)
+		groupCpy.Status.Members[i].Complete = pointer.P(memberComplete)
+		complete = complete && memberComplete
+	}
+
+	if complete {
+		if err := ctrl.releaseRestoreGroupMembers(groupCpy); err != nil {
+			return 0, err
+		}
+		groupCpy.Status.Complete = pointer.P(true)
+		groupCpy.Status.RestoreTime = currentTime()
+		updateRestoreGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+		updateRestoreGroupCondition(groupCpy, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+		ctrl.Recorder.Eventf(groupCpy, corev1.EventTypeNormal, restoreGroupCompleteEvent,
+			"Restored %d VirtualMachines", len(groupCpy.Status.Members))
+	}
+
+	return 0, ctrl.updateRestoreGroupStatus(group, groupCpy)
+}
+
+func (ctrl *VMRestoreController) createRestoreGroupMember(group *snapshotv1.VirtualMachineRestoreGroup, member snapshotv1.VirtualMachineRestoreGroupMember, vmSnapshotName string) error {
+	if vmSnapshotName == "" {
+		return fmt.Errorf("VirtualMachineSnapshotGroup %s has no snapshot of VM %s",
+			group.Spec.VirtualMachineSnapshotGroupName, member.VirtualMachineName)
+	}
+
+	vmRestore := &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      member.VirtualMachineRestoreName,
+			Namespace: group.Namespace,
+			Labels: map[string]string{
+				restoreGroupLabel: group.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(group, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineRestoreGroup")),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     member.VirtualMachineName,
+			},
+			VirtualMachineSnapshotName: vmSnapshotName,
+			TargetReadinessPolicy:      group.Spec.TargetReadinessPolicy,
+			VolumeRestorePolicy:        group.Spec.VolumeRestorePolicy,
+		},
+	}
+
+	_, err := ctrl.Client.VirtualMachineRestore(group.Namespace).Create(context.Background(), vmRestore, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	ctrl.Recorder.Eventf(group, corev1.EventTypeNormal, restoreGroupMemberCreatedEvent,
+		"Created VirtualMachineRestore %s for VM %s", vmRestore.Name, member.VirtualMachineName)
+	return nil
+}
+
+func (ctrl *VMRestoreController) getVMSnapshotGroup(namespace, name string) (*snapshotv1.VirtualMachineSnapshotGroup, error) {
+	obj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*snapshotv1.VirtualMachineSnapshotGroup).DeepCopy(), nil
+}
+
+func (ctrl *VMRestoreController) getVMRestoreByName(namespace, name string) (*snapshotv1.VirtualMachineRestore, error) {
+	obj, exists, err := ctrl.VMRestoreInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*snapshotv1.VirtualMachineRestore).DeepCopy(), nil
+}
+
+// releaseRestoreGroupMembers clears the restore in progress of the member VMs,
+// which holds them back from starting until the whole group is restored
+func (ctrl *VMRestoreController) releaseRestoreGroupMembers(group *snapshotv1.VirtualMachineRestoreGroup) error {
+	for _, member := range group.Status.Members {
+		obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(cacheKeyFunc(group.Namespace, member.VirtualMachineName))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		vm := obj.(*kubevirtv1.VirtualMachine)
+		if vm.Status.RestoreInProgress == nil || *vm.Status.RestoreInProgress != member.VirtualMachineRestoreName {
+			continue
+		}
+
+		vmCopy := vm.DeepCopy()
+		vmCopy.Status.RestoreInProgress = nil
+		vmCopy.Status.MemoryDumpRequest = nil
+		if _, err := ctrl.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VMRestoreController) failRestoreGroup(group, groupCpy *snapshotv1.VirtualMachineRestoreGroup, reason string) error {
+	if err := ctrl.releaseRestoreGroupMembers(groupCpy); err != nil {
+		return err
+	}
+	groupCpy.Status.Complete = pointer.P(false)
+	updateRestoreGroupCondition(groupCpy, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	updateRestoreGroupCondition(groupCpy, newReadyCondition(corev1.ConditionFalse, "Operation failed"))
+	updateRestoreGroupCondition(groupCpy, newFailureCondition(corev1.ConditionTrue, reason))
+	ctrl.Recorder.Event(groupCpy, corev1.EventTypeWarning, restoreGroupFailedEvent, reason)
+
+	return ctrl.updateRestoreGroupStatus(group, groupCpy)
+}
+
+func (ctrl *VMRestoreController) updateRestoreGroupStatus(oldGroup, newGroup *snapshotv1.VirtualMachineRestoreGroup) error {
+	if !equality.Semantic.DeepEqual(oldGroup.Status, newGroup.Status) {
+		if _, err := ctrl.Client.VirtualMachineRestoreGroup(newGroup.Namespace).UpdateStatus(context.Background(), newGroup, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateRestoreGroupCondition(group *snapshotv1.VirtualMachineRestoreGroup, c snapshotv1.Condition) {
+	group.Status.Conditions = updateCondition(group.Status.Conditions, c)
+}
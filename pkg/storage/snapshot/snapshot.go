@@ -43,6 +43,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/freezehooks"
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 )
 
@@ -72,11 +73,14 @@ const (
 
 // Indication messages
 var snapshotIndicationMessages = map[snapshotv1.Indication]string{
-	snapshotv1.VMSnapshotOnlineSnapshotIndication: "Snapshot taken while the VM was running. Consistency depends on guest-agent quiescing.",
-	snapshotv1.VMSnapshotGuestAgentIndication:     "Guest agent was active and attempted to quiesce the filesystem for application consistency.",
-	snapshotv1.VMSnapshotNoGuestAgentIndication:   "Guest agent was not available. Snapshot is crash-consistent and may not be application-consistent.",
-	snapshotv1.VMSnapshotQuiesceTimeoutIndication: "Guest agent quiesced the filesystem, but the freeze window timed out before completion. Snapshot is crash-consistent and may not be application-consistent.",
-	snapshotv1.VMSnapshotPausedIndication:         "Snapshot taken while the VM was paused. Snapshot is crash-consistent and may not be application-consistent.",
+	snapshotv1.VMSnapshotOnlineSnapshotIndication:   "Snapshot taken while the VM was running. Consistency depends on guest-agent quiescing.",
+	snapshotv1.VMSnapshotGuestAgentIndication:       "Guest agent was active and attempted to quiesce the filesystem for application consistency.",
+	snapshotv1.VMSnapshotNoGuestAgentIndication:     "Guest agent was not available. Snapshot is crash-consistent and may not be application-consistent.",
+	snapshotv1.VMSnapshotQuiesceTimeoutIndication:   "Guest agent quiesced the filesystem, but the freeze window timed out before completion. Snapshot is crash-consistent and may not be application-consistent.",
+	snapshotv1.VMSnapshotPausedIndication:           "Snapshot taken while the VM was paused. Snapshot is crash-consistent and may not be application-consistent.",
+	snapshotv1.VMSnapshotFreezeHooksIndication:      "Pre-freeze and post-thaw hooks were run around the filesystem freeze.",
+	snapshotv1.VMSnapshotFreezeHookFailedIndication: "One or more freeze hooks failed. Snapshot may not be application-consistent.",
+	snapshotv1.VMSnapshotMemoryStateIndication:      "Memory state of the VM was saved. Restoring the snapshot resumes the VM from that state.",
 }
 
 func VmSnapshotReady(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
@@ -219,9 +223,20 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 				canRemoveFinalizer = false
 			} else {
 				if canUnlockSource(vmSnapshot, content) {
-					if _, err := source.Unlock(); err != nil {
+					if source.Locked() {
+						if err := ctrl.dissociateMemoryState(vmSnapshot, content); err != nil {
+							return 0, err
+						}
+					}
+					unlocked, err := source.Unlock()
+					if err != nil {
 						return 0, err
 					}
+					if unlocked || !source.Locked() {
+						if err := ctrl.unpauseMemoryStateSource(vmSnapshot, content); err != nil {
+							return 0, err
+						}
+					}
 				}
 				canRemoveFinalizer = !source.Locked()
 			}
@@ -280,6 +295,55 @@ func (ctrl *VMSnapshotController) unfreezeSource(vmSnapshot *snapshotv1.VirtualM
 	return nil
 }
 
+// runFreezeHooks starts the hooks of the given stage when the source filesystem
+// is actually quiesced and records their results in the content status once
+// they finished. The hooks run in the background; done is false until they
+// finished, and the content is requeued at that point.
+func (ctrl *VMSnapshotController) runFreezeHooks(vmSnapshot *snapshotv1.VirtualMachineSnapshot, source snapshotSource, content *snapshotv1.VirtualMachineSnapshotContent, stage kubevirtv1.FreezeHookStage) (bool, error) {
+	if vmSnapshot.Spec.Hooks == nil {
+		return true, nil
+	}
+	hooks := vmSnapshot.Spec.Hooks.PreFreeze
+	if stage == kubevirtv1.PostThawStage {
+		hooks = vmSnapshot.Spec.Hooks.PostThaw
+	} else if source.Frozen() {
+		// the hooks already ran before the source was frozen
+		return true, nil
+	}
+	if len(hooks) == 0 || !source.Locked() || source.Paused() || !source.GuestAgent() {
+		return true, nil
+	}
+
+	key := cacheKeyFunc(content.Namespace, content.Name)
+	results, done, err := ctrl.freezeHooks.Run(key, vmSnapshot.Namespace, vmSnapshot.Spec.Source.Name, stage, hooks)
+	if !done {
+		log.Log.V(3).Infof("Waiting for %s hooks of vmsnapshotcontent %s", stage, key)
+		return false, nil
+	}
+	content.Status.FreezeHooks = freezehooks.MergeResults(content.Status.FreezeHooks, results, stage)
+	return true, err
+}
+
+func (ctrl *VMSnapshotController) runPostThawHooks(vmSnapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) (bool, error) {
+	if vmSnapshot == nil || vmSnapshot.Spec.Hooks == nil {
+		return true, nil
+	}
+	source, err := ctrl.getSnapshotSource(vmSnapshot)
+	if err != nil {
+		return false, err
+	}
+	if source == nil {
+		return true, nil
+	}
+	return ctrl.runFreezeHooks(vmSnapshot, source, content, kubevirtv1.PostThawStage)
+}
+
+// postThawHooksStarted reports whether the source was already unfrozen and the
+// post-thaw hooks of the content are running or waiting to be collected
+func (ctrl *VMSnapshotController) postThawHooksStarted(content *snapshotv1.VirtualMachineSnapshotContent) bool {
+	return ctrl.freezeHooks.Started(cacheKeyFunc(content.Namespace, content.Name), kubevirtv1.PostThawStage)
+}
+
 func generateFinalizerPatch(test, replace []string) ([]byte, error) {
 	return patch.New(
 		patch.WithTest("/metadata/finalizers", test),
@@ -347,6 +411,12 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 		return 0, err
 	}
 
+	// members of a snapshot group are frozen and thawed by the group
+	group, err := ctrl.getSnapshotGroup(vmSnapshot)
+	if err != nil {
+		return 0, err
+	}
+
 	if vmSnapshot == nil || vmSnapshotTerminating(vmSnapshot) {
 		err = ctrl.unfreezeSource(vmSnapshot)
 		if err != nil {
@@ -375,6 +445,22 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 
 	contentCreated := vmSnapshotContentCreated(content)
 
+	if !contentCreated && content.Spec.MemoryState != nil && vmSnapshot != nil && !vmSnapshotDeleting(vmSnapshot) {
+		saved, err := ctrl.saveMemoryState(vmSnapshot, contentCpy)
+		if err != nil {
+			contentCpy.Status.Error = &snapshotv1.Error{
+				Time:    currentTime(),
+				Message: pointer.P(err.Error()),
+			}
+			contentCpy.Status.ReadyToUse = pointer.P(false)
+			// Retry again in 5 seconds
+			return 5 * time.Second, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+		}
+		if !saved {
+			return snapshotRetryInterval, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+		}
+	}
+
 	for _, volumeBackup := range content.Spec.VolumeBackups {
 		if volumeBackup.VolumeSnapshotName == nil {
 			continue
@@ -408,6 +494,14 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 				continue
 			}
 
+			if !didFreeze && group != nil {
+				if !snapshotGroupFrozen(group) {
+					log.Log.V(3).Infof("Waiting for snapshot group %s/%s to freeze its members", group.Namespace, group.Name)
+					return 0, nil
+				}
+				didFreeze = true
+			}
+
 			if !didFreeze {
 				source, err := ctrl.getSnapshotSource(vmSnapshot)
 				if err != nil {
@@ -418,6 +512,21 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 					return 0, fmt.Errorf("unable to get snapshot source")
 				}
 
+				done, err := ctrl.runFreezeHooks(vmSnapshot, source, contentCpy, kubevirtv1.PreFreezeStage)
+				if !done && err == nil {
+					// requeued once the hooks finished
+					return 0, nil
+				}
+				if err != nil {
+					contentCpy.Status.Error = &snapshotv1.Error{
+						Time:    currentTime(),
+						Message: pointer.P(err.Error()),
+					}
+					contentCpy.Status.ReadyToUse = pointer.P(false)
+					// Retry again in 5 seconds
+					return 5 * time.Second, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+				}
+
 				if err := source.Freeze(); err != nil {
 					contentCpy.Status.Error = &snapshotv1.Error{
 						Time:    currentTime(),
@@ -482,9 +591,32 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	if created && contentCpy.Status.CreationTime == nil {
 		contentCpy.Status.CreationTime = currentTime()
 
-		err = ctrl.unfreezeSource(vmSnapshot)
-		if err != nil {
-			if strings.Contains(err.Error(), VSSFreezeLimitReached) {
+		// the group unfreezes its members once all of them are created
+		if group == nil {
+			// the source was already unfrozen when the post-thaw hooks were started
+			if ctrl.postThawHooksStarted(content) {
+				err = nil
+			} else {
+				err = ctrl.unfreezeSource(vmSnapshot)
+			}
+			if err != nil {
+				if strings.Contains(err.Error(), VSSFreezeLimitReached) {
+					contentCpy.Status.CreationTime = nil
+					contentCpy.Status.Error = &snapshotv1.Error{
+						Time:    currentTime(),
+						Message: pointer.P(err.Error()),
+					}
+					return 5 * time.Second, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+				}
+				return 0, err
+			}
+
+			done, err := ctrl.runPostThawHooks(vmSnapshot, contentCpy)
+			if !done && err == nil {
+				// requeued once the hooks finished
+				return 0, nil
+			}
+			if err != nil {
 				contentCpy.Status.CreationTime = nil
 				contentCpy.Status.Error = &snapshotv1.Error{
 					Time:    currentTime(),
@@ -492,7 +624,6 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 				}
 				return 5 * time.Second, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
 			}
-			return 0, err
 		}
 	}
 
@@ -655,6 +786,18 @@ func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMa
 	if err != nil {
 		return err
 	}
+
+	var memoryState *snapshotv1.MemoryState
+	if includeMemory(vmSnapshot) && source.Online() {
+		vb, err := ctrl.createMemoryStateBackup(vmSnapshot, volumeBackups)
+		if err != nil {
+			return err
+		}
+		volumeBackups = append(volumeBackups, *vb)
+		memoryState = &snapshotv1.MemoryState{VolumeName: vb.VolumeName}
+		addMemoryStateVolume(sourceSpec.VirtualMachine, vb.VolumeName)
+	}
+
 	content := &snapshotv1.VirtualMachineSnapshotContent{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:       GetVMSnapshotContentName(vmSnapshot),
@@ -665,6 +808,7 @@ func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMa
 			VirtualMachineSnapshotName: &vmSnapshot.Name,
 			Source:                     sourceSpec,
 			VolumeBackups:              volumeBackups,
+			MemoryState:                memoryState,
 		},
 	}
 
@@ -832,6 +976,9 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		}
 	}
 
+	updateFreezeHookIndications(vmSnapshotCpy, content)
+	updateMemoryStateIndications(vmSnapshotCpy, content)
+
 	if VmSnapshotReady(vmSnapshotCpy) {
 		updateSnapshotCondition(vmSnapshotCpy, newReadyCondition(corev1.ConditionTrue, "Ready"))
 	} else {
@@ -880,20 +1027,7 @@ func updateSnapshotSourceIndications(snapshot *snapshotv1.VirtualMachineSnapshot
 			indications = sets.Insert(indications, snapshotv1.VMSnapshotNoGuestAgentIndication)
 		}
 
-		indicationsList := sets.List(indications)
-
-		// Update the old field for backward compatibility
-		snapshot.Status.Indications = indicationsList
-
-		// Update the new sourceIndications field
-		var sourceIndications []snapshotv1.SourceIndication
-		for _, indication := range indicationsList {
-			sourceIndications = append(sourceIndications, snapshotv1.SourceIndication{
-				Indication: indication,
-				Message:    IndicationMessage(indication),
-			})
-		}
-		snapshot.Status.SourceIndications = sourceIndications
+		setSnapshotIndications(snapshot, indications)
 	} else {
 		// For offline snapshots, no indications are needed
 		snapshot.Status.Indications = nil
@@ -901,6 +1035,66 @@ func updateSnapshotSourceIndications(snapshot *snapshotv1.VirtualMachineSnapshot
 	}
 }
 
+// updateFreezeHookIndications records the outcome of the freeze hooks
+// reported in the content status
+func updateFreezeHookIndications(snapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) {
+	if content == nil || content.Status == nil || len(content.Status.FreezeHooks) == 0 {
+		return
+	}
+
+	indications := sets.New(snapshot.Status.Indications...)
+	indications = sets.Insert(indications, snapshotv1.VMSnapshotFreezeHooksIndication)
+	var failedHooks []string
+	for _, result := range content.Status.FreezeHooks {
+		if !result.Succeeded {
+			failedHooks = append(failedHooks, fmt.Sprintf("%s hook %s: %s", result.Stage, result.Name, result.Message))
+		}
+	}
+	if len(failedHooks) > 0 {
+		indications = sets.Insert(indications, snapshotv1.VMSnapshotFreezeHookFailedIndication)
+	}
+	setSnapshotIndications(snapshot, indications)
+
+	for i, sourceIndication := range snapshot.Status.SourceIndications {
+		if sourceIndication.Indication == snapshotv1.VMSnapshotFreezeHookFailedIndication && len(failedHooks) > 0 {
+			snapshot.Status.SourceIndications[i].Message = fmt.Sprintf("%s Failed hooks: %s",
+				sourceIndication.Message, strings.Join(failedHooks, "; "))
+		}
+	}
+}
+
+// updateMemoryStateIndications records that the memory state was saved along
+// with the volumes. The source is paused on purpose while saving its memory
+// state, so the snapshot is consistent with it.
+func updateMemoryStateIndications(snapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) {
+	if content == nil || content.Spec.MemoryState == nil || snapshot.Status == nil {
+		return
+	}
+
+	indications := sets.New(snapshot.Status.Indications...)
+	indications = sets.Insert(indications, snapshotv1.VMSnapshotMemoryStateIndication)
+	indications.Delete(snapshotv1.VMSnapshotPausedIndication)
+	setSnapshotIndications(snapshot, indications)
+}
+
+// setSnapshotIndications updates both the old and new indication fields
+func setSnapshotIndications(snapshot *snapshotv1.VirtualMachineSnapshot, indications sets.Set[snapshotv1.Indication]) {
+	indicationsList := sets.List(indications)
+
+	// Update the old field for backward compatibility
+	snapshot.Status.Indications = indicationsList
+
+	// Update the new sourceIndications field
+	var sourceIndications []snapshotv1.SourceIndication
+	for _, indication := range indicationsList {
+		sourceIndications = append(sourceIndications, snapshotv1.SourceIndication{
+			Indication: indication,
+			Message:    IndicationMessage(indication),
+		})
+	}
+	snapshot.Status.SourceIndications = sourceIndications
+}
+
 func (ctrl *VMSnapshotController) updateSnapshotSnapshotableVolumes(snapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) error {
 	if content == nil {
 		return nil
@@ -40,6 +40,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/storage/freezehooks"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
 )
 
@@ -70,6 +71,7 @@ type VMSnapshotController struct {
 
 	VMSnapshotInformer        cache.SharedIndexInformer
 	VMSnapshotContentInformer cache.SharedIndexInformer
+	VMSnapshotGroupInformer   cache.SharedIndexInformer
 	VMInformer                cache.SharedIndexInformer
 	VMIInformer               cache.SharedIndexInformer
 	StorageClassInformer      cache.SharedIndexInformer
@@ -89,6 +91,9 @@ type VMSnapshotController struct {
 	crdQueue               workqueue.TypedRateLimitingInterface[string]
 	vmSnapshotStatusQueue  workqueue.TypedRateLimitingInterface[string]
 	vmQueue                workqueue.TypedRateLimitingInterface[string]
+	vmSnapshotGroupQueue   workqueue.TypedRateLimitingInterface[string]
+
+	freezeHooks *freezehooks.AsyncRunner
 
 	dynamicInformerMap map[string]*dynamicInformer
 	eventHandlerMap    map[string]cache.ResourceEventHandlerFuncs
@@ -118,6 +123,14 @@ func (ctrl *VMSnapshotController) Init() error {
 		workqueue.DefaultTypedControllerRateLimiter[string](),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-vm"},
 	)
+	ctrl.vmSnapshotGroupQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-vmsnapshotgroup"},
+	)
+
+	ctrl.freezeHooks = freezehooks.NewAsyncRunner(ctrl.Client, func(key string) {
+		ctrl.vmSnapshotContentQueue.Add(key)
+	})
 
 	ctrl.dynamicInformerMap = map[string]*dynamicInformer{
 		volumeSnapshotCRD:      {informerFunc: controller.VolumeSnapshotInformer},
@@ -160,6 +173,17 @@ func (ctrl *VMSnapshotController) Init() error {
 		return err
 	}
 
+	_, err = ctrl.VMSnapshotGroupInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroup(newObj) },
+		},
+		ctrl.ResyncPeriod,
+	)
+	if err != nil {
+		return err
+	}
+
 	_, err = ctrl.VMInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handleVM,
@@ -231,6 +255,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 	defer ctrl.crdQueue.ShutDown()
 	defer ctrl.vmSnapshotStatusQueue.ShutDown()
 	defer ctrl.vmQueue.ShutDown()
+	defer ctrl.vmSnapshotGroupQueue.ShutDown()
 
 	log.Log.Info("Starting snapshot controller.")
 	defer log.Log.Info("Shutting down snapshot controller.")
@@ -239,6 +264,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 		stopCh,
 		ctrl.VMSnapshotInformer.HasSynced,
 		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMSnapshotGroupInformer.HasSynced,
 		ctrl.VMInformer.HasSynced,
 		ctrl.VMIInformer.HasSynced,
 		ctrl.CRDInformer.HasSynced,
@@ -267,6 +293,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 		go wait.Until(ctrl.vmSnapshotContentWorker, time.Second, stopCh)
 		go wait.Until(ctrl.vmSnapshotStatusWorker, time.Second, stopCh)
 		go wait.Until(ctrl.vmWorker, time.Second, stopCh)
+		go wait.Until(ctrl.vmSnapshotGroupWorker, time.Second, stopCh)
 	}
 
 	<-stopCh
@@ -431,6 +458,7 @@ func (ctrl *VMSnapshotController) handleVMSnapshot(obj interface{}) {
 		}
 		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
 		ctrl.vmSnapshotQueue.Add(objName)
+		ctrl.enqueueSnapshotGroup(vmSnapshot)
 	}
 }
 
@@ -450,6 +478,12 @@ func (ctrl *VMSnapshotController) handleVMSnapshotContent(obj interface{}) {
 			k := cacheKeyFunc(content.Namespace, *content.Spec.VirtualMachineSnapshotName)
 			log.Log.V(5).Infof("enqueued vmsnapshot %q for sync", k)
 			ctrl.vmSnapshotQueue.Add(k)
+
+			if storeObj, exists, _ := ctrl.VMSnapshotInformer.GetStore().GetByKey(k); exists {
+				if vmSnapshot, ok := storeObj.(*snapshotv1.VirtualMachineSnapshot); ok {
+					ctrl.enqueueSnapshotGroup(vmSnapshot)
+				}
+			}
 		}
 
 		log.Log.V(5).Infof(enqueuedForSyncFmt, objName)
@@ -375,6 +375,7 @@ var _ = Describe("Snapshot controlleer", func() {
 			pvcInformer, pvcSource = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
 			crdInformer, crdSource = testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 			dvInformer, dvSource = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
 
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
@@ -383,6 +384,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				Client:                    virtClient,
 				VMSnapshotInformer:        vmSnapshotInformer,
 				VMSnapshotContentInformer: vmSnapshotContentInformer,
+				VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
 				VMInformer:                vmInformer,
 				VMIInformer:               vmiInformer,
 				PodInformer:               podInformer,
@@ -1667,6 +1669,411 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*snapshotCreates).To(Equal(1))
 			})
 
+			Context("with freeze hooks", func() {
+				const hookName = "flush"
+
+				var (
+					vm                  *v1.VirtualMachine
+					vmSnapshot          *snapshotv1.VirtualMachineSnapshot
+					vmSnapshotContent   *snapshotv1.VirtualMachineSnapshotContent
+					volumeSnapshotClass vsv1.VolumeSnapshotClass
+				)
+
+				BeforeEach(func() {
+					storageClassSource.Add(createStorageClass())
+					volumeSnapshotClass = createVolumeSnapshotClasses()[0]
+
+					vm = createLockedVM()
+					vmSource.Add(vm)
+					vmi := createVMI(vm)
+					vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+						Type:          v1.VirtualMachineInstanceAgentConnected,
+						LastProbeTime: metav1.Now(),
+						Status:        corev1.ConditionTrue,
+					})
+					vmiSource.Add(vmi)
+
+					vmSnapshot = createVMSnapshotInProgress()
+					vmSnapshot.Spec.Hooks = &v1.FreezeHooks{
+						PreFreeze: []v1.FreezeHook{{
+							Name:      hookName,
+							GuestExec: &v1.GuestExecHook{Command: []string{"/usr/bin/db-flush"}},
+						}},
+						PostThaw: []v1.FreezeHook{{
+							Name:      hookName,
+							GuestExec: &v1.GuestExecHook{Command: []string{"/usr/bin/db-resume"}},
+							OnFailure: pointer.P(v1.FreezeHookContinue),
+						}},
+					}
+					vmSnapshotContent = createVMSnapshotContent()
+					vmSnapshotContent.UID = contentUID
+				})
+
+				// the hooks run in the background, the content is
+				// requeued and processed again once they finished
+				processVMSnapshotContentWithHooks := func() {
+					controller.processVMSnapshotContentWorkItem()
+					Eventually(mockVMSnapshotContentQueue.Len).Should(Equal(1))
+					controller.processVMSnapshotContentWorkItem()
+				}
+
+				It("should run pre-freeze hooks before freezing the vm", func() {
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: pointer.P(false),
+						FreezeHooks: []v1.FreezeHookResult{
+							{Name: hookName, Stage: v1.PreFreezeStage, Succeeded: true},
+						},
+					}
+					for _, volumeSnapshot := range createVolumeSnapshots(vmSnapshotContent) {
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus,
+							snapshotv1.VolumeSnapshotStatus{VolumeSnapshotName: volumeSnapshot.Name})
+					}
+
+					gomock.InOrder(
+						vmiInterface.EXPECT().GuestExec(gomock.Any(), vm.Name, &v1.GuestExecOptions{
+							Command:        []string{"/usr/bin/db-flush"},
+							TimeoutSeconds: 30,
+						}).Return(nil),
+						vmiInterface.EXPECT().Freeze(context.Background(), vm.Name, 0*time.Second).Return(nil),
+					)
+					snapshotCreates := expectVolumeSnapshotCreates(k8sSnapshotClient, volumeSnapshotClass.Name, vmSnapshotContent)
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(volumeSnapshotClass)
+					processVMSnapshotContentWithHooks()
+					testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
+					Expect(*updateStatusCalls).To(Equal(1))
+					Expect(*snapshotCreates).To(Equal(1))
+				})
+
+				It("should set content error and not freeze the vm if a pre-freeze hook fails", func() {
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: pointer.P(false),
+						Error: &snapshotv1.Error{
+							Time:    timeFunc(),
+							Message: pointer.P("PreFreeze hook flush failed: exit code 1"),
+						},
+						FreezeHooks: []v1.FreezeHookResult{
+							{Name: hookName, Stage: v1.PreFreezeStage, Message: "exit code 1"},
+						},
+					}
+
+					vmiInterface.EXPECT().GuestExec(gomock.Any(), vm.Name, gomock.Any()).Return(fmt.Errorf("exit code 1"))
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(volumeSnapshotClass)
+					processVMSnapshotContentWithHooks()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should run post-thaw hooks after unfreezing the vm", func() {
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: pointer.P(false),
+						FreezeHooks: []v1.FreezeHookResult{
+							{Name: hookName, Stage: v1.PreFreezeStage, Succeeded: true},
+						},
+					}
+					volumeSnapshots := createVolumeSnapshots(vmSnapshotContent)
+					for i := range volumeSnapshots {
+						volumeSnapshots[i].Status.ReadyToUse = pointer.P(true)
+						volumeSnapshots[i].Status.CreationTime = timeFunc()
+						volumeSnapshotSource.Add(&volumeSnapshots[i])
+						vmSnapshotContent.Status.VolumeSnapshotStatus = append(vmSnapshotContent.Status.VolumeSnapshotStatus,
+							snapshotv1.VolumeSnapshotStatus{VolumeSnapshotName: volumeSnapshots[i].Name})
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					updatedContent := createVMSnapshotContent()
+					updatedContent.UID = contentUID
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						CreationTime: timeFunc(),
+						ReadyToUse:   pointer.P(true),
+						FreezeHooks: []v1.FreezeHookResult{
+							{Name: hookName, Stage: v1.PreFreezeStage, Succeeded: true},
+							{Name: hookName, Stage: v1.PostThawStage, Message: "exit code 1"},
+						},
+					}
+					for i := range volumeSnapshots {
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+							VolumeSnapshotName: volumeSnapshots[i].Name,
+							ReadyToUse:         volumeSnapshots[i].Status.ReadyToUse,
+							CreationTime:       volumeSnapshots[i].Status.CreationTime,
+						})
+					}
+
+					gomock.InOrder(
+						vmiInterface.EXPECT().Unfreeze(context.Background(), vm.Name).Return(nil),
+						vmiInterface.EXPECT().GuestExec(gomock.Any(), vm.Name, gomock.Any()).Return(fmt.Errorf("exit code 1")),
+					)
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(volumeSnapshotClass)
+					processVMSnapshotContentWithHooks()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should record the freeze hook outcome in the source indications", func() {
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: pointer.P(false),
+						FreezeHooks: []v1.FreezeHookResult{
+							{Name: hookName, Stage: v1.PreFreezeStage, Succeeded: true},
+							{Name: hookName, Stage: v1.PostThawStage, Message: "exit code 1"},
+						},
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+					addVirtualMachineSnapshot(vmSnapshot)
+
+					updatedSnapshot := vmSnapshot.DeepCopy()
+					updatedSnapshot.Status.VirtualMachineSnapshotContentName = &vmSnapshotContent.Name
+					updatedSnapshot.Status.ReadyToUse = pointer.P(false)
+					updatedSnapshot.Status.Indications = []snapshotv1.Indication{
+						snapshotv1.VMSnapshotFreezeHookFailedIndication,
+						snapshotv1.VMSnapshotFreezeHooksIndication,
+						snapshotv1.VMSnapshotGuestAgentIndication,
+						snapshotv1.VMSnapshotOnlineSnapshotIndication,
+					}
+					updatedSnapshot.Status.SourceIndications = []snapshotv1.SourceIndication{
+						{
+							Indication: snapshotv1.VMSnapshotFreezeHookFailedIndication,
+							Message: IndicationMessage(snapshotv1.VMSnapshotFreezeHookFailedIndication) +
+								" Failed hooks: PostThaw hook flush: exit code 1",
+						},
+						{
+							Indication: snapshotv1.VMSnapshotFreezeHooksIndication,
+							Message:    IndicationMessage(snapshotv1.VMSnapshotFreezeHooksIndication),
+						},
+						{
+							Indication: snapshotv1.VMSnapshotGuestAgentIndication,
+							Message:    IndicationMessage(snapshotv1.VMSnapshotGuestAgentIndication),
+						},
+						{
+							Indication: snapshotv1.VMSnapshotOnlineSnapshotIndication,
+							Message:    IndicationMessage(snapshotv1.VMSnapshotOnlineSnapshotIndication),
+						},
+					}
+					updatedSnapshot.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"),
+						newReadyCondition(corev1.ConditionFalse, "Not ready"),
+					}
+
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+			})
+
+			Context("with memory state", func() {
+				var (
+					vm                  *v1.VirtualMachine
+					vmi                 *v1.VirtualMachineInstance
+					vmSnapshot          *snapshotv1.VirtualMachineSnapshot
+					vmSnapshotContent   *snapshotv1.VirtualMachineSnapshotContent
+					volumeSnapshotClass vsv1.VolumeSnapshotClass
+					memoryClaimName     string
+				)
+
+				pauseVMI := func(vmi *v1.VirtualMachineInstance) {
+					vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstancePaused,
+						Status: corev1.ConditionTrue,
+					})
+				}
+
+				BeforeEach(func() {
+					storageClassSource.Add(createStorageClass())
+					volumeSnapshotClass = createVolumeSnapshotClasses()[0]
+
+					vm = createLockedVM()
+					vmi = createVMI(vm)
+
+					vmSnapshot = createVMSnapshotInProgress()
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					memoryClaimName = memoryStateClaimName(vmSnapshot)
+
+					vmSnapshotContent = createVMSnapshotContent()
+					vmSnapshotContent.UID = contentUID
+					vmSnapshotContent.Spec.MemoryState = &snapshotv1.MemoryState{VolumeName: memoryClaimName}
+				})
+
+				It("should record that the source is paused before pausing it", func() {
+					vmSource.Add(vm)
+					vmiSource.Add(vmi)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						MemoryState: &snapshotv1.MemoryStateStatus{SourcePaused: true},
+					}
+
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVirtualMachineSnapshotContent(vmSnapshotContent)
+					controller.processVMSnapshotContentWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should pause the source and request its memory state", func() {
+					vmSource.Add(vm)
+					vmiSource.Add(vmi)
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						MemoryState: &snapshotv1.MemoryStateStatus{SourcePaused: true},
+					}
+
+					updatedVM := vm.DeepCopy()
+					updatedVM.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryClaimName,
+						Phase:     v1.MemoryDumpAssociating,
+						Format:    v1.MemoryDumpFormatSavedState,
+					}
+					gomock.InOrder(
+						vmiInterface.EXPECT().Pause(context.Background(), vm.Name, &v1.PauseOptions{}).Return(nil),
+						vmInterface.EXPECT().UpdateStatus(context.Background(), updatedVM, metav1.UpdateOptions{}).Return(updatedVM, nil),
+					)
+
+					vmSnapshotSource.Add(vmSnapshot)
+					addVirtualMachineSnapshotContent(vmSnapshotContent)
+					controller.processVMSnapshotContentWorkItem()
+				})
+
+				It("should snapshot the volumes once the memory state is saved", func() {
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryClaimName,
+						Phase:     v1.MemoryDumpCompleted,
+						FileName:  pointer.P("memory.dump"),
+						Format:    v1.MemoryDumpFormatSavedState,
+					}
+					vmSource.Add(vm)
+					pauseVMI(vmi)
+					vmiSource.Add(vmi)
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						MemoryState: &snapshotv1.MemoryStateStatus{SourcePaused: true},
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: pointer.P(false),
+						MemoryState: &snapshotv1.MemoryStateStatus{
+							SourcePaused: true,
+							FileName:     pointer.P("memory.dump"),
+						},
+					}
+					for _, volumeSnapshot := range createVolumeSnapshots(vmSnapshotContent) {
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus,
+							snapshotv1.VolumeSnapshotStatus{VolumeSnapshotName: volumeSnapshot.Name})
+					}
+
+					snapshotCreates := expectVolumeSnapshotCreates(k8sSnapshotClient, volumeSnapshotClass.Name, vmSnapshotContent)
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(volumeSnapshotClass)
+					controller.processVMSnapshotContentWorkItem()
+					testutils.ExpectEvent(recorder, memoryStateSavedEvent)
+					testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
+					Expect(*updateStatusCalls).To(Equal(1))
+					Expect(*snapshotCreates).To(Equal(1))
+				})
+
+				It("should set content error if saving the memory state failed", func() {
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryClaimName,
+						Phase:     v1.MemoryDumpFailed,
+						Message:   "no space left",
+						Format:    v1.MemoryDumpFormatSavedState,
+					}
+					vmSource.Add(vm)
+					pauseVMI(vmi)
+					vmiSource.Add(vmi)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: pointer.P(false),
+						Error: &snapshotv1.Error{
+							Time:    timeFunc(),
+							Message: pointer.P("failed to save the memory state: no space left"),
+						},
+						MemoryState: &snapshotv1.MemoryStateStatus{},
+					}
+
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVirtualMachineSnapshotContent(vmSnapshotContent)
+					controller.processVMSnapshotContentWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should remove the memory state volume and unpause the source when unlocking it", func() {
+					vmSnapshot = createVMSnapshotSuccess()
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					vmSnapshot.Status.VirtualMachineSnapshotContentName = &vmSnapshotContent.Name
+					vmSnapshot.Status.Indications = []snapshotv1.Indication{snapshotv1.VMSnapshotPausedIndication}
+
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryClaimName,
+						Phase:     v1.MemoryDumpCompleted,
+						FileName:  pointer.P("memory.dump"),
+						Format:    v1.MemoryDumpFormatSavedState,
+					}
+					vmSource.Add(vm)
+					pauseVMI(vmi)
+					vmiSource.Add(vmi)
+
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse:   pointer.P(true),
+						CreationTime: timeFunc(),
+						MemoryState: &snapshotv1.MemoryStateStatus{
+							SourcePaused: true,
+							FileName:     pointer.P("memory.dump"),
+						},
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					dissociatedVM := vm.DeepCopy()
+					dissociatedVM.Status.MemoryDumpRequest.Remove = true
+					unlockedVM := dissociatedVM.DeepCopy()
+					unlockedVM.Finalizers = []string{}
+					unlockedVM.ResourceVersion = "1"
+					patchBytes, err := patch.GenerateTestReplacePatch("/metadata/finalizers", []string{"snapshot.kubevirt.io/snapshot-source-protection"}, []string{})
+					Expect(err).ToNot(HaveOccurred())
+					statusUpdate := unlockedVM.DeepCopy()
+					statusUpdate.Status.SnapshotInProgress = nil
+					gomock.InOrder(
+						vmInterface.EXPECT().UpdateStatus(context.Background(), dissociatedVM, metav1.UpdateOptions{}).Return(dissociatedVM, nil),
+						vmInterface.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}).Return(unlockedVM, nil),
+						vmInterface.EXPECT().UpdateStatus(context.Background(), statusUpdate, metav1.UpdateOptions{}).Return(statusUpdate, nil),
+						vmiInterface.EXPECT().Unpause(context.Background(), vm.Name, &v1.UnpauseOptions{}).Return(nil),
+					)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.Status.MemoryState.SourcePaused = false
+					contentUpdates := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+
+					snapshotUpdates := 0
+					vmSnapshotClient.Fake.PrependReactor("update", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						updated := action.(testing.UpdateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+						Expect(updated.Status.Indications).To(ConsistOf(snapshotv1.VMSnapshotMemoryStateIndication))
+						snapshotUpdates++
+						return true, updated, nil
+					})
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(*contentUpdates).To(Equal(1))
+					Expect(snapshotUpdates).To(Equal(1))
+				})
+			})
+
 			It("should not freeze paused vm with guest agent and show Paused indication", func() {
 				storageClass := createStorageClass()
 				vmSnapshot := createVMSnapshotInProgress()
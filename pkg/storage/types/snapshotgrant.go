@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package types
+
+import (
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+// IsVirtualMachineSnapshotGranted returns true if one of the grants allows the
+// given namespace to use the VirtualMachineSnapshot with the given name
+func IsVirtualMachineSnapshotGranted(grants []*snapshotv1.VirtualMachineSnapshotGrant, snapshotName, namespace string) bool {
+	for _, grant := range grants {
+		if grantsNamespace(grant, namespace) && grantsSnapshot(grant, snapshotName) {
+			return true
+		}
+	}
+	return false
+}
+
+func grantsNamespace(grant *snapshotv1.VirtualMachineSnapshotGrant, namespace string) bool {
+	for _, from := range grant.Spec.From {
+		if from.Namespace == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func grantsSnapshot(grant *snapshotv1.VirtualMachineSnapshotGrant, snapshotName string) bool {
+	if len(grant.Spec.To) == 0 {
+		return true
+	}
+	for _, to := range grant.Spec.To {
+		if to.Name == snapshotName {
+			return true
+		}
+	}
+	return false
+}
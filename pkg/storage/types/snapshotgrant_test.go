@@ -0,0 +1,53 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package types
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+var _ = Describe("VirtualMachineSnapshotGrant", func() {
+	newGrant := func(namespaces []string, snapshots ...string) *snapshotv1.VirtualMachineSnapshotGrant {
+		grant := &snapshotv1.VirtualMachineSnapshotGrant{}
+		for _, namespace := range namespaces {
+			grant.Spec.From = append(grant.Spec.From, snapshotv1.VirtualMachineSnapshotGrantFrom{Namespace: namespace})
+		}
+		for _, snapshot := range snapshots {
+			grant.Spec.To = append(grant.Spec.To, snapshotv1.VirtualMachineSnapshotGrantTo{Name: snapshot})
+		}
+		return grant
+	}
+
+	DescribeTable("should check if a snapshot is granted to a namespace", func(grants []*snapshotv1.VirtualMachineSnapshotGrant, expected bool) {
+		Expect(IsVirtualMachineSnapshotGranted(grants, "snapshot", "sandbox")).To(Equal(expected))
+	},
+		Entry("without grants", nil, false),
+		Entry("with a grant for all snapshots", []*snapshotv1.VirtualMachineSnapshotGrant{newGrant([]string{"other", "sandbox"})}, true),
+		Entry("with a grant for the snapshot", []*snapshotv1.VirtualMachineSnapshotGrant{newGrant([]string{"sandbox"}, "other", "snapshot")}, true),
+		Entry("with a grant for other snapshots", []*snapshotv1.VirtualMachineSnapshotGrant{newGrant([]string{"sandbox"}, "other")}, false),
+		Entry("with a grant for other namespaces", []*snapshotv1.VirtualMachineSnapshotGrant{newGrant([]string{"other"}, "snapshot")}, false),
+		Entry("with one of several grants matching", []*snapshotv1.VirtualMachineSnapshotGrant{
+			newGrant([]string{"other"}), newGrant([]string{"sandbox"}, "snapshot"),
+		}, true),
+	)
+})
@@ -20,6 +20,8 @@
 package types
 
 import (
+	"fmt"
+
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
@@ -65,6 +67,26 @@ func IsHotplugVolume(vol *v1.Volume) bool {
 	return false
 }
 
+// IsSavedStateVolume returns true if the VMI is started from the saved
+// memory state held by the volume instead of being booted
+func IsSavedStateVolume(vol *v1.Volume) bool {
+	return vol.MemoryDump != nil &&
+		!vol.MemoryDump.Hotpluggable &&
+		vol.MemoryDump.Format == v1.MemoryDumpFormatSavedState
+}
+
+// IsPersistentContainerDisk returns true if the writes to the containerDisk
+// volume are kept in an overlay stored on a PVC
+func IsPersistentContainerDisk(vol *v1.Volume) bool {
+	return vol.ContainerDisk != nil && vol.ContainerDisk.Persistent != nil
+}
+
+// PersistentContainerDiskClaimName returns the name of the PVC holding the
+// overlay of a persistent containerDisk volume of the VM
+func PersistentContainerDiskClaimName(vmName, volumeName string) string {
+	return fmt.Sprintf("%s-%s-overlay", vmName, volumeName)
+}
+
 func IsUtilityVolume(vmi *v1.VirtualMachineInstance, volumeName string) bool {
 	for _, utilityVolume := range vmi.Spec.UtilityVolumes {
 		if utilityVolume.Name == volumeName {
@@ -126,6 +148,27 @@ func GetFilesystemsFromVolumes(vmi *v1.VirtualMachineInstance) map[string]*v1.Fi
 	return fs
 }
 
+// IsHotplugFilesystem returns true if the named volume is hotplugged and shared
+// with the guest through a virtiofs filesystem
+func IsHotplugFilesystem(vmi *v1.VirtualMachineInstance, volumeName string) bool {
+	isFilesystem := false
+	for _, fs := range vmi.Spec.Domain.Devices.Filesystems {
+		if fs.Name == volumeName && fs.Virtiofs != nil {
+			isFilesystem = true
+			break
+		}
+	}
+	if !isFilesystem {
+		return false
+	}
+	for i := range vmi.Spec.Volumes {
+		if vmi.Spec.Volumes[i].Name == volumeName {
+			return IsHotplugVolume(&vmi.Spec.Volumes[i])
+		}
+	}
+	return false
+}
+
 func IsMigratedVolume(name string, vmi *v1.VirtualMachineInstance) bool {
 	for _, v := range vmi.Status.MigratedVolumes {
 		if v.VolumeName == name {
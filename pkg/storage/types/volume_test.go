@@ -72,6 +72,50 @@ var _ = Describe("Volume type test", func() {
 		})
 	})
 
+	DescribeTable("IsSavedStateVolume", func(hotpluggable bool, format v1.MemoryDumpFormat, expected bool) {
+		volume := &v1.Volume{
+			Name: "memory",
+			VolumeSource: v1.VolumeSource{
+				MemoryDump: &v1.MemoryDumpVolumeSource{
+					PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+						Hotpluggable: hotpluggable,
+					},
+					Format: format,
+				},
+			},
+		}
+		Expect(IsSavedStateVolume(volume)).To(Equal(expected))
+	},
+		Entry("with a saved state volume", false, v1.MemoryDumpFormatSavedState, true),
+		Entry("with a hotpluggable saved state volume", true, v1.MemoryDumpFormatSavedState, false),
+		Entry("with a raw memory dump volume", false, v1.MemoryDumpFormatRaw, false),
+		Entry("with a memory dump volume without format", true, v1.MemoryDumpFormat(""), false),
+	)
+
+	DescribeTable("IsHotplugFilesystem", func(hotpluggable bool, filesystem *v1.Filesystem, expected bool) {
+		vmi := &v1.VirtualMachineInstance{
+			Spec: v1.VirtualMachineInstanceSpec{
+				Volumes: []v1.Volume{{
+					Name: "shared",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							Hotpluggable: hotpluggable,
+						},
+					},
+				}},
+			},
+		}
+		if filesystem != nil {
+			vmi.Spec.Domain.Devices.Filesystems = []v1.Filesystem{*filesystem}
+		}
+		Expect(IsHotplugFilesystem(vmi, "shared")).To(Equal(expected))
+	},
+		Entry("with a hotplugged virtiofs filesystem", true, &v1.Filesystem{Name: "shared", Virtiofs: &v1.FilesystemVirtiofs{}}, true),
+		Entry("with a permanent virtiofs filesystem", false, &v1.Filesystem{Name: "shared", Virtiofs: &v1.FilesystemVirtiofs{}}, false),
+		Entry("with a hotplugged disk", true, nil, false),
+		Entry("with a filesystem of another volume", true, &v1.Filesystem{Name: "other", Virtiofs: &v1.FilesystemVirtiofs{}}, false),
+	)
+
 	Context("GetTotalSizeMigratedVolumes", func() {
 		It("should return 0 when no migrated volumes", func() {
 			vmi := &v1.VirtualMachineInstance{
@@ -2,6 +2,13 @@ package tpm
 
 import v1 "kubevirt.io/api/core/v1"
 
+const (
+	// StateEncryptionKeyDir is where virt-launcher finds the key encrypting the persistent TPM state and EFI NVRAM
+	StateEncryptionKeyDir = "/run/kubevirt-private/backend-storage-key"
+	// StateEncryptionKeyFile is the file, and Secret entry, holding the key
+	StateEncryptionKeyFile = "key"
+)
+
 func HasDevice(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
 	return vmiSpec.Domain.Devices.TPM != nil &&
 		(vmiSpec.Domain.Devices.TPM.Enabled == nil || *vmiSpec.Domain.Devices.TPM.Enabled)
@@ -21,6 +21,7 @@ const (
 	VirtImageVolumeDir                        = "/var/run/kubevirt-image-volume"
 	VirtKernelBootVolumeDir                   = "/var/run/kubevirt-kernel-boot"
 	VirtPrivateDir                            = "/var/run/kubevirt-private"
+	ContainerDiskCacheDir                     = "/var/lib/kubevirt/container-disk-cache"
 	KubeletRoot                               = "/var/lib/kubelet"
 	KubeletPodsDir                            = KubeletRoot + "/pods"
 	HostRootMount                             = "/proc/1/root/"
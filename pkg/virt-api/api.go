@@ -69,6 +69,7 @@ import (
 	mime "kubevirt.io/kubevirt/pkg/rest"
 	"kubevirt.io/kubevirt/pkg/rest/filter"
 	"kubevirt.io/kubevirt/pkg/service"
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/openapi"
 	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
@@ -317,6 +318,17 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestexec")).
+			To(subresourceApp.GuestExecVMIRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.GuestExecOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestExec").
+			Doc("Execute a command in the guest of a VirtualMachineInstance through the guest agent.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("reset")).
 			To(subresourceApp.ResetVMIRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -568,6 +580,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("commitcontainerdisk")).
+			To(subresourceApp.CommitContainerDiskVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.VirtualMachineContainerDiskCommitRequest{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"CommitContainerDisk").
+			Doc("Commits a persistent containerDisk of a stopped Virtual Machine into a PVC.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		// AMD SEV endpoints
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/fetchcertchain")).
 			To(subresourceApp.SEVFetchCertChainRequestHandler).
@@ -644,6 +666,31 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath(cbt.BackupExportMapSubresource)).
+			To(subresourceApp.BackupMapVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.BackupExportNameParam(subws)).
+			Param(definitions.BackupExportBitmapParam(subws)).
+			Param(definitions.BackupExportOffsetParam(subws)).
+			Param(definitions.BackupExportLengthParam(subws)).
+			Operation(version.Version+"BackupMap").
+			Doc("Get the extents of a range of a pull mode backup export of the specified VirtualMachineInstance.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath(cbt.BackupExportReadSubresource)).
+			To(subresourceApp.BackupReadVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.BackupExportNameParam(subws)).
+			Param(definitions.BackupExportOffsetParam(subws)).
+			Param(definitions.BackupExportLengthParam(subws)).
+			Operation(version.Version+"BackupRead").
+			Doc("Read a range of a pull mode backup export of the specified VirtualMachineInstance.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("redefine-checkpoint")).
 			To(subresourceApp.RedefineCheckpointVMIRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -655,6 +702,17 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("delete-checkpoint")).
+			To(subresourceApp.DeleteCheckpointVMIRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(backupv1.BackupCheckpoint{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"DeleteCheckpoint").
+			Doc("Delete a checkpoint of a VirtualMachineInstance.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		// Return empty api resource list.
 		// K8s expects to be able to retrieve a resource list for each aggregated
 		// app in order to discover what resources it provides. Without returning
@@ -693,10 +751,22 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/backup",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/backup/map",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/backup/read",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/redefine-checkpoint",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/delete-checkpoint",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/pause",
 						Namespaced: true,
@@ -713,6 +783,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/unfreeze",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/guestexec",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/reset",
 						Namespaced: true,
@@ -1015,12 +1089,24 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli, informers)
 	})
+	http.HandleFunc(components.VMSnapshotGroupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotGroups(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMRestoreGroupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMRestoreGroups(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMBackupValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMBackups(w, r, app.clusterConfig, app.virtCli, informers)
 	})
 	http.HandleFunc(components.VMBackupTrackerValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMBackupTrackers(w, r, app.clusterConfig)
 	})
+	http.HandleFunc(components.VMBackupScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMBackupSchedules(w, r, app.clusterConfig, app.virtCli)
+	})
+	http.HandleFunc(components.VMBackupRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMBackupRestores(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
@@ -1045,6 +1131,9 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.MigrationPolicyCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeMigrationPolicies(w, r)
 	})
+	http.HandleFunc(components.StorageMigrationPlanValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeStorageMigrationPlans(w, r)
+	})
 	http.HandleFunc(components.VMCloneCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVirtualMachineClones(w, r, app.clusterConfig, app.virtCli)
 	})
@@ -127,6 +127,9 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmsgGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotgroups")
+	vmrgGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestoregroups")
+	vmsgrantGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotgrants")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -148,6 +151,21 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmsgGVR, &snapshotv1.VirtualMachineSnapshotGroup{}, "VirtualMachineSnapshotGroup", &snapshotv1.VirtualMachineSnapshotGroupList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmrgGVR, &snapshotv1.VirtualMachineRestoreGroup{}, "VirtualMachineRestoreGroup", &snapshotv1.VirtualMachineRestoreGroupList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmsgrantGVR, &snapshotv1.VirtualMachineSnapshotGrant{}, "VirtualMachineSnapshotGrant", &snapshotv1.VirtualMachineSnapshotGrantList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
@@ -177,6 +195,7 @@ func exportApiServiceDefinitions() []*restful.WebService {
 
 func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 	mpGVR := migrationsv1.SchemeGroupVersion.WithResource(migrations.ResourceMigrationPolicies)
+	smpGVR := migrationsv1.SchemeGroupVersion.WithResource(migrations.ResourceVirtualMachineStorageMigrationPlans)
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: migrationsv1.SchemeGroupVersion.Group, Version: migrationsv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -188,6 +207,11 @@ func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, smpGVR, &migrationsv1.VirtualMachineStorageMigrationPlan{}, migrationsv1.VirtualMachineStorageMigrationPlanKind.Kind, &migrationsv1.VirtualMachineStorageMigrationPlanList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(mpGVR)
 	if err != nil {
 		panic(err)
@@ -717,3 +741,26 @@ func VSOCKPortParameter(ws *restful.WebService) *restful.Parameter {
 func VSOCKTLSParameter(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(TLSParamName, "Weather to request a TLS encrypted session from the VSOCK application.").DataType("boolean").Required(false)
 }
+
+const (
+	BackupExportNameParamName   = "exportName"
+	BackupExportBitmapParamName = "bitmapName"
+	BackupExportOffsetParamName = "offset"
+	BackupExportLengthParamName = "length"
+)
+
+func BackupExportNameParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(BackupExportNameParamName, "The NBD export name of the backed up volume.").Required(true)
+}
+
+func BackupExportBitmapParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(BackupExportBitmapParamName, "The dirty bitmap to report changed extents of an incremental backup from.").Required(false)
+}
+
+func BackupExportOffsetParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(BackupExportOffsetParamName, "The offset in bytes of the requested range.").DataType("integer").Required(true)
+}
+
+func BackupExportLengthParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(BackupExportLengthParamName, "The length in bytes of the requested range.").DataType("integer").Required(true)
+}
@@ -0,0 +1,66 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
+)
+
+const noActiveBackupErr = "VMI has no backup in progress"
+
+func (app *SubresourceAPIApp) BackupMapVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	app.backupExportRequestHandler(request, response, 0, func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.BackupMapURI(vmi, request.Request.URL.RawQuery)
+	})
+}
+
+func (app *SubresourceAPIApp) BackupReadVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	app.backupExportRequestHandler(request, response, cbt.MaxBackupExportReadLength, func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.BackupReadURI(vmi, request.Request.URL.RawQuery)
+	})
+}
+
+func (app *SubresourceAPIApp) backupExportRequestHandler(request *restful.Request, response *restful.Response, maxLength uint64, getURL URLResolver) {
+	if _, err := cbt.ParseBackupExportRequest(request.Request.URL.Query(), maxLength); err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	app.httpGetRequestBinaryHandler(request, response, vmiHasActiveBackup, getURL)
+}
+
+func vmiHasActiveBackup(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if !vmi.IsRunning() {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	cbtStatus := vmi.Status.ChangedBlockTracking
+	if cbtStatus == nil || cbtStatus.BackupStatus == nil || cbtStatus.BackupStatus.Completed {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(noActiveBackupErr))
+	}
+	return nil
+}
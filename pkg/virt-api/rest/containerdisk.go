@@ -0,0 +1,163 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	persistentcontainerdisk "kubevirt.io/kubevirt/pkg/storage/persistent-containerdisk"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	containerDiskCommitNotEnabledErr      = "Committing persistent containerDisks requires the PersistentContainerDisk and ImageVolume feature gates"
+	containerDiskCommitMissingFieldsErr   = "Committing a containerDisk requires the volume name and the claim name to be set"
+	containerDiskCommitVolumeErrFmt       = "volume [%s] is not a persistent containerDisk"
+	containerDiskCommitVMRunningErr       = "the VM must be stopped to commit its containerDisk"
+	containerDiskCommitInProgressErrFmt   = "commit of containerDisk [%s] already in progress"
+	containerDiskCommitOverlayClaimErrFmt = "pvc [%s] is the overlay of the containerDisk, it can't be the commit target"
+)
+
+func (app *SubresourceAPIApp) validateContainerDiskCommitRequest(vm *v1.VirtualMachine, commitReq *v1.VirtualMachineContainerDiskCommitRequest) *errors.StatusError {
+	if commitReq.VolumeName == "" || commitReq.ClaimName == "" {
+		return errors.NewBadRequest(containerDiskCommitMissingFieldsErr)
+	}
+
+	isPersistent := false
+	if vm.Spec.Template != nil {
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			if volume.Name == commitReq.VolumeName && storagetypes.IsPersistentContainerDisk(&volume) {
+				isPersistent = true
+				break
+			}
+		}
+	}
+	if !isPersistent {
+		return errors.NewBadRequest(fmt.Sprintf(containerDiskCommitVolumeErrFmt, commitReq.VolumeName))
+	}
+
+	if persistentcontainerdisk.IsCommitInProgress(vm) {
+		return errors.NewConflict(v1.Resource("virtualmachine"), vm.Name,
+			fmt.Errorf(containerDiskCommitInProgressErrFmt, vm.Status.ContainerDiskCommitRequest.VolumeName))
+	}
+
+	if _, statErr := app.FetchVirtualMachineInstance(vm.Namespace, vm.Name); statErr == nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, fmt.Errorf(containerDiskCommitVMRunningErr))
+	} else if !errors.IsNotFound(statErr) {
+		return statErr
+	}
+
+	if commitReq.ClaimName == storagetypes.PersistentContainerDiskClaimName(vm.Name, commitReq.VolumeName) {
+		return errors.NewConflict(v1.Resource("persistentvolumeclaim"), commitReq.ClaimName,
+			fmt.Errorf(containerDiskCommitOverlayClaimErrFmt, commitReq.ClaimName))
+	}
+
+	// The claim may not exist yet when it is populated by a DataVolume,
+	// the commit waits for the DataVolume to succeed in that case
+	_, err := app.virtCli.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Get(context.Background(), commitReq.ClaimName, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	} else if !errors.IsNotFound(err) {
+		return errors.NewInternalError(fmt.Errorf("unable to retrieve dv [%s]: %v", commitReq.ClaimName, err))
+	}
+	pvc, statErr := app.fetchPersistentVolumeClaim(commitReq.ClaimName, vm.Namespace)
+	if statErr != nil {
+		return statErr
+	}
+	if storagetypes.IsReadOnlyAccessMode(pvc.Spec.AccessModes) {
+		return errors.NewConflict(v1.Resource("persistentvolumeclaim"), commitReq.ClaimName, fmt.Errorf(pvcAccessModeErr))
+	}
+
+	return nil
+}
+
+func generateVMContainerDiskCommitRequestPatch(vm *v1.VirtualMachine, commitReq *v1.VirtualMachineContainerDiskCommitRequest) ([]byte, error) {
+	patchSet := patch.New(patch.WithTest("/status/containerDiskCommitRequest", vm.Status.ContainerDiskCommitRequest))
+	if vm.Status.ContainerDiskCommitRequest != nil {
+		patchSet.AddOption(patch.WithReplace("/status/containerDiskCommitRequest", commitReq))
+	} else {
+		patchSet.AddOption(patch.WithAdd("/status/containerDiskCommitRequest", commitReq))
+	}
+
+	return patchSet.GeneratePayload()
+}
+
+func (app *SubresourceAPIApp) CommitContainerDiskVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.PersistentContainerDiskEnabled() || !app.clusterConfig.ImageVolumeEnabled() {
+		writeError(errors.NewBadRequest(containerDiskCommitNotEnabledErr), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body"), response)
+		return
+	}
+	commitReq := &v1.VirtualMachineContainerDiskCommitRequest{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, commitReq); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if statErr = app.validateContainerDiskCommitRequest(vm, commitReq); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	commitReq.Phase = v1.ContainerDiskCommitPending
+	commitReq.StartTimestamp = nil
+	commitReq.EndTimestamp = nil
+	commitReq.Message = ""
+	patchBytes, err := generateVMContainerDiskCommitRequestPatch(vm, commitReq)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	if _, err = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vm).Errorf("unable to patch vm status: %v", err)
+		writeError(errors.NewInternalError(fmt.Errorf("unable to patch vm status: %v", err)), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
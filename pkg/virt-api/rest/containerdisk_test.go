@@ -0,0 +1,195 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Commit containerDisk Subresource api", func() {
+	const (
+		volumeName = "disk0"
+		claimName  = "target"
+	)
+
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		kubeClient *fake.Clientset
+		vmClient   *kubecli.MockVirtualMachineInterface
+		vmiClient  *kubecli.MockVirtualMachineInstanceInterface
+		app        *SubresourceAPIApp
+		patchBody  []byte
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		}
+	)
+
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKV(kv)
+
+	newVM := func(persistent bool) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithContainerDisk(volumeName, "registry:5000/disk")))
+		vm.Name = testVMName
+		vm.Namespace = metav1.NamespaceDefault
+		if persistent {
+			vm.Spec.Template.Spec.Volumes[0].ContainerDisk.Persistent = &v1.PersistentContainerDisk{
+				Capacity: resource.MustParse("1Gi"),
+			}
+		}
+		return vm
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		response = restful.NewResponse(httptest.NewRecorder())
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().CdiClient().Return(cdifake.NewSimpleClientset()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		kvConfig := kv.DeepCopy()
+		kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.PersistentContainerDiskGate, featuregate.ImageVolume}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+		patchBody = nil
+		vmClient.EXPECT().PatchStatus(context.Background(), testVMName, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, _ metav1.PatchOptions) (*v1.VirtualMachine, error) {
+				patchBody = body
+				return nil, nil
+			}).AnyTimes()
+
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	AfterEach(func() {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+	})
+
+	setBody := func(req *v1.VirtualMachineContainerDiskCommitRequest) {
+		body, err := json.Marshal(req)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+	}
+
+	DescribeTable("should validate the commit request", func(vm *v1.VirtualMachine, req *v1.VirtualMachineContainerDiskCommitRequest, vmiExists, claimExists bool, statusCode int) {
+		setBody(req)
+		vmClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vm, nil).AnyTimes()
+		if vmiExists {
+			vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(libvmi.New(), nil).AnyTimes()
+		} else {
+			vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).
+				Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), testVMName)).AnyTimes()
+		}
+		if claimExists {
+			_, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Create(context.Background(), &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: claimName, Namespace: metav1.NamespaceDefault},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		app.CommitContainerDiskVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(statusCode))
+		if statusCode == http.StatusAccepted {
+			Expect(string(patchBody)).To(ContainSubstring(`"phase":"Pending"`))
+		} else {
+			Expect(patchBody).To(BeNil())
+		}
+	},
+		Entry("of a stopped VM", newVM(true),
+			&v1.VirtualMachineContainerDiskCommitRequest{VolumeName: volumeName, ClaimName: claimName}, false, true, http.StatusAccepted),
+		Entry("without a claim name", newVM(true),
+			&v1.VirtualMachineContainerDiskCommitRequest{VolumeName: volumeName}, false, true, http.StatusBadRequest),
+		Entry("of an ephemeral containerDisk", newVM(false),
+			&v1.VirtualMachineContainerDiskCommitRequest{VolumeName: volumeName, ClaimName: claimName}, false, true, http.StatusBadRequest),
+		Entry("of a running VM", newVM(true),
+			&v1.VirtualMachineContainerDiskCommitRequest{VolumeName: volumeName, ClaimName: claimName}, true, true, http.StatusConflict),
+		Entry("into a missing claim", newVM(true),
+			&v1.VirtualMachineContainerDiskCommitRequest{VolumeName: volumeName, ClaimName: claimName}, false, false, http.StatusNotFound),
+		Entry("into the overlay claim", newVM(true),
+			&v1.VirtualMachineContainerDiskCommitRequest{VolumeName: volumeName, ClaimName: "testvm-disk0-overlay"}, false, true, http.StatusConflict),
+	)
+
+	It("should reject a commit while another one is in progress", func() {
+		setBody(&v1.VirtualMachineContainerDiskCommitRequest{VolumeName: volumeName, ClaimName: claimName})
+		vm := newVM(true)
+		vm.Status.ContainerDiskCommitRequest = &v1.VirtualMachineContainerDiskCommitRequest{
+			VolumeName: volumeName,
+			ClaimName:  "other",
+			Phase:      v1.ContainerDiskCommitInProgress,
+		}
+		vmClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vm, nil)
+
+		app.CommitContainerDiskVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+	})
+
+	It("should fail when the feature gate is disabled", func() {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+		setBody(&v1.VirtualMachineContainerDiskCommitRequest{VolumeName: volumeName, ClaimName: claimName})
+
+		app.CommitContainerDiskVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -391,6 +391,24 @@ func (app *SubresourceAPIApp) UnfreezeVMIRequestHandler(request *restful.Request
 
 }
 
+func (app *SubresourceAPIApp) GuestExecVMIRequestHandler(request *restful.Request, response *restful.Response) {
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestExecURI(vmi)
+	}
+	app.putRequestHandler(request, response, validate, getURL, false)
+}
+
 func (app *SubresourceAPIApp) ResetVMIRequestHandler(request *restful.Request, response *restful.Response) {
 
 	// Post process any error responses in order to append human
@@ -712,3 +730,20 @@ func (app *SubresourceAPIApp) RedefineCheckpointVMIRequestHandler(request *restf
 
 	app.putRequestHandler(request, response, validate, getURL, false)
 }
+
+func (app *SubresourceAPIApp) DeleteCheckpointVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.ChangedBlockTracking == nil ||
+			vmi.Status.ChangedBlockTracking.State != v1.ChangedBlockTrackingEnabled {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name,
+				fmt.Errorf("ChangedBlockTracking is not enabled"))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.DeleteCheckpointURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL, false)
+}
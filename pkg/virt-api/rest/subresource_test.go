@@ -1184,6 +1184,41 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("GuestExec", func() {
+		It("Should execute a command in the guest of a running VMI", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec"),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+
+			expectVMI(Running, UnPaused, guestAgentConnected)
+
+			app.GuestExecVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should fail executing a command in the guest of a not running VMI", func() {
+
+			expectVMI(NotRunning, UnPaused, guestAgentConnected)
+
+			app.GuestExecVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail executing a command without a connected guest agent", func() {
+
+			expectVMI(Running, UnPaused)
+
+			app.GuestExecVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+
 	Context("Reset", func() {
 		It("Should reset a running VMI", func() {
 			backend.AppendHandlers(
@@ -26,6 +26,7 @@ import (
 
 	"github.com/emicklei/go-restful/v3"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,7 +38,9 @@ import (
 )
 
 const (
-	hotplugVolumeNotEnabledError = "Enable DeclarativeHotplugVolumes or HotplugVolumes feature gate to use this API."
+	hotplugVolumeNotEnabledError     = "Enable DeclarativeHotplugVolumes or HotplugVolumes feature gate to use this API."
+	hotplugFilesystemNotEnabledError = "Enable HotplugFilesystems feature gate to hotplug filesystems."
+	hotplugFilesystemNotSupportedFmt = "VMI %s/%s was not started with support for filesystem hotplug"
 )
 
 // VMAddVolumeRequestHandler handles the subresource for hot plugging a volume and disk.
@@ -92,15 +95,29 @@ func (app *SubresourceAPIApp) addVolumeRequestHandler(request *restful.Request,
 	if opts.Name == "" {
 		writeError(errors.NewBadRequest("AddVolumeOptions requires name to be set"), response)
 		return
-	} else if opts.Disk == nil {
+	} else if opts.Disk == nil && opts.Filesystem == nil {
 		writeError(errors.NewBadRequest("AddVolumeOptions requires disk to not be nil"), response)
 		return
+	} else if opts.Disk != nil && opts.Filesystem != nil {
+		writeError(errors.NewBadRequest("AddVolumeOptions requires either disk or filesystem to be set, not both"), response)
+		return
 	} else if opts.VolumeSource == nil {
 		writeError(errors.NewBadRequest("AddVolumeOptions requires VolumeSource to not be nil"), response)
 		return
 	}
 
-	opts.Disk.Name = opts.Name
+	if opts.Filesystem != nil {
+		if !app.clusterConfig.HotplugFilesystemsEnabled() {
+			writeError(errors.NewBadRequest(hotplugFilesystemNotEnabledError), response)
+			return
+		} else if opts.Filesystem.Virtiofs == nil {
+			writeError(errors.NewBadRequest("AddVolumeOptions requires filesystem to be of type virtiofs"), response)
+			return
+		}
+		opts.Filesystem.Name = opts.Name
+	} else {
+		opts.Disk.Name = opts.Name
+	}
 	volumeRequest := v1.VirtualMachineVolumeRequest{
 		AddVolumeOptions: opts,
 	}
@@ -225,6 +242,13 @@ func (app *SubresourceAPIApp) vmiVolumePatch(name, namespace string, volumeReque
 		return errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning))
 	}
 
+	if options := volumeRequest.AddVolumeOptions; options != nil && options.Filesystem != nil {
+		if _, ok := vmi.Annotations[v1.HotplugFilesystemsAnnotation]; !ok {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), name,
+				fmt.Errorf(hotplugFilesystemNotSupportedFmt, vmi.Namespace, vmi.Name))
+		}
+	}
+
 	err := verifyVolumeOption(vmi.Spec.Volumes, volumeRequest)
 	if err != nil {
 		return errors.NewConflict(v1.Resource("virtualmachineinstance"), name, err)
@@ -369,6 +393,18 @@ func generateVolumeRequestPatch(prefix string, vmiSpec *v1.VirtualMachineInstanc
 		patchSet.AddOption(patch.WithAdd(diskPath, vmiSpecCopy.Domain.Devices.Disks))
 	}
 
+	// Filesystems are only patched when the request changes them, so that
+	// disk requests keep working against objects without filesystems
+	if !equality.Semantic.DeepEqual(vmiSpec.Domain.Devices.Filesystems, vmiSpecCopy.Domain.Devices.Filesystems) {
+		filesystemPath := prefix + "/spec/domain/devices/filesystems"
+		patchSet.AddOption(patch.WithTest(filesystemPath, vmiSpec.Domain.Devices.Filesystems))
+		if len(vmiSpec.Domain.Devices.Filesystems) > 0 {
+			patchSet.AddOption(patch.WithReplace(filesystemPath, vmiSpecCopy.Domain.Devices.Filesystems))
+		} else {
+			patchSet.AddOption(patch.WithAdd(filesystemPath, vmiSpecCopy.Domain.Devices.Filesystems))
+		}
+	}
+
 	return patchSet.GeneratePayload()
 }
 
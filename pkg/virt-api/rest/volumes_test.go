@@ -379,6 +379,60 @@ var _ = Describe("Add/Remove Volume Subresource api", func() {
 		}, http.StatusAccepted, featuregate.HotplugVolumesGate, featuregate.DeclarativeHotplugVolumesGate),
 	)
 
+	DescribeTable("Should handle filesystem add volume request", func(addOpts *v1.AddVolumeOptions, withAnnotation bool, code int, featuregates ...string) {
+		enableFeatureGates(featuregates...)
+		request.Request.Body = newAddVolumeBody(addOpts)
+
+		vmi := libvmi.New(
+			libvmi.WithName(request.PathParameter("name")),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithPersistentVolumeClaim("existingvol", "testpvcdiskclaim"),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		)
+		if withAnnotation {
+			vmi.Annotations = map[string]string{v1.HotplugFilesystemsAnnotation: ""}
+		}
+
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil).AnyTimes()
+		if code == http.StatusAccepted {
+			vmiClient.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, body []byte, _ metav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+					Expect(string(body)).To(ContainSubstring(`"path":"/spec/domain/devices/filesystems"`))
+					return vmi, nil
+				})
+		}
+
+		app.VMIAddVolumeRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(code))
+	},
+		Entry("Accept with HotplugFilesystems", &v1.AddVolumeOptions{
+			Name:         "fs1",
+			Filesystem:   &v1.Filesystem{Virtiofs: &v1.FilesystemVirtiofs{}},
+			VolumeSource: &v1.HotplugVolumeSource{},
+		}, true, http.StatusAccepted, featuregate.HotplugVolumesGate, featuregate.HotplugFilesystemsGate),
+		Entry("Reject without HotplugFilesystems", &v1.AddVolumeOptions{
+			Name:         "fs1",
+			Filesystem:   &v1.Filesystem{Virtiofs: &v1.FilesystemVirtiofs{}},
+			VolumeSource: &v1.HotplugVolumeSource{},
+		}, true, http.StatusBadRequest, featuregate.HotplugVolumesGate),
+		Entry("Reject when the VMI was not started with shared memory", &v1.AddVolumeOptions{
+			Name:         "fs1",
+			Filesystem:   &v1.Filesystem{Virtiofs: &v1.FilesystemVirtiofs{}},
+			VolumeSource: &v1.HotplugVolumeSource{},
+		}, false, http.StatusConflict, featuregate.HotplugVolumesGate, featuregate.HotplugFilesystemsGate),
+		Entry("Reject a filesystem which is not virtiofs", &v1.AddVolumeOptions{
+			Name:         "fs1",
+			Filesystem:   &v1.Filesystem{},
+			VolumeSource: &v1.HotplugVolumeSource{},
+		}, true, http.StatusBadRequest, featuregate.HotplugVolumesGate, featuregate.HotplugFilesystemsGate),
+		Entry("Reject both a disk and a filesystem", &v1.AddVolumeOptions{
+			Name:         "fs1",
+			Disk:         &v1.Disk{},
+			Filesystem:   &v1.Filesystem{Virtiofs: &v1.FilesystemVirtiofs{}},
+			VolumeSource: &v1.HotplugVolumeSource{},
+		}, true, http.StatusBadRequest, featuregate.HotplugVolumesGate, featuregate.HotplugFilesystemsGate),
+	)
+
 	DescribeTable("Should generate expected vmi patch", func(volumeRequest *v1.VirtualMachineVolumeRequest, expectedPatchSet *patch.PatchSet) {
 
 		vmi := api.NewMinimalVMI(request.PathParameter("name"))
@@ -454,6 +508,40 @@ var _ = Describe("Add/Remove Volume Subresource api", func() {
 				patch.WithReplace("/spec/volumes", []v1.Volume{}),
 				patch.WithReplace("/spec/domain/devices/disks", []v1.Disk{}),
 			)),
+		Entry("add filesystem request",
+			&v1.VirtualMachineVolumeRequest{
+				AddVolumeOptions: &v1.AddVolumeOptions{
+					Name:         "fs1",
+					Filesystem:   &v1.Filesystem{Virtiofs: &v1.FilesystemVirtiofs{}},
+					VolumeSource: &v1.HotplugVolumeSource{},
+				},
+			},
+			patch.New(
+				patch.WithTest("/spec/volumes", []v1.Volume{{
+					Name: "existingvol",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testpvcdiskclaim",
+						}},
+					},
+				}}),
+				patch.WithTest("/spec/domain/devices/disks", []v1.Disk{{Name: "existingvol"}}),
+				patch.WithReplace("/spec/volumes", []v1.Volume{
+					{
+						Name: "existingvol",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "testpvcdiskclaim",
+							}},
+						},
+					},
+					{Name: "fs1"},
+				}),
+				patch.WithReplace("/spec/domain/devices/disks", []v1.Disk{{Name: "existingvol"}}),
+				patch.WithTest("/spec/domain/devices/filesystems", []v1.Filesystem(nil)),
+				patch.WithAdd("/spec/domain/devices/filesystems", []v1.Filesystem{{Name: "fs1", Virtiofs: &v1.FilesystemVirtiofs{}}}),
+			),
+		),
 	)
 
 	DescribeTable("Should generate expected vm patch (volume request)", func(volumeRequest *v1.VirtualMachineVolumeRequest, existingVolumeRequests []v1.VirtualMachineVolumeRequest, expectedPatchSet *patch.PatchSet, expectError bool) {
@@ -68,6 +68,16 @@ func ApplyNewVMIMutations(newVMI *v1.VirtualMachineInstance, clusterConfig *virt
 		}
 	}
 
+	if clusterConfig.HotplugFilesystemsEnabled() {
+		// Filesystems can only be hotplugged when the guest memory is shared with virtiofsd,
+		// which has to be decided when the VMI starts
+		log.Log.V(4).Infof("Add %s annotation", v1.HotplugFilesystemsAnnotation)
+		if newVMI.Annotations == nil {
+			newVMI.Annotations = map[string]string{}
+		}
+		newVMI.Annotations[v1.HotplugFilesystemsAnnotation] = ""
+	}
+
 	if !clusterConfig.RootEnabled() {
 		markAsNonroot(newVMI)
 	}
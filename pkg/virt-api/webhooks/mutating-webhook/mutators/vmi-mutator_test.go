@@ -623,6 +623,25 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(exist).To(BeTrue())
 	})
 
+	DescribeTable("should set the HotplugFilesystems annotation", func(featureGates []string, expected bool) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: featureGates,
+					},
+				},
+			},
+		})
+
+		vmiMeta, _, _ := getMetaSpecStatusFromAdmit()
+		_, exist := vmiMeta.Annotations[v1.HotplugFilesystemsAnnotation]
+		Expect(exist).To(Equal(expected))
+	},
+		Entry("when the HotplugFilesystems featureGate is enabled", []string{featuregate.HotplugFilesystemsGate}, true),
+		Entry("not when the HotplugFilesystems featureGate is disabled", nil, false),
+	)
+
 	It("should convert CPU requests to sockets", func() {
 		vmi.Spec.Domain.CPU = &v1.CPU{Model: "EPYC"}
 		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
@@ -0,0 +1,168 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+)
+
+// StorageMigrationPlanAdmitter validates VirtualMachineStorageMigrationPlans
+type StorageMigrationPlanAdmitter struct {
+}
+
+// NewStorageMigrationPlanAdmitter creates a StorageMigrationPlanAdmitter
+func NewStorageMigrationPlanAdmitter() *StorageMigrationPlanAdmitter {
+	return &StorageMigrationPlanAdmitter{}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *StorageMigrationPlanAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != migrationsv1.VirtualMachineStorageMigrationPlanKind.Group ||
+		ar.Request.Resource.Resource != migrations.ResourceVirtualMachineStorageMigrationPlans {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	plan := &migrationsv1.VirtualMachineStorageMigrationPlan{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, plan); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	sourceField := k8sfield.NewPath("spec")
+
+	if ar.Request.Operation == admissionv1.Update {
+		oldPlan := &migrationsv1.VirtualMachineStorageMigrationPlan{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPlan); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		if !equality.Semantic.DeepEqual(oldPlan.Spec, plan.Spec) {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "spec is immutable after creation",
+				Field:   sourceField.String(),
+			}})
+		}
+	}
+
+	causes := validateStorageMigrationPlanSpec(sourceField, &plan.Spec)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateStorageMigrationPlanSpec(field *k8sfield.Path, spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.TargetStorageClassName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "a target storage class is required",
+			Field:   field.Child("targetStorageClassName").String(),
+		})
+	}
+
+	if len(spec.VirtualMachines) == 0 && spec.Selector == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "either virtualMachines or selector must be set",
+			Field:   field.Child("virtualMachines").String(),
+		})
+	}
+
+	for i, name := range spec.VirtualMachines {
+		if name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must not be empty",
+				Field:   field.Child("virtualMachines").Index(i).String(),
+			})
+		}
+	}
+
+	if spec.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   field.Child("selector").String(),
+			})
+		}
+	}
+
+	if spec.MaxParallelMigrations != nil && *spec.MaxParallelMigrations < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   field.Child("maxParallelMigrations").String(),
+		})
+	}
+
+	if spec.BandwidthPerMigration != nil && spec.BandwidthPerMigration.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   field.Child("bandwidthPerMigration").String(),
+		})
+	}
+
+	if spec.Window != nil {
+		causes = append(causes, validateStorageMigrationWindow(field.Child("window"), spec.Window)...)
+	}
+
+	return causes
+}
+
+func validateStorageMigrationWindow(field *k8sfield.Path, window *migrationsv1.StorageMigrationWindow) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, bound := range []struct{ name, value string }{{"start", window.Start}, {"end", window.End}} {
+		if _, err := time.Parse(migrationsv1.StorageMigrationWindowTimeFormat, bound.value); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q is not a time of day in the HH:MM format", bound.value),
+				Field:   field.Child(bound.name).String(),
+			})
+		}
+	}
+	if len(causes) == 0 && window.Start == window.End {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "the window must not be empty",
+			Field:   field.Child("end").String(),
+		})
+	}
+	return causes
+}
@@ -0,0 +1,162 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating StorageMigrationPlan Admitter", func() {
+	var admitter *StorageMigrationPlanAdmitter
+
+	BeforeEach(func() {
+		admitter = NewStorageMigrationPlanAdmitter()
+	})
+
+	validSpec := func() migrationsv1.VirtualMachineStorageMigrationPlanSpec {
+		return migrationsv1.VirtualMachineStorageMigrationPlanSpec{
+			VirtualMachines:        []string{"vm1", "vm2"},
+			TargetStorageClassName: "fast",
+		}
+	}
+
+	DescribeTable("should reject a plan with", func(mutate func(*migrationsv1.VirtualMachineStorageMigrationPlanSpec), field string) {
+		spec := validSpec()
+		mutate(&spec)
+
+		resp := admitter.Admit(context.Background(), newStorageMigrationPlanAdmissionReview(admissionv1.Create, spec, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("no target storage class", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.TargetStorageClassName = ""
+		}, "spec.targetStorageClassName"),
+		Entry("neither virtual machines nor a selector", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.VirtualMachines = nil
+		}, "spec.virtualMachines"),
+		Entry("an empty virtual machine name", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.VirtualMachines = []string{"vm1", ""}
+		}, "spec.virtualMachines[1]"),
+		Entry("an invalid selector", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.Selector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bogus"}},
+			}
+		}, "spec.selector"),
+		Entry("zero parallel migrations", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.MaxParallelMigrations = pointer.P(int32(0))
+		}, "spec.maxParallelMigrations"),
+		Entry("negative bandwidth", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.BandwidthPerMigration = resource.NewScaledQuantity(-1, resource.Mega)
+		}, "spec.bandwidthPerMigration"),
+		Entry("a window start which is not a time of day", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.Window = &migrationsv1.StorageMigrationWindow{Start: "25:00", End: "06:00"}
+		}, "spec.window.start"),
+		Entry("a window end which is not a time of day", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.Window = &migrationsv1.StorageMigrationWindow{Start: "22:00", End: "6pm"}
+		}, "spec.window.end"),
+		Entry("an empty window", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.Window = &migrationsv1.StorageMigrationWindow{Start: "22:00", End: "22:00"}
+		}, "spec.window.end"),
+	)
+
+	DescribeTable("should accept a plan with", func(mutate func(*migrationsv1.VirtualMachineStorageMigrationPlanSpec)) {
+		spec := validSpec()
+		mutate(&spec)
+
+		resp := admitter.Admit(context.Background(), newStorageMigrationPlanAdmissionReview(admissionv1.Create, spec, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	},
+		Entry("only virtual machine names", func(_ *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {}),
+		Entry("only a selector", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.VirtualMachines = nil
+			spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}}
+		}),
+		Entry("throttling and source cleanup", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.MaxParallelMigrations = pointer.P(int32(3))
+			spec.BandwidthPerMigration = resource.NewScaledQuantity(64, resource.Mega)
+			spec.DeleteSourcePVCs = pointer.P(true)
+		}),
+		Entry("a window spanning midnight", func(spec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) {
+			spec.Window = &migrationsv1.StorageMigrationWindow{Start: "22:00", End: "06:00"}
+		}),
+	)
+
+	It("should reject spec updates", func() {
+		oldSpec := validSpec()
+		spec := validSpec()
+		spec.TargetStorageClassName = "slow"
+
+		resp := admitter.Admit(context.Background(), newStorageMigrationPlanAdmissionReview(admissionv1.Update, spec, &oldSpec))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+	})
+
+	It("should accept updates that keep the spec", func() {
+		oldSpec := validSpec()
+
+		resp := admitter.Admit(context.Background(), newStorageMigrationPlanAdmissionReview(admissionv1.Update, validSpec(), &oldSpec))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
+
+func newStorageMigrationPlanAdmissionReview(operation admissionv1.Operation, spec migrationsv1.VirtualMachineStorageMigrationPlanSpec, oldSpec *migrationsv1.VirtualMachineStorageMigrationPlanSpec) *admissionv1.AdmissionReview {
+	plan := &migrationsv1.VirtualMachineStorageMigrationPlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "plan", Namespace: "default"},
+		Spec:       spec,
+	}
+	planBytes, _ := json.Marshal(plan)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: operation,
+			Namespace: plan.Namespace,
+			Resource: metav1.GroupVersionResource{
+				Group:    migrationsv1.VirtualMachineStorageMigrationPlanKind.Group,
+				Resource: migrations.ResourceVirtualMachineStorageMigrationPlans,
+			},
+			Object: runtime.RawExtension{
+				Raw: planBytes,
+			},
+		},
+	}
+
+	if oldSpec != nil {
+		oldPlan := plan.DeepCopy()
+		oldPlan.Spec = *oldSpec
+		oldPlanBytes, _ := json.Marshal(oldPlan)
+		ar.Request.OldObject = runtime.RawExtension{Raw: oldPlanBytes}
+	}
+
+	return ar
+}
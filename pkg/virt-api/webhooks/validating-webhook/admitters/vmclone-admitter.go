@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"kubevirt.io/kubevirt/pkg/network/link"
@@ -33,15 +34,18 @@ import (
 
 	clonebase "kubevirt.io/api/clone"
 	clone "kubevirt.io/api/clone/v1beta1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 const (
 	virtualMachineKind         = "VirtualMachine"
 	virtualMachineSnapshotKind = "VirtualMachineSnapshot"
+	virtualMachineExportKind   = "VirtualMachineExport"
 )
 
 // VirtualMachineCloneAdmitter validates VirtualMachineClones
@@ -100,6 +104,14 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses := validateRemoteExport(vmClone, admitter.Config); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
+	if newCauses := validateSourceNamespace(vmClone, admitter.Config); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
 	if newCauses := validateTarget(vmClone); newCauses != nil {
 		causes = append(causes, newCauses...)
 	}
@@ -163,7 +175,7 @@ func validateSourceAndTargetKind(vmClone *clone.VirtualMachineClone) []metav1.St
 	var causes []metav1.StatusCause = nil
 	sourceField := k8sfield.NewPath("spec")
 
-	supportedSourceTypes := []string{virtualMachineKind, virtualMachineSnapshotKind}
+	supportedSourceTypes := []string{virtualMachineKind, virtualMachineSnapshotKind, virtualMachineExportKind}
 	supportedTargetTypes := []string{virtualMachineKind}
 
 	if !doesSliceContainStr(supportedSourceTypes, vmClone.Spec.Source.Kind) {
@@ -225,6 +237,79 @@ func validateSource(ctx context.Context, client kubecli.KubevirtClient, vmClone
 	return causes
 }
 
+func validateRemoteExport(vmClone *clone.VirtualMachineClone, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	remoteExportField := k8sfield.NewPath("spec").Child("remoteExport")
+	remoteExport := vmClone.Spec.RemoteExport
+
+	addCause := func(field *k8sfield.Path, message string) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: message,
+			Field:   field.String(),
+		})
+	}
+
+	if vmClone.Spec.Source == nil || vmClone.Spec.Source.Kind != virtualMachineExportKind {
+		if remoteExport != nil {
+			addCause(remoteExportField, "remoteExport can only be set when the source is a VirtualMachineExport")
+		}
+		return causes
+	}
+
+	if !config.RemoteExportCloneEnabled() {
+		addCause(k8sfield.NewPath("spec").Child("source").Child("kind"),
+			fmt.Sprintf("cloning from a VirtualMachineExport requires the %s feature gate", featuregate.RemoteExportCloneGate))
+	}
+	if remoteExport == nil {
+		addCause(remoteExportField, "remoteExport must be set when the source is a VirtualMachineExport")
+		return causes
+	}
+	if remoteExport.TokenSecretRef == "" {
+		addCause(remoteExportField.Child("tokenSecretRef"), "remoteExport tokenSecretRef cannot be empty")
+	}
+	if remoteExport.Link.Cert == "" {
+		addCause(remoteExportField.Child("link").Child("cert"), "remoteExport link cert cannot be empty")
+	}
+
+	manifestURL := ""
+	for _, manifest := range remoteExport.Link.Manifests {
+		if manifest.Type == exportv1.AllManifests {
+			manifestURL = manifest.Url
+		}
+	}
+	if u, err := url.Parse(manifestURL); manifestURL == "" || err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		addCause(remoteExportField.Child("link").Child("manifests"),
+			fmt.Sprintf("remoteExport link must provide an https url with a host and without credentials for the %s manifest", exportv1.AllManifests))
+	}
+
+	return causes
+}
+
+func validateSourceNamespace(vmClone *clone.VirtualMachineClone, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if vmClone.Spec.SourceNamespace == "" || vmClone.Spec.SourceNamespace == vmClone.Namespace {
+		return nil
+	}
+
+	sourceNamespaceField := k8sfield.NewPath("spec").Child("sourceNamespace")
+	if vmClone.Spec.Source == nil || vmClone.Spec.Source.Kind != virtualMachineSnapshotKind {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "sourceNamespace can only be set when the source is a VirtualMachineSnapshot",
+			Field:   sourceNamespaceField.String(),
+		}}
+	}
+	if !config.CrossNamespaceRestoreEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("cloning from another namespace requires the %s feature gate", featuregate.CrossNamespaceRestoreGate),
+			Field:   sourceNamespaceField.String(),
+		}}
+	}
+
+	return nil
+}
+
 func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
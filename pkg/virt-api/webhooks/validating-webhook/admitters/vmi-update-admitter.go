@@ -185,6 +185,8 @@ func admitHotplug(
 		oldVMI.Spec.Volumes,
 		newVMI.Spec.Domain.Devices.Disks,
 		oldVMI.Spec.Domain.Devices.Disks,
+		newVMI.Spec.Domain.Devices.Filesystems,
+		oldVMI.Spec.Domain.Devices.Filesystems,
 		oldVMI.Status.VolumeStatus,
 		newVMI,
 		clusterConfig)
@@ -368,14 +368,34 @@ func (admitter *VMsAdmitter) validateVolumeRequests(ctx context.Context, vm *v1.
 				}}, nil
 			}
 
-			// Validate the disk is configured properly
-			invalidDiskStatusCause := storageadmitters.ValidateHotplugDiskConfiguration(
-				volumeRequest.AddVolumeOptions.Disk, name,
-				"AddVolume request",
-				k8sfield.NewPath("Status", "volumeRequests").String(),
-			)
-			if invalidDiskStatusCause != nil {
-				return invalidDiskStatusCause, nil
+			if volumeRequest.AddVolumeOptions.Disk != nil && volumeRequest.AddVolumeOptions.Filesystem != nil {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("AddVolume request for [%s] requires either disk or filesystem to be set, not both", name),
+					Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
+				}}, nil
+			}
+
+			if volumeRequest.AddVolumeOptions.Filesystem != nil {
+				// Validate the filesystem is configured properly
+				invalidFilesystemStatusCause := storageadmitters.ValidateHotplugFilesystemConfiguration(
+					volumeRequest.AddVolumeOptions.Filesystem, name,
+					"AddVolume request",
+					k8sfield.NewPath("Status", "volumeRequests").String(),
+				)
+				if invalidFilesystemStatusCause != nil {
+					return invalidFilesystemStatusCause, nil
+				}
+			} else {
+				// Validate the disk is configured properly
+				invalidDiskStatusCause := storageadmitters.ValidateHotplugDiskConfiguration(
+					volumeRequest.AddVolumeOptions.Disk, name,
+					"AddVolume request",
+					k8sfield.NewPath("Status", "volumeRequests").String(),
+				)
+				if invalidDiskStatusCause != nil {
+					return invalidDiskStatusCause, nil
+				}
 			}
 
 			newVolume := v1.Volume{
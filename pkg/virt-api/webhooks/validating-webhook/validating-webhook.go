@@ -90,6 +90,14 @@ func ServeVMRestores(resp http.ResponseWriter, req *http.Request, clusterConfig
 	validating_webhooks.Serve(resp, req, storageadmitters.NewVMRestoreAdmitter(clusterConfig, virtCli, informers.VMRestoreInformer))
 }
 
+func ServeVMSnapshotGroups(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMSnapshotGroupAdmitter(clusterConfig))
+}
+
+func ServeVMRestoreGroups(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMRestoreGroupAdmitter(clusterConfig))
+}
+
 func ServeVMBackups(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient, informers *webhooks.Informers) {
 	validating_webhooks.Serve(resp, req, storageadmitters.NewVMBackupAdmitter(clusterConfig, virtCli, informers.VMBackupInformer))
 }
@@ -98,6 +106,14 @@ func ServeVMBackupTrackers(resp http.ResponseWriter, req *http.Request, clusterC
 	validating_webhooks.Serve(resp, req, storageadmitters.NewVMBackupTrackerAdmitter(clusterConfig))
 }
 
+func ServeVMBackupSchedules(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMBackupScheduleAdmitter(clusterConfig, virtCli))
+}
+
+func ServeVMBackupRestores(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMBackupRestoreAdmitter(clusterConfig))
+}
+
 func ServeVMExports(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
 	validating_webhooks.Serve(resp, req, storageadmitters.NewVMExportAdmitter(clusterConfig))
 }
@@ -138,6 +154,10 @@ func ServeMigrationPolicies(resp http.ResponseWriter, req *http.Request) {
 	validating_webhooks.Serve(resp, req, admitters.NewMigrationPolicyAdmitter())
 }
 
+func ServeStorageMigrationPlans(resp http.ResponseWriter, req *http.Request) {
+	validating_webhooks.Serve(resp, req, admitters.NewStorageMigrationPlanAdmitter())
+}
+
 func ServeVirtualMachineClones(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient) {
 	validating_webhooks.Serve(resp, req, admitters.NewVMCloneAdmitter(clusterConfig, virtCli))
 }
@@ -228,3 +228,43 @@ func (config *ClusterConfig) TemplateEnabled() bool {
 func (config *ClusterConfig) ContainerPathVolumesEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.ContainerPathVolumesGate)
 }
+
+func (config *ClusterConfig) ContainerDiskCacheEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ContainerDiskCacheGate)
+}
+
+func (config *ClusterConfig) PersistentContainerDiskEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.PersistentContainerDiskGate)
+}
+
+func (config *ClusterConfig) HotplugFilesystemsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.HotplugFilesystemsGate)
+}
+
+func (config *ClusterConfig) DiskIOTuneEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.DiskIOTuneGate)
+}
+
+func (config *ClusterConfig) RemoteExportCloneEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.RemoteExportCloneGate)
+}
+
+func (config *ClusterConfig) CrossNamespaceRestoreEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.CrossNamespaceRestoreGate)
+}
+
+func (config *ClusterConfig) InterfaceFirewallEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.InterfaceFirewallGate)
+}
+
+func (config *ClusterConfig) IPv6AutoConfigEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.IPv6AutoConfigGate)
+}
+
+func (config *ClusterConfig) InterfaceBandwidthEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.InterfaceBandwidthGate)
+}
+
+func (config *ClusterConfig) BridgeIPPreservingMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.BridgeIPPreservingMigrationGate)
+}
@@ -198,6 +198,76 @@ const (
 	// via virtiofs. This allows VMs to access credentials and tokens injected into pods
 	// by external systems such as AWS IRSA, GKE Workload Identity, or TEE attestation.
 	ContainerPathVolumesGate = "ContainerPathVolumes"
+
+	// Owner: sig-storage
+	// Alpha: v1.8.0
+	//
+	// ContainerDiskCache makes virt-handler share the containerDisk base images of all the VMIs on a node through
+	// a node cache addressed by image digest, instead of bind mounting the image of every containerDisk container.
+	// The images are still pulled for every launcher pod.
+	ContainerDiskCacheGate = "ContainerDiskCache"
+
+	// Owner: sig-storage
+	// Alpha: v1.8.0
+	//
+	// PersistentContainerDisk allows to keep the writes to a containerDisk in a qcow2 overlay stored on a PVC owned
+	// by the VM, and to commit the containerDisk along with its overlay into a PVC.
+	PersistentContainerDiskGate = "PersistentContainerDisk"
+
+	// Owner: sig-storage
+	// Alpha: v1.8.0
+	//
+	// HotplugFilesystems allows to hotplug PVC backed virtiofs filesystems into running VMIs.
+	// VMIs created while the gate is enabled are started with shared guest memory, which virtiofs requires.
+	// VMIs with hotplugged filesystems are not live migratable.
+	HotplugFilesystemsGate = "HotplugFilesystems"
+
+	// Owner: sig-storage
+	// Alpha: v1.8.0
+	//
+	// DiskIOTune allows to throttle the I/O of disks with bandwidth and IOPS limits.
+	DiskIOTuneGate = "DiskIOTune"
+
+	// Owner: sig-storage
+	// Alpha: v1.8.0
+	//
+	// RemoteExportClone allows to clone a VM from a VirtualMachineExport of a remote cluster.
+	RemoteExportCloneGate = "RemoteExportClone"
+
+	// Owner: sig-storage
+	// Alpha: v1.8.0
+	//
+	// CrossNamespaceRestore allows to restore and clone VirtualMachineSnapshots of other namespaces
+	// which are granted by a VirtualMachineSnapshotGrant.
+	CrossNamespaceRestoreGate = "CrossNamespaceRestore"
+
+	// Owner: sig-network
+	// Alpha: v1.8.0
+	//
+	// InterfaceFirewall allows to filter the traffic of bridge, masquerade and passt interfaces
+	// with ingress and egress rules enforced in the virt-launcher network namespace.
+	InterfaceFirewallGate = "InterfaceFirewall"
+
+	// Owner: sig-network
+	// Alpha: v1.8.0
+	//
+	// IPv6AutoConfig allows virt-launcher to configure the IPv6 of bridge binding guests
+	// with router advertisements and DHCPv6, based on the IPAM results reported by Multus.
+	IPv6AutoConfigGate = "IPv6AutoConfig"
+
+	// Owner: sig-network
+	// Alpha: v1.8.0
+	//
+	// InterfaceBandwidth allows to limit the inbound and outbound traffic of bridge and masquerade interfaces
+	// with traffic control enforced in the virt-launcher network namespace.
+	InterfaceBandwidthGate = "InterfaceBandwidth"
+
+	// Owner: sig-network
+	// Alpha: v1.8.0
+	//
+	// BridgeIPPreservingMigration allows to live migrate VMIs connected to the pod network with the bridge binding
+	// while the guest keeps its address, translated by the target virt-launcher pod to the target pod address.
+	BridgeIPPreservingMigrationGate = "BridgeIPPreservingMigration"
 )
 
 func init() {
@@ -240,4 +310,14 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: RebootPolicy, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: Template, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: ContainerPathVolumesGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ContainerDiskCacheGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: PersistentContainerDiskGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: HotplugFilesystemsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: DiskIOTuneGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: RemoteExportCloneGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: CrossNamespaceRestoreGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: InterfaceFirewallGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: IPv6AutoConfigGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: InterfaceBandwidthGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: BridgeIPPreservingMigrationGate, State: Alpha})
 }
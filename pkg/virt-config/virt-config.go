@@ -406,6 +406,10 @@ func (c *ClusterConfig) GetVMStateStorageClass() string {
 	return c.GetConfig().VMStateStorageClass
 }
 
+func (c *ClusterConfig) GetVMStateEncryption() *v1.VMStateEncryption {
+	return c.GetConfig().VMStateEncryption
+}
+
 func (c *ClusterConfig) IsFreePageReportingDisabled() bool {
 	return c.GetConfig().VirtualMachineOptions != nil && c.GetConfig().VirtualMachineOptions.DisableFreePageReporting != nil
 }
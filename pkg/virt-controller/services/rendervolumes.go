@@ -17,6 +17,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/config"
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
+	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
@@ -171,6 +172,12 @@ func withVMIVolumes(pvcStore cache.Store, vmiSpecVolumes []v1.Volume, vmiVolumeS
 				}
 			}
 
+			if volume.MemoryDump != nil && !volume.MemoryDump.Hotpluggable {
+				if err := renderer.handleMemoryDumpVolume(volume, pvcStore); err != nil {
+					return err
+				}
+			}
+
 			if volume.DownwardMetrics != nil {
 				renderer.handleDownwardMetrics(volume)
 			}
@@ -487,6 +494,74 @@ func withBackendStorage(vmi *v1.VirtualMachineInstance, backendStoragePVCName st
 	}
 }
 
+// withPersistentContainerDisks mounts the overlay PVCs of the persistent containerDisks
+// where virt-launcher creates the overlays of the containerDisks, so that an existing
+// overlay is reused instead of being created from scratch
+func withPersistentContainerDisks(pvcStore cache.Store, vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		for _, volume := range vmi.Spec.Volumes {
+			if !types.IsPersistentContainerDisk(&volume) {
+				continue
+			}
+			claimName := types.PersistentContainerDiskClaimName(vmi.Name, volume.Name)
+			pvc, exists, isBlock, err := types.IsPVCBlockFromStore(pvcStore, renderer.namespace, claimName)
+			if err != nil {
+				return err
+			} else if !exists {
+				return types.PvcNotFoundError{Reason: fmt.Sprintf("didn't find PVC %v", claimName)}
+			} else if isBlock {
+				return fmt.Errorf("overlay PVC %s of containerDisk %s must be filesystem mode", claimName, volume.Name)
+			}
+
+			podVolumeName := fmt.Sprintf("%s-overlay", volume.Name)
+			path := filepath.Join(renderer.ephemeralDiskDir, ephemeraldisk.DiskDataDir, volume.Name)
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: podVolumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claimName,
+					},
+				},
+			})
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      podVolumeName,
+				MountPath: path,
+			})
+			if types.HasSharedAccessMode(pvc.Spec.AccessModes) {
+				renderer.sharedFilesystemPaths = append(renderer.sharedFilesystemPaths, path)
+			}
+		}
+		return nil
+	}
+}
+
+// withBackendStorageEncryption mounts the key of an encrypted backend storage PVC,
+// virt-launcher hands it over to libvirt to encrypt the persistent TPM state and EFI NVRAM
+func withBackendStorageEncryption(vmi *v1.VirtualMachineInstance, keySecretName string) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		if keySecretName == "" || (!tpm.HasPersistentDevice(&vmi.Spec) && !backendstorage.HasPersistentEFI(&vmi.Spec)) {
+			return nil
+		}
+
+		const volumeName = "vm-state-key"
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: volumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{
+					SecretName: keySecretName,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      volumeName,
+			ReadOnly:  true,
+			MountPath: tpm.StateEncryptionKeyDir,
+		})
+
+		return nil
+	}
+}
+
 func withSidecarVolumes(hookSidecars hooks.HookSidecarList) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		if len(hookSidecars) != 0 {
@@ -660,6 +735,22 @@ func (vr *VolumeRenderer) handleDataVolume(volume v1.Volume, pvcStore cache.Stor
 	return nil
 }
 
+func (vr *VolumeRenderer) handleMemoryDumpVolume(volume v1.Volume, pvcStore cache.Store) error {
+	claimName := volume.MemoryDump.ClaimName
+	if err := vr.addPVCToLaunchManifest(pvcStore, volume, claimName); err != nil {
+		return err
+	}
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volume.Name,
+		VolumeSource: k8sv1.VolumeSource{
+			PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			},
+		},
+	})
+	return nil
+}
+
 func (vr *VolumeRenderer) handleHostDisk(volume v1.Volume) {
 	var hostPathType k8sv1.HostPathType
 
@@ -8,13 +8,17 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/tpm"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -296,6 +300,128 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	Context("with a persistent containerDisk", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		newPVCStore := func(pvcs ...*k8sv1.PersistentVolumeClaim) cache.Store {
+			store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			for _, pvc := range pvcs {
+				Expect(store.Add(pvc)).To(Succeed())
+			}
+			return store
+		}
+
+		newOverlayPVC := func(accessMode k8sv1.PersistentVolumeAccessMode) *k8sv1.PersistentVolumeClaim {
+			return &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "testvmi-disk0-overlay", Namespace: namespace},
+				Spec:       k8sv1.PersistentVolumeClaimSpec{AccessModes: []k8sv1.PersistentVolumeAccessMode{accessMode}},
+			}
+		}
+
+		BeforeEach(func() {
+			vmi = libvmi.New(
+				libvmi.WithName("testvmi"),
+				libvmi.WithNamespace(namespace),
+				libvmi.WithContainerDisk("disk0", "registry:5000/disk"),
+			)
+			vmi.Spec.Volumes[0].ContainerDisk.Persistent = &v1.PersistentContainerDisk{Capacity: resource.MustParse("1Gi")}
+		})
+
+		It("should mount the overlay PVC where virt-launcher creates the containerDisk overlay", func() {
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir,
+				withPersistentContainerDisks(newPVCStore(newOverlayPVC(k8sv1.ReadWriteOnce)), vmi))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(vsr.Mounts()).To(ContainElement(k8sv1.VolumeMount{
+				Name:      "disk0-overlay",
+				MountPath: "disk1/disk-data/disk0",
+			}))
+			Expect(vsr.Volumes()).To(ContainElement(k8sv1.Volume{
+				Name: "disk0-overlay",
+				VolumeSource: k8sv1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "testvmi-disk0-overlay"},
+				},
+			}))
+			Expect(vsr.SharedFilesystemPaths()).To(BeEmpty())
+		})
+
+		It("should report the overlay of a shared PVC as a shared filesystem", func() {
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir,
+				withPersistentContainerDisks(newPVCStore(newOverlayPVC(k8sv1.ReadWriteMany)), vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.SharedFilesystemPaths()).To(ConsistOf("disk1/disk-data/disk0"))
+		})
+
+		It("should wait for the overlay PVC", func() {
+			_, err := NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir,
+				withPersistentContainerDisks(newPVCStore(), vmi))
+			Expect(err).To(MatchError(ContainSubstring("didn't find PVC testvmi-disk0-overlay")))
+		})
+	})
+
+	Context("with a saved state memory dump volume", func() {
+		const (
+			memoryDumpVolumeName = "memory-state"
+		)
+
+		pvcStore := &cache.FakeCustomStore{
+			GetByKeyFunc: func(key string) (item interface{}, exists bool, err error) {
+				return &k8sv1.PersistentVolumeClaim{}, true, nil
+			},
+		}
+
+		newMemoryDumpVolume := func(hotpluggable bool) v1.Volume {
+			return v1.Volume{
+				Name: memoryDumpVolumeName,
+				VolumeSource: v1.VolumeSource{MemoryDump: &v1.MemoryDumpVolumeSource{
+					PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "memory-claim",
+						},
+						Hotpluggable: hotpluggable,
+					},
+					Format: v1.MemoryDumpFormatSavedState,
+				}},
+			}
+		}
+
+		It("should mount the memory dump claim in the launcher", func() {
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(pvcStore, []v1.Volume{newMemoryDumpVolume(false)}, nil))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      memoryDumpVolumeName,
+						MountPath: "/var/run/kubevirt-private/vmi-disks/memory-state",
+					})))
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: memoryDumpVolumeName,
+						VolumeSource: k8sv1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "memory-claim",
+							},
+						},
+					})))
+		})
+
+		It("should not mount a hotpluggable memory dump claim in the launcher", func() {
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(pvcStore, []v1.Volume{newMemoryDumpVolume(true)}, nil))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(vsr.Mounts()).To(ConsistOf(defaultVolumeMounts()))
+			Expect(vsr.Volumes()).To(ConsistOf(defaultVolumes()))
+		})
+	})
+
 	Context("with Downward API option", func() {
 		const (
 			downwardAPIVolumeName = "downward-then-upward"
@@ -392,6 +518,48 @@ var _ = Describe("Container spec renderer", func() {
 			Expect(vsr.Mounts()).To(ContainElement(expectedMount))
 		})
 	})
+	Context("With backend storage encryption", func() {
+		const keySecretName = backendStoragePVC + "-key"
+
+		expectedKeyMount := k8sv1.VolumeMount{
+			Name:      "vm-state-key",
+			ReadOnly:  true,
+			MountPath: tpm.StateEncryptionKeyDir,
+		}
+
+		persistentEFI := func() *v1.VirtualMachineInstance {
+			vmi := libvmi.New(libvmi.WithUefi(false))
+			vmi.Spec.Domain.Firmware.Bootloader.EFI.Persistent = pointer.P(true)
+			return vmi
+		}
+
+		DescribeTable("should mount the key of the backend storage", func(vmi *v1.VirtualMachineInstance) {
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withBackendStorageEncryption(vmi, keySecretName))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(vsr.Volumes()).To(ContainElement(k8sv1.Volume{
+				Name: "vm-state-key",
+				VolumeSource: k8sv1.VolumeSource{
+					Secret: &k8sv1.SecretVolumeSource{SecretName: keySecretName},
+				},
+			}))
+			Expect(vsr.Mounts()).To(ContainElement(expectedKeyMount))
+		},
+			Entry("when the TPM is persistent", libvmi.New(libvmi.WithTPM(true))),
+			Entry("when the EFI is persistent", persistentEFI()),
+		)
+
+		DescribeTable("should not mount the key", func(vmi *v1.VirtualMachineInstance, secretName string) {
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withBackendStorageEncryption(vmi, secretName))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Mounts()).NotTo(ContainElement(expectedKeyMount))
+		},
+			Entry("when the backend storage is not encrypted", libvmi.New(libvmi.WithTPM(true)), ""),
+			Entry("when the TPM is not persistent", libvmi.New(libvmi.WithTPM(false)), keySecretName),
+		)
+	})
 })
 
 func vmiDiskPath(volumeName string) string {
@@ -25,6 +25,7 @@ import (
 	"maps"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"slices"
 	"sort"
 	"strconv"
@@ -57,6 +58,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/multus"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	persistentcontainerdisk "kubevirt.io/kubevirt/pkg/storage/persistent-containerdisk"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -66,6 +68,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	operatorutil "kubevirt.io/kubevirt/pkg/virt-operator/util"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
 const (
@@ -249,15 +252,14 @@ func (t *TemplateService) GetLauncherImage() string {
 }
 
 func (t *TemplateService) RenderLaunchManifestNoVm(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
-	backendStoragePVCName := ""
+	var backendStoragePVC *k8sv1.PersistentVolumeClaim
 	if backendstorage.IsBackendStorageNeeded(vmi) {
-		backendStoragePVC := backendstorage.PVCForVMI(t.persistentVolumeClaimStore, vmi)
+		backendStoragePVC = backendstorage.PVCForVMI(t.persistentVolumeClaimStore, vmi)
 		if backendStoragePVC == nil {
 			return nil, fmt.Errorf("can't generate manifest without backend-storage PVC, waiting for the PVC to be created")
 		}
-		backendStoragePVCName = backendStoragePVC.Name
 	}
-	return t.renderLaunchManifest(vmi, nil, backendStoragePVCName, true)
+	return t.renderLaunchManifest(vmi, nil, backendStoragePVC, true)
 }
 
 func (t *TemplateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance, migration *v1.VirtualMachineInstanceMigration, sourcePod *k8sv1.Pod) (*k8sv1.Pod, error) {
@@ -265,15 +267,14 @@ func (t *TemplateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance
 	if err != nil {
 		return nil, fmt.Errorf("can not proceed with the migration when no reproducible image digest can be detected: %v", err)
 	}
-	backendStoragePVCName := ""
+	var backendStoragePVC *k8sv1.PersistentVolumeClaim
 	if backendstorage.IsBackendStorageNeeded(vmi) {
-		backendStoragePVC := backendstorage.PVCForMigrationTarget(t.persistentVolumeClaimStore, migration)
+		backendStoragePVC = backendstorage.PVCForMigrationTarget(t.persistentVolumeClaimStore, migration)
 		if backendStoragePVC == nil {
 			return nil, fmt.Errorf("can't generate manifest without backend-storage PVC, waiting for the PVC to be created")
 		}
-		backendStoragePVCName = backendStoragePVC.Name
 	}
-	targetPod, err := t.renderLaunchManifest(vmi, reproducibleImageIDs, backendStoragePVCName, false)
+	targetPod, err := t.renderLaunchManifest(vmi, reproducibleImageIDs, backendStoragePVC, false)
 	if err != nil {
 		return nil, err
 	}
@@ -291,15 +292,19 @@ func (t *TemplateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance
 }
 
 func (t *TemplateService) RenderLaunchManifest(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
-	backendStoragePVCName := ""
+	var backendStoragePVC *k8sv1.PersistentVolumeClaim
 	if backendstorage.IsBackendStorageNeeded(vmi) {
-		backendStoragePVC := backendstorage.PVCForVMI(t.persistentVolumeClaimStore, vmi)
+		backendStoragePVC = backendstorage.PVCForVMI(t.persistentVolumeClaimStore, vmi)
 		if backendStoragePVC == nil {
 			return nil, fmt.Errorf("can't generate manifest without backend-storage PVC, waiting for the PVC to be created")
 		}
-		backendStoragePVCName = backendStoragePVC.Name
 	}
-	return t.renderLaunchManifest(vmi, nil, backendStoragePVCName, false)
+	// Persistent containerDisks are pinned to the image their overlay was written on top of
+	imageIDs, err := persistentcontainerdisk.OverlayImageIDs(vmi, t.persistentVolumeClaimStore)
+	if err != nil {
+		return nil, err
+	}
+	return t.renderLaunchManifest(vmi, imageIDs, backendStoragePVC, false)
 }
 
 func generateQemuTimeoutWithJitter(qemuTimeoutBaseSeconds int) string {
@@ -329,7 +334,7 @@ func computePodSecurityContext(vmi *v1.VirtualMachineInstance, seccomp *k8sv1.Se
 	return psc
 }
 
-func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, imageIDs map[string]string, backendStoragePVCName string, tempPod bool) (*k8sv1.Pod, error) {
+func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, imageIDs map[string]string, backendStoragePVC *k8sv1.PersistentVolumeClaim, tempPod bool) (*k8sv1.Pod, error) {
 	precond.MustNotBeNil(vmi)
 	domain := precond.MustNotBeEmpty(vmi.GetObjectMeta().GetName())
 	namespace := precond.MustNotBeEmpty(vmi.GetObjectMeta().GetNamespace())
@@ -438,7 +443,7 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		command = append(command, "--simulate-crash")
 	}
 
-	volumeRenderer, err := t.newVolumeRenderer(vmi, imageIDs, namespace, requestedHookSidecarList, backendStoragePVCName)
+	volumeRenderer, err := t.newVolumeRenderer(vmi, imageIDs, namespace, requestedHookSidecarList, backendStoragePVC)
 	if err != nil {
 		return nil, err
 	}
@@ -855,13 +860,19 @@ func (t *TemplateService) newContainerSpecRenderer(vmi *v1.VirtualMachineInstanc
 	return containerRenderer
 }
 
-func (t *TemplateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, imageIDs map[string]string, namespace string, requestedHookSidecarList hooks.HookSidecarList, backendStoragePVCName string) (*VolumeRenderer, error) {
+func (t *TemplateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, imageIDs map[string]string, namespace string, requestedHookSidecarList hooks.HookSidecarList, backendStoragePVC *k8sv1.PersistentVolumeClaim) (*VolumeRenderer, error) {
 	imageVolumeFeatureGateEnabled := t.clusterConfig.ImageVolumeEnabled()
+	backendStoragePVCName := ""
+	if backendStoragePVC != nil {
+		backendStoragePVCName = backendStoragePVC.Name
+	}
 	volumeOpts := []VolumeRendererOption{
 		withVMIConfigVolumes(vmi.Spec.Domain.Devices.Disks, vmi.Spec.Volumes),
 		withVMIVolumes(t.persistentVolumeClaimStore, vmi.Spec.Volumes, vmi.Status.VolumeStatus),
+		withPersistentContainerDisks(t.persistentVolumeClaimStore, vmi),
 		withAccessCredentials(vmi.Spec.AccessCredentials),
 		withBackendStorage(vmi, backendStoragePVCName),
+		withBackendStorageEncryption(vmi, backendstorage.EncryptionKeySecretName(vmi, backendStoragePVC)),
 	}
 	if imageVolumeFeatureGateEnabled {
 		volumeOpts = append(volumeOpts, withImageVolumes(vmi))
@@ -879,7 +890,8 @@ func (t *TemplateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, imag
 	}
 
 	if vmispec.BindingPluginNetworkWithDeviceInfoExist(vmi.Spec.Domain.Devices.Interfaces, t.clusterConfig.GetNetworkBindings()) ||
-		vmispec.SRIOVInterfaceExist(vmi.Spec.Domain.Devices.Interfaces) {
+		vmispec.SRIOVInterfaceExist(vmi.Spec.Domain.Devices.Interfaces) ||
+		vmispec.IPv6AutoConfigInterfaceExist(vmi.Spec.Domain.Devices.Interfaces) {
 		volumeOpts = append(volumeOpts, func(renderer *VolumeRenderer) error {
 			renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(downwardapi.NetworkInfoVolumeName, downwardapi.MountPath))
 			return nil
@@ -1216,6 +1228,97 @@ func (t *TemplateService) RenderHotplugAttachmentTriggerPodTemplate(volume *v1.V
 	return pod, nil
 }
 
+// RenderHotplugFilesystemAttachmentPodTemplate renders the attachment pod of a hotplugged virtiofs filesystem.
+// The pod runs virtiofsd on the PVC and exposes its socket in the hotplug-disks emptyDir, from where
+// virt-handler mounts it into the virt-launcher pod.
+func (t *TemplateService) RenderHotplugFilesystemAttachmentPodTemplate(volume *v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, claimName string) (*k8sv1.Pod, error) {
+	zero := int64(0)
+	sharedMount := k8sv1.MountPropagationHostToContainer
+
+	tolerations := append(hotplugPodTolerations(), ownerPod.Spec.Tolerations...)
+
+	// Remove duplicates
+	sort.Slice(tolerations, func(i, j int) bool {
+		return tolerations[i].Key < tolerations[j].Key
+	})
+	tolerations = slices.Compact(tolerations)
+
+	resources := virtiofs.ResourcesForVirtioFSContainer(vmi.IsCPUDedicated(), vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed(), t.clusterConfig)
+	container := generateVirtiofsdContainer(volume, t.launcherImage, resources,
+		filepath.Join("/path", virtiofs.HotplugVirtioFSSocketName(volume.Name)),
+		k8sv1.VolumeMount{
+			Name:             hotplugDisks,
+			MountPath:        "/path",
+			MountPropagation: &sharedMount,
+		})
+	container.SecurityContext.SeccompProfile = &k8sv1.SeccompProfile{
+		Type: k8sv1.SeccompProfileTypeRuntimeDefault,
+	}
+	container.SecurityContext.SELinuxOptions = &k8sv1.SELinuxOptions{
+		// If SELinux is enabled on the host, this level will be adjusted below to match the level
+		// of its companion virt-launcher pod to allow it to connect to the socket.
+		Type:  t.clusterConfig.GetSELinuxLauncherType(),
+		Level: "s0",
+	}
+
+	pod := &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "hp-volume-",
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(ownerPod, schema.GroupVersionKind{
+					Group:   k8sv1.SchemeGroupVersion.Group,
+					Version: k8sv1.SchemeGroupVersion.Version,
+					Kind:    "Pod",
+				}),
+			},
+			Labels: map[string]string{
+				v1.AppLabel:                     hotplugDisk,
+				virtiofs.HotplugFilesystemLabel: volume.Name,
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			Containers: []k8sv1.Container{container},
+			Affinity: &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+							{
+								MatchExpressions: []k8sv1.NodeSelectorRequirement{
+									{
+										Key:      k8sv1.LabelHostname,
+										Operator: k8sv1.NodeSelectorOpIn,
+										Values:   []string{ownerPod.Spec.NodeName},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Tolerations: tolerations,
+			Volumes: []k8sv1.Volume{
+				{
+					Name: volume.Name,
+					VolumeSource: k8sv1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: claimName,
+						},
+					},
+				},
+				emptyDirVolume(hotplugDisks),
+			},
+			TerminationGracePeriodSeconds: &zero,
+		},
+	}
+
+	err := matchSELinuxLevelOfVMI(pod, vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	return pod, nil
+}
+
 func (t *TemplateService) RenderExporterManifest(vmExport *exportv1.VirtualMachineExport, namePrefix string) *k8sv1.Pod {
 	exporterPod := &k8sv1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
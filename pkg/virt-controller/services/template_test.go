@@ -59,6 +59,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/multus"
+	persistentcontainerdisk "kubevirt.io/kubevirt/pkg/storage/persistent-containerdisk"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -295,6 +296,26 @@ var _ = Describe("Template", func() {
 
 		})
 
+		It("should pin a persistent containerDisk to the digest recorded on its overlay", func() {
+			_, kvStore, svc = configFactory(defaultArch)
+			vmi := newMinimalWithContainerDisk("random")
+			vmi.Spec.Volumes[0].ContainerDisk.Persistent = &v1.PersistentContainerDisk{}
+			overlay := &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "random-containerdisk-overlay",
+					Namespace:   vmi.Namespace,
+					Annotations: map[string]string{persistentcontainerdisk.ImageIDAnnotation: "my-image-1@sha256:1234"},
+				},
+			}
+			Expect(pvcCache.Add(overlay)).To(Succeed())
+			DeferCleanup(pvcCache.Delete, overlay)
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pod.Spec.Containers).To(ContainElement(HaveField("Image", "my-image-1@sha256:1234")))
+		})
+
 		Context("with NonRoot feature-gate", func() {
 			var vmi *v1.VirtualMachineInstance
 			BeforeEach(func() {
@@ -4428,6 +4449,33 @@ var _ = Describe("Template", func() {
 			}))
 		})
 
+		It("should render a virtiofsd attachment pod for a hotplugged filesystem", func() {
+			vmi := api.NewMinimalVMI("fake-vmi")
+			ownerPod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			vmi.Status.SelinuxContext = "test_u:test_r:test_t:s0:c1,c2"
+			volume := &v1.Volume{
+				Name: "shared",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "shared-claim"},
+						Hotpluggable:                      true,
+					},
+				},
+			}
+			pod, err := svc.RenderHotplugFilesystemAttachmentPodTemplate(volume, ownerPod, vmi, "shared-claim")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Labels).To(HaveKeyWithValue(virtiofs.HotplugFilesystemLabel, "shared"))
+			Expect(pod.Spec.Containers).To(HaveLen(1))
+			Expect(pod.Spec.Containers[0].Name).To(Equal("virtiofs-shared"))
+			Expect(pod.Spec.Containers[0].Args).To(ContainElement(ContainSubstring(virtiofs.HotplugVirtioFSSocketName("shared"))))
+			Expect(pod.Spec.Containers[0].SecurityContext.SELinuxOptions.Level).To(Equal("s0:c1,c2"))
+			Expect(pod.Spec.Volumes).To(ContainElement(HaveField("VolumeSource.PersistentVolumeClaim.ClaimName", "shared-claim")))
+			Expect(pod.Spec.Volumes).To(ContainElement(HaveField("Name", "hotplug-disks")))
+		})
+
 		It("should compute the correct tolerations when rendering hotplug attachment pods", func() {
 			vmi := api.NewMinimalVMI("fake-vmi")
 			duplicateToleration := []k8sv1.Toleration{{Key: "test", Operator: k8sv1.TolerationOpExists, Effect: k8sv1.TaintEffectNoSchedule},
@@ -9,6 +9,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/config"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
@@ -31,6 +32,10 @@ func generateVirtioFSContainers(vmi *v1.VirtualMachineInstance, image string, co
 			if volume.ContainerPath != nil {
 				continue
 			}
+			// Skip hotplugged volumes - their virtiofsd runs in the hotplug attachment pod
+			if storagetypes.IsHotplugVolume(&volume) {
+				continue
+			}
 			resources := virtiofs.ResourcesForVirtioFSContainer(vmi.IsCPUDedicated(), vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed(), config)
 			container := generateContainerFromVolume(&volume, image, resources)
 			containers = append(containers, container)
@@ -63,8 +68,17 @@ func virtioFSMountPoint(volume *v1.Volume) string {
 }
 
 func generateContainerFromVolume(volume *v1.Volume, image string, resources k8sv1.ResourceRequirements) k8sv1.Container {
+	// This is required to pass socket to compute
+	socketVolumeMount := k8sv1.VolumeMount{
+		Name:      virtiofs.VirtioFSContainers,
+		MountPath: virtiofs.VirtioFSContainersMountBaseDir,
+	}
 
-	socketPathArg := fmt.Sprintf("--socket-path=%s", virtiofs.VirtioFSSocketPath(volume.Name))
+	return generateVirtiofsdContainer(volume, image, resources, virtiofs.VirtioFSSocketPath(volume.Name), socketVolumeMount)
+}
+
+func generateVirtiofsdContainer(volume *v1.Volume, image string, resources k8sv1.ResourceRequirements, socketPath string, socketVolumeMount k8sv1.VolumeMount) k8sv1.Container {
+	socketPathArg := fmt.Sprintf("--socket-path=%s", socketPath)
 	sourceArg := fmt.Sprintf("--shared-dir=%s", virtioFSMountPoint(volume))
 
 	args := []string{socketPathArg, sourceArg, "--sandbox=none", "--cache=auto"}
@@ -79,13 +93,7 @@ func generateContainerFromVolume(volume *v1.Volume, image string, resources k8sv
 	// This migration mode doesn't require any privileges.
 	args = append(args, "--migration-mode=find-paths")
 
-	volumeMounts := []k8sv1.VolumeMount{
-		// This is required to pass socket to compute
-		{
-			Name:      virtiofs.VirtioFSContainers,
-			MountPath: virtiofs.VirtioFSContainersMountBaseDir,
-		},
-	}
+	volumeMounts := []k8sv1.VolumeMount{socketVolumeMount}
 
 	if !isAutoMount(volume) {
 		volumeMounts = append(volumeMounts, k8sv1.VolumeMount{
@@ -128,4 +128,24 @@ var _ = Describe("virtiofs container", func() {
 		Expect(containers).To(HaveLen(1))
 		Expect(containers[0].Name).To(Equal("virtiofs-pvc-volume"))
 	})
+
+	It("should skip hotplugged volumes", func() {
+		vmi := api.NewMinimalVMI("testvm")
+
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "hotplug-volume",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: testutils.NewFakePersistentVolumeSource().PersistentVolumeClaimVolumeSource,
+					Hotpluggable:                      true,
+				},
+			},
+		})
+		vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems, v1.Filesystem{
+			Name:     "hotplug-volume",
+			Virtiofs: &v1.FilesystemVirtiofs{},
+		})
+
+		Expect(generateVirtioFSContainers(vmi, "virtiofs-container", config)).To(BeEmpty())
+	})
 })
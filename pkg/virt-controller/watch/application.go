@@ -44,6 +44,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
+	volumemigration "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
 
 	"github.com/emicklei/go-restful/v3"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
@@ -73,6 +74,7 @@ import (
 
 	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
@@ -90,6 +92,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/service"
 	backup "kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	persistentcontainerdisk "kubevirt.io/kubevirt/pkg/storage/persistent-containerdisk"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -211,6 +214,9 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmSnapshotGroupInformer      cache.SharedIndexInformer
+	vmSnapshotGrantInformer      cache.SharedIndexInformer
+	vmRestoreGroupInformer       cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
@@ -222,9 +228,16 @@ type VirtControllerApp struct {
 	vmCloneInformer   cache.SharedIndexInformer
 	vmCloneController *clonecontroller.VMCloneController
 
-	vmBackupInformer        cache.SharedIndexInformer
-	vmBackupTrackerInformer cache.SharedIndexInformer
-	vmBackupController      *backup.VMBackupController
+	storageMigrationPlanInformer   cache.SharedIndexInformer
+	storageMigrationPlanController *volumemigration.PlanController
+
+	vmBackupInformer         cache.SharedIndexInformer
+	vmBackupTrackerInformer  cache.SharedIndexInformer
+	vmBackupScheduleInformer cache.SharedIndexInformer
+	vmBackupRestoreInformer  cache.SharedIndexInformer
+	vmBackupController       *backup.VMBackupController
+
+	containerDiskCommitController *persistentcontainerdisk.CommitController
 
 	instancetypeInformer        cache.SharedIndexInformer
 	clusterInstancetypeInformer cache.SharedIndexInformer
@@ -257,24 +270,26 @@ type VirtControllerApp struct {
 	reInitChan chan string
 
 	// number of threads for each controller
-	nodeControllerThreads             int
-	vmiControllerThreads              int
-	draStatusControllerThreads        int
-	rsControllerThreads               int
-	poolControllerThreads             int
-	vmControllerThreads               int
-	migrationControllerThreads        int
-	evacuationControllerThreads       int
-	disruptionBudgetControllerThreads int
-	launcherSubGid                    int64
-	exportControllerThreads           int
-	snapshotControllerThreads         int
-	restoreControllerThreads          int
-	snapshotControllerResyncPeriod    time.Duration
-	cloneControllerThreads            int
-	additionalLauncherAnnotationsSync []string
-	additionalLauncherLabelsSync      []string
-	backupControllerThreads           int
+	nodeControllerThreads                 int
+	vmiControllerThreads                  int
+	draStatusControllerThreads            int
+	rsControllerThreads                   int
+	poolControllerThreads                 int
+	vmControllerThreads                   int
+	migrationControllerThreads            int
+	evacuationControllerThreads           int
+	disruptionBudgetControllerThreads     int
+	launcherSubGid                        int64
+	exportControllerThreads               int
+	snapshotControllerThreads             int
+	restoreControllerThreads              int
+	snapshotControllerResyncPeriod        time.Duration
+	cloneControllerThreads                int
+	storageMigrationPlanControllerThreads int
+	additionalLauncherAnnotationsSync     []string
+	additionalLauncherLabelsSync          []string
+	backupControllerThreads               int
+	containerDiskCommitControllerThreads  int
 
 	promCertFilePath         string
 	promKeyFilePath          string
@@ -295,6 +310,7 @@ func init() {
 	utilruntime.Must(poolv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(clone.AddToScheme(scheme.Scheme))
 	utilruntime.Must(backupv1.AddToScheme(scheme.Scheme))
+	utilruntime.Must(migrationsv1.AddToScheme(scheme.Scheme))
 }
 
 func Execute() {
@@ -410,10 +426,15 @@ func Execute() {
 
 	app.vmBackupInformer = app.informerFactory.VirtualMachineBackup()
 	app.vmBackupTrackerInformer = app.informerFactory.VirtualMachineBackupTracker()
+	app.vmBackupScheduleInformer = app.informerFactory.VirtualMachineBackupSchedule()
+	app.vmBackupRestoreInformer = app.informerFactory.VirtualMachineBackupRestore()
 	app.vmExportInformer = app.informerFactory.VirtualMachineExport()
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmSnapshotGroupInformer = app.informerFactory.VirtualMachineSnapshotGroup()
+	app.vmSnapshotGrantInformer = app.informerFactory.VirtualMachineSnapshotGrant()
+	app.vmRestoreGroupInformer = app.informerFactory.VirtualMachineRestoreGroup()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
@@ -456,6 +477,7 @@ func Execute() {
 	app.migrationPolicyInformer = app.informerFactory.MigrationPolicy()
 
 	app.vmCloneInformer = app.informerFactory.VirtualMachineClone()
+	app.storageMigrationPlanInformer = app.informerFactory.VirtualMachineStorageMigrationPlan()
 
 	app.instancetypeInformer = app.informerFactory.VirtualMachineInstancetype()
 	app.clusterInstancetypeInformer = app.informerFactory.VirtualMachineClusterInstancetype()
@@ -500,7 +522,9 @@ func Execute() {
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
+	app.initStorageMigrationPlanController()
 	app.initBackupController()
+	app.initContainerDiskCommitController()
 	go app.Run()
 
 	<-app.reInitChan
@@ -647,11 +671,21 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the clone controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.storageMigrationPlanController.Run(vca.storageMigrationPlanControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the storage migration plan controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.vmBackupController.Run(vca.backupControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the backup controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.containerDiskCommitController.Run(vca.containerDiskCommitControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the containerDisk commit controller: %v", err)
+			}
+		}()
 
 		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced, vca.namespaceInformer.HasSynced, vca.resourceQuotaInformer.HasSynced)
 		close(vca.readyChan)
@@ -898,6 +932,7 @@ func (vca *VirtControllerApp) initSnapshotController() {
 		Client:                    vca.clientSet,
 		VMSnapshotInformer:        vca.vmSnapshotInformer,
 		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		VMSnapshotGroupInformer:   vca.vmSnapshotGroupInformer,
 		VMInformer:                vca.vmInformer,
 		VMIInformer:               vca.vmiInformer,
 		StorageClassInformer:      vca.storageClassInformer,
@@ -920,7 +955,10 @@ func (vca *VirtControllerApp) initRestoreController() {
 	vca.restoreController = &snapshot.VMRestoreController{
 		Client:                    vca.clientSet,
 		VMRestoreInformer:         vca.vmRestoreInformer,
+		VMRestoreGroupInformer:    vca.vmRestoreGroupInformer,
 		VMSnapshotInformer:        vca.vmSnapshotInformer,
+		VMSnapshotGroupInformer:   vca.vmSnapshotGroupInformer,
+		VMSnapshotGrantInformer:   vca.vmSnapshotGrantInformer,
 		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
 		VMInformer:                vca.vmInformer,
 		VMIInformer:               vca.vmiInformer,
@@ -956,6 +994,7 @@ func (vca *VirtControllerApp) initExportController() {
 		VolumeSnapshotProvider:      vca.snapshotController,
 		VMSnapshotInformer:          vca.vmSnapshotInformer,
 		VMSnapshotContentInformer:   vca.vmSnapshotContentInformer,
+		VMBackupInformer:            vca.vmBackupInformer,
 		VMInformer:                  vca.vmInformer,
 		VMIInformer:                 vca.vmiInformer,
 		CRDInformer:                 vca.crdInformer,
@@ -982,11 +1021,34 @@ func (vca *VirtControllerApp) initCloneController() {
 	}
 }
 
+func (vca *VirtControllerApp) initStorageMigrationPlanController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "storage-migration-plan-controller")
+	vca.storageMigrationPlanController, err = volumemigration.NewPlanController(
+		vca.clientSet, vca.storageMigrationPlanInformer, vca.vmInformer, vca.vmiInformer, vca.persistentVolumeClaimInformer, vca.dataVolumeInformer, recorder,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initBackupController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "backup-controller")
 	vca.vmBackupController, err = backup.NewVMBackupController(
-		vca.clientSet, vca.vmBackupInformer, vca.vmBackupTrackerInformer, vca.vmInformer, vca.vmiInformer, vca.persistentVolumeClaimInformer, recorder,
+		vca.clientSet, vca.vmBackupInformer, vca.vmBackupTrackerInformer, vca.vmBackupScheduleInformer, vca.vmBackupRestoreInformer,
+		vca.vmInformer, vca.vmiInformer, vca.persistentVolumeClaimInformer, vca.kvPodInformer, vca.launcherImage, recorder,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initContainerDiskCommitController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "containerdisk-commit-controller")
+	vca.containerDiskCommitController, err = persistentcontainerdisk.NewCommitController(
+		vca.clientSet, vca.vmInformer, vca.vmiInformer, vca.persistentVolumeClaimInformer, vca.dataVolumeInformer, vca.kvPodInformer, vca.launcherImage, vca.clusterConfig, recorder,
 	)
 	if err != nil {
 		panic(err)
@@ -1102,6 +1164,9 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.cloneControllerThreads, "clone-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for clone controller")
 
+	flag.IntVar(&vca.storageMigrationPlanControllerThreads, "storage-migration-plan-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for storage migration plan controller")
+
 	flag.StringSliceVar(&vca.additionalLauncherAnnotationsSync, "additional-launcher-annotations-sync", []string{},
 		"Comma separated list of annotation keys which if present on the VM template and so VMI, will be sync to the virt-launcher pod. Note, it is unidirectional from VM.spec.template.metadata -> VMI and VMI -> virt-launcher pod")
 
@@ -1110,6 +1175,9 @@ func (vca *VirtControllerApp) AddFlags() {
 
 	flag.IntVar(&vca.backupControllerThreads, "backup-controller-threads", defaultBackupControllerThreads,
 		"Number of goroutines to run for backup controller")
+
+	flag.IntVar(&vca.containerDiskCommitControllerThreads, "containerdisk-commit-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for containerDisk commit controller")
 }
 
 func (vca *VirtControllerApp) setupLeaderElector() (err error) {
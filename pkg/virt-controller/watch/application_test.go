@@ -57,6 +57,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/rest"
 	backup "kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	persistentcontainerdisk "kubevirt.io/kubevirt/pkg/storage/persistent-containerdisk"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -70,6 +71,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
+	volumemigration "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
 )
 
 func newValidGetRequest() *http.Request {
@@ -117,14 +119,20 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+		vmSnapshotGrantInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGrant{})
+		vmRestoreGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestoreGroup{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		exportServiceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Service{})
 		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
+		storageMigrationPlanInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.VirtualMachineStorageMigrationPlan{})
 		backupInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
 		backupTrackerInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupTracker{})
+		backupScheduleInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupSchedule{})
+		backupRestoreInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupRestore{})
 		secretInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Secret{})
 		instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
 		clusterInstancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
@@ -202,6 +210,7 @@ var _ = Describe("Application", func() {
 			Client:                    virtClient,
 			VMSnapshotInformer:        vmSnapshotInformer,
 			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
 			VMInformer:                vmInformer,
 			VMIInformer:               vmiInformer,
 			PodInformer:               podInformer,
@@ -217,7 +226,10 @@ var _ = Describe("Application", func() {
 		app.restoreController = &snapshot.VMRestoreController{
 			Client:                    virtClient,
 			VMRestoreInformer:         vmRestoreInformer,
+			VMRestoreGroupInformer:    vmRestoreGroupInformer,
 			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
+			VMSnapshotGrantInformer:   vmSnapshotGrantInformer,
 			VMSnapshotContentInformer: vmSnapshotContentInformer,
 			VMInformer:                vmInformer,
 			VMIInformer:               vmiInformer,
@@ -241,6 +253,7 @@ var _ = Describe("Application", func() {
 			SecretInformer:              secretInformer,
 			VMSnapshotInformer:          vmSnapshotInformer,
 			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            backupInformer,
 			VMInformer:                  vmInformer,
 			VMIInformer:                 vmiInformer,
 			CRDInformer:                 crdInformer,
@@ -266,13 +279,37 @@ var _ = Describe("Application", func() {
 			pvcInformer,
 			recorder,
 		)
+		app.storageMigrationPlanController, _ = volumemigration.NewPlanController(
+			virtClient,
+			storageMigrationPlanInformer,
+			vmInformer,
+			vmiInformer,
+			pvcInformer,
+			dvInformer,
+			recorder,
+		)
 		app.vmBackupController, _ = backup.NewVMBackupController(
 			virtClient,
 			backupInformer,
 			backupTrackerInformer,
+			backupScheduleInformer,
+			backupRestoreInformer,
 			vmInformer,
 			vmiInformer,
 			pvcInformer,
+			podInformer,
+			"",
+			recorder,
+		)
+		app.containerDiskCommitController, _ = persistentcontainerdisk.NewCommitController(
+			virtClient,
+			vmInformer,
+			vmiInformer,
+			pvcInformer,
+			dvInformer,
+			podInformer,
+			"",
+			config,
 			recorder,
 		)
 
@@ -43,8 +43,9 @@ import (
 type cloneSourceType string
 
 const (
-	sourceTypeVM       cloneSourceType = "VirtualMachine"
-	sourceTypeSnapshot cloneSourceType = "VirtualMachineSnapshot"
+	sourceTypeVM           cloneSourceType = "VirtualMachine"
+	sourceTypeSnapshot     cloneSourceType = "VirtualMachineSnapshot"
+	sourceTypeRemoteExport cloneSourceType = "VirtualMachineExport"
 )
 
 type cloneTargetType string
@@ -63,6 +64,8 @@ type syncInfoType struct {
 	targetVMName    string
 	targetVMCreated bool
 	pvcBound        bool
+	isRemoteImport  bool
+	vmImported      bool
 
 	event          Event
 	reason         string
@@ -152,6 +155,9 @@ func (ctrl *VMCloneController) sync(vmClone *clone.VirtualMachineClone) (syncInf
 	}
 
 	if ctrl.getTargetType(cloneInfo.vmClone) == targetTypeVM {
+		if cloneInfo.sourceType == sourceTypeRemoteExport {
+			return ctrl.syncTargetVMFromRemoteExport(vmClone), nil
+		}
 		return ctrl.syncTargetVM(cloneInfo), nil
 	}
 	return syncInfoType{err: fmt.Errorf("target type is unknown: %s", ctrl.getTargetType(cloneInfo.vmClone))}, nil
@@ -179,7 +185,7 @@ func (ctrl *VMCloneController) retrieveCloneInfo(vmClone *clone.VirtualMachineCl
 		cloneInfo.sourceVm = sourceVM
 
 	case sourceTypeSnapshot:
-		sourceSnapshotObj, err := ctrl.getSource(vmClone, sourceInfo.Name, vmClone.Namespace, string(sourceTypeSnapshot), ctrl.snapshotStore)
+		sourceSnapshotObj, err := ctrl.getSource(vmClone, sourceInfo.Name, getSourceNamespace(vmClone), string(sourceTypeSnapshot), ctrl.snapshotStore)
 		if err != nil {
 			return nil, err
 		}
@@ -188,6 +194,11 @@ func (ctrl *VMCloneController) retrieveCloneInfo(vmClone *clone.VirtualMachineCl
 		cloneInfo.snapshot = sourceSnapshot
 		cloneInfo.snapshotName = sourceSnapshot.Name
 
+	case sourceTypeRemoteExport:
+		if vmClone.Spec.RemoteExport == nil {
+			return nil, fmt.Errorf("clone %s with source type %s has no remote export defined", vmClone.Name, sourceInfo.Kind)
+		}
+
 	default:
 		return nil, fmt.Errorf("clone %s is defined with an unknown source type %s", vmClone.Name, sourceInfo.Kind)
 	}
@@ -213,7 +224,7 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 			}
 		}
 
-		vmCloneInfo.snapshot, syncInfo = ctrl.verifySnapshotReady(vmClone, vmCloneInfo.snapshotName, vmCloneInfo.vmClone.Namespace, syncInfo)
+		vmCloneInfo.snapshot, syncInfo = ctrl.verifySnapshotReady(vmClone, vmCloneInfo.snapshotName, getSourceNamespace(vmClone), syncInfo)
 		if syncInfo.isFailingOrError() || !syncInfo.snapshotReady {
 			return syncInfo
 		}
@@ -223,7 +234,7 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 	case clone.RestoreInProgress:
 		// Here we have to know the snapshot name
 		if vmCloneInfo.snapshot == nil {
-			vmCloneInfo.snapshot, syncInfo = ctrl.getSnapshot(vmCloneInfo.snapshotName, vmCloneInfo.vmClone.Namespace, syncInfo)
+			vmCloneInfo.snapshot, syncInfo = ctrl.getSnapshot(vmCloneInfo.snapshotName, getSourceNamespace(vmClone), syncInfo)
 			if syncInfo.isFailingOrError() {
 				return syncInfo
 			}
@@ -315,7 +326,14 @@ func (ctrl *VMCloneController) updateStatus(origClone *clone.VirtualMachineClone
 	}
 
 	if isInPhase(vmClone, clone.PhaseUnset) && !syncInfo.isClonePending {
-		assignPhase(clone.SnapshotInProgress)
+		if syncInfo.isRemoteImport {
+			assignPhase(clone.ImportInProgress)
+		} else {
+			assignPhase(clone.SnapshotInProgress)
+		}
+	}
+	if isInPhase(vmClone, clone.ImportInProgress) && syncInfo.vmImported {
+		assignPhase(clone.CreatingTargetVM)
 	}
 	if isInPhase(vmClone, clone.SnapshotInProgress) {
 		if snapshotName := syncInfo.snapshotName; snapshotName != "" {
@@ -544,6 +562,9 @@ func (ctrl *VMCloneController) createRestoreFromVm(vmClone *clone.VirtualMachine
 		return syncInfo
 	}
 	restore := generateRestore(vmClone.Spec.Target, vm.Name, vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches, vmClone.Spec.VolumeNamePolicy)
+	if snapshotNamespace := getSourceNamespace(vmClone); snapshotNamespace != vmClone.Namespace {
+		restore.Spec.VirtualMachineSnapshotNamespace = snapshotNamespace
+	}
 	log.Log.Object(vmClone).Infof("creating restore %s for clone %s", restore.Name, vmClone.Name)
 	createdRestore, err := ctrl.client.VirtualMachineRestore(restore.Namespace).Create(context.Background(), restore, v1.CreateOptions{})
 	if err != nil {
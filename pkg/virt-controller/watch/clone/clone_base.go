@@ -3,6 +3,7 @@ package clone
 import (
 	"errors"
 	"fmt"
+	"net"
 	"time"
 
 	k8scorev1 "k8s.io/api/core/v1"
@@ -37,11 +38,15 @@ const (
 	TargetVMCreated       Event = "TargetVMCreated"
 	PVCBound              Event = "PVCBound"
 
+	RemoteExportImportStarted Event = "RemoteExportImportStarted"
+	RemoteExportImportFailed  Event = "RemoteExportImportFailed"
+
 	SnapshotDeleted                 Event = "SnapshotDeleted"
 	SnapshotContentInvalid          Event = "SnapshotContentInvalid"
 	SourceDoesNotExist              Event = "SourceDoesNotExist"
 	SourceWithBackendStorageInvalid Event = "SourceVMWithBackendStorageInvalid"
 	VMVolumeSnapshotsInvalid        Event = "VMVolumeSnapshotsInvalid"
+	RemoteExportManifestInvalid     Event = "RemoteExportManifestInvalid"
 )
 
 var (
@@ -63,6 +68,8 @@ type VMCloneController struct {
 	pvcStore             cache.Store
 	recorder             record.EventRecorder
 
+	remoteExportAddressFilter func(net.IP) error
+
 	vmCloneQueue workqueue.TypedRateLimitingInterface[string]
 	hasSynced    func() bool
 }
@@ -77,6 +84,9 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		snapshotContentStore: snapshotContentInformer.GetStore(),
 		pvcStore:             pvcInformer.GetStore(),
 		recorder:             recorder,
+
+		remoteExportAddressFilter: rejectNodeLocalAddress,
+
 		vmCloneQueue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
 			workqueue.DefaultTypedControllerRateLimiter[string](),
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmclone"},
@@ -21,7 +21,11 @@ package clone
 import (
 	"context"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -39,9 +43,11 @@ import (
 
 	clone "kubevirt.io/api/clone/v1beta1"
 	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	kvcontroller "kubevirt.io/kubevirt/pkg/controller"
@@ -67,10 +73,11 @@ var _ = Describe("Clone", func() {
 		recorder   *record.FakeRecorder
 		mockQueue  *testutils.MockWorkQueue[string]
 
-		client    *kubevirtfake.Clientset
-		k8sClient *k8sfake.Clientset
-		sourceVM  *virtv1.VirtualMachine
-		vmClone   *clone.VirtualMachineClone
+		virtClient *kubecli.MockKubevirtClient
+		client     *kubevirtfake.Clientset
+		k8sClient  *k8sfake.Clientset
+		sourceVM   *virtv1.VirtualMachine
+		vmClone    *clone.VirtualMachineClone
 	)
 
 	addVM := func(vm *virtv1.VirtualMachine) {
@@ -133,6 +140,7 @@ var _ = Describe("Clone", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(vmRestore).ToNot(BeNil())
 		Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal(testSnapshotName))
+		Expect(vmRestore.Spec.VirtualMachineSnapshotNamespace).To(BeEmpty())
 		Expect(vmRestore.OwnerReferences).To(HaveLen(1))
 		validateOwnerReference(vmRestore.OwnerReferences[0], vmClone)
 	}
@@ -217,7 +225,7 @@ var _ = Describe("Clone", func() {
 
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
-		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
 		controller, _ = NewVmCloneController(
 			virtClient,
 			cloneInformer,
@@ -793,6 +801,30 @@ var _ = Describe("Clone", func() {
 				expectRestoreExists()
 			})
 
+			It("when snapshot in another namespace is ready - should create restore of that namespace", func() {
+				const sourceNamespace = "production"
+				snapshot := createVirtualMachineSnapshot(sourceVM)
+				snapshot.Namespace = sourceNamespace
+				snapshot.Status.ReadyToUse = pointer.P(true)
+				snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+				snapshotContent.Namespace = sourceNamespace
+				setSnapshotSource(vmClone, snapshot.Name)
+				vmClone.Spec.SourceNamespace = sourceNamespace
+
+				addClone(vmClone)
+				Expect(controller.snapshotStore.Add(snapshot)).To(Succeed())
+				addSnapshotContent(snapshotContent)
+
+				sanityExecute()
+				expectEvent(SnapshotReady)
+				expectEvent(RestoreCreated)
+				expectCloneBeInPhase(clone.RestoreInProgress)
+				vmRestore, err := client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal(testSnapshotName))
+				Expect(vmRestore.Spec.VirtualMachineSnapshotNamespace).To(Equal(sourceNamespace))
+			})
+
 			It("when restore already exists and vmclone is not update yet - should update the clone phase", func() {
 				snapshot := createVirtualMachineSnapshot(sourceVM)
 				snapshot.Status.ReadyToUse = pointer.P(true)
@@ -816,6 +848,250 @@ var _ = Describe("Clone", func() {
 		})
 	})
 
+	Context("with source remote export", func() {
+		const (
+			exportToken          = "export-token"
+			tokenSecretName      = "remote-export-token"
+			testCAName           = "clone-export-ca-clone-uid"
+			testHeaderSecretName = "clone-export-token-clone-uid"
+		)
+
+		var (
+			server     *httptest.Server
+			coreClient *k8sfake.Clientset
+			manifests  []runtime.Object
+			requests   int
+		)
+
+		newExportedVM := func() *virtv1.VirtualMachine {
+			vm := libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(virtv1.DefaultPodNetwork()),
+				libvmi.WithDataVolume("disk0", "exported-dv"),
+				libvmi.WithPersistentVolumeClaim("disk1", "exported-pvc"),
+			), libvmi.WithDataVolumeTemplate(newExportedDataVolume("exported-dv")))
+			vm.Name = "exported-vm"
+			vm.TypeMeta = metav1.TypeMeta{
+				Kind:       virtv1.VirtualMachineGroupVersionKind.Kind,
+				APIVersion: virtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+			}
+			return vm
+		}
+
+		expectTargetVM := func() *virtv1.VirtualMachine {
+			targetVM, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return targetVM
+		}
+
+		BeforeEach(func() {
+			requests = 0
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests++
+				if req.Header.Get("x-kubevirt-export-token") != exportToken {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				list := k8sv1.List{TypeMeta: metav1.TypeMeta{Kind: "List", APIVersion: "v1"}}
+				for _, obj := range manifests {
+					list.Items = append(list.Items, runtime.RawExtension{Object: obj})
+				}
+				Expect(json.NewEncoder(w).Encode(list)).To(Succeed())
+			}))
+			DeferCleanup(server.Close)
+
+			manifests = []runtime.Object{
+				&k8sv1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: "export-ca-cm-remote-export"},
+				},
+				newExportedVM(),
+				newExportedDataVolume("exported-pvc"),
+			}
+
+			vmClone.Spec.Source = &k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.P("export.kubevirt.io"),
+				Kind:     "VirtualMachineExport",
+				Name:     "remote-export",
+			}
+			vmClone.Spec.RemoteExport = &clone.VirtualMachineCloneRemoteExportSource{
+				Link: exportv1.VirtualMachineExportLink{
+					Cert: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+					Manifests: []exportv1.VirtualMachineExportManifest{{
+						Type: exportv1.AllManifests,
+						Url:  server.URL + "/manifests/all",
+					}},
+				},
+				TokenSecretRef: tokenSecretName,
+			}
+
+			coreClient = k8sfake.NewSimpleClientset(&k8sv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: tokenSecretName, Namespace: metav1.NamespaceDefault},
+				Data:       map[string][]byte{"token": []byte(exportToken)},
+			})
+			virtClient.EXPECT().CoreV1().Return(coreClient.CoreV1()).AnyTimes()
+			virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+
+			// The test server listens on the loopback address
+			controller.remoteExportAddressFilter = func(net.IP) error { return nil }
+		})
+
+		It("should create the target VM importing the exported volumes", func() {
+			addClone(vmClone)
+
+			controller.Execute()
+			expectEvent(RemoteExportImportStarted)
+			expectCloneBeInPhase(clone.CreatingTargetVM)
+
+			targetVM := expectTargetVM()
+			Expect(targetVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+			Expect(targetVM.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("clone-clone-uid-disk0"))
+			Expect(targetVM.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("clone-clone-uid-disk1"))
+			Expect(targetVM.Spec.DataVolumeTemplates).To(HaveLen(2))
+			for i, name := range []string{"clone-clone-uid-disk0", "clone-clone-uid-disk1"} {
+				template := targetVM.Spec.DataVolumeTemplates[i]
+				Expect(template.Name).To(Equal(name))
+				Expect(template.Spec.Source.HTTP.CertConfigMap).To(Equal(testCAName))
+				Expect(template.Spec.Source.HTTP.SecretExtraHeaders).To(ConsistOf(testHeaderSecretName))
+			}
+
+			caConfigMap, err := coreClient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Get(context.TODO(), testCAName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caConfigMap.Data).To(HaveKeyWithValue("ca.pem", vmClone.Spec.RemoteExport.Link.Cert))
+			validateOwnerReference(caConfigMap.OwnerReferences[0], vmClone)
+
+			headerSecret, err := coreClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.TODO(), testHeaderSecretName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(headerSecret.Data).To(HaveKeyWithValue("token", []byte("x-kubevirt-export-token:"+exportToken)))
+			validateOwnerReference(headerSecret.OwnerReferences[0], vmClone)
+		})
+
+		It("should prefix the imported volumes with the target name", func() {
+			vmClone.Spec.VolumeNamePolicy = pointer.P(clone.VolumeNamePolicyPrefixTargetName)
+			addClone(vmClone)
+
+			controller.Execute()
+			expectCloneBeInPhase(clone.CreatingTargetVM)
+
+			targetVM := expectTargetVM()
+			Expect(targetVM.Spec.DataVolumeTemplates[0].Name).To(Equal("test-target-vm-disk0"))
+			Expect(targetVM.Spec.DataVolumeTemplates[1].Name).To(Equal("test-target-vm-disk1"))
+		})
+
+		It("should apply the clone filters and patches to the target VM", func() {
+			exportedVM := newExportedVM()
+			exportedVM.Labels = map[string]string{"keep": "true", "drop": "true"}
+			manifests[1] = exportedVM
+			vmClone.Spec.LabelFilters = []string{"keep"}
+			vmClone.Spec.NewMacAddresses = map[string]string{"default": "DE-AD-00-00-BE-AF"}
+			vmClone.Spec.Patches = []string{`{"op": "add", "path": "/metadata/labels/patched", "value": "true"}`}
+			addClone(vmClone)
+
+			controller.Execute()
+
+			targetVM := expectTargetVM()
+			Expect(targetVM.Labels).To(Equal(map[string]string{"keep": "true", "patched": "true"}))
+			Expect(targetVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("DE-AD-00-00-BE-AF"))
+		})
+
+		It("should keep retrying when the remote export rejects the token", func() {
+			_, err := coreClient.CoreV1().Secrets(metav1.NamespaceDefault).Update(context.TODO(), &k8sv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: tokenSecretName, Namespace: metav1.NamespaceDefault},
+				Data:       map[string][]byte{"token": []byte("wrong-token")},
+			}, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			addClone(vmClone)
+
+			controller.Execute()
+			expectEvent(RemoteExportImportFailed)
+			expectCloneBeInPhase(clone.ImportInProgress)
+			_, err = client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+			Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+		})
+
+		It("should keep retrying when the token secret can't be read", func() {
+			coreClient.Fake.PrependReactor("get", "secrets", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				return true, nil, errors.NewForbidden(k8sv1.Resource("secrets"), tokenSecretName, fmt.Errorf("forbidden"))
+			})
+			addClone(vmClone)
+
+			controller.Execute()
+			expectEvent(RemoteExportImportFailed)
+			expectCloneBeInPhase(clone.ImportInProgress)
+		})
+
+		It("should not fetch the manifests again once the target VM exists", func() {
+			targetVM := newExportedVM()
+			targetVM.Name = vmClone.Spec.Target.Name
+			targetVM.Namespace = metav1.NamespaceDefault
+			addVM(targetVM)
+			addClone(vmClone)
+
+			controller.Execute()
+			expectEvent(TargetVMCreated)
+			expectCloneBeInPhase(clone.Succeeded)
+			Expect(requests).To(BeZero())
+		})
+
+		It("should refuse to connect to a node local address", func() {
+			controller.remoteExportAddressFilter = rejectNodeLocalAddress
+			addClone(vmClone)
+
+			controller.Execute()
+			expectEvent(RemoteExportImportFailed)
+			expectCloneBeInPhase(clone.ImportInProgress)
+			Expect(requests).To(BeZero())
+		})
+
+		It("should refuse a manifest url which is not https", func() {
+			vmClone.Spec.RemoteExport.Link.Manifests[0].Url = strings.Replace(server.URL, "https://", "http://", 1) + "/manifests/all"
+			addClone(vmClone)
+
+			controller.Execute()
+			expectEvent(RemoteExportImportFailed)
+			expectCloneBeInPhase(clone.ImportInProgress)
+			Expect(requests).To(BeZero())
+		})
+
+		It("should refuse manifests exceeding the size limit", func() {
+			manifests[0] = &k8sv1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "export-ca-cm-remote-export"},
+				Data:       map[string]string{"big": strings.Repeat("x", remoteExportManifestsMaxSize)},
+			}
+			addClone(vmClone)
+
+			controller.Execute()
+			expectEvent(RemoteExportImportFailed)
+			expectCloneBeInPhase(clone.ImportInProgress)
+			_, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+			Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+		})
+
+		It("should fail the clone when the manifests have no VM", func() {
+			manifests = manifests[:1]
+			addClone(vmClone)
+
+			controller.Execute()
+			expectEvent(RemoteExportManifestInvalid)
+			expectCloneBeInPhase(clone.Failed)
+		})
+
+		It("should move to Succeeded once the target VM exists", func() {
+			targetVM := newExportedVM()
+			targetVM.Name = vmClone.Spec.Target.Name
+			targetVM.Namespace = metav1.NamespaceDefault
+			vmClone.Status.Phase = clone.CreatingTargetVM
+			vmClone.Status.TargetName = pointer.P(targetVM.Name)
+			addVM(targetVM)
+			addClone(vmClone)
+
+			sanityExecute()
+			expectEvent(TargetVMCreated)
+			expectCloneBeInPhase(clone.Succeeded)
+		})
+	})
+
 	Context("generation of target VM", func() {
 		BeforeEach(func() {
 			snapshot := createVirtualMachineSnapshot(sourceVM)
@@ -1217,6 +1493,22 @@ var _ = Describe("Clone", func() {
 	})
 })
 
+func newExportedDataVolume(name string) *cdiv1.DataVolume {
+	return &cdiv1.DataVolume{
+		TypeMeta:   metav1.TypeMeta{Kind: "DataVolume", APIVersion: "cdi.kubevirt.io/v1beta1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				HTTP: &cdiv1.DataVolumeSourceHTTP{
+					URL:                "https://remote.example.com/volumes/" + name + "/disk.img.gz",
+					CertConfigMap:      "export-ca-cm-remote-export",
+					SecretExtraHeaders: []string{"header-secret-remote-export"},
+				},
+			},
+		},
+	}
+}
+
 func createVirtualMachineSnapshot(vm *virtv1.VirtualMachine, owner ...metav1.OwnerReference) *snapshotv1.VirtualMachineSnapshot {
 	return &snapshotv1.VirtualMachineSnapshot{
 		ObjectMeta: metav1.ObjectMeta{
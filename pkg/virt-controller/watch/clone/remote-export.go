@@ -0,0 +1,441 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	clone "kubevirt.io/api/clone/v1beta1"
+	k6tv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
+)
+
+const (
+	remoteExportTokenKey       = "token"
+	remoteExportCAKey          = "ca.pem"
+	remoteExportTokenHeader    = "x-kubevirt-export-token"
+	remoteExportRequestTimeout = time.Minute
+	// remoteExportManifestsMaxSize bounds the memory a remote export can make virt-controller allocate
+	remoteExportManifestsMaxSize = 10 * 1024 * 1024
+	dataVolumeKind               = "DataVolume"
+)
+
+var ErrRemoteExportManifestInvalid = errors.New("remote export manifest is invalid")
+
+func (ctrl *VMCloneController) syncTargetVMFromRemoteExport(vmClone *clone.VirtualMachineClone) syncInfoType {
+	syncInfo := syncInfoType{isRemoteImport: true}
+
+	switch vmClone.Status.Phase {
+	case clone.PhaseUnset, clone.ImportInProgress:
+		syncInfo = ctrl.importFromRemoteExport(vmClone, syncInfo)
+		if syncInfo.isFailingOrError() {
+			return syncInfo
+		}
+
+		fallthrough
+
+	case clone.CreatingTargetVM:
+		syncInfo = ctrl.verifyVmReady(vmClone, syncInfo)
+
+	case clone.Succeeded:
+
+	default:
+		log.Log.Object(vmClone).Infof("clone %s is in phase %s - nothing to do", vmClone.Name, string(vmClone.Status.Phase))
+	}
+
+	return syncInfo
+}
+
+// importFromRemoteExport fetches the manifests of the remote VirtualMachineExport and creates the target VM.
+// The exported volumes are imported by CDI through the DataVolumeTemplates of the target VM.
+func (ctrl *VMCloneController) importFromRemoteExport(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	remoteExport := vmClone.Spec.RemoteExport
+
+	// The target VM holds everything taken from the manifests, once it exists they are not fetched again
+	_, exists, err := ctrl.vmStore.GetByKey(getKey(vmClone.Spec.Target.Name, vmClone.Namespace))
+	if err != nil {
+		return ctrl.remoteExportImportFailed(vmClone, err, syncInfo)
+	} else if exists {
+		syncInfo.vmImported = true
+		syncInfo.targetVMName = vmClone.Spec.Target.Name
+		return syncInfo
+	}
+
+	token, err := ctrl.getRemoteExportToken(vmClone.Namespace, remoteExport.TokenSecretRef)
+	if err != nil {
+		return ctrl.remoteExportImportFailed(vmClone, err, syncInfo)
+	}
+
+	manifests, err := fetchRemoteExportManifests(&remoteExport.Link, token, ctrl.remoteExportAddressFilter)
+	if err != nil {
+		return ctrl.remoteExportImportFailed(vmClone, err, syncInfo)
+	}
+
+	sourceVM, dataVolumes, err := parseRemoteExportManifests(manifests)
+	if err != nil {
+		syncInfo.isCloneFailing = true
+		syncInfo.event = RemoteExportManifestInvalid
+		syncInfo.reason = err.Error()
+		return syncInfo
+	}
+
+	targetVM, err := generateRemoteExportTarget(vmClone, sourceVM, dataVolumes)
+	if err != nil {
+		return ctrl.remoteExportImportFailed(vmClone, fmt.Errorf("error generating target VM: %v", err), syncInfo)
+	}
+
+	if err := ctrl.createRemoteExportImportResources(vmClone, remoteExport.Link.Cert, token); err != nil {
+		return ctrl.remoteExportImportFailed(vmClone, err, syncInfo)
+	}
+
+	log.Log.Object(vmClone).Infof("creating target VM %s for clone %s", targetVM.Name, vmClone.Name)
+	_, err = ctrl.client.VirtualMachine(vmClone.Namespace).Create(context.Background(), targetVM, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return ctrl.remoteExportImportFailed(vmClone, fmt.Errorf("failed creating target VM %s: %v", targetVM.Name, err), syncInfo)
+	} else if err == nil {
+		ctrl.logAndRecord(vmClone, RemoteExportImportStarted, fmt.Sprintf("started import of remote export into VM %s for clone %s", targetVM.Name, vmClone.Name))
+	}
+
+	syncInfo.vmImported = true
+	syncInfo.targetVMName = targetVM.Name
+
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) remoteExportImportFailed(vmClone *clone.VirtualMachineClone, err error, syncInfo syncInfoType) syncInfoType {
+	retErr := fmt.Errorf("failed importing remote export for clone %s: %v", vmClone.Name, err)
+	ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(RemoteExportImportFailed), retErr.Error())
+	syncInfo.setError(retErr)
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) getRemoteExportToken(namespace, secretName string) (string, error) {
+	// virt-controller can't read secrets cluster wide, the access to the token
+	// secret has to be granted in the namespace of the clone
+	secret, err := ctrl.client.CoreV1().Secrets(namespace).Get(context.Background(), secretName, metav1.GetOptions{})
+	if k8serrors.IsForbidden(err) {
+		return "", fmt.Errorf("get access to token secret %s is not granted to the kubevirt-controller service account: %v", secretName, err)
+	} else if err != nil {
+		return "", fmt.Errorf("cannot get token secret %s: %v", secretName, err)
+	}
+
+	token, exists := secret.Data[remoteExportTokenKey]
+	if !exists || len(token) == 0 {
+		return "", fmt.Errorf("token secret %s has no %s key", secretName, remoteExportTokenKey)
+	}
+
+	return string(token), nil
+}
+
+// createRemoteExportImportResources creates the CA configmap and the header secret
+// CDI needs to import the exported volumes
+func (ctrl *VMCloneController) createRemoteExportImportResources(vmClone *clone.VirtualMachineClone, cert, token string) error {
+	ownerRefs := []metav1.OwnerReference{getCloneOwnerReference(vmClone.Name, vmClone.UID)}
+
+	caConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            generateRemoteExportCAName(vmClone.UID),
+			Namespace:       vmClone.Namespace,
+			OwnerReferences: ownerRefs,
+		},
+		Data: map[string]string{
+			remoteExportCAKey: cert,
+		},
+	}
+	_, err := ctrl.client.CoreV1().ConfigMaps(vmClone.Namespace).Create(context.Background(), caConfigMap, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed creating configmap %s: %v", caConfigMap.Name, err)
+	}
+
+	headerSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            generateRemoteExportHeaderSecretName(vmClone.UID),
+			Namespace:       vmClone.Namespace,
+			OwnerReferences: ownerRefs,
+		},
+		Data: map[string][]byte{
+			remoteExportTokenKey: []byte(fmt.Sprintf("%s:%s", remoteExportTokenHeader, token)),
+		},
+	}
+	_, err = ctrl.client.CoreV1().Secrets(vmClone.Namespace).Create(context.Background(), headerSecret, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed creating secret %s: %v", headerSecret.Name, err)
+	}
+
+	return nil
+}
+
+// rejectNodeLocalAddress keeps the remote export from pointing virt-controller to endpoints
+// only reachable from its node, like the cloud metadata service
+func rejectNodeLocalAddress(ip net.IP) error {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("remote export address %s is not allowed", ip)
+	}
+	return nil
+}
+
+func validateRemoteExportURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("remote export url is invalid: %v", err)
+	}
+	if u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return fmt.Errorf("remote export url %s must be an https url with a host and without credentials", u.Redacted())
+	}
+	return nil
+}
+
+// fetchRemoteExportManifests fetches the manifests of the remote export. The address is checked once
+// resolved, so that a name can't resolve to a node local address, and redirects are not followed
+func fetchRemoteExportManifests(link *exportv1.VirtualMachineExportLink, token string, addressFilter func(net.IP) error) ([]byte, error) {
+	manifestURL := ""
+	for _, manifest := range link.Manifests {
+		if manifest.Type == exportv1.AllManifests {
+			manifestURL = manifest.Url
+			break
+		}
+	}
+	if manifestURL == "" {
+		return nil, fmt.Errorf("remote export does not provide the %s manifest", exportv1.AllManifests)
+	}
+	if err := validateRemoteExportURL(manifestURL); err != nil {
+		return nil, err
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(link.Cert)) {
+		return nil, fmt.Errorf("remote export certificate is invalid")
+	}
+	dialer := &net.Dialer{
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("remote export address %s is not an IP", host)
+			}
+			return addressFilter(ip)
+		},
+	}
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext:     dialer.DialContext,
+			TLSClientConfig: &tls.Config{RootCAs: roots},
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Timeout: remoteExportRequestTimeout,
+	}
+
+	req, err := http.NewRequest(http.MethodGet, manifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", runtime.ContentTypeJSON)
+	req.Header.Set(remoteExportTokenHeader, token)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch manifests from %s: %v", manifestURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot fetch manifests from %s: %s", manifestURL, resp.Status)
+	}
+
+	manifests, err := io.ReadAll(io.LimitReader(resp.Body, remoteExportManifestsMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read manifests from %s: %v", manifestURL, err)
+	}
+	if len(manifests) > remoteExportManifestsMaxSize {
+		return nil, fmt.Errorf("manifests from %s exceed %d bytes", manifestURL, remoteExportManifestsMaxSize)
+	}
+	return manifests, nil
+}
+
+func parseRemoteExportManifests(manifests []byte) (*k6tv1.VirtualMachine, []*cdiv1.DataVolume, error) {
+	list := &corev1.List{}
+	if err := json.Unmarshal(manifests, list); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrRemoteExportManifestInvalid, err)
+	}
+
+	var (
+		vm          *k6tv1.VirtualMachine
+		dataVolumes []*cdiv1.DataVolume
+	)
+	for _, item := range list.Items {
+		typeMeta := metav1.TypeMeta{}
+		if err := json.Unmarshal(item.Raw, &typeMeta); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrRemoteExportManifestInvalid, err)
+		}
+
+		switch typeMeta.Kind {
+		case k6tv1.VirtualMachineGroupVersionKind.Kind:
+			vm = &k6tv1.VirtualMachine{}
+			if err := json.Unmarshal(item.Raw, vm); err != nil {
+				return nil, nil, fmt.Errorf("%w: %v", ErrRemoteExportManifestInvalid, err)
+			}
+		case dataVolumeKind:
+			dv := &cdiv1.DataVolume{}
+			if err := json.Unmarshal(item.Raw, dv); err != nil {
+				return nil, nil, fmt.Errorf("%w: %v", ErrRemoteExportManifestInvalid, err)
+			}
+			dataVolumes = append(dataVolumes, dv)
+		}
+	}
+
+	if vm == nil || vm.Spec.Template == nil {
+		return nil, nil, fmt.Errorf("%w: no VirtualMachine found", ErrRemoteExportManifestInvalid)
+	}
+
+	return vm, dataVolumes, nil
+}
+
+// generateRemoteExportTarget turns the exported VM and DataVolumes into the target VM.
+// Every exported volume becomes a DataVolumeTemplate, named according to the VolumeNamePolicy,
+// importing from the remote export with the CA configmap and header secret of the clone.
+func generateRemoteExportTarget(vmClone *clone.VirtualMachineClone, sourceVM *k6tv1.VirtualMachine, dataVolumes []*cdiv1.DataVolume) (*k6tv1.VirtualMachine, error) {
+	caName := generateRemoteExportCAName(vmClone.UID)
+	headerSecretName := generateRemoteExportHeaderSecretName(vmClone.UID)
+
+	targetVM := &k6tv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        sourceVM.Name,
+			Namespace:   vmClone.Namespace,
+			Labels:      sourceVM.Labels,
+			Annotations: sourceVM.Annotations,
+		},
+		Spec: *sourceVM.Spec.DeepCopy(),
+	}
+
+	volumeNames := map[string]string{}
+	for _, volume := range targetVM.Spec.Template.Spec.Volumes {
+		if volume.DataVolume != nil {
+			volumeNames[volume.DataVolume.Name] = volume.Name
+		} else if volume.PersistentVolumeClaim != nil {
+			volumeNames[volume.PersistentVolumeClaim.ClaimName] = volume.Name
+		}
+	}
+
+	newNames := map[string]string{}
+	newName := func(name string) string {
+		volumeName, exists := volumeNames[name]
+		if !exists {
+			volumeName = name
+		}
+		newNames[name] = remoteExportVolumeName(vmClone, volumeName)
+		return newNames[name]
+	}
+
+	for i := range targetVM.Spec.DataVolumeTemplates {
+		template := &targetVM.Spec.DataVolumeTemplates[i]
+		template.Name = newName(template.Name)
+		setRemoteExportSource(template.Spec.Source, caName, headerSecretName)
+	}
+	for _, dv := range dataVolumes {
+		if _, exists := newNames[dv.Name]; exists {
+			continue
+		}
+		if _, isUsed := volumeNames[dv.Name]; !isUsed {
+			continue
+		}
+		template := k6tv1.DataVolumeTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        newName(dv.Name),
+				Labels:      dv.Labels,
+				Annotations: dv.Annotations,
+			},
+			Spec: *dv.Spec.DeepCopy(),
+		}
+		setRemoteExportSource(template.Spec.Source, caName, headerSecretName)
+		targetVM.Spec.DataVolumeTemplates = append(targetVM.Spec.DataVolumeTemplates, template)
+	}
+
+	for i := range targetVM.Spec.Template.Spec.Volumes {
+		volume := &targetVM.Spec.Template.Spec.Volumes[i]
+		if volume.DataVolume != nil {
+			if name, exists := newNames[volume.DataVolume.Name]; exists {
+				volume.DataVolume.Name = name
+			}
+		} else if volume.PersistentVolumeClaim != nil {
+			if name, exists := newNames[volume.PersistentVolumeClaim.ClaimName]; exists {
+				volume.PersistentVolumeClaim.ClaimName = name
+			}
+		}
+	}
+
+	patches, err := generatePatches(targetVM, &vmClone.Spec)
+	if err != nil {
+		return nil, err
+	}
+	targetVM, err = virtsnapshot.PatchVM(targetVM, patches)
+	if err != nil {
+		return nil, err
+	}
+
+	targetVM.Name = vmClone.Spec.Target.Name
+	targetVM.Namespace = vmClone.Namespace
+
+	return targetVM, nil
+}
+
+func remoteExportVolumeName(vmClone *clone.VirtualMachineClone, volumeName string) string {
+	if vmClone.Spec.VolumeNamePolicy != nil && *vmClone.Spec.VolumeNamePolicy == clone.VolumeNamePolicyPrefixTargetName {
+		return naming.GetName(vmClone.Spec.Target.Name, volumeName, validation.DNS1035LabelMaxLength)
+	}
+
+	return fmt.Sprintf("clone-%s-%s", vmClone.UID, volumeName)
+}
+
+func setRemoteExportSource(source *cdiv1.DataVolumeSource, caName, headerSecretName string) {
+	if source == nil || source.HTTP == nil {
+		return
+	}
+
+	source.HTTP.CertConfigMap = caName
+	source.HTTP.SecretExtraHeaders = []string{headerSecretName}
+}
@@ -64,10 +64,27 @@ func generateRestoreName(vmCloneUID types.UID) string {
 	return fmt.Sprintf("tmp-restore-%s", string(vmCloneUID))
 }
 
+func generateRemoteExportCAName(vmCloneUID types.UID) string {
+	return fmt.Sprintf("clone-export-ca-%s", string(vmCloneUID))
+}
+
+func generateRemoteExportHeaderSecretName(vmCloneUID types.UID) string {
+	return fmt.Sprintf("clone-export-token-%s", string(vmCloneUID))
+}
+
 func generateVMName(oldVMName string) string {
 	return generateNameWithRandomSuffix(oldVMName, "clone")
 }
 
+// getSourceNamespace returns the namespace of the clone source, which only differs
+// from the namespace of the clone for VirtualMachineSnapshot sources
+func getSourceNamespace(vmClone *clone.VirtualMachineClone) string {
+	if vmClone.Spec.SourceNamespace != "" {
+		return vmClone.Spec.SourceNamespace
+	}
+	return vmClone.Namespace
+}
+
 func isInPhase(vmClone *clone.VirtualMachineClone, phase clone.VirtualMachineClonePhase) bool {
 	return vmClone.Status.Phase == phase
 }
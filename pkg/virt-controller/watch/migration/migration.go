@@ -36,6 +36,7 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -1243,6 +1244,7 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 	if !c.isMigrationPolicyMatched(vmiCopy) {
 		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
 	}
+	setStorageMigrationBandwidth(vmiCopy)
 
 	if controller.VMIHasHotplugCPU(vmi) && vmi.IsCPUDedicated() {
 		cpuLimitsCount, err := getTargetPodLimitsCount(pod)
@@ -1402,15 +1404,19 @@ func (c *Controller) handleBackendStorage(migration *virtv1.VirtualMachineInstan
 		}
 	}
 
+	bs := backendstorage.NewBackendStorage(c.clientset, c.clusterConfig, c.storageClassStore, c.storageProfileStore, c.pvcStore)
 	pvc := backendstorage.PVCForMigrationTarget(c.pvcStore, migration)
 	if pvc != nil {
 		migration.Status.MigrationState.TargetPersistentStatePVCName = pvc.Name
+		// The target virt-launcher needs the key of an encrypted PVC
+		if err := bs.EnsureEncryptionKey(vmi, pvc); err != nil {
+			return err
+		}
 	}
 	if migration.Status.MigrationState.TargetPersistentStatePVCName != "" {
 		// backend storage pvc has already been created or has ReadWriteMany access-mode
 		return nil
 	}
-	bs := backendstorage.NewBackendStorage(c.clientset, c.clusterConfig, c.storageClassStore, c.storageProfileStore, c.pvcStore)
 	key := controller.MigrationKey(migration)
 	c.pvcExpectations.ExpectCreations(key, 1)
 	backendStoragePVC, err := bs.CreatePVCForMigrationTarget(vmi, migration.Name)
@@ -1424,7 +1430,7 @@ func (c *Controller) handleBackendStorage(migration *virtv1.VirtualMachineInstan
 		c.pvcExpectations.CreationObserved(key)
 	}
 
-	return nil
+	return bs.EnsureEncryptionKey(vmi, backendStoragePVC)
 }
 
 func (c *Controller) createAttachmentPod(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, virtLauncherPod *k8sv1.Pod) error {
@@ -2514,6 +2520,29 @@ func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, cl
 	return nil
 }
 
+// setStorageMigrationBandwidth applies the bandwidth requested for a storage migration through the VMI annotation.
+// The annotation can only lower the bandwidth configured by the cluster or a MigrationPolicy, never raise it
+func setStorageMigrationBandwidth(vmi *virtv1.VirtualMachineInstance) {
+	bandwidth, ok := vmi.Annotations[virtv1.StorageMigrationBandwidthAnnotation]
+	if !ok || len(vmi.Status.MigratedVolumes) == 0 || vmi.Status.MigrationState.MigrationConfiguration == nil {
+		return
+	}
+	quantity, err := resource.ParseQuantity(bandwidth)
+	if err == nil && quantity.Sign() <= 0 {
+		err = errors.New("bandwidth must be positive")
+	}
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warningf("ignoring invalid %s annotation", virtv1.StorageMigrationBandwidthAnnotation)
+		return
+	}
+	// A nil or zero configured bandwidth means unlimited
+	configured := vmi.Status.MigrationState.MigrationConfiguration.BandwidthPerMigration
+	if configured != nil && !configured.IsZero() && configured.Cmp(quantity) <= 0 {
+		return
+	}
+	vmi.Status.MigrationState.MigrationConfiguration.BandwidthPerMigration = &quantity
+}
+
 func (c *Controller) isMigrationPolicyMatched(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi == nil {
 		return false
@@ -2219,6 +2219,39 @@ var _ = Describe("Migration watcher", func() {
 				false,
 			),
 		)
+
+		DescribeTable("should clamp the migration policy bandwidth with the storage migration bandwidth annotation", func(annotation, expected string) {
+			vmi = newVirtualMachine("testvmi", v1.Running)
+			vmi.Annotations = map[string]string{v1.StorageMigrationBandwidthAnnotation: annotation}
+			vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{{VolumeName: "disk0"}}
+			migration := newMigration("testmigration", vmi.Name, v1.MigrationScheduled)
+
+			targetPod = newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			targetPod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name: "compute", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+			}}
+
+			migrationPolicy := generatePolicyAndAlignVMI(vmi)
+			migrationPolicy.Spec.BandwidthPerMigration = &stubResourceQuantity
+
+			addMigrationPolicies(*migrationPolicy)
+			addMigration(migration)
+			addPod(targetPod)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.MigrationState.MigrationConfiguration.BandwidthPerMigration.String()).To(Equal(expected))
+		},
+			Entry("lowering it", "16Mi", "16Mi"),
+			Entry("without raising it", "32Mi", "25Mi"),
+			Entry("ignoring a zero bandwidth", "0", "25Mi"),
+		)
 	})
 
 	Context("Migration of host-model VMI", func() {
@@ -70,6 +70,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	storagehotplug "kubevirt.io/kubevirt/pkg/storage/hotplug"
 	"kubevirt.io/kubevirt/pkg/storage/memorydump"
+	persistentcontainerdisk "kubevirt.io/kubevirt/pkg/storage/persistent-containerdisk"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
@@ -125,6 +126,8 @@ const (
 	volumesUpdateErrorReason           = "VolumesUpdateError"
 	tolerationsChangeErrorReason       = "TolerationsChangeError"
 	annotationsLabelsChangeErrorReason = "AnnotationsLabelsChangeError"
+	diskIOTuneChangeErrorReason        = "DiskIOTuneChangeError"
+	ifaceBandwidthChangeErrorReason    = "InterfaceBandwidthChangeError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -770,6 +773,130 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+func (c *Controller) vmiDiskIOTunePatch(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	patchset := patch.New()
+	vmDisks := storagetypes.GetDisksByName(&vm.Spec.Template.Spec)
+	for i, vmiDisk := range vmi.Spec.Domain.Devices.Disks {
+		vmDisk, ok := vmDisks[vmiDisk.Name]
+		if !ok || equality.Semantic.DeepEqual(vmDisk.IOTune, vmiDisk.IOTune) {
+			continue
+		}
+		path := fmt.Sprintf("/spec/domain/devices/disks/%d/ioTune", i)
+		switch {
+		case vmDisk.IOTune == nil:
+			patchset.AddOption(patch.WithTest(path, vmiDisk.IOTune), patch.WithRemove(path))
+		case vmiDisk.IOTune == nil:
+			patchset.AddOption(patch.WithAdd(path, vmDisk.IOTune))
+		default:
+			patchset.AddOption(patch.WithTest(path, vmiDisk.IOTune), patch.WithReplace(path, vmDisk.IOTune))
+		}
+	}
+
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{})
+	return err
+}
+
+func (c *Controller) handleDiskIOTuneChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	if !hasDiskIOTuneChanged(vmCopyWithInstancetype, vmi) {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("disk I/O limits should not be changed during VMI migration")
+	}
+
+	if err := c.vmiDiskIOTunePatch(vmCopyWithInstancetype, vmi); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update disk I/O limits: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func hasDiskIOTuneChanged(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	vmDisks := storagetypes.GetDisksByName(&vm.Spec.Template.Spec)
+	for _, vmiDisk := range vmi.Spec.Domain.Devices.Disks {
+		if vmDisk, ok := vmDisks[vmiDisk.Name]; ok && !equality.Semantic.DeepEqual(vmDisk.IOTune, vmiDisk.IOTune) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) vmiInterfaceBandwidthPatch(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	patchset := patch.New()
+	vmIfaces := netvmispec.IndexInterfaceSpecByName(vm.Spec.Template.Spec.Domain.Devices.Interfaces)
+	for i, vmiIface := range vmi.Spec.Domain.Devices.Interfaces {
+		vmIface, ok := vmIfaces[vmiIface.Name]
+		if !ok || equality.Semantic.DeepEqual(vmIface.Bandwidth, vmiIface.Bandwidth) {
+			continue
+		}
+		path := fmt.Sprintf("/spec/domain/devices/interfaces/%d/bandwidth", i)
+		switch {
+		case vmIface.Bandwidth == nil:
+			patchset.AddOption(patch.WithTest(path, vmiIface.Bandwidth), patch.WithRemove(path))
+		case vmiIface.Bandwidth == nil:
+			patchset.AddOption(patch.WithAdd(path, vmIface.Bandwidth))
+		default:
+			patchset.AddOption(patch.WithTest(path, vmiIface.Bandwidth), patch.WithReplace(path, vmIface.Bandwidth))
+		}
+	}
+
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{})
+	return err
+}
+
+func (c *Controller) handleInterfaceBandwidthChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	if !hasInterfaceBandwidthChanged(vmCopyWithInstancetype, vmi) {
+		return nil
+	}
+
+	if err := c.vmiInterfaceBandwidthPatch(vmCopyWithInstancetype, vmi); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update interface bandwidth limits: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func hasInterfaceBandwidthChanged(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	vmIfaces := netvmispec.IndexInterfaceSpecByName(vm.Spec.Template.Spec.Domain.Devices.Interfaces)
+	for _, vmiIface := range vmi.Spec.Domain.Devices.Interfaces {
+		if vmIface, ok := vmIfaces[vmiIface.Name]; ok && !equality.Semantic.DeepEqual(vmIface.Bandwidth, vmiIface.Bandwidth) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -1268,6 +1395,19 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 		return vm, nil
 	}
 
+	if persistentcontainerdisk.IsCommitInProgress(vm) {
+		log.Log.Object(vm).V(4).Info("Waiting for the containerDisk commit to finish, delaying start")
+		return vm, nil
+	}
+
+	if err := persistentcontainerdisk.CheckOverlayImages(vm, c.pvcStore); err != nil {
+		return vm, err
+	}
+
+	if err := persistentcontainerdisk.CreateOverlayPVCs(c.clientset, vm, c.pvcStore); err != nil {
+		return vm, err
+	}
+
 	// TODO add check for existence
 	vmKey, err := controller.KeyFunc(vm)
 	if err != nil {
@@ -2857,6 +2997,7 @@ func (c *Controller) trimDoneVolumeRequests(vm *virtv1.VirtualMachine) {
 
 	volumeMap := make(map[string]virtv1.Volume)
 	diskMap := make(map[string]virtv1.Disk)
+	filesystemMap := make(map[string]virtv1.Filesystem)
 
 	for _, volume := range vm.Spec.Template.Spec.Volumes {
 		volumeMap[volume.Name] = volume
@@ -2864,6 +3005,9 @@ func (c *Controller) trimDoneVolumeRequests(vm *virtv1.VirtualMachine) {
 	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
 		diskMap[disk.Name] = disk
 	}
+	for _, filesystem := range vm.Spec.Template.Spec.Domain.Devices.Filesystems {
+		filesystemMap[filesystem.Name] = filesystem
+	}
 
 	tmpVolRequests := vm.Status.VolumeRequests[:0]
 	for _, request := range vm.Status.VolumeRequests {
@@ -2883,10 +3027,11 @@ func (c *Controller) trimDoneVolumeRequests(vm *virtv1.VirtualMachine) {
 
 		_, volExists := volumeMap[volName]
 		_, diskExists := diskMap[volName]
+		_, filesystemExists := filesystemMap[volName]
 
-		if added && volExists && diskExists {
+		if added && volExists && (diskExists || filesystemExists) {
 			removeRequest = true
-		} else if !added && !volExists && !diskExists {
+		} else if !added && !volExists && !diskExists && !filesystemExists {
 			removeRequest = true
 		}
 
@@ -2923,8 +3068,9 @@ func validLiveUpdateVolumes(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.Vir
 		}
 	}
 	// Evaluate if any volumes were removed and they were hotplugged volumes
+	// or the saved state volume the VMI was started from
 	for _, v := range oldVols {
-		if !storagetypes.IsHotplugVolume(v) {
+		if !storagetypes.IsHotplugVolume(v) && !storagetypes.IsSavedStateVolume(v) {
 			return false
 		}
 	}
@@ -2973,6 +3119,43 @@ func validLiveUpdateDisks(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.Virtu
 	return true
 }
 
+func validLiveUpdateFilesystems(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	hotplugSupported := false
+	if vmi != nil {
+		_, hotplugSupported = vmi.Annotations[virtv1.HotplugFilesystemsAnnotation]
+	}
+	oldFilesystems := make(map[string]virtv1.Filesystem)
+	for _, filesystem := range oldVMSpec.Template.Spec.Domain.Devices.Filesystems {
+		oldFilesystems[filesystem.Name] = filesystem
+	}
+	oldVols := storagetypes.GetVolumesByName(&oldVMSpec.Template.Spec)
+	vols := storagetypes.GetVolumesByName(&vm.Spec.Template.Spec)
+
+	// Evaluate if any filesystem has changed or has been added
+	for _, newFilesystem := range vm.Spec.Template.Spec.Domain.Devices.Filesystems {
+		newVolume, okNewVolume := vols[newFilesystem.Name]
+		oldFilesystem, okOldFilesystem := oldFilesystems[newFilesystem.Name]
+		switch {
+		case okOldFilesystem && equality.Semantic.DeepEqual(oldFilesystem, newFilesystem):
+			delete(oldFilesystems, newFilesystem.Name)
+		// Filesystems associated to a hotpluggable volume can only be plugged if the VMI
+		// was started with shared memory
+		case okNewVolume && storagetypes.IsHotplugVolume(newVolume) && hotplugSupported:
+			delete(oldFilesystems, newFilesystem.Name)
+		default:
+			return false
+		}
+	}
+	// Evaluate if any filesystems were removed and they were hotplugged volumes
+	for _, oldFilesystem := range oldFilesystems {
+		if v, ok := oldVols[oldFilesystem.Name]; ok && !storagetypes.IsHotplugVolume(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func setRestartRequired(vm *virtv1.VirtualMachine, message string) {
 	vmConditions := controller.NewVirtualMachineConditionManager()
 	vmConditions.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
@@ -3009,6 +3192,9 @@ func (c *Controller) syncRestartRequired(lastSeenVMSpec *virtv1.VirtualMachineSp
 	if validLiveUpdateDisks(&lastSeenVM.Spec, currentVM) {
 		lastSeenVM.Spec.Template.Spec.Domain.Devices.Disks = currentVM.Spec.Template.Spec.Domain.Devices.Disks
 	}
+	if validLiveUpdateFilesystems(&lastSeenVM.Spec, currentVM, vmi) {
+		lastSeenVM.Spec.Template.Spec.Domain.Devices.Filesystems = currentVM.Spec.Template.Spec.Domain.Devices.Filesystems
+	}
 
 	// Ignore all the live-updatable fields by copying them over. (If the feature gate is disabled, nothing is live-updatable)
 	// Note: this list needs to stay up-to-date with everything that can be live-updated
@@ -3028,6 +3214,20 @@ func (c *Controller) syncRestartRequired(lastSeenVMSpec *virtv1.VirtualMachineSp
 		lastSeenVM.Spec.Template.Spec.NodeSelector = currentVM.Spec.Template.Spec.NodeSelector
 		lastSeenVM.Spec.Template.Spec.Affinity = currentVM.Spec.Template.Spec.Affinity
 		lastSeenVM.Spec.Template.Spec.Tolerations = currentVM.Spec.Template.Spec.Tolerations
+
+		currentDisks := storagetypes.GetDisksByName(&currentVM.Spec.Template.Spec)
+		for i, disk := range lastSeenVM.Spec.Template.Spec.Domain.Devices.Disks {
+			if currentDisk, ok := currentDisks[disk.Name]; ok {
+				lastSeenVM.Spec.Template.Spec.Domain.Devices.Disks[i].IOTune = currentDisk.IOTune
+			}
+		}
+
+		currentIfaces := netvmispec.IndexInterfaceSpecByName(currentVM.Spec.Template.Spec.Domain.Devices.Interfaces)
+		for i, iface := range lastSeenVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+			if currentIface, ok := currentIfaces[iface.Name]; ok {
+				lastSeenVM.Spec.Template.Spec.Domain.Devices.Interfaces[i].Bandwidth = currentIface.Bandwidth
+			}
+		}
 	}
 
 	if !netvmliveupdate.IsRestartRequired(currentVM, vmi) {
@@ -3262,6 +3462,7 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	if err := memorydump.HandleRequest(c.clientset, vmCopy, vmi, c.pvcStore); err != nil {
 		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling memory dump request: %v", err), memorydump.ErrorReason), nil
 	}
+	memorydump.HandleSavedState(vmCopy, vmi)
 
 	if vmi, err = c.syncDynamicAnnotationsAndLabelsToVMI(vmCopy, vmi); err != nil {
 		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling annotation and labels sync request: %v", err), annotationsLabelsChangeErrorReason), nil
@@ -3285,6 +3486,14 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling memory hotplug requests: %v", err), hotplugMemoryErrorReason), nil
 		}
 
+		if err := c.handleDiskIOTuneChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling disk I/O limits change request: %v", err), diskIOTuneChangeErrorReason), nil
+		}
+
+		if err := c.handleInterfaceBandwidthChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling interface bandwidth limits change request: %v", err), ifaceBandwidthChangeErrorReason), nil
+		}
+
 		if isWaitAsReceiverRunStrategy(vm) {
 			if err := c.handleWaitAsReceiverVolumeInfo(vmCopy, vmi); err != nil {
 				return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling wait as receiver volume migration requests: %v", err), volumesUpdateErrorReason), nil
@@ -46,6 +46,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libdv"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	persistentcontainerdisk "kubevirt.io/kubevirt/pkg/storage/persistent-containerdisk"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -2535,6 +2536,78 @@ var _ = Describe("VirtualMachine", func() {
 			Entry("with run strategy RerunOnFailure", v1.RunStrategyRerunOnFailure),
 		)
 
+		Context("with a persistent containerDisk", func() {
+			newPersistentContainerDiskVM := func() *v1.VirtualMachine {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
+					Name: "disk0",
+					VolumeSource: v1.VolumeSource{
+						ContainerDisk: &v1.ContainerDiskSource{
+							Image: "registry:5000/disk",
+							Persistent: &v1.PersistentContainerDisk{
+								Capacity: resource.MustParse("1Gi"),
+							},
+						},
+					},
+				})
+				return vm
+			}
+
+			It("should create the overlay PVC before creating the VMI", func() {
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.TODO(), newPersistentContainerDiskVM(), metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(vm.Namespace).Get(context.TODO(), vm.Name+"-disk0-overlay", metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pvc.OwnerReferences[0].UID).To(Equal(vm.UID))
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should not start the VM when the image changed since the overlay was created", func() {
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.TODO(), newPersistentContainerDiskVM(), metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+				Expect(controller.pvcStore.Add(&k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        vm.Name + "-disk0-overlay",
+						Namespace:   vm.Namespace,
+						Annotations: map[string]string{persistentcontainerdisk.ImageAnnotation: "registry:5000/old"},
+					},
+				})).To(Succeed())
+
+				sanityExecute(vm)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineFailure)
+				Expect(cond).To(Not(BeNil()))
+				Expect(cond.Message).To(ContainSubstring("was created on top of image registry:5000/old"))
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should not start the VM while the containerDisk is committed", func() {
+				vm := newPersistentContainerDiskVM()
+				vm.Status.ContainerDiskCommitRequest = &v1.VirtualMachineContainerDiskCommitRequest{
+					VolumeName: "disk0",
+					ClaimName:  "target",
+					Phase:      v1.ContainerDiskCommitInProgress,
+				}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
 		It("should ignore the name of a VirtualMachineInstance templates", func() {
 			vm, _ := watchtesting.DefaultVirtualMachineWithNames(true, "vmname", "vminame")
 
@@ -5924,6 +5997,87 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("Disk IOTune", func() {
+				DescribeTable("should be live-updated", func(existingIOTune, updatedIOTune *v1.DiskIOTune) {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+
+					vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0", IOTune: updatedIOTune}}
+					vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0", IOTune: existingIOTune}}
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					By("Expecting to see the updated VMI with the new disk I/O limits")
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.Disks[0].IOTune).To(Equal(updatedIOTune))
+				},
+					Entry("when adding limits", nil, &v1.DiskIOTune{TotalIOPSSec: pointer.P(int64(100))}),
+					Entry("when changing limits",
+						&v1.DiskIOTune{TotalIOPSSec: pointer.P(int64(100))}, &v1.DiskIOTune{TotalIOPSSec: pointer.P(int64(200))}),
+					Entry("when removing limits", &v1.DiskIOTune{TotalIOPSSec: pointer.P(int64(100))}, nil),
+				)
+			})
+
+			Context("Interface bandwidth", func() {
+				DescribeTable("should be live-updated", func(existingBandwidth, updatedBandwidth *v1.InterfaceBandwidth) {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", Bandwidth: updatedBandwidth}}
+					vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", Bandwidth: existingBandwidth}}
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					By("Expecting to see the updated VMI with the new interface bandwidth limits")
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth).To(Equal(updatedBandwidth))
+				},
+					Entry("when adding limits", nil, &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 100}}),
+					Entry("when changing limits",
+						&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 100}},
+						&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 200}}),
+					Entry("when removing limits", &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 100}}, nil),
+				)
+			})
+
 			Context("Affinity", func() {
 				It("should be live-updated", func() {
 					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
@@ -6314,6 +6468,56 @@ var _ = Describe("VirtualMachine", func() {
 				Expect(vm.Status.Conditions).To(restartRequiredMatcher(k8sv1.ConditionTrue), "restart required")
 			})
 
+			It("should not appear when changing the I/O limits of a disk", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+
+				By("Creating a VMI with a disk limited to 100 IOPS")
+				vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{
+					Name:   "disk0",
+					IOTune: &v1.DiskIOTune{TotalIOPSSec: pointer.P(int64(100))},
+				}}
+				vmi = SetupVMIFromVM(vm)
+				controller.vmiIndexer.Add(vmi)
+				controller.crIndexer.Add(createVMRevision(vm))
+
+				By("Changing the limit to 200 IOPS")
+				vm.Spec.Template.Spec.Domain.Devices.Disks[0].IOTune.TotalIOPSSec = pointer.P(int64(200))
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				By("Executing the controller expecting no RestartRequired condition")
+				sanityExecute(vm)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Status.Conditions).ToNot(restartRequiredMatcher(k8sv1.ConditionTrue))
+			})
+
+			It("should not appear when changing the bandwidth limits of an interface", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+
+				By("Creating a VMI with an interface limited to 100 KiB/s")
+				iface := v1.DefaultMasqueradeNetworkInterface()
+				iface.Bandwidth = &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 100}}
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface}
+				vm.Spec.Template.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+				vmi = SetupVMIFromVM(vm)
+				controller.vmiIndexer.Add(vmi)
+				controller.crIndexer.Add(createVMRevision(vm))
+
+				By("Changing the limit to 200 KiB/s")
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth.Inbound.Average = 200
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				By("Executing the controller expecting no RestartRequired condition")
+				sanityExecute(vm)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Status.Conditions).ToNot(restartRequiredMatcher(k8sv1.ConditionTrue))
+			})
+
 			It("should appear when VM doesn't specify maxSockets and sockets go above cluster-wide maxSockets", func() {
 				var maxSockets uint32 = 8
 
@@ -7193,8 +7397,62 @@ var _ = Describe("VirtualMachine", func() {
 			Entry("cd-rom inject", []v1.Volume{createPVCVol("vol1", "test1", false)}, []v1.Volume{createPVCVol("vol1", "test1", false), createPVCVol("vol2", "test2", true)},
 				[]v1.Disk{createDisk("vol1"), createCDRom("vol2")}, []v1.Disk{createDisk("vol1"), createCDRom("vol2")}, true),
 		)
+		DescribeTable("should be validated for filesystem updates", func(oldVols, newVols []v1.Volume, oldFilesystems, newFilesystems []string, hotplugSupported, expectValid bool) {
+			toFilesystems := func(names []string) []v1.Filesystem {
+				var filesystems []v1.Filesystem
+				for _, name := range names {
+					filesystems = append(filesystems, v1.Filesystem{Name: name, Virtiofs: &v1.FilesystemVirtiofs{}})
+				}
+				return filesystems
+			}
+			oldVm, _ := watchtesting.DefaultVirtualMachine(true)
+			newVm := oldVm.DeepCopy()
+			oldVm.Spec.Template.Spec.Volumes = oldVols
+			newVm.Spec.Template.Spec.Volumes = newVols
+			oldVm.Spec.Template.Spec.Domain.Devices.Filesystems = toFilesystems(oldFilesystems)
+			newVm.Spec.Template.Spec.Domain.Devices.Filesystems = toFilesystems(newFilesystems)
+			vmi := libvmi.New()
+			if hotplugSupported {
+				vmi.Annotations = map[string]string{v1.HotplugFilesystemsAnnotation: ""}
+			}
+			Expect(validLiveUpdateFilesystems(&oldVm.Spec, newVm, vmi)).To(Equal(expectValid))
+		},
+			Entry("without changes", []v1.Volume{createPVCVol("vol1", "test1", false)}, []v1.Volume{createPVCVol("vol1", "test1", false)},
+				[]string{"vol1"}, []string{"vol1"}, false, true),
+			Entry("for an added pvc", []v1.Volume{}, []v1.Volume{createPVCVol("vol1", "test1", false)},
+				nil, []string{"vol1"}, true, false),
+			Entry("for a removed pvc", []v1.Volume{createPVCVol("vol1", "test1", false)}, []v1.Volume{},
+				[]string{"vol1"}, nil, true, false),
+			Entry("for an added hotpluggable pvc", []v1.Volume{}, []v1.Volume{createPVCVol("vol1", "test1", true)},
+				nil, []string{"vol1"}, true, true),
+			Entry("for an added hotpluggable pvc without shared memory", []v1.Volume{}, []v1.Volume{createPVCVol("vol1", "test1", true)},
+				nil, []string{"vol1"}, false, false),
+			Entry("for a removed hotpluggable pvc", []v1.Volume{createPVCVol("vol1", "test1", true)}, []v1.Volume{},
+				[]string{"vol1"}, nil, true, true),
+		)
 	})
 
+	DescribeTable("should trim done volume requests of filesystems", func(request v1.VirtualMachineVolumeRequest, withFilesystem bool, expectedRequests int) {
+		vm, _ := watchtesting.DefaultVirtualMachine(true)
+		vm.Status.VolumeRequests = []v1.VirtualMachineVolumeRequest{request}
+		if withFilesystem {
+			vm.Spec.Template.Spec.Volumes = []v1.Volume{{Name: "fs1"}}
+			vm.Spec.Template.Spec.Domain.Devices.Filesystems = []v1.Filesystem{{Name: "fs1", Virtiofs: &v1.FilesystemVirtiofs{}}}
+		}
+
+		(&Controller{}).trimDoneVolumeRequests(vm)
+		Expect(vm.Status.VolumeRequests).To(HaveLen(expectedRequests))
+	},
+		Entry("when the filesystem was added",
+			v1.VirtualMachineVolumeRequest{AddVolumeOptions: &v1.AddVolumeOptions{Name: "fs1", Filesystem: &v1.Filesystem{}}}, true, 0),
+		Entry("not before the filesystem was added",
+			v1.VirtualMachineVolumeRequest{AddVolumeOptions: &v1.AddVolumeOptions{Name: "fs1", Filesystem: &v1.Filesystem{}}}, false, 1),
+		Entry("when the filesystem was removed",
+			v1.VirtualMachineVolumeRequest{RemoveVolumeOptions: &v1.RemoveVolumeOptions{Name: "fs1"}}, false, 0),
+		Entry("not before the filesystem was removed",
+			v1.VirtualMachineVolumeRequest{RemoveVolumeOptions: &v1.RemoveVolumeOptions{Name: "fs1"}}, true, 1),
+	)
+
 	Context("syncVolumeMigration", func() {
 		const (
 			volName = "disk0"
@@ -231,6 +231,9 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 			return common.NewSyncError(fmt.Errorf("failed to get attachment pods: %v", err), controller.FailedHotplugSyncReason), pod
 		}
 
+		hotplugVolumes, hotplugFilesystemVolumes := splitHotplugFilesystemVolumes(vmi, hotplugVolumes)
+		hotplugAttachmentPods, filesystemAttachmentPods := splitFilesystemAttachmentPods(hotplugAttachmentPods)
+
 		if pod.DeletionTimestamp == nil && needsHandleHotplug(hotplugVolumes, hotplugAttachmentPods) {
 			var hotplugSyncErr common.SyncError
 			hotplugSyncErr = c.handleHotplugVolumes(hotplugVolumes, hotplugAttachmentPods, vmi, pod, dataVolumes)
@@ -245,6 +248,12 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 				}
 			}
 		}
+
+		if pod.DeletionTimestamp == nil {
+			if syncErr := c.handleHotplugFilesystems(hotplugFilesystemVolumes, filesystemAttachmentPods, vmi, pod, dataVolumes); syncErr != nil {
+				return syncErr, pod
+			}
+		}
 	}
 	return nil, pod
 }
@@ -34,8 +34,10 @@ import (
 
 	"kubevirt.io/client-go/log"
 
+	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/controller"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	persistentcontainerdisk "kubevirt.io/kubevirt/pkg/storage/persistent-containerdisk"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 )
@@ -157,6 +159,9 @@ func (c *Controller) handleBackendStorage(vmi *virtv1.VirtualMachineInstance) (s
 			return "", common.NewSyncError(err, controller.FailedBackendStorageCreateReason)
 		}
 	}
+	if err = c.backendStorage.EnsureEncryptionKey(vmi, pvc); err != nil {
+		return "", common.NewSyncError(err, controller.FailedBackendStorageCreateReason)
+	}
 	return pvc.Name, nil
 }
 
@@ -258,10 +263,17 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 		return err
 	}
 
-	attachmentPod, _ := getActiveAndOldAttachmentPods(hotplugVolumes, attachmentPods)
+	diskHotplugVolumes, _ := splitHotplugFilesystemVolumes(vmi, hotplugVolumes)
+	diskAttachmentPods, filesystemAttachmentPods := splitFilesystemAttachmentPods(attachmentPods)
+	attachmentPod, _ := getActiveAndOldAttachmentPods(diskHotplugVolumes, diskAttachmentPods)
 
 	newStatus := make([]virtv1.VolumeStatus, 0)
 
+	containerDiskImageIDs := c.containerDiskImageIDs(vmi, virtlauncherPod)
+	if err := persistentcontainerdisk.RecordOverlayImageIDs(c.clientset, vmi, c.pvcIndexer, containerDiskImageIDs); err != nil {
+		return err
+	}
+
 	backendStoragePVC := backendstorage.PVCForVMI(c.pvcIndexer, vmi)
 	if backendStoragePVC != nil {
 		if backendStorage, ok := oldStatusMap[backendStoragePVC.Name]; ok {
@@ -287,7 +299,11 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 		pvcName := storagetypes.PVCNameFromVirtVolume(&volume)
 
 		if _, ok := hotplugVolumesMap[volume.Name]; ok {
-			c.processHotplugVolumeStatus(vmi, volume.Name, pvcName, &status, attachmentPod)
+			if storagetypes.IsHotplugFilesystem(vmi, volume.Name) {
+				c.processHotplugVolumeStatus(vmi, volume.Name, pvcName, &status, findFilesystemAttachmentPod(volume.Name, filesystemAttachmentPods))
+			} else {
+				c.processHotplugVolumeStatus(vmi, volume.Name, pvcName, &status, attachmentPod)
+			}
 		}
 		if volume.VolumeSource.PersistentVolumeClaim != nil || volume.VolumeSource.DataVolume != nil || volume.VolumeSource.MemoryDump != nil {
 			err = c.processPVCInfo(&status, pvcName, vmi.Namespace, false)
@@ -295,6 +311,18 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 				return err
 			}
 		}
+		if storagetypes.IsPersistentContainerDisk(&volume) {
+			err = c.processPVCInfo(&status, storagetypes.PersistentContainerDiskClaimName(vmi.Name, volume.Name), vmi.Namespace, false)
+			if err != nil {
+				return err
+			}
+		}
+		if imageID, ok := containerDiskImageIDs[volume.Name]; ok && volume.ContainerDisk != nil {
+			if status.ContainerDiskVolume == nil {
+				status.ContainerDiskVolume = &virtv1.ContainerDiskInfo{}
+			}
+			status.ContainerDiskVolume.ImageID = imageID
+		}
 
 		newStatus = append(newStatus, status)
 	}
@@ -344,6 +372,26 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 	return nil
 }
 
+// containerDiskImageIDs returns the containerDisk images pinned to the digest the container runtime pulled, once all
+// the containerDisk containers of the launcher pod started. virt-handler keys its containerDisk cache on them
+func (c *Controller) containerDiskImageIDs(vmi *virtv1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod) map[string]string {
+	if virtlauncherPod == nil {
+		return nil
+	}
+	imageIDs, err := containerdisk.ExtractImageIDsFromSourcePod(vmi, virtlauncherPod, c.clusterConfig.ImageVolumeEnabled())
+	if err != nil {
+		log.Log.Object(vmi).V(4).Reason(err).Info("containerDisk image digests are not known yet")
+		return nil
+	}
+	pinned := map[string]string{}
+	for name, imageID := range imageIDs {
+		if strings.Contains(imageID, "@sha256:") {
+			pinned[name] = imageID
+		}
+	}
+	return pinned
+}
+
 func (c *Controller) checkEphemeralHotplugVolumes(vmi *virtv1.VirtualMachineInstance) {
 	vm := c.getOwnerVM(vmi)
 	if vmi == nil || vm == nil {
@@ -69,6 +69,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
 var _ = Describe("VirtualMachineInstance watcher", func() {
@@ -3076,6 +3077,113 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			Entry("should return true if len(volumes) == len(attachmentpods), but contents differ", makeVolumes(1, 3), makePods(1, 2), true),
 		)
 
+		Context("with hotplugged filesystems", func() {
+			newFilesystemVMI := func(indexes ...int) *virtv1.VirtualMachineInstance {
+				vmi := newPendingVirtualMachine("testvmi")
+				for _, volume := range makeVolumes(indexes...) {
+					volume.PersistentVolumeClaim.Hotpluggable = true
+					vmi.Spec.Volumes = append(vmi.Spec.Volumes, *volume)
+					vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems, virtv1.Filesystem{
+						Name:     volume.Name,
+						Virtiofs: &virtv1.FilesystemVirtiofs{},
+					})
+				}
+				return vmi
+			}
+
+			newFilesystemAttachmentPod := func(virtlauncherPod *k8sv1.Pod, index int) *k8sv1.Pod {
+				pod := newPodForVirtlauncher(virtlauncherPod, fmt.Sprintf("hp-fs-%d", index), fmt.Sprintf("fs%d", index), k8sv1.PodRunning)
+				pod.Labels = map[string]string{virtiofs.HotplugFilesystemLabel: fmt.Sprintf("volume%d", index)}
+				return pod
+			}
+
+			It("should separate filesystem volumes and pods from the disk ones", func() {
+				vmi := newFilesystemVMI(1)
+				volumes := append(makeVolumes(2), &vmi.Spec.Volumes[0])
+				diskVolumes, filesystemVolumes := splitHotplugFilesystemVolumes(vmi, volumes)
+				Expect(diskVolumes).To(HaveLen(1))
+				Expect(diskVolumes[0].Name).To(Equal("volume2"))
+				Expect(filesystemVolumes).To(HaveLen(1))
+				Expect(filesystemVolumes[0].Name).To(Equal("volume1"))
+
+				virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				fsPod := newFilesystemAttachmentPod(virtlauncherPod, 1)
+				diskPods, filesystemPods := splitFilesystemAttachmentPods(append(makePods(2), fsPod))
+				Expect(diskPods).To(HaveLen(1))
+				Expect(filesystemPods).To(ConsistOf(fsPod))
+			})
+
+			It("should create an attachment pod for every ready filesystem", func() {
+				vmi := newFilesystemVMI(1, 2)
+				vmi.Status.SelinuxContext = "system_u:system_r:container_file_t:s0:c1,c2"
+				virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				addVirtualMachine(vmi)
+				addPod(virtlauncherPod)
+				preparePVC(1)
+				addDataVolumePVC(newHotplugPVC("claim2", vmi.Namespace, k8sv1.ClaimPending))
+				addDataVolume(&cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{Name: "claim2", Namespace: vmi.Namespace},
+					Status:     cdiv1.DataVolumeStatus{Phase: cdiv1.Pending},
+				})
+				volumes := []*virtv1.Volume{&vmi.Spec.Volumes[0], &vmi.Spec.Volumes[1]}
+
+				syncErr := controller.handleHotplugFilesystems(volumes, nil, vmi, virtlauncherPod, nil)
+				Expect(syncErr).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
+
+				pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+					LabelSelector: virtiofs.HotplugFilesystemLabel,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pods.Items).To(HaveLen(1))
+				Expect(pods.Items[0].Labels).To(HaveKeyWithValue(virtiofs.HotplugFilesystemLabel, "volume1"))
+				Expect(pods.Items[0].Spec.Volumes).To(ContainElement(HaveField("Name", "volume1")))
+			})
+
+			It("should not create a second attachment pod for a filesystem", func() {
+				vmi := newFilesystemVMI(1)
+				virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				fsPod := newFilesystemAttachmentPod(virtlauncherPod, 1)
+				addVirtualMachine(vmi)
+				addPod(virtlauncherPod)
+				addPod(fsPod)
+				preparePVC(1)
+
+				syncErr := controller.handleHotplugFilesystems([]*virtv1.Volume{&vmi.Spec.Volumes[0]}, []*k8sv1.Pod{fsPod}, vmi, virtlauncherPod, nil)
+				Expect(syncErr).ToNot(HaveOccurred())
+				pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pods.Items).To(HaveLen(2))
+			})
+
+			DescribeTable("should handle the attachment pod of an unplugged filesystem", func(phase virtv1.VolumePhase, shouldDelete bool) {
+				vmi := newFilesystemVMI()
+				addVolumeStatuses(vmi, virtv1.VolumeStatus{
+					Name:          "volume1",
+					Phase:         phase,
+					HotplugVolume: &virtv1.HotplugVolumeStatus{},
+				})
+				virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				fsPod := newFilesystemAttachmentPod(virtlauncherPod, 1)
+				addVirtualMachine(vmi)
+				addPod(virtlauncherPod)
+				addPod(fsPod)
+
+				syncErr := controller.handleHotplugFilesystems(nil, []*k8sv1.Pod{fsPod}, vmi, virtlauncherPod, nil)
+				Expect(syncErr).ToNot(HaveOccurred())
+				if shouldDelete {
+					testutils.ExpectEvent(recorder, kvcontroller.SuccessfulDeletePodReason)
+					expectPodDoesNotExist(fsPod.Namespace, fsPod.Name)
+				} else {
+					expectPodExists(fsPod.Namespace, fsPod.Name)
+				}
+			},
+				Entry("not while the filesystem is attached", virtv1.VolumeReady, false),
+				Entry("not while the filesystem is mounted", virtv1.HotplugVolumeMounted, false),
+				Entry("once the filesystem is detached", virtv1.HotplugVolumeDetaching, true),
+			)
+		})
+
 		DescribeTable("virtlauncherAttachmentPods", func(podCount int) {
 			vmi := newPendingVirtualMachine("testvmi")
 			virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
@@ -3506,6 +3614,59 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			Expect(volumeStatus.PersistentVolumeClaimInfo.ClaimName).To(Equal("filesystem-pvc"))
 		})
 
+		It("Should report the overlay PVC of a persistent containerDisk", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Spec.Volumes = []virtv1.Volume{{
+				Name: "disk0",
+				VolumeSource: virtv1.VolumeSource{
+					ContainerDisk: &virtv1.ContainerDiskSource{
+						Image:      "registry:5000/disk",
+						Persistent: &virtv1.PersistentContainerDisk{Capacity: resource.MustParse("1Gi")},
+					},
+				},
+			}}
+
+			Expect(controller.pvcIndexer.Add(&k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testvmi-disk0-overlay",
+					Namespace: k8sv1.NamespaceDefault,
+				},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+				},
+			})).To(Succeed())
+
+			virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			Expect(controller.updateVolumeStatus(vmi, virtlauncherPod)).To(Succeed())
+
+			Expect(vmi.Status.VolumeStatus).To(HaveLen(1))
+			volumeStatus := vmi.Status.VolumeStatus[0]
+			Expect(volumeStatus.PersistentVolumeClaimInfo).ToNot(BeNil())
+			Expect(volumeStatus.PersistentVolumeClaimInfo.ClaimName).To(Equal("testvmi-disk0-overlay"))
+			Expect(volumeStatus.PersistentVolumeClaimInfo.AccessModes).To(ConsistOf(k8sv1.ReadWriteMany))
+		})
+
+		It("Should report the digest the container runtime pulled for a containerDisk", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Spec.Volumes = []virtv1.Volume{{
+				Name: "disk0",
+				VolumeSource: virtv1.VolumeSource{
+					ContainerDisk: &virtv1.ContainerDiskSource{Image: "registry:5000/disk:latest"},
+				},
+			}}
+
+			virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			virtlauncherPod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name:    "volumedisk0",
+				ImageID: "registry:5000/disk@sha256:0123456789abcdef",
+			}}
+			Expect(controller.updateVolumeStatus(vmi, virtlauncherPod)).To(Succeed())
+
+			Expect(vmi.Status.VolumeStatus).To(HaveLen(1))
+			Expect(vmi.Status.VolumeStatus[0].ContainerDiskVolume).ToNot(BeNil())
+			Expect(vmi.Status.VolumeStatus[0].ContainerDiskVolume.ImageID).To(Equal("registry:5000/disk@sha256:0123456789abcdef"))
+		})
+
 		Context("isUtilityVolumeWithBlockPVC", func() {
 			It("should return true for a utility volume with block mode PVC", func() {
 				vmi := newPendingVirtualMachine("testvmi")
@@ -36,6 +36,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
 func needsHandleHotplug(hotplugVolumes []*v1.Volume, hotplugAttachmentPods []*k8sv1.Pod) bool {
@@ -213,6 +214,121 @@ func (c *Controller) handleHotplugVolumes(hotplugVolumes []*v1.Volume, hotplugAt
 	return nil
 }
 
+// splitHotplugFilesystemVolumes separates the hotplugged volumes shared with the guest through
+// virtiofs, which are served by a dedicated attachment pod each, from the volumes attached as disks.
+func splitHotplugFilesystemVolumes(vmi *v1.VirtualMachineInstance, hotplugVolumes []*v1.Volume) ([]*v1.Volume, []*v1.Volume) {
+	diskVolumes := make([]*v1.Volume, 0)
+	filesystemVolumes := make([]*v1.Volume, 0)
+	for _, volume := range hotplugVolumes {
+		if storagetypes.IsHotplugFilesystem(vmi, volume.Name) {
+			filesystemVolumes = append(filesystemVolumes, volume)
+		} else {
+			diskVolumes = append(diskVolumes, volume)
+		}
+	}
+	return diskVolumes, filesystemVolumes
+}
+
+func splitFilesystemAttachmentPods(attachmentPods []*k8sv1.Pod) ([]*k8sv1.Pod, []*k8sv1.Pod) {
+	diskPods := make([]*k8sv1.Pod, 0)
+	filesystemPods := make([]*k8sv1.Pod, 0)
+	for _, pod := range attachmentPods {
+		if _, ok := pod.Labels[virtiofs.HotplugFilesystemLabel]; ok {
+			filesystemPods = append(filesystemPods, pod)
+		} else {
+			diskPods = append(diskPods, pod)
+		}
+	}
+	return diskPods, filesystemPods
+}
+
+func findFilesystemAttachmentPod(volumeName string, filesystemAttachmentPods []*k8sv1.Pod) *k8sv1.Pod {
+	var found *k8sv1.Pod
+	for _, pod := range filesystemAttachmentPods {
+		if pod.Labels[virtiofs.HotplugFilesystemLabel] != volumeName {
+			continue
+		}
+		// prefer the pod which is not being deleted
+		if found == nil || found.DeletionTimestamp != nil {
+			found = pod
+		}
+	}
+	return found
+}
+
+// handleHotplugFilesystems makes sure every ready hotplugged virtiofs volume is served by its own
+// attachment pod running virtiofsd, and deletes the pods of the volumes which have been unplugged.
+func (c *Controller) handleHotplugFilesystems(filesystemVolumes []*v1.Volume, filesystemAttachmentPods []*k8sv1.Pod, vmi *v1.VirtualMachineInstance, virtLauncherPod *k8sv1.Pod, dataVolumes []*cdiv1.DataVolume) common.SyncError {
+	logger := log.Log.Object(vmi)
+
+	volumesByName := make(map[string]*v1.Volume)
+	for _, volume := range filesystemVolumes {
+		volumesByName[volume.Name] = volume
+	}
+	statusMap := make(map[string]v1.VolumeStatus)
+	for _, vs := range vmi.Status.VolumeStatus {
+		statusMap[vs.Name] = vs
+	}
+
+	for _, attachmentPod := range filesystemAttachmentPods {
+		volumeName := attachmentPod.Labels[virtiofs.HotplugFilesystemLabel]
+		if _, wanted := volumesByName[volumeName]; wanted {
+			continue
+		}
+		if volumeStatus, ok := statusMap[volumeName]; ok && !volumeReadyForPodDelete(volumeStatus.Phase) {
+			logger.V(3).Infof("Not deleting attachment pod %s, because filesystem %s is still mounted", attachmentPod.Name, volumeName)
+			continue
+		}
+		if err := c.deleteAttachmentPod(vmi, attachmentPod); err != nil {
+			return common.NewSyncError(fmt.Errorf("Error deleting attachment pod %v", err), controller.FailedDeletePodReason)
+		}
+	}
+
+	for _, volume := range filesystemVolumes {
+		if findFilesystemAttachmentPod(volume.Name, filesystemAttachmentPods) != nil {
+			continue
+		}
+		ready, wffc, err := storagetypes.VolumeReadyToAttachToNode(vmi.Namespace, *volume, dataVolumes, c.dataVolumeIndexer, c.pvcIndexer)
+		if err != nil {
+			return common.NewSyncError(fmt.Errorf("Error determining volume status %v", err), controller.PVCNotReadyReason)
+		}
+		if wffc {
+			logger.V(1).Infof("Volume %s/%s is in WaitForFistConsumer, triggering population", vmi.Namespace, volume.Name)
+			if syncError := c.triggerHotplugPopulation(volume, vmi, virtLauncherPod); syncError != nil {
+				return syncError
+			}
+			continue
+		}
+		if !ready {
+			logger.V(3).Infof("Skipping hotplugged filesystem: %s, not ready", volume.Name)
+			continue
+		}
+		if syncErr := c.createFilesystemAttachmentPod(vmi, virtLauncherPod, volume); syncErr != nil {
+			return syncErr
+		}
+	}
+	return nil
+}
+
+func (c *Controller) createFilesystemAttachmentPod(vmi *v1.VirtualMachineInstance, virtLauncherPod *k8sv1.Pod, volume *v1.Volume) common.SyncError {
+	claimName := storagetypes.PVCNameFromVirtVolume(volume)
+	if claimName == "" {
+		return common.NewSyncError(fmt.Errorf("Unable to hotplug filesystem %s, claim not PVC or Datavolume", volume.Name), controller.FailedCreatePodReason)
+	}
+	attachmentPodTemplate, err := c.templateService.RenderHotplugFilesystemAttachmentPodTemplate(volume, virtLauncherPod, vmi, claimName)
+	if err != nil {
+		return common.NewSyncError(fmt.Errorf("Error rendering filesystem attachment pod template %v", err), controller.FailedCreatePodReason)
+	}
+	vmiKey := controller.VirtualMachineInstanceKey(vmi)
+	pod, err := c.createPod(vmiKey, vmi.Namespace, attachmentPodTemplate)
+	if err != nil {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedCreatePodReason, "Error creating attachment pod for filesystem %s: %v", volume.Name, err)
+		return common.NewSyncError(fmt.Errorf("Error creating attachment pod %v", err), controller.FailedCreatePodReason)
+	}
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, controller.SuccessfulCreatePodReason, "Created attachment pod %s for filesystem %s", pod.Name, volume.Name)
+	return nil
+}
+
 func (c *Controller) createAttachmentPod(vmi *v1.VirtualMachineInstance, virtLauncherPod *k8sv1.Pod, volumes []*v1.Volume) (*k8sv1.Pod, common.SyncError) {
 	attachmentPodTemplate, _ := c.createAttachmentPodTemplate(vmi, virtLauncherPod, volumes)
 	if attachmentPodTemplate == nil {
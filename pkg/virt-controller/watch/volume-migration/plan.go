@@ -0,0 +1,844 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package volumemigration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	// StorageMigrationStarted is the event recorded when the volumes of a VirtualMachine start moving
+	StorageMigrationStarted = "StorageMigrationStarted"
+	// StorageMigrationCompleted is the event recorded when all the volumes of a VirtualMachine were moved
+	StorageMigrationCompleted = "StorageMigrationCompleted"
+	// StorageMigrationFailed is the event recorded when the volumes of a VirtualMachine could not be moved
+	StorageMigrationFailed = "StorageMigrationFailed"
+
+	planUnknownTypeErrFmt = "storage migration plan controller expected object of type %s but found object of unknown type"
+)
+
+// PlanController drives VirtualMachineStorageMigrationPlans. It moves the volumes of the selected VirtualMachines
+// to the target StorageClass by triggering volume migrations, at most MaxParallelMigrations VirtualMachines at a time
+// and only while the window of the plan is open
+type PlanController struct {
+	client      kubecli.KubevirtClient
+	planIndexer cache.Indexer
+	vmIndexer   cache.Indexer
+	vmiStore    cache.Store
+	pvcStore    cache.Store
+	dvStore     cache.Store
+	recorder    record.EventRecorder
+	clock       clock.PassiveClock
+
+	queue     workqueue.TypedRateLimitingInterface[string]
+	hasSynced func() bool
+}
+
+func NewPlanController(client kubecli.KubevirtClient, planInformer, vmInformer, vmiInformer, pvcInformer, dvInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*PlanController, error) {
+	c := &PlanController{
+		client:      client,
+		planIndexer: planInformer.GetIndexer(),
+		vmIndexer:   vmInformer.GetIndexer(),
+		vmiStore:    vmiInformer.GetStore(),
+		pvcStore:    pvcInformer.GetStore(),
+		dvStore:     dvInformer.GetStore(),
+		recorder:    recorder,
+		clock:       clock.RealClock{},
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-storage-migration-plan"},
+		),
+	}
+
+	c.hasSynced = func() bool {
+		return planInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() &&
+			pvcInformer.HasSynced() && dvInformer.HasSynced()
+	}
+
+	_, err := planInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handlePlan,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handlePlan(newObj) },
+			DeleteFunc: c.handlePlan,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleNamespacedObject,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleNamespacedObject(newObj) },
+			DeleteFunc: c.handleNamespacedObject,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *PlanController) handlePlan(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	plan, ok := obj.(*migrationsv1.VirtualMachineStorageMigrationPlan)
+	if !ok {
+		log.Log.Errorf(planUnknownTypeErrFmt, "virtualmachinestoragemigrationplan")
+		return
+	}
+
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(plan)
+	if err != nil {
+		log.Log.Object(plan).Reason(err).Error("cannot get storage migration plan key")
+		return
+	}
+	c.queue.Add(key)
+}
+
+// handleNamespacedObject enqueues the unfinished plans in the namespace of a changed VMI
+func (c *PlanController) handleNamespacedObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		log.Log.Reason(fmt.Errorf("unexpected obj %#v", obj)).Error("Failed to process notification")
+		return
+	}
+
+	plans, err := c.planIndexer.ByIndex(cache.NamespaceIndex, o.GetNamespace())
+	if err != nil {
+		log.Log.Reason(err).Error("cannot get storage migration plans from namespace indexer")
+		return
+	}
+	for _, p := range plans {
+		plan := p.(*migrationsv1.VirtualMachineStorageMigrationPlan)
+		if isPlanFinished(plan) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(plan)
+		if err != nil {
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+func (c *PlanController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting storage migration plan controller")
+	defer log.Log.Info("Shutting down storage migration plan controller")
+
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *PlanController) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing storage migration plan %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed storage migration plan %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *PlanController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *PlanController) execute(key string) error {
+	obj, exists, err := c.planIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	plan := obj.(*migrationsv1.VirtualMachineStorageMigrationPlan)
+	if plan.DeletionTimestamp != nil || isPlanFinished(plan) {
+		return nil
+	}
+
+	planCopy := plan.DeepCopy()
+	// The generated target claim names are persisted before any migration starts
+	if planCopy.Status == nil {
+		status, err := c.initializeStatus(planCopy)
+		if err != nil {
+			return err
+		}
+		planCopy.Status = status
+		_, err = c.client.VirtualMachineStorageMigrationPlan(planCopy.Namespace).UpdateStatus(context.Background(), planCopy, metav1.UpdateOptions{})
+		return err
+	}
+
+	syncErr := c.sync(planCopy)
+
+	if !equality.Semantic.DeepEqual(plan.Status, planCopy.Status) {
+		if _, err := c.client.VirtualMachineStorageMigrationPlan(planCopy.Namespace).UpdateStatus(context.Background(), planCopy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return syncErr
+}
+
+// initializeStatus records a volume entry for every PVC or DataVolume of the selected VirtualMachines which isn't
+// already on the target StorageClass
+func (c *PlanController) initializeStatus(plan *migrationsv1.VirtualMachineStorageMigrationPlan) (*migrationsv1.VirtualMachineStorageMigrationPlanStatus, error) {
+	status := &migrationsv1.VirtualMachineStorageMigrationPlanStatus{
+		Phase:          migrationsv1.StorageMigrationInProgress,
+		StartTimestamp: pointerToNow(),
+	}
+
+	vmNames, err := c.planVirtualMachines(plan)
+	if err != nil {
+		return nil, err
+	}
+	for _, vmName := range vmNames {
+		obj, exists, err := c.vmIndexer.GetByKey(controller.NamespacedKey(plan.Namespace, vmName))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			c.recorder.Eventf(plan, k8sv1.EventTypeWarning, StorageMigrationFailed, "VirtualMachine %s does not exist", vmName)
+			continue
+		}
+		vm := obj.(*virtv1.VirtualMachine)
+		if vm.Spec.Template == nil {
+			continue
+		}
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			volumeStatus := c.newVolumeStatus(plan, vm, volume)
+			if volumeStatus != nil {
+				status.Volumes = append(status.Volumes, *volumeStatus)
+			}
+		}
+	}
+	return status, nil
+}
+
+func (c *PlanController) newVolumeStatus(plan *migrationsv1.VirtualMachineStorageMigrationPlan, vm *virtv1.VirtualMachine, volume virtv1.Volume) *migrationsv1.StorageMigrationVolumeStatus {
+	claimName := storagetypes.PVCNameFromVirtVolume(&volume)
+	if claimName == "" || isHotplugVolume(volume) {
+		return nil
+	}
+	volumeStatus := &migrationsv1.StorageMigrationVolumeStatus{
+		VirtualMachine:  vm.Name,
+		VolumeName:      volume.Name,
+		SourceClaimName: claimName,
+		TargetClaimName: fmt.Sprintf("%s-mig-%s", claimName, rand.String(5)),
+		Phase:           migrationsv1.StorageMigrationPending,
+	}
+
+	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(plan.Namespace, claimName, c.pvcStore)
+	if err != nil || pvc == nil {
+		volumeStatus.Phase = migrationsv1.StorageMigrationFailed
+		volumeStatus.Message = fmt.Sprintf("source PersistentVolumeClaim %s not found", claimName)
+		return volumeStatus
+	}
+	if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName == plan.Spec.TargetStorageClassName {
+		return nil
+	}
+	if size := claimSize(pvc); size != nil {
+		volumeStatus.TotalBytes = size.Value()
+	}
+	return volumeStatus
+}
+
+// planVirtualMachines returns the sorted names of the VirtualMachines listed or selected by the plan
+func (c *PlanController) planVirtualMachines(plan *migrationsv1.VirtualMachineStorageMigrationPlan) ([]string, error) {
+	names := map[string]struct{}{}
+	for _, name := range plan.Spec.VirtualMachines {
+		names[name] = struct{}{}
+	}
+	if plan.Spec.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(plan.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+		objs, err := c.vmIndexer.ByIndex(cache.NamespaceIndex, plan.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			vm := obj.(*virtv1.VirtualMachine)
+			if vm.DeletionTimestamp == nil && selector.Matches(labels.Set(vm.Labels)) {
+				names[vm.Name] = struct{}{}
+			}
+		}
+	}
+
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted, nil
+}
+
+func (c *PlanController) sync(plan *migrationsv1.VirtualMachineStorageMigrationPlan) error {
+	vmPhases := vmPhases(plan.Status.Volumes)
+
+	active := 0
+	for _, vmName := range sortedKeys(vmPhases) {
+		if vmPhases[vmName] != migrationsv1.StorageMigrationInProgress {
+			continue
+		}
+		if err := c.syncVirtualMachine(plan, vmName); err != nil {
+			return err
+		}
+		if vmPhase(plan.Status.Volumes, vmName) == migrationsv1.StorageMigrationInProgress {
+			active++
+		}
+	}
+
+	maxParallel := 1
+	if plan.Spec.MaxParallelMigrations != nil {
+		maxParallel = int(*plan.Spec.MaxParallelMigrations)
+	}
+	untilOpen, err := untilWindowOpens(plan.Spec.Window, c.clock.Now())
+	if err != nil {
+		return err
+	}
+	for _, vmName := range sortedKeys(vmPhases) {
+		if active >= maxParallel {
+			break
+		}
+		if vmPhases[vmName] != migrationsv1.StorageMigrationPending {
+			continue
+		}
+		if untilOpen > 0 {
+			c.enqueueAfter(plan, untilOpen)
+			break
+		}
+		if err := c.startVirtualMachine(plan, vmName); err != nil {
+			return err
+		}
+		if vmPhase(plan.Status.Volumes, vmName) == migrationsv1.StorageMigrationInProgress {
+			active++
+		}
+	}
+
+	for _, vmName := range sortedKeys(vmPhases) {
+		if !isTerminalPhase(vmPhase(plan.Status.Volumes, vmName)) {
+			continue
+		}
+		if err := c.removeBandwidthAnnotation(plan, vmName); err != nil {
+			return err
+		}
+	}
+
+	for _, volume := range plan.Status.Volumes {
+		if !isTerminalPhase(volume.Phase) {
+			return nil
+		}
+	}
+	plan.Status.Phase = migrationsv1.StorageMigrationSucceeded
+	for _, volume := range plan.Status.Volumes {
+		if volume.Phase == migrationsv1.StorageMigrationFailed {
+			plan.Status.Phase = migrationsv1.StorageMigrationFailed
+			break
+		}
+	}
+	plan.Status.CompletionTimestamp = pointerToNow()
+	return nil
+}
+
+// startVirtualMachine creates the target claims and swaps the volumes of the VirtualMachine to trigger the volume migration
+func (c *PlanController) startVirtualMachine(plan *migrationsv1.VirtualMachineStorageMigrationPlan, vmName string) error {
+	obj, exists, err := c.vmIndexer.GetByKey(controller.NamespacedKey(plan.Namespace, vmName))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		c.failVirtualMachine(plan, vmName, "VirtualMachine does not exist")
+		return nil
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+	vmi, err := c.getVMI(plan.Namespace, vmName)
+	if err != nil {
+		return err
+	}
+	if vmi == nil || !vmi.IsRunning() {
+		c.failVirtualMachine(plan, vmName, "VirtualMachine is not running")
+		return nil
+	}
+
+	for _, volume := range plan.Status.Volumes {
+		if volume.VirtualMachine != vmName || volume.Phase != migrationsv1.StorageMigrationPending {
+			continue
+		}
+		if err := c.createTargetClaim(plan, volume); err != nil {
+			return err
+		}
+	}
+
+	if err := c.setBandwidthAnnotation(plan, vmi); err != nil {
+		return err
+	}
+	if err := c.patchVirtualMachineVolumes(plan, vm); err != nil {
+		return err
+	}
+
+	for i := range plan.Status.Volumes {
+		if plan.Status.Volumes[i].VirtualMachine == vmName && plan.Status.Volumes[i].Phase == migrationsv1.StorageMigrationPending {
+			plan.Status.Volumes[i].Phase = migrationsv1.StorageMigrationInProgress
+		}
+	}
+	c.recorder.Eventf(plan, k8sv1.EventTypeNormal, StorageMigrationStarted, "Started moving the volumes of VirtualMachine %s", vmName)
+	return nil
+}
+
+func (c *PlanController) createTargetClaim(plan *migrationsv1.VirtualMachineStorageMigrationPlan, volume migrationsv1.StorageMigrationVolumeStatus) error {
+	target, err := storagetypes.GetPersistentVolumeClaimFromCache(plan.Namespace, volume.TargetClaimName, c.pvcStore)
+	if err != nil {
+		return err
+	}
+	if target != nil {
+		return nil
+	}
+	source, err := storagetypes.GetPersistentVolumeClaimFromCache(plan.Namespace, volume.SourceClaimName, c.pvcStore)
+	if err != nil {
+		return err
+	}
+	if source == nil {
+		return storagetypes.NewPVCNotFoundError(volume.SourceClaimName)
+	}
+
+	target = &k8sv1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volume.TargetClaimName,
+			Namespace: plan.Namespace,
+		},
+		Spec: k8sv1.PersistentVolumeClaimSpec{
+			AccessModes:      source.Spec.AccessModes,
+			VolumeMode:       source.Spec.VolumeMode,
+			StorageClassName: &plan.Spec.TargetStorageClassName,
+		},
+	}
+	if size := claimSize(source); size != nil {
+		target.Spec.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceStorage: *size}
+	}
+	_, err = c.client.CoreV1().PersistentVolumeClaims(plan.Namespace).Create(context.Background(), target, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// setBandwidthAnnotation annotates the VMI with the bandwidth the migration controller applies to the storage migration
+func (c *PlanController) setBandwidthAnnotation(plan *migrationsv1.VirtualMachineStorageMigrationPlan, vmi *virtv1.VirtualMachineInstance) error {
+	if plan.Spec.BandwidthPerMigration == nil {
+		return nil
+	}
+	bandwidth := plan.Spec.BandwidthPerMigration.String()
+	if vmi.Annotations[virtv1.StorageMigrationBandwidthAnnotation] == bandwidth {
+		return nil
+	}
+
+	patchSet := patch.New()
+	if vmi.Annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{virtv1.StorageMigrationBandwidthAnnotation: bandwidth}))
+	} else {
+		patchSet.AddOption(patch.WithAdd(fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.StorageMigrationBandwidthAnnotation)), bandwidth))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// removeBandwidthAnnotation drops the bandwidth set by the plan once the VirtualMachine is done, so that it doesn't
+// throttle later migrations
+func (c *PlanController) removeBandwidthAnnotation(plan *migrationsv1.VirtualMachineStorageMigrationPlan, vmName string) error {
+	if plan.Spec.BandwidthPerMigration == nil {
+		return nil
+	}
+	vmi, err := c.getVMI(plan.Namespace, vmName)
+	if err != nil || vmi == nil {
+		return err
+	}
+	bandwidth, ok := vmi.Annotations[virtv1.StorageMigrationBandwidthAnnotation]
+	if !ok {
+		return nil
+	}
+
+	annotationPath := fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.StorageMigrationBandwidthAnnotation))
+	patchBytes, err := patch.New(
+		patch.WithTest(annotationPath, bandwidth),
+		patch.WithRemove(annotationPath),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// patchVirtualMachineVolumes points the volumes of the VirtualMachine to the target claims with the Migration update strategy.
+// The DataVolumeTemplates of the moved DataVolumes are dropped, otherwise the VM controller would keep recreating them
+func (c *PlanController) patchVirtualMachineVolumes(plan *migrationsv1.VirtualMachineStorageMigrationPlan, vm *virtv1.VirtualMachine) error {
+	targets := map[string]migrationsv1.StorageMigrationVolumeStatus{}
+	for _, volume := range plan.Status.Volumes {
+		if volume.VirtualMachine == vm.Name && volume.Phase == migrationsv1.StorageMigrationPending {
+			targets[volume.VolumeName] = volume
+		}
+	}
+
+	volumes := make([]virtv1.Volume, 0, len(vm.Spec.Template.Spec.Volumes))
+	movedDVs := map[string]struct{}{}
+	updated := false
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		target, ok := targets[volume.Name]
+		if !ok || storagetypes.PVCNameFromVirtVolume(&volume) == target.TargetClaimName {
+			volumes = append(volumes, volume)
+			continue
+		}
+		updated = true
+		if volume.DataVolume != nil {
+			movedDVs[volume.DataVolume.Name] = struct{}{}
+		}
+		volumes = append(volumes, virtv1.Volume{
+			Name: volume.Name,
+			VolumeSource: virtv1.VolumeSource{
+				PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: target.TargetClaimName,
+					},
+				},
+			},
+		})
+	}
+
+	if !updated {
+		return nil
+	}
+
+	patchSet := patch.New(
+		patch.WithTest("/spec/template/spec/volumes", vm.Spec.Template.Spec.Volumes),
+		patch.WithReplace("/spec/template/spec/volumes", volumes),
+		patch.WithAdd("/spec/updateVolumesStrategy", virtv1.UpdateVolumesStrategyMigration),
+	)
+	if len(movedDVs) > 0 && len(vm.Spec.DataVolumeTemplates) > 0 {
+		var templates []virtv1.DataVolumeTemplateSpec
+		for _, template := range vm.Spec.DataVolumeTemplates {
+			if _, ok := movedDVs[template.Name]; !ok {
+				templates = append(templates, template)
+			}
+		}
+		patchSet.AddOption(
+			patch.WithTest("/spec/dataVolumeTemplates", vm.Spec.DataVolumeTemplates),
+			patch.WithReplace("/spec/dataVolumeTemplates", templates),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.client.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// syncVirtualMachine updates the progress of the volumes of a VirtualMachine being migrated
+func (c *PlanController) syncVirtualMachine(plan *migrationsv1.VirtualMachineStorageMigrationPlan, vmName string) error {
+	vmi, err := c.getVMI(plan.Namespace, vmName)
+	if err != nil {
+		return err
+	}
+	if vmi == nil || vmi.IsFinal() {
+		c.failVirtualMachine(plan, vmName, "VirtualMachineInstance stopped during the storage migration")
+		return nil
+	}
+	cond := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, virtv1.VirtualMachineInstanceVolumesChange)
+	if cond != nil && cond.Status == k8sv1.ConditionFalse {
+		c.failVirtualMachine(plan, vmName, fmt.Sprintf("storage migration cancelled: %s", cond.Message))
+		return nil
+	}
+
+	if !isStorageMigrationCompleted(plan, vmi, vmName) {
+		progress := map[string]virtv1.StorageMigratedVolumeProgress{}
+		var failureReason string
+		if vmi.Status.MigrationState != nil {
+			for _, p := range vmi.Status.MigrationState.MigratedVolumesProgress {
+				progress[p.VolumeName] = p
+			}
+			if vmi.Status.MigrationState.Failed {
+				failureReason = vmi.Status.MigrationState.FailureReason
+			}
+		}
+		for i := range plan.Status.Volumes {
+			volume := &plan.Status.Volumes[i]
+			if volume.VirtualMachine != vmName {
+				continue
+			}
+			if p, ok := progress[volume.VolumeName]; ok {
+				volume.BytesCopied = p.BytesCopied
+				if p.TotalBytes > 0 {
+					volume.TotalBytes = p.TotalBytes
+				}
+			}
+			volume.Message = failureReason
+		}
+		return nil
+	}
+
+	if plan.Spec.DeleteSourcePVCs != nil && *plan.Spec.DeleteSourcePVCs {
+		for _, volume := range plan.Status.Volumes {
+			if volume.VirtualMachine != vmName {
+				continue
+			}
+			if err := c.deleteSourceClaim(plan.Namespace, volume.SourceClaimName); err != nil {
+				return err
+			}
+		}
+	}
+	for i := range plan.Status.Volumes {
+		volume := &plan.Status.Volumes[i]
+		if volume.VirtualMachine != vmName {
+			continue
+		}
+		volume.Phase = migrationsv1.StorageMigrationSucceeded
+		volume.BytesCopied = volume.TotalBytes
+		volume.Message = ""
+	}
+	c.recorder.Eventf(plan, k8sv1.EventTypeNormal, StorageMigrationCompleted, "Moved the volumes of VirtualMachine %s", vmName)
+	return nil
+}
+
+// isStorageMigrationCompleted checks if the VMI runs on the target claims and the migration copying them succeeded
+func isStorageMigrationCompleted(plan *migrationsv1.VirtualMachineStorageMigrationPlan, vmi *virtv1.VirtualMachineInstance, vmName string) bool {
+	if len(vmi.Status.MigratedVolumes) > 0 || vmi.Status.MigrationState == nil ||
+		!vmi.Status.MigrationState.Completed || vmi.Status.MigrationState.Failed {
+		return false
+	}
+	claims := storagetypes.GetPVCsFromVolumes(vmi.Spec.Volumes)
+	for _, volume := range plan.Status.Volumes {
+		if volume.VirtualMachine == vmName && claims[volume.VolumeName] != volume.TargetClaimName {
+			return false
+		}
+	}
+	return true
+}
+
+// deleteSourceClaim deletes the DataVolume owning the source claim, or the claim itself
+func (c *PlanController) deleteSourceClaim(namespace, name string) error {
+	_, dvExists, err := c.dvStore.GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil {
+		return err
+	}
+	if dvExists {
+		err = c.client.CdiClient().CdiV1beta1().DataVolumes(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	} else {
+		err = c.client.CoreV1().PersistentVolumeClaims(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	}
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *PlanController) failVirtualMachine(plan *migrationsv1.VirtualMachineStorageMigrationPlan, vmName, message string) {
+	for i := range plan.Status.Volumes {
+		volume := &plan.Status.Volumes[i]
+		if volume.VirtualMachine != vmName || isTerminalPhase(volume.Phase) {
+			continue
+		}
+		volume.Phase = migrationsv1.StorageMigrationFailed
+		volume.Message = message
+	}
+	c.recorder.Eventf(plan, k8sv1.EventTypeWarning, StorageMigrationFailed, "Failed to move the volumes of VirtualMachine %s: %s", vmName, message)
+}
+
+func (c *PlanController) getVMI(namespace, name string) (*virtv1.VirtualMachineInstance, error) {
+	obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*virtv1.VirtualMachineInstance), nil
+}
+
+// vmPhases aggregates the phases of the volume entries per VirtualMachine. A VirtualMachine is in progress as soon as
+// one of its volumes is, and pending until all its volumes are terminal
+func vmPhases(volumes []migrationsv1.StorageMigrationVolumeStatus) map[string]migrationsv1.StorageMigrationPhase {
+	phases := map[string]migrationsv1.StorageMigrationPhase{}
+	for _, volume := range volumes {
+		phases[volume.VirtualMachine] = vmPhase(volumes, volume.VirtualMachine)
+	}
+	return phases
+}
+
+func vmPhase(volumes []migrationsv1.StorageMigrationVolumeStatus, vmName string) migrationsv1.StorageMigrationPhase {
+	phase := migrationsv1.StorageMigrationSucceeded
+	for _, volume := range volumes {
+		if volume.VirtualMachine != vmName {
+			continue
+		}
+		switch volume.Phase {
+		case migrationsv1.StorageMigrationInProgress:
+			return migrationsv1.StorageMigrationInProgress
+		case migrationsv1.StorageMigrationPending:
+			phase = migrationsv1.StorageMigrationPending
+		case migrationsv1.StorageMigrationFailed:
+			if phase != migrationsv1.StorageMigrationPending {
+				phase = migrationsv1.StorageMigrationFailed
+			}
+		}
+	}
+	return phase
+}
+
+func (c *PlanController) enqueueAfter(plan *migrationsv1.VirtualMachineStorageMigrationPlan, after time.Duration) {
+	key, err := cache.MetaNamespaceKeyFunc(plan)
+	if err != nil {
+		log.Log.Object(plan).Reason(err).Error("cannot get storage migration plan key")
+		return
+	}
+	c.queue.AddAfter(key, after)
+}
+
+// untilWindowOpens returns how long to wait for the window to open, zero if it is open or unset
+func untilWindowOpens(window *migrationsv1.StorageMigrationWindow, now time.Time) (time.Duration, error) {
+	if window == nil {
+		return 0, nil
+	}
+	start, err := timeOfDay(window.Start)
+	if err != nil {
+		return 0, err
+	}
+	end, err := timeOfDay(window.End)
+	if err != nil {
+		return 0, err
+	}
+
+	now = now.UTC()
+	current := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute + time.Duration(now.Second())*time.Second
+	if start < end && current >= start && current < end {
+		return 0, nil
+	}
+	// The window spans midnight
+	if start > end && (current >= start || current < end) {
+		return 0, nil
+	}
+	until := start - current
+	if until < 0 {
+		until += 24 * time.Hour
+	}
+	return until, nil
+}
+
+func timeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse(migrationsv1.StorageMigrationWindowTimeFormat, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid storage migration window time %q: %v", value, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func sortedKeys(phases map[string]migrationsv1.StorageMigrationPhase) []string {
+	keys := make([]string, 0, len(phases))
+	for key := range phases {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isTerminalPhase(phase migrationsv1.StorageMigrationPhase) bool {
+	return phase == migrationsv1.StorageMigrationSucceeded || phase == migrationsv1.StorageMigrationFailed
+}
+
+func isPlanFinished(plan *migrationsv1.VirtualMachineStorageMigrationPlan) bool {
+	return plan.Status != nil && isTerminalPhase(plan.Status.Phase)
+}
+
+func isHotplugVolume(volume virtv1.Volume) bool {
+	return (volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Hotpluggable) ||
+		(volume.DataVolume != nil && volume.DataVolume.Hotpluggable)
+}
+
+func claimSize(pvc *k8sv1.PersistentVolumeClaim) *resource.Quantity {
+	if size, ok := pvc.Status.Capacity[k8sv1.ResourceStorage]; ok {
+		return &size
+	}
+	if size, ok := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]; ok {
+		return &size
+	}
+	return nil
+}
+
+func pointerToNow() *metav1.Time {
+	now := metav1.Now()
+	return &now
+}
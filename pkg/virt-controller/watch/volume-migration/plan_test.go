@@ -0,0 +1,376 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package volumemigration
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Storage migration plan", func() {
+	const (
+		ns         = "test"
+		planName   = "plan"
+		oldSC      = "old-sc"
+		targetSC   = "new-sc"
+		sourcePVC  = "src"
+		targetPVC  = "src-mig-abcde"
+		volumeName = "disk0"
+	)
+
+	var (
+		kubevirtClient *kubevirtfake.Clientset
+		k8sClient      *k8sfake.Clientset
+		recorder       *record.FakeRecorder
+		planStore      cache.Store
+		vmStore        cache.Store
+		vmiStore       cache.Store
+		pvcStore       cache.Store
+		ctrl           *PlanController
+	)
+
+	newPVC := func(name, storageClass string) *k8sv1.PersistentVolumeClaim {
+		return &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				AccessModes:      []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+				VolumeMode:       pointer.P(k8sv1.PersistentVolumeBlock),
+				StorageClassName: pointer.P(storageClass),
+			},
+			Status: k8sv1.PersistentVolumeClaimStatus{
+				Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		}
+	}
+
+	newPlan := func(status *migrationsv1.VirtualMachineStorageMigrationPlanStatus, vms ...string) *migrationsv1.VirtualMachineStorageMigrationPlan {
+		return &migrationsv1.VirtualMachineStorageMigrationPlan{
+			ObjectMeta: metav1.ObjectMeta{Name: planName, Namespace: ns},
+			Spec: migrationsv1.VirtualMachineStorageMigrationPlanSpec{
+				VirtualMachines:        vms,
+				TargetStorageClassName: targetSC,
+			},
+			Status: status,
+		}
+	}
+
+	volumeStatus := func(vmName string, phase migrationsv1.StorageMigrationPhase) migrationsv1.StorageMigrationVolumeStatus {
+		return migrationsv1.StorageMigrationVolumeStatus{
+			VirtualMachine:  vmName,
+			VolumeName:      volumeName,
+			SourceClaimName: sourcePVC + "-" + vmName,
+			TargetClaimName: targetPVC + "-" + vmName,
+			Phase:           phase,
+			TotalBytes:      1024,
+		}
+	}
+
+	addVM := func(name string, opts ...libvmi.Option) *virtv1.VirtualMachine {
+		opts = append([]libvmi.Option{libvmi.WithNamespace(ns), libvmi.WithName(name)}, opts...)
+		vm := libvmi.NewVirtualMachine(libvmi.New(opts...))
+		Expect(vmStore.Add(vm)).To(Succeed())
+		_, err := kubevirtClient.KubevirtV1().VirtualMachines(ns).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	addVMI := func(vmi *virtv1.VirtualMachineInstance) {
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		_, err := kubevirtClient.KubevirtV1().VirtualMachineInstances(ns).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addPVC := func(pvc *k8sv1.PersistentVolumeClaim) {
+		Expect(pvcStore.Add(pvc)).To(Succeed())
+		_, err := k8sClient.CoreV1().PersistentVolumeClaims(ns).Create(context.Background(), pvc, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addPlan := func(plan *migrationsv1.VirtualMachineStorageMigrationPlan) {
+		Expect(planStore.Add(plan)).To(Succeed())
+		_, err := kubevirtClient.MigrationsV1alpha1().VirtualMachineStorageMigrationPlans(ns).Create(context.Background(), plan, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	executePlan := func() *migrationsv1.VirtualMachineStorageMigrationPlan {
+		Expect(ctrl.execute(controller.NamespacedKey(ns, planName))).To(Succeed())
+		plan, err := kubevirtClient.MigrationsV1alpha1().VirtualMachineStorageMigrationPlans(ns).Get(context.Background(), planName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return plan
+	}
+
+	runningVMI := func(name string, opts ...libvmistatus.Option) *virtv1.VirtualMachineInstance {
+		opts = append(opts, libvmistatus.WithPhase(virtv1.Running))
+		return libvmi.New(
+			libvmi.WithNamespace(ns), libvmi.WithName(name),
+			libvmi.WithPersistentVolumeClaim(volumeName, sourcePVC+"-"+name),
+			libvmistatus.WithStatus(libvmistatus.New(opts...)),
+		)
+	}
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(mockCtrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineStorageMigrationPlan(ns).
+			Return(kubevirtClient.MigrationsV1alpha1().VirtualMachineStorageMigrationPlans(ns)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(ns).Return(kubevirtClient.KubevirtV1().VirtualMachines(ns)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(ns).Return(kubevirtClient.KubevirtV1().VirtualMachineInstances(ns)).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		planInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.VirtualMachineStorageMigrationPlan{})
+		vmInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		planStore = planInformer.GetStore()
+		vmStore = vmInformer.GetStore()
+		vmiStore = vmiInformer.GetStore()
+		pvcStore = pvcInformer.GetStore()
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		var err error
+		ctrl, err = NewPlanController(virtClient, planInformer, vmInformer, vmiInformer, pvcInformer, dvInformer, recorder)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should record the volumes which aren't on the target storage class", func() {
+		addVM("vm1",
+			libvmi.WithPersistentVolumeClaim("disk0", "old"),
+			libvmi.WithPersistentVolumeClaim("disk1", "moved"),
+		)
+		addPVC(newPVC("old", oldSC))
+		addPVC(newPVC("moved", targetSC))
+		addPlan(newPlan(nil, "vm1"))
+
+		plan := executePlan()
+		Expect(plan.Status.Phase).To(Equal(migrationsv1.StorageMigrationInProgress))
+		Expect(plan.Status.StartTimestamp).ToNot(BeNil())
+		Expect(plan.Status.Volumes).To(HaveLen(1))
+		Expect(plan.Status.Volumes[0].VolumeName).To(Equal("disk0"))
+		Expect(plan.Status.Volumes[0].SourceClaimName).To(Equal("old"))
+		Expect(plan.Status.Volumes[0].TargetClaimName).To(HavePrefix("old-mig-"))
+		Expect(plan.Status.Volumes[0].Phase).To(Equal(migrationsv1.StorageMigrationPending))
+		Expect(plan.Status.Volumes[0].TotalBytes).To(BeEquivalentTo(1024 * 1024 * 1024))
+	})
+
+	It("should start at most MaxParallelMigrations storage migrations", func() {
+		for _, name := range []string{"vm1", "vm2"} {
+			addVM(name, libvmi.WithPersistentVolumeClaim(volumeName, sourcePVC+"-"+name))
+			addVMI(runningVMI(name))
+			addPVC(newPVC(sourcePVC+"-"+name, oldSC))
+		}
+		plan := newPlan(&migrationsv1.VirtualMachineStorageMigrationPlanStatus{
+			Phase: migrationsv1.StorageMigrationInProgress,
+			Volumes: []migrationsv1.StorageMigrationVolumeStatus{
+				volumeStatus("vm1", migrationsv1.StorageMigrationPending),
+				volumeStatus("vm2", migrationsv1.StorageMigrationPending),
+			},
+		}, "vm1", "vm2")
+		plan.Spec.BandwidthPerMigration = pointer.P(resource.MustParse("64Mi"))
+		addPlan(plan)
+
+		plan = executePlan()
+		Expect(plan.Status.Volumes[0].Phase).To(Equal(migrationsv1.StorageMigrationInProgress))
+		Expect(plan.Status.Volumes[1].Phase).To(Equal(migrationsv1.StorageMigrationPending))
+		testutils.ExpectEvent(recorder, StorageMigrationStarted)
+
+		target, err := k8sClient.CoreV1().PersistentVolumeClaims(ns).Get(context.Background(), targetPVC+"-vm1", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(target.Spec.StorageClassName).To(HaveValue(Equal(targetSC)))
+		Expect(target.Spec.VolumeMode).To(HaveValue(Equal(k8sv1.PersistentVolumeBlock)))
+		Expect(target.Spec.Resources.Requests.Storage().String()).To(Equal("1Gi"))
+
+		vm, err := kubevirtClient.KubevirtV1().VirtualMachines(ns).Get(context.Background(), "vm1", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Spec.UpdateVolumesStrategy).To(HaveValue(Equal(virtv1.UpdateVolumesStrategyMigration)))
+		Expect(vm.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(targetPVC + "-vm1"))
+
+		vmi, err := kubevirtClient.KubevirtV1().VirtualMachineInstances(ns).Get(context.Background(), "vm1", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmi.Annotations).To(HaveKeyWithValue(virtv1.StorageMigrationBandwidthAnnotation, "64Mi"))
+
+		_, err = k8sClient.CoreV1().PersistentVolumeClaims(ns).Get(context.Background(), targetPVC+"-vm2", metav1.GetOptions{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not start storage migrations while the window is closed", func() {
+		addVM("vm1", libvmi.WithPersistentVolumeClaim(volumeName, sourcePVC+"-vm1"))
+		addVMI(runningVMI("vm1"))
+		addPVC(newPVC(sourcePVC+"-vm1", oldSC))
+		plan := newPlan(&migrationsv1.VirtualMachineStorageMigrationPlanStatus{
+			Phase:   migrationsv1.StorageMigrationInProgress,
+			Volumes: []migrationsv1.StorageMigrationVolumeStatus{volumeStatus("vm1", migrationsv1.StorageMigrationPending)},
+		}, "vm1")
+		plan.Spec.Window = &migrationsv1.StorageMigrationWindow{Start: "22:00", End: "06:00"}
+		addPlan(plan)
+		ctrl.clock = testclock.NewFakePassiveClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		plan = executePlan()
+		Expect(plan.Status.Volumes[0].Phase).To(Equal(migrationsv1.StorageMigrationPending))
+		_, err := k8sClient.CoreV1().PersistentVolumeClaims(ns).Get(context.Background(), targetPVC+"-vm1", metav1.GetOptions{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	DescribeTable("should wait for the window to open", func(start, end string, hour, minute int, expected time.Duration) {
+		window := &migrationsv1.StorageMigrationWindow{Start: start, End: end}
+		until, err := untilWindowOpens(window, time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(until).To(Equal(expected))
+	},
+		Entry("inside a daytime window", "08:00", "18:00", 12, 0, time.Duration(0)),
+		Entry("before a daytime window", "08:00", "18:00", 6, 30, 90*time.Minute),
+		Entry("after a daytime window", "08:00", "18:00", 19, 0, 13*time.Hour),
+		Entry("inside a window spanning midnight, before midnight", "22:00", "06:00", 23, 0, time.Duration(0)),
+		Entry("inside a window spanning midnight, after midnight", "22:00", "06:00", 1, 0, time.Duration(0)),
+		Entry("outside a window spanning midnight", "22:00", "06:00", 12, 0, 10*time.Hour),
+	)
+
+	It("should fail the volumes of a VirtualMachine which isn't running", func() {
+		addVM("vm1", libvmi.WithPersistentVolumeClaim(volumeName, sourcePVC+"-vm1"))
+		addPlan(newPlan(&migrationsv1.VirtualMachineStorageMigrationPlanStatus{
+			Phase:   migrationsv1.StorageMigrationInProgress,
+			Volumes: []migrationsv1.StorageMigrationVolumeStatus{volumeStatus("vm1", migrationsv1.StorageMigrationPending)},
+		}, "vm1"))
+
+		plan := executePlan()
+		Expect(plan.Status.Volumes[0].Phase).To(Equal(migrationsv1.StorageMigrationFailed))
+		Expect(plan.Status.Phase).To(Equal(migrationsv1.StorageMigrationFailed))
+		Expect(plan.Status.CompletionTimestamp).ToNot(BeNil())
+		testutils.ExpectEvent(recorder, StorageMigrationFailed)
+	})
+
+	It("should report the copy progress of the migrated volumes", func() {
+		addVMI(runningVMI("vm1", libvmistatus.WithMigrationState(virtv1.VirtualMachineInstanceMigrationState{
+			MigratedVolumesProgress: []virtv1.StorageMigratedVolumeProgress{
+				{VolumeName: volumeName, BytesCopied: 256, TotalBytes: 1024},
+			},
+		})))
+		addPlan(newPlan(&migrationsv1.VirtualMachineStorageMigrationPlanStatus{
+			Phase:   migrationsv1.StorageMigrationInProgress,
+			Volumes: []migrationsv1.StorageMigrationVolumeStatus{volumeStatus("vm1", migrationsv1.StorageMigrationInProgress)},
+		}, "vm1"))
+
+		plan := executePlan()
+		Expect(plan.Status.Volumes[0].Phase).To(Equal(migrationsv1.StorageMigrationInProgress))
+		Expect(plan.Status.Volumes[0].BytesCopied).To(BeEquivalentTo(256))
+	})
+
+	It("should fail the volumes when the storage migration is cancelled", func() {
+		addVMI(runningVMI("vm1", libvmistatus.WithCondition(virtv1.VirtualMachineInstanceCondition{
+			Type:    virtv1.VirtualMachineInstanceVolumesChange,
+			Status:  k8sv1.ConditionFalse,
+			Reason:  virtv1.VirtualMachineInstanceReasonVolumesChangeCancellation,
+			Message: "volumes reverted",
+		})))
+		addPlan(newPlan(&migrationsv1.VirtualMachineStorageMigrationPlanStatus{
+			Phase:   migrationsv1.StorageMigrationInProgress,
+			Volumes: []migrationsv1.StorageMigrationVolumeStatus{volumeStatus("vm1", migrationsv1.StorageMigrationInProgress)},
+		}, "vm1"))
+
+		plan := executePlan()
+		Expect(plan.Status.Volumes[0].Phase).To(Equal(migrationsv1.StorageMigrationFailed))
+		Expect(plan.Status.Volumes[0].Message).To(ContainSubstring("volumes reverted"))
+		Expect(plan.Status.Phase).To(Equal(migrationsv1.StorageMigrationFailed))
+	})
+
+	It("should complete the plan and delete the source claims once the volumes were moved", func() {
+		vmi := libvmi.New(
+			libvmi.WithNamespace(ns), libvmi.WithName("vm1"),
+			libvmi.WithPersistentVolumeClaim(volumeName, targetPVC+"-vm1"),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(virtv1.Running),
+				libvmistatus.WithMigrationState(virtv1.VirtualMachineInstanceMigrationState{Completed: true}),
+			)),
+		)
+		addVMI(vmi)
+		addPVC(newPVC(sourcePVC+"-vm1", oldSC))
+		plan := newPlan(&migrationsv1.VirtualMachineStorageMigrationPlanStatus{
+			Phase:   migrationsv1.StorageMigrationInProgress,
+			Volumes: []migrationsv1.StorageMigrationVolumeStatus{volumeStatus("vm1", migrationsv1.StorageMigrationInProgress)},
+		}, "vm1")
+		plan.Spec.DeleteSourcePVCs = pointer.P(true)
+		addPlan(plan)
+
+		plan = executePlan()
+		Expect(plan.Status.Volumes[0].Phase).To(Equal(migrationsv1.StorageMigrationSucceeded))
+		Expect(plan.Status.Volumes[0].BytesCopied).To(BeEquivalentTo(1024))
+		Expect(plan.Status.Phase).To(Equal(migrationsv1.StorageMigrationSucceeded))
+		Expect(plan.Status.CompletionTimestamp).ToNot(BeNil())
+		testutils.ExpectEvent(recorder, StorageMigrationCompleted)
+
+		_, err := k8sClient.CoreV1().PersistentVolumeClaims(ns).Get(context.Background(), sourcePVC+"-vm1", metav1.GetOptions{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should remove the bandwidth annotation once the volumes of a VirtualMachine were moved", func() {
+		vmi := libvmi.New(
+			libvmi.WithNamespace(ns), libvmi.WithName("vm1"),
+			libvmi.WithAnnotation(virtv1.StorageMigrationBandwidthAnnotation, "64Mi"),
+			libvmi.WithPersistentVolumeClaim(volumeName, targetPVC+"-vm1"),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(virtv1.Running),
+				libvmistatus.WithMigrationState(virtv1.VirtualMachineInstanceMigrationState{Completed: true}),
+			)),
+		)
+		addVMI(vmi)
+		plan := newPlan(&migrationsv1.VirtualMachineStorageMigrationPlanStatus{
+			Phase:   migrationsv1.StorageMigrationInProgress,
+			Volumes: []migrationsv1.StorageMigrationVolumeStatus{volumeStatus("vm1", migrationsv1.StorageMigrationInProgress)},
+		}, "vm1")
+		plan.Spec.BandwidthPerMigration = pointer.P(resource.MustParse("64Mi"))
+		addPlan(plan)
+
+		plan = executePlan()
+		Expect(plan.Status.Phase).To(Equal(migrationsv1.StorageMigrationSucceeded))
+
+		vmi, err := kubevirtClient.KubevirtV1().VirtualMachineInstances(ns).Get(context.Background(), "vm1", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmi.Annotations).ToNot(HaveKey(virtv1.StorageMigrationBandwidthAnnotation))
+	})
+})
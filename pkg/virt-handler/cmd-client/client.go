@@ -29,6 +29,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -49,6 +50,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/info"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	nbdv1 "kubevirt.io/kubevirt/pkg/storage/cbt/nbd/v1"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
@@ -114,6 +116,9 @@ type LauncherClient interface {
 	GetScreenshot(*v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
 	VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *backupv1.BackupOptions) error
 	RedefineCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *backupv1.BackupCheckpoint) (checkpointInvalid bool, err error)
+	DeleteCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *backupv1.BackupCheckpoint) error
+	MapBackupExport(ctx context.Context, exportName, bitmapName string, offset, length uint64) ([]backupv1.BackupExportExtent, error)
+	ReadBackupExport(ctx context.Context, exportName string, offset, length uint64, w io.Writer) error
 }
 
 type VirtLauncherClient struct {
@@ -836,3 +841,87 @@ func (c *VirtLauncherClient) RedefineCheckpoint(vmi *v1.VirtualMachineInstance,
 
 	return false, nil
 }
+
+func (c *VirtLauncherClient) DeleteCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *backupv1.BackupCheckpoint) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	checkpointJson, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.DeleteCheckpointRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Checkpoint: checkpointJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := c.v1client.DeleteCheckpoint(ctx, request)
+
+	err = handleError(err, "DeleteCheckpoint", response)
+	return err
+}
+
+// MapBackupExport returns the extents of the requested range of a pull mode
+// backup export, as reported by the NBD server of the backup job
+func (c *VirtLauncherClient) MapBackupExport(ctx context.Context, exportName, bitmapName string, offset, length uint64) ([]backupv1.BackupExportExtent, error) {
+	stream, err := nbdv1.NewNBDClient(c.conn).Map(ctx, &nbdv1.MapRequest{
+		ExportName: exportName,
+		BitmapName: bitmapName,
+		Offset:     offset,
+		Length:     length,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to map backup export %s: %w", exportName, err)
+	}
+
+	var extents []backupv1.BackupExportExtent
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return extents, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to map backup export %s: %w", exportName, err)
+		}
+		for _, extent := range response.GetExtents() {
+			extents = append(extents, backupv1.BackupExportExtent{
+				Offset:      extent.GetOffset(),
+				Length:      extent.GetLength(),
+				Flags:       extent.GetFlags(),
+				Description: extent.GetDescription(),
+			})
+		}
+	}
+}
+
+// ReadBackupExport copies the requested range of a pull mode backup export to w
+func (c *VirtLauncherClient) ReadBackupExport(ctx context.Context, exportName string, offset, length uint64, w io.Writer) error {
+	stream, err := nbdv1.NewNBDClient(c.conn).Read(ctx, &nbdv1.ReadRequest{
+		ExportName: exportName,
+		Offset:     offset,
+		Length:     length,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read backup export %s: %w", exportName, err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read backup export %s: %w", exportName, err)
+		}
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return err
+		}
+	}
+}
@@ -10,6 +10,8 @@
 package cmdclient
 
 import (
+	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -71,6 +73,20 @@ func (mr *MockLauncherClientMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockLauncherClient)(nil).Close))
 }
 
+// DeleteCheckpoint mocks base method.
+func (m *MockLauncherClient) DeleteCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *v1alpha1.BackupCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckpoint", vmi, checkpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckpoint indicates an expected call of DeleteCheckpoint.
+func (mr *MockLauncherClientMockRecorder) DeleteCheckpoint(vmi, checkpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckpoint", reflect.TypeOf((*MockLauncherClient)(nil).DeleteCheckpoint), vmi, checkpoint)
+}
+
 // DeleteDomain mocks base method.
 func (m *MockLauncherClient) DeleteDomain(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -337,6 +353,21 @@ func (mr *MockLauncherClientMockRecorder) KillVirtualMachine(vmi any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).KillVirtualMachine), vmi)
 }
 
+// MapBackupExport mocks base method.
+func (m *MockLauncherClient) MapBackupExport(ctx context.Context, exportName, bitmapName string, offset, length uint64) ([]v1alpha1.BackupExportExtent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MapBackupExport", ctx, exportName, bitmapName, offset, length)
+	ret0, _ := ret[0].([]v1alpha1.BackupExportExtent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MapBackupExport indicates an expected call of MapBackupExport.
+func (mr *MockLauncherClientMockRecorder) MapBackupExport(ctx, exportName, bitmapName, offset, length any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MapBackupExport", reflect.TypeOf((*MockLauncherClient)(nil).MapBackupExport), ctx, exportName, bitmapName, offset, length)
+}
+
 // MigrateVirtualMachine mocks base method.
 func (m *MockLauncherClient) MigrateVirtualMachine(vmi *v1.VirtualMachineInstance, options *MigrationOptions) error {
 	m.ctrl.T.Helper()
@@ -379,6 +410,20 @@ func (mr *MockLauncherClientMockRecorder) Ping() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockLauncherClient)(nil).Ping))
 }
 
+// ReadBackupExport mocks base method.
+func (m *MockLauncherClient) ReadBackupExport(ctx context.Context, exportName string, offset, length uint64, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadBackupExport", ctx, exportName, offset, length, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadBackupExport indicates an expected call of ReadBackupExport.
+func (mr *MockLauncherClientMockRecorder) ReadBackupExport(ctx, exportName, offset, length, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadBackupExport", reflect.TypeOf((*MockLauncherClient)(nil).ReadBackupExport), ctx, exportName, offset, length, w)
+}
+
 // RedefineCheckpoint mocks base method.
 func (m *MockLauncherClient) RedefineCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *v1alpha1.BackupCheckpoint) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,415 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package container_disk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	"kubevirt.io/kubevirt/pkg/safepath"
+)
+
+const (
+	cachedImageName = "disk.img"
+	cacheRefsDir    = "refs"
+	selinuxXattr    = "security.selinux"
+	copyBufferSize  = 1024 * 1024
+
+	// defaultCacheMinFreePercent is the share of the filesystem holding the cache which is kept free by evicting
+	// unreferenced images
+	defaultCacheMinFreePercent = 15
+)
+
+// imageCacheLock serializes the cache operations of all the mounters of virt-handler, the cache state itself is on disk.
+// Images are copied into the cache outside of it, under the lock of their key.
+var imageCacheLock sync.Mutex
+
+// imageCacheKeyLocks holds the locks of the keys being acquired, so that an image is only copied once, it is guarded
+// by imageCacheLock
+var imageCacheKeyLocks = map[string]*imageCacheKeyLock{}
+
+type imageCacheKeyLock struct {
+	sync.Mutex
+	users int
+}
+
+// lockImageCacheKey locks a key of the cache and returns the function unlocking it
+func lockImageCacheKey(key string) func() {
+	imageCacheLock.Lock()
+	lock, exists := imageCacheKeyLocks[key]
+	if !exists {
+		lock = &imageCacheKeyLock{}
+		imageCacheKeyLocks[key] = lock
+	}
+	lock.users++
+	imageCacheLock.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		imageCacheLock.Lock()
+		defer imageCacheLock.Unlock()
+		lock.users--
+		if lock.users == 0 {
+			delete(imageCacheKeyLocks, key)
+		}
+	}
+}
+
+// ImageCache is a node level cache of containerDisk base images, addressed by the image digest the container runtime
+// pulled. The cached images are shared read-only by all the VMIs on the node, they are reference counted per VMI and
+// the unreferenced images are evicted when the filesystem holding the cache runs low on space.
+// The cache doesn't avoid image pulls, the container runtime still pulls the image of every containerDisk container.
+type ImageCache interface {
+	// Acquire returns the cached copy of the source image for the given key, adding it to the cache if needed,
+	// and records a reference from the VMI
+	Acquire(vmiUID types.UID, key string, source *safepath.Path) (*safepath.Path, error)
+	// Release drops all the references of the VMI
+	Release(vmiUID types.UID) error
+}
+
+type freeSpaceFunc func(path string) (available uint64, total uint64, err error)
+
+type imageCache struct {
+	dir            string
+	minFreePercent uint64
+	freeSpace      freeSpaceFunc
+	copyImage      func(source, target string) error
+}
+
+func NewImageCache(dir string) ImageCache {
+	return &imageCache{
+		dir:            dir,
+		minFreePercent: defaultCacheMinFreePercent,
+		freeSpace:      statfsFreeSpace,
+		copyImage:      copyImage,
+	}
+}
+
+func (c *imageCache) Acquire(vmiUID types.UID, key string, source *safepath.Path) (*safepath.Path, error) {
+	unlockKey := lockImageCacheKey(key)
+	defer unlockKey()
+
+	entryDir := filepath.Join(c.dir, key)
+	image := filepath.Join(entryDir, cachedImageName)
+	cached, err := c.reference(vmiUID, entryDir, image, source)
+	if err != nil {
+		return nil, err
+	}
+	if cached {
+		metrics.IncContainerDiskCacheHits()
+	} else {
+		metrics.IncContainerDiskCacheMisses()
+		if err := c.add(image, source); err != nil {
+			c.dropReference(vmiUID, entryDir)
+			return nil, err
+		}
+	}
+
+	return safepath.JoinAndResolveWithRelativeRoot("/", image)
+}
+
+// reference records a reference from the VMI to the entry and returns whether the image is cached already. The
+// reference is recorded before the image is added, so that the entry isn't evicted while the image is copied.
+func (c *imageCache) reference(vmiUID types.UID, entryDir, image string, source *safepath.Path) (bool, error) {
+	imageCacheLock.Lock()
+	defer imageCacheLock.Unlock()
+
+	cached := true
+	if _, err := os.Stat(image); errors.Is(err, os.ErrNotExist) {
+		cached = false
+		sourceInfo, err := safepath.StatAtNoFollow(source)
+		if err != nil {
+			return false, err
+		}
+		c.evict(uint64(sourceInfo.Size()))
+	} else if err != nil {
+		return false, err
+	}
+
+	if err := os.MkdirAll(filepath.Join(entryDir, cacheRefsDir), 0700); err != nil {
+		return false, err
+	}
+	ref, err := os.OpenFile(filepath.Join(entryDir, cacheRefsDir, string(vmiUID)), os.O_CREATE|os.O_RDONLY, 0600)
+	if err != nil {
+		return false, err
+	}
+	_ = ref.Close()
+	// The modification time of the entry is used to evict the least recently used images first
+	now := time.Now()
+	if err := os.Chtimes(entryDir, now, now); err != nil {
+		return false, err
+	}
+	return cached, nil
+}
+
+func (c *imageCache) dropReference(vmiUID types.UID, entryDir string) {
+	imageCacheLock.Lock()
+	defer imageCacheLock.Unlock()
+
+	err := os.Remove(filepath.Join(entryDir, cacheRefsDir, string(vmiUID)))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Log.Reason(err).Warningf("failed to drop the reference of VMI %s to containerDisk image %s", vmiUID, filepath.Base(entryDir))
+	}
+}
+
+// add copies the image next to its final path and only takes imageCacheLock to rename it
+func (c *imageCache) add(image string, source *safepath.Path) error {
+	tmpImage := image + ".tmp"
+	err := source.ExecuteNoFollow(func(path string) error {
+		if err := c.copyImage(path, tmpImage); err != nil {
+			return err
+		}
+		return copySELinuxLabel(path, tmpImage)
+	})
+	if err != nil {
+		_ = os.Remove(tmpImage)
+		return fmt.Errorf("failed to add the containerDisk image to the cache: %v", err)
+	}
+
+	imageCacheLock.Lock()
+	defer imageCacheLock.Unlock()
+	return os.Rename(tmpImage, image)
+}
+
+func (c *imageCache) Release(vmiUID types.UID) error {
+	imageCacheLock.Lock()
+	defer imageCacheLock.Unlock()
+
+	entries, err := os.ReadDir(c.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		err := os.Remove(filepath.Join(c.dir, entry.Name(), cacheRefsDir, string(vmiUID)))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	c.evict(0)
+	return nil
+}
+
+type cacheEntry struct {
+	dir     string
+	lastUse time.Time
+}
+
+// evict removes the least recently used unreferenced images until the required space is available
+// above the free space threshold
+func (c *imageCache) evict(required uint64) {
+	entries, err := c.unreferencedEntries()
+	if err != nil {
+		log.Log.Reason(err).Warning("failed to list the containerDisk cache entries")
+		return
+	}
+	for _, entry := range entries {
+		available, total, err := c.freeSpace(c.dir)
+		if err != nil {
+			log.Log.Reason(err).Warning("failed to check the free space of the containerDisk cache")
+			return
+		}
+		if available >= required && (available-required)*100 >= total*c.minFreePercent {
+			return
+		}
+		log.Log.Infof("evicting containerDisk image %s from the cache", filepath.Base(entry.dir))
+		if err := os.RemoveAll(entry.dir); err != nil {
+			log.Log.Reason(err).Warningf("failed to evict containerDisk image %s from the cache", filepath.Base(entry.dir))
+			continue
+		}
+		metrics.IncContainerDiskCacheEvictions()
+	}
+}
+
+func (c *imageCache) unreferencedEntries() ([]cacheEntry, error) {
+	dirEntries, err := os.ReadDir(c.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var entries []cacheEntry
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(c.dir, dirEntry.Name())
+		refs, err := os.ReadDir(filepath.Join(dir, cacheRefsDir))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		if len(refs) > 0 {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, cacheEntry{dir: dir, lastUse: info.ModTime()})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastUse.Before(entries[j].lastUse)
+	})
+	return entries, nil
+}
+
+// imageCacheKey returns the address of a containerDisk image in the cache, made of the image digest and the path of
+// the disk inside the image. Images referenced by tag are addressed by the digest the container runtime pulled, as
+// recorded by virt-controller in the volume status. It returns an empty key while that digest isn't known yet.
+func imageCacheKey(vmi *v1.VirtualMachineInstance, volume *v1.Volume) string {
+	image := volume.ContainerDisk.Image
+	if !strings.Contains(image, "@sha256:") {
+		image = ""
+		for _, status := range vmi.Status.VolumeStatus {
+			if status.Name == volume.Name && status.ContainerDiskVolume != nil {
+				image = status.ContainerDiskVolume.ImageID
+			}
+		}
+	}
+	_, digest, found := strings.Cut(image, "@")
+	if !found {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%s", digest, volume.ContainerDisk.Path)))
+	return hex.EncodeToString(hash[:])
+}
+
+// copyImage clones the image when the filesystem supports reflinks, and otherwise copies its data without filling
+// the holes, so that thin images stay thin in the cache
+func copyImage(source, target string) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0444)
+	if err != nil {
+		return err
+	}
+	if err := unix.IoctlFileClone(int(out.Fd()), int(in.Fd())); err != nil {
+		if err := copySparse(in, out, info.Size()); err != nil {
+			_ = out.Close()
+			return err
+		}
+	}
+	if err := out.Sync(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// copySparse copies the data segments of the source and skips the holes as well as the zeroed blocks
+func copySparse(in, out *os.File, size int64) error {
+	buf := make([]byte, copyBufferSize)
+	for offset := int64(0); offset < size; {
+		start, end, err := nextDataSegment(in, offset, size)
+		if err != nil {
+			return err
+		}
+		for offset = start; offset < end; {
+			n, err := in.ReadAt(buf[:min(int64(len(buf)), end-offset)], offset)
+			if n == 0 && err != nil {
+				return err
+			}
+			if !isZero(buf[:n]) {
+				if _, err := out.WriteAt(buf[:n], offset); err != nil {
+					return err
+				}
+			}
+			offset += int64(n)
+		}
+	}
+	return out.Truncate(size)
+}
+
+// nextDataSegment returns the bounds of the first data segment of the file after offset, or an empty segment at the
+// end of the file if there is no data left. Filesystems not reporting holes have a single data segment
+func nextDataSegment(f *os.File, offset, size int64) (int64, int64, error) {
+	start, err := unix.Seek(int(f.Fd()), offset, unix.SEEK_DATA)
+	if errors.Is(err, unix.ENXIO) {
+		return size, size, nil
+	} else if errors.Is(err, unix.EINVAL) || errors.Is(err, unix.EOPNOTSUPP) {
+		return offset, size, nil
+	} else if err != nil {
+		return 0, 0, err
+	}
+	end, err := unix.Seek(int(f.Fd()), start, unix.SEEK_HOLE)
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+func isZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// copySELinuxLabel keeps the label of the image inside the containerDisk, so that qemu can read the cached copy
+func copySELinuxLabel(source, target string) error {
+	label := make([]byte, 256)
+	n, err := unix.Getxattr(source, selinuxXattr, label)
+	if errors.Is(err, unix.ENODATA) || errors.Is(err, unix.ENOTSUP) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return unix.Setxattr(target, selinuxXattr, label[:n], 0)
+}
+
+func statfsFreeSpace(path string) (uint64, uint64, error) {
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return 0, 0, err
+	}
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, err
+	}
+	return stat.Bavail * uint64(stat.Bsize), stat.Blocks * uint64(stat.Bsize), nil
+}
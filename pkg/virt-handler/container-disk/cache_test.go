@@ -0,0 +1,257 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package container_disk
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/unsafepath"
+)
+
+var _ = Describe("ContainerDisk image cache", func() {
+	var (
+		cacheDir  string
+		sourceDir string
+		cache     *imageCache
+	)
+
+	const total = 1000
+
+	newSource := func(name, content string) *safepath.Path {
+		path := filepath.Join(sourceDir, name)
+		Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+		source, err := safepath.JoinAndResolveWithRelativeRoot("/", path)
+		Expect(err).ToNot(HaveOccurred())
+		return source
+	}
+
+	newVolume := func(image string) *v1.Volume {
+		return &v1.Volume{
+			Name:         "disk",
+			VolumeSource: v1.VolumeSource{ContainerDisk: &v1.ContainerDiskSource{Image: image, Path: "/disk/disk.qcow2"}},
+		}
+	}
+
+	newVMI := func(imageID string) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			Status: v1.VirtualMachineInstanceStatus{
+				VolumeStatus: []v1.VolumeStatus{{
+					Name:                "disk",
+					ContainerDiskVolume: &v1.ContainerDiskInfo{ImageID: imageID},
+				}},
+			},
+		}
+	}
+
+	acquire := func(vmiUID types.UID, content string) string {
+		source := newSource(string(vmiUID), content)
+		key := imageCacheKey(newVMI("registry:5000/disk@sha256:"+content), newVolume("registry:5000/disk:latest"))
+		Expect(key).ToNot(BeEmpty())
+		image, err := cache.Acquire(vmiUID, key, source)
+		Expect(err).ToNot(HaveOccurred())
+		return unsafepath.UnsafeAbsolute(image.Raw())
+	}
+
+	BeforeEach(func() {
+		cacheDir = GinkgoT().TempDir()
+		sourceDir = GinkgoT().TempDir()
+		cache = &imageCache{
+			dir:            cacheDir,
+			minFreePercent: 15,
+			freeSpace: func(string) (uint64, uint64, error) {
+				return total, total, nil
+			},
+			copyImage: copyImage,
+		}
+	})
+
+	It("should share the cached image between VMIs with the same image digest", func() {
+		first := acquire("vmi1", "content")
+		second := acquire("vmi2", "content")
+		Expect(second).To(Equal(first))
+
+		entries, err := os.ReadDir(cacheDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+
+		refs, err := os.ReadDir(filepath.Join(cacheDir, entries[0].Name(), cacheRefsDir))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(refs).To(HaveLen(2))
+
+		data, err := os.ReadFile(filepath.Join(cacheDir, entries[0].Name(), cachedImageName))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("content"))
+	})
+
+	It("should drop the references of a VMI on release", func() {
+		acquire("vmi1", "content")
+		acquire("vmi2", "content")
+		Expect(cache.Release("vmi1")).To(Succeed())
+
+		entries, err := os.ReadDir(cacheDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		refs, err := os.ReadDir(filepath.Join(cacheDir, entries[0].Name(), cacheRefsDir))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(refs).To(HaveLen(1))
+		Expect(refs[0].Name()).To(Equal("vmi2"))
+	})
+
+	It("should keep unreferenced images while there is enough free space", func() {
+		acquire("vmi1", "content")
+		Expect(cache.Release("vmi1")).To(Succeed())
+
+		entries, err := os.ReadDir(cacheDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("should evict the least recently used unreferenced images under disk pressure", func() {
+		oldImage := acquire("vmi1", "old")
+		acquire("vmi2", "recent")
+		acquire("vmi3", "in-use")
+		Expect(cache.Release("vmi1")).To(Succeed())
+		Expect(cache.Release("vmi2")).To(Succeed())
+
+		old := filepath.Base(filepath.Dir(oldImage))
+		past := time.Now().Add(-time.Hour)
+		Expect(os.Chtimes(filepath.Join(cacheDir, old), past, past)).To(Succeed())
+
+		// Every cached image takes 30% of the filesystem
+		cache.freeSpace = func(string) (uint64, uint64, error) {
+			entries, err := os.ReadDir(cacheDir)
+			if err != nil {
+				return 0, 0, err
+			}
+			return total - uint64(len(entries))*300, total, nil
+		}
+		cache.evict(0)
+
+		remaining, err := os.ReadDir(cacheDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remaining).To(HaveLen(2))
+		for _, entry := range remaining {
+			Expect(entry.Name()).ToNot(Equal(old))
+		}
+	})
+
+	It("should never evict referenced images", func() {
+		acquire("vmi1", "content")
+		cache.freeSpace = func(string) (uint64, uint64, error) {
+			return 0, total, nil
+		}
+		cache.evict(0)
+
+		entries, err := os.ReadDir(cacheDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("should copy the image without holding the cache lock nor letting the entry be evicted", func() {
+		cache.copyImage = func(source, target string) error {
+			Expect(imageCacheLock.TryLock()).To(BeTrue())
+			defer imageCacheLock.Unlock()
+
+			cache.freeSpace = func(string) (uint64, uint64, error) {
+				return 0, total, nil
+			}
+			cache.evict(0)
+			_, err := os.Stat(filepath.Dir(target))
+			Expect(err).ToNot(HaveOccurred())
+			return copyImage(source, target)
+		}
+
+		image := acquire("vmi1", "content")
+		data, err := os.ReadFile(image)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("content"))
+		Expect(imageCacheKeyLocks).To(BeEmpty())
+	})
+
+	It("should drop the reference of the VMI when the image can't be copied", func() {
+		cache.copyImage = func(string, string) error {
+			return errors.New("copy failed")
+		}
+		source := newSource("vmi1", "content")
+		key := imageCacheKey(newVMI("registry:5000/disk@sha256:content"), newVolume("registry:5000/disk:latest"))
+		_, err := cache.Acquire("vmi1", key, source)
+		Expect(err).To(MatchError(ContainSubstring("copy failed")))
+
+		refs, err := os.ReadDir(filepath.Join(cacheDir, key, cacheRefsDir))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(refs).To(BeEmpty())
+		Expect(filepath.Join(cacheDir, key, cachedImageName+".tmp")).ToNot(BeAnExistingFile())
+	})
+
+	It("should address images referenced by digest by their digest and path", func() {
+		volume := newVolume("registry:5000/disk@sha256:0123456789abcdef")
+		first := imageCacheKey(newVMI(""), volume)
+		Expect(first).ToNot(BeEmpty())
+		Expect(imageCacheKey(newVMI("registry:5000/disk@sha256:fedcba9876543210"), volume)).To(Equal(first))
+
+		volume.ContainerDisk.Path = "/disk/other.qcow2"
+		Expect(imageCacheKey(newVMI(""), volume)).ToNot(Equal(first))
+	})
+
+	It("should address images referenced by tag by the digest the container runtime pulled", func() {
+		volume := newVolume("registry:5000/disk:latest")
+		Expect(imageCacheKey(newVMI(""), volume)).To(BeEmpty())
+
+		first := imageCacheKey(newVMI("registry:5000/disk@sha256:0123456789abcdef"), volume)
+		Expect(first).ToNot(BeEmpty())
+		Expect(imageCacheKey(newVMI("registry:5000/disk@sha256:fedcba9876543210"), volume)).ToNot(Equal(first))
+	})
+
+	It("should keep the holes of thin images", func() {
+		const size = 4 * 1024 * 1024
+		source := filepath.Join(sourceDir, "thin")
+		f, err := os.Create(source)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Truncate(size)).To(Succeed())
+		_, err = f.WriteAt([]byte("data"), size/2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+
+		target := filepath.Join(cacheDir, "thin")
+		Expect(copyImage(source, target)).To(Succeed())
+
+		sourceData, err := os.ReadFile(source)
+		Expect(err).ToNot(HaveOccurred())
+		targetData, err := os.ReadFile(target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(targetData).To(Equal(sourceData))
+
+		var stat syscall.Stat_t
+		Expect(syscall.Stat(target, &stat)).To(Succeed())
+		Expect(stat.Blocks * 512).To(BeNumerically("<", size))
+	})
+})
@@ -72,6 +72,7 @@ type mounter struct {
 	kernelBootSocketPathGetter containerdisk.KernelBootSocketPathGetter
 	clusterConfig              *virtconfig.ClusterConfig
 	nodeIsolationResult        isolation.IsolationResult
+	imageCache                 ImageCache
 }
 
 type Mounter interface {
@@ -121,6 +122,7 @@ func NewMounter(isoDetector isolation.PodIsolationDetector, mountStateDir string
 		kernelBootSocketPathGetter: containerdisk.NewKernelBootSocketPathGetter(""),
 		clusterConfig:              clusterConfig,
 		nodeIsolationResult:        isolation.NodeIsolationResult(),
+		imageCache:                 NewImageCache(util.ContainerDiskCacheDir),
 	}
 }
 
@@ -313,6 +315,13 @@ func (m *mounter) MountAndVerify(vmi *v1.VirtualMachineInstance) error {
 					return fmt.Errorf("failed to find a sourceFile in containerDisk %v: %v", volume.Name, err)
 				}
 
+				if m.imageCache != nil && m.clusterConfig.ContainerDiskCacheEnabled() {
+					sourceFile, err = m.getCachedContainerDiskPath(vmi, &volume, sourceFile)
+					if err != nil {
+						return fmt.Errorf("failed to get containerDisk %v from the node cache: %v", volume.Name, err)
+					}
+				}
+
 				log.DefaultLogger().Object(vmi).Infof("Bind mounting container disk at %s to %s", sourceFile, targetFile)
 				out, err := virt_chroot.MountChroot(sourceFile, targetFile, true).CombinedOutput()
 				if err != nil {
@@ -377,6 +386,12 @@ func (m *mounter) Unmount(vmi *v1.VirtualMachineInstance) error {
 		return err
 	}
 
+	if m.imageCache != nil {
+		if err := m.imageCache.Release(vmi.UID); err != nil {
+			return fmt.Errorf("failed to release the cached containerDisk images: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -646,6 +661,17 @@ func (m *mounter) getContainerDiskPath(vmi *v1.VirtualMachineInstance, volume *v
 	return containerdisk.GetImage(mountPoint, volume.ContainerDisk.Path)
 }
 
+// getCachedContainerDiskPath returns the copy of the containerDisk image shared through the node cache. The image of
+// the containerDisk container is used directly while its digest isn't known
+func (m *mounter) getCachedContainerDiskPath(vmi *v1.VirtualMachineInstance, volume *v1.Volume, sourceFile *safepath.Path) (*safepath.Path, error) {
+	key := imageCacheKey(vmi, volume)
+	if key == "" {
+		log.DefaultLogger().Object(vmi).Infof("the digest of containerDisk %s is not known, not using the node cache", volume.Name)
+		return sourceFile, nil
+	}
+	return m.imageCache.Acquire(vmi.UID, key, sourceFile)
+}
+
 func (m *mounter) getKernelArtifactPaths(vmi *v1.VirtualMachineInstance) (*kernelArtifacts, error) {
 	sock, err := m.kernelBootSocketPathGetter(vmi)
 	if err != nil {
@@ -91,6 +91,10 @@ const (
 
 type netconf interface {
 	Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, launcherPid int) error
+	SetupFirewall(vmi *v1.VirtualMachineInstance, launcherPid int) error
+	SetupBandwidth(vmi *v1.VirtualMachineInstance, launcherPid int) error
+	PublishPreservedAddresses(vmi *v1.VirtualMachineInstance, launcherPid int) error
+	ReportPreservedAddresses(vmi *v1.VirtualMachineInstance) error
 	Teardown(vmi *v1.VirtualMachineInstance) error
 }
 
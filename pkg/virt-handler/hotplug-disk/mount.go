@@ -40,6 +40,7 @@ import (
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 
 	"github.com/opencontainers/runc/libcontainer/configs"
 
@@ -304,7 +305,12 @@ func (m *volumeMounter) mountHotplugVolume(
 	logger := log.DefaultLogger()
 	logger.V(4).Infof("Hotplug check volume name: %s", volumeName)
 	if sourceUID != "" {
-		if m.isBlockVolume(&vmi.Status, volumeName) {
+		if storagetypes.IsHotplugFilesystem(vmi, volumeName) {
+			logger.V(3).Infof("Mounting virtiofs socket of filesystem: %s", volumeName)
+			if err := m.mountVirtiofsHotplugVolume(vmi, volumeName, sourceUID, record); err != nil {
+				return fmt.Errorf("failed to mount virtiofs socket of hotplug filesystem %s: %w", volumeName, err)
+			}
+		} else if m.isBlockVolume(&vmi.Status, volumeName) {
 			logger.V(3).Infof("Mounting block volume: %s", volumeName)
 			if err := m.mountBlockHotplugVolume(vmi, volumeName, sourceUID, record, cgroupManager); err != nil {
 				return fmt.Errorf("failed to mount block hotplug volume %s: %w", volumeName, err)
@@ -564,6 +570,46 @@ func (m *volumeMounter) mountFileSystemHotplugVolume(vmi *v1.VirtualMachineInsta
 	return m.ownershipManager.SetFileOwnership(target)
 }
 
+// mountVirtiofsHotplugVolume bind mounts the socket of the virtiofsd serving a hotplugged filesystem
+// from its attachment pod into the hotplug directory of virt-launcher.
+func (m *volumeMounter) mountVirtiofsHotplugVolume(vmi *v1.VirtualMachineInstance, volume string, sourceUID types.UID, record *vmiMountTargetRecord) error {
+	virtlauncherUID := m.findVirtlauncherUID(vmi)
+	if virtlauncherUID == "" {
+		// This is not the node the pod is running on.
+		return nil
+	}
+	target, err := m.hotplugDiskManager.GetFileSystemSocketTargetPathFromHostView(virtlauncherUID, volume, true)
+	if err != nil {
+		return err
+	}
+
+	isMounted, err := isMounted(target)
+	if err != nil {
+		return fmt.Errorf("failed to determine if %s is already mounted: %v", target, err)
+	}
+	if !isMounted {
+		basePath, err := deviceBasePath(sourceUID, m.kubeletPodsDir)
+		if err != nil {
+			return err
+		}
+		sourcePath, err := basePath.AppendAndResolveWithRelativeRoot(virtiofs.HotplugVirtioFSSocketName(volume))
+		if err != nil {
+			log.DefaultLogger().V(3).Infof("Error getting virtiofs socket path: %v", err)
+			// virtiofsd might not have created its socket yet, try again on the next sync.
+			return nil
+		}
+		if err := m.writePathToMountRecord(unsafepath.UnsafeAbsolute(target.Raw()), vmi, record); err != nil {
+			return err
+		}
+		if out, err := mountCommand(sourcePath, target); err != nil {
+			return fmt.Errorf("failed to bindmount virtiofs socket from %v to %v: %v : %v", sourcePath, target, string(out), err)
+		}
+		log.DefaultLogger().V(1).Infof("successfully mounted virtiofs socket of %v", volume)
+	}
+
+	return m.ownershipManager.SetFileOwnership(target)
+}
+
 func (m *volumeMounter) findVirtlauncherUID(vmi *v1.VirtualMachineInstance) (uid types.UID) {
 	cnt := 0
 	for podUID := range vmi.Status.ActivePods {
@@ -674,7 +720,13 @@ func (m *volumeMounter) Unmount(vmi *v1.VirtualMachineInstance, cgroupManager cg
 			}
 			var path *safepath.Path
 			var err error
-			if m.isBlockVolume(&vmi.Status, volume.Name) {
+			if storagetypes.IsHotplugFilesystem(vmi, volume.Name) {
+				path, err = m.hotplugDiskManager.GetFileSystemSocketTargetPathFromHostView(virtlauncherUID, volume.Name, false)
+				if errors.Is(err, os.ErrNotExist) {
+					// already unmounted or never mounted
+					continue
+				}
+			} else if m.isBlockVolume(&vmi.Status, volume.Name) {
 				path, err = safepath.JoinNoFollow(basePath, volume.Name)
 				if errors.Is(err, os.ErrNotExist) {
 					// already unmounted or never mounted
@@ -846,6 +898,16 @@ func (m *volumeMounter) IsMounted(vmi *v1.VirtualMachineInstance, volume string,
 		}
 		return false, err
 	}
+	if storagetypes.IsHotplugFilesystem(vmi, volume) {
+		path, err := safepath.JoinNoFollow(targetPath, virtiofs.HotplugVirtioFSSocketName(volume))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return false, nil
+			}
+			return false, err
+		}
+		return isMounted(path)
+	}
 	if m.isBlockVolume(&vmi.Status, volume) {
 		deviceName, err := safepath.JoinNoFollow(targetPath, volume)
 		if err != nil {
@@ -693,6 +693,42 @@ var _ = Describe("HotplugVolume", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should bind mount the virtiofs socket of a hotplugged filesystem", func() {
+			socketFile, err := newFile(filepath.Join(tempDir, "volumes"), "testvolume.sock")
+			Expect(err).ToNot(HaveOccurred())
+			targetFilePath, err := newFile(unsafepath.UnsafeAbsolute(targetPodPath.Raw()), "testvolume.sock")
+			Expect(err).ToNot(HaveOccurred())
+			isMounted = func(path *safepath.Path) (bool, error) {
+				Expect(path).To(Equal(targetFilePath))
+				return false, nil
+			}
+			mountCommand = func(sourcePath, targetPath *safepath.Path) ([]byte, error) {
+				Expect(unsafepath.UnsafeAbsolute(sourcePath.Raw())).To(Equal(unsafepath.UnsafeAbsolute(socketFile.Raw())))
+				Expect(targetPath).To(Equal(targetFilePath))
+				return []byte("Success"), nil
+			}
+			ownershipManager.EXPECT().SetFileOwnership(targetFilePath)
+
+			err = m.mountVirtiofsHotplugVolume(vmi, "testvolume", "ghfjk", record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record.MountTargetEntries).To(HaveLen(1))
+			Expect(record.MountTargetEntries[0].TargetFile).To(Equal(unsafepath.UnsafeAbsolute(targetFilePath.Raw())))
+		})
+
+		It("should wait for virtiofsd to create the socket of a hotplugged filesystem", func() {
+			isMounted = func(path *safepath.Path) (bool, error) {
+				return false, nil
+			}
+			mountCommand = func(sourcePath, targetPath *safepath.Path) ([]byte, error) {
+				Fail("socket should not be mounted")
+				return nil, nil
+			}
+
+			err = m.mountVirtiofsHotplugVolume(vmi, "testvolume", "ghfjk", record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record.MountTargetEntries).To(BeEmpty())
+		})
+
 		It("unmountFileSystemHotplugVolumes should return error if isMounted returns error", func() {
 			testPath, err := newFile(tempDir, "test")
 			Expect(err).ToNot(HaveOccurred())
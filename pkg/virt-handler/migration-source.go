@@ -40,6 +40,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/controller"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -59,6 +60,7 @@ type passtRepairSourceHandler interface {
 type MigrationSourceController struct {
 	*BaseController
 	vmiExpectations    *controller.UIDTrackingControllerExpectations
+	netConf            netconf
 	passtRepairHandler passtRepairSourceHandler
 }
 
@@ -73,6 +75,7 @@ func NewMigrationSourceController(
 	podIsolationDetector isolation.PodIsolationDetector,
 	migrationProxy migrationproxy.ProxyManager,
 	virtLauncherFSRunDirPattern string,
+	netConf netconf,
 	netStat netstat,
 	passtRepairHandler passtRepairSourceHandler,
 ) (*MigrationSourceController, error) {
@@ -105,6 +108,7 @@ func NewMigrationSourceController(
 	c := &MigrationSourceController{
 		BaseController:     baseCtrl,
 		vmiExpectations:    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		netConf:            netConf,
 		passtRepairHandler: passtRepairHandler,
 	}
 
@@ -204,6 +208,13 @@ func (c *MigrationSourceController) setMigrationProgressStatus(vmi *v1.VirtualMa
 	}
 
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+
+	if migrationMetadata.Volumes != "" {
+		var volumes []v1.StorageMigratedVolumeProgress
+		if err := json.Unmarshal([]byte(migrationMetadata.Volumes), &volumes); err == nil {
+			vmi.Status.MigrationState.MigratedVolumesProgress = volumes
+		}
+	}
 }
 
 func (c *MigrationSourceController) updateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -491,6 +502,10 @@ func (c *MigrationSourceController) migrateVMI(vmi *v1.VirtualMachineInstance, d
 		return nil
 	}
 
+	if err = c.publishPreservedAddresses(vmi); err != nil {
+		return fmt.Errorf("failed to publish the guest addresses to preserve: %v", err)
+	}
+
 	err = c.handleSourceMigrationProxy(vmi)
 	if errors.Is(err, errWaitingForTargetPorts) {
 		c.logger.Object(vmi).V(4).Info("waiting for target node to publish migration ports")
@@ -554,6 +569,18 @@ func (c *MigrationSourceController) migrateVMI(vmi *v1.VirtualMachineInstance, d
 	return nil
 }
 
+// publishPreservedAddresses lets the migration target know the addresses the guest keeps across the migration.
+func (c *MigrationSourceController) publishPreservedAddresses(vmi *v1.VirtualMachineInstance) error {
+	if !netsetup.IsWaitingForPreservedAddresses(vmi) {
+		return nil
+	}
+	res, err := c.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return err
+	}
+	return c.netConf.PublishPreservedAddresses(vmi, res.Pid())
+}
+
 func isMigrationDone(state *v1.VirtualMachineInstanceMigrationState) bool {
 	return state == nil || (state.EndTimestamp != nil && (state.Completed || state.Failed))
 }
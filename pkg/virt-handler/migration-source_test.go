@@ -197,6 +197,7 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 			mockIsolationDetector,
 			migrationProxy,
 			"/tmp/%d",
+			&netConfStub{},
 			&netStatStub{},
 			migrationSourcePasstRepairHandler,
 		)
@@ -359,6 +360,26 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 				d.Spec.Metadata.KubeVirt.Migration.AbortStatus)))
 		})
 
+		It("should report the copy progress of the migrated volumes", func() {
+			d := newDomainMigrationKubevirtMetadata("1234", nil, false, false, v1.MigrationPreCopy)
+			d.Spec.Metadata.KubeVirt.Migration.Volumes = `[{"volumeName":"datavol","bytesCopied":512,"totalBytes":2048}]`
+			vmi := libvmi.New(libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithMigrationState(v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:      "1234",
+					SourceNode:        host,
+					TargetNodeAddress: "othernode",
+				}), libvmistatus.WithNodeName(host)),
+			))
+
+			controller.setMigrationProgressStatus(vmi, d)
+
+			Expect(vmi.Status.MigrationState.MigratedVolumesProgress).To(ConsistOf(v1.StorageMigratedVolumeProgress{
+				VolumeName:  "datavol",
+				BytesCopied: 512,
+				TotalBytes:  2048,
+			}))
+		})
+
 		It("should send an event if the migration failed", func() {
 			d := newDomainMigrationKubevirtMetadata("1234", pointer.P(metav1.NewTime(time.Now())),
 				true, true, v1.MigrationPreCopy)
@@ -602,6 +623,55 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(updatedVMI.Status.Interfaces[0].InterfaceName).To(Equal(testIfaceName))
 	})
+
+	It("should publish the guest addresses to preserve before migrating the vmi", func() {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.UID = vmiTestUUID
+		vmi.ObjectMeta.ResourceVersion = "1"
+		vmi.Status.Phase = v1.Running
+		vmi.Status.NodeName = host
+		vmi.Labels = map[string]string{v1.MigrationTargetNodeNameLabel: "othernode"}
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name: v1.DefaultPodNetwork().Name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				Bridge: &v1.InterfaceBridge{MigrationMode: v1.BridgeMigrationModePreserveIP},
+			},
+		}}
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetNode:                     "othernode",
+			TargetNodeAddress:              "127.0.0.1:12345",
+			SourceNode:                     host,
+			MigrationUID:                   "123",
+			TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+		}
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+			{
+				Type:   v1.VirtualMachineInstanceIsMigratable,
+				Status: k8sv1.ConditionTrue,
+			},
+		}
+		vmi = addActivePods(vmi, podTestUUID, host)
+
+		domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+		domain.Status.Status = api.Running
+		addVMI(vmi, domain)
+
+		migratedInterface := v1.MigratedInterfaceState{
+			Name:         v1.DefaultPodNetwork().Name,
+			Phase:        v1.MigratedInterfacePending,
+			GuestIP:      "10.0.2.2/24",
+			GuestGateway: "10.0.2.1",
+		}
+		controller.netConf = &netConfStub{MigratedInterfaces: []v1.MigratedInterfaceState{migratedInterface}}
+
+		client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any())
+		sanityExecute()
+		testutils.ExpectEvent(recorder, VMIMigrating)
+		updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updatedVMI.Status.MigrationState.MigratedInterfaces).To(ConsistOf(migratedInterface))
+	})
 })
 
 type stubSourcePasstRepairHandler struct {
@@ -219,6 +219,10 @@ func (c *MigrationTargetController) updateStatus(vmi *v1.VirtualMachineInstance,
 		return nil
 	}
 
+	if err := c.netConf.ReportPreservedAddresses(vmi); err != nil {
+		return err
+	}
+
 	domainExists := domain != nil
 
 	// detect domain on target node
@@ -726,6 +730,13 @@ func (c *MigrationTargetController) processVMI(vmi *v1.VirtualMachineInstance) e
 		return err
 	}
 
+	// The guest addresses are not synchronized across clusters, a decentralized migration does not preserve them.
+	if !vmi.IsDecentralizedMigration() && netsetup.IsWaitingForPreservedAddresses(vmi) {
+		c.logger.Object(vmi).V(4).Info("waiting for the migration source to publish the guest addresses to preserve")
+		c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), time.Second*1)
+		return nil
+	}
+
 	if err := c.setupNetwork(vmi, netsetup.FilterNetsForMigrationTarget(vmi), c.netConf); err != nil {
 		return fmt.Errorf("failed to configure vmi network for migration target: %w", err)
 	}
@@ -296,6 +296,43 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		Expect(migrationTargetPasstRepairHandler.isHandleMigrationTargetCalled).Should(BeTrue())
 	})
 
+	It("should wait for the migration source to publish the guest addresses to preserve", func() {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.UID = vmiTestUUID
+		vmi.ObjectMeta.ResourceVersion = "1"
+		vmi.Status.Phase = v1.Running
+		vmi.Labels = map[string]string{v1.MigrationTargetNodeNameLabel: host}
+		vmi.Status.NodeName = "othernode"
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name: v1.DefaultPodNetwork().Name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				Bridge: &v1.InterfaceBridge{MigrationMode: v1.BridgeMigrationModePreserveIP},
+			},
+		}}
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetNode:   host,
+			SourceNode:   "othernode",
+			MigrationUID: "123",
+		}
+		vmi = addActivePods(vmi, podTestUUID, host)
+
+		Expect(os.MkdirAll(cmdclient.SocketDirectoryOnHost(string(podTestUUID)), os.ModePerm)).To(Succeed())
+		socketFile := cmdclient.SocketFilePathOnHost(string(podTestUUID))
+		Expect(os.RemoveAll(socketFile)).To(Succeed())
+		socket, err := net.Listen("unix", socketFile)
+		Expect(err).NotTo(HaveOccurred())
+		defer socket.Close()
+
+		createVMI(vmi)
+		sanityExecute()
+		Expect(mockQueue.GetAddAfterEnqueueCount()).To(BeNumerically(">", 0))
+		Expect(migrationTargetPasstRepairHandler.isHandleMigrationTargetCalled).Should(BeFalse())
+		updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updatedVMI.Status.MigrationState.TargetNodeAddress).To(BeEmpty())
+	})
+
 	It("should abort target prep if VMI is deleted", func() {
 		vmi := api2.NewMinimalVMI("testvmi")
 		vmi.UID = vmiTestUUID
@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
+)
+
+func (lh *LifecycleHandler) BackupMapHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	req, err := cbt.ParseBackupExportRequest(request.Request.URL.Query(), 0)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Invalid backup export map request")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	extents, err := client.MapBackupExport(request.Request.Context(), req.ExportName, req.BitmapName, req.Offset, req.Length)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to map backup export")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteAsJson(extents)
+}
+
+func (lh *LifecycleHandler) BackupReadHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	req, err := cbt.ParseBackupExportRequest(request.Request.URL.Query(), cbt.MaxBackupExportReadLength)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Invalid backup export read request")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	response.AddHeader("Content-Type", restful.MIME_OCTET)
+	writer := &backupExportWriter{writer: response}
+	if err := client.ReadBackupExport(request.Request.Context(), req.ExportName, req.Offset, req.Length, writer); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to read backup export")
+		if !writer.written {
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+		// The status and part of the data are already sent, abort the
+		// connection so the truncated body is not taken for a complete one
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// backupExportWriter records whether any data of a backup export was sent
+type backupExportWriter struct {
+	writer  io.Writer
+	written bool
+}
+
+func (w *backupExportWriter) Write(p []byte) (int, error) {
+	w.written = true
+	return w.writer.Write(p)
+}
@@ -36,6 +36,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 const (
@@ -155,6 +156,50 @@ func (lh *LifecycleHandler) UnfreezeHandler(request *restful.Request, response *
 	response.WriteHeader(http.StatusAccepted)
 }
 
+func (lh *LifecycleHandler) GuestExecHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("Request with no body: guest exec options are required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve guest exec options from request"))
+		return
+	}
+
+	defer request.Request.Body.Close()
+	opts := &v1.GuestExecOptions{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode guest exec options")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	if len(opts.Command) == 0 {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("command is required"))
+		return
+	}
+
+	exitCode, stdOut, err := client.Exec(api.VMINamespaceKeyFunc(vmi), opts.Command[0], opts.Command[1:], opts.TimeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to execute %s in the guest", opts.Command[0])
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	if exitCode != 0 {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("%s exited with code %d: %s", opts.Command[0], exitCode, stdOut))
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
 func (lh *LifecycleHandler) ResetHandler(request *restful.Request, response *restful.Response) {
 	vmi, client, err := lh.getVMILauncherClient(request, response)
 	if err != nil {
@@ -420,3 +465,36 @@ func (lh *LifecycleHandler) RedefineCheckpointHandler(request *restful.Request,
 
 	response.WriteHeader(http.StatusOK)
 }
+
+func (lh *LifecycleHandler) DeleteCheckpointHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("Request with no body: checkpoint info is required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve checkpoint info from request"))
+		return
+	}
+
+	checkpoint := &backupv1.BackupCheckpoint{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(checkpoint)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode checkpoint info")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := client.DeleteCheckpoint(vmi, checkpoint); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to delete checkpoint %s", checkpoint.Name)
+		response.WriteError(http.StatusServiceUnavailable, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
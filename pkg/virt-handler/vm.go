@@ -48,7 +48,6 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
-	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -63,6 +62,7 @@ import (
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -584,9 +584,10 @@ func (c *VirtualMachineController) updateChecksumInfo(vmi *v1.VirtualMachineInst
 			continue
 		}
 
-		vmi.Status.VolumeStatus[i].ContainerDiskVolume = &v1.ContainerDiskInfo{
-			Checksum: checksum,
+		if vmi.Status.VolumeStatus[i].ContainerDiskVolume == nil {
+			vmi.Status.VolumeStatus[i].ContainerDiskVolume = &v1.ContainerDiskInfo{}
 		}
+		vmi.Status.VolumeStatus[i].ContainerDiskVolume.Checksum = checksum
 	}
 
 	// kernelboot
@@ -618,11 +619,20 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 	}
 
 	diskDeviceMap := make(map[string]string)
+	diskIOTuneMap := make(map[string]*v1.DiskIOTune)
 	if domain != nil {
 		for _, disk := range domain.Spec.Devices.Disks {
 			// don't care about empty cdroms
 			if disk.Source.File != "" || disk.Source.Dev != "" {
 				diskDeviceMap[disk.Alias.GetName()] = disk.Target.Device
+				diskIOTuneMap[disk.Alias.GetName()] = diskIOTuneFromDomain(disk.IOTune)
+			}
+		}
+		for _, fs := range domain.Spec.Devices.Filesystems {
+			// hotplugged filesystems are attached through a socket in the hotplug directory,
+			// their mount tag is the target the guest uses
+			if fs.Source != nil && fs.Target != nil && strings.HasPrefix(fs.Source.Socket, v1.HotplugDiskDir) {
+				diskDeviceMap[fs.Target.Dir] = fs.Target.Dir
 			}
 		}
 	}
@@ -641,6 +651,7 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 		// relying on the fact that target will be "" if not in the map
 		// see updateHotplugVolumeStatus
 		volumeStatus.Target = diskDeviceMap[volumeStatus.Name]
+		volumeStatus.IOTune = diskIOTuneMap[volumeStatus.Name]
 		if volumeStatus.HotplugVolume != nil {
 			hasHotplug = true
 			volumeStatus, tmpNeedsRefresh = c.updateHotplugVolumeStatus(vmi, volumeStatus, specVolumeMap)
@@ -665,6 +676,45 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 	return hasHotplug
 }
 
+// diskIOTuneFromDomain reports the I/O limits libvirt applies to a disk
+func diskIOTuneFromDomain(ioTune *api.DiskIOTune) *v1.DiskIOTune {
+	if ioTune == nil {
+		return nil
+	}
+	toLimit := func(value uint64) *int64 {
+		if value == 0 {
+			return nil
+		}
+		return pointer.P(int64(value))
+	}
+
+	status := &v1.DiskIOTune{
+		TotalBytesSec: toLimit(ioTune.TotalBytesSec),
+		ReadBytesSec:  toLimit(ioTune.ReadBytesSec),
+		WriteBytesSec: toLimit(ioTune.WriteBytesSec),
+		TotalIOPSSec:  toLimit(ioTune.TotalIopsSec),
+		ReadIOPSSec:   toLimit(ioTune.ReadIopsSec),
+		WriteIOPSSec:  toLimit(ioTune.WriteIopsSec),
+	}
+	burst := &v1.DiskIOTuneBurst{
+		TotalBytesSec: toLimit(ioTune.TotalBytesSecMax),
+		ReadBytesSec:  toLimit(ioTune.ReadBytesSecMax),
+		WriteBytesSec: toLimit(ioTune.WriteBytesSecMax),
+		TotalIOPSSec:  toLimit(ioTune.TotalIopsSecMax),
+		ReadIOPSSec:   toLimit(ioTune.ReadIopsSecMax),
+		WriteIOPSSec:  toLimit(ioTune.WriteIopsSecMax),
+		LengthSeconds: toLimit(max(ioTune.TotalBytesSecMaxLength, ioTune.ReadBytesSecMaxLength, ioTune.WriteBytesSecMaxLength,
+			ioTune.TotalIopsSecMaxLength, ioTune.ReadIopsSecMaxLength, ioTune.WriteIopsSecMaxLength)),
+	}
+	if !equality.Semantic.DeepEqual(burst, &v1.DiskIOTuneBurst{}) {
+		status.Burst = burst
+	}
+	if equality.Semantic.DeepEqual(status, &v1.DiskIOTune{}) {
+		return nil
+	}
+	return status
+}
+
 func (c *VirtualMachineController) updateGuestInfoFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 
 	if domain == nil || domain.Status.OSInfo.Name == "" || vmi.Status.GuestOSInfo.Name == domain.Status.OSInfo.Name {
@@ -724,6 +774,42 @@ func (c *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.Virt
 	}
 }
 
+func (c *VirtualMachineController) updateFilesystemResizeConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.FilesystemResize == nil {
+		return
+	}
+
+	filesystemResize := domain.Spec.Metadata.KubeVirt.FilesystemResize
+	status := k8sv1.ConditionFalse
+	reason := v1.VirtualMachineInstanceReasonFilesystemResizeInProgress
+	message := "Growing the guest filesystems of the expanded disks"
+	if filesystemResize.Completed {
+		message = filesystemResize.Message
+		if filesystemResize.Failed {
+			reason = v1.VirtualMachineInstanceReasonFilesystemResizeFailed
+		} else {
+			status = k8sv1.ConditionTrue
+			reason = v1.VirtualMachineInstanceReasonFilesystemResizeSucceeded
+		}
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestFilesystemResized)
+	if condition != nil && condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestFilesystemResized)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestFilesystemResized,
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+	})
+	if reason == v1.VirtualMachineInstanceReasonFilesystemResizeFailed {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, reason, fmt.Sprintf("Growing the guest filesystems failed: %s", message))
+	}
+}
+
 func (c *VirtualMachineController) updateLiveMigrationConditions(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
 	// Calculate whether the VM is migratable
 	liveMigrationCondition, isBlockMigration := c.calculateLiveMigrationCondition(vmi)
@@ -1041,6 +1127,7 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 
 func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) error {
 	c.updateAccessCredentialConditions(vmi, domain, condManager)
+	c.updateFilesystemResizeConditions(vmi, domain, condManager)
 	c.updateLiveMigrationConditions(vmi, condManager)
 	err := c.updateGuestAgentConditions(vmi, domain, condManager)
 	if err != nil {
@@ -1759,6 +1846,11 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 	// Some combinations of disks makes the VMI no suitable for live migration.
 	// A relevant error will be returned in this case.
 	for _, volume := range vmi.Spec.Volumes {
+		// The virtiofsd of a hotplugged filesystem runs in an attachment pod which
+		// is bound to the source node
+		if storagetypes.IsHotplugFilesystem(vmi, volume.Name) {
+			return true, fmt.Errorf("cannot migrate VMI: hotplugged filesystem %v can't be live migrated", volume.Name)
+		}
 		volSrc := volume.VolumeSource
 		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil {
 			var claimName string
@@ -1776,6 +1868,14 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 				return true, fmt.Errorf("cannot migrate VMI: PVC %v is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)", claimName)
 			}
 
+		} else if storagetypes.IsPersistentContainerDisk(&volume) {
+			// The overlay is shared through its PVC, the base image is pulled on the target
+			volumeStatus, ok := volumeStatusMap[volume.Name]
+			if !ok || volumeStatus.PersistentVolumeClaimInfo == nil {
+				return true, fmt.Errorf("cannot migrate VMI: Unable to determine if the overlay PVC of containerDisk %v is shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)", volume.Name)
+			} else if !storagetypes.HasSharedAccessMode(volumeStatus.PersistentVolumeClaimInfo.AccessModes) {
+				return true, fmt.Errorf("cannot migrate VMI: PVC %v is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)", volumeStatus.PersistentVolumeClaimInfo.ClaimName)
+			}
 		} else if volSrc.HostDisk != nil {
 			// Check if this is a translated PVC.
 			volumeStatus, ok := volumeStatusMap[volume.Name]
@@ -2023,6 +2123,16 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		*errorTolerantFeaturesError = append(*errorTolerantFeaturesError, err)
 	}
 
+	if err := c.netConf.SetupFirewall(vmi, isolationRes.Pid()); err != nil {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, "FirewallSetupFailed", err.Error())
+		*errorTolerantFeaturesError = append(*errorTolerantFeaturesError, err)
+	}
+
+	if err := c.netConf.SetupBandwidth(vmi, isolationRes.Pid()); err != nil {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, "BandwidthSetupFailed", err.Error())
+		*errorTolerantFeaturesError = append(*errorTolerantFeaturesError, err)
+	}
+
 	return nil
 }
 
@@ -2071,6 +2181,17 @@ func (c *VirtualMachineController) handleStartingVMI(
 		return false, fmt.Errorf("failed to configure vmi network: %w", err)
 	}
 
+	isolationRes, err := c.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return false, fmt.Errorf(failedDetectIsolationFmt, err)
+	}
+	if err := c.netConf.SetupFirewall(vmi, isolationRes.Pid()); err != nil {
+		return false, fmt.Errorf("failed to configure vmi firewall: %w", err)
+	}
+	if err := c.netConf.SetupBandwidth(vmi, isolationRes.Pid()); err != nil {
+		return false, fmt.Errorf("failed to configure vmi bandwidth: %w", err)
+	}
+
 	if err := c.setupDevicesOwnerships(vmi, c.recorder); err != nil {
 		return false, err
 	}
@@ -2491,7 +2612,7 @@ func (c *VirtualMachineController) updateBackupStatus(vmi *v1.VirtualMachineInst
 		vmi.Status.ChangedBlockTracking.BackupStatus.CheckpointName = &backupMetadata.CheckpointName
 	}
 	if backupMetadata.Volumes != "" {
-		var volumes []backupv1.BackupVolumeInfo
+		var volumes []v1.BackupVolumeInfo
 		if err := json.Unmarshal([]byte(backupMetadata.Volumes), &volumes); err == nil && len(volumes) > 0 {
 			vmi.Status.ChangedBlockTracking.BackupStatus.Volumes = volumes
 		}
@@ -72,6 +72,7 @@ import (
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	notifyserver "kubevirt.io/kubevirt/pkg/virt-handler/notify-server"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
 var _ = Describe("VirtualMachineInstance", func() {
@@ -983,6 +984,48 @@ var _ = Describe("VirtualMachineInstance", func() {
 			))
 		})
 
+		DescribeTable("should reflect the guest filesystem resize in a condition", func(filesystemResize *api.FilesystemResizeMetadata, expectedStatus k8sv1.ConditionStatus, expectedReason, expectedMessage string) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.FilesystemResize = filesystemResize
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			expectEvent(v1.VirtualMachineInstanceReasonFilesystemResizeFailed, expectedReason == v1.VirtualMachineInstanceReasonFilesystemResizeFailed)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(v1.VirtualMachineInstanceGuestFilesystemResized),
+					"Status":  Equal(expectedStatus),
+					"Reason":  Equal(expectedReason),
+					"Message": Equal(expectedMessage),
+				}),
+			))
+		},
+			Entry("in progress",
+				&api.FilesystemResizeMetadata{StartTimestamp: pointer.P(metav1.Now())},
+				k8sv1.ConditionFalse, v1.VirtualMachineInstanceReasonFilesystemResizeInProgress, "Growing the guest filesystems of the expanded disks"),
+			Entry("succeeded",
+				&api.FilesystemResizeMetadata{Completed: true, Message: "Grew /data"},
+				k8sv1.ConditionTrue, v1.VirtualMachineInstanceReasonFilesystemResizeSucceeded, "Grew /data"),
+			Entry("failed",
+				&api.FilesystemResizeMetadata{Completed: true, Failed: true, Message: "/data: growing a vfat filesystem is not supported"},
+				k8sv1.ConditionFalse, v1.VirtualMachineInstanceReasonFilesystemResizeFailed, "/data: growing a vfat filesystem is not supported"),
+		)
+
 		type domainIsPausedTest struct {
 			domainStateChangeReason api.StateChangeReason
 			vmiMigrationState       v1.VirtualMachineInstanceMigrationState
@@ -1364,6 +1407,44 @@ var _ = Describe("VirtualMachineInstance", func() {
 				sanityExecute()
 			})
 
+			It("should report a firewall setup failure and still sync the VMI", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+
+				addVMI(vmi, domain)
+				controller.netConf = &netConfStub{SetupFirewallError: fmt.Errorf("firewall error")}
+
+				mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+				mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+				client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+
+				sanityExecute()
+				testutils.ExpectEvent(recorder, "FirewallSetupFailed")
+				testutils.ExpectEvent(recorder, v1.SyncFailed.String())
+			})
+
+			It("should report a bandwidth setup failure and still sync the VMI", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+
+				addVMI(vmi, domain)
+				controller.netConf = &netConfStub{SetupBandwidthError: fmt.Errorf("bandwidth error")}
+
+				mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+				mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+				client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+
+				sanityExecute()
+				testutils.ExpectEvent(recorder, "BandwidthSetupFailed")
+				testutils.ExpectEvent(recorder, v1.SyncFailed.String())
+			})
+
 			It("should call mount, fail if mount fails", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
 				vmi.UID = vmiTestUUID
@@ -1437,6 +1518,62 @@ var _ = Describe("VirtualMachineInstance", func() {
 				Expect(hasHotplug).To(BeTrue())
 			})
 
+			It("should mark a hotplugged filesystem attached to the domain as ready", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+					Name:  "shared",
+					Phase: v1.HotplugVolumeMounted,
+					HotplugVolume: &v1.HotplugVolumeStatus{
+						AttachPodName: "testpod",
+						AttachPodUID:  "1234",
+					},
+				})
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				domain.Spec.Devices.Filesystems = append(domain.Spec.Devices.Filesystems, api.FilesystemDevice{
+					Source: &api.FilesystemSource{Socket: virtiofs.HotplugVirtioFSSocketPath("shared")},
+					Target: &api.FilesystemTarget{Dir: "shared"},
+				})
+				addVMI(vmi, domain)
+				Expect(controller.updateVolumeStatusesFromDomain(vmi, domain)).To(BeTrue())
+				testutils.ExpectEvent(recorder, VolumeReadyReason)
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.VolumeReady))
+				Expect(vmi.Status.VolumeStatus[0].Target).To(Equal("shared"))
+			})
+
+			It("should report the I/O limits applied to the disk of a volume", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+					Name:   "test",
+					Target: "vda",
+				})
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				domain.Spec.Devices.Disks = append(domain.Spec.Devices.Disks, api.Disk{
+					Alias:  api.NewUserDefinedAlias("test"),
+					Target: api.DiskTarget{Device: "vda"},
+					Source: api.DiskSource{File: "test"},
+					IOTune: &api.DiskIOTune{
+						TotalIopsSec:          500,
+						TotalIopsSecMax:       1000,
+						TotalIopsSecMaxLength: 10,
+					},
+				})
+				addVMI(vmi, domain)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.VolumeStatus[0].IOTune).To(Equal(&v1.DiskIOTune{
+					TotalIOPSSec: pointer.P(int64(500)),
+					Burst: &v1.DiskIOTuneBurst{
+						TotalIOPSSec:  pointer.P(int64(1000)),
+						LengthSeconds: pointer.P(int64(10)),
+					},
+				}))
+			})
+
 			DescribeTable("should generate a mount event, when able to move to mount", func(currentPhase v1.VolumePhase) {
 				vmi := api2.NewMinimalVMI("testvmi")
 				vmi.UID = vmiTestUUID
@@ -1929,6 +2066,42 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI: PVC testblock is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)")))
 		})
+		DescribeTable("should check the overlay PVC of persistent containerDisks", func(accessMode k8sv1.PersistentVolumeAccessMode, expectedErr string) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "myvolume",
+					VolumeSource: v1.VolumeSource{
+						ContainerDisk: &v1.ContainerDiskSource{
+							Image:      "registry:5000/disk",
+							Persistent: &v1.PersistentContainerDisk{},
+						},
+					},
+				},
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name: "myvolume",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						ClaimName:   "testvmi-myvolume-overlay",
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{accessMode},
+					},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			if expectedErr == "" {
+				Expect(blockMigrate).To(BeFalse())
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(blockMigrate).To(BeTrue())
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+			Entry("and allow migration with a shared overlay", k8sv1.ReadWriteMany, ""),
+			Entry("and fail migration with a non-shared overlay", k8sv1.ReadWriteOnce,
+				"cannot migrate VMI: PVC testvmi-myvolume-overlay is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)"),
+		)
 		It("should fail migration for non-shared data volume PVCs", func() {
 
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -2160,6 +2333,35 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI with non-shared HostDisk")))
 		})
+		It("should not be allowed to live-migrate hotplugged filesystems", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Filesystems = []v1.Filesystem{
+				{Name: "shared", Virtiofs: &v1.FilesystemVirtiofs{}},
+			}
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "shared",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "shared"},
+							Hotpluggable:                      true,
+						},
+					},
+				},
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name: "shared",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+					},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			Expect(blockMigrate).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("hotplugged filesystem shared can't be live migrated")))
+		})
 		DescribeTable("with host model", func(hostCpuModel string) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
@@ -3180,8 +3382,11 @@ func NewScheduledVMI(vmiUID types.UID, podUID types.UID, hostname string) *v1.Vi
 }
 
 type netConfStub struct {
-	vmiUID     types.UID
-	SetupError error
+	vmiUID              types.UID
+	SetupError          error
+	SetupFirewallError  error
+	SetupBandwidthError error
+	MigratedInterfaces  []v1.MigratedInterfaceState
 }
 
 func (nc *netConfStub) Setup(_ *v1.VirtualMachineInstance, _ []v1.Network, _ int) error {
@@ -3191,6 +3396,31 @@ func (nc *netConfStub) Setup(_ *v1.VirtualMachineInstance, _ []v1.Network, _ int
 	return nil
 }
 
+func (nc *netConfStub) SetupFirewall(_ *v1.VirtualMachineInstance, _ int) error {
+	return nc.SetupFirewallError
+}
+
+func (nc *netConfStub) SetupBandwidth(_ *v1.VirtualMachineInstance, _ int) error {
+	return nc.SetupBandwidthError
+}
+
+func (nc *netConfStub) PublishPreservedAddresses(vmi *v1.VirtualMachineInstance, _ int) error {
+	vmi.Status.MigrationState.MigratedInterfaces = append(vmi.Status.MigrationState.MigratedInterfaces, nc.MigratedInterfaces...)
+	return nil
+}
+
+func (nc *netConfStub) ReportPreservedAddresses(vmi *v1.VirtualMachineInstance) error {
+	if vmi.Status.MigrationState == nil {
+		return nil
+	}
+	for i := range vmi.Status.MigrationState.MigratedInterfaces {
+		if vmi.Status.MigrationState.MigratedInterfaces[i].Phase == v1.MigratedInterfacePending {
+			vmi.Status.MigrationState.MigratedInterfaces[i].Phase = v1.MigratedInterfaceReady
+		}
+	}
+	return nil
+}
+
 func (nc *netConfStub) Teardown(_ *v1.VirtualMachineInstance) error {
 	nc.vmiUID = ""
 	return nil
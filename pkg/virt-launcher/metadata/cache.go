@@ -31,6 +31,7 @@ type Cache struct {
 	GracePeriod       SafeData[api.GracePeriodMetadata]
 	AccessCredential  SafeData[api.AccessCredentialMetadata]
 	MemoryDump        SafeData[api.MemoryDumpMetadata]
+	FilesystemResize  SafeData[api.FilesystemResizeMetadata]
 	Backup            SafeData[api.BackupMetadata]
 	GuestPanicHandled SafeData[bool]
 
@@ -46,6 +47,7 @@ func NewCache() *Cache {
 	cache.GracePeriod.dirtyChanel = cache.notificationSignal
 	cache.AccessCredential.dirtyChanel = cache.notificationSignal
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
+	cache.FilesystemResize.dirtyChanel = cache.notificationSignal
 	cache.Backup.dirtyChanel = cache.notificationSignal
 	cache.GuestPanicHandled.dirtyChanel = cache.notificationSignal
 	return cache
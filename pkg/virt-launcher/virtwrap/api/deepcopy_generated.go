@@ -250,6 +250,22 @@ func (in *BackupMetadata) DeepCopy() *BackupMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupServer) DeepCopyInto(out *BackupServer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupServer.
+func (in *BackupServer) DeepCopy() *BackupServer {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupTarget) DeepCopyInto(out *BackupTarget) {
 	*out = *in
@@ -985,7 +1001,9 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.TPMs != nil {
 		in, out := &in.TPMs, &out.TPMs
 		*out = make([]TPM, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.VSOCK != nil {
 		in, out := &in.VSOCK, &out.VSOCK
@@ -1070,6 +1088,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(Shareable)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -1172,6 +1195,22 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -1293,6 +1332,11 @@ func (in *DomainBackup) DeepCopyInto(out *DomainBackup) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(BackupServer)
+		**out = **in
+	}
 	if in.BackupDisks != nil {
 		in, out := &in.BackupDisks, &out.BackupDisks
 		*out = new(BackupDisks)
@@ -1409,6 +1453,33 @@ func (in *DomainList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshot) DeepCopyInto(out *DomainSnapshot) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(SnapshotMemory)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = new(SnapshotDisks)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshot.
+func (in *DomainSnapshot) DeepCopy() *DomainSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
@@ -1992,6 +2063,30 @@ func (in *FilesystemDriver) DeepCopy() *FilesystemDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilesystemResizeMetadata) DeepCopyInto(out *FilesystemResizeMetadata) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemResizeMetadata.
+func (in *FilesystemResizeMetadata) DeepCopy() *FilesystemResizeMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(FilesystemResizeMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilesystemSource) DeepCopyInto(out *FilesystemSource) {
 	*out = *in
@@ -2522,6 +2617,11 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FilesystemResize != nil {
+		in, out := &in.FilesystemResize, &out.FilesystemResize
+		*out = new(FilesystemResizeMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3015,6 +3115,11 @@ func (in *NUMATune) DeepCopy() *NUMATune {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NVRam) DeepCopyInto(out *NVRam) {
 	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(NVRamSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3028,6 +3133,60 @@ func (in *NVRam) DeepCopy() *NVRam {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NVRamEncryption) DeepCopyInto(out *NVRamEncryption) {
+	*out = *in
+	out.Secret = in.Secret
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NVRamEncryption.
+func (in *NVRamEncryption) DeepCopy() *NVRamEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(NVRamEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NVRamEncryptionSecret) DeepCopyInto(out *NVRamEncryptionSecret) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NVRamEncryptionSecret.
+func (in *NVRamEncryptionSecret) DeepCopy() *NVRamEncryptionSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(NVRamEncryptionSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NVRamSource) DeepCopyInto(out *NVRamSource) {
+	*out = *in
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(NVRamEncryption)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NVRamSource.
+func (in *NVRamSource) DeepCopy() *NVRamSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NVRamSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NoSharePages) DeepCopyInto(out *NoSharePages) {
 	*out = *in
@@ -3097,7 +3256,7 @@ func (in *OS) DeepCopyInto(out *OS) {
 	if in.NVRam != nil {
 		in, out := &in.NVRam, &out.NVRam
 		*out = new(NVRam)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -3571,6 +3730,59 @@ func (in *SliceType) DeepCopy() *SliceType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotDisk) DeepCopyInto(out *SnapshotDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotDisk.
+func (in *SnapshotDisk) DeepCopy() *SnapshotDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotDisks) DeepCopyInto(out *SnapshotDisks) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]SnapshotDisk, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotDisks.
+func (in *SnapshotDisks) DeepCopy() *SnapshotDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotMemory) DeepCopyInto(out *SnapshotMemory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotMemory.
+func (in *SnapshotMemory) DeepCopy() *SnapshotMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundCard) DeepCopyInto(out *SoundCard) {
 	*out = *in
@@ -3710,7 +3922,7 @@ func (in *TLBFlush) DeepCopy() *TLBFlush {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TPM) DeepCopyInto(out *TPM) {
 	*out = *in
-	out.Backend = in.Backend
+	in.Backend.DeepCopyInto(&out.Backend)
 	return
 }
 
@@ -3727,6 +3939,11 @@ func (in *TPM) DeepCopy() *TPM {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TPMBackend) DeepCopyInto(out *TPMBackend) {
 	*out = *in
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(TPMEncryption)
+		**out = **in
+	}
 	return
 }
 
@@ -3740,6 +3957,22 @@ func (in *TPMBackend) DeepCopy() *TPMBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TPMEncryption) DeepCopyInto(out *TPMEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPMEncryption.
+func (in *TPMEncryption) DeepCopy() *TPMEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(TPMEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -413,6 +413,7 @@ type KubeVirtMetadata struct {
 	Backup           *BackupMetadata           `xml:"backup,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	FilesystemResize *FilesystemResizeMetadata `xml:"filesystemResize,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -429,6 +430,14 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+type FilesystemResizeMetadata struct {
+	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time `xml:"endTimestamp,omitempty"`
+	Completed      bool         `xml:"completed,omitempty"`
+	Failed         bool         `xml:"failed,omitempty"`
+	Message        string       `xml:"message,omitempty"`
+}
+
 type MigrationMetadata struct {
 	UID            types.UID        `xml:"uid,omitempty"`
 	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
@@ -437,6 +446,7 @@ type MigrationMetadata struct {
 	FailureReason  string           `xml:"failureReason,omitempty"`
 	AbortStatus    string           `xml:"abortStatus,omitempty"`
 	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	Volumes        string           `xml:"volumes,omitempty"`
 }
 
 type BackupMetadata struct {
@@ -460,10 +470,16 @@ type GracePeriodMetadata struct {
 
 // DomainBackup mirroring libvirt XML under https://libvirt.org/formatbackup.html#backup-xml-format
 type DomainBackup struct {
-	XMLName     xml.Name     `xml:"domainbackup"`
-	Mode        string       `xml:"mode,attr"`
-	Incremental *string      `xml:"incremental,omitempty"`
-	BackupDisks *BackupDisks `xml:"disks"`
+	XMLName     xml.Name      `xml:"domainbackup"`
+	Mode        string        `xml:"mode,attr"`
+	Incremental *string       `xml:"incremental,omitempty"`
+	Server      *BackupServer `xml:"server,omitempty"`
+	BackupDisks *BackupDisks  `xml:"disks"`
+}
+
+type BackupServer struct {
+	Transport string `xml:"transport,attr"`
+	Socket    string `xml:"socket,attr,omitempty"`
 }
 
 type BackupDisks struct {
@@ -471,10 +487,12 @@ type BackupDisks struct {
 }
 
 type BackupDisk struct {
-	Name   string        `xml:"name,attr"`
-	Backup string        `xml:"backup,attr"`
-	Type   string        `xml:"type,attr,omitempty"`
-	Target *BackupTarget `xml:"target,omitempty"`
+	Name         string        `xml:"name,attr"`
+	Backup       string        `xml:"backup,attr"`
+	Type         string        `xml:"type,attr,omitempty"`
+	ExportName   string        `xml:"exportname,attr,omitempty"`
+	ExportBitmap string        `xml:"exportbitmap,attr,omitempty"`
+	Target       *BackupTarget `xml:"target,omitempty"`
 }
 
 type BackupTarget struct {
@@ -503,6 +521,27 @@ type CheckpointParent struct {
 	Name string `xml:"name"`
 }
 
+// DomainSnapshot mirroring libvirt XML under https://libvirt.org/formatsnapshot.html
+type DomainSnapshot struct {
+	XMLName xml.Name        `xml:"domainsnapshot"`
+	Memory  *SnapshotMemory `xml:"memory"`
+	Disks   *SnapshotDisks  `xml:"disks"`
+}
+
+type SnapshotMemory struct {
+	Snapshot string `xml:"snapshot,attr"`
+	File     string `xml:"file,attr,omitempty"`
+}
+
+type SnapshotDisks struct {
+	Disks []SnapshotDisk `xml:"disk"`
+}
+
+type SnapshotDisk struct {
+	Name     string `xml:"name,attr"`
+	Snapshot string `xml:"snapshot,attr"`
+}
+
 type Commandline struct {
 	QEMUEnv []Env `xml:"qemu:env,omitempty"`
 	QEMUArg []Arg `xml:"qemu:arg,omitempty"`
@@ -629,9 +668,15 @@ type TPM struct {
 }
 
 type TPMBackend struct {
-	Type            string `xml:"type,attr"`
-	Version         string `xml:"version,attr"`
-	PersistentState string `xml:"persistent_state,attr,omitempty"`
+	Type            string         `xml:"type,attr"`
+	Version         string         `xml:"version,attr"`
+	PersistentState string         `xml:"persistent_state,attr,omitempty"`
+	Encryption      *TPMEncryption `xml:"encryption,omitempty"`
+}
+
+// TPMEncryption references the libvirt secret encrypting the TPM state
+type TPMEncryption struct {
+	Secret string `xml:"secret,attr"`
 }
 
 // RedirectedDevice describes a device to be redirected
@@ -769,7 +814,9 @@ type Disk struct {
 	FilesystemOverhead *v1.Percent   `xml:"filesystemOverhead,omitempty"`
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool          `xml:"expandDisksEnabled,omitempty"`
+	AutoGrowFilesystem bool          `xml:"autoGrowFilesystem,omitempty"`
 	Shareable          *Shareable    `xml:"shareable,omitempty"`
+	IOTune             *DiskIOTune   `xml:"iotune,omitempty"`
 }
 
 type DiskAuth struct {
@@ -855,6 +902,27 @@ type BlockIO struct {
 	DiscardGranularity *uint `xml:"discard_granularity,attr,omitempty"`
 }
 
+type DiskIOTune struct {
+	TotalBytesSec          uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec           uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIopsSec           uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIopsSec            uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec           uint64 `xml:"write_iops_sec,omitempty"`
+	TotalBytesSecMax       uint64 `xml:"total_bytes_sec_max,omitempty"`
+	ReadBytesSecMax        uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       uint64 `xml:"write_bytes_sec_max,omitempty"`
+	TotalIopsSecMax        uint64 `xml:"total_iops_sec_max,omitempty"`
+	ReadIopsSecMax         uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIopsSecMax        uint64 `xml:"write_iops_sec_max,omitempty"`
+	TotalBytesSecMaxLength uint64 `xml:"total_bytes_sec_max_length,omitempty"`
+	ReadBytesSecMaxLength  uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	TotalIopsSecMaxLength  uint64 `xml:"total_iops_sec_max_length,omitempty"`
+	ReadIopsSecMaxLength   uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIopsSecMaxLength  uint64 `xml:"write_iops_sec_max_length,omitempty"`
+}
+
 type Reservations struct {
 	Managed            string              `xml:"managed,attr,omitempty"`
 	SourceReservations *SourceReservations `xml:"source,omitempty"`
@@ -1112,8 +1180,27 @@ type SMBios struct {
 }
 
 type NVRam struct {
-	Template string `xml:"template,attr,omitempty"`
-	NVRam    string `xml:",chardata"`
+	Type     string       `xml:"type,attr,omitempty"`
+	Template string       `xml:"template,attr,omitempty"`
+	NVRam    string       `xml:",chardata"`
+	Source   *NVRamSource `xml:"source,omitempty"`
+}
+
+// NVRamSource is the file holding the NVRAM, when the NVRAM is encrypted
+type NVRamSource struct {
+	File       string           `xml:"file,attr"`
+	Encryption *NVRamEncryption `xml:"encryption,omitempty"`
+}
+
+// NVRamEncryption references the libvirt secret of a LUKS encrypted NVRAM
+type NVRamEncryption struct {
+	Format string                `xml:"format,attr"`
+	Secret NVRamEncryptionSecret `xml:"secret"`
+}
+
+type NVRamEncryptionSecret struct {
+	Type string `xml:"type,attr"`
+	UUID string `xml:"uuid,attr"`
 }
 
 type Boot struct {
@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/tpm"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// backendStorageEncryptionKeyPath is a variable to allow unit tests to point it elsewhere
+var backendStorageEncryptionKeyPath = filepath.Join(tpm.StateEncryptionKeyDir, tpm.StateEncryptionKeyFile)
+
+var luksMagic = []byte{'L', 'U', 'K', 'S', 0xba, 0xbe}
+
+func isBackendStorageEncrypted() (bool, error) {
+	if _, err := os.Stat(backendStorageEncryptionKeyPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat the backend storage encryption key: %v", err)
+	}
+	return true, nil
+}
+
+// tpmStateEncryptionSecret returns the UUID of the libvirt secret encrypting the persistent
+// TPM state, or an empty string if the backend storage of the VMI is not encrypted.
+func tpmStateEncryptionSecret(vmi *v1.VirtualMachineInstance) (string, error) {
+	if !tpm.HasPersistentDevice(&vmi.Spec) {
+		return "", nil
+	}
+	if encrypted, err := isBackendStorageEncrypted(); !encrypted || err != nil {
+		return "", err
+	}
+	return string(vmi.UID), nil
+}
+
+// nvramEncryptionSecret returns the UUID of the libvirt secret encrypting the persistent
+// EFI NVRAM, or an empty string if the backend storage of the VMI is not encrypted.
+func nvramEncryptionSecret(vmi *v1.VirtualMachineInstance) (string, error) {
+	if !backendstorage.HasPersistentEFI(&vmi.Spec) {
+		return "", nil
+	}
+	if encrypted, err := isBackendStorageEncrypted(); !encrypted || err != nil {
+		return "", err
+	}
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(string(vmi.UID)+"/nvram")).String(), nil
+}
+
+// defineBackendStorageEncryptionSecrets hands the key of the backend storage over to libvirt.
+// A migration target gets the key of the source, libvirt migrates the TPM state with it.
+func (l *LibvirtDomainManager) defineBackendStorageEncryptionSecrets(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	tpmSecretUUID, err := tpmStateEncryptionSecret(vmi)
+	if err != nil {
+		return err
+	}
+	nvramSecretUUID, err := nvramEncryptionSecret(vmi)
+	if err != nil {
+		return err
+	}
+	if tpmSecretUUID == "" && nvramSecretUUID == "" {
+		return nil
+	}
+
+	key, err := os.ReadFile(backendStorageEncryptionKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the backend storage encryption key: %v", err)
+	}
+
+	if tpmSecretUUID != "" {
+		secretXML := fmt.Sprintf(`<secret ephemeral='no' private='yes'><uuid>%s</uuid><usage type='vtpm'><name>%s_%s</name></usage></secret>`,
+			tpmSecretUUID, vmi.Namespace, vmi.Name)
+		if err := l.virConn.SecretDefineWithValue(secretXML, key); err != nil {
+			return fmt.Errorf("failed to define the TPM state encryption secret: %v", err)
+		}
+	}
+
+	nvram := domain.Spec.OS.NVRam
+	if nvramSecretUUID == "" || nvram == nil || nvram.Source == nil {
+		return nil
+	}
+	secretXML := fmt.Sprintf(`<secret ephemeral='no' private='yes'><uuid>%s</uuid><usage type='volume'><volume>%s</volume></usage></secret>`,
+		nvramSecretUUID, nvram.Source.File)
+	if err := l.virConn.SecretDefineWithValue(secretXML, key); err != nil {
+		return fmt.Errorf("failed to define the NVRAM encryption secret: %v", err)
+	}
+
+	return createEncryptedNVRAM(nvram.Template, nvram.Source.File)
+}
+
+// createEncryptedNVRAM creates a LUKS encrypted NVRAM from its template, libvirt only copies templates in clear.
+// An existing NVRAM has to be encrypted already, the backend storage is only marked encrypted while empty.
+func createEncryptedNVRAM(template, nvramPath string) error {
+	f, err := os.Open(nvramPath)
+	if err == nil {
+		defer f.Close()
+		magic := make([]byte, len(luksMagic))
+		if _, err := io.ReadFull(f, magic); err != nil || !bytes.Equal(magic, luksMagic) {
+			return fmt.Errorf("the NVRAM %s of an encrypted backend storage is not encrypted", nvramPath)
+		}
+		return nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to open the NVRAM %s: %v", nvramPath, err)
+	}
+
+	// Create the NVRAM next to its final path and rename it, so that a failure never leaves a partial NVRAM behind
+	tmpPath := nvramPath + ".tmp"
+	cmd := exec.Command("/usr/bin/qemu-img", "convert",
+		"--object", "secret,id=nvram-key,format=raw,file="+backendStorageEncryptionKeyPath,
+		"-f", "raw", "-O", "luks", "-o", "key-secret=nvram-key",
+		template, tmpPath)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("creating the encrypted NVRAM failed with error: %v, output: %s", err, out)
+	}
+	return os.Rename(tmpPath, nvramPath)
+}
@@ -0,0 +1,146 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
+)
+
+var _ = Describe("Backend storage encryption", func() {
+	const vmiUID = "a5ed1b2c-7b0e-4d4e-8c8f-0c9b4a8f3e11"
+
+	var (
+		mockLibvirt *testing.Libvirt
+		manager     *LibvirtDomainManager
+		key         = []byte("0123456789abcdef0123456789abcdef")
+	)
+
+	BeforeEach(func() {
+		mockLibvirt = testing.NewLibvirt(gomock.NewController(GinkgoT()))
+		manager = &LibvirtDomainManager{virConn: mockLibvirt.VirtConnection}
+
+		originalKeyPath := backendStorageEncryptionKeyPath
+		DeferCleanup(func() { backendStorageEncryptionKeyPath = originalKeyPath })
+		backendStorageEncryptionKeyPath = filepath.Join(GinkgoT().TempDir(), "key")
+	})
+
+	It("should define a libvirt secret holding the key of an encrypted backend storage", func() {
+		Expect(os.WriteFile(backendStorageEncryptionKeyPath, key, 0o600)).To(Succeed())
+		vmi := libvmi.New(libvmi.WithTPM(true), libvmi.WithName("testvmi"), libvmi.WithNamespace("default"))
+		vmi.UID = types.UID(vmiUID)
+
+		mockLibvirt.ConnectionEXPECT().SecretDefineWithValue(
+			"<secret ephemeral='no' private='yes'><uuid>"+vmiUID+"</uuid><usage type='vtpm'><name>default_testvmi</name></usage></secret>",
+			key,
+		).Return(nil)
+
+		Expect(manager.defineBackendStorageEncryptionSecrets(vmi, &api.Domain{})).To(Succeed())
+
+		secretUUID, err := tpmStateEncryptionSecret(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secretUUID).To(Equal(vmiUID))
+	})
+
+	It("should not define a secret when the backend storage is not encrypted", func() {
+		vmi := libvmi.New(libvmi.WithTPM(true))
+		vmi.UID = types.UID(vmiUID)
+
+		Expect(manager.defineBackendStorageEncryptionSecrets(vmi, &api.Domain{})).To(Succeed())
+
+		secretUUID, err := tpmStateEncryptionSecret(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secretUUID).To(BeEmpty())
+	})
+
+	It("should not define a secret when the TPM is not persistent", func() {
+		Expect(os.WriteFile(backendStorageEncryptionKeyPath, key, 0o600)).To(Succeed())
+		vmi := libvmi.New(libvmi.WithTPM(false))
+		vmi.UID = types.UID(vmiUID)
+
+		Expect(manager.defineBackendStorageEncryptionSecrets(vmi, &api.Domain{})).To(Succeed())
+	})
+
+	Context("with a persistent EFI", func() {
+		var (
+			vmi       *v1.VirtualMachineInstance
+			nvramPath string
+			domain    *api.Domain
+		)
+
+		BeforeEach(func() {
+			Expect(os.WriteFile(backendStorageEncryptionKeyPath, key, 0o600)).To(Succeed())
+			vmi = libvmi.New(libvmi.WithUefi(false), libvmi.WithName("testvmi"), libvmi.WithNamespace("default"))
+			vmi.Spec.Domain.Firmware.Bootloader.EFI.Persistent = pointer.P(true)
+			vmi.UID = types.UID(vmiUID)
+
+			nvramPath = filepath.Join(GinkgoT().TempDir(), "testvmi_VARS.fd")
+			domain = &api.Domain{}
+			domain.Spec.OS.NVRam = &api.NVRam{
+				Type:     "file",
+				Template: "/usr/share/OVMF/OVMF_VARS.fd",
+				Source:   &api.NVRamSource{File: nvramPath},
+			}
+		})
+
+		It("should define a libvirt secret for the NVRAM and keep an encrypted NVRAM", func() {
+			Expect(os.WriteFile(nvramPath, append(luksMagic, 0, 1), 0o600)).To(Succeed())
+			secretUUID, err := nvramEncryptionSecret(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secretUUID).ToNot(BeEmpty())
+			Expect(secretUUID).ToNot(Equal(vmiUID))
+
+			mockLibvirt.ConnectionEXPECT().SecretDefineWithValue(
+				"<secret ephemeral='no' private='yes'><uuid>"+secretUUID+"</uuid><usage type='volume'><volume>"+nvramPath+"</volume></usage></secret>",
+				key,
+			).Return(nil)
+
+			Expect(manager.defineBackendStorageEncryptionSecrets(vmi, domain)).To(Succeed())
+		})
+
+		It("should refuse an NVRAM in clear on an encrypted backend storage", func() {
+			Expect(os.WriteFile(nvramPath, []byte("cleartext NVRAM"), 0o600)).To(Succeed())
+			mockLibvirt.ConnectionEXPECT().SecretDefineWithValue(gomock.Any(), key).Return(nil)
+
+			Expect(manager.defineBackendStorageEncryptionSecrets(vmi, domain)).To(MatchError(ContainSubstring("is not encrypted")))
+		})
+
+		It("should not encrypt the NVRAM when the backend storage is not encrypted", func() {
+			Expect(os.Remove(backendStorageEncryptionKeyPath)).To(Succeed())
+
+			secretUUID, err := nvramEncryptionSecret(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secretUUID).To(BeEmpty())
+		})
+	})
+})
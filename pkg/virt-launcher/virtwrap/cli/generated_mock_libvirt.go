@@ -172,6 +172,20 @@ func (mr *MockConnectionMockRecorder) DomainEventMemoryDeviceSizeChangeRegister(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventMemoryDeviceSizeChangeRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventMemoryDeviceSizeChangeRegister), callback)
 }
 
+// DomainRestoreFlags mocks base method.
+func (m *MockConnection) DomainRestoreFlags(srcFile, xml string, flags libvirt.DomainSaveRestoreFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainRestoreFlags", srcFile, xml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainRestoreFlags indicates an expected call of DomainRestoreFlags.
+func (mr *MockConnectionMockRecorder) DomainRestoreFlags(srcFile, xml, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainRestoreFlags", reflect.TypeOf((*MockConnection)(nil).DomainRestoreFlags), srcFile, xml, flags)
+}
+
 // GetAllDomainStats mocks base method.
 func (m *MockConnection) GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +321,20 @@ func (mr *MockConnectionMockRecorder) QemuAgentCommand(command, domainName any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuAgentCommand", reflect.TypeOf((*MockConnection)(nil).QemuAgentCommand), command, domainName)
 }
 
+// SecretDefineWithValue mocks base method.
+func (m *MockConnection) SecretDefineWithValue(xml string, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretDefineWithValue", xml, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SecretDefineWithValue indicates an expected call of SecretDefineWithValue.
+func (mr *MockConnectionMockRecorder) SecretDefineWithValue(xml, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretDefineWithValue", reflect.TypeOf((*MockConnection)(nil).SecretDefineWithValue), xml, value)
+}
+
 // SetReconnectChan mocks base method.
 func (m *MockConnection) SetReconnectChan(reconnect chan bool) {
 	m.ctrl.T.Helper()
@@ -510,6 +538,21 @@ func (mr *MockVirDomainMockRecorder) BlockResize(disk, size, flags any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockResize", reflect.TypeOf((*MockVirDomain)(nil).BlockResize), disk, size, flags)
 }
 
+// CheckpointLookupByName mocks base method.
+func (m *MockVirDomain) CheckpointLookupByName(name string, flags uint32) (*libvirt.DomainCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointLookupByName", name, flags)
+	ret0, _ := ret[0].(*libvirt.DomainCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLookupByName indicates an expected call of CheckpointLookupByName.
+func (mr *MockVirDomainMockRecorder) CheckpointLookupByName(name, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLookupByName", reflect.TypeOf((*MockVirDomain)(nil).CheckpointLookupByName), name, flags)
+}
+
 // CoreDumpWithFormat mocks base method.
 func (m *MockVirDomain) CoreDumpWithFormat(to string, format libvirt.DomainCoreDumpFormat, flags libvirt.DomainCoreDumpFlags) error {
 	m.ctrl.T.Helper()
@@ -539,6 +582,21 @@ func (mr *MockVirDomainMockRecorder) CreateCheckpointXML(xmlConfig, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckpointXML", reflect.TypeOf((*MockVirDomain)(nil).CreateCheckpointXML), xmlConfig, flags)
 }
 
+// CreateSnapshotXML mocks base method.
+func (m *MockVirDomain) CreateSnapshotXML(xml string, flags libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotXML", xml, flags)
+	ret0, _ := ret[0].(*libvirt.DomainSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotXML indicates an expected call of CreateSnapshotXML.
+func (mr *MockVirDomainMockRecorder) CreateSnapshotXML(xml, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotXML", reflect.TypeOf((*MockVirDomain)(nil).CreateSnapshotXML), xml, flags)
+}
+
 // CreateWithFlags mocks base method.
 func (m *MockVirDomain) CreateWithFlags(flags libvirt.DomainCreateFlags) error {
 	m.ctrl.T.Helper()
@@ -638,6 +696,21 @@ func (mr *MockVirDomainMockRecorder) GetBlockInfo(disk, flags any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockInfo), disk, flags)
 }
 
+// GetBlockJobInfo mocks base method.
+func (m *MockVirDomain) GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockJobInfo", disk, flags)
+	ret0, _ := ret[0].(*libvirt.DomainBlockJobInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockJobInfo indicates an expected call of GetBlockJobInfo.
+func (mr *MockVirDomainMockRecorder) GetBlockJobInfo(disk, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockJobInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockJobInfo), disk, flags)
+}
+
 // GetDiskErrors mocks base method.
 func (m *MockVirDomain) GetDiskErrors(flags uint32) ([]libvirt.DomainDiskError, error) {
 	m.ctrl.T.Helper()
@@ -902,6 +975,20 @@ func (mr *MockVirDomainMockRecorder) Screenshot(stream, screen, flags any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Screenshot", reflect.TypeOf((*MockVirDomain)(nil).Screenshot), stream, screen, flags)
 }
 
+// SetBlockIoTune mocks base method.
+func (m *MockVirDomain) SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlockIoTune", disk, params, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBlockIoTune indicates an expected call of SetBlockIoTune.
+func (mr *MockVirDomainMockRecorder) SetBlockIoTune(disk, params, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockIoTune", reflect.TypeOf((*MockVirDomain)(nil).SetBlockIoTune), disk, params, flags)
+}
+
 // SetLaunchSecurityState mocks base method.
 func (m *MockVirDomain) SetLaunchSecurityState(params *libvirt.DomainLaunchSecurityStateParameters, flags uint32) error {
 	m.ctrl.T.Helper()
@@ -47,6 +47,7 @@ const ConnectionInterval = 500 * time.Millisecond
 type Connection interface {
 	LookupDomainByName(name string) (VirDomain, error)
 	DomainDefineXML(xml string) (VirDomain, error)
+	DomainRestoreFlags(srcFile string, xml string, flags libvirt.DomainSaveRestoreFlags) error
 	Close() (int, error)
 	DomainEventJobCompletedRegister(callback libvirt.DomainEventJobCompletedCallback) error
 	DomainEventLifecycleRegister(callback libvirt.DomainEventLifecycleCallback) error
@@ -69,6 +70,8 @@ type Connection interface {
 	GetDomainDirtyRate(calculationDuration time.Duration, flags libvirt.DomainDirtyRateCalcFlags) ([]*stats.DomainStatsDirtyRate, error)
 	GetQemuVersion() (string, error)
 	GetSEVInfo() (*api.SEVNodeParameters, error)
+	// helper method, defines a secret and sets its value in one go
+	SecretDefineWithValue(xml string, value []byte) error
 }
 
 type Stream interface {
@@ -248,6 +251,33 @@ func (l *LibvirtConnection) DomainDefineXML(xml string) (dom VirDomain, err erro
 	return
 }
 
+func (l *LibvirtConnection) DomainRestoreFlags(srcFile string, xml string, flags libvirt.DomainSaveRestoreFlags) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	err = l.Connect.DomainRestoreFlags(srcFile, xml, flags)
+	l.checkConnectionLost(err)
+	return
+}
+
+func (l *LibvirtConnection) SecretDefineWithValue(xml string, value []byte) error {
+	if err := l.reconnectIfNecessary(); err != nil {
+		return err
+	}
+
+	secret, err := l.Connect.SecretDefineXML(xml, 0)
+	if err != nil {
+		l.checkConnectionLost(err)
+		return err
+	}
+	defer secret.Free()
+
+	err = secret.SetValue(value, 0)
+	l.checkConnectionLost(err)
+	return err
+}
+
 func (l *LibvirtConnection) ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error) {
 	if err := l.reconnectIfNecessary(); err != nil {
 		return nil, err
@@ -636,7 +666,9 @@ type VirDomain interface {
 	Suspend() error
 	Resume() error
 	BlockResize(disk string, size uint64, flags libvirt.DomainBlockResizeFlags) error
+	SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error
 	GetBlockInfo(disk string, flags uint32) (*libvirt.DomainBlockInfo, error)
+	GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error)
 	AttachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	UpdateDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	DetachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
@@ -671,6 +703,8 @@ type VirDomain interface {
 	Screenshot(stream *libvirt.Stream, screen, flags uint32) (string, error)
 	BackupBegin(backupXML string, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error
 	CreateCheckpointXML(xmlConfig string, flags libvirt.DomainCheckpointCreateFlags) (*libvirt.DomainCheckpoint, error)
+	CreateSnapshotXML(xml string, flags libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error)
+	CheckpointLookupByName(name string, flags uint32) (*libvirt.DomainCheckpoint, error)
 }
 
 func NewConnection(uri string, user string, pass string, checkInterval time.Duration) (Connection, error) {
@@ -35,6 +35,8 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	nbdv1 "kubevirt.io/kubevirt/pkg/storage/cbt/nbd/v1"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap"
@@ -638,6 +640,8 @@ func RunServer(socketPath string,
 	// register more versions as soon as needed
 	// and add them to info.go
 	cmdv1.RegisterCmdServer(grpcServer, server)
+	// the NBD export of pull mode backups is read through the cmd socket
+	nbdv1.RegisterNBDServer(grpcServer, storage.NewNBDClient(cbt.BackupNBDSocketPath))
 
 	sock, err := grpcutil.CreateSocket(socketPath)
 	if err != nil {
@@ -824,11 +828,17 @@ func getBackupOptionsFromRequest(request *cmdv1.BackupRequest) (*backupv1.Backup
 
 	switch options.Cmd {
 	case backupv1.Start:
-		if options.Mode != backupv1.PushMode {
-			return nil, fmt.Errorf("currently only backup in push mode is supported")
-		}
-		if options.PushPath == nil {
-			return nil, fmt.Errorf("backup with push mode - pushPath wasn't provided")
+		switch options.Mode {
+		case backupv1.PushMode:
+			if options.PushPath == nil {
+				return nil, fmt.Errorf("backup with push mode - pushPath wasn't provided")
+			}
+		case backupv1.PullMode:
+			if options.PushPath != nil {
+				return nil, fmt.Errorf("backup with pull mode - pushPath is not supported")
+			}
+		default:
+			return nil, fmt.Errorf("unsupported backup mode: %s", options.Mode)
 		}
 	case backupv1.Abort:
 		return options, nil
@@ -918,3 +928,33 @@ func (l *Launcher) RedefineCheckpoint(_ context.Context, request *cmdv1.Redefine
 		},
 	}, nil
 }
+
+func (l *Launcher) DeleteCheckpoint(_ context.Context, request *cmdv1.DeleteCheckpointRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if !storage.IsChangedBlockTrackingEnabled(vmi) {
+		response.Success = false
+		response.Message = "Delete checkpoint failed: ChangedBlockTracking is not enabled"
+		return response, nil
+	}
+
+	checkpoint := &backupv1.BackupCheckpoint{}
+	if err := json.Unmarshal(request.Checkpoint, checkpoint); err != nil {
+		response.Success = false
+		response.Message = fmt.Sprintf("Delete checkpoint failed: invalid checkpoint info: %v", err)
+		return response, nil
+	}
+
+	if err := l.domainManager.DeleteCheckpoint(vmi, checkpoint); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to delete checkpoint %s", checkpoint.Name)
+		response.Success = false
+		response.Message = err.Error()
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Checkpoint %s deleted successfully", checkpoint.Name)
+	return response, nil
+}
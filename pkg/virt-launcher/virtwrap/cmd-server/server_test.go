@@ -353,7 +353,7 @@ var _ = Describe("Virt remote commands", func() {
 				checkpoint = &backupv1.BackupCheckpoint{
 					Name:         "checkpoint-1",
 					CreationTime: &creationTime,
-					Volumes: []backupv1.BackupVolumeInfo{
+					Volumes: []v1.BackupVolumeInfo{
 						{VolumeName: "disk1", DiskTarget: "vda"},
 						{VolumeName: "disk2", DiskTarget: "vdb"},
 					},
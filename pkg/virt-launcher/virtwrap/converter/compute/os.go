@@ -38,6 +38,8 @@ type EFIConfiguration struct {
 	EFICode      string
 	EFIVars      string
 	SecureLoader bool
+	// NVRamEncryptionSecret is the UUID of the libvirt secret encrypting the persistent NVRAM
+	NVRamEncryptionSecret string
 }
 
 type OSDomainConfigurator struct {
@@ -123,9 +125,26 @@ func (o OSDomainConfigurator) configureEFI(vmi *v1.VirtualMachineInstance, domai
 			Template: o.efiConfiguration.EFIVars,
 			NVRam:    filepath.Join(services.PathForNVram(vmi), vmi.Name+"_VARS.fd"),
 		}
+		if o.efiConfiguration.NVRamEncryptionSecret != "" {
+			configureNVRamEncryption(domain.Spec.OS.NVRam, o.efiConfiguration.NVRamEncryptionSecret)
+		}
 	}
 }
 
+// configureNVRamEncryption moves the NVRAM path to a LUKS encrypted file source,
+// virt-launcher creates the encrypted NVRAM from the template since libvirt can't
+func configureNVRamEncryption(nvram *api.NVRam, secretUUID string) {
+	nvram.Type = "file"
+	nvram.Source = &api.NVRamSource{
+		File: nvram.NVRam,
+		Encryption: &api.NVRamEncryption{
+			Format: "luks",
+			Secret: api.NVRamEncryptionSecret{Type: "passphrase", UUID: secretUUID},
+		},
+	}
+	nvram.NVRam = ""
+}
+
 func configureBIOS(firmware *v1.Firmware, domain *api.Domain) {
 	if firmware.Bootloader == nil || firmware.Bootloader.BIOS == nil {
 		return
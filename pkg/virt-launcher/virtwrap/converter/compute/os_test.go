@@ -165,6 +165,28 @@ var _ = Describe("OS Domain Configurator", func() {
 			Entry("without secure boot", false),
 			Entry("with secure boot", true),
 		)
+
+		It("should encrypt the NVRAM when an encryption secret is given", func() {
+			const secretUUID = "3a4f8b1e-2c5d-4e6f-8a9b-0c1d2e3f4a5b"
+			vmi := libvmi.New(withEFIBootloader(false))
+			var domain api.Domain
+			encryptedEFIConfig := *efiConfig
+			encryptedEFIConfig.NVRamEncryptionSecret = secretUUID
+
+			Expect(compute.NewOSDomainConfigurator(!smbiosEnabled, &encryptedEFIConfig).Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.OS.NVRam).To(Equal(&api.NVRam{
+				Type:     "file",
+				Template: efiConfig.EFIVars,
+				Source: &api.NVRamSource{
+					File: "/var/lib/libvirt/qemu/nvram/" + vmi.Name + "_VARS.fd",
+					Encryption: &api.NVRamEncryption{
+						Format: "luks",
+						Secret: api.NVRamEncryptionSecret{Type: "passphrase", UUID: secretUUID},
+					},
+				},
+			}))
+		})
 	})
 
 	Context("ACPI configuration", func() {
@@ -26,7 +26,21 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
-type TPMDomainConfigurator struct{}
+type TPMDomainConfigurator struct {
+	encryptionSecret string
+}
+
+type tpmOption func(*TPMDomainConfigurator)
+
+func NewTPMDomainConfigurator(options ...tpmOption) TPMDomainConfigurator {
+	var configurator TPMDomainConfigurator
+
+	for _, f := range options {
+		f(&configurator)
+	}
+
+	return configurator
+}
 
 func (t TPMDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	if !tpm.HasDevice(&vmi.Spec) {
@@ -48,8 +62,19 @@ func (t TPMDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain
 		//   we decided to introduce them together. Ultimately, we should use tpm-crb for all cases,
 		//   as it is now the generally preferred model
 		newTPMDevice.Model = "tpm-crb"
+
+		if t.encryptionSecret != "" {
+			newTPMDevice.Backend.Encryption = &api.TPMEncryption{Secret: t.encryptionSecret}
+		}
 	}
 
 	domain.Spec.Devices.TPMs = []api.TPM{newTPMDevice}
 	return nil
 }
+
+// TPMWithEncryptionSecret sets the UUID of the libvirt secret encrypting the persistent TPM state
+func TPMWithEncryptionSecret(secretUUID string) tpmOption {
+	return func(t *TPMDomainConfigurator) {
+		t.encryptionSecret = secretUUID
+	}
+}
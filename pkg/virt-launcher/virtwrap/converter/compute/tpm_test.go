@@ -33,7 +33,7 @@ var _ = Describe("TPM Domain Configurator", func() {
 		vmi := libvmi.New()
 		var domain api.Domain
 
-		Expect(compute.TPMDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(compute.NewTPMDomainConfigurator().Configure(vmi, &domain)).To(Succeed())
 		Expect(domain).To(Equal(api.Domain{}))
 	})
 
@@ -41,7 +41,7 @@ var _ = Describe("TPM Domain Configurator", func() {
 		vmi := libvmi.New(libvmi.WithTPM(false))
 		var domain api.Domain
 
-		Expect(compute.TPMDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(compute.NewTPMDomainConfigurator().Configure(vmi, &domain)).To(Succeed())
 
 		expectedDomain := api.Domain{
 			Spec: api.DomainSpec{
@@ -65,7 +65,7 @@ var _ = Describe("TPM Domain Configurator", func() {
 		vmi := libvmi.New(libvmi.WithTPM(true))
 		var domain api.Domain
 
-		Expect(compute.TPMDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(compute.NewTPMDomainConfigurator().Configure(vmi, &domain)).To(Succeed())
 
 		expectedDomain := api.Domain{
 			Spec: api.DomainSpec{
@@ -85,4 +85,25 @@ var _ = Describe("TPM Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("Should encrypt the state of a persistent TPM when an encryption secret is set", func() {
+		const secretUUID = "5d4c8d2a-4a2e-4f3b-9d0b-3c6a4e7f1a2b"
+		vmi := libvmi.New(libvmi.WithTPM(true))
+		var domain api.Domain
+
+		Expect(compute.NewTPMDomainConfigurator(compute.TPMWithEncryptionSecret(secretUUID)).Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.TPMs).To(HaveLen(1))
+		Expect(domain.Spec.Devices.TPMs[0].Backend.Encryption).To(Equal(&api.TPMEncryption{Secret: secretUUID}))
+	})
+
+	It("Should not encrypt the state of a non-persistent TPM", func() {
+		vmi := libvmi.New(libvmi.WithTPM(false))
+		var domain api.Domain
+
+		Expect(compute.NewTPMDomainConfigurator(compute.TPMWithEncryptionSecret("uuid")).Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.TPMs).To(HaveLen(1))
+		Expect(domain.Spec.Devices.TPMs[0].Backend.Encryption).To(BeNil())
+	})
 })
@@ -79,9 +79,10 @@ type deviceNamer struct {
 }
 
 type EFIConfiguration struct {
-	EFICode      string
-	EFIVars      string
-	SecureLoader bool
+	EFICode               string
+	EFIVars               string
+	SecureLoader          bool
+	NVRamEncryptionSecret string
 }
 
 type ConverterContext struct {
@@ -116,6 +117,7 @@ type ConverterContext struct {
 	BochsForEFIGuests               bool
 	SerialConsoleLog                bool
 	DomainAttachmentByInterfaceName map[string]string
+	TPMStateEncryptionSecret        string
 }
 
 func assignDiskToSCSIController(disk *api.Disk, unit int) {
@@ -197,11 +199,13 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		if !slices.Contains(c.VolumesDiscardIgnore, diskDevice.Name) {
 			disk.Driver.Discard = "unmap"
 		}
+		disk.IOTune = storage.ConvertDiskIOTune(diskDevice.IOTune)
 		volumeStatus, ok := volumeStatusMap[diskDevice.Name]
 		if ok && volumeStatus.PersistentVolumeClaimInfo != nil {
 			disk.FilesystemOverhead = volumeStatus.PersistentVolumeClaimInfo.FilesystemOverhead
 			disk.Capacity = storagetypes.GetDiskCapacity(volumeStatus.PersistentVolumeClaimInfo)
 			disk.ExpandDisksEnabled = c.ExpandDisksEnabled
+			disk.AutoGrowFilesystem = c.ExpandDisksEnabled && diskDevice.AutoGrowFilesystem != nil && *diskDevice.AutoGrowFilesystem
 		}
 	}
 	if numQueues != nil && disk.Target.Bus == v1.DiskBusVirtio {
@@ -1062,7 +1066,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			network.WithROMTuningSupport(c.Architecture.IsROMTuningSupported()),
 			network.WithVirtioModel(virtioModel),
 		),
-		compute.TPMDomainConfigurator{},
+		compute.NewTPMDomainConfigurator(compute.TPMWithEncryptionSecret(c.TPMStateEncryptionSecret)),
 		compute.VSOCKDomainConfigurator{},
 		compute.NewHypervisorDomainConfigurator(c.AllowEmulation, c.KvmAvailable),
 		compute.NewLaunchSecurityDomainConfigurator(architecture),
@@ -1094,7 +1098,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		compute.NewHypervisorFeaturesDomainConfigurator(c.Architecture.HasVMPort(), c.UseLaunchSecurityTDX),
 		compute.NewSysInfoDomainConfigurator(convertCmdv1SMBIOSToComputeSMBIOS(c.SMBios)),
 		compute.NewOSDomainConfigurator(c.Architecture.IsSMBiosNeeded(), convertEFIConfiguration(c.EFIConfiguration)),
-		storage.NewVirtiofsConfigurator(),
+		storage.NewVirtiofsConfigurator(c.HotplugVolumes),
 		compute.UsbRedirectDeviceDomainConfigurator{},
 		compute.NewControllersDomainConfigurator(
 			compute.ControllersWithUSBNeeded(c.Architecture.IsUSBNeeded(vmi)),
@@ -1120,8 +1124,9 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			isMemfdRequired = true
 		}
 	}
-	// virtiofs require shared access
-	if util.IsVMIVirtiofsEnabled(vmi) || netvmispec.HasPasstBinding(vmi) {
+	// virtiofs require shared access, also when filesystems might be hotplugged later
+	_, hotplugFilesystems := vmi.Annotations[v1.HotplugFilesystemsAnnotation]
+	if util.IsVMIVirtiofsEnabled(vmi) || netvmispec.HasPasstBinding(vmi) || hotplugFilesystems {
 		if domain.Spec.MemoryBacking == nil {
 			domain.Spec.MemoryBacking = &api.MemoryBacking{}
 		}
@@ -1382,8 +1387,9 @@ func convertEFIConfiguration(input *EFIConfiguration) *compute.EFIConfiguration
 	}
 
 	return &compute.EFIConfiguration{
-		EFICode:      input.EFICode,
-		EFIVars:      input.EFIVars,
-		SecureLoader: input.SecureLoader,
+		EFICode:               input.EFICode,
+		EFIVars:               input.EFIVars,
+		SecureLoader:          input.SecureLoader,
+		NVRamEncryptionSecret: input.NVRamEncryptionSecret,
 	}
 }
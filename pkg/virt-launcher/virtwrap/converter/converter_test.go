@@ -147,6 +147,41 @@ var _ = Describe("Converter", func() {
 			MultiArchEntry("Lower request than capacity", int64(1111), int64(9999), int64(1111)),
 		)
 
+		DescribeTable("Should propagate autoGrowFilesystem only with ExpandDisks", func(expandDisksEnabled bool, autoGrowFilesystem *bool, expected bool) {
+			context := &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), ExpandDisksEnabled: expandDisksEnabled}
+			v1Disk := v1.Disk{
+				Name:               "myvolume",
+				DiskDevice:         v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.VirtIO}},
+				AutoGrowFilesystem: autoGrowFilesystem,
+			}
+			apiDisk := api.Disk{}
+			volumeStatusMap := map[string]v1.VolumeStatus{
+				"myvolume": {PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{}},
+			}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, map[string]deviceNamer{}, nil, volumeStatusMap)).To(Succeed())
+			Expect(apiDisk.AutoGrowFilesystem).To(Equal(expected))
+		},
+			Entry("enabled with ExpandDisks", true, pointer.P(true), true),
+			Entry("enabled without ExpandDisks", false, pointer.P(true), false),
+			Entry("disabled", true, pointer.P(false), false),
+			Entry("unset", true, nil, false),
+		)
+
+		It("Should convert the disk IOTune", func() {
+			context := &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH)}
+			v1Disk := v1.Disk{
+				Name:       "myvolume",
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.VirtIO}},
+				IOTune: &v1.DiskIOTune{
+					TotalIOPSSec: pointer.P(int64(500)),
+					Burst:        &v1.DiskIOTuneBurst{TotalIOPSSec: pointer.P(int64(1000))},
+				},
+			}
+			apiDisk := api.Disk{}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, map[string]deviceNamer{}, nil, map[string]v1.VolumeStatus{})).To(Succeed())
+			Expect(apiDisk.IOTune).To(Equal(&api.DiskIOTune{TotalIopsSec: 500, TotalIopsSecMax: 1000}))
+		})
+
 		DescribeTable("Should assign scsi controller to", func(diskDevice v1.DiskDevice) {
 			context := &ConverterContext{}
 			v1Disk := v1.Disk{
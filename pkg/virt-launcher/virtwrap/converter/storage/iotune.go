@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// ConvertDiskIOTune converts the I/O limits of a disk into its libvirt <iotune> element.
+// It returns nil when no limit is set.
+func ConvertDiskIOTune(ioTune *v1.DiskIOTune) *api.DiskIOTune {
+	if ioTune == nil {
+		return nil
+	}
+
+	apiIOTune := &api.DiskIOTune{
+		TotalBytesSec: toUint64(ioTune.TotalBytesSec),
+		ReadBytesSec:  toUint64(ioTune.ReadBytesSec),
+		WriteBytesSec: toUint64(ioTune.WriteBytesSec),
+		TotalIopsSec:  toUint64(ioTune.TotalIOPSSec),
+		ReadIopsSec:   toUint64(ioTune.ReadIOPSSec),
+		WriteIopsSec:  toUint64(ioTune.WriteIOPSSec),
+	}
+
+	if burst := ioTune.Burst; burst != nil {
+		apiIOTune.TotalBytesSecMax = toUint64(burst.TotalBytesSec)
+		apiIOTune.ReadBytesSecMax = toUint64(burst.ReadBytesSec)
+		apiIOTune.WriteBytesSecMax = toUint64(burst.WriteBytesSec)
+		apiIOTune.TotalIopsSecMax = toUint64(burst.TotalIOPSSec)
+		apiIOTune.ReadIopsSecMax = toUint64(burst.ReadIOPSSec)
+		apiIOTune.WriteIopsSecMax = toUint64(burst.WriteIOPSSec)
+
+		// The burst length only applies to the limits having a burst
+		length := toUint64(burst.LengthSeconds)
+		apiIOTune.TotalBytesSecMaxLength = burstLength(apiIOTune.TotalBytesSecMax, length)
+		apiIOTune.ReadBytesSecMaxLength = burstLength(apiIOTune.ReadBytesSecMax, length)
+		apiIOTune.WriteBytesSecMaxLength = burstLength(apiIOTune.WriteBytesSecMax, length)
+		apiIOTune.TotalIopsSecMaxLength = burstLength(apiIOTune.TotalIopsSecMax, length)
+		apiIOTune.ReadIopsSecMaxLength = burstLength(apiIOTune.ReadIopsSecMax, length)
+		apiIOTune.WriteIopsSecMaxLength = burstLength(apiIOTune.WriteIopsSecMax, length)
+	}
+
+	if *apiIOTune == (api.DiskIOTune{}) {
+		return nil
+	}
+	return apiIOTune
+}
+
+func toUint64(value *int64) uint64 {
+	if value == nil || *value < 0 {
+		return 0
+	}
+	return uint64(*value)
+}
+
+func burstLength(limit, length uint64) uint64 {
+	if limit == 0 {
+		return 0
+	}
+	return length
+}
@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/storage"
+)
+
+var _ = Describe("Disk IOTune conversion", func() {
+	DescribeTable("should convert", func(ioTune *v1.DiskIOTune, expected *api.DiskIOTune) {
+		Expect(storage.ConvertDiskIOTune(ioTune)).To(Equal(expected))
+	},
+		Entry("nothing without limits", nil, nil),
+		Entry("nothing with empty limits", &v1.DiskIOTune{Burst: &v1.DiskIOTuneBurst{}}, nil),
+		Entry("the base limits",
+			&v1.DiskIOTune{
+				ReadBytesSec:  pointer.P(int64(1000)),
+				WriteBytesSec: pointer.P(int64(2000)),
+				TotalIOPSSec:  pointer.P(int64(300)),
+			},
+			&api.DiskIOTune{
+				ReadBytesSec:  1000,
+				WriteBytesSec: 2000,
+				TotalIopsSec:  300,
+			},
+		),
+		Entry("the burst limits with their length",
+			&v1.DiskIOTune{
+				TotalBytesSec: pointer.P(int64(1000)),
+				TotalIOPSSec:  pointer.P(int64(300)),
+				Burst: &v1.DiskIOTuneBurst{
+					TotalIOPSSec:  pointer.P(int64(600)),
+					LengthSeconds: pointer.P(int64(10)),
+				},
+			},
+			&api.DiskIOTune{
+				TotalBytesSec:         1000,
+				TotalIopsSec:          300,
+				TotalIopsSecMax:       600,
+				TotalIopsSecMaxLength: 10,
+			},
+		),
+	)
+})
@@ -22,14 +22,19 @@ package storage
 import (
 	v1 "kubevirt.io/api/core/v1"
 
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
-type VirtiofsConfigurator struct{}
+type VirtiofsConfigurator struct {
+	hotplugVolumes map[string]v1.VolumeStatus
+}
 
-func NewVirtiofsConfigurator() VirtiofsConfigurator {
-	return VirtiofsConfigurator{}
+func NewVirtiofsConfigurator(hotplugVolumes map[string]v1.VolumeStatus) VirtiofsConfigurator {
+	return VirtiofsConfigurator{
+		hotplugVolumes: hotplugVolumes,
+	}
 }
 
 func (f VirtiofsConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -38,6 +43,17 @@ func (f VirtiofsConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *
 			continue
 		}
 
+		socketPath := virtiofs.VirtioFSSocketPath(fs.Name)
+		if storagetypes.IsHotplugFilesystem(vmi, fs.Name) {
+			// The virtiofsd of a hotplugged filesystem runs in the attachment pod,
+			// its socket is only usable once virt-handler mounted it into the launcher
+			hpStatus, ok := f.hotplugVolumes[fs.Name]
+			if !ok || (hpStatus.Phase != v1.HotplugVolumeMounted && hpStatus.Phase != v1.VolumeReady) {
+				continue
+			}
+			socketPath = virtiofs.HotplugVirtioFSSocketPath(fs.Name)
+		}
+
 		domain.Spec.Devices.Filesystems = append(domain.Spec.Devices.Filesystems,
 			api.FilesystemDevice{
 				Type:       "mount",
@@ -47,7 +63,7 @@ func (f VirtiofsConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *
 					Queue: "1024",
 				},
 				Source: &api.FilesystemSource{
-					Socket: virtiofs.VirtioFSSocketPath(fs.Name),
+					Socket: socketPath,
 				},
 				Target: &api.FilesystemTarget{
 					Dir: fs.Name,
@@ -23,6 +23,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/storage"
@@ -69,4 +71,35 @@ var _ = Describe("VirtioFS Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	DescribeTable("Should configure hotplugged filesystems", func(phase v1.VolumePhase, expectedFilesystems []api.FilesystemDevice) {
+		vmi := libvmi.New(
+			libvmi.WithHotplugFilesystemPVC("myfs"),
+		)
+		var domain api.Domain
+
+		hotplugVolumes := map[string]v1.VolumeStatus{
+			"myfs": {Name: "myfs", Phase: phase, HotplugVolume: &v1.HotplugVolumeStatus{}},
+		}
+		Expect(storage.NewVirtiofsConfigurator(hotplugVolumes).Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.Filesystems).To(Equal(expectedFilesystems))
+	},
+		Entry("not before the socket is mounted", v1.HotplugVolumeAttachedToNode, nil),
+		Entry("once the socket is mounted", v1.HotplugVolumeMounted, []api.FilesystemDevice{
+			{
+				Type:       "mount",
+				AccessMode: "passthrough",
+				Driver: &api.FilesystemDriver{
+					Type:  "virtiofs",
+					Queue: "1024",
+				},
+				Source: &api.FilesystemSource{
+					Socket: "/var/run/kubevirt/hotplug-disks/myfs.sock",
+				},
+				Target: &api.FilesystemTarget{
+					Dir: "myfs",
+				},
+			},
+		}),
+	)
 })
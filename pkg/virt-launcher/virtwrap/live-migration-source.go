@@ -20,6 +20,7 @@
 package virtwrap
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -86,6 +87,9 @@ type migrationMonitor struct {
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+
+	// migratedVolumeTargets maps the migrated volumes to the target device of their disk
+	migratedVolumeTargets map[string]string
 }
 
 type inflightMigrationAborted struct {
@@ -239,6 +243,10 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 			} else {
 				disks.shared[volume.Name] = true
 			}
+		case storagetypes.IsPersistentContainerDisk(&volume):
+			// the overlay is on a shared PVC, virt-handler only allows
+			// to migrate the VMI when the PVC is shared
+			disks.shared[volume.Name] = true
 		case volSrc.HostDisk != nil:
 			if _, ok := migrateDisks[volume.Name]; ok {
 				disks.localToMigrate[volume.Name] = true
@@ -661,6 +669,7 @@ func (m *migrationMonitor) startMonitor() {
 				m.l.setMigrationResult(true, aborted.message, aborted.abortStatus)
 				return
 			}
+			m.updateMigratedVolumesProgress(dom)
 			logInterval++
 			if logInterval%monitorLogInterval == 0 {
 				logMigrationInfo(logger, string(migrationUID), jobStats)
@@ -675,6 +684,62 @@ func (m *migrationMonitor) startMonitor() {
 	}
 }
 
+// updateMigratedVolumesProgress records how much of every migrated volume has been copied, based on the
+// block jobs libvirt runs to mirror the disks to the target
+func (m *migrationMonitor) updateMigratedVolumesProgress(dom cli.VirDomain) {
+	if len(m.vmi.Status.MigratedVolumes) == 0 {
+		return
+	}
+	if m.migratedVolumeTargets == nil {
+		disks, err := util.GetAllDomainDisks(dom)
+		if err != nil {
+			log.Log.Object(m.vmi).Reason(err).Warning("failed to get the domain disks to report the volume migration progress")
+			return
+		}
+		migratedVolumes := make(map[string]bool)
+		for _, v := range m.vmi.Status.MigratedVolumes {
+			migratedVolumes[v.VolumeName] = true
+		}
+		m.migratedVolumeTargets = make(map[string]string)
+		// the name of the volume should match the alias
+		for _, disk := range disks {
+			if name := disk.Alias.GetName(); migratedVolumes[name] {
+				m.migratedVolumeTargets[name] = disk.Target.Device
+			}
+		}
+	}
+
+	var volumes []v1.StorageMigratedVolumeProgress
+	for _, v := range m.vmi.Status.MigratedVolumes {
+		target, ok := m.migratedVolumeTargets[v.VolumeName]
+		if !ok {
+			continue
+		}
+		info, err := dom.GetBlockJobInfo(target, 0)
+		if err != nil {
+			log.Log.Object(m.vmi).Reason(err).V(4).Infof("failed to get the block job info of volume %s", v.VolumeName)
+			continue
+		}
+		volumes = append(volumes, v1.StorageMigratedVolumeProgress{
+			VolumeName:  v.VolumeName,
+			BytesCopied: int64(info.Cur),
+			TotalBytes:  int64(info.End),
+		})
+	}
+	if len(volumes) == 0 {
+		return
+	}
+	volumesJSON, err := json.Marshal(volumes)
+	if err != nil {
+		log.Log.Object(m.vmi).Reason(err).Warning("failed to marshal the volume migration progress")
+		return
+	}
+
+	m.l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.Volumes = string(volumesJSON)
+	})
+}
+
 // logMigrationInfo logs the same migration info as `virsh -r domjobinfo`
 func logMigrationInfo(logger *log.FilteredLogger, uid string, info *libvirt.DomainJobInfo) {
 	bToMiB := func(bytes uint64) uint64 {
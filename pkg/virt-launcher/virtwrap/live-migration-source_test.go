@@ -25,7 +25,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
 	"k8s.io/apimachinery/pkg/types"
+	"libvirt.org/go/libvirt"
 	"libvirt.org/go/libvirtxml"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -163,6 +165,63 @@ var _ = Describe("Live migration source", func() {
 					localToMigrate: map[string]bool{vol: true},
 				})))
 		})
+
+		It("should classify persistent containerDisks as shared volumes", func() {
+			vmi := libvmi.New(
+				libvmi.WithContainerDisk("persistent", "registry:5000/disk"),
+				libvmi.WithContainerDisk("ephemeral", "registry:5000/disk"),
+			)
+			vmi.Spec.Volumes[0].ContainerDisk.Persistent = &v1.PersistentContainerDisk{}
+			Expect(classifyVolumesForMigration(vmi)).To(PointTo(Equal(
+				migrationDisks{
+					shared:         map[string]bool{"persistent": true},
+					generated:      map[string]bool{"ephemeral": true},
+					localToMigrate: map[string]bool{},
+				})))
+		})
+	})
+
+	Context("updateMigratedVolumesProgress", func() {
+		const domainXML = `<domain type="kvm">
+  <devices>
+    <disk type="file" device="disk">
+      <source file="/var/run/kubevirt-private/vmi-disks/datavol/disk.img"></source>
+      <target dev="vda" bus="virtio"></target>
+      <alias name="ua-datavol"></alias>
+    </disk>
+    <disk type="file" device="disk">
+      <source file="/var/run/kubevirt-private/vmi-disks/shared/disk.img"></source>
+      <target dev="vdb" bus="virtio"></target>
+      <alias name="ua-shared"></alias>
+    </disk>
+  </devices>
+</domain>`
+
+		It("should record the block job progress of the migrated volumes", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDomain := cli.NewMockVirDomain(ctrl)
+			vmi := libvmi.New(libvmistatus.WithStatus(
+				libvmistatus.New(
+					libvmistatus.WithMigratedVolume(v1.StorageMigratedVolumeInfo{
+						VolumeName: "datavol",
+					}),
+				),
+			))
+			monitor := &migrationMonitor{
+				l:   &LibvirtDomainManager{metadataCache: metadata.NewCache()},
+				vmi: vmi,
+			}
+
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(domainXML, nil).Times(1)
+			mockDomain.EXPECT().GetBlockJobInfo("vda", libvirt.DomainBlockJobInfoFlags(0)).Return(&libvirt.DomainBlockJobInfo{Cur: 512, End: 2048}, nil).Times(2)
+
+			monitor.updateMigratedVolumesProgress(mockDomain)
+			monitor.updateMigratedVolumesProgress(mockDomain)
+
+			migrationMetadata, exists := monitor.l.metadataCache.Migration.Load()
+			Expect(exists).To(BeTrue())
+			Expect(migrationMetadata.Volumes).To(MatchJSON(`[{"volumeName":"datavol","bytesCopied":512,"totalBytes":2048}]`))
+		})
 	})
 
 	Context("getDiskPathFromSource", func() {
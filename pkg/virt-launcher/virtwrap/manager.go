@@ -156,6 +156,7 @@ type DomainManager interface {
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	BackupVirtualMachine(*v1.VirtualMachineInstance, *backupv1.BackupOptions) error
 	RedefineCheckpoint(*v1.VirtualMachineInstance, *backupv1.BackupCheckpoint) (checkpointInvalid bool, err error)
+	DeleteCheckpoint(*v1.VirtualMachineInstance, *backupv1.BackupCheckpoint) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
@@ -823,6 +824,10 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("failed to craete downwardMetric disk: %v", err)
 	}
 
+	if err := l.defineBackendStorageEncryptionSecrets(vmi, domain); err != nil {
+		return domain, err
+	}
+
 	// set drivers cache mode
 	for i := range domain.Spec.Devices.Disks {
 		err := converter.SetDriverCacheMode(&domain.Spec.Devices.Disks[i], l.directIOChecker)
@@ -1031,10 +1036,15 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 			return nil, fmt.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV/SEV-ES=%v, SEV-SNP=%v, TDX=%v", secureBoot, sev, snp, tdx)
 		}
 
+		nvramSecret, err := nvramEncryptionSecret(vmi)
+		if err != nil {
+			return nil, err
+		}
 		efiConf = &converter.EFIConfiguration{
-			EFICode:      l.efiEnvironment.EFICode(secureBoot, vmType),
-			EFIVars:      l.efiEnvironment.EFIVars(secureBoot, vmType),
-			SecureLoader: secureBoot,
+			EFICode:               l.efiEnvironment.EFICode(secureBoot, vmType),
+			EFIVars:               l.efiEnvironment.EFIVars(secureBoot, vmType),
+			SecureLoader:          secureBoot,
+			NVRamEncryptionSecret: nvramSecret,
 		}
 	}
 
@@ -1092,6 +1102,11 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 	}
 	c.DisksInfo = l.disksInfo
 
+	c.TPMStateEncryptionSecret, err = tpmStateEncryptionSecret(vmi)
+	if err != nil {
+		return nil, err
+	}
+
 	if !isMigrationTarget {
 		sriovDevices, err := sriov.CreateHostDevices(vmi)
 		if err != nil {
@@ -1219,6 +1234,14 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		return nil, err
 	}
 
+	if err := syncFilesystems(domain, oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
+	if err := syncDiskIOTune(domain, oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
 	var domainAttachments map[string]string
 	if options != nil {
 		domainAttachments = options.GetInterfaceDomainAttachment()
@@ -1317,6 +1340,7 @@ func (l *LibvirtDomainManager) syncDisks(
 	}
 
 	// Resize and notify the VM about changed disks
+	var expandedDisks []api.Disk
 	for _, disk := range domain.Spec.Devices.Disks {
 		if shouldExpandOnline(dom, disk) {
 			possibleGuestSize, ok := possibleGuestSize(disk)
@@ -1331,9 +1355,12 @@ func (l *LibvirtDomainManager) syncDisks(
 			err := dom.BlockResize(getSourceFile(disk), uint64(possibleGuestSize), flags)
 			if err != nil {
 				logger.Reason(err).Errorf("libvirt failed to expand disk image %v", disk)
+			} else if disk.AutoGrowFilesystem {
+				expandedDisks = append(expandedDisks, disk)
 			}
 		}
 	}
+	l.storageManager.GrowGuestFilesystems(vmi, expandedDisks)
 
 	return nil
 }
@@ -1348,6 +1375,16 @@ func (l *LibvirtDomainManager) startDomain(
 	}
 
 	createFlags := getDomainCreateFlags(vmi)
+
+	savedStateFile, err := storage.SavedStateFile(vmi)
+	if err != nil {
+		logger.Reason(err).Error("Failed to look up the saved state of the VirtualMachineInstance.")
+		return err
+	}
+	if savedStateFile != "" {
+		return l.restoreDomain(vmi, dom, savedStateFile, createFlags)
+	}
+
 	if err := dom.CreateWithFlags(createFlags); err != nil {
 		logger.Reason(err).
 			Errorf("Failed to start VirtualMachineInstance with flags %v.", createFlags)
@@ -1361,6 +1398,39 @@ func (l *LibvirtDomainManager) startDomain(
 	return nil
 }
 
+// restoreDomain starts the defined domain from a saved device and RAM state
+// instead of booting it
+func (l *LibvirtDomainManager) restoreDomain(
+	vmi *v1.VirtualMachineInstance,
+	dom cli.VirDomain,
+	savedStateFile string,
+	createFlags libvirt.DomainCreateFlags,
+) error {
+	logger := log.Log.Object(vmi)
+
+	domainXML, err := dom.GetXMLDesc(libvirt.DOMAIN_XML_SECURE | libvirt.DOMAIN_XML_MIGRATABLE)
+	if err != nil {
+		logger.Reason(err).Error("Failed to get the domain XML to restore the saved state.")
+		return err
+	}
+
+	restoreFlags := libvirt.DOMAIN_SAVE_RUNNING
+	if createFlags&libvirt.DOMAIN_START_PAUSED != 0 {
+		restoreFlags = libvirt.DOMAIN_SAVE_PAUSED
+	}
+	if err := l.virConn.DomainRestoreFlags(savedStateFile, domainXML, restoreFlags); err != nil {
+		logger.Reason(err).
+			Errorf("Failed to start VirtualMachineInstance from saved state %s.", savedStateFile)
+		return err
+	}
+
+	logger.Infof("Domain started from saved state %s.", savedStateFile)
+	if vmi.ShouldStartPaused() {
+		l.paused.add(vmi.UID)
+	}
+	return nil
+}
+
 func (l *LibvirtDomainManager) lookupOrCreateVirDomain(
 	domain *api.Domain,
 	vmi *v1.VirtualMachineInstance,
@@ -1476,6 +1546,168 @@ func checkIfDiskReadyToUseFunc(filename string) (bool, error) {
 	return true, nil
 }
 
+// syncFilesystems hot attaches and detaches the virtiofs filesystems whose virtiofsd
+// socket has been mounted by virt-handler into the hotplug directory.
+func syncFilesystems(domain *api.Domain, spec *api.DomainSpec, dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	logger := log.Log.Object(vmi)
+
+	for _, detachFilesystem := range getDetachedFilesystems(spec.Devices.Filesystems, domain.Spec.Devices.Filesystems) {
+		logger.V(1).Infof("Detaching filesystem %s", detachFilesystem.Target.Dir)
+		detachBytes, err := xml.Marshal(detachFilesystem)
+		if err != nil {
+			logger.Reason(err).Error("marshalling detached filesystem failed")
+			return err
+		}
+		if err := dom.DetachDeviceFlags(string(detachBytes), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			logger.Reason(err).Error("detaching filesystem")
+			return err
+		}
+	}
+	for _, attachFilesystem := range getAttachedFilesystems(spec.Devices.Filesystems, domain.Spec.Devices.Filesystems) {
+		logger.V(1).Infof("Attaching filesystem %s", attachFilesystem.Target.Dir)
+		attachBytes, err := xml.Marshal(attachFilesystem)
+		if err != nil {
+			logger.Reason(err).Error("marshalling attached filesystem failed")
+			return err
+		}
+		if err := dom.AttachDeviceFlags(string(attachBytes), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			logger.Reason(err).Error("attaching filesystem")
+			return err
+		}
+	}
+	return nil
+}
+
+// syncDiskIOTune applies the changed I/O limits of the disks to the running domain.
+func syncDiskIOTune(domain *api.Domain, spec *api.DomainSpec, dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	logger := log.Log.Object(vmi)
+
+	for _, disk := range getIOTuneUpdatedDisks(spec.Devices.Disks, domain.Spec.Devices.Disks) {
+		logger.V(1).Infof("Updating I/O limits of disk %s, target %s", disk.Alias.GetName(), disk.Target.Device)
+		if err := dom.SetBlockIoTune(disk.Target.Device, toBlockIoTuneParameters(disk.IOTune), affectDomainLiveAndConfigLibvirtFlags); err != nil {
+			logger.Reason(err).Error("updating disk I/O limits")
+			return err
+		}
+	}
+	return nil
+}
+
+func getIOTuneUpdatedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
+	oldDiskMap := make(map[string]api.Disk)
+	for _, disk := range oldDisks {
+		oldDiskMap[disk.Target.Device] = disk
+	}
+	var res []api.Disk
+	for _, newDisk := range newDisks {
+		if newDisk.Device != "disk" && newDisk.Device != "lun" {
+			continue
+		}
+		oldDisk, ok := oldDiskMap[newDisk.Target.Device]
+		if !ok {
+			continue
+		}
+		if equality.Semantic.DeepEqual(getDiskIOTune(oldDisk), getDiskIOTune(newDisk)) {
+			continue
+		}
+		res = append(res, newDisk)
+	}
+	return res
+}
+
+func getDiskIOTune(disk api.Disk) api.DiskIOTune {
+	if disk.IOTune == nil {
+		return api.DiskIOTune{}
+	}
+	return *disk.IOTune
+}
+
+// toBlockIoTuneParameters sets every limit explicitly, libvirt keeps the previous value of the omitted ones
+func toBlockIoTuneParameters(ioTune *api.DiskIOTune) *libvirt.DomainBlockIoTuneParameters {
+	if ioTune == nil {
+		ioTune = &api.DiskIOTune{}
+	}
+	return &libvirt.DomainBlockIoTuneParameters{
+		TotalBytesSecSet:          true,
+		TotalBytesSec:             ioTune.TotalBytesSec,
+		ReadBytesSecSet:           true,
+		ReadBytesSec:              ioTune.ReadBytesSec,
+		WriteBytesSecSet:          true,
+		WriteBytesSec:             ioTune.WriteBytesSec,
+		TotalIopsSecSet:           true,
+		TotalIopsSec:              ioTune.TotalIopsSec,
+		ReadIopsSecSet:            true,
+		ReadIopsSec:               ioTune.ReadIopsSec,
+		WriteIopsSecSet:           true,
+		WriteIopsSec:              ioTune.WriteIopsSec,
+		TotalBytesSecMaxSet:       true,
+		TotalBytesSecMax:          ioTune.TotalBytesSecMax,
+		ReadBytesSecMaxSet:        true,
+		ReadBytesSecMax:           ioTune.ReadBytesSecMax,
+		WriteBytesSecMaxSet:       true,
+		WriteBytesSecMax:          ioTune.WriteBytesSecMax,
+		TotalIopsSecMaxSet:        true,
+		TotalIopsSecMax:           ioTune.TotalIopsSecMax,
+		ReadIopsSecMaxSet:         true,
+		ReadIopsSecMax:            ioTune.ReadIopsSecMax,
+		WriteIopsSecMaxSet:        true,
+		WriteIopsSecMax:           ioTune.WriteIopsSecMax,
+		TotalBytesSecMaxLengthSet: true,
+		TotalBytesSecMaxLength:    ioTune.TotalBytesSecMaxLength,
+		ReadBytesSecMaxLengthSet:  true,
+		ReadBytesSecMaxLength:     ioTune.ReadBytesSecMaxLength,
+		WriteBytesSecMaxLengthSet: true,
+		WriteBytesSecMaxLength:    ioTune.WriteBytesSecMaxLength,
+		TotalIopsSecMaxLengthSet:  true,
+		TotalIopsSecMaxLength:     ioTune.TotalIopsSecMaxLength,
+		ReadIopsSecMaxLengthSet:   true,
+		ReadIopsSecMaxLength:      ioTune.ReadIopsSecMaxLength,
+		WriteIopsSecMaxLengthSet:  true,
+		WriteIopsSecMaxLength:     ioTune.WriteIopsSecMaxLength,
+	}
+}
+
+func isHotplugFilesystem(fs api.FilesystemDevice) bool {
+	return fs.Source != nil && fs.Target != nil && strings.HasPrefix(fs.Source.Socket, v1.HotplugDiskDir)
+}
+
+func getDetachedFilesystems(oldFilesystems, newFilesystems []api.FilesystemDevice) []api.FilesystemDevice {
+	newFilesystemMap := make(map[string]api.FilesystemDevice)
+	for _, fs := range newFilesystems {
+		if fs.Target != nil {
+			newFilesystemMap[fs.Target.Dir] = fs
+		}
+	}
+	res := make([]api.FilesystemDevice, 0)
+	for _, oldFilesystem := range oldFilesystems {
+		if !isHotplugFilesystem(oldFilesystem) {
+			continue
+		}
+		if _, ok := newFilesystemMap[oldFilesystem.Target.Dir]; !ok {
+			res = append(res, oldFilesystem)
+		}
+	}
+	return res
+}
+
+func getAttachedFilesystems(oldFilesystems, newFilesystems []api.FilesystemDevice) []api.FilesystemDevice {
+	oldFilesystemMap := make(map[string]api.FilesystemDevice)
+	for _, fs := range oldFilesystems {
+		if fs.Target != nil {
+			oldFilesystemMap[fs.Target.Dir] = fs
+		}
+	}
+	res := make([]api.FilesystemDevice, 0)
+	for _, newFilesystem := range newFilesystems {
+		if !isHotplugFilesystem(newFilesystem) {
+			continue
+		}
+		if _, ok := oldFilesystemMap[newFilesystem.Target.Dir]; !ok {
+			res = append(res, newFilesystem)
+		}
+	}
+	return res
+}
+
 func isHotplugDisk(disk api.Disk) bool {
 	return strings.HasPrefix(getBackendSource(disk), v1.HotplugDiskDir)
 }
@@ -2570,3 +2802,7 @@ func (l *LibvirtDomainManager) BackupVirtualMachine(vmi *v1.VirtualMachineInstan
 func (l *LibvirtDomainManager) RedefineCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *backupv1.BackupCheckpoint) (checkpointInvalid bool, err error) {
 	return l.storageManager.RedefineCheckpoint(vmi, checkpoint)
 }
+
+func (l *LibvirtDomainManager) DeleteCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *backupv1.BackupCheckpoint) error {
+	return l.storageManager.DeleteCheckpoint(vmi, checkpoint)
+}
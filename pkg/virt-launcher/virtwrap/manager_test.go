@@ -526,6 +526,27 @@ var _ = Describe("Manager", func() {
 			Expect(newspec).ToNot(BeNil())
 		})
 
+		DescribeTable("should start a VirtualMachineInstance from its saved state", func(startPaused bool, restoreFlags libvirt.DomainSaveRestoreFlags) {
+			const savedStateFile = "/var/run/kubevirt-private/vmi-disks/memory/testvmi-memory.memory.dump"
+			vmi := newVMI(testNamespace, testVmName)
+			createFlags := libvirt.DOMAIN_NONE
+			if startPaused {
+				strategy := v1.StartStrategyPaused
+				vmi.Spec.StartStrategy = &strategy
+				createFlags = libvirt.DOMAIN_START_PAUSED
+			}
+
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DOMAIN_XML_SECURE|libvirt.DOMAIN_XML_MIGRATABLE).Return("<domain></domain>", nil)
+			mockLibvirt.ConnectionEXPECT().DomainRestoreFlags(savedStateFile, "<domain></domain>", restoreFlags).Return(nil)
+			manager, _ := newLibvirtDomainManagerDefault()
+			libvirtManager := manager.(*LibvirtDomainManager)
+			Expect(libvirtManager.restoreDomain(vmi, mockLibvirt.VirtDomain, savedStateFile, createFlags)).To(Succeed())
+			Expect(libvirtManager.paused.contains(vmi.UID)).To(Equal(startPaused))
+		},
+			Entry("running", false, libvirt.DOMAIN_SAVE_RUNNING),
+			Entry("paused", true, libvirt.DOMAIN_SAVE_PAUSED),
+		)
+
 		It("should leave a defined and started VirtualMachineInstance alone", func() {
 			vmi := newVMI(testNamespace, testVmName)
 			domainSpec := expectedDomainFor(vmi)
@@ -2702,6 +2723,90 @@ var _ = Describe("getAttachedDisks", func() {
 	)
 })
 
+var _ = Describe("hotplugged filesystems", func() {
+	newFilesystem := func(name, socket string) api.FilesystemDevice {
+		return api.FilesystemDevice{
+			Type:       "mount",
+			AccessMode: "passthrough",
+			Driver:     &api.FilesystemDriver{Type: "virtiofs", Queue: "1024"},
+			Source:     &api.FilesystemSource{Socket: socket},
+			Target:     &api.FilesystemTarget{Dir: name},
+		}
+	}
+	hotplugged := newFilesystem("hotplugged", filepath.Join(v1.HotplugDiskDir, "hotplugged.sock"))
+	static := newFilesystem("static", "/var/run/kubevirt/virtiofs-containers/static.sock")
+
+	DescribeTable("getAttachedFilesystems should return the correct values", func(oldFilesystems, newFilesystems, expected []api.FilesystemDevice) {
+		Expect(getAttachedFilesystems(oldFilesystems, newFilesystems)).To(Equal(expected))
+	},
+		Entry("be empty with empty old and new", []api.FilesystemDevice{}, []api.FilesystemDevice{}, []api.FilesystemDevice{}),
+		Entry("be empty with old and new being identical",
+			[]api.FilesystemDevice{static, hotplugged}, []api.FilesystemDevice{static, hotplugged}, []api.FilesystemDevice{}),
+		Entry("contain a new hotplugged filesystem",
+			[]api.FilesystemDevice{static}, []api.FilesystemDevice{static, hotplugged}, []api.FilesystemDevice{hotplugged}),
+		Entry("ignore filesystems which are not hotplugged",
+			[]api.FilesystemDevice{}, []api.FilesystemDevice{static}, []api.FilesystemDevice{}),
+	)
+
+	DescribeTable("getDetachedFilesystems should return the correct values", func(oldFilesystems, newFilesystems, expected []api.FilesystemDevice) {
+		Expect(getDetachedFilesystems(oldFilesystems, newFilesystems)).To(Equal(expected))
+	},
+		Entry("be empty with empty old and new", []api.FilesystemDevice{}, []api.FilesystemDevice{}, []api.FilesystemDevice{}),
+		Entry("be empty with old and new being identical",
+			[]api.FilesystemDevice{static, hotplugged}, []api.FilesystemDevice{static, hotplugged}, []api.FilesystemDevice{}),
+		Entry("contain a removed hotplugged filesystem",
+			[]api.FilesystemDevice{static, hotplugged}, []api.FilesystemDevice{static}, []api.FilesystemDevice{hotplugged}),
+		Entry("ignore filesystems which are not hotplugged",
+			[]api.FilesystemDevice{static}, []api.FilesystemDevice{}, []api.FilesystemDevice{}),
+	)
+})
+
+var _ = Describe("disk I/O limits", func() {
+	newDisk := func(device, target string, ioTune *api.DiskIOTune) api.Disk {
+		return api.Disk{
+			Device: device,
+			Target: api.DiskTarget{Device: target},
+			Alias:  api.NewUserDefinedAlias(target),
+			IOTune: ioTune,
+		}
+	}
+	limited := &api.DiskIOTune{TotalIopsSec: 500}
+
+	DescribeTable("getIOTuneUpdatedDisks should return the correct values", func(oldDisks, newDisks, expected []api.Disk) {
+		Expect(getIOTuneUpdatedDisks(oldDisks, newDisks)).To(Equal(expected))
+	},
+		Entry("be empty without changes",
+			[]api.Disk{newDisk("disk", "vda", limited)}, []api.Disk{newDisk("disk", "vda", limited)}, nil),
+		Entry("be empty when nil and empty limits are compared",
+			[]api.Disk{newDisk("disk", "vda", nil)}, []api.Disk{newDisk("disk", "vda", &api.DiskIOTune{})}, nil),
+		Entry("contain a disk getting limits",
+			[]api.Disk{newDisk("disk", "vda", nil)}, []api.Disk{newDisk("disk", "vda", limited)}, []api.Disk{newDisk("disk", "vda", limited)}),
+		Entry("contain a disk losing its limits",
+			[]api.Disk{newDisk("lun", "sda", limited)}, []api.Disk{newDisk("lun", "sda", nil)}, []api.Disk{newDisk("lun", "sda", nil)}),
+		Entry("ignore newly attached disks",
+			[]api.Disk{}, []api.Disk{newDisk("disk", "vda", limited)}, nil),
+		Entry("ignore cdroms",
+			[]api.Disk{newDisk("cdrom", "sda", nil)}, []api.Disk{newDisk("cdrom", "sda", limited)}, nil),
+	)
+
+	It("should set every limit of an updated disk", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockDomain := cli.NewMockVirDomain(ctrl)
+		oldSpec := &api.DomainSpec{Devices: api.Devices{Disks: []api.Disk{newDisk("disk", "vda", &api.DiskIOTune{ReadBytesSec: 1000})}}}
+		domain := &api.Domain{Spec: api.DomainSpec{Devices: api.Devices{Disks: []api.Disk{newDisk("disk", "vda", limited)}}}}
+
+		mockDomain.EXPECT().SetBlockIoTune("vda", gomock.Any(), affectDomainLiveAndConfigLibvirtFlags).DoAndReturn(
+			func(_ string, params *libvirt.DomainBlockIoTuneParameters, _ libvirt.DomainModificationImpact) error {
+				Expect(params.TotalIopsSecSet).To(BeTrue())
+				Expect(params.TotalIopsSec).To(Equal(uint64(500)))
+				Expect(params.ReadBytesSecSet).To(BeTrue())
+				Expect(params.ReadBytesSec).To(BeZero())
+				return nil
+			})
+		Expect(syncDiskIOTune(domain, oldSpec, mockDomain, &v1.VirtualMachineInstance{})).To(Succeed())
+	})
+})
+
 var _ = Describe("getDetachedDisks", func() {
 	DescribeTable("should return the correct values", func(oldDisks, newDisks, expected []api.Disk) {
 		res := getDetachedDisks(oldDisks, newDisks)
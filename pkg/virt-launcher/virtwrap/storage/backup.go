@@ -36,6 +36,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -111,6 +112,7 @@ func (m *StorageManager) initializeBackupMetadata(backupOptions *backupv1.Backup
 
 	b := api.BackupMetadata{
 		Name:           backupOptions.BackupName,
+		Mode:           string(backupOptions.Mode),
 		StartTimestamp: backupOptions.BackupStartTime,
 		SkipQuiesce:    backupOptions.SkipQuiesce,
 	}
@@ -151,6 +153,12 @@ func (m *StorageManager) backup(vmi *v1.VirtualMachineInstance, backupOptions *b
 			}
 		}(backupPath)
 	}
+	if backupOptions.Mode == backupv1.PullMode {
+		if err := preparePullModeSocket(cbt.BackupNBDSocketPath); err != nil {
+			logger.Reason(err).Error("error preparing the backup export socket")
+			return err
+		}
+	}
 	domainBackup, domainCheckpoint, backupVolumesInfo := generateDomainBackup(domainDisks, backupOptions, backupPath)
 	backupXML, err := xml.Marshal(domainBackup)
 	if err != nil {
@@ -201,7 +209,7 @@ func (m *StorageManager) backup(vmi *v1.VirtualMachineInstance, backupOptions *b
 	return dom.BackupBegin(strings.ToLower(string(backupXML)), strings.ToLower(string(checkpointXML)), 0)
 }
 
-func generateDomainBackup(disks []api.Disk, backupOptions *backupv1.BackupOptions, backupPath string) (*api.DomainBackup, *api.DomainCheckpoint, []backupv1.BackupVolumeInfo) {
+func generateDomainBackup(disks []api.Disk, backupOptions *backupv1.BackupOptions, backupPath string) (*api.DomainBackup, *api.DomainCheckpoint, []v1.BackupVolumeInfo) {
 	domainBackup := &api.DomainBackup{
 		Mode: string(backupOptions.Mode),
 	}
@@ -209,9 +217,16 @@ func generateDomainBackup(disks []api.Disk, backupOptions *backupv1.BackupOption
 		log.Log.Infof("Generating incremental backup %s from checkpoint: %s", backupOptions.BackupName, *backupOptions.Incremental)
 		domainBackup.Incremental = backupOptions.Incremental
 	}
+	pullMode := backupOptions.Mode == backupv1.PullMode
+	if pullMode {
+		domainBackup.Server = &api.BackupServer{
+			Transport: "unix",
+			Socket:    cbt.BackupNBDSocketPath,
+		}
+	}
 	backupDisks := &api.BackupDisks{}
 	checkpointDisks := &api.CheckpointDisks{}
-	var backupVolumesInfo []backupv1.BackupVolumeInfo
+	var backupVolumesInfo []v1.BackupVolumeInfo
 	// the name of the volume should match the alias
 	for _, disk := range disks {
 		if disk.Target.Device == "" {
@@ -232,8 +247,14 @@ func generateDomainBackup(disks []api.Disk, backupOptions *backupv1.BackupOption
 					File: targetQCOW2File(backupPath, backupOptions.BackupName, volumeName),
 				}
 			}
+			if pullMode {
+				backupDisk.ExportName = cbt.BackupExportName(volumeName)
+				if isIncrementalBackup(backupOptions) {
+					backupDisk.ExportBitmap = cbt.BackupExportBitmapName(volumeName)
+				}
+			}
 			checkpointDisk.Checkpoint = "bitmap"
-			backupVolumesInfo = append(backupVolumesInfo, backupv1.BackupVolumeInfo{
+			backupVolumesInfo = append(backupVolumesInfo, v1.BackupVolumeInfo{
 				VolumeName: volumeName,
 				DiskTarget: disk.Target.Device,
 			})
@@ -261,6 +282,18 @@ func getBackupPath(backupOptions *backupv1.BackupOptions, vmiName string) string
 	return filepath.Join(*backupOptions.PushPath, vmiName, backupNameWithTime)
 }
 
+// preparePullModeSocket makes sure the directory of the NBD socket exists
+// and that no socket of a previous pull mode backup is left behind
+func preparePullModeSocket(socketPath string) error {
+	if err := kutil.MkdirAllWithNosec(filepath.Dir(socketPath)); err != nil {
+		return fmt.Errorf("error creating dir for backup export socket: %w", err)
+	}
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error removing stale backup export socket: %w", err)
+	}
+	return nil
+}
+
 func targetQCOW2File(pushPath, backupName, volumeName string) string {
 	fileName := fmt.Sprintf("%s-%s.qcow2", backupName, volumeName)
 	return filepath.Join(pushPath, fileName)
@@ -298,9 +331,15 @@ func HandleBackupJobCompletedEvent(domain cli.VirDomain, event *libvirt.DomainEv
 	case libvirt.DOMAIN_JOB_COMPLETED:
 		logger.Info("Backup has been completed successfully")
 	case libvirt.DOMAIN_JOB_CANCELLED:
-		logger.Info("Backup has been aborted")
-		message = "backup aborted"
-		failed = backupMetadata.Mode == string(backupv1.PushMode)
+		if backupMetadata.Mode == string(backupv1.PullMode) {
+			// A pull mode backup job keeps running until it is aborted,
+			// which is how the export is stopped once it is not needed anymore
+			logger.Info("Backup export has been stopped")
+		} else {
+			logger.Info("Backup has been aborted")
+			message = "backup aborted"
+			failed = true
+		}
 	case libvirt.DOMAIN_JOB_FAILED:
 		logger.Info("Backup has failed")
 		failed = true
@@ -463,6 +502,39 @@ func (m *StorageManager) RedefineCheckpoint(vmi *v1.VirtualMachineInstance, chec
 	return false, nil
 }
 
+// DeleteCheckpoint deletes a checkpoint that fell out of the retention policy
+// of a backup tracker. A checkpoint that is already gone, e.g. because it was
+// not redefined after a VM restart, is not an error.
+func (m *StorageManager) DeleteCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *backupv1.BackupCheckpoint) error {
+	logger := log.Log.With("checkpointName", checkpoint.Name)
+	logger.Info("Deleting checkpoint")
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := m.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return fmt.Errorf("failed to lookup domain %s: %v", domName, err)
+	}
+	defer dom.Free()
+
+	domainCheckpoint, err := dom.CheckpointLookupByName(checkpoint.Name, 0)
+	if err != nil {
+		var libvirtErr libvirt.Error
+		if errors.As(err, &libvirtErr) && libvirtErr.Code == libvirt.ERR_NO_DOMAIN_CHECKPOINT {
+			logger.V(3).Info("Checkpoint does not exist, nothing to delete")
+			return nil
+		}
+		return fmt.Errorf("failed to lookup checkpoint %s: %v", checkpoint.Name, err)
+	}
+	defer domainCheckpoint.Free()
+
+	if err := domainCheckpoint.Delete(0); err != nil {
+		return fmt.Errorf("failed to delete checkpoint %s: %v", checkpoint.Name, err)
+	}
+
+	logger.Info("Checkpoint deleted successfully")
+	return nil
+}
+
 // findDisksWithCheckpointBitmap iterates over all domain disks and returns those
 // that have the specified checkpoint bitmap in their qcow2 file.
 func findDisksWithCheckpointBitmap(dom cli.VirDomain, checkpointName string) (*api.CheckpointDisks, []string, error) {
@@ -37,6 +37,7 @@ import (
 
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
@@ -454,6 +455,50 @@ var _ = Describe("Backup", func() {
 			Expect(volumesInfo[1].VolumeName).To(Equal("datadisk"))
 			Expect(volumesInfo[1].DiskTarget).To(Equal("vdb"))
 		})
+
+		DescribeTable("should export the disks over NBD in pull mode", func(incremental *string, expectedBitmap string) {
+			backupOptions.Mode = backupv1.PullMode
+			backupOptions.PushPath = nil
+			backupOptions.Incremental = incremental
+
+			disks := []api.Disk{
+				{
+					Target: api.DiskTarget{Device: "vda"},
+					Source: api.DiskSource{DataStore: &api.DataStore{}},
+					Alias:  api.NewUserDefinedAlias("disk0"),
+				},
+			}
+
+			domainBackup, _, _ := generateDomainBackup(disks, backupOptions, "")
+
+			Expect(domainBackup.Mode).To(Equal(string(backupv1.PullMode)))
+			Expect(domainBackup.Server).To(Equal(&api.BackupServer{
+				Transport: "unix",
+				Socket:    cbt.BackupNBDSocketPath,
+			}))
+			Expect(domainBackup.BackupDisks.Disks).To(HaveLen(1))
+			Expect(domainBackup.BackupDisks.Disks[0].Target).To(BeNil())
+			Expect(domainBackup.BackupDisks.Disks[0].ExportName).To(Equal("disk0"))
+			Expect(domainBackup.BackupDisks.Disks[0].ExportBitmap).To(Equal(expectedBitmap))
+		},
+			Entry("for a full backup", nil, ""),
+			Entry("for an incremental backup", pointer.P("previous-checkpoint"), "backup-disk0"),
+		)
+
+		It("should not set an NBD server in push mode", func() {
+			disks := []api.Disk{
+				{
+					Target: api.DiskTarget{Device: "vda"},
+					Source: api.DiskSource{DataStore: &api.DataStore{}},
+					Alias:  api.NewUserDefinedAlias("disk0"),
+				},
+			}
+
+			domainBackup, _, _ := generateDomainBackup(disks, backupOptions, tempDir)
+
+			Expect(domainBackup.Server).To(BeNil())
+			Expect(domainBackup.BackupDisks.Disks[0].ExportName).To(BeEmpty())
+		})
 	})
 
 	Describe("HandleBackupJobCompletedEvent", func() {
@@ -552,6 +597,22 @@ var _ = Describe("Backup", func() {
 				Entry("with failure and no error message", libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_FAILED}, "unknown failure reason"),
 				Entry("with an unknown job completion type", libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_BOUNDED}, fmt.Sprintf("unexpected job completion type: %d", libvirt.DOMAIN_JOB_BOUNDED)),
 			)
+
+			It("should not fail a Pull mode backup when its export is stopped", func() {
+				metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, _ bool) {
+					backupMetadata.Mode = string(backupv1.PullMode)
+				})
+				event.Info = libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_CANCELLED}
+				mockDomain.EXPECT().GetJobStats(gomock.Any()).Return(&event.Info, nil)
+
+				HandleBackupJobCompletedEvent(mockDomain, event, metadataCache)
+
+				backupMetadata, exists := metadataCache.Backup.Load()
+				Expect(exists).To(BeTrue())
+				Expect(backupMetadata.Completed).To(BeTrue())
+				Expect(backupMetadata.Failed).To(BeFalse())
+				Expect(backupMetadata.BackupMsg).To(BeEmpty())
+			})
 		})
 
 		Context("abort backup", func() {
@@ -810,6 +871,29 @@ var _ = Describe("Backup", func() {
 		})
 	})
 
+	Describe("DeleteCheckpoint", func() {
+		checkpoint := &backupv1.BackupCheckpoint{Name: "checkpoint-1"}
+
+		It("should succeed when the checkpoint does not exist", func() {
+			mockConn.EXPECT().LookupDomainByName(gomock.Any()).Return(mockDomain, nil)
+			mockDomain.EXPECT().CheckpointLookupByName(checkpoint.Name, uint32(0)).
+				Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN_CHECKPOINT})
+			mockDomain.EXPECT().Free().Return(nil)
+
+			Expect(manager.DeleteCheckpoint(vmi, checkpoint)).To(Succeed())
+		})
+
+		It("should fail when the checkpoint lookup fails", func() {
+			mockConn.EXPECT().LookupDomainByName(gomock.Any()).Return(mockDomain, nil)
+			mockDomain.EXPECT().CheckpointLookupByName(checkpoint.Name, uint32(0)).
+				Return(nil, libvirt.Error{Code: libvirt.ERR_INTERNAL_ERROR})
+			mockDomain.EXPECT().Free().Return(nil)
+
+			err := manager.DeleteCheckpoint(vmi, checkpoint)
+			Expect(err).To(MatchError(ContainSubstring("failed to lookup checkpoint checkpoint-1")))
+		})
+	})
+
 	Describe("findDisksWithCheckpointBitmap", func() {
 		const checkpointName = "checkpoint-1"
 
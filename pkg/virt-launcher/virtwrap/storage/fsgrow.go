@@ -0,0 +1,190 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	fsGrowTimeoutSeconds = 60
+	windowsGuestOSID     = "mswindows"
+	// growpart exits with 1 and prints NOCHANGE when the partition already fills the disk
+	growpartNoChange = "NOCHANGE"
+)
+
+// GrowGuestFilesystems grows the guest partitions and filesystems on disks which just got expanded.
+// It runs in the background and reports its progress and result in the metadata.
+func (m *StorageManager) GrowGuestFilesystems(vmi *v1.VirtualMachineInstance, disks []api.Disk) {
+	if len(disks) == 0 {
+		return
+	}
+
+	go func() {
+		m.filesystemResizeLock.Lock()
+		defer m.filesystemResizeLock.Unlock()
+
+		m.initializeFilesystemResizeMetadata()
+		grown, err := m.growGuestFilesystems(vmi, disks)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Failed to grow the guest filesystems")
+		}
+		m.setFilesystemResizeResult(grown, err)
+	}()
+}
+
+func (m *StorageManager) growGuestFilesystems(vmi *v1.VirtualMachineInstance, disks []api.Disk) ([]string, error) {
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := m.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return nil, err
+	}
+	defer dom.Free()
+
+	guestInfo, err := dom.GetGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS|libvirt.DOMAIN_GUEST_INFO_FILESYSTEM, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the guest filesystems: %v", err)
+	}
+	windows := guestInfo.OS != nil && guestInfo.OS.ID == windowsGuestOSID
+
+	var grown []string
+	var errs []error
+	for _, disk := range disks {
+		for _, fs := range guestInfo.FileSystems {
+			guestDisk, onDisk := guestDiskOf(fs, disk.Target.Device)
+			if !onDisk {
+				continue
+			}
+			if windows {
+				err = m.growWindowsFilesystem(domName, fs)
+			} else {
+				err = m.growLinuxFilesystem(domName, fs, guestDisk)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", fs.MountPoint, err))
+				continue
+			}
+			grown = append(grown, fs.MountPoint)
+		}
+	}
+
+	return grown, errors.Join(errs...)
+}
+
+// guestDiskOf returns the guest device of the disk holding the filesystem,
+// libvirt reports the domain disks by their target device
+func guestDiskOf(fs libvirt.DomainGuestInfoFileSystem, target string) (string, bool) {
+	for _, disk := range fs.Disks {
+		if disk.Alias == target {
+			return disk.Device, true
+		}
+	}
+	return "", false
+}
+
+func (m *StorageManager) growLinuxFilesystem(domName string, fs libvirt.DomainGuestInfoFileSystem, guestDisk string) error {
+	device := "/dev/" + fs.Name
+	diskName := filepath.Base(guestDisk)
+	if fs.Name != diskName {
+		partition := strings.TrimPrefix(strings.TrimPrefix(fs.Name, diskName), "p")
+		if _, err := strconv.Atoi(partition); err != nil {
+			return fmt.Errorf("growing %s on %s is not supported", fs.Name, guestDisk)
+		}
+		out, err := agent.GuestExec(m.virConn, domName, "growpart", []string{guestDisk, partition}, fsGrowTimeoutSeconds)
+		if err != nil && !strings.Contains(out, growpartNoChange) {
+			return fmt.Errorf("growpart failed: %v", err)
+		}
+	}
+
+	var err error
+	switch fs.FSType {
+	case "ext2", "ext3", "ext4":
+		_, err = agent.GuestExec(m.virConn, domName, "resize2fs", []string{device}, fsGrowTimeoutSeconds)
+	case "xfs":
+		_, err = agent.GuestExec(m.virConn, domName, "xfs_growfs", []string{fs.MountPoint}, fsGrowTimeoutSeconds)
+	case "btrfs":
+		_, err = agent.GuestExec(m.virConn, domName, "btrfs", []string{"filesystem", "resize", "max", fs.MountPoint}, fsGrowTimeoutSeconds)
+	default:
+		return fmt.Errorf("growing a %s filesystem is not supported", fs.FSType)
+	}
+	if err != nil {
+		return fmt.Errorf("growing the %s filesystem failed: %v", fs.FSType, err)
+	}
+	return nil
+}
+
+func (m *StorageManager) growWindowsFilesystem(domName string, fs libvirt.DomainGuestInfoFileSystem) error {
+	if len(fs.MountPoint) < 2 || fs.MountPoint[1] != ':' {
+		return fmt.Errorf("growing a volume without a drive letter is not supported")
+	}
+	driveLetter := fs.MountPoint[:1]
+	script := fmt.Sprintf("$p = Get-Partition -DriveLetter %[1]s; $s = Get-PartitionSupportedSize -DriveLetter %[1]s; "+
+		"if ($s.SizeMax -gt $p.Size) { Resize-Partition -DriveLetter %[1]s -Size $s.SizeMax }", driveLetter)
+	_, err := agent.GuestExec(m.virConn, domName, "powershell.exe", []string{"-NoProfile", "-NonInteractive", "-Command", script}, fsGrowTimeoutSeconds)
+	if err != nil {
+		return fmt.Errorf("Resize-Partition failed: %v", err)
+	}
+	return nil
+}
+
+func (m *StorageManager) initializeFilesystemResizeMetadata() {
+	m.metadataCache.FilesystemResize.WithSafeBlock(func(filesystemResizeMetadata *api.FilesystemResizeMetadata, _ bool) {
+		now := metav1.Now()
+		*filesystemResizeMetadata = api.FilesystemResizeMetadata{
+			StartTimestamp: &now,
+		}
+	})
+	log.Log.V(4).Infof("initialize filesystem resize metadata: %s", m.metadataCache.FilesystemResize.String())
+}
+
+func (m *StorageManager) setFilesystemResizeResult(grown []string, err error) {
+	m.metadataCache.FilesystemResize.WithSafeBlock(func(filesystemResizeMetadata *api.FilesystemResizeMetadata, initialized bool) {
+		if !initialized {
+			return
+		}
+
+		now := metav1.Now()
+		filesystemResizeMetadata.Completed = true
+		filesystemResizeMetadata.EndTimestamp = &now
+		switch {
+		case err != nil:
+			filesystemResizeMetadata.Failed = true
+			filesystemResizeMetadata.Message = err.Error()
+		case len(grown) == 0:
+			filesystemResizeMetadata.Message = "No guest filesystem found on the expanded disks"
+		default:
+			filesystemResizeMetadata.Message = fmt.Sprintf("Grew %s", strings.Join(grown, ", "))
+		}
+	})
+	log.Log.V(4).Infof("set filesystem resize results in metadata: %s", m.metadataCache.FilesystemResize.String())
+}
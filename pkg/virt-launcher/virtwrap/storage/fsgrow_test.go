@@ -0,0 +1,172 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"libvirt.org/go/libvirt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Guest filesystem grow", func() {
+	const (
+		testVmName     = "testvmi"
+		testNamespace  = "testnamespace"
+		testDomainName = testNamespace + "_" + testVmName
+	)
+
+	var (
+		mockConn      *cli.MockConnection
+		mockDomain    *cli.MockVirDomain
+		manager       *StorageManager
+		metadataCache *metadata.Cache
+		vmi           *v1.VirtualMachineInstance
+		disks         []api.Disk
+		guestCommands []string
+	)
+
+	guestExecRE := regexp.MustCompile(`"path": "([^"]*)", "arg": \[ (.*) \]`)
+
+	// expectGuestExec records the guest-exec commands and makes them exit with the given code and output
+	expectGuestExec := func(exitCode int, output string) {
+		mockConn.EXPECT().QemuAgentCommand(gomock.Any(), testDomainName).DoAndReturn(func(command, _ string) (string, error) {
+			if match := guestExecRE.FindStringSubmatch(command); match != nil {
+				guestCommands = append(guestCommands, strings.TrimSpace(match[1]+" "+strings.ReplaceAll(strings.ReplaceAll(match[2], `"`, ""), ",", "")))
+				return `{"return":{"pid":1}}`, nil
+			}
+			return fmt.Sprintf(`{"return":{"exited":true,"exitcode":%d,"out-data":"%s"}}`, exitCode, base64.StdEncoding.EncodeToString([]byte(output))), nil
+		}).Times(2)
+	}
+
+	expectGuestInfo := func(osID string, filesystems ...libvirt.DomainGuestInfoFileSystem) {
+		mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+		mockDomain.EXPECT().Free()
+		mockDomain.EXPECT().GetGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS|libvirt.DOMAIN_GUEST_INFO_FILESYSTEM, uint32(0)).Return(&libvirt.DomainGuestInfo{
+			OS:          &libvirt.DomainGuestInfoOS{ID: osID},
+			FileSystems: filesystems,
+		}, nil)
+	}
+
+	filesystem := func(name, mountPoint, fsType, target, guestDisk string) libvirt.DomainGuestInfoFileSystem {
+		return libvirt.DomainGuestInfoFileSystem{
+			Name:       name,
+			MountPoint: mountPoint,
+			FSType:     fsType,
+			Disks:      []libvirt.DomainGuestInfoFileSystemDisk{{Alias: target, Device: guestDisk}},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+		mockDomain = cli.NewMockVirDomain(ctrl)
+		metadataCache = metadata.NewCache()
+		manager = NewStorageManager(mockConn, metadataCache)
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: testVmName, Namespace: testNamespace},
+		}
+		disks = []api.Disk{{Target: api.DiskTarget{Device: "vdb"}, AutoGrowFilesystem: true}}
+		guestCommands = nil
+	})
+
+	DescribeTable("should grow a Linux filesystem", func(fs libvirt.DomainGuestInfoFileSystem, expectedCommands ...string) {
+		expectGuestInfo("fedora", fs, filesystem("vda1", "/", "xfs", "vda", "/dev/vda"))
+		for range expectedCommands {
+			expectGuestExec(0, "")
+		}
+
+		grown, err := manager.growGuestFilesystems(vmi, disks)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(grown).To(ConsistOf(fs.MountPoint))
+		Expect(guestCommands).To(Equal(expectedCommands))
+	},
+		Entry("ext4 on a partition", filesystem("vdb1", "/data", "ext4", "vdb", "/dev/vdb"), "growpart /dev/vdb 1", "resize2fs /dev/vdb1"),
+		Entry("xfs on a partition", filesystem("vdb2", "/data", "xfs", "vdb", "/dev/vdb"), "growpart /dev/vdb 2", "xfs_growfs /data"),
+		Entry("btrfs on the whole disk", filesystem("vdb", "/data", "btrfs", "vdb", "/dev/vdb"), "btrfs filesystem resize max /data"),
+		Entry("ext4 on a nvme partition", filesystem("nvme0n1p3", "/data", "ext4", "vdb", "/dev/nvme0n1"), "growpart /dev/nvme0n1 3", "resize2fs /dev/nvme0n1p3"),
+	)
+
+	It("should keep going when the partition already fills the disk", func() {
+		expectGuestInfo("fedora", filesystem("vdb1", "/data", "ext4", "vdb", "/dev/vdb"))
+		expectGuestExec(1, "NOCHANGE: partition 1 is size 2097152. it cannot be grown")
+		expectGuestExec(0, "")
+
+		grown, err := manager.growGuestFilesystems(vmi, disks)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(grown).To(ConsistOf("/data"))
+	})
+
+	It("should report filesystems which can't be grown", func() {
+		expectGuestInfo("fedora",
+			filesystem("dm-0", "/lvm", "xfs", "vdb", "/dev/vdb"),
+			filesystem("vdb2", "/boot/efi", "vfat", "vdb", "/dev/vdb"),
+		)
+		expectGuestExec(0, "")
+
+		grown, err := manager.growGuestFilesystems(vmi, disks)
+		Expect(err).To(MatchError(ContainSubstring("/lvm: growing dm-0 on /dev/vdb is not supported")))
+		Expect(err).To(MatchError(ContainSubstring("/boot/efi: growing a vfat filesystem is not supported")))
+		Expect(grown).To(BeEmpty())
+	})
+
+	It("should grow a Windows volume", func() {
+		expectGuestInfo(windowsGuestOSID, filesystem(`\\?\Volume{1}\`, `D:\`, "NTFS", "vdb", ""))
+		expectGuestExec(0, "")
+
+		grown, err := manager.growGuestFilesystems(vmi, disks)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(grown).To(ConsistOf(`D:\`))
+		Expect(guestCommands).To(ConsistOf(And(HavePrefix("powershell.exe"), ContainSubstring("Resize-Partition -DriveLetter D"))))
+	})
+
+	It("should report the result in the metadata", func() {
+		manager.initializeFilesystemResizeMetadata()
+		filesystemResize, exists := metadataCache.FilesystemResize.Load()
+		Expect(exists).To(BeTrue())
+		Expect(filesystemResize.StartTimestamp).ToNot(BeNil())
+		Expect(filesystemResize.Completed).To(BeFalse())
+
+		manager.setFilesystemResizeResult(nil, fmt.Errorf("/data: growing a vfat filesystem is not supported"))
+		filesystemResize, _ = metadataCache.FilesystemResize.Load()
+		Expect(filesystemResize.Completed).To(BeTrue())
+		Expect(filesystemResize.Failed).To(BeTrue())
+		Expect(filesystemResize.Message).To(Equal("/data: growing a vfat filesystem is not supported"))
+
+		manager.initializeFilesystemResizeMetadata()
+		manager.setFilesystemResizeResult([]string{"/data", "/srv"}, nil)
+		filesystemResize, _ = metadataCache.FilesystemResize.Load()
+		Expect(filesystemResize.Failed).To(BeFalse())
+		Expect(filesystemResize.Message).To(Equal("Grew /data, /srv"))
+	})
+})
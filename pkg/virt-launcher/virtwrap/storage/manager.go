@@ -20,6 +20,8 @@
 package storage
 
 import (
+	"sync"
+
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 )
@@ -34,6 +36,7 @@ type StorageManager struct {
 	metadataCache            *metadata.Cache
 	memoryDumpInProgress     chan struct{}
 	cancelSafetyUnfreezeChan chan struct{}
+	filesystemResizeLock     sync.Mutex
 }
 
 func NewStorageManager(connection cli.Connection, metadataCache *metadata.Cache) *StorageManager {
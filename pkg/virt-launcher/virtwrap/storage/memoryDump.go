@@ -20,6 +20,7 @@
 package storage
 
 import (
+	"encoding/xml"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -31,7 +32,11 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
 )
 
 func (m *StorageManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
@@ -71,7 +76,11 @@ func (m *StorageManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath str
 	logger.Infof("Starting memory dump")
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	if memoryDumpFormat(vmi, dumpPath) == v1.MemoryDumpFormatSavedState {
+		err = saveDomainState(dom, dumpPath)
+	} else {
+		err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	}
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", FailedDomainMemoryDump, err)
@@ -135,3 +144,78 @@ func removePreviousMemoryDump(dir string) {
 		}
 	}
 }
+
+// memoryDumpFormat returns the format requested by the memory dump
+// volume the dump is written to
+func memoryDumpFormat(vmi *v1.VirtualMachineInstance, dumpPath string) v1.MemoryDumpFormat {
+	volumeName := filepath.Base(filepath.Dir(dumpPath))
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName && volume.MemoryDump != nil && volume.MemoryDump.Format != "" {
+			return volume.MemoryDump.Format
+		}
+	}
+	return v1.MemoryDumpFormatRaw
+}
+
+// saveDomainState saves the device and RAM state of the domain without
+// touching its disks, which are expected to be snapshotted separately
+func saveDomainState(dom cli.VirDomain, dumpPath string) error {
+	disks, err := util.GetAllDomainDisks(dom)
+	if err != nil {
+		return err
+	}
+
+	domainSnapshot := &api.DomainSnapshot{
+		Memory: &api.SnapshotMemory{
+			Snapshot: "external",
+			File:     dumpPath,
+		},
+		Disks: &api.SnapshotDisks{},
+	}
+	for _, disk := range disks {
+		if disk.Target.Device == "" {
+			continue
+		}
+		domainSnapshot.Disks.Disks = append(domainSnapshot.Disks.Disks, api.SnapshotDisk{
+			Name:     disk.Target.Device,
+			Snapshot: "no",
+		})
+	}
+
+	snapshotXML, err := xml.Marshal(domainSnapshot)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := dom.CreateSnapshotXML(string(snapshotXML), libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA)
+	if err != nil {
+		return err
+	}
+	if snapshot != nil {
+		return snapshot.Free()
+	}
+	return nil
+}
+
+// SavedStateFile returns the saved state the VMI should be started from,
+// or an empty string if the VMI should be booted
+func SavedStateFile(vmi *v1.VirtualMachineInstance) (string, error) {
+	for _, volume := range vmi.Spec.Volumes {
+		if !storagetypes.IsSavedStateVolume(&volume) {
+			continue
+		}
+
+		dir := hostdisk.GetMountedHostDiskDir(volume.Name)
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			return "", err
+		}
+		for _, file := range files {
+			if strings.HasSuffix(file.Name(), "memory.dump") {
+				return filepath.Join(dir, file.Name()), nil
+			}
+		}
+		return "", fmt.Errorf("no saved state found in volume %s", volume.Name)
+	}
+	return "", nil
+}
@@ -115,6 +115,31 @@ var _ = Describe("MemoryDump", func() {
 		Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
 	})
 
+	It("should save the domain state when the volume requests the saved state format", func() {
+		mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+		mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(`<domain><devices><disk><target dev="vda"></target></disk></devices></domain>`, nil)
+		mockDomain.EXPECT().CreateSnapshotXML(
+			`<domainsnapshot><memory snapshot="external" file="`+testDumpPath+`"></memory><disks><disk name="vda" snapshot="no"></disk></disks></domainsnapshot>`,
+			libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA,
+		).Return(nil, nil)
+
+		vmi := newVMI(testNamespace, testVmName)
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: "path",
+			VolumeSource: v1.VolumeSource{
+				MemoryDump: &v1.MemoryDumpVolumeSource{
+					Format: v1.MemoryDumpFormatSavedState,
+				},
+			},
+		}}
+		Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+
+		Eventually(func() bool {
+			memoryDump, _ := metadataCache.MemoryDump.Load()
+			return memoryDump.Completed && !memoryDump.Failed
+		}, 5*time.Second, 2).Should(BeTrue())
+	})
+
 	It("should update domain with memory dump info if memory dump failed", func() {
 		mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
 		dumpFailure := fmt.Errorf("Memory dump failed!!")
@@ -90,8 +90,8 @@ const (
 	NAMESPACE = "kubevirt-test"
 
 	// +1 for ContainerPathVolumes webhook (always enabled in tests)
-	resourceCount = 92 + virtTemplateResourceCount
-	patchCount    = 60 + virtTemplatePatchCount
+	resourceCount = 98 + virtTemplateResourceCount
+	patchCount    = 66 + virtTemplatePatchCount
 	updateCount   = 33 + virtTemplateUpdateCount
 
 	// 1 because a temporary validation webhook is created to block new CRDs until api server is deployed
@@ -117,6 +117,12 @@ var (
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
 		components.NewVirtualMachineBackupTrackerCrd,
+		components.NewVirtualMachineBackupScheduleCrd,
+		components.NewVirtualMachineBackupRestoreCrd,
+		components.NewVirtualMachineSnapshotGroupCrd,
+		components.NewVirtualMachineRestoreGroupCrd,
+		components.NewVirtualMachineSnapshotGrantCrd,
+		components.NewVirtualMachineStorageMigrationPlanCrd,
 	}
 	numCRDs = len(crdFunctions) + numVirtTemplateCRDs
 )
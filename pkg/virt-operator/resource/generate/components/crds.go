@@ -57,20 +57,26 @@ const (
 )
 
 var (
-	VIRTUALMACHINE                   = "virtualmachines." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCE           = "virtualmachineinstances." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEPRESET     = "virtualmachineinstancepresets." + virtv1.VirtualMachineInstancePresetGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEREPLICASET = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEMIGRATION  = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
-	KUBEVIRT                         = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
-	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1beta1.SchemeGroupVersion.Group
-	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
-	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1beta1.SchemeGroupVersion.Group
-	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1beta1.SchemeGroupVersion.Group
-	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
-	VIRTUALMACHINECLONE              = "virtualmachineclones." + clone.GroupName
-	VIRTUALMACHINEBACKUP             = "virtualmachinebackups." + backupv1alpha1.SchemeGroupVersion.Group
-	VIRTUALMACHINEBACKUPTRACKER      = "virtualmachinebackuptrackers." + backupv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINE                     = "virtualmachines." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCE             = "virtualmachineinstances." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEPRESET       = "virtualmachineinstancepresets." + virtv1.VirtualMachineInstancePresetGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEREPLICASET   = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEMIGRATION    = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
+	KUBEVIRT                           = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
+	VIRTUALMACHINEPOOL                 = "virtualmachinepools." + poolv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOT             = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTCONTENT      = "virtualmachinesnapshotcontents." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTGROUP        = "virtualmachinesnapshotgroups." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINERESTOREGROUP         = "virtualmachinerestoregroups." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTGRANT        = "virtualmachinesnapshotgrants." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINEEXPORT               = "virtualmachineexports." + exportv1beta1.SchemeGroupVersion.Group
+	MIGRATIONPOLICY                    = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
+	VIRTUALMACHINESTORAGEMIGRATIONPLAN = migrations.ResourceVirtualMachineStorageMigrationPlans + "." + migrationsv1.VirtualMachineStorageMigrationPlanKind.Group
+	VIRTUALMACHINECLONE                = "virtualmachineclones." + clone.GroupName
+	VIRTUALMACHINEBACKUP               = "virtualmachinebackups." + backupv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEBACKUPTRACKER        = "virtualmachinebackuptrackers." + backupv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEBACKUPSCHEDULE       = "virtualmachinebackupschedules." + backupv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEBACKUPRESTORE        = "virtualmachinebackuprestores." + backupv1alpha1.SchemeGroupVersion.Group
 )
 
 func addFieldsToVersion(version *extv1.CustomResourceDefinitionVersion, fields ...interface{}) error {
@@ -614,6 +620,131 @@ func NewVirtualMachineRestoreCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineSnapshotGroupCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTGROUP
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotgroups",
+			Singular:   "virtualmachinesnapshotgroup",
+			Kind:       "VirtualMachineSnapshotGroup",
+			ShortNames: []string{"vmsnapshotgroup", "vmsnapshotgroups"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+		{Name: "ReadyToUse", Type: "boolean", JSONPath: ".status.readyToUse"},
+		{Name: "CreationTime", Type: "date", JSONPath: ".status.creationTime"},
+		{Name: "Error", Type: "string", JSONPath: errorMessageJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineRestoreGroupCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINERESTOREGROUP
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinerestoregroups",
+			Singular:   "virtualmachinerestoregroup",
+			Kind:       "VirtualMachineRestoreGroup",
+			ShortNames: []string{"vmrestoregroup", "vmrestoregroups"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "SnapshotGroup", Type: "string", JSONPath: ".spec.virtualMachineSnapshotGroupName"},
+		{Name: "Complete", Type: "boolean", JSONPath: ".status.complete"},
+		{Name: "RestoreTime", Type: "date", JSONPath: ".status.restoreTime"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineSnapshotGrantCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTGRANT
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotgrants",
+			Singular:   "virtualmachinesnapshotgrant",
+			Kind:       "VirtualMachineSnapshotGrant",
+			ShortNames: []string{"vmsnapshotgrant", "vmsnapshotgrants"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineExportCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -760,6 +891,97 @@ func NewVirtualMachineBackupTrackerCrd() (*extv1.CustomResourceDefinition, error
 	return crd, nil
 }
 
+func NewVirtualMachineBackupScheduleCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEBACKUPSCHEDULE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: backupv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    backupv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinebackupschedules",
+			Singular:   "virtualmachinebackupschedule",
+			Kind:       "VirtualMachineBackupSchedule",
+			ShortNames: []string{"vmbackupschedule", "vmbackupschedules"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+		{Name: "Suspend", Type: "boolean", JSONPath: ".spec.suspend"},
+		{Name: "LastSchedule", Type: "date", JSONPath: ".status.lastScheduleTime"},
+		{Name: "NextSchedule", Type: "date", JSONPath: ".status.nextScheduleTime"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineBackupRestoreCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEBACKUPRESTORE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: backupv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    backupv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinebackuprestores",
+			Singular:   "virtualmachinebackuprestore",
+			Kind:       "VirtualMachineBackupRestore",
+			ShortNames: []string{"vmbackuprestore", "vmbackuprestores"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Target", Type: "string", JSONPath: ".spec.target.name"},
+		{Name: "Checkpoint", Type: "string", JSONPath: ".spec.checkpointName"},
+		{Name: "Done", Type: "string", JSONPath: ".status.conditions[?(@.type=='Done')].status"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineInstancetypeCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -912,6 +1134,48 @@ func NewMigrationPolicyCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineStorageMigrationPlanCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESTORAGEMIGRATIONPLAN
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: migrationsv1.VirtualMachineStorageMigrationPlanKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    migrationsv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     migrations.ResourceVirtualMachineStorageMigrationPlans,
+			Singular:   "virtualmachinestoragemigrationplan",
+			Kind:       migrationsv1.VirtualMachineStorageMigrationPlanKind.Kind,
+			ShortNames: []string{"vmsmp", "vmsmps"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	}, []extv1.CustomResourceColumnDefinition{
+		{Name: "StorageClass", Type: "string", JSONPath: ".spec.targetStorageClassName"},
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+		{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineCloneCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
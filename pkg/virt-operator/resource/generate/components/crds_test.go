@@ -43,6 +43,9 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineSnapshot", NewVirtualMachineSnapshotCrd),
 		Entry("for VirtualMachineSnapshotContent", NewVirtualMachineSnapshotContentCrd),
 		Entry("for VirtualMachineRestore", NewVirtualMachineRestoreCrd),
+		Entry("for VirtualMachineSnapshotGroup", NewVirtualMachineSnapshotGroupCrd),
+		Entry("for VirtualMachineRestoreGroup", NewVirtualMachineRestoreGroupCrd),
+		Entry("for VirtualMachineSnapshotGrant", NewVirtualMachineSnapshotGrantCrd),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd),
 		Entry("for VirtualMachineInstancetype", NewVirtualMachineInstancetypeCrd),
 		Entry("for VirtualMachineClusterInstancetype", NewVirtualMachineClusterInstancetypeCrd),
@@ -50,6 +53,7 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
+		Entry("for VirtualMachineStorageMigrationPlan", NewVirtualMachineStorageMigrationPlanCrd),
 	)
 
 	It("DataVolumeTemplates should have nullable a XPreserveUnknownFields on metadata", func() {
@@ -136,6 +140,9 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineSnapshot", NewVirtualMachineSnapshotCrd, "SourceKind", "SourceName", "Phase", "ReadyToUse", "CreationTime", "Error"),
 		Entry("for VirtualMachineSnapshotContent", NewVirtualMachineSnapshotContentCrd, "ReadyToUse", "CreationTime", "Error"),
 		Entry("for VirtualMachineRestore", NewVirtualMachineRestoreCrd, "TargetKind", "TargetName", "Complete", "RestoreTime"),
+		Entry("for VirtualMachineSnapshotGroup", NewVirtualMachineSnapshotGroupCrd, "Phase", "ReadyToUse", "CreationTime", "Error"),
+		Entry("for VirtualMachineRestoreGroup", NewVirtualMachineRestoreGroupCrd, "SnapshotGroup", "Complete", "RestoreTime"),
+		Entry("for VirtualMachineSnapshotGrant", NewVirtualMachineSnapshotGrantCrd),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd, "SourceKind", "SourceName", "Phase"),
 		Entry("for VirtualMachineInstancetype", NewVirtualMachineInstancetypeCrd),
 		Entry("for VirtualMachineClusterInstancetype", NewVirtualMachineClusterInstancetypeCrd),
@@ -143,6 +150,7 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd, "Phase", "SourceVirtualMachine", "TargetVirtualMachine"),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
+		Entry("for VirtualMachineStorageMigrationPlan", NewVirtualMachineStorageMigrationPlanCrd, "StorageClass", "Phase", "Age"),
 	)
 
 	DescribeTable("Additional printer columns map to expected value", func(crdFunc func() (*extv1.CustomResourceDefinition, error), obj any, expected ...string) {
@@ -1761,6 +1761,33 @@ var CRDsValidation map[string]string = map[string]string{
               - LiveUpdate
               nullable: true
               type: string
+            vmStateEncryption:
+              description: |-
+                VMStateEncryption configures the encryption of the VM state, like TPM, stored in the VMStateStorageClass PVCs.
+                When omitted, the VM state is stored unencrypted.
+              properties:
+                keyProvider:
+                  description: |-
+                    KeyProvider is the source of the keys, either Secret or KMS.
+                    Defaults to Secret.
+                  enum:
+                  - Secret
+                  - KMS
+                  type: string
+                kms:
+                  description: KMS configures the KMS key provider.
+                  properties:
+                    keyEncryptionKeySecret:
+                      description: |-
+                        KeyEncryptionKeySecret is the name of the Secret in the KubeVirt install namespace
+                        holding the key encryption key under the "key" entry.
+                        To rotate the key encryption key, move it to the "previous-key" entry and set the new one,
+                        the keys get re-wrapped with the new one on their next live migration.
+                      type: string
+                  required:
+                  - keyEncryptionKeySecret
+                  type: object
+              type: object
             vmStateStorageClass:
               description: VMStateStorageClass is the name of the storage class to
                 use for the PVCs created to preserve VM state, like TPM.
@@ -6202,6 +6229,12 @@ var CRDsValidation map[string]string = map[string]string{
                             are connected to the vmi.
                           items:
                             properties:
+                              autoGrowFilesystem:
+                                description: |-
+                                  AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent
+                                  after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.
+                                  Defaults to false.
+                                type: boolean
                               blockSize:
                                 description: If specified, the virtual disk will be
                                   presented with the given block sizes.
@@ -6303,6 +6336,84 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune throttles the I/O of the disk.
+                                  Requires the DiskIOTune feature gate. Changes are applied to a running VMI
+                                  when the VM uses the LiveUpdate rollout strategy.
+                                properties:
+                                  burst:
+                                    description: Burst allows the disk to exceed the
+                                      limits for a short period of time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: |-
+                                          LengthSeconds is the maximum duration of a burst.
+                                          Defaults to one second.
+                                        format: int64
+                                        type: integer
+                                      readBytesSec:
+                                        description: ReadBytesSec is the read burst
+                                          throughput in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec is the read burst
+                                          I/O operations per second.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec is the total burst
+                                          throughput in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec is the total burst
+                                          I/O operations per second.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec is the write burst
+                                          throughput in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec is the write burst
+                                          I/O operations per second.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBytesSec:
+                                    description: ReadBytesSec is the read throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec is the read I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec is the total throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec is the total I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec is the write throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec is the write I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -6480,6 +6591,62 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic of the interface.
+                                  The limits are enforced with traffic control on the tap device in the network namespace
+                                  of the virt-launcher pod and can be updated while the VMI is running.
+                                  Requires the InterfaceBandwidth feature gate. Supported by the bridge and masquerade bindings.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average rate in
+                                          kibibytes per second.
+                                        format: int64
+                                        type: integer
+                                      burst:
+                                        description: |-
+                                          Burst is the amount of kibibytes that can be sent at the peak rate.
+                                          Defaults to the amount sent in one second at the average rate.
+                                        format: int64
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                          Must not be lower than the average.
+                                        format: int64
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average rate in
+                                          kibibytes per second.
+                                        format: int64
+                                        type: integer
+                                      burst:
+                                        description: |-
+                                          Burst is the amount of kibibytes that can be sent at the peak rate.
+                                          Defaults to the amount sent in one second at the average rate.
+                                        format: int64
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                          Must not be lower than the average.
+                                        format: int64
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -6504,6 +6671,30 @@ var CRDsValidation map[string]string = map[string]string{
                               bridge:
                                 description: InterfaceBridge connects to a given network
                                   via a linux bridge.
+                                properties:
+                                  autoConfig:
+                                    description: |-
+                                      AutoConfig enables the IPv6 auto-configuration of the guest interface.
+                                      The guest is configured with the IPv6 address the network IPAM assigned to the pod interface,
+                                      as reported by Multus.
+                                    properties:
+                                      delegatedPrefix:
+                                        description: |-
+                                          DelegatedPrefix is an IPv6 prefix (in CIDR notation) delegated to the guest
+                                          through DHCPv6 prefix delegation. Requires the DHCPv6 mode.
+                                        type: string
+                                      mode:
+                                        description: |-
+                                          Mode of the auto-configuration, one of SLAAC or DHCPv6.
+                                          Defaults to DHCPv6.
+                                        type: string
+                                    type: object
+                                  migrationMode:
+                                    description: |-
+                                      MigrationMode defines how the guest addressing is handled when the VMI is live migrated.
+                                      When set to PreserveIP on the pod network, the guest keeps the IPv4 address and gateway it received
+                                      before the migration and the target virt-launcher pod translates them to its own.
+                                    type: string
                                 type: object
                               dhcpOptions:
                                 description: If specified the network interface will
@@ -6513,6 +6704,41 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
+                                  extraOptions:
+                                    description: |-
+                                      ExtraOptions passes arbitrary options, identified by their numeric code,
+                                      to the interface's DHCP and DHCPv6 servers.
+                                      An extra option replaces the option the server provides with the same code.
+                                    items:
+                                      description: DHCPExtraOption defines a DHCP
+                                        option by its numeric code and typed value.
+                                      properties:
+                                        code:
+                                          description: |-
+                                            Code of the option, from 1 to 254 for DHCP and from 1 to 65535 for DHCPv6.
+                                            Options which are managed by the server, such as the server identifier, are not allowed.
+                                          format: int32
+                                          type: integer
+                                        family:
+                                          description: |-
+                                            Family of the server which passes the option, IPv4 for DHCP and IPv6 for DHCPv6.
+                                            Defaults to IPv4.
+                                          type: string
+                                        type:
+                                          description: |-
+                                            Type of the value, one of String, Uint8, Uint16, Uint32, IPAddresses or Hex.
+                                            Defaults to String.
+                                          type: string
+                                        value:
+                                          description: Value of the option, encoded
+                                            according to its type.
+                                          type: string
+                                      required:
+                                      - code
+                                      - value
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
@@ -6546,6 +6772,102 @@ var CRDsValidation map[string]string = map[string]string{
                                       to interface's DHCP server
                                     type: string
                                 type: object
+                              firewall:
+                                description: |-
+                                  Firewall defines the rules filtering the traffic of the interface.
+                                  The rules are enforced in the network namespace of the virt-launcher pod
+                                  and can be updated while the VMI is running.
+                                  Supported by the bridge, masquerade and passt bindings.
+                                properties:
+                                  egress:
+                                    description: Egress rules filter the traffic sent
+                                      by the guest.
+                                    items:
+                                      properties:
+                                        action:
+                                          description: |-
+                                            Action applied to the matching traffic.
+                                            Defaults to Allow.
+                                          type: string
+                                        cidrBlocks:
+                                          description: |-
+                                            CIDRBlocks of the remote peers of the matching traffic.
+                                            All the peers are matched when empty.
+                                          items:
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        name:
+                                          description: |-
+                                            Name of the rule, unique per interface and direction.
+                                            It identifies the rule counters reported in the VMI status.
+                                          type: string
+                                        ports:
+                                          description: |-
+                                            Ports of the matching traffic: the guest ports for ingress rules and the
+                                            remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                            All the ports are matched when empty.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        protocol:
+                                          description: |-
+                                            Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                            ICMP matches both ICMP and ICMPv6.
+                                            All the protocols are matched when empty.
+                                          type: string
+                                      required:
+                                      - name
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  ingress:
+                                    description: Ingress rules filter the traffic
+                                      received by the guest.
+                                    items:
+                                      properties:
+                                        action:
+                                          description: |-
+                                            Action applied to the matching traffic.
+                                            Defaults to Allow.
+                                          type: string
+                                        cidrBlocks:
+                                          description: |-
+                                            CIDRBlocks of the remote peers of the matching traffic.
+                                            All the peers are matched when empty.
+                                          items:
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        name:
+                                          description: |-
+                                            Name of the rule, unique per interface and direction.
+                                            It identifies the rule counters reported in the VMI status.
+                                          type: string
+                                        ports:
+                                          description: |-
+                                            Ports of the matching traffic: the guest ports for ingress rules and the
+                                            remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                            All the ports are matched when empty.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        protocol:
+                                          description: |-
+                                            Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                            ICMP matches both ICMP and ICMPv6.
+                                            All the protocols are matched when empty.
+                                          type: string
+                                      required:
+                                      - name
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                type: object
                               macAddress:
                                 description: 'Interface MAC address. For example:
                                   de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -8100,6 +8422,35 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Path defines the path to disk file in the
                               container
                             type: string
+                          persistent:
+                            description: |-
+                              Persistent keeps the writes to the disk across restarts of the VM in a qcow2 overlay
+                              on top of the image, stored on a PVC which is created along with the VM and deleted with it.
+                              The image must not change during the lifetime of the overlay, referencing it by digest is recommended.
+                            properties:
+                              accessModes:
+                                description: |-
+                                  AccessModes of the overlay PVC, defaults to ReadWriteOnce.
+                                  ReadWriteMany is required to live migrate the VM.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              capacity:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Capacity of the overlay PVC, it bounds
+                                  the amount of data written to the disk
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              storageClassName:
+                                description: StorageClassName of the overlay PVC,
+                                  the default storage class is used when empty
+                                type: string
+                            required:
+                            - capacity
+                            type: object
                         required:
                         - image
                         type: object
@@ -8309,6 +8660,12 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: |-
+                              Format represents the format of the memory dump, defaults to Raw.
+                              A non hotpluggable volume with the SavedState format is used to
+                              start the VMI from the saved state instead of booting it.
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -8479,6 +8836,35 @@ var CRDsValidation map[string]string = map[string]string{
                     description: BackupVolumeInfo contains information about a volume
                       included in a backup
                     properties:
+                      claim:
+                        description: Claim describes the PVC which backed the volume
+                          at backup time
+                        properties:
+                          accessModes:
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          capacity:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Capacity is the capacity of the PVC
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          claimName:
+                            description: ClaimName is the name of the PVC
+                            type: string
+                          storageClassName:
+                            type: string
+                          volumeMode:
+                            description: PersistentVolumeMode describes how a volume
+                              is intended to be consumed, either Block or Filesystem.
+                            type: string
+                        required:
+                        - capacity
+                        - claimName
+                        type: object
                       diskTarget:
                         description: DiskTarget is the disk target device name at
                           backup time
@@ -8525,6 +8911,38 @@ var CRDsValidation map[string]string = map[string]string{
             - type
             type: object
           type: array
+        containerDiskCommitRequest:
+          description: |-
+            ContainerDiskCommitRequest tracks the request to commit a persistent containerDisk
+            into a PVC
+          nullable: true
+          properties:
+            claimName:
+              description: ClaimName is the name of the PVC receiving the disk, it
+                can be the PVC of a blank DataVolume
+              type: string
+            endTimestamp:
+              description: EndTimestamp represents the time the commit completed
+              format: date-time
+              type: string
+            message:
+              description: Message is a detailed message about failure of the commit
+              type: string
+            phase:
+              description: Phase represents the commit phase
+              type: string
+            startTimestamp:
+              description: StartTimestamp represents the time the commit started
+              format: date-time
+              type: string
+            volumeName:
+              description: VolumeName is the name of the persistent containerDisk
+                volume to commit
+              type: string
+          required:
+          - claimName
+          - volumeName
+          type: object
         created:
           description: Created indicates if the virtual machine is created in the
             cluster
@@ -8584,6 +9002,10 @@ var CRDsValidation map[string]string = map[string]string{
             fileName:
               description: FileName represents the name of the output file
               type: string
+            format:
+              description: Format represents the format of the memory dump, defaults
+                to Raw
+              type: string
             message:
               description: Message is a detailed message about failure of the memory
                 dump
@@ -8711,9 +9133,16 @@ var CRDsValidation map[string]string = map[string]string{
                   within this field specify how to add the volume
                 properties:
                   disk:
-                    description: Disk represents the hotplug disk that will be plugged
-                      into the running VMI
+                    description: |-
+                      Disk represents the hotplug disk that will be plugged into the running VMI.
+                      Either Disk or Filesystem must be set.
                     properties:
+                      autoGrowFilesystem:
+                        description: |-
+                          AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent
+                          after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.
+                          Defaults to false.
+                        type: boolean
                       blockSize:
                         description: If specified, the virtual disk will be presented
                           with the given block sizes.
@@ -8814,6 +9243,84 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune throttles the I/O of the disk.
+                          Requires the DiskIOTune feature gate. Changes are applied to a running VMI
+                          when the VM uses the LiveUpdate rollout strategy.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed the limits
+                              for a short period of time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is the maximum duration of a burst.
+                                  Defaults to one second.
+                                format: int64
+                                type: integer
+                              readBytesSec:
+                                description: ReadBytesSec is the read burst throughput
+                                  in bytes per second.
+                                format: int64
+                                type: integer
+                              readIOPSSec:
+                                description: ReadIOPSSec is the read burst I/O operations
+                                  per second.
+                                format: int64
+                                type: integer
+                              totalBytesSec:
+                                description: TotalBytesSec is the total burst throughput
+                                  in bytes per second.
+                                format: int64
+                                type: integer
+                              totalIOPSSec:
+                                description: TotalIOPSSec is the total burst I/O operations
+                                  per second.
+                                format: int64
+                                type: integer
+                              writeBytesSec:
+                                description: WriteBytesSec is the write burst throughput
+                                  in bytes per second.
+                                format: int64
+                                type: integer
+                              writeIOPSSec:
+                                description: WriteIOPSSec is the write burst I/O operations
+                                  per second.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesSec:
+                            description: ReadBytesSec is the read throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec is the read I/O operations per
+                              second limit.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec is the total throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec is the total I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec is the write throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec is the write I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -8861,6 +9368,21 @@ var CRDsValidation map[string]string = map[string]string{
                       type: string
                     type: array
                     x-kubernetes-list-type: atomic
+                  filesystem:
+                    description: |-
+                      Filesystem represents the virtiofs filesystem that will be plugged into the running VMI
+                      instead of a disk.
+                    properties:
+                      name:
+                        description: Name is the device name
+                        type: string
+                      virtiofs:
+                        description: Virtiofs is supported
+                        type: object
+                    required:
+                    - name
+                    - virtiofs
+                    type: object
                   name:
                     description: |-
                       Name represents the name that will be used to map the
@@ -8912,7 +9434,6 @@ var CRDsValidation map[string]string = map[string]string{
                         type: object
                     type: object
                 required:
-                - disk
                 - name
                 - volumeSource
                 type: object
@@ -9122,30 +9643,187 @@ var CRDsValidation map[string]string = map[string]string{
         forceFullBackup:
           description: ForceFullBackup indicates that a full backup is desired
           type: boolean
-        mode:
-          description: Mode specifies the way the backup output will be recieved
-          enum:
-          - Push
-          type: string
-        pvcName:
-          description: |-
-            PvcName required in push mode. Specifies the name of the PVC
-            where the backup output will be stored
-          type: string
-        skipQuiesce:
-          description: SkipQuiesce indicates whether the VM's filesystem shoule not
-            be quiesced before the backup
-          type: boolean
-        source:
+        hooks:
           description: |-
-            Source specifies the backup source - either a VirtualMachine or a VirtualMachineBackupTracker.
-            When Kind is VirtualMachine: performs a backup of the specified VM.
-            When Kind is VirtualMachineBackupTracker: uses the tracker to get the source VM
-            and the base checkpoint for incremental backup. The tracker will be updated
-            with the new checkpoint after backup completion.
+            Hooks run around the freeze of the guest filesystem, they are
+            skipped when SkipQuiesce is set
           properties:
-            apiGroup:
-              description: |-
+            postThaw:
+              description: PostThaw hooks run in order after the guest filesystem
+                is thawed
+              items:
+                description: |-
+                  FreezeHook is a single action which runs before the guest filesystem is
+                  frozen or after it is thawed. Exactly one of GuestExec and HTTP must be set.
+                properties:
+                  guestExec:
+                    description: GuestExec runs a command in the guest through the
+                      guest agent, it is only allowed for users who may run commands
+                      in the VMI through its guestexec subresource
+                    properties:
+                      command:
+                        description: |-
+                          Command is the executable and its arguments, it is not run in a shell.
+                          The hook fails if the command exits with a non zero code.
+                        items:
+                          type: string
+                        minItems: 1
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - command
+                    type: object
+                  http:
+                    description: HTTP sends a POST request to a pod in the namespace
+                      of the VM
+                    properties:
+                      path:
+                        description: Path is the path of the request
+                        type: string
+                      podName:
+                        description: |-
+                          PodName is the name of the pod which receives the request, it must be
+                          in the namespace of the VM and labeled kubevirt.io/freeze-hook-target=true.
+                          Pods using the host network are not supported.
+                        minLength: 1
+                        type: string
+                      port:
+                        description: |-
+                          Port is the port the pod listens on, it must be declared by a container
+                          of the pod
+                        format: int32
+                        maximum: 65535
+                        minimum: 1
+                        type: integer
+                    required:
+                    - podName
+                    - port
+                    type: object
+                  name:
+                    description: Name identifies the hook in the recorded results
+                    minLength: 1
+                    type: string
+                  onFailure:
+                    description: |-
+                      OnFailure defines what happens when the hook fails. Fail, the default,
+                      fails the operation. Continue records the failure and goes on.
+                    enum:
+                    - Fail
+                    - Continue
+                    type: string
+                  timeout:
+                    description: Timeout limits how long the hook may run. Defaults
+                      to 30s.
+                    type: string
+                required:
+                - name
+                type: object
+                x-kubernetes-validations:
+                - message: exactly one of guestExec or http must be set
+                  rule: has(self.guestExec) != has(self.http)
+              type: array
+              x-kubernetes-list-type: atomic
+            preFreeze:
+              description: PreFreeze hooks run in order before the guest filesystem
+                is frozen
+              items:
+                description: |-
+                  FreezeHook is a single action which runs before the guest filesystem is
+                  frozen or after it is thawed. Exactly one of GuestExec and HTTP must be set.
+                properties:
+                  guestExec:
+                    description: GuestExec runs a command in the guest through the
+                      guest agent, it is only allowed for users who may run commands
+                      in the VMI through its guestexec subresource
+                    properties:
+                      command:
+                        description: |-
+                          Command is the executable and its arguments, it is not run in a shell.
+                          The hook fails if the command exits with a non zero code.
+                        items:
+                          type: string
+                        minItems: 1
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - command
+                    type: object
+                  http:
+                    description: HTTP sends a POST request to a pod in the namespace
+                      of the VM
+                    properties:
+                      path:
+                        description: Path is the path of the request
+                        type: string
+                      podName:
+                        description: |-
+                          PodName is the name of the pod which receives the request, it must be
+                          in the namespace of the VM and labeled kubevirt.io/freeze-hook-target=true.
+                          Pods using the host network are not supported.
+                        minLength: 1
+                        type: string
+                      port:
+                        description: |-
+                          Port is the port the pod listens on, it must be declared by a container
+                          of the pod
+                        format: int32
+                        maximum: 65535
+                        minimum: 1
+                        type: integer
+                    required:
+                    - podName
+                    - port
+                    type: object
+                  name:
+                    description: Name identifies the hook in the recorded results
+                    minLength: 1
+                    type: string
+                  onFailure:
+                    description: |-
+                      OnFailure defines what happens when the hook fails. Fail, the default,
+                      fails the operation. Continue records the failure and goes on.
+                    enum:
+                    - Fail
+                    - Continue
+                    type: string
+                  timeout:
+                    description: Timeout limits how long the hook may run. Defaults
+                      to 30s.
+                    type: string
+                required:
+                - name
+                type: object
+                x-kubernetes-validations:
+                - message: exactly one of guestExec or http must be set
+                  rule: has(self.guestExec) != has(self.http)
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
+        mode:
+          description: Mode specifies the way the backup output will be recieved
+          enum:
+          - Push
+          - Pull
+          type: string
+        pvcName:
+          description: |-
+            PvcName required in push mode. Specifies the name of the PVC
+            where the backup output will be stored
+          type: string
+        skipQuiesce:
+          description: SkipQuiesce indicates whether the VM's filesystem shoule not
+            be quiesced before the backup
+          type: boolean
+        source:
+          description: |-
+            Source specifies the backup source - either a VirtualMachine or a VirtualMachineBackupTracker.
+            When Kind is VirtualMachine: performs a backup of the specified VM.
+            When Kind is VirtualMachineBackupTracker: uses the tracker to get the source VM
+            and the base checkpoint for incremental backup. The tracker will be updated
+            with the new checkpoint after backup completion.
+          properties:
+            apiGroup:
+              description: |-
                 APIGroup is the group for the resource being referenced.
                 If APIGroup is not specified, the specified Kind must be in the core API group.
                 For any other third-party types, APIGroup is required.
@@ -9174,6 +9852,13 @@ var CRDsValidation map[string]string = map[string]string{
               self.kind == ''VirtualMachineBackupTracker'')'
           - message: name is required
             rule: self.name != ''
+        ttlDuration:
+          description: |-
+            TTLDuration is used in pull mode only. It limits how long the backup
+            export stays available, the export is removed once it expires or when
+            the backup is deleted, whichever comes first.
+            If not set the export stays available until the backup is deleted.
+          type: string
       required:
       - source
       type: object
@@ -9183,10 +9868,18 @@ var CRDsValidation map[string]string = map[string]string{
       - message: pvcName must be provided when mode is unset or Push
         rule: (has(self.mode) && self.mode != 'Push') || (has(self.pvcName) && self.pvcName
           != "")
+      - message: pvcName is not supported when mode is Pull
+        rule: '!has(self.mode) || self.mode != ''Pull'' || !has(self.pvcName)'
+      - message: ttlDuration is only supported when mode is Pull
+        rule: '!has(self.ttlDuration) || (has(self.mode) && self.mode == ''Pull'')'
     status:
       description: VirtualMachineBackupStatus is the status for a VirtualMachineBackup
         resource
       properties:
+        baseCheckpointName:
+          description: BaseCheckpointName is the checkpoint an incremental backup
+            is based on
+          type: string
         checkpointName:
           description: CheckpointName the name of the checkpoint created for the current
             backup
@@ -9218,6 +9911,77 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        export:
+          description: |-
+            Export contains the information needed to read the backup
+            output of a pull mode backup
+          properties:
+            endpoint:
+              description: |-
+                Endpoint is the path of the backup export, relative to the Kubernetes
+                API server. The extents of an exported volume are listed with a GET
+                request to <endpoint>/map and its data is read with a GET request to
+                <endpoint>/read. Requests are authenticated and authorized by the API
+                server, and travel over TLS all the way to the node that runs the VM.
+              type: string
+            expirationTime:
+              description: ExpirationTime is the time at which the export will be
+                removed
+              format: date-time
+              type: string
+            volumes:
+              description: Volumes lists the exported volumes
+              items:
+                description: BackupExportVolume describes the NBD export of a single
+                  volume
+                properties:
+                  dirtyBitmap:
+                    description: |-
+                      DirtyBitmap is the name of the dirty bitmap that holds the blocks
+                      changed since the base checkpoint, only set for incremental backups
+                    type: string
+                  exportName:
+                    description: ExportName is the NBD export name to read the volume
+                      data from
+                    type: string
+                  volumeName:
+                    description: VolumeName is the volume name from VMI spec
+                    type: string
+                required:
+                - exportName
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - endpoint
+          type: object
+        freezeHooks:
+          description: |-
+            FreezeHooks lists the outcome of the hooks which ran around the freeze
+            of the guest filesystem
+          items:
+            description: FreezeHookResult is the outcome of a hook
+            properties:
+              message:
+                description: Message describes the failure of the hook
+                type: string
+              name:
+                description: Name is the name of the hook
+                type: string
+              stage:
+                description: Stage is the point at which the hook ran
+                type: string
+              succeeded:
+                description: Succeeded indicates that the hook completed successfully
+                type: boolean
+            required:
+            - name
+            - stage
+            - succeeded
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         includedVolumes:
           description: IncludedVolumes lists the volumes that were included in the
             backup
@@ -9225,6 +9989,35 @@ var CRDsValidation map[string]string = map[string]string{
             description: BackupVolumeInfo contains information about a volume included
               in a backup
             properties:
+              claim:
+                description: Claim describes the PVC which backed the volume at backup
+                  time
+                properties:
+                  accessModes:
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  capacity:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: Capacity is the capacity of the PVC
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  claimName:
+                    description: ClaimName is the name of the PVC
+                    type: string
+                  storageClassName:
+                    type: string
+                  volumeMode:
+                    description: PersistentVolumeMode describes how a volume is intended
+                      to be consumed, either Block or Filesystem.
+                    type: string
+                required:
+                - capacity
+                - claimName
+                type: object
               diskTarget:
                 description: DiskTarget is the disk target device name at backup time
                 type: string
@@ -9240,15 +10033,21 @@ var CRDsValidation map[string]string = map[string]string{
         type:
           description: Type indicates if the backup was full or incremental
           type: string
+        virtualMachine:
+          description: |-
+            VirtualMachine is the definition of the source VirtualMachine,
+            captured when the backup started
+          type: object
+          x-kubernetes-preserve-unknown-fields: true
       type: object
   required:
   - spec
   type: object
 `,
-	"virtualmachinebackuptracker": `openAPIV3Schema:
+	"virtualmachinebackuprestore": `openAPIV3Schema:
   description: |-
-    VirtualMachineBackupTracker defines the way to track the latest checkpoint of
-    a backup solution for a vm
+    VirtualMachineBackupRestore restores a VM, or a single volume of a VM,
+    from the push mode backups of a checkpoint chain
   properties:
     apiVersion:
       description: |-
@@ -9268,12 +10067,21 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
-      description: VirtualMachineBackupTrackerSpec is the spec for a VirtualMachineBackupTracker
+      description: VirtualMachineBackupRestoreSpec is the spec for a VirtualMachineBackupRestore
         resource
       properties:
-        source:
-          description: Source specifies the VM that this backupTracker is associated
-            with
+        checkpointName:
+          description: |-
+            CheckpointName is the checkpoint to restore. The disks are rebuilt
+            from the full backup of its chain and the incremental backups up to
+            the checkpoint.
+          minLength: 1
+          type: string
+        target:
+          description: |-
+            Target is the VirtualMachine to restore. Unless VolumeName is set, the
+            VirtualMachine must not exist and is recreated from the definition
+            captured at backup time.
           properties:
             apiGroup:
               description: |-
@@ -9299,58 +10107,87 @@ var CRDsValidation map[string]string = map[string]string{
             rule: self.kind == 'VirtualMachine'
           - message: name is required
             rule: self.name != ''
+        volumeName:
+          description: |-
+            VolumeName restores only this volume, into the existing target
+            VirtualMachine which must be stopped
+          type: string
       required:
-      - source
+      - checkpointName
+      - target
       type: object
       x-kubernetes-validations:
       - message: spec is immutable after creation
         rule: self == oldSelf
     status:
+      description: VirtualMachineBackupRestoreStatus is the status for a VirtualMachineBackupRestore
+        resource
       properties:
-        checkpointRedefinitionRequired:
-          description: |-
-            CheckpointRedefinitionRequired is set to true by virt-handler when the VM
-            restarts and has a checkpoint that needs to be redefined in libvirt.
-            virt-controller will process this flag, attempt redefinition, and clear it.
-          type: boolean
-        latestCheckpoint:
+        backups:
           description: |-
-            LatestCheckpoint is the metadata of the checkpoint of
-            the latest performed backup
-          properties:
-            creationTime:
-              format: date-time
-              type: string
-            name:
-              type: string
-            volumes:
-              description: Volumes lists volumes and their disk targets at backup
-                time
-              items:
-                description: BackupVolumeInfo contains information about a volume
-                  included in a backup
-                properties:
-                  diskTarget:
-                    description: DiskTarget is the disk target device name at backup
-                      time
-                    type: string
-                  volumeName:
-                    description: VolumeName is the volume name from VMI spec
-                    type: string
-                required:
-                - diskTarget
-                - volumeName
-                type: object
-              type: array
-              x-kubernetes-list-type: atomic
-          type: object
+            Backups lists the VirtualMachineBackups of the restored chain,
+            starting with the full backup
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        volumes:
+          description: Volumes lists the restored volumes
+          items:
+            description: BackupRestoreVolumeStatus describes the restore of a single
+              volume
+            properties:
+              claimName:
+                description: ClaimName is the name of the PVC the volume is restored
+                  into
+                type: string
+              completed:
+                description: Completed indicates the data of the volume was restored
+                type: boolean
+              volumeName:
+                description: VolumeName is the volume name from the VM spec
+                type: string
+            required:
+            - claimName
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
       type: object
   required:
   - spec
   type: object
 `,
-	"virtualmachineclone": `openAPIV3Schema:
-  description: VirtualMachineClone is a CRD that clones one VM into another.
+	"virtualmachinebackupschedule": `openAPIV3Schema:
+  description: VirtualMachineBackupSchedule defines a schedule to periodically back
+    up VMs
   properties:
     apiVersion:
       description: |-
@@ -9370,76 +10207,111 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
+      description: VirtualMachineBackupScheduleSpec is the spec for a VirtualMachineBackupSchedule
+        resource
       properties:
-        annotationFilters:
-          description: |-
-            Example use: "!some/key*".
-            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        labelFilters:
-          description: |-
-            Example use: "!some/key*".
-            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        newMacAddresses:
-          additionalProperties:
-            type: string
-          description: |-
-            NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the
-            value is the new mac address. If this field is not specified, a new MAC address will
-            be generated automatically, as for any interface that is not included in this map.
+        concurrency:
+          description: Concurrency limits the number of backups running at the same
+            time
+          properties:
+            maxPerNode:
+              description: MaxPerNode is the maximum number of backups running on
+                the same node
+              format: int32
+              minimum: 1
+              type: integer
+            maxPerStorageClass:
+              description: |-
+                MaxPerStorageClass is the maximum number of backups running on VMs
+                with volumes of the same storage class
+              format: int32
+              minimum: 1
+              type: integer
           type: object
-        newSMBiosSerial:
-          description: |-
-            NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will
-            be generated automatically.
-          type: string
-        patches:
-          description: |-
-            Patches holds JSON patches to apply to target. Patches should fit the target's Kind.
-            Example: '{"op": "add", "path": "/spec/template/metadata/labels/example", "value": "new-label"}'
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        source:
-          description: |-
-            Source is the object that would be cloned. Currently supported source types are:
-            VirtualMachine of kubevirt.io API group,
-            VirtualMachineSnapshot of snapshot.kubevirt.io API group
+        retentionPolicy:
+          description: RetentionPolicy is set on the VirtualMachineBackupTrackers
+            owned by the schedule
           properties:
-            apiGroup:
+            fullBackupInterval:
               description: |-
-                APIGroup is the group for the resource being referenced.
-                If APIGroup is not specified, the specified Kind must be in the core API group.
-                For any other third-party types, APIGroup is required.
-              type: string
-            kind:
-              description: Kind is the type of resource being referenced
+                FullBackupInterval forces a full backup once the last full backup is
+                older than the interval
               type: string
-            name:
-              description: Name is the name of resource being referenced
+            maxAge:
+              description: |-
+                MaxAge is the maximum age of a checkpoint in the chain. Like for
+                MaxChainLength, expired checkpoints are pruned a whole chain at a time.
               type: string
-          required:
-          - kind
-          - name
+            maxChainLength:
+              description: |-
+                MaxChainLength is the maximum number of checkpoints kept in the chain.
+                Checkpoints are pruned a whole chain at a time, a full checkpoint is
+                only removed with its incrementals once a newer full checkpoint exists.
+              format: int32
+              minimum: 1
+              type: integer
+            maxIncrementals:
+              description: |-
+                MaxIncrementals forces a full backup once this many incremental backups
+                were taken since the last full backup
+              format: int32
+              minimum: 1
+              type: integer
           type: object
-          x-kubernetes-map-type: atomic
-        target:
+        schedule:
           description: |-
-            Target is the outcome of the cloning process.
-            Currently supported source types are:
-            - VirtualMachine of kubevirt.io API group
-            - Empty (nil).
-            If the target is not provided, the target type would default to VirtualMachine and a random
-            name would be generated for the target. The target's name can be viewed by
-            inspecting status "TargetName" field below.
+            Schedule is a cron expression in the standard five field format, or one
+            of the @hourly, @daily, @weekly, @monthly and @yearly macros, evaluated in UTC
+          minLength: 1
+          type: string
+        selector:
+          description: Selector selects the VMs that are backed up in the namespace
+            of the schedule
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+        source:
+          description: Source specifies the VM that is backed up
           properties:
             apiGroup:
               description: |-
@@ -9458,88 +10330,283 @@ var CRDsValidation map[string]string = map[string]string{
           - name
           type: object
           x-kubernetes-map-type: atomic
+          x-kubernetes-validations:
+          - message: apiGroup must be kubevirt.io
+            rule: has(self.apiGroup) && self.apiGroup == 'kubevirt.io'
+          - message: kind must be VirtualMachine
+            rule: self.kind == 'VirtualMachine'
+          - message: name is required
+            rule: self.name != ''
+        suspend:
+          description: Suspend stops the schedule from creating new backups
+          type: boolean
         template:
-          description: For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+          description: Template describes the VirtualMachineBackups created by the
+            schedule
           properties:
-            annotationFilters:
+            hooks:
               description: |-
-                Example use: "!some/key*".
-                For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
-              items:
-                type: string
-              type: array
-              x-kubernetes-list-type: atomic
-            labelFilters:
+                Hooks run around the freeze of the guest filesystem, they are
+                skipped when SkipQuiesce is set
+              properties:
+                postThaw:
+                  description: PostThaw hooks run in order after the guest filesystem
+                    is thawed
+                  items:
+                    description: |-
+                      FreezeHook is a single action which runs before the guest filesystem is
+                      frozen or after it is thawed. Exactly one of GuestExec and HTTP must be set.
+                    properties:
+                      guestExec:
+                        description: GuestExec runs a command in the guest through
+                          the guest agent, it is only allowed for users who may run
+                          commands in the VMI through its guestexec subresource
+                        properties:
+                          command:
+                            description: |-
+                              Command is the executable and its arguments, it is not run in a shell.
+                              The hook fails if the command exits with a non zero code.
+                            items:
+                              type: string
+                            minItems: 1
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        required:
+                        - command
+                        type: object
+                      http:
+                        description: HTTP sends a POST request to a pod in the namespace
+                          of the VM
+                        properties:
+                          path:
+                            description: Path is the path of the request
+                            type: string
+                          podName:
+                            description: |-
+                              PodName is the name of the pod which receives the request, it must be
+                              in the namespace of the VM and labeled kubevirt.io/freeze-hook-target=true.
+                              Pods using the host network are not supported.
+                            minLength: 1
+                            type: string
+                          port:
+                            description: |-
+                              Port is the port the pod listens on, it must be declared by a container
+                              of the pod
+                            format: int32
+                            maximum: 65535
+                            minimum: 1
+                            type: integer
+                        required:
+                        - podName
+                        - port
+                        type: object
+                      name:
+                        description: Name identifies the hook in the recorded results
+                        minLength: 1
+                        type: string
+                      onFailure:
+                        description: |-
+                          OnFailure defines what happens when the hook fails. Fail, the default,
+                          fails the operation. Continue records the failure and goes on.
+                        enum:
+                        - Fail
+                        - Continue
+                        type: string
+                      timeout:
+                        description: Timeout limits how long the hook may run. Defaults
+                          to 30s.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                    x-kubernetes-validations:
+                    - message: exactly one of guestExec or http must be set
+                      rule: has(self.guestExec) != has(self.http)
+                  type: array
+                  x-kubernetes-list-type: atomic
+                preFreeze:
+                  description: PreFreeze hooks run in order before the guest filesystem
+                    is frozen
+                  items:
+                    description: |-
+                      FreezeHook is a single action which runs before the guest filesystem is
+                      frozen or after it is thawed. Exactly one of GuestExec and HTTP must be set.
+                    properties:
+                      guestExec:
+                        description: GuestExec runs a command in the guest through
+                          the guest agent, it is only allowed for users who may run
+                          commands in the VMI through its guestexec subresource
+                        properties:
+                          command:
+                            description: |-
+                              Command is the executable and its arguments, it is not run in a shell.
+                              The hook fails if the command exits with a non zero code.
+                            items:
+                              type: string
+                            minItems: 1
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        required:
+                        - command
+                        type: object
+                      http:
+                        description: HTTP sends a POST request to a pod in the namespace
+                          of the VM
+                        properties:
+                          path:
+                            description: Path is the path of the request
+                            type: string
+                          podName:
+                            description: |-
+                              PodName is the name of the pod which receives the request, it must be
+                              in the namespace of the VM and labeled kubevirt.io/freeze-hook-target=true.
+                              Pods using the host network are not supported.
+                            minLength: 1
+                            type: string
+                          port:
+                            description: |-
+                              Port is the port the pod listens on, it must be declared by a container
+                              of the pod
+                            format: int32
+                            maximum: 65535
+                            minimum: 1
+                            type: integer
+                        required:
+                        - podName
+                        - port
+                        type: object
+                      name:
+                        description: Name identifies the hook in the recorded results
+                        minLength: 1
+                        type: string
+                      onFailure:
+                        description: |-
+                          OnFailure defines what happens when the hook fails. Fail, the default,
+                          fails the operation. Continue records the failure and goes on.
+                        enum:
+                        - Fail
+                        - Continue
+                        type: string
+                      timeout:
+                        description: Timeout limits how long the hook may run. Defaults
+                          to 30s.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                    x-kubernetes-validations:
+                    - message: exactly one of guestExec or http must be set
+                      rule: has(self.guestExec) != has(self.http)
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            mode:
+              description: Mode specifies the way the backup output will be recieved
+              enum:
+              - Push
+              - Pull
+              type: string
+            pvcName:
               description: |-
-                Example use: "!some/key*".
-                For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
-              items:
-                type: string
-              type: array
-              x-kubernetes-list-type: atomic
+                PvcName required in push mode. Specifies the name of the PVC
+                where the backup output will be stored. Push mode is not supported
+                when the schedule selects its VMs with Selector.
+              type: string
+            skipQuiesce:
+              description: SkipQuiesce indicates whether the VM's filesystem shoule
+                not be quiesced before the backup
+              type: boolean
+            ttlDuration:
+              description: TTLDuration limits how long the export of a pull mode backup
+                stays available
+              type: string
           type: object
-        volumeNamePolicy:
-          description: VolumeNamePolicy defines how to handle volume naming during
-            the clone operation
-          enum:
-          - RandomizeNames
-          - PrefixTargetName
-          type: string
+          x-kubernetes-validations:
+          - message: pvcName must be provided when mode is unset or Push
+            rule: (has(self.mode) && self.mode != 'Push') || (has(self.pvcName) &&
+              self.pvcName != "")
+          - message: pvcName is not supported when mode is Pull
+            rule: '!has(self.mode) || self.mode != ''Pull'' || !has(self.pvcName)'
+          - message: ttlDuration is only supported when mode is Pull
+            rule: '!has(self.ttlDuration) || (has(self.mode) && self.mode == ''Pull'')'
       required:
-      - source
+      - schedule
+      - template
       type: object
+      x-kubernetes-validations:
+      - message: exactly one of source or selector must be provided
+        rule: has(self.source) != has(self.selector)
+      - message: pvcName is not supported with a selector
+        rule: '!has(self.selector) || !has(self.template.pvcName)'
     status:
+      description: VirtualMachineBackupScheduleStatus is the status for a VirtualMachineBackupSchedule
+        resource
       properties:
-        conditions:
+        lastScheduleTime:
+          description: LastScheduleTime is the last time a backup was scheduled
+          format: date-time
+          type: string
+        nextScheduleTime:
+          description: NextScheduleTime is the next time a backup will be scheduled
+          format: date-time
+          type: string
+        sources:
+          description: Sources reports the state of the schedule for each VM it backs
+            up
           items:
-            description: Condition defines conditions
+            description: BackupScheduleSourceStatus reports the state of a schedule
+              for a single VM
             properties:
-              lastProbeTime:
+              consecutiveFailures:
+                description: |-
+                  ConsecutiveFailures is the number of backups of the VM that failed
+                  since the last successful one
+                format: int32
+                type: integer
+              lastBackupName:
+                description: LastBackupName is the name of the last backup created
+                  for the VM
+                type: string
+              lastFailure:
+                description: LastFailure is the reason of the last failed backup of
+                  the VM
+                type: string
+              lastScheduleTime:
+                description: LastScheduleTime is the last time a backup of the VM
+                  was scheduled
                 format: date-time
-                nullable: true
                 type: string
-              lastTransitionTime:
+              lastSuccessfulTime:
+                description: LastSuccessfulTime is the last time a backup of the VM
+                  completed successfully
                 format: date-time
-                nullable: true
                 type: string
               message:
+                description: Message explains why a due backup of the VM was not created
+                  yet
                 type: string
-              reason:
-                type: string
-              status:
+              trackerName:
+                description: |-
+                  TrackerName is the name of the VirtualMachineBackupTracker owned by
+                  the schedule to track the checkpoints of the VM
                 type: string
-              type:
-                description: ConditionType is the const type for Conditions
+              vmName:
+                description: VMName is the name of the VM
                 type: string
             required:
-            - status
-            - type
+            - vmName
             type: object
           type: array
           x-kubernetes-list-type: atomic
-        creationTime:
-          format: date-time
-          nullable: true
-          type: string
-        phase:
-          type: string
-        restoreName:
-          nullable: true
-          type: string
-        snapshotName:
-          nullable: true
-          type: string
-        targetName:
-          nullable: true
-          type: string
       type: object
   required:
   - spec
   type: object
 `,
-	"virtualmachineclusterinstancetype": `openAPIV3Schema:
-  description: VirtualMachineClusterInstancetype is a cluster scoped version of VirtualMachineInstancetype
-    resource.
+	"virtualmachinebackuptracker": `openAPIV3Schema:
+  description: |-
+    VirtualMachineBackupTracker defines the way to track the latest checkpoint of
+    a backup solution for a vm
   properties:
     apiVersion:
       description: |-
@@ -9559,265 +10626,227 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
-      description: Required spec describing the instancetype
+      description: VirtualMachineBackupTrackerSpec is the spec for a VirtualMachineBackupTracker
+        resource
       properties:
-        annotations:
-          additionalProperties:
-            type: string
-          description: Optionally defines the required Annotations to be used by the
-            instance type and applied to the VirtualMachineInstance
-          type: object
-        cpu:
-          description: Required CPU related attributes of the instancetype.
+        retentionPolicy:
+          description: |-
+            RetentionPolicy limits the checkpoint chain tracked for the VM.
+            When a backup completes, checkpoints falling out of the policy are
+            deleted together with the VirtualMachineBackups that created them.
           properties:
-            dedicatedCPUPlacement:
+            fullBackupInterval:
               description: |-
-                DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
-                with enough dedicated pCPUs and pin the vCPUs to it.
-              type: boolean
-            guest:
+                FullBackupInterval forces a full backup once the last full backup is
+                older than the interval
+              type: string
+            maxAge:
               description: |-
-                Required number of vCPUs to expose to the guest.
-
-                The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+                MaxAge is the maximum age of a checkpoint in the chain. Like for
+                MaxChainLength, expired checkpoints are pruned a whole chain at a time.
+              type: string
+            maxChainLength:
+              description: |-
+                MaxChainLength is the maximum number of checkpoints kept in the chain.
+                Checkpoints are pruned a whole chain at a time, a full checkpoint is
+                only removed with its incrementals once a newer full checkpoint exists.
               format: int32
+              minimum: 1
               type: integer
-            isolateEmulatorThread:
+            maxIncrementals:
               description: |-
-                IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
-                the emulator thread on it.
-              type: boolean
-            maxSockets:
-              description: MaxSockets specifies the maximum amount of sockets that
-                can be hotplugged
+                MaxIncrementals forces a full backup once this many incremental backups
+                were taken since the last full backup
               format: int32
+              minimum: 1
               type: integer
-            model:
+          type: object
+        source:
+          description: Source specifies the VM that this backupTracker is associated
+            with
+          properties:
+            apiGroup:
               description: |-
-                Model specifies the CPU model inside the VMI.
-                List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
-                It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
-                and "host-model" to get CPU closest to the node one.
-                Defaults to host-model.
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
               type: string
-            numa:
-              description: NUMA allows specifying settings for the guest NUMA topology
-              properties:
-                guestMappingPassthrough:
-                  description: |-
-                    GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
-                    The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
-                  type: object
-              type: object
-            realtime:
-              description: Realtime instructs the virt-launcher to tune the VMI for
-                lower latency, optional for real time workloads
-              properties:
-                mask:
-                  description: |-
-                    Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
-                    Example: "0-3,^1","0,2,3","2-3"
-                  type: string
-              type: object
           required:
-          - guest
+          - kind
+          - name
           type: object
-        gpus:
-          description: Optionally defines any GPU devices associated with the instancetype.
-          items:
-            properties:
-              claimName:
-                description: |-
-                  ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
-                  device is allocated
-                type: string
-              deviceName:
-                description: DeviceName is the name of the device provisioned by device-plugins
-                type: string
-              name:
-                description: Name of the GPU device as exposed by a device plugin
-                type: string
-              requestName:
-                description: |-
-                  RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
-                  device is requested
-                type: string
-              tag:
-                description: If specified, the virtual network interface address and
-                  its tag will be provided to the guest via config drive
-                type: string
-              virtualGPUOptions:
-                properties:
-                  display:
-                    properties:
-                      enabled:
-                        description: |-
-                          Enabled determines if a display addapter backed by a vGPU should be enabled or disabled on the guest.
-                          Defaults to true.
-                        type: boolean
-                      ramFB:
-                        description: |-
-                          Enables a boot framebuffer, until the guest OS loads a real GPU driver
-                          Defaults to true.
-                        properties:
-                          enabled:
-                            description: |-
-                              Enabled determines if the feature should be enabled or disabled on the guest.
-                              Defaults to true.
-                            type: boolean
-                        type: object
-                    type: object
-                type: object
-            required:
-            - name
-            type: object
-          type: array
-          x-kubernetes-list-type: atomic
-        hostDevices:
-          description: Optionally defines any HostDevices associated with the instancetype.
+          x-kubernetes-map-type: atomic
+          x-kubernetes-validations:
+          - message: apiGroup must be kubevirt.io
+            rule: has(self.apiGroup) && self.apiGroup == 'kubevirt.io'
+          - message: kind must be VirtualMachine
+            rule: self.kind == 'VirtualMachine'
+          - message: name is required
+            rule: self.name != ''
+      required:
+      - source
+      type: object
+      x-kubernetes-validations:
+      - message: spec is immutable after creation
+        rule: self == oldSelf
+    status:
+      properties:
+        checkpointRedefinitionRequired:
+          description: |-
+            CheckpointRedefinitionRequired is set to true by virt-handler when the VM
+            restarts and has a checkpoint that needs to be redefined in libvirt.
+            virt-controller will process this flag, attempt redefinition, and clear it.
+          type: boolean
+        checkpoints:
+          description: |-
+            Checkpoints is the history of the checkpoints kept in the chain,
+            ordered from the oldest to the latest
           items:
             properties:
-              claimName:
-                description: |-
-                  ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
-                  device is allocated
+              backupName:
+                description: BackupName is the name of the VirtualMachineBackup that
+                  created the checkpoint
                 type: string
-              deviceName:
-                description: DeviceName is the name of the device provisioned by device-plugins
+              creationTime:
+                format: date-time
                 type: string
               name:
                 type: string
-              requestName:
-                description: |-
-                  RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
-                  device is requested
-                type: string
-              tag:
-                description: If specified, the virtual network interface address and
-                  its tag will be provided to the guest via config drive
+              type:
+                description: Type is the type of the backup that created the checkpoint
                 type: string
-            required:
-            - name
+              volumes:
+                description: Volumes lists volumes and their disk targets at backup
+                  time
+                items:
+                  description: BackupVolumeInfo contains information about a volume
+                    included in a backup
+                  properties:
+                    claim:
+                      description: Claim describes the PVC which backed the volume
+                        at backup time
+                      properties:
+                        accessModes:
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        capacity:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Capacity is the capacity of the PVC
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        claimName:
+                          description: ClaimName is the name of the PVC
+                          type: string
+                        storageClassName:
+                          type: string
+                        volumeMode:
+                          description: PersistentVolumeMode describes how a volume
+                            is intended to be consumed, either Block or Filesystem.
+                          type: string
+                      required:
+                      - capacity
+                      - claimName
+                      type: object
+                    diskTarget:
+                      description: DiskTarget is the disk target device name at backup
+                        time
+                      type: string
+                    volumeName:
+                      description: VolumeName is the volume name from VMI spec
+                      type: string
+                  required:
+                  - diskTarget
+                  - volumeName
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
             type: object
           type: array
           x-kubernetes-list-type: atomic
-        ioThreads:
-          description: Optionally specifies the IOThreads options to be used by the
-            instancetype.
-          properties:
-            supplementalPoolThreadCount:
-              description: SupplementalPoolThreadCount specifies how many iothreads
-                are allocated for the supplementalPool policy.
-              format: int32
-              type: integer
-          type: object
-        ioThreadsPolicy:
-          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
-          type: string
-        launchSecurity:
-          description: Optionally defines the LaunchSecurity to be used by the instancetype.
+        latestCheckpoint:
+          description: |-
+            LatestCheckpoint is the metadata of the checkpoint of
+            the latest performed backup
           properties:
-            sev:
-              description: AMD Secure Encrypted Virtualization (SEV).
-              properties:
-                attestation:
-                  description: If specified, run the attestation process for a vmi.
-                  type: object
-                dhCert:
-                  description: Base64 encoded guest owner's Diffie-Hellman key.
-                  type: string
-                policy:
-                  description: |-
-                    Guest policy flags as defined in AMD SEV API specification.
-                    Note: due to security reasons it is not allowed to enable guest debugging. Therefore NoDebug flag is not exposed to users and is always true.
-                  properties:
-                    encryptedState:
-                      description: |-
-                        SEV-ES is required.
-                        Defaults to false.
-                      type: boolean
-                  type: object
-                session:
-                  description: Base64 encoded session blob.
-                  type: string
-              type: object
-            snp:
-              description: AMD SEV-SNP flags defined by the SEV-SNP specifications.
-              type: object
-            tdx:
-              description: Intel Trust Domain Extensions (TDX).
-              type: object
-          type: object
-        memory:
-          description: Required Memory related attributes of the instancetype.
-          properties:
-            guest:
-              anyOf:
-              - type: integer
-              - type: string
-              description: Required amount of memory which is visible inside the guest
-                OS.
-              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-              x-kubernetes-int-or-string: true
-            hugepages:
-              description: Optionally enables the use of hugepages for the VirtualMachineInstance
-                instead of regular memory.
-              properties:
-                pageSize:
-                  description: PageSize specifies the hugepage size, for x86_64 architecture
-                    valid values are 1Gi and 2Mi.
-                  type: string
-              type: object
-            maxGuest:
-              anyOf:
-              - type: integer
-              - type: string
-              description: |-
-                MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
-                The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
-              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-              x-kubernetes-int-or-string: true
-            overcommitPercent:
-              description: |-
-                OvercommitPercent is the percentage of the guest memory which will be overcommitted.
-                This means that the VMIs parent pod (virt-launcher) will request less
-                physical memory by a factor specified by the OvercommitPercent.
-                Overcommits can lead to memory exhaustion, which in turn can lead to crashes. Use carefully.
-                Defaults to 0
-              maximum: 100
-              minimum: 0
-              type: integer
-          required:
-          - guest
-          type: object
-        nodeSelector:
-          additionalProperties:
-            type: string
-          description: |-
-            NodeSelector is a selector which must be true for the vmi to fit on a node.
-            Selector which must match a node's labels for the vmi to be scheduled on that node.
-            More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
-
-            NodeSelector is the name of the custom node selector for the instancetype.
+            backupName:
+              description: BackupName is the name of the VirtualMachineBackup that
+                created the checkpoint
+              type: string
+            creationTime:
+              format: date-time
+              type: string
+            name:
+              type: string
+            type:
+              description: Type is the type of the backup that created the checkpoint
+              type: string
+            volumes:
+              description: Volumes lists volumes and their disk targets at backup
+                time
+              items:
+                description: BackupVolumeInfo contains information about a volume
+                  included in a backup
+                properties:
+                  claim:
+                    description: Claim describes the PVC which backed the volume at
+                      backup time
+                    properties:
+                      accessModes:
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      capacity:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Capacity is the capacity of the PVC
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      claimName:
+                        description: ClaimName is the name of the PVC
+                        type: string
+                      storageClassName:
+                        type: string
+                      volumeMode:
+                        description: PersistentVolumeMode describes how a volume is
+                          intended to be consumed, either Block or Filesystem.
+                        type: string
+                    required:
+                    - capacity
+                    - claimName
+                    type: object
+                  diskTarget:
+                    description: DiskTarget is the disk target device name at backup
+                      time
+                    type: string
+                  volumeName:
+                    description: VolumeName is the volume name from VMI spec
+                    type: string
+                required:
+                - diskTarget
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
           type: object
-        schedulerName:
-          description: |-
-            If specified, the VMI will be dispatched by specified scheduler.
-            If not specified, the VMI will be dispatched by default scheduler.
-
-            SchedulerName is the name of the custom K8s scheduler for the instancetype.
-          type: string
-      required:
-      - cpu
-      - memory
       type: object
   required:
   - spec
   type: object
 `,
-	"virtualmachineclusterpreference": `openAPIV3Schema:
-  description: VirtualMachineClusterPreference is a cluster scoped version of the
-    VirtualMachinePreference resource.
+	"virtualmachineclone": `openAPIV3Schema:
+  description: VirtualMachineClone is a CRD that clones one VM into another.
   properties:
     apiVersion:
       description: |-
@@ -9837,56 +10866,738 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
-      description: Required spec describing the preferences
       properties:
-        annotations:
+        annotationFilters:
+          description: |-
+            Example use: "!some/key*".
+            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        labelFilters:
+          description: |-
+            Example use: "!some/key*".
+            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        newMacAddresses:
           additionalProperties:
             type: string
-          description: Optionally defines preferred Annotations to be applied to the
-            VirtualMachineInstance
+          description: |-
+            NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the
+            value is the new mac address. If this field is not specified, a new MAC address will
+            be generated automatically, as for any interface that is not included in this map.
           type: object
-        clock:
-          description: Clock optionally defines preferences associated with the Clock
-            attribute of a VirtualMachineInstance DomainSpec
+        newSMBiosSerial:
+          description: |-
+            NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will
+            be generated automatically.
+          type: string
+        patches:
+          description: |-
+            Patches holds JSON patches to apply to target. Patches should fit the target's Kind.
+            Example: '{"op": "add", "path": "/spec/template/metadata/labels/example", "value": "new-label"}'
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        remoteExport:
+          description: |-
+            RemoteExport points to a VirtualMachineExport of a remote cluster to clone from.
+            It must be set when the source is a VirtualMachineExport.
           properties:
-            preferredClockOffset:
-              description: ClockOffset allows specifying the UTC offset or the timezone
-                of the guest clock.
+            link:
+              description: |-
+                Link is the external link of the remote VirtualMachineExport, as found in its status.
+                It has to provide the "all" manifest.
               properties:
-                timezone:
-                  description: |-
-                    Timezone sets the guest clock to the specified timezone.
-                    Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                cert:
+                  description: Cert is the public CA certificate base64 encoded
                   type: string
-                utc:
-                  description: |-
-                    UTC sets the guest clock to UTC on each boot. If an offset is specified,
-                    guest changes to the clock will be kept during reboots and are not reset.
-                  properties:
-                    offsetSeconds:
-                      description: |-
-                        OffsetSeconds specifies an offset in seconds, relative to UTC. If set,
-                        guest changes to the clock will be kept during reboots and not reset.
-                      type: integer
-                  type: object
+                manifests:
+                  description: Manifests is a list of available manifests for the
+                    export
+                  items:
+                    description: VirtualMachineExportManifest contains the type and
+                      URL of the exported manifest
+                    properties:
+                      type:
+                        description: Type is the type of manifest returned
+                        type: string
+                      url:
+                        description: Url is the url of the endpoint that returns the
+                          manifest
+                        type: string
+                    required:
+                    - type
+                    - url
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - type
+                  x-kubernetes-list-type: map
+                volumes:
+                  description: Volumes is a list of available volumes to export
+                  items:
+                    description: VirtualMachineExportVolume contains the name and
+                      available formats for the exported volume
+                    properties:
+                      formats:
+                        items:
+                          description: VirtualMachineExportVolumeFormat contains the
+                            format type and URL to get the volume in that format
+                          properties:
+                            format:
+                              description: Format is the format of the image at the
+                                specified URL
+                              type: string
+                            url:
+                              description: Url is the url that contains the volume
+                                in the format specified
+                              type: string
+                          required:
+                          - format
+                          - url
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - format
+                        x-kubernetes-list-type: map
+                      name:
+                        description: Name is the name of the exported volume
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+              required:
+              - cert
               type: object
-            preferredTimer:
-              description: Timer specifies whih timers are attached to the vmi.
-              properties:
-                hpet:
-                  description: HPET (High Precision Event Timer) - multiple timers
-                    with periodic interrupts.
-                  properties:
-                    present:
-                      description: |-
-                        Enabled set to false makes sure that the machine type or a preset can't add the timer.
-                        Defaults to true.
-                      type: boolean
-                    tickPolicy:
-                      description: |-
-                        TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest.
-                        One of "delay", "catchup", "merge", "discard".
-                      type: string
+            tokenSecretRef:
+              description: |-
+                TokenSecretRef is the name of the secret, in the namespace of the clone,
+                holding the token of the remote VirtualMachineExport under the "token" key.
+                The kubevirt-controller service account has to be granted get access to the secret.
+              type: string
+          required:
+          - link
+          - tokenSecretRef
+          type: object
+        source:
+          description: |-
+            Source is the object that would be cloned. Currently supported source types are:
+            VirtualMachine of kubevirt.io API group,
+            VirtualMachineSnapshot of snapshot.kubevirt.io API group,
+            VirtualMachineExport of export.kubevirt.io API group, which requires RemoteExport to be set
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+        sourceNamespace:
+          description: |-
+            SourceNamespace is the namespace of a VirtualMachineSnapshot source, it defaults to the
+            namespace of the clone. Cloning a snapshot from another namespace requires a
+            VirtualMachineSnapshotGrant in that namespace allowing the namespace of the clone.
+          type: string
+        target:
+          description: |-
+            Target is the outcome of the cloning process.
+            Currently supported source types are:
+            - VirtualMachine of kubevirt.io API group
+            - Empty (nil).
+            If the target is not provided, the target type would default to VirtualMachine and a random
+            name would be generated for the target. The target's name can be viewed by
+            inspecting status "TargetName" field below.
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+        template:
+          description: For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+          properties:
+            annotationFilters:
+              description: |-
+                Example use: "!some/key*".
+                For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            labelFilters:
+              description: |-
+                Example use: "!some/key*".
+                For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
+        volumeNamePolicy:
+          description: VolumeNamePolicy defines how to handle volume naming during
+            the clone operation
+          enum:
+          - RandomizeNames
+          - PrefixTargetName
+          type: string
+      required:
+      - source
+      type: object
+    status:
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        creationTime:
+          format: date-time
+          nullable: true
+          type: string
+        phase:
+          type: string
+        restoreName:
+          nullable: true
+          type: string
+        snapshotName:
+          nullable: true
+          type: string
+        targetName:
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclusterinstancetype": `openAPIV3Schema:
+  description: VirtualMachineClusterInstancetype is a cluster scoped version of VirtualMachineInstancetype
+    resource.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the instancetype
+      properties:
+        annotations:
+          additionalProperties:
+            type: string
+          description: Optionally defines the required Annotations to be used by the
+            instance type and applied to the VirtualMachineInstance
+          type: object
+        cpu:
+          description: Required CPU related attributes of the instancetype.
+          properties:
+            dedicatedCPUPlacement:
+              description: |-
+                DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
+                with enough dedicated pCPUs and pin the vCPUs to it.
+              type: boolean
+            guest:
+              description: |-
+                Required number of vCPUs to expose to the guest.
+
+                The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+              format: int32
+              type: integer
+            isolateEmulatorThread:
+              description: |-
+                IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
+                the emulator thread on it.
+              type: boolean
+            maxSockets:
+              description: MaxSockets specifies the maximum amount of sockets that
+                can be hotplugged
+              format: int32
+              type: integer
+            model:
+              description: |-
+                Model specifies the CPU model inside the VMI.
+                List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
+                It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
+                and "host-model" to get CPU closest to the node one.
+                Defaults to host-model.
+              type: string
+            numa:
+              description: NUMA allows specifying settings for the guest NUMA topology
+              properties:
+                guestMappingPassthrough:
+                  description: |-
+                    GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
+                    The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
+                  type: object
+              type: object
+            realtime:
+              description: Realtime instructs the virt-launcher to tune the VMI for
+                lower latency, optional for real time workloads
+              properties:
+                mask:
+                  description: |-
+                    Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
+                    Example: "0-3,^1","0,2,3","2-3"
+                  type: string
+              type: object
+          required:
+          - guest
+          type: object
+        diskIOTune:
+          description: Optionally defines the I/O limits applied to every disk and
+            LUN of the VirtualMachineInstance. It conflicts with disks defining their
+            own.
+          properties:
+            burst:
+              description: Burst allows the disk to exceed the limits for a short
+                period of time.
+              properties:
+                lengthSeconds:
+                  description: |-
+                    LengthSeconds is the maximum duration of a burst.
+                    Defaults to one second.
+                  format: int64
+                  type: integer
+                readBytesSec:
+                  description: ReadBytesSec is the read burst throughput in bytes
+                    per second.
+                  format: int64
+                  type: integer
+                readIOPSSec:
+                  description: ReadIOPSSec is the read burst I/O operations per second.
+                  format: int64
+                  type: integer
+                totalBytesSec:
+                  description: TotalBytesSec is the total burst throughput in bytes
+                    per second.
+                  format: int64
+                  type: integer
+                totalIOPSSec:
+                  description: TotalIOPSSec is the total burst I/O operations per
+                    second.
+                  format: int64
+                  type: integer
+                writeBytesSec:
+                  description: WriteBytesSec is the write burst throughput in bytes
+                    per second.
+                  format: int64
+                  type: integer
+                writeIOPSSec:
+                  description: WriteIOPSSec is the write burst I/O operations per
+                    second.
+                  format: int64
+                  type: integer
+              type: object
+            readBytesSec:
+              description: ReadBytesSec is the read throughput limit in bytes per
+                second.
+              format: int64
+              type: integer
+            readIOPSSec:
+              description: ReadIOPSSec is the read I/O operations per second limit.
+              format: int64
+              type: integer
+            totalBytesSec:
+              description: TotalBytesSec is the total throughput limit in bytes per
+                second.
+              format: int64
+              type: integer
+            totalIOPSSec:
+              description: TotalIOPSSec is the total I/O operations per second limit.
+              format: int64
+              type: integer
+            writeBytesSec:
+              description: WriteBytesSec is the write throughput limit in bytes per
+                second.
+              format: int64
+              type: integer
+            writeIOPSSec:
+              description: WriteIOPSSec is the write I/O operations per second limit.
+              format: int64
+              type: integer
+          type: object
+        gpus:
+          description: Optionally defines any GPU devices associated with the instancetype.
+          items:
+            properties:
+              claimName:
+                description: |-
+                  ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
+                  device is allocated
+                type: string
+              deviceName:
+                description: DeviceName is the name of the device provisioned by device-plugins
+                type: string
+              name:
+                description: Name of the GPU device as exposed by a device plugin
+                type: string
+              requestName:
+                description: |-
+                  RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
+                  device is requested
+                type: string
+              tag:
+                description: If specified, the virtual network interface address and
+                  its tag will be provided to the guest via config drive
+                type: string
+              virtualGPUOptions:
+                properties:
+                  display:
+                    properties:
+                      enabled:
+                        description: |-
+                          Enabled determines if a display addapter backed by a vGPU should be enabled or disabled on the guest.
+                          Defaults to true.
+                        type: boolean
+                      ramFB:
+                        description: |-
+                          Enables a boot framebuffer, until the guest OS loads a real GPU driver
+                          Defaults to true.
+                        properties:
+                          enabled:
+                            description: |-
+                              Enabled determines if the feature should be enabled or disabled on the guest.
+                              Defaults to true.
+                            type: boolean
+                        type: object
+                    type: object
+                type: object
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        hostDevices:
+          description: Optionally defines any HostDevices associated with the instancetype.
+          items:
+            properties:
+              claimName:
+                description: |-
+                  ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
+                  device is allocated
+                type: string
+              deviceName:
+                description: DeviceName is the name of the device provisioned by device-plugins
+                type: string
+              name:
+                type: string
+              requestName:
+                description: |-
+                  RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
+                  device is requested
+                type: string
+              tag:
+                description: If specified, the virtual network interface address and
+                  its tag will be provided to the guest via config drive
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        interfaceBandwidth:
+          description: Optionally defines the bandwidth limits applied to every bridge
+            and masquerade interface of the VirtualMachineInstance. It conflicts with
+            interfaces defining their own.
+          properties:
+            inbound:
+              description: Inbound limits the traffic received by the guest.
+              properties:
+                average:
+                  description: Average is the average rate in kibibytes per second.
+                  format: int64
+                  type: integer
+                burst:
+                  description: |-
+                    Burst is the amount of kibibytes that can be sent at the peak rate.
+                    Defaults to the amount sent in one second at the average rate.
+                  format: int64
+                  type: integer
+                peak:
+                  description: |-
+                    Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                    Must not be lower than the average.
+                  format: int64
+                  type: integer
+              required:
+              - average
+              type: object
+            outbound:
+              description: Outbound limits the traffic sent by the guest.
+              properties:
+                average:
+                  description: Average is the average rate in kibibytes per second.
+                  format: int64
+                  type: integer
+                burst:
+                  description: |-
+                    Burst is the amount of kibibytes that can be sent at the peak rate.
+                    Defaults to the amount sent in one second at the average rate.
+                  format: int64
+                  type: integer
+                peak:
+                  description: |-
+                    Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                    Must not be lower than the average.
+                  format: int64
+                  type: integer
+              required:
+              - average
+              type: object
+          type: object
+        ioThreads:
+          description: Optionally specifies the IOThreads options to be used by the
+            instancetype.
+          properties:
+            supplementalPoolThreadCount:
+              description: SupplementalPoolThreadCount specifies how many iothreads
+                are allocated for the supplementalPool policy.
+              format: int32
+              type: integer
+          type: object
+        ioThreadsPolicy:
+          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
+          type: string
+        launchSecurity:
+          description: Optionally defines the LaunchSecurity to be used by the instancetype.
+          properties:
+            sev:
+              description: AMD Secure Encrypted Virtualization (SEV).
+              properties:
+                attestation:
+                  description: If specified, run the attestation process for a vmi.
+                  type: object
+                dhCert:
+                  description: Base64 encoded guest owner's Diffie-Hellman key.
+                  type: string
+                policy:
+                  description: |-
+                    Guest policy flags as defined in AMD SEV API specification.
+                    Note: due to security reasons it is not allowed to enable guest debugging. Therefore NoDebug flag is not exposed to users and is always true.
+                  properties:
+                    encryptedState:
+                      description: |-
+                        SEV-ES is required.
+                        Defaults to false.
+                      type: boolean
+                  type: object
+                session:
+                  description: Base64 encoded session blob.
+                  type: string
+              type: object
+            snp:
+              description: AMD SEV-SNP flags defined by the SEV-SNP specifications.
+              type: object
+            tdx:
+              description: Intel Trust Domain Extensions (TDX).
+              type: object
+          type: object
+        memory:
+          description: Required Memory related attributes of the instancetype.
+          properties:
+            guest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Required amount of memory which is visible inside the guest
+                OS.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            hugepages:
+              description: Optionally enables the use of hugepages for the VirtualMachineInstance
+                instead of regular memory.
+              properties:
+                pageSize:
+                  description: PageSize specifies the hugepage size, for x86_64 architecture
+                    valid values are 1Gi and 2Mi.
+                  type: string
+              type: object
+            maxGuest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
+                The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            overcommitPercent:
+              description: |-
+                OvercommitPercent is the percentage of the guest memory which will be overcommitted.
+                This means that the VMIs parent pod (virt-launcher) will request less
+                physical memory by a factor specified by the OvercommitPercent.
+                Overcommits can lead to memory exhaustion, which in turn can lead to crashes. Use carefully.
+                Defaults to 0
+              maximum: 100
+              minimum: 0
+              type: integer
+          required:
+          - guest
+          type: object
+        nodeSelector:
+          additionalProperties:
+            type: string
+          description: |-
+            NodeSelector is a selector which must be true for the vmi to fit on a node.
+            Selector which must match a node's labels for the vmi to be scheduled on that node.
+            More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+
+            NodeSelector is the name of the custom node selector for the instancetype.
+          type: object
+        schedulerName:
+          description: |-
+            If specified, the VMI will be dispatched by specified scheduler.
+            If not specified, the VMI will be dispatched by default scheduler.
+
+            SchedulerName is the name of the custom K8s scheduler for the instancetype.
+          type: string
+      required:
+      - cpu
+      - memory
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclusterpreference": `openAPIV3Schema:
+  description: VirtualMachineClusterPreference is a cluster scoped version of the
+    VirtualMachinePreference resource.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the preferences
+      properties:
+        annotations:
+          additionalProperties:
+            type: string
+          description: Optionally defines preferred Annotations to be applied to the
+            VirtualMachineInstance
+          type: object
+        clock:
+          description: Clock optionally defines preferences associated with the Clock
+            attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredClockOffset:
+              description: ClockOffset allows specifying the UTC offset or the timezone
+                of the guest clock.
+              properties:
+                timezone:
+                  description: |-
+                    Timezone sets the guest clock to the specified timezone.
+                    Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                  type: string
+                utc:
+                  description: |-
+                    UTC sets the guest clock to UTC on each boot. If an offset is specified,
+                    guest changes to the clock will be kept during reboots and are not reset.
+                  properties:
+                    offsetSeconds:
+                      description: |-
+                        OffsetSeconds specifies an offset in seconds, relative to UTC. If set,
+                        guest changes to the clock will be kept during reboots and not reset.
+                      type: integer
+                  type: object
+              type: object
+            preferredTimer:
+              description: Timer specifies whih timers are attached to the vmi.
+              properties:
+                hpet:
+                  description: HPET (High Precision Event Timer) - multiple timers
+                    with periodic interrupts.
+                  properties:
+                    present:
+                      description: |-
+                        Enabled set to false makes sure that the machine type or a preset can't add the timer.
+                        Defaults to true.
+                      type: boolean
+                    tickPolicy:
+                      description: |-
+                        TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest.
+                        One of "delay", "catchup", "merge", "discard".
+                      type: string
                   type: object
                 hyperv:
                   description: Hyperv (Hypervclock) - lets guests read the host’s
@@ -10079,6 +11790,55 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredInputType optionally defines the preferred type
                 for Input devices.
               type: string
+            preferredInterfaceBandwidth:
+              description: PreferredInterfaceBandwidth optionally defines the preferred
+                bandwidth limits of each bridge and masquerade interface.
+              properties:
+                inbound:
+                  description: Inbound limits the traffic received by the guest.
+                  properties:
+                    average:
+                      description: Average is the average rate in kibibytes per second.
+                      format: int64
+                      type: integer
+                    burst:
+                      description: |-
+                        Burst is the amount of kibibytes that can be sent at the peak rate.
+                        Defaults to the amount sent in one second at the average rate.
+                      format: int64
+                      type: integer
+                    peak:
+                      description: |-
+                        Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                        Must not be lower than the average.
+                      format: int64
+                      type: integer
+                  required:
+                  - average
+                  type: object
+                outbound:
+                  description: Outbound limits the traffic sent by the guest.
+                  properties:
+                    average:
+                      description: Average is the average rate in kibibytes per second.
+                      format: int64
+                      type: integer
+                    burst:
+                      description: |-
+                        Burst is the amount of kibibytes that can be sent at the peak rate.
+                        Defaults to the amount sent in one second at the average rate.
+                      format: int64
+                      type: integer
+                    peak:
+                      description: |-
+                        Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                        Must not be lower than the average.
+                      format: int64
+                      type: integer
+                  required:
+                  - average
+                  type: object
+              type: object
             preferredInterfaceMasquerade:
               description: PreferredInterfaceMasquerade optionally defines the preferred
                 masquerade configuration to use with each network interface.
@@ -10547,8 +12307,8 @@ var CRDsValidation map[string]string = map[string]string{
       properties:
         source:
           description: |-
-            TypedLocalObjectReference contains enough information to let you locate the
-            typed referenced object inside the same namespace.
+            Source is the PersistentVolumeClaim, VirtualMachine, VirtualMachineSnapshot
+            or push mode VirtualMachineBackup to export
           properties:
             apiGroup:
               description: |-
@@ -12130,6 +13890,12 @@ var CRDsValidation map[string]string = map[string]string{
                     to the vmi.
                   items:
                     properties:
+                      autoGrowFilesystem:
+                        description: |-
+                          AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent
+                          after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.
+                          Defaults to false.
+                        type: boolean
                       blockSize:
                         description: If specified, the virtual disk will be presented
                           with the given block sizes.
@@ -12230,6 +13996,84 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune throttles the I/O of the disk.
+                          Requires the DiskIOTune feature gate. Changes are applied to a running VMI
+                          when the VM uses the LiveUpdate rollout strategy.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed the limits
+                              for a short period of time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is the maximum duration of a burst.
+                                  Defaults to one second.
+                                format: int64
+                                type: integer
+                              readBytesSec:
+                                description: ReadBytesSec is the read burst throughput
+                                  in bytes per second.
+                                format: int64
+                                type: integer
+                              readIOPSSec:
+                                description: ReadIOPSSec is the read burst I/O operations
+                                  per second.
+                                format: int64
+                                type: integer
+                              totalBytesSec:
+                                description: TotalBytesSec is the total burst throughput
+                                  in bytes per second.
+                                format: int64
+                                type: integer
+                              totalIOPSSec:
+                                description: TotalIOPSSec is the total burst I/O operations
+                                  per second.
+                                format: int64
+                                type: integer
+                              writeBytesSec:
+                                description: WriteBytesSec is the write burst throughput
+                                  in bytes per second.
+                                format: int64
+                                type: integer
+                              writeIOPSSec:
+                                description: WriteIOPSSec is the write burst I/O operations
+                                  per second.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesSec:
+                            description: ReadBytesSec is the read throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec is the read I/O operations per
+                              second limit.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec is the total throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec is the total I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec is the write throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec is the write I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -12404,6 +14248,61 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic of the interface.
+                          The limits are enforced with traffic control on the tap device in the network namespace
+                          of the virt-launcher pod and can be updated while the VMI is running.
+                          Requires the InterfaceBandwidth feature gate. Supported by the bridge and masquerade bindings.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                description: Average is the average rate in kibibytes
+                                  per second.
+                                format: int64
+                                type: integer
+                              burst:
+                                description: |-
+                                  Burst is the amount of kibibytes that can be sent at the peak rate.
+                                  Defaults to the amount sent in one second at the average rate.
+                                format: int64
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                  Must not be lower than the average.
+                                format: int64
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                description: Average is the average rate in kibibytes
+                                  per second.
+                                format: int64
+                                type: integer
+                              burst:
+                                description: |-
+                                  Burst is the amount of kibibytes that can be sent at the peak rate.
+                                  Defaults to the amount sent in one second at the average rate.
+                                format: int64
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                  Must not be lower than the average.
+                                format: int64
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -12428,6 +14327,30 @@ var CRDsValidation map[string]string = map[string]string{
                       bridge:
                         description: InterfaceBridge connects to a given network via
                           a linux bridge.
+                        properties:
+                          autoConfig:
+                            description: |-
+                              AutoConfig enables the IPv6 auto-configuration of the guest interface.
+                              The guest is configured with the IPv6 address the network IPAM assigned to the pod interface,
+                              as reported by Multus.
+                            properties:
+                              delegatedPrefix:
+                                description: |-
+                                  DelegatedPrefix is an IPv6 prefix (in CIDR notation) delegated to the guest
+                                  through DHCPv6 prefix delegation. Requires the DHCPv6 mode.
+                                type: string
+                              mode:
+                                description: |-
+                                  Mode of the auto-configuration, one of SLAAC or DHCPv6.
+                                  Defaults to DHCPv6.
+                                type: string
+                            type: object
+                          migrationMode:
+                            description: |-
+                              MigrationMode defines how the guest addressing is handled when the VMI is live migrated.
+                              When set to PreserveIP on the pod network, the guest keeps the IPv4 address and gateway it received
+                              before the migration and the target virt-launcher pod translates them to its own.
+                            type: string
                         type: object
                       dhcpOptions:
                         description: If specified the network interface will pass
@@ -12437,6 +14360,41 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          extraOptions:
+                            description: |-
+                              ExtraOptions passes arbitrary options, identified by their numeric code,
+                              to the interface's DHCP and DHCPv6 servers.
+                              An extra option replaces the option the server provides with the same code.
+                            items:
+                              description: DHCPExtraOption defines a DHCP option by
+                                its numeric code and typed value.
+                              properties:
+                                code:
+                                  description: |-
+                                    Code of the option, from 1 to 254 for DHCP and from 1 to 65535 for DHCPv6.
+                                    Options which are managed by the server, such as the server identifier, are not allowed.
+                                  format: int32
+                                  type: integer
+                                family:
+                                  description: |-
+                                    Family of the server which passes the option, IPv4 for DHCP and IPv6 for DHCPv6.
+                                    Defaults to IPv4.
+                                  type: string
+                                type:
+                                  description: |-
+                                    Type of the value, one of String, Uint8, Uint16, Uint32, IPAddresses or Hex.
+                                    Defaults to String.
+                                  type: string
+                                value:
+                                  description: Value of the option, encoded according
+                                    to its type.
+                                  type: string
+                              required:
+                              - code
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -12470,6 +14428,102 @@ var CRDsValidation map[string]string = map[string]string{
                               DHCP server
                             type: string
                         type: object
+                      firewall:
+                        description: |-
+                          Firewall defines the rules filtering the traffic of the interface.
+                          The rules are enforced in the network namespace of the virt-launcher pod
+                          and can be updated while the VMI is running.
+                          Supported by the bridge, masquerade and passt bindings.
+                        properties:
+                          egress:
+                            description: Egress rules filter the traffic sent by the
+                              guest.
+                            items:
+                              properties:
+                                action:
+                                  description: |-
+                                    Action applied to the matching traffic.
+                                    Defaults to Allow.
+                                  type: string
+                                cidrBlocks:
+                                  description: |-
+                                    CIDRBlocks of the remote peers of the matching traffic.
+                                    All the peers are matched when empty.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                name:
+                                  description: |-
+                                    Name of the rule, unique per interface and direction.
+                                    It identifies the rule counters reported in the VMI status.
+                                  type: string
+                                ports:
+                                  description: |-
+                                    Ports of the matching traffic: the guest ports for ingress rules and the
+                                    remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                    All the ports are matched when empty.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                protocol:
+                                  description: |-
+                                    Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                    ICMP matches both ICMP and ICMPv6.
+                                    All the protocols are matched when empty.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          ingress:
+                            description: Ingress rules filter the traffic received
+                              by the guest.
+                            items:
+                              properties:
+                                action:
+                                  description: |-
+                                    Action applied to the matching traffic.
+                                    Defaults to Allow.
+                                  type: string
+                                cidrBlocks:
+                                  description: |-
+                                    CIDRBlocks of the remote peers of the matching traffic.
+                                    All the peers are matched when empty.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                name:
+                                  description: |-
+                                    Name of the rule, unique per interface and direction.
+                                    It identifies the rule counters reported in the VMI status.
+                                  type: string
+                                ports:
+                                  description: |-
+                                    Ports of the matching traffic: the guest ports for ingress rules and the
+                                    remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                    All the ports are matched when empty.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                protocol:
+                                  description: |-
+                                    Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                    ICMP matches both ICMP and ICMPv6.
+                                    All the protocols are matched when empty.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -14007,6 +16061,35 @@ var CRDsValidation map[string]string = map[string]string{
                   path:
                     description: Path defines the path to disk file in the container
                     type: string
+                  persistent:
+                    description: |-
+                      Persistent keeps the writes to the disk across restarts of the VM in a qcow2 overlay
+                      on top of the image, stored on a PVC which is created along with the VM and deleted with it.
+                      The image must not change during the lifetime of the overlay, referencing it by digest is recommended.
+                    properties:
+                      accessModes:
+                        description: |-
+                          AccessModes of the overlay PVC, defaults to ReadWriteOnce.
+                          ReadWriteMany is required to live migrate the VM.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      capacity:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Capacity of the overlay PVC, it bounds the amount
+                          of data written to the disk
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      storageClassName:
+                        description: StorageClassName of the overlay PVC, the default
+                          storage class is used when empty
+                        type: string
+                    required:
+                    - capacity
+                    type: object
                 required:
                 - image
                 type: object
@@ -14210,6 +16293,12 @@ var CRDsValidation map[string]string = map[string]string{
                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                     type: string
+                  format:
+                    description: |-
+                      Format represents the format of the memory dump, defaults to Raw.
+                      A non hotpluggable volume with the SavedState format is used to
+                      start the VMI from the saved state instead of booting it.
+                    type: string
                   hotpluggable:
                     description: Hotpluggable indicates whether the volume can be
                       hotplugged and hotunplugged.
@@ -14382,6 +16471,35 @@ var CRDsValidation map[string]string = map[string]string{
                     description: BackupVolumeInfo contains information about a volume
                       included in a backup
                     properties:
+                      claim:
+                        description: Claim describes the PVC which backed the volume
+                          at backup time
+                        properties:
+                          accessModes:
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          capacity:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Capacity is the capacity of the PVC
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          claimName:
+                            description: ClaimName is the name of the PVC
+                            type: string
+                          storageClassName:
+                            type: string
+                          volumeMode:
+                            description: PersistentVolumeMode describes how a volume
+                              is intended to be consumed, either Block or Filesystem.
+                            type: string
+                        required:
+                        - capacity
+                        - claimName
+                        type: object
                       diskTarget:
                         description: DiskTarget is the disk target device name at
                           backup time
@@ -14586,6 +16704,34 @@ var CRDsValidation map[string]string = map[string]string{
           description: Interfaces represent the details of available network interfaces.
           items:
             properties:
+              firewallRules:
+                description: FirewallRules reports the counters of the firewall rules
+                  enforced on the interface.
+                items:
+                  properties:
+                    bytes:
+                      description: Bytes is the number of bytes matched by the rule
+                      format: int64
+                      type: integer
+                    direction:
+                      description: Direction of the traffic filtered by the rule
+                      type: string
+                    name:
+                      description: Name of the firewall rule
+                      type: string
+                    packets:
+                      description: Packets is the number of packets matched by the
+                        rule
+                      format: int64
+                      type: integer
+                  required:
+                  - bytes
+                  - direction
+                  - name
+                  - packets
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
               infoSource:
                 description: 'Specifies the origin of the interface data collected.
                   values: domain, guest-agent, multus-status.'
@@ -14828,6 +16974,68 @@ var CRDsValidation map[string]string = map[string]string{
             failureReason:
               description: Contains the reason why the migration failed
               type: string
+            migratedInterfaces:
+              description: |-
+                MigratedInterfaces reports the preservation of the guest address of every interface
+                that keeps its IP across the migration
+              items:
+                description: MigratedInterfaceState reports the preservation of the
+                  guest address of an interface being migrated
+                properties:
+                  guestGateway:
+                    description: GuestGateway is the gateway address the guest keeps
+                      across the migration
+                    type: string
+                  guestIP:
+                    description: GuestIP is the address, in CIDR notation, the guest
+                      keeps across the migration
+                    type: string
+                  message:
+                    description: Message is a human readable detail of the phase
+                    type: string
+                  name:
+                    description: Name is the name of the interface
+                    type: string
+                  phase:
+                    description: Phase of the guest address preservation
+                    type: string
+                  targetIP:
+                    description: TargetIP is the address of the target pod the guest
+                      address is translated to
+                    type: string
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            migratedVolumesProgress:
+              description: MigratedVolumesProgress reports how much of every migrated
+                volume has been copied to the target
+              items:
+                description: StorageMigratedVolumeProgress reports the copy progress
+                  of a volume being migrated
+                properties:
+                  bytesCopied:
+                    description: BytesCopied is the amount of data already copied
+                      to the destination volume
+                    format: int64
+                    type: integer
+                  totalBytes:
+                    description: TotalBytes is the amount of data to copy to the destination
+                      volume
+                    format: int64
+                    type: integer
+                  volumeName:
+                    description: VolumeName is the name of the volume that is being
+                      migrated
+                    type: string
+                required:
+                - bytesCopied
+                - totalBytes
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
@@ -15185,6 +17393,10 @@ var CRDsValidation map[string]string = map[string]string{
                       artifacts inside the containerdisk
                     format: int32
                     type: integer
+                  imageID:
+                    description: ImageID is the image reference with the digest of
+                      the containerdisk, as reported by the container runtime
+                    type: string
                 type: object
               hotplugVolume:
                 description: If the volume is hotplug, this will contain the hotplug
@@ -15199,6 +17411,82 @@ var CRDsValidation map[string]string = map[string]string{
                       the volume to the node.
                     type: string
                 type: object
+              ioTune:
+                description: IOTune shows the I/O limits currently applied to the
+                  disk of the volume
+                properties:
+                  burst:
+                    description: Burst allows the disk to exceed the limits for a
+                      short period of time.
+                    properties:
+                      lengthSeconds:
+                        description: |-
+                          LengthSeconds is the maximum duration of a burst.
+                          Defaults to one second.
+                        format: int64
+                        type: integer
+                      readBytesSec:
+                        description: ReadBytesSec is the read burst throughput in
+                          bytes per second.
+                        format: int64
+                        type: integer
+                      readIOPSSec:
+                        description: ReadIOPSSec is the read burst I/O operations
+                          per second.
+                        format: int64
+                        type: integer
+                      totalBytesSec:
+                        description: TotalBytesSec is the total burst throughput in
+                          bytes per second.
+                        format: int64
+                        type: integer
+                      totalIOPSSec:
+                        description: TotalIOPSSec is the total burst I/O operations
+                          per second.
+                        format: int64
+                        type: integer
+                      writeBytesSec:
+                        description: WriteBytesSec is the write burst throughput in
+                          bytes per second.
+                        format: int64
+                        type: integer
+                      writeIOPSSec:
+                        description: WriteIOPSSec is the write burst I/O operations
+                          per second.
+                        format: int64
+                        type: integer
+                    type: object
+                  readBytesSec:
+                    description: ReadBytesSec is the read throughput limit in bytes
+                      per second.
+                    format: int64
+                    type: integer
+                  readIOPSSec:
+                    description: ReadIOPSSec is the read I/O operations per second
+                      limit.
+                    format: int64
+                    type: integer
+                  totalBytesSec:
+                    description: TotalBytesSec is the total throughput limit in bytes
+                      per second.
+                    format: int64
+                    type: integer
+                  totalIOPSSec:
+                    description: TotalIOPSSec is the total I/O operations per second
+                      limit.
+                    format: int64
+                    type: integer
+                  writeBytesSec:
+                    description: WriteBytesSec is the write throughput limit in bytes
+                      per second.
+                    format: int64
+                    type: integer
+                  writeIOPSSec:
+                    description: WriteIOPSSec is the write I/O operations per second
+                      limit.
+                    format: int64
+                    type: integer
+                type: object
               memoryDumpVolume:
                 description: If the volume is memorydump volume, this will contain
                   the memorydump info.
@@ -15421,6 +17709,68 @@ var CRDsValidation map[string]string = map[string]string{
             failureReason:
               description: Contains the reason why the migration failed
               type: string
+            migratedInterfaces:
+              description: |-
+                MigratedInterfaces reports the preservation of the guest address of every interface
+                that keeps its IP across the migration
+              items:
+                description: MigratedInterfaceState reports the preservation of the
+                  guest address of an interface being migrated
+                properties:
+                  guestGateway:
+                    description: GuestGateway is the gateway address the guest keeps
+                      across the migration
+                    type: string
+                  guestIP:
+                    description: GuestIP is the address, in CIDR notation, the guest
+                      keeps across the migration
+                    type: string
+                  message:
+                    description: Message is a human readable detail of the phase
+                    type: string
+                  name:
+                    description: Name is the name of the interface
+                    type: string
+                  phase:
+                    description: Phase of the guest address preservation
+                    type: string
+                  targetIP:
+                    description: TargetIP is the address of the target pod the guest
+                      address is translated to
+                    type: string
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            migratedVolumesProgress:
+              description: MigratedVolumesProgress reports how much of every migrated
+                volume has been copied to the target
+              items:
+                description: StorageMigratedVolumeProgress reports the copy progress
+                  of a volume being migrated
+                properties:
+                  bytesCopied:
+                    description: BytesCopied is the amount of data already copied
+                      to the destination volume
+                    format: int64
+                    type: integer
+                  totalBytes:
+                    description: TotalBytes is the amount of data to copy to the destination
+                      volume
+                    format: int64
+                    type: integer
+                  volumeName:
+                    description: VolumeName is the name of the volume that is being
+                      migrated
+                    type: string
+                required:
+                - bytesCopied
+                - totalBytes
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
@@ -16016,6 +18366,12 @@ var CRDsValidation map[string]string = map[string]string{
                     to the vmi.
                   items:
                     properties:
+                      autoGrowFilesystem:
+                        description: |-
+                          AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent
+                          after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.
+                          Defaults to false.
+                        type: boolean
                       blockSize:
                         description: If specified, the virtual disk will be presented
                           with the given block sizes.
@@ -16116,6 +18472,84 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune throttles the I/O of the disk.
+                          Requires the DiskIOTune feature gate. Changes are applied to a running VMI
+                          when the VM uses the LiveUpdate rollout strategy.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed the limits
+                              for a short period of time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is the maximum duration of a burst.
+                                  Defaults to one second.
+                                format: int64
+                                type: integer
+                              readBytesSec:
+                                description: ReadBytesSec is the read burst throughput
+                                  in bytes per second.
+                                format: int64
+                                type: integer
+                              readIOPSSec:
+                                description: ReadIOPSSec is the read burst I/O operations
+                                  per second.
+                                format: int64
+                                type: integer
+                              totalBytesSec:
+                                description: TotalBytesSec is the total burst throughput
+                                  in bytes per second.
+                                format: int64
+                                type: integer
+                              totalIOPSSec:
+                                description: TotalIOPSSec is the total burst I/O operations
+                                  per second.
+                                format: int64
+                                type: integer
+                              writeBytesSec:
+                                description: WriteBytesSec is the write burst throughput
+                                  in bytes per second.
+                                format: int64
+                                type: integer
+                              writeIOPSSec:
+                                description: WriteIOPSSec is the write burst I/O operations
+                                  per second.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesSec:
+                            description: ReadBytesSec is the read throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec is the read I/O operations per
+                              second limit.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec is the total throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec is the total I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec is the write throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec is the write I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -16290,6 +18724,61 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic of the interface.
+                          The limits are enforced with traffic control on the tap device in the network namespace
+                          of the virt-launcher pod and can be updated while the VMI is running.
+                          Requires the InterfaceBandwidth feature gate. Supported by the bridge and masquerade bindings.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                description: Average is the average rate in kibibytes
+                                  per second.
+                                format: int64
+                                type: integer
+                              burst:
+                                description: |-
+                                  Burst is the amount of kibibytes that can be sent at the peak rate.
+                                  Defaults to the amount sent in one second at the average rate.
+                                format: int64
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                  Must not be lower than the average.
+                                format: int64
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                description: Average is the average rate in kibibytes
+                                  per second.
+                                format: int64
+                                type: integer
+                              burst:
+                                description: |-
+                                  Burst is the amount of kibibytes that can be sent at the peak rate.
+                                  Defaults to the amount sent in one second at the average rate.
+                                format: int64
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                  Must not be lower than the average.
+                                format: int64
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -16314,6 +18803,30 @@ var CRDsValidation map[string]string = map[string]string{
                       bridge:
                         description: InterfaceBridge connects to a given network via
                           a linux bridge.
+                        properties:
+                          autoConfig:
+                            description: |-
+                              AutoConfig enables the IPv6 auto-configuration of the guest interface.
+                              The guest is configured with the IPv6 address the network IPAM assigned to the pod interface,
+                              as reported by Multus.
+                            properties:
+                              delegatedPrefix:
+                                description: |-
+                                  DelegatedPrefix is an IPv6 prefix (in CIDR notation) delegated to the guest
+                                  through DHCPv6 prefix delegation. Requires the DHCPv6 mode.
+                                type: string
+                              mode:
+                                description: |-
+                                  Mode of the auto-configuration, one of SLAAC or DHCPv6.
+                                  Defaults to DHCPv6.
+                                type: string
+                            type: object
+                          migrationMode:
+                            description: |-
+                              MigrationMode defines how the guest addressing is handled when the VMI is live migrated.
+                              When set to PreserveIP on the pod network, the guest keeps the IPv4 address and gateway it received
+                              before the migration and the target virt-launcher pod translates them to its own.
+                            type: string
                         type: object
                       dhcpOptions:
                         description: If specified the network interface will pass
@@ -16323,6 +18836,41 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          extraOptions:
+                            description: |-
+                              ExtraOptions passes arbitrary options, identified by their numeric code,
+                              to the interface's DHCP and DHCPv6 servers.
+                              An extra option replaces the option the server provides with the same code.
+                            items:
+                              description: DHCPExtraOption defines a DHCP option by
+                                its numeric code and typed value.
+                              properties:
+                                code:
+                                  description: |-
+                                    Code of the option, from 1 to 254 for DHCP and from 1 to 65535 for DHCPv6.
+                                    Options which are managed by the server, such as the server identifier, are not allowed.
+                                  format: int32
+                                  type: integer
+                                family:
+                                  description: |-
+                                    Family of the server which passes the option, IPv4 for DHCP and IPv6 for DHCPv6.
+                                    Defaults to IPv4.
+                                  type: string
+                                type:
+                                  description: |-
+                                    Type of the value, one of String, Uint8, Uint16, Uint32, IPAddresses or Hex.
+                                    Defaults to String.
+                                  type: string
+                                value:
+                                  description: Value of the option, encoded according
+                                    to its type.
+                                  type: string
+                              required:
+                              - code
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -16356,6 +18904,102 @@ var CRDsValidation map[string]string = map[string]string{
                               DHCP server
                             type: string
                         type: object
+                      firewall:
+                        description: |-
+                          Firewall defines the rules filtering the traffic of the interface.
+                          The rules are enforced in the network namespace of the virt-launcher pod
+                          and can be updated while the VMI is running.
+                          Supported by the bridge, masquerade and passt bindings.
+                        properties:
+                          egress:
+                            description: Egress rules filter the traffic sent by the
+                              guest.
+                            items:
+                              properties:
+                                action:
+                                  description: |-
+                                    Action applied to the matching traffic.
+                                    Defaults to Allow.
+                                  type: string
+                                cidrBlocks:
+                                  description: |-
+                                    CIDRBlocks of the remote peers of the matching traffic.
+                                    All the peers are matched when empty.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                name:
+                                  description: |-
+                                    Name of the rule, unique per interface and direction.
+                                    It identifies the rule counters reported in the VMI status.
+                                  type: string
+                                ports:
+                                  description: |-
+                                    Ports of the matching traffic: the guest ports for ingress rules and the
+                                    remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                    All the ports are matched when empty.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                protocol:
+                                  description: |-
+                                    Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                    ICMP matches both ICMP and ICMPv6.
+                                    All the protocols are matched when empty.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          ingress:
+                            description: Ingress rules filter the traffic received
+                              by the guest.
+                            items:
+                              properties:
+                                action:
+                                  description: |-
+                                    Action applied to the matching traffic.
+                                    Defaults to Allow.
+                                  type: string
+                                cidrBlocks:
+                                  description: |-
+                                    CIDRBlocks of the remote peers of the matching traffic.
+                                    All the peers are matched when empty.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                name:
+                                  description: |-
+                                    Name of the rule, unique per interface and direction.
+                                    It identifies the rule counters reported in the VMI status.
+                                  type: string
+                                ports:
+                                  description: |-
+                                    Ports of the matching traffic: the guest ports for ingress rules and the
+                                    remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                    All the ports are matched when empty.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                protocol:
+                                  description: |-
+                                    Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                    ICMP matches both ICMP and ICMPv6.
+                                    All the protocols are matched when empty.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -18540,6 +21184,12 @@ var CRDsValidation map[string]string = map[string]string{
                             are connected to the vmi.
                           items:
                             properties:
+                              autoGrowFilesystem:
+                                description: |-
+                                  AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent
+                                  after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.
+                                  Defaults to false.
+                                type: boolean
                               blockSize:
                                 description: If specified, the virtual disk will be
                                   presented with the given block sizes.
@@ -18641,6 +21291,84 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune throttles the I/O of the disk.
+                                  Requires the DiskIOTune feature gate. Changes are applied to a running VMI
+                                  when the VM uses the LiveUpdate rollout strategy.
+                                properties:
+                                  burst:
+                                    description: Burst allows the disk to exceed the
+                                      limits for a short period of time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: |-
+                                          LengthSeconds is the maximum duration of a burst.
+                                          Defaults to one second.
+                                        format: int64
+                                        type: integer
+                                      readBytesSec:
+                                        description: ReadBytesSec is the read burst
+                                          throughput in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec is the read burst
+                                          I/O operations per second.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec is the total burst
+                                          throughput in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec is the total burst
+                                          I/O operations per second.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec is the write burst
+                                          throughput in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec is the write burst
+                                          I/O operations per second.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBytesSec:
+                                    description: ReadBytesSec is the read throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec is the read I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec is the total throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec is the total I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec is the write throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec is the write I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -18818,6 +21546,62 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic of the interface.
+                                  The limits are enforced with traffic control on the tap device in the network namespace
+                                  of the virt-launcher pod and can be updated while the VMI is running.
+                                  Requires the InterfaceBandwidth feature gate. Supported by the bridge and masquerade bindings.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average rate in
+                                          kibibytes per second.
+                                        format: int64
+                                        type: integer
+                                      burst:
+                                        description: |-
+                                          Burst is the amount of kibibytes that can be sent at the peak rate.
+                                          Defaults to the amount sent in one second at the average rate.
+                                        format: int64
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                          Must not be lower than the average.
+                                        format: int64
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average rate in
+                                          kibibytes per second.
+                                        format: int64
+                                        type: integer
+                                      burst:
+                                        description: |-
+                                          Burst is the amount of kibibytes that can be sent at the peak rate.
+                                          Defaults to the amount sent in one second at the average rate.
+                                        format: int64
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                          Must not be lower than the average.
+                                        format: int64
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -18842,6 +21626,30 @@ var CRDsValidation map[string]string = map[string]string{
                               bridge:
                                 description: InterfaceBridge connects to a given network
                                   via a linux bridge.
+                                properties:
+                                  autoConfig:
+                                    description: |-
+                                      AutoConfig enables the IPv6 auto-configuration of the guest interface.
+                                      The guest is configured with the IPv6 address the network IPAM assigned to the pod interface,
+                                      as reported by Multus.
+                                    properties:
+                                      delegatedPrefix:
+                                        description: |-
+                                          DelegatedPrefix is an IPv6 prefix (in CIDR notation) delegated to the guest
+                                          through DHCPv6 prefix delegation. Requires the DHCPv6 mode.
+                                        type: string
+                                      mode:
+                                        description: |-
+                                          Mode of the auto-configuration, one of SLAAC or DHCPv6.
+                                          Defaults to DHCPv6.
+                                        type: string
+                                    type: object
+                                  migrationMode:
+                                    description: |-
+                                      MigrationMode defines how the guest addressing is handled when the VMI is live migrated.
+                                      When set to PreserveIP on the pod network, the guest keeps the IPv4 address and gateway it received
+                                      before the migration and the target virt-launcher pod translates them to its own.
+                                    type: string
                                 type: object
                               dhcpOptions:
                                 description: If specified the network interface will
@@ -18851,6 +21659,41 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
+                                  extraOptions:
+                                    description: |-
+                                      ExtraOptions passes arbitrary options, identified by their numeric code,
+                                      to the interface's DHCP and DHCPv6 servers.
+                                      An extra option replaces the option the server provides with the same code.
+                                    items:
+                                      description: DHCPExtraOption defines a DHCP
+                                        option by its numeric code and typed value.
+                                      properties:
+                                        code:
+                                          description: |-
+                                            Code of the option, from 1 to 254 for DHCP and from 1 to 65535 for DHCPv6.
+                                            Options which are managed by the server, such as the server identifier, are not allowed.
+                                          format: int32
+                                          type: integer
+                                        family:
+                                          description: |-
+                                            Family of the server which passes the option, IPv4 for DHCP and IPv6 for DHCPv6.
+                                            Defaults to IPv4.
+                                          type: string
+                                        type:
+                                          description: |-
+                                            Type of the value, one of String, Uint8, Uint16, Uint32, IPAddresses or Hex.
+                                            Defaults to String.
+                                          type: string
+                                        value:
+                                          description: Value of the option, encoded
+                                            according to its type.
+                                          type: string
+                                      required:
+                                      - code
+                                      - value
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
@@ -18884,6 +21727,102 @@ var CRDsValidation map[string]string = map[string]string{
                                       to interface's DHCP server
                                     type: string
                                 type: object
+                              firewall:
+                                description: |-
+                                  Firewall defines the rules filtering the traffic of the interface.
+                                  The rules are enforced in the network namespace of the virt-launcher pod
+                                  and can be updated while the VMI is running.
+                                  Supported by the bridge, masquerade and passt bindings.
+                                properties:
+                                  egress:
+                                    description: Egress rules filter the traffic sent
+                                      by the guest.
+                                    items:
+                                      properties:
+                                        action:
+                                          description: |-
+                                            Action applied to the matching traffic.
+                                            Defaults to Allow.
+                                          type: string
+                                        cidrBlocks:
+                                          description: |-
+                                            CIDRBlocks of the remote peers of the matching traffic.
+                                            All the peers are matched when empty.
+                                          items:
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        name:
+                                          description: |-
+                                            Name of the rule, unique per interface and direction.
+                                            It identifies the rule counters reported in the VMI status.
+                                          type: string
+                                        ports:
+                                          description: |-
+                                            Ports of the matching traffic: the guest ports for ingress rules and the
+                                            remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                            All the ports are matched when empty.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        protocol:
+                                          description: |-
+                                            Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                            ICMP matches both ICMP and ICMPv6.
+                                            All the protocols are matched when empty.
+                                          type: string
+                                      required:
+                                      - name
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  ingress:
+                                    description: Ingress rules filter the traffic
+                                      received by the guest.
+                                    items:
+                                      properties:
+                                        action:
+                                          description: |-
+                                            Action applied to the matching traffic.
+                                            Defaults to Allow.
+                                          type: string
+                                        cidrBlocks:
+                                          description: |-
+                                            CIDRBlocks of the remote peers of the matching traffic.
+                                            All the peers are matched when empty.
+                                          items:
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        name:
+                                          description: |-
+                                            Name of the rule, unique per interface and direction.
+                                            It identifies the rule counters reported in the VMI status.
+                                          type: string
+                                        ports:
+                                          description: |-
+                                            Ports of the matching traffic: the guest ports for ingress rules and the
+                                            remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                            All the ports are matched when empty.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        protocol:
+                                          description: |-
+                                            Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                            ICMP matches both ICMP and ICMPv6.
+                                            All the protocols are matched when empty.
+                                          type: string
+                                      required:
+                                      - name
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                type: object
                               macAddress:
                                 description: 'Interface MAC address. For example:
                                   de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -20438,6 +23377,35 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Path defines the path to disk file in the
                               container
                             type: string
+                          persistent:
+                            description: |-
+                              Persistent keeps the writes to the disk across restarts of the VM in a qcow2 overlay
+                              on top of the image, stored on a PVC which is created along with the VM and deleted with it.
+                              The image must not change during the lifetime of the overlay, referencing it by digest is recommended.
+                            properties:
+                              accessModes:
+                                description: |-
+                                  AccessModes of the overlay PVC, defaults to ReadWriteOnce.
+                                  ReadWriteMany is required to live migrate the VM.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              capacity:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Capacity of the overlay PVC, it bounds
+                                  the amount of data written to the disk
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              storageClassName:
+                                description: StorageClassName of the overlay PVC,
+                                  the default storage class is used when empty
+                                type: string
+                            required:
+                            - capacity
+                            type: object
                         required:
                         - image
                         type: object
@@ -20647,6 +23615,12 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: |-
+                              Format represents the format of the memory dump, defaults to Raw.
+                              A non hotpluggable volume with the SavedState format is used to
+                              start the VMI from the saved state instead of booting it.
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -20903,6 +23877,79 @@ var CRDsValidation map[string]string = map[string]string{
           required:
           - guest
           type: object
+        diskIOTune:
+          description: Optionally defines the I/O limits applied to every disk and
+            LUN of the VirtualMachineInstance. It conflicts with disks defining their
+            own.
+          properties:
+            burst:
+              description: Burst allows the disk to exceed the limits for a short
+                period of time.
+              properties:
+                lengthSeconds:
+                  description: |-
+                    LengthSeconds is the maximum duration of a burst.
+                    Defaults to one second.
+                  format: int64
+                  type: integer
+                readBytesSec:
+                  description: ReadBytesSec is the read burst throughput in bytes
+                    per second.
+                  format: int64
+                  type: integer
+                readIOPSSec:
+                  description: ReadIOPSSec is the read burst I/O operations per second.
+                  format: int64
+                  type: integer
+                totalBytesSec:
+                  description: TotalBytesSec is the total burst throughput in bytes
+                    per second.
+                  format: int64
+                  type: integer
+                totalIOPSSec:
+                  description: TotalIOPSSec is the total burst I/O operations per
+                    second.
+                  format: int64
+                  type: integer
+                writeBytesSec:
+                  description: WriteBytesSec is the write burst throughput in bytes
+                    per second.
+                  format: int64
+                  type: integer
+                writeIOPSSec:
+                  description: WriteIOPSSec is the write burst I/O operations per
+                    second.
+                  format: int64
+                  type: integer
+              type: object
+            readBytesSec:
+              description: ReadBytesSec is the read throughput limit in bytes per
+                second.
+              format: int64
+              type: integer
+            readIOPSSec:
+              description: ReadIOPSSec is the read I/O operations per second limit.
+              format: int64
+              type: integer
+            totalBytesSec:
+              description: TotalBytesSec is the total throughput limit in bytes per
+                second.
+              format: int64
+              type: integer
+            totalIOPSSec:
+              description: TotalIOPSSec is the total I/O operations per second limit.
+              format: int64
+              type: integer
+            writeBytesSec:
+              description: WriteBytesSec is the write throughput limit in bytes per
+                second.
+              format: int64
+              type: integer
+            writeIOPSSec:
+              description: WriteIOPSSec is the write I/O operations per second limit.
+              format: int64
+              type: integer
+          type: object
         gpus:
           description: Optionally defines any GPU devices associated with the instancetype.
           items:
@@ -20982,6 +24029,56 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        interfaceBandwidth:
+          description: Optionally defines the bandwidth limits applied to every bridge
+            and masquerade interface of the VirtualMachineInstance. It conflicts with
+            interfaces defining their own.
+          properties:
+            inbound:
+              description: Inbound limits the traffic received by the guest.
+              properties:
+                average:
+                  description: Average is the average rate in kibibytes per second.
+                  format: int64
+                  type: integer
+                burst:
+                  description: |-
+                    Burst is the amount of kibibytes that can be sent at the peak rate.
+                    Defaults to the amount sent in one second at the average rate.
+                  format: int64
+                  type: integer
+                peak:
+                  description: |-
+                    Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                    Must not be lower than the average.
+                  format: int64
+                  type: integer
+              required:
+              - average
+              type: object
+            outbound:
+              description: Outbound limits the traffic sent by the guest.
+              properties:
+                average:
+                  description: Average is the average rate in kibibytes per second.
+                  format: int64
+                  type: integer
+                burst:
+                  description: |-
+                    Burst is the amount of kibibytes that can be sent at the peak rate.
+                    Defaults to the amount sent in one second at the average rate.
+                  format: int64
+                  type: integer
+                peak:
+                  description: |-
+                    Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                    Must not be lower than the average.
+                  format: int64
+                  type: integer
+              required:
+              - average
+              type: object
+          type: object
         ioThreads:
           description: Optionally specifies the IOThreads options to be used by the
             instancetype.
@@ -23598,6 +26695,12 @@ var CRDsValidation map[string]string = map[string]string{
                                     which are connected to the vmi.
                                   items:
                                     properties:
+                                      autoGrowFilesystem:
+                                        description: |-
+                                          AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent
+                                          after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.
+                                          Defaults to false.
+                                        type: boolean
                                       blockSize:
                                         description: If specified, the virtual disk
                                           will be presented with the given block sizes.
@@ -23703,6 +26806,87 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO specifies which QEMU disk IO mode should be used.
                                           Supported values are: native, default, threads.
                                         type: string
+                                      ioTune:
+                                        description: |-
+                                          IOTune throttles the I/O of the disk.
+                                          Requires the DiskIOTune feature gate. Changes are applied to a running VMI
+                                          when the VM uses the LiveUpdate rollout strategy.
+                                        properties:
+                                          burst:
+                                            description: Burst allows the disk to
+                                              exceed the limits for a short period
+                                              of time.
+                                            properties:
+                                              lengthSeconds:
+                                                description: |-
+                                                  LengthSeconds is the maximum duration of a burst.
+                                                  Defaults to one second.
+                                                format: int64
+                                                type: integer
+                                              readBytesSec:
+                                                description: ReadBytesSec is the read
+                                                  burst throughput in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              readIOPSSec:
+                                                description: ReadIOPSSec is the read
+                                                  burst I/O operations per second.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                description: TotalBytesSec is the
+                                                  total burst throughput in bytes
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSec:
+                                                description: TotalIOPSSec is the total
+                                                  burst I/O operations per second.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                description: WriteBytesSec is the
+                                                  write burst throughput in bytes
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSec:
+                                                description: WriteIOPSSec is the write
+                                                  burst I/O operations per second.
+                                                format: int64
+                                                type: integer
+                                            type: object
+                                          readBytesSec:
+                                            description: ReadBytesSec is the read
+                                              throughput limit in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          readIOPSSec:
+                                            description: ReadIOPSSec is the read I/O
+                                              operations per second limit.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            description: TotalBytesSec is the total
+                                              throughput limit in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSec:
+                                            description: TotalIOPSSec is the total
+                                              I/O operations per second limit.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            description: WriteBytesSec is the write
+                                              throughput limit in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSec:
+                                            description: WriteIOPSSec is the write
+                                              I/O operations per second limit.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -23885,6 +27069,62 @@ var CRDsValidation map[string]string = map[string]string{
                                           in PCI addresses assigned to the device.
                                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: |-
+                                          Bandwidth limits the traffic of the interface.
+                                          The limits are enforced with traffic control on the tap device in the network namespace
+                                          of the virt-launcher pod and can be updated while the VMI is running.
+                                          Requires the InterfaceBandwidth feature gate. Supported by the bridge and masquerade bindings.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic
+                                              received by the guest.
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  rate in kibibytes per second.
+                                                format: int64
+                                                type: integer
+                                              burst:
+                                                description: |-
+                                                  Burst is the amount of kibibytes that can be sent at the peak rate.
+                                                  Defaults to the amount sent in one second at the average rate.
+                                                format: int64
+                                                type: integer
+                                              peak:
+                                                description: |-
+                                                  Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                                  Must not be lower than the average.
+                                                format: int64
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic
+                                              sent by the guest.
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  rate in kibibytes per second.
+                                                format: int64
+                                                type: integer
+                                              burst:
+                                                description: |-
+                                                  Burst is the amount of kibibytes that can be sent at the peak rate.
+                                                  Defaults to the amount sent in one second at the average rate.
+                                                format: int64
+                                                type: integer
+                                              peak:
+                                                description: |-
+                                                  Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                                  Must not be lower than the average.
+                                                format: int64
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
                                       binding:
                                         description: |-
                                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -23909,6 +27149,30 @@ var CRDsValidation map[string]string = map[string]string{
                                       bridge:
                                         description: InterfaceBridge connects to a
                                           given network via a linux bridge.
+                                        properties:
+                                          autoConfig:
+                                            description: |-
+                                              AutoConfig enables the IPv6 auto-configuration of the guest interface.
+                                              The guest is configured with the IPv6 address the network IPAM assigned to the pod interface,
+                                              as reported by Multus.
+                                            properties:
+                                              delegatedPrefix:
+                                                description: |-
+                                                  DelegatedPrefix is an IPv6 prefix (in CIDR notation) delegated to the guest
+                                                  through DHCPv6 prefix delegation. Requires the DHCPv6 mode.
+                                                type: string
+                                              mode:
+                                                description: |-
+                                                  Mode of the auto-configuration, one of SLAAC or DHCPv6.
+                                                  Defaults to DHCPv6.
+                                                type: string
+                                            type: object
+                                          migrationMode:
+                                            description: |-
+                                              MigrationMode defines how the guest addressing is handled when the VMI is live migrated.
+                                              When set to PreserveIP on the pod network, the guest keeps the IPv4 address and gateway it received
+                                              before the migration and the target virt-launcher pod translates them to its own.
+                                            type: string
                                         type: object
                                       dhcpOptions:
                                         description: If specified the network interface
@@ -23919,6 +27183,42 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: If specified will pass option
                                               67 to interface's DHCP server
                                             type: string
+                                          extraOptions:
+                                            description: |-
+                                              ExtraOptions passes arbitrary options, identified by their numeric code,
+                                              to the interface's DHCP and DHCPv6 servers.
+                                              An extra option replaces the option the server provides with the same code.
+                                            items:
+                                              description: DHCPExtraOption defines
+                                                a DHCP option by its numeric code
+                                                and typed value.
+                                              properties:
+                                                code:
+                                                  description: |-
+                                                    Code of the option, from 1 to 254 for DHCP and from 1 to 65535 for DHCPv6.
+                                                    Options which are managed by the server, such as the server identifier, are not allowed.
+                                                  format: int32
+                                                  type: integer
+                                                family:
+                                                  description: |-
+                                                    Family of the server which passes the option, IPv4 for DHCP and IPv6 for DHCPv6.
+                                                    Defaults to IPv4.
+                                                  type: string
+                                                type:
+                                                  description: |-
+                                                    Type of the value, one of String, Uint8, Uint16, Uint32, IPAddresses or Hex.
+                                                    Defaults to String.
+                                                  type: string
+                                                value:
+                                                  description: Value of the option,
+                                                    encoded according to its type.
+                                                  type: string
+                                              required:
+                                              - code
+                                              - value
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
                                           ntpServers:
                                             description: If specified will pass the
                                               configured NTP server to the VM via
@@ -23954,6 +27254,102 @@ var CRDsValidation map[string]string = map[string]string{
                                               66 to interface's DHCP server
                                             type: string
                                         type: object
+                                      firewall:
+                                        description: |-
+                                          Firewall defines the rules filtering the traffic of the interface.
+                                          The rules are enforced in the network namespace of the virt-launcher pod
+                                          and can be updated while the VMI is running.
+                                          Supported by the bridge, masquerade and passt bindings.
+                                        properties:
+                                          egress:
+                                            description: Egress rules filter the traffic
+                                              sent by the guest.
+                                            items:
+                                              properties:
+                                                action:
+                                                  description: |-
+                                                    Action applied to the matching traffic.
+                                                    Defaults to Allow.
+                                                  type: string
+                                                cidrBlocks:
+                                                  description: |-
+                                                    CIDRBlocks of the remote peers of the matching traffic.
+                                                    All the peers are matched when empty.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                                  x-kubernetes-list-type: atomic
+                                                name:
+                                                  description: |-
+                                                    Name of the rule, unique per interface and direction.
+                                                    It identifies the rule counters reported in the VMI status.
+                                                  type: string
+                                                ports:
+                                                  description: |-
+                                                    Ports of the matching traffic: the guest ports for ingress rules and the
+                                                    remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                                    All the ports are matched when empty.
+                                                  items:
+                                                    format: int32
+                                                    type: integer
+                                                  type: array
+                                                  x-kubernetes-list-type: atomic
+                                                protocol:
+                                                  description: |-
+                                                    Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                                    ICMP matches both ICMP and ICMPv6.
+                                                    All the protocols are matched when empty.
+                                                  type: string
+                                              required:
+                                              - name
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          ingress:
+                                            description: Ingress rules filter the
+                                              traffic received by the guest.
+                                            items:
+                                              properties:
+                                                action:
+                                                  description: |-
+                                                    Action applied to the matching traffic.
+                                                    Defaults to Allow.
+                                                  type: string
+                                                cidrBlocks:
+                                                  description: |-
+                                                    CIDRBlocks of the remote peers of the matching traffic.
+                                                    All the peers are matched when empty.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                                  x-kubernetes-list-type: atomic
+                                                name:
+                                                  description: |-
+                                                    Name of the rule, unique per interface and direction.
+                                                    It identifies the rule counters reported in the VMI status.
+                                                  type: string
+                                                ports:
+                                                  description: |-
+                                                    Ports of the matching traffic: the guest ports for ingress rules and the
+                                                    remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                                    All the ports are matched when empty.
+                                                  items:
+                                                    format: int32
+                                                    type: integer
+                                                  type: array
+                                                  x-kubernetes-list-type: atomic
+                                                protocol:
+                                                  description: |-
+                                                    Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                                    ICMP matches both ICMP and ICMPv6.
+                                                    All the protocols are matched when empty.
+                                                  type: string
+                                              required:
+                                              - name
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        type: object
                                       macAddress:
                                         description: 'Interface MAC address. For example:
                                           de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -25528,6 +28924,37 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: Path defines the path to disk file
                                       in the container
                                     type: string
+                                  persistent:
+                                    description: |-
+                                      Persistent keeps the writes to the disk across restarts of the VM in a qcow2 overlay
+                                      on top of the image, stored on a PVC which is created along with the VM and deleted with it.
+                                      The image must not change during the lifetime of the overlay, referencing it by digest is recommended.
+                                    properties:
+                                      accessModes:
+                                        description: |-
+                                          AccessModes of the overlay PVC, defaults to ReadWriteOnce.
+                                          ReadWriteMany is required to live migrate the VM.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      capacity:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Capacity of the overlay PVC,
+                                          it bounds the amount of data written to
+                                          the disk
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      storageClassName:
+                                        description: StorageClassName of the overlay
+                                          PVC, the default storage class is used when
+                                          empty
+                                        type: string
+                                    required:
+                                    - capacity
+                                    type: object
                                 required:
                                 - image
                                 type: object
@@ -25743,6 +29170,12 @@ var CRDsValidation map[string]string = map[string]string{
                                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                     type: string
+                                  format:
+                                    description: |-
+                                      Format represents the format of the memory dump, defaults to Raw.
+                                      A non hotpluggable volume with the SavedState format is used to
+                                      start the VMI from the saved state instead of booting it.
+                                    type: string
                                   hotpluggable:
                                     description: Hotpluggable indicates whether the
                                       volume can be hotplugged and hotunplugged.
@@ -26180,6 +29613,55 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredInputType optionally defines the preferred type
                 for Input devices.
               type: string
+            preferredInterfaceBandwidth:
+              description: PreferredInterfaceBandwidth optionally defines the preferred
+                bandwidth limits of each bridge and masquerade interface.
+              properties:
+                inbound:
+                  description: Inbound limits the traffic received by the guest.
+                  properties:
+                    average:
+                      description: Average is the average rate in kibibytes per second.
+                      format: int64
+                      type: integer
+                    burst:
+                      description: |-
+                        Burst is the amount of kibibytes that can be sent at the peak rate.
+                        Defaults to the amount sent in one second at the average rate.
+                      format: int64
+                      type: integer
+                    peak:
+                      description: |-
+                        Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                        Must not be lower than the average.
+                      format: int64
+                      type: integer
+                  required:
+                  - average
+                  type: object
+                outbound:
+                  description: Outbound limits the traffic sent by the guest.
+                  properties:
+                    average:
+                      description: Average is the average rate in kibibytes per second.
+                      format: int64
+                      type: integer
+                    burst:
+                      description: |-
+                        Burst is the amount of kibibytes that can be sent at the peak rate.
+                        Defaults to the amount sent in one second at the average rate.
+                      format: int64
+                      type: integer
+                    peak:
+                      description: |-
+                        Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                        Must not be lower than the average.
+                      format: int64
+                      type: integer
+                  required:
+                  - average
+                  type: object
+              type: object
             preferredInterfaceMasquerade:
               description: PreferredInterfaceMasquerade optionally defines the preferred
                 masquerade configuration to use with each network interface.
@@ -26656,6 +30138,12 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: atomic
+        restoreMemoryState:
+          description: |-
+            RestoreMemoryState starts the restored VM from the memory state saved
+            in the snapshot instead of booting it. It is ignored when the snapshot
+            does not include memory.
+          type: boolean
         target:
           description: initially only VirtualMachine type supported
           properties:
@@ -26683,6 +30171,13 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         virtualMachineSnapshotName:
           type: string
+        virtualMachineSnapshotNamespace:
+          description: |-
+            VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot,
+            it defaults to the namespace of the VirtualMachineRestore. Restoring a snapshot
+            from another namespace requires a VirtualMachineSnapshotGrant in that namespace
+            allowing the namespace of the VirtualMachineRestore.
+          type: string
         volumeOwnershipPolicy:
           description: VolumeOwnershipPolicy defines what owns volumes once they're
             restored
@@ -26711,15 +30206,119 @@ var CRDsValidation map[string]string = map[string]string{
           type: array
           x-kubernetes-list-type: atomic
         volumeRestorePolicy:
-          description: VolumeRestorePolicy defines how to handle the restore of snapshotted
-            volumes
+          description: VolumeRestorePolicy defines how to handle the restore of snapshotted
+            volumes
+          type: string
+      required:
+      - target
+      - virtualMachineSnapshotName
+      type: object
+    status:
+      description: VirtualMachineRestoreStatus is the status for a VirtualMachineRestore
+        resource
+      properties:
+        complete:
+          type: boolean
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        deletedDataVolumes:
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+        restoreTime:
+          format: date-time
+          type: string
+        restores:
+          items:
+            description: VolumeRestore contains the data needed to restore a PVC
+            properties:
+              dataVolumeName:
+                type: string
+              persistentVolumeClaim:
+                type: string
+              volumeName:
+                type: string
+              volumeSnapshotName:
+                type: string
+            required:
+            - persistentVolumeClaim
+            - volumeName
+            - volumeSnapshotName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinerestoregroup": `openAPIV3Schema:
+  description: |-
+    VirtualMachineRestoreGroup defines the operation of restoring all members
+    of a VirtualMachineSnapshotGroup
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineRestoreGroupSpec is the spec for a VirtualMachineRestoreGroup
+        resource
+      properties:
+        targetReadinessPolicy:
+          description: TargetReadinessPolicy is passed to the VirtualMachineRestore
+            of each member
+          type: string
+        virtualMachineSnapshotGroupName:
+          type: string
+        volumeRestorePolicy:
+          description: VolumeRestorePolicy is passed to the VirtualMachineRestore
+            of each member
           type: string
       required:
-      - target
-      - virtualMachineSnapshotName
+      - virtualMachineSnapshotGroupName
       type: object
     status:
-      description: VirtualMachineRestoreStatus is the status for a VirtualMachineRestore
+      description: VirtualMachineRestoreGroupStatus is the status for a VirtualMachineRestoreGroup
         resource
       properties:
         complete:
@@ -26751,33 +30350,26 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
-        deletedDataVolumes:
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: set
-        restoreTime:
-          format: date-time
-          type: string
-        restores:
+        members:
           items:
-            description: VolumeRestore contains the data needed to restore a PVC
+            description: VirtualMachineRestoreGroupMember is a VM restored by a VirtualMachineRestoreGroup
             properties:
-              dataVolumeName:
-                type: string
-              persistentVolumeClaim:
-                type: string
-              volumeName:
+              complete:
+                type: boolean
+              virtualMachineName:
                 type: string
-              volumeSnapshotName:
+              virtualMachineRestoreName:
                 type: string
             required:
-            - persistentVolumeClaim
-            - volumeName
-            - volumeSnapshotName
+            - virtualMachineName
+            - virtualMachineRestoreName
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        restoreTime:
+          description: RestoreTime is the time the restores of all members completed
+          format: date-time
+          type: string
       type: object
   required:
   - spec
@@ -26819,6 +30411,169 @@ var CRDsValidation map[string]string = map[string]string{
             as failed.
             Defaults to DefaultFailureDeadline - 5min
           type: string
+        hooks:
+          description: |-
+            Hooks run around the freeze of the guest filesystem of a running VM.
+            They only run when the guest agent is connected and the VM is not paused.
+          properties:
+            postThaw:
+              description: PostThaw hooks run in order after the guest filesystem
+                is thawed
+              items:
+                description: |-
+                  FreezeHook is a single action which runs before the guest filesystem is
+                  frozen or after it is thawed. Exactly one of GuestExec and HTTP must be set.
+                properties:
+                  guestExec:
+                    description: GuestExec runs a command in the guest through the
+                      guest agent, it is only allowed for users who may run commands
+                      in the VMI through its guestexec subresource
+                    properties:
+                      command:
+                        description: |-
+                          Command is the executable and its arguments, it is not run in a shell.
+                          The hook fails if the command exits with a non zero code.
+                        items:
+                          type: string
+                        minItems: 1
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - command
+                    type: object
+                  http:
+                    description: HTTP sends a POST request to a pod in the namespace
+                      of the VM
+                    properties:
+                      path:
+                        description: Path is the path of the request
+                        type: string
+                      podName:
+                        description: |-
+                          PodName is the name of the pod which receives the request, it must be
+                          in the namespace of the VM and labeled kubevirt.io/freeze-hook-target=true.
+                          Pods using the host network are not supported.
+                        minLength: 1
+                        type: string
+                      port:
+                        description: |-
+                          Port is the port the pod listens on, it must be declared by a container
+                          of the pod
+                        format: int32
+                        maximum: 65535
+                        minimum: 1
+                        type: integer
+                    required:
+                    - podName
+                    - port
+                    type: object
+                  name:
+                    description: Name identifies the hook in the recorded results
+                    minLength: 1
+                    type: string
+                  onFailure:
+                    description: |-
+                      OnFailure defines what happens when the hook fails. Fail, the default,
+                      fails the operation. Continue records the failure and goes on.
+                    enum:
+                    - Fail
+                    - Continue
+                    type: string
+                  timeout:
+                    description: Timeout limits how long the hook may run. Defaults
+                      to 30s.
+                    type: string
+                required:
+                - name
+                type: object
+                x-kubernetes-validations:
+                - message: exactly one of guestExec or http must be set
+                  rule: has(self.guestExec) != has(self.http)
+              type: array
+              x-kubernetes-list-type: atomic
+            preFreeze:
+              description: PreFreeze hooks run in order before the guest filesystem
+                is frozen
+              items:
+                description: |-
+                  FreezeHook is a single action which runs before the guest filesystem is
+                  frozen or after it is thawed. Exactly one of GuestExec and HTTP must be set.
+                properties:
+                  guestExec:
+                    description: GuestExec runs a command in the guest through the
+                      guest agent, it is only allowed for users who may run commands
+                      in the VMI through its guestexec subresource
+                    properties:
+                      command:
+                        description: |-
+                          Command is the executable and its arguments, it is not run in a shell.
+                          The hook fails if the command exits with a non zero code.
+                        items:
+                          type: string
+                        minItems: 1
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - command
+                    type: object
+                  http:
+                    description: HTTP sends a POST request to a pod in the namespace
+                      of the VM
+                    properties:
+                      path:
+                        description: Path is the path of the request
+                        type: string
+                      podName:
+                        description: |-
+                          PodName is the name of the pod which receives the request, it must be
+                          in the namespace of the VM and labeled kubevirt.io/freeze-hook-target=true.
+                          Pods using the host network are not supported.
+                        minLength: 1
+                        type: string
+                      port:
+                        description: |-
+                          Port is the port the pod listens on, it must be declared by a container
+                          of the pod
+                        format: int32
+                        maximum: 65535
+                        minimum: 1
+                        type: integer
+                    required:
+                    - podName
+                    - port
+                    type: object
+                  name:
+                    description: Name identifies the hook in the recorded results
+                    minLength: 1
+                    type: string
+                  onFailure:
+                    description: |-
+                      OnFailure defines what happens when the hook fails. Fail, the default,
+                      fails the operation. Continue records the failure and goes on.
+                    enum:
+                    - Fail
+                    - Continue
+                    type: string
+                  timeout:
+                    description: Timeout limits how long the hook may run. Defaults
+                      to 30s.
+                    type: string
+                required:
+                - name
+                type: object
+                x-kubernetes-validations:
+                - message: exactly one of guestExec or http must be set
+                  rule: has(self.guestExec) != has(self.http)
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
+        includeMemory:
+          description: |-
+            IncludeMemory saves the device and RAM state of a running VM into a
+            PVC that is snapshotted next to its volumes, so that a restore can
+            resume the VM instead of booting it. The VM stays paused until its
+            volume snapshots are taken.
+          type: boolean
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -26971,6 +30726,17 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineSnapshotContentSpec is the spec for a VirtualMachineSnapshotContent
         resource
       properties:
+        memoryState:
+          description: MemoryState references the volume holding the saved memory
+            state of the VM
+          properties:
+            volumeName:
+              description: VolumeName is the name of the memory dump volume in the
+                source VM spec
+              type: string
+          required:
+          - volumeName
+          type: object
         source:
           description: SourceSpec contains the appropriate spec for the resource being
             snapshotted
@@ -29129,6 +32895,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         luns which are connected to the vmi.
                                       items:
                                         properties:
+                                          autoGrowFilesystem:
+                                            description: |-
+                                              AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent
+                                              after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.
+                                              Defaults to false.
+                                            type: boolean
                                           blockSize:
                                             description: If specified, the virtual
                                               disk will be presented with the given
@@ -29236,6 +33008,93 @@ var CRDsValidation map[string]string = map[string]string{
                                               IO specifies which QEMU disk IO mode should be used.
                                               Supported values are: native, default, threads.
                                             type: string
+                                          ioTune:
+                                            description: |-
+                                              IOTune throttles the I/O of the disk.
+                                              Requires the DiskIOTune feature gate. Changes are applied to a running VMI
+                                              when the VM uses the LiveUpdate rollout strategy.
+                                            properties:
+                                              burst:
+                                                description: Burst allows the disk
+                                                  to exceed the limits for a short
+                                                  period of time.
+                                                properties:
+                                                  lengthSeconds:
+                                                    description: |-
+                                                      LengthSeconds is the maximum duration of a burst.
+                                                      Defaults to one second.
+                                                    format: int64
+                                                    type: integer
+                                                  readBytesSec:
+                                                    description: ReadBytesSec is the
+                                                      read burst throughput in bytes
+                                                      per second.
+                                                    format: int64
+                                                    type: integer
+                                                  readIOPSSec:
+                                                    description: ReadIOPSSec is the
+                                                      read burst I/O operations per
+                                                      second.
+                                                    format: int64
+                                                    type: integer
+                                                  totalBytesSec:
+                                                    description: TotalBytesSec is
+                                                      the total burst throughput in
+                                                      bytes per second.
+                                                    format: int64
+                                                    type: integer
+                                                  totalIOPSSec:
+                                                    description: TotalIOPSSec is the
+                                                      total burst I/O operations per
+                                                      second.
+                                                    format: int64
+                                                    type: integer
+                                                  writeBytesSec:
+                                                    description: WriteBytesSec is
+                                                      the write burst throughput in
+                                                      bytes per second.
+                                                    format: int64
+                                                    type: integer
+                                                  writeIOPSSec:
+                                                    description: WriteIOPSSec is the
+                                                      write burst I/O operations per
+                                                      second.
+                                                    format: int64
+                                                    type: integer
+                                                type: object
+                                              readBytesSec:
+                                                description: ReadBytesSec is the read
+                                                  throughput limit in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              readIOPSSec:
+                                                description: ReadIOPSSec is the read
+                                                  I/O operations per second limit.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                description: TotalBytesSec is the
+                                                  total throughput limit in bytes
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSec:
+                                                description: TotalIOPSSec is the total
+                                                  I/O operations per second limit.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                description: WriteBytesSec is the
+                                                  write throughput limit in bytes
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSec:
+                                                description: WriteIOPSSec is the write
+                                                  I/O operations per second limit.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -29423,6 +33282,62 @@ var CRDsValidation map[string]string = map[string]string{
                                               in PCI addresses assigned to the device.
                                               This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: |-
+                                              Bandwidth limits the traffic of the interface.
+                                              The limits are enforced with traffic control on the tap device in the network namespace
+                                              of the virt-launcher pod and can be updated while the VMI is running.
+                                              Requires the InterfaceBandwidth feature gate. Supported by the bridge and masquerade bindings.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic
+                                                  received by the guest.
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      rate in kibibytes per second.
+                                                    format: int64
+                                                    type: integer
+                                                  burst:
+                                                    description: |-
+                                                      Burst is the amount of kibibytes that can be sent at the peak rate.
+                                                      Defaults to the amount sent in one second at the average rate.
+                                                    format: int64
+                                                    type: integer
+                                                  peak:
+                                                    description: |-
+                                                      Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                                      Must not be lower than the average.
+                                                    format: int64
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic
+                                                  sent by the guest.
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      rate in kibibytes per second.
+                                                    format: int64
+                                                    type: integer
+                                                  burst:
+                                                    description: |-
+                                                      Burst is the amount of kibibytes that can be sent at the peak rate.
+                                                      Defaults to the amount sent in one second at the average rate.
+                                                    format: int64
+                                                    type: integer
+                                                  peak:
+                                                    description: |-
+                                                      Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+                                                      Must not be lower than the average.
+                                                    format: int64
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
                                           binding:
                                             description: |-
                                               Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -29447,6 +33362,30 @@ var CRDsValidation map[string]string = map[string]string{
                                           bridge:
                                             description: InterfaceBridge connects
                                               to a given network via a linux bridge.
+                                            properties:
+                                              autoConfig:
+                                                description: |-
+                                                  AutoConfig enables the IPv6 auto-configuration of the guest interface.
+                                                  The guest is configured with the IPv6 address the network IPAM assigned to the pod interface,
+                                                  as reported by Multus.
+                                                properties:
+                                                  delegatedPrefix:
+                                                    description: |-
+                                                      DelegatedPrefix is an IPv6 prefix (in CIDR notation) delegated to the guest
+                                                      through DHCPv6 prefix delegation. Requires the DHCPv6 mode.
+                                                    type: string
+                                                  mode:
+                                                    description: |-
+                                                      Mode of the auto-configuration, one of SLAAC or DHCPv6.
+                                                      Defaults to DHCPv6.
+                                                    type: string
+                                                type: object
+                                              migrationMode:
+                                                description: |-
+                                                  MigrationMode defines how the guest addressing is handled when the VMI is live migrated.
+                                                  When set to PreserveIP on the pod network, the guest keeps the IPv4 address and gateway it received
+                                                  before the migration and the target virt-launcher pod translates them to its own.
+                                                type: string
                                             type: object
                                           dhcpOptions:
                                             description: If specified the network
@@ -29457,6 +33396,42 @@ var CRDsValidation map[string]string = map[string]string{
                                                 description: If specified will pass
                                                   option 67 to interface's DHCP server
                                                 type: string
+                                              extraOptions:
+                                                description: |-
+                                                  ExtraOptions passes arbitrary options, identified by their numeric code,
+                                                  to the interface's DHCP and DHCPv6 servers.
+                                                  An extra option replaces the option the server provides with the same code.
+                                                items:
+                                                  description: DHCPExtraOption defines
+                                                    a DHCP option by its numeric code
+                                                    and typed value.
+                                                  properties:
+                                                    code:
+                                                      description: |-
+                                                        Code of the option, from 1 to 254 for DHCP and from 1 to 65535 for DHCPv6.
+                                                        Options which are managed by the server, such as the server identifier, are not allowed.
+                                                      format: int32
+                                                      type: integer
+                                                    family:
+                                                      description: |-
+                                                        Family of the server which passes the option, IPv4 for DHCP and IPv6 for DHCPv6.
+                                                        Defaults to IPv4.
+                                                      type: string
+                                                    type:
+                                                      description: |-
+                                                        Type of the value, one of String, Uint8, Uint16, Uint32, IPAddresses or Hex.
+                                                        Defaults to String.
+                                                      type: string
+                                                    value:
+                                                      description: Value of the option,
+                                                        encoded according to its type.
+                                                      type: string
+                                                  required:
+                                                  - code
+                                                  - value
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
                                               ntpServers:
                                                 description: If specified will pass
                                                   the configured NTP server to the
@@ -29464,33 +33439,129 @@ var CRDsValidation map[string]string = map[string]string{
                                                 items:
                                                   type: string
                                                 type: array
-                                              privateOptions:
-                                                description: 'If specified will pass
-                                                  extra DHCP options for private use,
-                                                  range: 224-254'
+                                              privateOptions:
+                                                description: 'If specified will pass
+                                                  extra DHCP options for private use,
+                                                  range: 224-254'
+                                                items:
+                                                  description: DHCPExtraOptions defines
+                                                    Extra DHCP options for a VM.
+                                                  properties:
+                                                    option:
+                                                      description: |-
+                                                        Option is an Integer value from 224-254
+                                                        Required.
+                                                      type: integer
+                                                    value:
+                                                      description: |-
+                                                        Value is a String value for the Option provided
+                                                        Required.
+                                                      type: string
+                                                  required:
+                                                  - option
+                                                  - value
+                                                  type: object
+                                                type: array
+                                              tftpServerName:
+                                                description: If specified will pass
+                                                  option 66 to interface's DHCP server
+                                                type: string
+                                            type: object
+                                          firewall:
+                                            description: |-
+                                              Firewall defines the rules filtering the traffic of the interface.
+                                              The rules are enforced in the network namespace of the virt-launcher pod
+                                              and can be updated while the VMI is running.
+                                              Supported by the bridge, masquerade and passt bindings.
+                                            properties:
+                                              egress:
+                                                description: Egress rules filter the
+                                                  traffic sent by the guest.
+                                                items:
+                                                  properties:
+                                                    action:
+                                                      description: |-
+                                                        Action applied to the matching traffic.
+                                                        Defaults to Allow.
+                                                      type: string
+                                                    cidrBlocks:
+                                                      description: |-
+                                                        CIDRBlocks of the remote peers of the matching traffic.
+                                                        All the peers are matched when empty.
+                                                      items:
+                                                        type: string
+                                                      type: array
+                                                      x-kubernetes-list-type: atomic
+                                                    name:
+                                                      description: |-
+                                                        Name of the rule, unique per interface and direction.
+                                                        It identifies the rule counters reported in the VMI status.
+                                                      type: string
+                                                    ports:
+                                                      description: |-
+                                                        Ports of the matching traffic: the guest ports for ingress rules and the
+                                                        remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                                        All the ports are matched when empty.
+                                                      items:
+                                                        format: int32
+                                                        type: integer
+                                                      type: array
+                                                      x-kubernetes-list-type: atomic
+                                                    protocol:
+                                                      description: |-
+                                                        Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                                        ICMP matches both ICMP and ICMPv6.
+                                                        All the protocols are matched when empty.
+                                                      type: string
+                                                  required:
+                                                  - name
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              ingress:
+                                                description: Ingress rules filter
+                                                  the traffic received by the guest.
                                                 items:
-                                                  description: DHCPExtraOptions defines
-                                                    Extra DHCP options for a VM.
                                                   properties:
-                                                    option:
+                                                    action:
                                                       description: |-
-                                                        Option is an Integer value from 224-254
-                                                        Required.
-                                                      type: integer
-                                                    value:
+                                                        Action applied to the matching traffic.
+                                                        Defaults to Allow.
+                                                      type: string
+                                                    cidrBlocks:
                                                       description: |-
-                                                        Value is a String value for the Option provided
-                                                        Required.
+                                                        CIDRBlocks of the remote peers of the matching traffic.
+                                                        All the peers are matched when empty.
+                                                      items:
+                                                        type: string
+                                                      type: array
+                                                      x-kubernetes-list-type: atomic
+                                                    name:
+                                                      description: |-
+                                                        Name of the rule, unique per interface and direction.
+                                                        It identifies the rule counters reported in the VMI status.
+                                                      type: string
+                                                    ports:
+                                                      description: |-
+                                                        Ports of the matching traffic: the guest ports for ingress rules and the
+                                                        remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+                                                        All the ports are matched when empty.
+                                                      items:
+                                                        format: int32
+                                                        type: integer
+                                                      type: array
+                                                      x-kubernetes-list-type: atomic
+                                                    protocol:
+                                                      description: |-
+                                                        Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+                                                        ICMP matches both ICMP and ICMPv6.
+                                                        All the protocols are matched when empty.
                                                       type: string
                                                   required:
-                                                  - option
-                                                  - value
+                                                  - name
                                                   type: object
                                                 type: array
-                                              tftpServerName:
-                                                description: If specified will pass
-                                                  option 66 to interface's DHCP server
-                                                type: string
+                                                x-kubernetes-list-type: atomic
                                             type: object
                                           macAddress:
                                             description: 'Interface MAC address. For
@@ -31078,6 +35149,37 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: Path defines the path to disk
                                           file in the container
                                         type: string
+                                      persistent:
+                                        description: |-
+                                          Persistent keeps the writes to the disk across restarts of the VM in a qcow2 overlay
+                                          on top of the image, stored on a PVC which is created along with the VM and deleted with it.
+                                          The image must not change during the lifetime of the overlay, referencing it by digest is recommended.
+                                        properties:
+                                          accessModes:
+                                            description: |-
+                                              AccessModes of the overlay PVC, defaults to ReadWriteOnce.
+                                              ReadWriteMany is required to live migrate the VM.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          capacity:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: Capacity of the overlay PVC,
+                                              it bounds the amount of data written
+                                              to the disk
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          storageClassName:
+                                            description: StorageClassName of the overlay
+                                              PVC, the default storage class is used
+                                              when empty
+                                            type: string
+                                        required:
+                                        - capacity
+                                        type: object
                                     required:
                                     - image
                                     type: object
@@ -31296,6 +35398,12 @@ var CRDsValidation map[string]string = map[string]string{
                                           claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                           More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                         type: string
+                                      format:
+                                        description: |-
+                                          Format represents the format of the memory dump, defaults to Raw.
+                                          A non hotpluggable volume with the SavedState format is used to
+                                          start the VMI from the saved state instead of booting it.
+                                        type: string
                                       hotpluggable:
                                         description: Hotpluggable indicates whether
                                           the volume can be hotplugged and hotunplugged.
@@ -31474,6 +35582,38 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: BackupVolumeInfo contains information
                                   about a volume included in a backup
                                 properties:
+                                  claim:
+                                    description: Claim describes the PVC which backed
+                                      the volume at backup time
+                                    properties:
+                                      accessModes:
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      capacity:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Capacity is the capacity of the
+                                          PVC
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      claimName:
+                                        description: ClaimName is the name of the
+                                          PVC
+                                        type: string
+                                      storageClassName:
+                                        type: string
+                                      volumeMode:
+                                        description: PersistentVolumeMode describes
+                                          how a volume is intended to be consumed,
+                                          either Block or Filesystem.
+                                        type: string
+                                    required:
+                                    - capacity
+                                    - claimName
+                                    type: object
                                   diskTarget:
                                     description: DiskTarget is the disk target device
                                       name at backup time
@@ -31523,6 +35663,41 @@ var CRDsValidation map[string]string = map[string]string{
                         - type
                         type: object
                       type: array
+                    containerDiskCommitRequest:
+                      description: |-
+                        ContainerDiskCommitRequest tracks the request to commit a persistent containerDisk
+                        into a PVC
+                      nullable: true
+                      properties:
+                        claimName:
+                          description: ClaimName is the name of the PVC receiving
+                            the disk, it can be the PVC of a blank DataVolume
+                          type: string
+                        endTimestamp:
+                          description: EndTimestamp represents the time the commit
+                            completed
+                          format: date-time
+                          type: string
+                        message:
+                          description: Message is a detailed message about failure
+                            of the commit
+                          type: string
+                        phase:
+                          description: Phase represents the commit phase
+                          type: string
+                        startTimestamp:
+                          description: StartTimestamp represents the time the commit
+                            started
+                          format: date-time
+                          type: string
+                        volumeName:
+                          description: VolumeName is the name of the persistent containerDisk
+                            volume to commit
+                          type: string
+                      required:
+                      - claimName
+                      - volumeName
+                      type: object
                     created:
                       description: Created indicates if the virtual machine is created
                         in the cluster
@@ -31584,6 +35759,10 @@ var CRDsValidation map[string]string = map[string]string{
                           description: FileName represents the name of the output
                             file
                           type: string
+                        format:
+                          description: Format represents the format of the memory
+                            dump, defaults to Raw
+                          type: string
                         message:
                           description: Message is a detailed message about failure
                             of the memory dump
@@ -31715,9 +35894,16 @@ var CRDsValidation map[string]string = map[string]string{
                               within this field specify how to add the volume
                             properties:
                               disk:
-                                description: Disk represents the hotplug disk that
-                                  will be plugged into the running VMI
+                                description: |-
+                                  Disk represents the hotplug disk that will be plugged into the running VMI.
+                                  Either Disk or Filesystem must be set.
                                 properties:
+                                  autoGrowFilesystem:
+                                    description: |-
+                                      AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent
+                                      after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.
+                                      Defaults to false.
+                                    type: boolean
                                   blockSize:
                                     description: If specified, the virtual disk will
                                       be presented with the given block sizes.
@@ -31823,6 +36009,84 @@ var CRDsValidation map[string]string = map[string]string{
                                       IO specifies which QEMU disk IO mode should be used.
                                       Supported values are: native, default, threads.
                                     type: string
+                                  ioTune:
+                                    description: |-
+                                      IOTune throttles the I/O of the disk.
+                                      Requires the DiskIOTune feature gate. Changes are applied to a running VMI
+                                      when the VM uses the LiveUpdate rollout strategy.
+                                    properties:
+                                      burst:
+                                        description: Burst allows the disk to exceed
+                                          the limits for a short period of time.
+                                        properties:
+                                          lengthSeconds:
+                                            description: |-
+                                              LengthSeconds is the maximum duration of a burst.
+                                              Defaults to one second.
+                                            format: int64
+                                            type: integer
+                                          readBytesSec:
+                                            description: ReadBytesSec is the read
+                                              burst throughput in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          readIOPSSec:
+                                            description: ReadIOPSSec is the read burst
+                                              I/O operations per second.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            description: TotalBytesSec is the total
+                                              burst throughput in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSec:
+                                            description: TotalIOPSSec is the total
+                                              burst I/O operations per second.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            description: WriteBytesSec is the write
+                                              burst throughput in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSec:
+                                            description: WriteIOPSSec is the write
+                                              burst I/O operations per second.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      readBytesSec:
+                                        description: ReadBytesSec is the read throughput
+                                          limit in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec is the read I/O operations
+                                          per second limit.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec is the total throughput
+                                          limit in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec is the total I/O
+                                          operations per second limit.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec is the write throughput
+                                          limit in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec is the write I/O
+                                          operations per second limit.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -31872,6 +36136,21 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: string
                                 type: array
                                 x-kubernetes-list-type: atomic
+                              filesystem:
+                                description: |-
+                                  Filesystem represents the virtiofs filesystem that will be plugged into the running VMI
+                                  instead of a disk.
+                                properties:
+                                  name:
+                                    description: Name is the device name
+                                    type: string
+                                  virtiofs:
+                                    description: Virtiofs is supported
+                                    type: object
+                                required:
+                                - name
+                                - virtiofs
+                                type: object
                               name:
                                 description: |-
                                   Name represents the name that will be used to map the
@@ -31923,7 +36202,6 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                 type: object
                             required:
-                            - disk
                             - name
                             - volumeSource
                             type: object
@@ -32320,24 +36598,290 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                     type: object
                 type: object
-              volumeName:
-                type: string
-              volumeSnapshotName:
+              volumeName:
+                type: string
+              volumeSnapshotName:
+                type: string
+            required:
+            - persistentVolumeClaim
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineSnapshotContentStatus is the status for a VirtualMachineSnapshotStatus
+        resource
+      properties:
+        creationTime:
+          format: date-time
+          nullable: true
+          type: string
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        freezeHooks:
+          items:
+            description: FreezeHookResult is the outcome of a hook
+            properties:
+              message:
+                description: Message describes the failure of the hook
+                type: string
+              name:
+                description: Name is the name of the hook
+                type: string
+              stage:
+                description: Stage is the point at which the hook ran
+                type: string
+              succeeded:
+                description: Succeeded indicates that the hook completed successfully
+                type: boolean
+            required:
+            - name
+            - stage
+            - succeeded
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        memoryState:
+          description: MemoryStateStatus is the status of saving the memory state
+            of the VM
+          properties:
+            fileName:
+              description: |-
+                FileName is the name of the saved state file, set once the memory
+                state is saved
+              type: string
+            sourcePaused:
+              description: |-
+                SourcePaused is set when the source VM was paused to save its memory
+                state, it is unpaused once the volume snapshots are taken
+              type: boolean
+          type: object
+        readyToUse:
+          type: boolean
+        volumeSnapshotStatus:
+          items:
+            description: VolumeSnapshotStatus is the status of a VolumeSnapshot
+            properties:
+              creationTime:
+                format: date-time
+                nullable: true
+                type: string
+              error:
+                description: Error is the last error encountered during the snapshot/restore
+                properties:
+                  message:
+                    type: string
+                  time:
+                    format: date-time
+                    type: string
+                type: object
+              readyToUse:
+                type: boolean
+              volumeSnapshotName:
+                type: string
+            required:
+            - volumeSnapshotName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinesnapshotgrant": `openAPIV3Schema:
+  description: |-
+    VirtualMachineSnapshotGrant allows VirtualMachineRestores in other namespaces
+    to restore the VirtualMachineSnapshots of its namespace
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotGrantSpec is the spec for a VirtualMachineSnapshotGrant
+        resource
+      properties:
+        from:
+          description: From lists the namespaces which are allowed to restore the
+            granted snapshots
+          items:
+            description: VirtualMachineSnapshotGrantFrom describes a namespace which
+              is allowed to restore the granted snapshots
+            properties:
+              namespace:
+                type: string
+            required:
+            - namespace
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        to:
+          description: |-
+            To lists the VirtualMachineSnapshots that are granted, all the
+            VirtualMachineSnapshots of the namespace are granted when it is empty
+          items:
+            description: VirtualMachineSnapshotGrantTo describes a granted VirtualMachineSnapshot
+            properties:
+              name:
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      required:
+      - from
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinesnapshotgroup": `openAPIV3Schema:
+  description: |-
+    VirtualMachineSnapshotGroup defines the operation of snapshotting a group of VMs
+    at the same point in time
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup
+        resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy is passed to the VirtualMachineSnapshot of each
+            member
+          type: string
+        failureDeadline:
+          description: |-
+            This time represents the number of seconds we permit the group snapshot
+            to take. In case we pass this deadline we mark the group snapshot
+            as failed and thaw all members.
+            Defaults to DefaultFailureDeadline - 5min
+          type: string
+        selector:
+          description: |-
+            Selector selects the VirtualMachines in the namespace of the group.
+            The members are resolved once, when the snapshot starts.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
                 type: string
-            required:
-            - persistentVolumeClaim
-            - volumeName
-            type: object
-          type: array
-          x-kubernetes-list-type: atomic
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
       required:
-      - source
+      - selector
       type: object
     status:
-      description: VirtualMachineSnapshotContentStatus is the status for a VirtualMachineSnapshotStatus
+      description: VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup
         resource
       properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         creationTime:
+          description: |-
+            CreationTime is the time the volume snapshots of all members
+            were taken and the members were thawed
           format: date-time
           nullable: true
           type: string
@@ -32350,31 +36894,219 @@ var CRDsValidation map[string]string = map[string]string{
               format: date-time
               type: string
           type: object
-        readyToUse:
-          type: boolean
-        volumeSnapshotStatus:
+        freezeTime:
+          description: |-
+            FreezeTime is the time all members were frozen, the volume
+            snapshots of the members are only taken after it
+          format: date-time
+          nullable: true
+          type: string
+        members:
           items:
-            description: VolumeSnapshotStatus is the status of a VolumeSnapshot
+            description: VirtualMachineSnapshotGroupMember is a VM snapshotted by
+              a VirtualMachineSnapshotGroup
             properties:
-              creationTime:
-                format: date-time
-                nullable: true
+              readyToUse:
+                type: boolean
+              virtualMachineName:
                 type: string
-              error:
-                description: Error is the last error encountered during the snapshot/restore
+              virtualMachineSnapshotName:
+                type: string
+            required:
+            - virtualMachineName
+            - virtualMachineSnapshotName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          description: VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
+          type: string
+        readyToUse:
+          type: boolean
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinestoragemigrationplan": `openAPIV3Schema:
+  description: |-
+    VirtualMachineStorageMigrationPlan moves the volumes of a set of running VirtualMachines to a new StorageClass
+    by live migrating their storage
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineStorageMigrationPlanSpec is the spec for a VirtualMachineStorageMigrationPlan
+        resource
+      properties:
+        bandwidthPerMigration:
+          anyOf:
+          - type: integer
+          - type: string
+          description: BandwidthPerMigration limits the bandwidth used by each storage
+            migration
+          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+          x-kubernetes-int-or-string: true
+        deleteSourcePVCs:
+          description: DeleteSourcePVCs deletes the source PersistentVolumeClaims
+            and DataVolumes of a VirtualMachine once all of its volumes were moved
+          type: boolean
+        maxParallelMigrations:
+          description: |-
+            MaxParallelMigrations is the maximum number of VirtualMachines whose volumes are migrated at the same time.
+            Defaults to 1
+          format: int32
+          type: integer
+        selector:
+          description: Selector selects the VirtualMachines, in the namespace of the
+            plan, whose volumes are moved
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
                 properties:
-                  message:
+                  key:
+                    description: key is the label key that the selector applies to.
                     type: string
-                  time:
-                    format: date-time
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
                     type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
                 type: object
-              readyToUse:
-                type: boolean
-              volumeSnapshotName:
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+        targetStorageClassName:
+          description: TargetStorageClassName is the StorageClass the volumes are
+            moved to
+          type: string
+        virtualMachines:
+          description: VirtualMachines lists the names of the VirtualMachines, in
+            the namespace of the plan, whose volumes are moved
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        window:
+          description: |-
+            Window restricts the time of day at which the storage migration of a VirtualMachine may start.
+            Storage migrations started inside the window run to completion
+          properties:
+            end:
+              description: End is the time of day the window closes at, in the
+                HH:MM format. A window ending before it starts spans midnight
+              type: string
+            start:
+              description: Start is the time of day the window opens at, in the
+                HH:MM format
+              type: string
+          required:
+          - end
+          - start
+          type: object
+      required:
+      - targetStorageClassName
+      type: object
+    status:
+      description: VirtualMachineStorageMigrationPlanStatus is the status for a VirtualMachineStorageMigrationPlan
+        resource
+      properties:
+        completionTimestamp:
+          format: date-time
+          nullable: true
+          type: string
+        phase:
+          description: StorageMigrationPhase is the current phase of a VirtualMachineStorageMigrationPlan
+            or of one of its volumes
+          type: string
+        startTimestamp:
+          format: date-time
+          nullable: true
+          type: string
+        volumes:
+          description: Volumes reports the progress of every volume moved by the plan
+          items:
+            description: StorageMigrationVolumeStatus reports the progress of a single
+              volume moved by a VirtualMachineStorageMigrationPlan
+            properties:
+              bytesCopied:
+                description: BytesCopied is the amount of data copied so far to the
+                  target volume
+                format: int64
+                type: integer
+              message:
+                type: string
+              phase:
+                description: StorageMigrationPhase is the current phase of a VirtualMachineStorageMigrationPlan
+                  or of one of its volumes
+                type: string
+              sourceClaimName:
+                description: SourceClaimName is the name of the PersistentVolumeClaim
+                  or DataVolume the volume is moved from
+                type: string
+              targetClaimName:
+                description: TargetClaimName is the name of the PersistentVolumeClaim
+                  the volume is moved to
+                type: string
+              totalBytes:
+                description: TotalBytes is the amount of data to copy to the target
+                  volume
+                format: int64
+                type: integer
+              virtualMachine:
+                description: VirtualMachine is the name of the VirtualMachine the
+                  volume belongs to
+                type: string
+              volumeName:
+                description: VolumeName is the name of the volume in the VirtualMachine
                 type: string
             required:
-            - volumeSnapshotName
+            - sourceClaimName
+            - targetClaimName
+            - virtualMachine
+            - volumeName
             type: object
           type: array
           x-kubernetes-list-type: atomic
@@ -301,8 +301,12 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 	migrationUpdatePath := MigrationUpdateValidatePath
 	vmSnapshotValidatePath := VMSnapshotValidatePath
 	vmRestoreValidatePath := VMRestoreValidatePath
+	vmSnapshotGroupValidatePath := VMSnapshotGroupValidatePath
+	vmRestoreGroupValidatePath := VMRestoreGroupValidatePath
 	vmBackupValidatePath := VMBackupValidatePath
 	vmBackupTrackerValidatePath := VMBackupTrackerValidatePath
+	vmBackupScheduleValidatePath := VMBackupScheduleValidatePath
+	vmBackupRestoreValidatePath := VMBackupRestoreValidatePath
 	vmExportValidatePath := VMExportValidatePath
 	VmInstancetypeValidatePath := VMInstancetypeValidatePath
 	VmClusterInstancetypeValidatePath := VMClusterInstancetypeValidatePath
@@ -311,6 +315,7 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 	podEvictionValidatePath := PodEvictionValidatePath
 	statusValidatePath := StatusValidatePath
 	migrationPolicyCreateValidatePath := MigrationPolicyCreateValidatePath
+	storageMigrationPlanValidatePath := StorageMigrationPlanValidatePath
 	vmCloneCreateValidatePath := VMCloneCreateValidatePath
 	failurePolicy := admissionregistrationv1.Fail
 
@@ -639,6 +644,56 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachinesnapshotgroup-validator.snapshot.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{snapshotv1.SchemeGroupVersion.Group},
+						APIVersions: []string{snapshotv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinesnapshotgroups"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmSnapshotGroupValidatePath,
+					},
+				},
+			},
+			{
+				Name:                    "virtualmachinerestoregroup-validator.snapshot.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{snapshotv1.SchemeGroupVersion.Group},
+						APIVersions: []string{snapshotv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinerestoregroups"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmRestoreGroupValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "virtualmachinebackup-validator.backup.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1"},
@@ -689,6 +744,55 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachinebackupschedule-validator.backup.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{backupv1.SchemeGroupVersion.Group},
+						APIVersions: []string{backupv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinebackupschedules"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmBackupScheduleValidatePath,
+					},
+				},
+			},
+			{
+				Name:                    "virtualmachinebackuprestore-validator.backup.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{backupv1.SchemeGroupVersion.Group},
+						APIVersions: []string{backupv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinebackuprestores"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmBackupRestoreValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "virtualmachineexport-validator.export.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1"},
@@ -876,6 +980,31 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "storage-migration-plan-validator.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{migrationsv1.SchemeGroupVersion.Group},
+						APIVersions: []string{migrationsv1.SchemeGroupVersion.Version},
+						Resources:   []string{migrations.ResourceVirtualMachineStorageMigrationPlans},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &storageMigrationPlanValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "vm-clone-validator.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1"},
@@ -961,10 +1090,18 @@ const VMSnapshotValidatePath = "/virtualmachinesnapshots-validate"
 
 const VMRestoreValidatePath = "/virtualmachinerestores-validate"
 
+const VMSnapshotGroupValidatePath = "/virtualmachinesnapshotgroups-validate"
+
+const VMRestoreGroupValidatePath = "/virtualmachinerestoregroups-validate"
+
 const VMBackupValidatePath = "/virtualmachinebackups-validate"
 
 const VMBackupTrackerValidatePath = "/virtualmachinebackuptrackers-validate"
 
+const VMBackupScheduleValidatePath = "/virtualmachinebackupschedules-validate"
+
+const VMBackupRestoreValidatePath = "/virtualmachinebackuprestores-validate"
+
 const VMExportValidatePath = "/virtualmachineexports-validate"
 
 const VMInstancetypeValidatePath = "/virtualmachineinstancetypes-validate"
@@ -981,6 +1118,8 @@ const PodEvictionValidatePath = "/pod-eviction-validate"
 
 const MigrationPolicyCreateValidatePath = "/migration-policy-validate-create"
 
+const StorageMigrationPlanValidatePath = "/storage-migration-plan-validate"
+
 const VMCloneCreateValidatePath = "/vm-clone-validate-create"
 
 const VMCloneCreateMutatePath = "/vm-clone-mutate-create"
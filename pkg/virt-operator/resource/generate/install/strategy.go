@@ -500,6 +500,11 @@ func GenerateCurrentInstallStrategy(config *operatorutil.KubeVirtDeploymentConfi
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineExportCrd,
 		components.NewVirtualMachineCloneCrd, components.NewVirtualMachineBackupCrd,
 		components.NewVirtualMachineBackupTrackerCrd,
+		components.NewVirtualMachineBackupScheduleCrd,
+		components.NewVirtualMachineBackupRestoreCrd,
+		components.NewVirtualMachineSnapshotGroupCrd, components.NewVirtualMachineRestoreGroupCrd,
+		components.NewVirtualMachineSnapshotGrantCrd,
+		components.NewVirtualMachineStorageMigrationPlanCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
@@ -202,6 +202,7 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinerestores",
 					"virtualmachinesnapshotcontents",
+					"virtualmachinesnapshotgrants",
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -53,7 +53,12 @@ const (
 	apiVMSnapshotContents = "virtualmachinesnapshotcontents"
 	apiVMBackups          = "virtualmachinebackups"
 	apiVMBackupTrackers   = "virtualmachinebackuptrackers"
+	apiVMBackupSchedules  = "virtualmachinebackupschedules"
+	apiVMBackupRestores   = "virtualmachinebackuprestores"
 	apiVMRestores         = "virtualmachinerestores"
+	apiVMSnapshotGroups   = "virtualmachinesnapshotgroups"
+	apiVMRestoreGroups    = "virtualmachinerestoregroups"
+	apiVMSnapshotGrants   = "virtualmachinesnapshotgrants"
 	apiVMExports          = "virtualmachineexports"
 	apiVMClones           = "virtualmachineclones"
 	apiVMPools            = "virtualmachinepools"
@@ -67,6 +72,7 @@ const (
 	apiVMRemoveVolume   = "virtualmachines/removevolume"
 	apiVMMigrate        = "virtualmachines/migrate"
 	apiVMMemoryDump     = "virtualmachines/memorydump"
+	apiVMCommitDisk     = "virtualmachines/commitcontainerdisk"
 	apiVMObjectGraph    = "virtualmachines/objectgraph"
 	apiVMEvacuateCancel = "virtualmachines/evacuate/cancel"
 
@@ -92,6 +98,8 @@ const (
 	apiVMInstancesUSBRedir                  = "virtualmachineinstances/usbredir"
 	apiVMInstancesObjectGraph               = "virtualmachineinstances/objectgraph"
 	apiVMInstancesEvacuateCancel            = "virtualmachineinstances/evacuate/cancel"
+	apiVMInstancesBackupMap                 = "virtualmachineinstances/backup/map"
+	apiVMInstancesBackupRead                = "virtualmachineinstances/backup/read"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -212,6 +220,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUSBRedir,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
+					apiVMInstancesBackupMap,
+					apiVMInstancesBackupRead,
 				},
 				Verbs: []string{
 					"get",
@@ -261,6 +271,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMCommitDisk,
 					apiVMEvacuateCancel,
 				},
 				Verbs: []string{
@@ -311,6 +322,9 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotGroups,
+					apiVMRestoreGroups,
+					apiVMSnapshotGrants,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -323,6 +337,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMBackups,
 					apiVMBackupTrackers,
+					apiVMBackupSchedules,
+					apiVMBackupRestores,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -386,6 +402,17 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVirtualMachineStorageMigrationPlans,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
 		},
 	}
 }
@@ -421,6 +448,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUSBRedir,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
+					apiVMInstancesBackupMap,
+					apiVMInstancesBackupRead,
 				},
 				Verbs: []string{
 					"get",
@@ -470,6 +499,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMCommitDisk,
 					apiVMEvacuateCancel,
 				},
 				Verbs: []string{
@@ -520,6 +550,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotGroups,
+					apiVMRestoreGroups,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -532,6 +564,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMBackups,
 					apiVMBackupTrackers,
+					apiVMBackupSchedules,
+					apiVMBackupRestores,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -606,6 +640,17 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVirtualMachineStorageMigrationPlans,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -726,6 +771,9 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotGroups,
+					apiVMRestoreGroups,
+					apiVMSnapshotGrants,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -738,6 +786,8 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMBackups,
 					apiVMBackupTrackers,
+					apiVMBackupSchedules,
+					apiVMBackupRestores,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -801,6 +851,17 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVirtualMachineStorageMigrationPlans,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -323,11 +323,28 @@ func baseControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshotcontents/finalizers",
 					"virtualmachinerestores",
 					"virtualmachinerestores/status",
+					"virtualmachinesnapshotgroups",
+					"virtualmachinesnapshotgroups/status",
+					"virtualmachinesnapshotgroups/finalizers",
+					"virtualmachinerestoregroups",
+					"virtualmachinerestoregroups/status",
+					"virtualmachinerestoregroups/finalizers",
 				},
 				Verbs: []string{
 					"get", "list", "watch", "create", "update", "delete", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"snapshot.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinesnapshotgrants",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"export.kubevirt.io",
@@ -366,6 +383,30 @@ func baseControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "create", "update", "delete", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"backup.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinebackupschedules",
+					"virtualmachinebackupschedules/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"backup.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinebackuprestores",
+					"virtualmachinebackuprestores/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"pool.kubevirt.io",
@@ -421,8 +462,12 @@ func baseControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/removevolume",
 					"virtualmachineinstances/backup",
 					"virtualmachineinstances/redefine-checkpoint",
+					"virtualmachineinstances/delete-checkpoint",
 					"virtualmachineinstances/freeze",
 					"virtualmachineinstances/unfreeze",
+					"virtualmachineinstances/guestexec",
+					"virtualmachineinstances/pause",
+					"virtualmachineinstances/unpause",
 					"virtualmachineinstances/reset",
 					"virtualmachineinstances/softreboot",
 					"virtualmachineinstances/sev/setupsession",
@@ -534,6 +579,18 @@ func baseControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVirtualMachineStorageMigrationPlans,
+					migrations.ResourceVirtualMachineStorageMigrationPlans + "/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					clone.GroupName,
@@ -118,6 +118,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewGuestOsInfoCommand(),
 		vm.NewUserListCommand(),
 		vm.NewFSListCommand(),
+		vm.NewFSResizeStatusCommand(),
 		vm.NewAddVolumeCommand(),
 		vm.NewRemoveVolumeCommand(),
 		vm.NewExpandCommand(),
@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_FSRESIZESTATUS = "fsresizestatus"
+
+func NewFSResizeStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fsresizestatus (VMI)",
+		Short:   "Show the status of growing the guest filesystems after a disk expansion.",
+		Example: usage(COMMAND_FSRESIZESTATUS),
+		Args:    cobra.ExactArgs(1),
+		RunE:    fsResizeStatusRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func fsResizeStatusRun(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	vmi, err := virtClient.VirtualMachineInstance(namespace).Get(cmd.Context(), vmiName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Error getting VirtualMachineInstance %s, %v", vmiName, err)
+	}
+
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type != v1.VirtualMachineInstanceGuestFilesystemResized {
+			continue
+		}
+		cmd.Printf("Status:  %s\n", condition.Status)
+		cmd.Printf("Reason:  %s\n", condition.Reason)
+		cmd.Printf("Message: %s\n", condition.Message)
+		cmd.Printf("Since:   %s\n", condition.LastTransitionTime.String())
+		return nil
+	}
+
+	cmd.Printf("No guest filesystem resize reported for VirtualMachineInstance %s\n", vmiName)
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("FS resize status command", func() {
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	const vmiName = "testvmi"
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	It("should fail with missing input parameters", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("fsresizestatus")
+		Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+	})
+
+	It("should fail with non existing vmi", func() {
+		vmiInterface.EXPECT().Get(gomock.Any(), vmiName, gomock.Any()).Return(nil, fmt.Errorf("not found"))
+
+		cmd := testing.NewRepeatableVirtctlCommand("fsresizestatus", vmiName)
+		Expect(cmd()).To(MatchError("Error getting VirtualMachineInstance testvmi, not found"))
+	})
+
+	It("should show the guest filesystem resize condition", func() {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: vmiName},
+			Status: v1.VirtualMachineInstanceStatus{
+				Conditions: []v1.VirtualMachineInstanceCondition{{
+					Type:    v1.VirtualMachineInstanceGuestFilesystemResized,
+					Status:  k8sv1.ConditionFalse,
+					Reason:  v1.VirtualMachineInstanceReasonFilesystemResizeFailed,
+					Message: "/data: growing a vfat filesystem is not supported",
+				}},
+			},
+		}
+		vmiInterface.EXPECT().Get(gomock.Any(), vmiName, gomock.Any()).Return(vmi, nil)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("fsresizestatus", vmiName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("Reason:  FilesystemResizeFailed"))
+		Expect(string(out)).To(ContainSubstring("Message: /data: growing a vfat filesystem is not supported"))
+	})
+
+	It("should report when no resize happened", func() {
+		vmiInterface.EXPECT().Get(gomock.Any(), vmiName, gomock.Any()).Return(&v1.VirtualMachineInstance{}, nil)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("fsresizestatus", vmiName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("No guest filesystem resize reported"))
+	})
+})
@@ -0,0 +1,311 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmexport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cheggaaa/pb/v3"
+	"golang.org/x/sync/errgroup"
+
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+// progressFileSuffix is appended to the output file to name the file that tracks the downloaded ranges
+const progressFileSuffix = ".progress"
+
+// RangeSize allows overriding the size of the ranges of a volume downloaded at once (useful for unit testing)
+var RangeSize int64 = 64 * 1024 * 1024
+
+// errTransient marks the failures of a ranged download which are retried from the last downloaded range
+var errTransient = errors.New("transient error")
+
+// downloadProgress is stored next to the output file, so an interrupted download can resume
+// from the ranges it already wrote
+type downloadProgress struct {
+	Size      int64   `json:"size"`
+	RangeSize int64   `json:"rangeSize"`
+	Completed []int64 `json:"completed,omitempty"`
+}
+
+func progressFile(outputFile string) string {
+	return outputFile + progressFileSuffix
+}
+
+// loadDownloadProgress reads the progress of an earlier download of the volume. Without a progress file,
+// the download starts over as nothing tells which content of the output file was downloaded
+func loadDownloadProgress(outputFile string, size int64) (*downloadProgress, error) {
+	progress := &downloadProgress{Size: size, RangeSize: RangeSize}
+	data, err := os.ReadFile(progressFile(outputFile))
+	if err == nil {
+		stored := &downloadProgress{}
+		if err := json.Unmarshal(data, stored); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %v", progressFile(outputFile), err)
+		}
+		if stored.Size == size && stored.RangeSize == RangeSize {
+			return stored, nil
+		}
+		printToOutput("The volume changed since the last download, starting over\n")
+		return progress, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return progress, nil
+}
+
+func (p *downloadProgress) save(outputFile string) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(progressFile(outputFile), data, 0600)
+}
+
+// pending returns the ranges left to download
+func (p *downloadProgress) pending() []int64 {
+	completed := make(map[int64]bool, len(p.Completed))
+	for _, i := range p.Completed {
+		completed[i] = true
+	}
+	var pending []int64
+	for i := int64(0); i*p.RangeSize < p.Size; i++ {
+		if !completed[i] {
+			pending = append(pending, i)
+		}
+	}
+	return pending
+}
+
+// bounds returns the first and last byte of a range
+func (p *downloadProgress) bounds(i int64) (int64, int64) {
+	start := i * p.RangeSize
+	return start, min(start+p.RangeSize, p.Size) - 1
+}
+
+// getVolumeSize requests the first byte of the volume to learn its size and whether the server supports ranged requests
+func getVolumeSize(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string) (int64, error) {
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, map[string]string{"Range": "bytes=0-0"})
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return 0, fmt.Errorf("the export server of '%s/%s' VirtualMachineExport does not support ranged downloads", vmexport.Namespace, vmexport.Name)
+	}
+	if resp.StatusCode != http.StatusPartialContent {
+		printToOutput("Bad status: %s\n", resp.Status)
+		return 0, errTransient
+	}
+	var start, end, size int64
+	if _, err := fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &size); err != nil {
+		return 0, fmt.Errorf("unable to parse the Content-Range of the volume: %v", err)
+	}
+	return size, nil
+}
+
+// downloadRange writes a range of the volume to its offset in the output file
+func downloadRange(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string, output *os.File, bar *pb.ProgressBar, start, end int64) error {
+	headers := map[string]string{"Range": fmt.Sprintf("bytes=%d-%d", start, end)}
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, headers)
+	if err != nil {
+		printToOutput("Failed to download bytes %d-%d: %v\n", start, end, err)
+		return errTransient
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusPartialContent {
+		printToOutput("Bad status: %s\n", resp.Status)
+		return errTransient
+	}
+	n, err := io.Copy(io.NewOffsetWriter(output, start), bar.NewProxyReader(resp.Body))
+	if err != nil {
+		var pathErr *os.PathError
+		if errors.As(err, &pathErr) {
+			return err
+		}
+		printToOutput("Failed to download bytes %d-%d: %v\n", start, end, err)
+		return errTransient
+	}
+	if n != end-start+1 {
+		printToOutput("Failed to download bytes %d-%d: got %d bytes\n", start, end, n)
+		return errTransient
+	}
+	return nil
+}
+
+// downloadVolumeRanges downloads the raw volume in ranges, several of them in parallel, recording the downloaded
+// ranges so a failed or interrupted download only fetches the missing ones
+func downloadVolumeRanges(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
+	output, ok := vmeInfo.OutputWriter.(*os.File)
+	if !ok || vmeInfo.OutputFile == "" {
+		return false, fmt.Errorf(ErrRequiredFlag, OUTPUT_FLAG, RESUME_FLAG+"/"+PARALLEL_FLAG)
+	}
+	downloadUrl, err := GetFormatUrlFromVirtualMachineExport(vmexport, vmeInfo, exportv1.KubeVirtRaw)
+	if err != nil {
+		return false, err
+	}
+	if downloadUrl == "" {
+		return false, fmt.Errorf("unable to get a raw URL from '%s/%s' VirtualMachineExport, ranged downloads need the raw volume", vmexport.Namespace, vmexport.Name)
+	}
+
+	size, err := getVolumeSize(client, vmexport, vmeInfo, downloadUrl)
+	if errors.Is(err, errTransient) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	progress, err := loadDownloadProgress(vmeInfo.OutputFile, size)
+	if err != nil {
+		return false, err
+	}
+	pending := progress.pending()
+
+	barTemplate := fmt.Sprintf(`{{ "Downloading file:" }} {{counters . }} {{ cycle . %s }} {{speed . }}`, progressBarCycle)
+	bar := pb.ProgressBarTemplate(barTemplate).Start64(size)
+	bar.SetCurrent(size - rangesLength(progress, pending))
+	defer bar.Finish()
+
+	var mutex sync.Mutex
+	group := errgroup.Group{}
+	group.SetLimit(vmeInfo.Parallel)
+	for _, i := range pending {
+		group.Go(func() error {
+			start, end := progress.bounds(i)
+			if err := downloadRange(client, vmexport, vmeInfo, downloadUrl, output, bar, start, end); err != nil {
+				return err
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			progress.Completed = append(progress.Completed, i)
+			return progress.save(vmeInfo.OutputFile)
+		})
+	}
+	err = group.Wait()
+	if errors.Is(err, errTransient) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	// Drop any leftover of a larger file the output replaced
+	if err := output.Truncate(size); err != nil {
+		return false, err
+	}
+	if err := os.Remove(progressFile(vmeInfo.OutputFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	if vmeInfo.VerifyDigest {
+		h := sha256.New()
+		if _, err := io.Copy(h, io.NewSectionReader(output, 0, size)); err != nil {
+			return false, err
+		}
+		if err := verifyDigest(client, vmexport, vmeInfo, h); err != nil {
+			return false, err
+		}
+	}
+
+	printToOutput("Download finished succesfully\n")
+	return true, nil
+}
+
+// rangesLength returns the number of bytes in the given ranges
+func rangesLength(progress *downloadProgress, ranges []int64) int64 {
+	var length int64
+	for _, i := range ranges {
+		start, end := progress.bounds(i)
+		length += end - start + 1
+	}
+	return length
+}
+
+// verifyDigest compares the SHA-256 digest of the downloaded volume with the one published by the export server,
+// volumes without a published digest are not verified
+func verifyDigest(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, h hash.Hash) error {
+	digestUrl, err := GetFormatUrlFromVirtualMachineExport(vmexport, vmeInfo, exportv1.SHA256)
+	if err != nil {
+		return err
+	}
+	if digestUrl == "" {
+		return nil
+	}
+	body, err := getDigest(client, vmexport, vmeInfo, digestUrl)
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(string(body))
+	if len(fields) == 0 {
+		return fmt.Errorf("the digest of the volume is empty")
+	}
+	if digest := hex.EncodeToString(h.Sum(nil)); digest != fields[0] {
+		return fmt.Errorf("the SHA-256 digest of the downloaded volume %s does not match the digest of the exported volume %s", digest, fields[0])
+	}
+	printToOutput("Verified the SHA-256 digest of the volume\n")
+	return nil
+}
+
+// getDigest gets the digest published by the export server, waiting for the server to compute it
+func getDigest(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, digestUrl string) ([]byte, error) {
+	for {
+		resp, err := HandleHTTPGetRequestFn(client, vmexport, digestUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusServiceUnavailable {
+			resp.Body.Close()
+			retryAfter := processingWaitInterval
+			if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+				retryAfter = time.Duration(seconds) * time.Second
+			}
+			printToOutput("Waiting for the export server to compute the digest of the volume\n")
+			time.Sleep(retryAfter)
+			continue
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unable to get the digest of the volume: %s", resp.Status)
+		}
+		return io.ReadAll(resp.Body)
+	}
+}
+
+// newDigestWriter returns a writer which also computes the digest of the downloaded volume when it should be verified
+func newDigestWriter(output io.Writer, vmeInfo *VMExportInfo) (io.Writer, hash.Hash) {
+	if !vmeInfo.VerifyDigest {
+		return output, nil
+	}
+	h := sha256.New()
+	return io.MultiWriter(output, h), h
+}
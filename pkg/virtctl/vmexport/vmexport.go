@@ -24,6 +24,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -84,6 +85,8 @@ const (
 	LABELS_FLAG            = "--labels"
 	ANNOTATIONS_FLAG       = "--annotations"
 	READINESS_TIMEOUT_FLAG = "--readiness-timeout"
+	RESUME_FLAG            = "--resume"
+	PARALLEL_FLAG          = "--parallel"
 
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
@@ -92,6 +95,7 @@ const (
 	// Possible output format for volumes
 	GZIP_FORMAT = "gzip"
 	RAW_FORMAT  = "raw"
+	OVA_FORMAT  = "ova"
 
 	ACCEPT           = "Accept"
 	APPLICATION_YAML = "application/yaml"
@@ -147,6 +151,8 @@ var (
 	resourceLabels       []string
 	resourceAnnotations  []string
 	readinessTimeout     string
+	resume               bool
+	parallel             int
 )
 
 type VMExportInfo struct {
@@ -156,7 +162,11 @@ type VMExportInfo struct {
 	DeleteVme        bool
 	IncludeSecret    bool
 	ExportManifest   bool
+	OVA              bool
 	Decompress       bool
+	VerifyDigest     bool
+	Resume           bool
+	Parallel         int
 	PortForward      bool
 	LocalPort        string
 	OutputFile       string
@@ -218,6 +228,12 @@ func usage() string {
 	# Create a VirtualMachineExport and download the requested volume from it
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --volume=volume1 --output=disk.img.gz
 
+	# Download a raw volume in 4 parallel ranges, resuming an earlier partial download and verifying its SHA-256 digest
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --format=raw --output=disk.img --parallel=4 --resume
+
+	# Create a VirtualMachineExport and download the VirtualMachine along with its disks as an OVA archive
+	{{ProgramName}} vmexport download vm1-export --vm=vm1 --format=ova --output=vm1.ova
+
 	# Create a VirtualMachineExport and get the VirtualMachine manifest in Yaml format
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --manifest
 
@@ -245,7 +261,7 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.MarkFlagsMutuallyExclusive("vm", "snapshot", "pvc")
 	cmd.Flags().StringVar(&outputFile, "output", "", "Specifies the output path of the volume to be downloaded.")
 	cmd.Flags().StringVar(&volumeName, "volume", "", "Specifies the volume to be downloaded.")
-	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There's two options: gzip (default) and raw.")
+	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There's three options: gzip (default), raw and ova, which downloads the VirtualMachine along with its disks as a single archive.")
 	cmd.Flags().BoolVar(&insecure, "insecure", false, "When used with the 'download' option, specifies that the http request should be insecure.")
 	cmd.Flags().BoolVar(&keepVme, "keep-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be retained after the download finishes.")
 	cmd.Flags().BoolVar(&deleteVme, "delete-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be deleted after the download finishes.")
@@ -261,6 +277,8 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.Flags().StringSliceVar(&resourceLabels, "labels", nil, "Specify custom labels to VM export object and its associated pod")
 	cmd.Flags().StringSliceVar(&resourceAnnotations, "annotations", nil, "Specify custom annotations to VM export object and its associated pod")
 	cmd.Flags().StringVar(&readinessTimeout, "readiness-timeout", "", "Specify maximum wait for VM export object to be ready")
+	cmd.Flags().BoolVar(&resume, "resume", false, "When used with the 'download' option and the raw format, continues a partial download of the volume into the output file instead of starting over")
+	cmd.Flags().IntVar(&parallel, "parallel", 1, "When used with the 'download' option and the raw format, the number of ranges of the volume downloaded in parallel")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -336,7 +354,7 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	// User wants the output in a file, create
 	if outputFile != "" && outputFile != "-" {
 		vmeInfo.OutputFile = outputFile
-		output, err := os.Create(vmeInfo.OutputFile)
+		output, err := openOutputFile(vmeInfo.OutputFile, resume)
 		if err != nil {
 			return err
 		}
@@ -354,6 +372,11 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	if format == RAW_FORMAT {
 		vmeInfo.Decompress = true
 	}
+	vmeInfo.OVA = format == OVA_FORMAT
+	// The published digests are those of the raw volumes
+	vmeInfo.VerifyDigest = format == RAW_FORMAT
+	vmeInfo.Resume = resume
+	vmeInfo.Parallel = parallel
 	vmeInfo.DownloadRetries = downloadRetries
 	vmeInfo.ShouldCreate = shouldCreate
 	vmeInfo.Insecure = insecure
@@ -396,6 +419,18 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	return nil
 }
 
+// openOutputFile opens the file the volume is downloaded to, keeping its content and the progress
+// of the ranged download when resuming
+func openOutputFile(outputFile string, resume bool) (*os.File, error) {
+	if resume {
+		return os.OpenFile(outputFile, os.O_RDWR|os.O_CREATE, 0666)
+	}
+	if err := os.Remove(progressFile(outputFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return os.Create(outputFile)
+}
+
 // Convert a slice of "key=value" strings to a map
 func convertSliceToMap(slice []string) map[string]string {
 	mapResult := make(map[string]string)
@@ -581,10 +616,18 @@ func getVirtualMachineManifest(client kubecli.KubevirtClient, vmexport *exportv1
 	return true, nil
 }
 
-// downloadVolume handles the process of downloading the requested volume from a VirtualMachineExport
+// downloadVolume handles the process of downloading the requested volume, or the OVA archive of the VM, from a VirtualMachineExport
 func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
+	if !vmeInfo.OVA && (vmeInfo.Resume || vmeInfo.Parallel > 1) {
+		return downloadVolumeRanges(client, vmexport, vmeInfo)
+	}
+
 	// Extract the URL from the vmexport
-	downloadUrl, err := GetUrlFromVirtualMachineExport(vmexport, vmeInfo)
+	getUrl := GetUrlFromVirtualMachineExport
+	if vmeInfo.OVA {
+		getUrl = GetOVAUrlFromVirtualMachineExport
+	}
+	downloadUrl, err := getUrl(vmexport, vmeInfo)
 	if err != nil {
 		return false, err
 	}
@@ -602,9 +645,15 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 	}
 
 	// Lastly, copy the file to the expected output
-	if err := copyFileWithProgressBar(vmeInfo.OutputWriter, resp, vmeInfo.Decompress); err != nil {
+	output, h := newDigestWriter(vmeInfo.OutputWriter, vmeInfo)
+	if err := copyFileWithProgressBar(output, resp, vmeInfo.Decompress); err != nil {
 		return false, err
 	}
+	if h != nil {
+		if err := verifyDigest(client, vmexport, vmeInfo, h); err != nil {
+			return false, err
+		}
+	}
 
 	printToOutput("Download finished succesfully\n")
 
@@ -629,14 +678,9 @@ func replaceUrlWithServiceUrl(manifestUrl string, vmeInfo *VMExportInfo) (string
 	return manUrl.String(), nil
 }
 
-// GetUrlFromVirtualMachineExport inspects the VirtualMachineExport status to fetch the extected URL
-func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
-	var (
-		downloadUrl string
-		err         error
-		format      exportv1.VirtualMachineExportVolumeFormat
-		links       *exportv1.VirtualMachineExportLink
-	)
+// getExportVolume returns the requested volume from the VirtualMachineExport status, or nil if it isn't found
+func getExportVolume(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (*exportv1.VirtualMachineExportVolume, error) {
+	var links *exportv1.VirtualMachineExportLink
 
 	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
 		links = vmexport.Status.Links.External
@@ -644,28 +688,45 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 		links = vmexport.Status.Links.Internal
 	}
 	if links == nil || len(links.Volumes) <= 0 {
-		return "", fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+		return nil, fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
 	}
 	volumeNumber := len(links.Volumes)
 	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
-		return "", fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+		return nil, fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
 	}
-	for _, exportVolume := range links.Volumes {
+	for i, exportVolume := range links.Volumes {
 		// Access the requested volume
 		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
-			for _, format = range exportVolume.Formats {
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
-					downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
-					if err != nil {
-						return "", err
-					}
-				}
-				// By default, we always attempt to find and get the compressed file URL,
-				// so we only break the loop when one is found.
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
-					break
+			return &links.Volumes[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// GetUrlFromVirtualMachineExport inspects the VirtualMachineExport status to fetch the extected URL
+func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
+	var (
+		downloadUrl string
+		format      exportv1.VirtualMachineExportVolumeFormat
+	)
+
+	exportVolume, err := getExportVolume(vmexport, vmeInfo)
+	if err != nil {
+		return "", err
+	}
+	if exportVolume != nil {
+		for _, format = range exportVolume.Formats {
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
+				downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
+				if err != nil {
+					return "", err
 				}
 			}
+			// By default, we always attempt to find and get the compressed file URL,
+			// so we only break the loop when one is found.
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
+				break
+			}
 		}
 	}
 
@@ -681,6 +742,34 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	return downloadUrl, nil
 }
 
+// GetFormatUrlFromVirtualMachineExport returns the URL of the requested volume in the given format, or an empty string
+// if the volume isn't available in that format
+func GetFormatUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, format exportv1.ExportVolumeFormat) (string, error) {
+	exportVolume, err := getExportVolume(vmexport, vmeInfo)
+	if err != nil || exportVolume == nil {
+		return "", err
+	}
+	for _, f := range exportVolume.Formats {
+		if f.Format == format {
+			return replaceUrlWithServiceUrl(f.Url, vmeInfo)
+		}
+	}
+	return "", nil
+}
+
+// GetOVAUrlFromVirtualMachineExport retrieves the URL of the OVA archive from VirtualMachineExport status
+func GetOVAUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
+	manifestMap, err := GetManifestUrlsFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil {
+		return "", err
+	}
+	ovaUrl, ok := manifestMap[exportv1.OVA]
+	if !ok {
+		return "", fmt.Errorf("unable to get the OVA URL from '%s/%s' VirtualMachineExport, only VirtualMachines can be exported as OVA", vmexport.Namespace, vmexport.Name)
+	}
+	return ovaUrl, nil
+}
+
 // GetManifestUrlsFromVirtualMachineExport retrieves the manifest URLs from VirtualMachineExport status
 func GetManifestUrlsFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (map[exportv1.ExportManifestType]string, error) {
 	res := make(map[exportv1.ExportManifestType]string, 0)
@@ -1005,14 +1094,49 @@ func handleDownloadFlags() error {
 		}
 	}
 
-	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT {
-		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw")
+	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT && format != OVA_FORMAT {
+		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw/ova")
+	}
+
+	if format == OVA_FORMAT {
+		if volumeName != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, FORMAT_FLAG+"="+OVA_FORMAT)
+		}
+		if exportManifest {
+			return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_FLAG, FORMAT_FLAG+"="+OVA_FORMAT)
+		}
+		if pvc != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, PVC_FLAG, FORMAT_FLAG+"="+OVA_FORMAT)
+		}
+		if snapshot != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, SNAPSHOT_FLAG, FORMAT_FLAG+"="+OVA_FORMAT)
+		}
 	}
 
 	if downloadRetries < 0 {
 		return fmt.Errorf(ErrInvalidValue, RETRY_FLAG, "positive integers")
 	}
 
+	if parallel < 1 {
+		return fmt.Errorf(ErrInvalidValue, PARALLEL_FLAG, "positive integers")
+	}
+
+	if resume || parallel > 1 {
+		rangedFlag := RESUME_FLAG
+		if !resume {
+			rangedFlag = PARALLEL_FLAG
+		}
+		if format != RAW_FORMAT {
+			return fmt.Errorf(ErrRequiredFlag, FORMAT_FLAG+"="+RAW_FORMAT, rangedFlag)
+		}
+		if outputFile == "-" {
+			return fmt.Errorf(ErrRequiredFlag, OUTPUT_FLAG+" <FILE>", rangedFlag)
+		}
+		if exportManifest {
+			return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_FLAG, rangedFlag)
+		}
+	}
+
 	if exportManifest {
 		if volumeName != "" {
 			return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, MANIFEST_FLAG)
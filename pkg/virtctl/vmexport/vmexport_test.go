@@ -20,8 +20,12 @@
 package vmexport_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -30,6 +34,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -383,7 +388,14 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'manifest' with volume type", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.VOLUME_FLAG, vmexport.MANIFEST_FLAG), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.VM_FLAG, "test"), setFlag(vmexport.VOLUME_FLAG, "volume")),
 			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.LOCAL_PORT_FLAG, "valid port numbers"), runDownloadCmd, vmexport.PORT_FORWARD_FLAG, setFlag(vmexport.LOCAL_PORT_FLAG, "test")),
-			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw/ova"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'ova' format with volume flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.VOLUME_FLAG, vmexport.FORMAT_FLAG+"="+vmexport.OVA_FORMAT), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, vmexport.OVA_FORMAT), setFlag(vmexport.VOLUME_FLAG, "volume")),
+			Entry("Using 'ova' format with manifest flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.MANIFEST_FLAG, vmexport.FORMAT_FLAG+"="+vmexport.OVA_FORMAT), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, vmexport.OVA_FORMAT), vmexport.MANIFEST_FLAG),
+			Entry("Using 'ova' format with pvc flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.PVC_FLAG, vmexport.FORMAT_FLAG+"="+vmexport.OVA_FORMAT), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, vmexport.OVA_FORMAT), setFlag(vmexport.PVC_FLAG, "test")),
+			Entry("Using 'parallel' with invalid number of ranges", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.PARALLEL_FLAG, "positive integers"), runDownloadCmd, setFlag(vmexport.PARALLEL_FLAG, "0")),
+			Entry("Using 'resume' without raw format", fmt.Sprintf(vmexport.ErrRequiredFlag, vmexport.FORMAT_FLAG+"="+vmexport.RAW_FORMAT, vmexport.RESUME_FLAG), runDownloadCmd, vmexport.RESUME_FLAG),
+			Entry("Using 'parallel' without raw format", fmt.Sprintf(vmexport.ErrRequiredFlag, vmexport.FORMAT_FLAG+"="+vmexport.RAW_FORMAT, vmexport.PARALLEL_FLAG), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, vmexport.GZIP_FORMAT), setFlag(vmexport.PARALLEL_FLAG, "2")),
+			Entry("Using 'resume' with stdout output", fmt.Sprintf(vmexport.ErrRequiredFlag, vmexport.OUTPUT_FLAG+" <FILE>", vmexport.RESUME_FLAG), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT), vmexport.RESUME_FLAG, setFlag(vmexport.OUTPUT_FLAG, "-")),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd),
 		)
 	})
@@ -644,6 +656,276 @@ var _ = Describe("vmexport", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		Context("OVA", func() {
+			const ovaUrl = "/test/ova"
+
+			BeforeEach(func() {
+				vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+					Name: volumeName,
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{{
+						Format: exportv1.KubeVirtGz,
+						Url:    server.URL,
+					}}},
+				})
+				_, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should download the VirtualMachine as a single OVA archive", func() {
+				data := []byte("ova archive")
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Expect(r.URL.String()).To(Equal(ovaUrl))
+					_, err := w.Write(data)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				vme.Status.Links.External.Manifests = append(vme.Status.Links.External.Manifests,
+					exportv1.VirtualMachineExportManifest{
+						Type: exportv1.OVA,
+						Url:  server.URL + ovaUrl,
+					},
+				)
+				_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.OVA_FORMAT),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+					vmexport.INSECURE_FLAG,
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				output, err := os.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output).To(Equal(data))
+			})
+
+			It("should fail when the VirtualMachineExport has no OVA link", func() {
+				vme.Status.Links.External.Manifests = append(vme.Status.Links.External.Manifests,
+					exportv1.VirtualMachineExportManifest{
+						Type: exportv1.AllManifests,
+						Url:  server.URL + "/test/all",
+					},
+				)
+				_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.OVA_FORMAT),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+					vmexport.INSECURE_FLAG,
+				)
+				Expect(err).To(MatchError(ContainSubstring("only VirtualMachines can be exported as OVA")))
+			})
+		})
+
+		Context("Ranged download", func() {
+			const (
+				rawPath    = "/volumes/test-pvc/disk.img"
+				gzipPath   = "/volumes/test-pvc/disk.img.gz"
+				digestPath = "/volumes/test-pvc/disk.img.sha256"
+				rangeSize  = 16
+			)
+
+			var (
+				data      []byte
+				digest    string
+				mutex     sync.Mutex
+				requested []string
+			)
+
+			BeforeEach(func() {
+				data = make([]byte, 5*rangeSize+7)
+				_, err := cryptorand.Read(data)
+				Expect(err).ToNot(HaveOccurred())
+				sum := sha256.Sum256(data)
+				digest = hex.EncodeToString(sum[:])
+
+				requested = nil
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Path {
+					case rawPath:
+						mutex.Lock()
+						requested = append(requested, r.Header.Get("Range"))
+						mutex.Unlock()
+						http.ServeContent(w, r, "disk.img", time.Time{}, bytes.NewReader(data))
+					case gzipPath:
+						gzipWriter := gzip.NewWriter(w)
+						_, err := gzipWriter.Write(data)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(gzipWriter.Close()).To(Succeed())
+					case digestPath:
+						fmt.Fprintf(w, "%s  disk.img\n", digest)
+					default:
+						w.WriteHeader(http.StatusNotFound)
+					}
+				})
+
+				origRangeSize := vmexport.RangeSize
+				vmexport.RangeSize = rangeSize
+				DeferCleanup(func() {
+					vmexport.RangeSize = origRangeSize
+				})
+
+				vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+					Name: volumeName,
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{
+						{
+							Format: exportv1.KubeVirtRaw,
+							Url:    server.URL + rawPath,
+						},
+						{
+							Format: exportv1.KubeVirtGz,
+							Url:    server.URL + gzipPath,
+						},
+						{
+							Format: exportv1.SHA256,
+							Url:    server.URL + digestPath,
+						},
+					}},
+				})
+				_, err = virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should download the ranges of the volume in parallel and verify its digest", func() {
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					setFlag(vmexport.PARALLEL_FLAG, "3"),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				output, err := os.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output).To(Equal(data))
+				Expect(requested).To(ConsistOf(
+					"bytes=0-0", "bytes=0-15", "bytes=16-31", "bytes=32-47", "bytes=48-63", "bytes=64-79", "bytes=80-86",
+				))
+				Expect(outputPath + ".progress").ToNot(BeAnExistingFile())
+			})
+
+			It("should resume from the ranges recorded by an earlier download", func() {
+				Expect(os.WriteFile(outputPath, append(make([]byte, 2*rangeSize), data[2*rangeSize:4*rangeSize]...), 0600)).To(Succeed())
+				progress := fmt.Sprintf(`{"size":%d,"rangeSize":%d,"completed":[2,3]}`, len(data), rangeSize)
+				Expect(os.WriteFile(outputPath+".progress", []byte(progress), 0600)).To(Succeed())
+
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					vmexport.RESUME_FLAG,
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				output, err := os.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output).To(Equal(data))
+				Expect(requested).To(ConsistOf("bytes=0-0", "bytes=0-15", "bytes=16-31", "bytes=64-79", "bytes=80-86"))
+			})
+
+			It("should start over when resuming without recorded progress", func() {
+				Expect(os.WriteFile(outputPath, make([]byte, 3*rangeSize+5), 0600)).To(Succeed())
+
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					vmexport.RESUME_FLAG,
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				output, err := os.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output).To(Equal(data))
+				Expect(requested).To(HaveLen(7))
+			})
+
+			It("should start over without resume", func() {
+				progress := fmt.Sprintf(`{"size":%d,"rangeSize":%d,"completed":[0,1,2,3,4]}`, len(data), rangeSize)
+				Expect(os.WriteFile(outputPath+".progress", []byte(progress), 0600)).To(Succeed())
+
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					setFlag(vmexport.PARALLEL_FLAG, "2"),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				output, err := os.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output).To(Equal(data))
+				Expect(requested).To(HaveLen(7))
+			})
+
+			It("should fail when the digest of the downloaded volume does not match", func() {
+				digest = strings.Repeat("0", 64)
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					setFlag(vmexport.PARALLEL_FLAG, "2"),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				)
+				Expect(err).To(MatchError(ContainSubstring("does not match the digest of the exported volume")))
+			})
+
+			It("should wait for the export server to compute the digest", func() {
+				handler := server.Config.Handler
+				digestRequests := 0
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path == digestPath {
+						digestRequests++
+						if digestRequests == 1 {
+							w.Header().Set("Retry-After", "0")
+							w.WriteHeader(http.StatusServiceUnavailable)
+							return
+						}
+					}
+					handler.ServeHTTP(w, r)
+				})
+
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					setFlag(vmexport.PARALLEL_FLAG, "2"),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(digestRequests).To(Equal(2))
+			})
+
+			It("should verify the digest of a volume downloaded in a single stream", func() {
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					setFlag(vmexport.VOLUME_FLAG, volumeName),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				output, err := os.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output).To(Equal(data))
+
+				digest = strings.Repeat("0", 64)
+				err = runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					setFlag(vmexport.VOLUME_FLAG, volumeName),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				)
+				Expect(err).To(MatchError(ContainSubstring("does not match the digest of the exported volume")))
+			})
+
+			It("should fail when the export server does not support ranged downloads", func() {
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					_, err := w.Write(data)
+					Expect(err).ToNot(HaveOccurred())
+				})
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					vmexport.RESUME_FLAG,
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				)
+				Expect(err).To(MatchError(ContainSubstring("does not support ranged downloads")))
+			})
+		})
+
 		It("Succesfully create VirtualMachineExport with TTL", func() {
 			ttl := metav1.Duration{Duration: 2 * time.Minute}
 			err := runCreateCmd(
@@ -23,9 +23,14 @@ import (
 	"fmt"
 	"path/filepath"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
+// HotplugFilesystemLabel holds the name of the hotplugged filesystem served by an attachment pod
+const HotplugFilesystemLabel = "kubevirt.io/hotplug-filesystem"
+
 // This is empty dir
 var VirtioFSContainers = "virtiofs-containers"
 var VirtioFSContainersMountBaseDir = filepath.Join(util.VirtShareDir, VirtioFSContainers)
@@ -34,3 +39,14 @@ func VirtioFSSocketPath(volumeName string) string {
 	socketName := fmt.Sprintf("%s.sock", volumeName)
 	return filepath.Join(VirtioFSContainersMountBaseDir, socketName)
 }
+
+// HotplugVirtioFSSocketName returns the name of the virtiofsd socket of a hotplugged filesystem.
+// The socket is created in the hotplug-disks directory of the attachment pod and bind mounted
+// by virt-handler into the hotplug-disks directory of virt-launcher.
+func HotplugVirtioFSSocketName(volumeName string) string {
+	return fmt.Sprintf("%s.sock", volumeName)
+}
+
+func HotplugVirtioFSSocketPath(volumeName string) string {
+	return filepath.Join(v1.HotplugDiskDir, HotplugVirtioFSSocketName(volumeName))
+}
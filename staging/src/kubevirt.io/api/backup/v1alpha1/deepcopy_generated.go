@@ -24,7 +24,10 @@ Copyright The KubeVirt Authors.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -36,8 +39,10 @@ func (in *BackupCheckpoint) DeepCopyInto(out *BackupCheckpoint) {
 	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
-		*out = make([]BackupVolumeInfo, len(*in))
-		copy(*out, *in)
+		*out = make([]v1.BackupVolumeInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -52,6 +57,96 @@ func (in *BackupCheckpoint) DeepCopy() *BackupCheckpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupConcurrencyPolicy) DeepCopyInto(out *BackupConcurrencyPolicy) {
+	*out = *in
+	if in.MaxPerNode != nil {
+		in, out := &in.MaxPerNode, &out.MaxPerNode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPerStorageClass != nil {
+		in, out := &in.MaxPerStorageClass, &out.MaxPerStorageClass
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupConcurrencyPolicy.
+func (in *BackupConcurrencyPolicy) DeepCopy() *BackupConcurrencyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupConcurrencyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupExport) DeepCopyInto(out *BackupExport) {
+	*out = *in
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]BackupExportVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupExport.
+func (in *BackupExport) DeepCopy() *BackupExport {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupExportExtent) DeepCopyInto(out *BackupExportExtent) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupExportExtent.
+func (in *BackupExportExtent) DeepCopy() *BackupExportExtent {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupExportExtent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupExportVolume) DeepCopyInto(out *BackupExportVolume) {
+	*out = *in
+	if in.DirtyBitmap != nil {
+		in, out := &in.DirtyBitmap, &out.DirtyBitmap
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupExportVolume.
+func (in *BackupExportVolume) DeepCopy() *BackupExportVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupExportVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupOptions) DeepCopyInto(out *BackupOptions) {
 	*out = *in
@@ -83,17 +178,77 @@ func (in *BackupOptions) DeepCopy() *BackupOptions {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackupVolumeInfo) DeepCopyInto(out *BackupVolumeInfo) {
+func (in *BackupRestoreVolumeStatus) DeepCopyInto(out *BackupRestoreVolumeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRestoreVolumeStatus.
+func (in *BackupRestoreVolumeStatus) DeepCopy() *BackupRestoreVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRestoreVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetentionPolicy) DeepCopyInto(out *BackupRetentionPolicy) {
+	*out = *in
+	if in.MaxChainLength != nil {
+		in, out := &in.MaxChainLength, &out.MaxChainLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxIncrementals != nil {
+		in, out := &in.MaxIncrementals, &out.MaxIncrementals
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FullBackupInterval != nil {
+		in, out := &in.FullBackupInterval, &out.FullBackupInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetentionPolicy.
+func (in *BackupRetentionPolicy) DeepCopy() *BackupRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleSourceStatus) DeepCopyInto(out *BackupScheduleSourceStatus) {
 	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeInfo.
-func (in *BackupVolumeInfo) DeepCopy() *BackupVolumeInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleSourceStatus.
+func (in *BackupScheduleSourceStatus) DeepCopy() *BackupScheduleSourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BackupVolumeInfo)
+	out := new(BackupScheduleSourceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -181,6 +336,295 @@ func (in *VirtualMachineBackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupRestore) DeepCopyInto(out *VirtualMachineBackupRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineBackupRestoreStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupRestore.
+func (in *VirtualMachineBackupRestore) DeepCopy() *VirtualMachineBackupRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineBackupRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupRestoreList) DeepCopyInto(out *VirtualMachineBackupRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineBackupRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupRestoreList.
+func (in *VirtualMachineBackupRestoreList) DeepCopy() *VirtualMachineBackupRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineBackupRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupRestoreSpec) DeepCopyInto(out *VirtualMachineBackupRestoreSpec) {
+	*out = *in
+	in.Target.DeepCopyInto(&out.Target)
+	if in.VolumeName != nil {
+		in, out := &in.VolumeName, &out.VolumeName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupRestoreSpec.
+func (in *VirtualMachineBackupRestoreSpec) DeepCopy() *VirtualMachineBackupRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupRestoreStatus) DeepCopyInto(out *VirtualMachineBackupRestoreStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]BackupRestoreVolumeStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupRestoreStatus.
+func (in *VirtualMachineBackupRestoreStatus) DeepCopy() *VirtualMachineBackupRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupSchedule) DeepCopyInto(out *VirtualMachineBackupSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineBackupScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupSchedule.
+func (in *VirtualMachineBackupSchedule) DeepCopy() *VirtualMachineBackupSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineBackupSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupScheduleList) DeepCopyInto(out *VirtualMachineBackupScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineBackupSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupScheduleList.
+func (in *VirtualMachineBackupScheduleList) DeepCopy() *VirtualMachineBackupScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineBackupScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupScheduleSpec) DeepCopyInto(out *VirtualMachineBackupScheduleSpec) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(BackupConcurrencyPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupScheduleSpec.
+func (in *VirtualMachineBackupScheduleSpec) DeepCopy() *VirtualMachineBackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupScheduleStatus) DeepCopyInto(out *VirtualMachineBackupScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]BackupScheduleSourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupScheduleStatus.
+func (in *VirtualMachineBackupScheduleStatus) DeepCopy() *VirtualMachineBackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupScheduleTemplate) DeepCopyInto(out *VirtualMachineBackupScheduleTemplate) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(BackupMode)
+		**out = **in
+	}
+	if in.PvcName != nil {
+		in, out := &in.PvcName, &out.PvcName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTLDuration != nil {
+		in, out := &in.TTLDuration, &out.TTLDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(v1.FreezeHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupScheduleTemplate.
+func (in *VirtualMachineBackupScheduleTemplate) DeepCopy() *VirtualMachineBackupScheduleTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupScheduleTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineBackupSpec) DeepCopyInto(out *VirtualMachineBackupSpec) {
 	*out = *in
@@ -195,6 +639,16 @@ func (in *VirtualMachineBackupSpec) DeepCopyInto(out *VirtualMachineBackupSpec)
 		*out = new(string)
 		**out = **in
 	}
+	if in.TTLDuration != nil {
+		in, out := &in.TTLDuration, &out.TTLDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(v1.FreezeHooks)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -225,7 +679,29 @@ func (in *VirtualMachineBackupStatus) DeepCopyInto(out *VirtualMachineBackupStat
 	}
 	if in.IncludedVolumes != nil {
 		in, out := &in.IncludedVolumes, &out.IncludedVolumes
-		*out = make([]BackupVolumeInfo, len(*in))
+		*out = make([]v1.BackupVolumeInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(BackupExport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BaseCheckpointName != nil {
+		in, out := &in.BaseCheckpointName, &out.BaseCheckpointName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualMachine != nil {
+		in, out := &in.VirtualMachine, &out.VirtualMachine
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FreezeHooks != nil {
+		in, out := &in.FreezeHooks, &out.FreezeHooks
+		*out = make([]v1.FreezeHookResult, len(*in))
 		copy(*out, *in)
 	}
 	return
@@ -310,6 +786,11 @@ func (in *VirtualMachineBackupTrackerList) DeepCopyObject() runtime.Object {
 func (in *VirtualMachineBackupTrackerSpec) DeepCopyInto(out *VirtualMachineBackupTrackerSpec) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -336,6 +817,13 @@ func (in *VirtualMachineBackupTrackerStatus) DeepCopyInto(out *VirtualMachineBac
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Checkpoints != nil {
+		in, out := &in.Checkpoints, &out.Checkpoints
+		*out = make([]BackupCheckpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -32,8 +32,10 @@ var SchemeGroupVersion = schema.GroupVersion{Group: backup.GroupName, Version: "
 
 var (
 	// GroupVersionKind
-	VirtualMachineBackupGroupVersionKind        = schema.GroupVersionKind{Group: backup.GroupName, Version: SchemeGroupVersion.Version, Kind: "VirtualMachineBackup"}
-	VirtualMachineBackupTrackerGroupVersionKind = schema.GroupVersionKind{Group: backup.GroupName, Version: SchemeGroupVersion.Version, Kind: "VirtualMachineBackupTracker"}
+	VirtualMachineBackupGroupVersionKind         = schema.GroupVersionKind{Group: backup.GroupName, Version: SchemeGroupVersion.Version, Kind: "VirtualMachineBackup"}
+	VirtualMachineBackupTrackerGroupVersionKind  = schema.GroupVersionKind{Group: backup.GroupName, Version: SchemeGroupVersion.Version, Kind: "VirtualMachineBackupTracker"}
+	VirtualMachineBackupScheduleGroupVersionKind = schema.GroupVersionKind{Group: backup.GroupName, Version: SchemeGroupVersion.Version, Kind: "VirtualMachineBackupSchedule"}
+	VirtualMachineBackupRestoreGroupVersionKind  = schema.GroupVersionKind{Group: backup.GroupName, Version: SchemeGroupVersion.Version, Kind: "VirtualMachineBackupRestore"}
 )
 
 // Kind takes an unqualified kind and returns back a Group qualified GroupKind
@@ -60,6 +62,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VirtualMachineBackupList{},
 		&VirtualMachineBackupTracker{},
 		&VirtualMachineBackupTrackerList{},
+		&VirtualMachineBackupSchedule{},
+		&VirtualMachineBackupScheduleList{},
+		&VirtualMachineBackupRestore{},
+		&VirtualMachineBackupRestoreList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -22,6 +22,9 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // BackupMode is the const type for the backup possible modes
@@ -31,23 +34,24 @@ const (
 	// PushMode defines backup which pushes the backup output
 	// to a provided PVC - this is the default behavior
 	PushMode BackupMode = "Push"
+	// PullMode defines backup which exposes the backup output
+	// through an NBD export that is read by the backup application
+	PullMode BackupMode = "Pull"
 )
 
-// BackupVolumeInfo contains information about a volume included in a backup
-type BackupVolumeInfo struct {
-	// VolumeName is the volume name from VMI spec
-	VolumeName string `json:"volumeName"`
-	// DiskTarget is the disk target device name at backup time
-	DiskTarget string `json:"diskTarget"`
-}
-
 type BackupCheckpoint struct {
 	Name         string       `json:"name,omitempty"`
 	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+	// BackupName is the name of the VirtualMachineBackup that created the checkpoint
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+	// Type is the type of the backup that created the checkpoint
+	// +optional
+	Type BackupType `json:"type,omitempty"`
 	// Volumes lists volumes and their disk targets at backup time
 	// +optional
 	// +listType=atomic
-	Volumes []BackupVolumeInfo `json:"volumes,omitempty"`
+	Volumes []v1.BackupVolumeInfo `json:"volumes,omitempty"`
 }
 
 // BackupType is the const type for the backup possible types
@@ -80,6 +84,14 @@ type BackupOptions struct {
 	SkipQuiesce     bool         `json:"skipQuiesce,omitempty"`
 }
 
+// BackupExportExtent describes a range of a pull mode backup export
+type BackupExportExtent struct {
+	Offset      uint64 `json:"offset"`
+	Length      uint64 `json:"length"`
+	Flags       uint64 `json:"flags"`
+	Description string `json:"description,omitempty"`
+}
+
 // VirtualMachineBackupTracker defines the way to track the latest checkpoint of
 // a backup solution for a vm
 // +k8s:openapi-gen=true
@@ -103,6 +115,36 @@ type VirtualMachineBackupTrackerSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self.kind == 'VirtualMachine'",message="kind must be VirtualMachine"
 	// +kubebuilder:validation:XValidation:rule="self.name != ''",message="name is required"
 	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// RetentionPolicy limits the checkpoint chain tracked for the VM.
+	// When a backup completes, checkpoints falling out of the policy are
+	// deleted together with the VirtualMachineBackups that created them.
+	// +optional
+	RetentionPolicy *BackupRetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+// BackupRetentionPolicy defines how long checkpoints are kept and when a full
+// backup is forced on a VirtualMachineBackupTracker
+type BackupRetentionPolicy struct {
+	// MaxChainLength is the maximum number of checkpoints kept in the chain.
+	// Checkpoints are pruned a whole chain at a time, a full checkpoint is
+	// only removed with its incrementals once a newer full checkpoint exists.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxChainLength *int32 `json:"maxChainLength,omitempty"`
+	// MaxIncrementals forces a full backup once this many incremental backups
+	// were taken since the last full backup
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxIncrementals *int32 `json:"maxIncrementals,omitempty"`
+	// FullBackupInterval forces a full backup once the last full backup is
+	// older than the interval
+	// +optional
+	FullBackupInterval *metav1.Duration `json:"fullBackupInterval,omitempty"`
+	// MaxAge is the maximum age of a checkpoint in the chain. Like for
+	// MaxChainLength, expired checkpoints are pruned a whole chain at a time.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
 }
 
 type VirtualMachineBackupTrackerStatus struct {
@@ -116,6 +158,12 @@ type VirtualMachineBackupTrackerStatus struct {
 	// restarts and has a checkpoint that needs to be redefined in libvirt.
 	// virt-controller will process this flag, attempt redefinition, and clear it.
 	CheckpointRedefinitionRequired *bool `json:"checkpointRedefinitionRequired,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	// Checkpoints is the history of the checkpoints kept in the chain,
+	// ordered from the oldest to the latest
+	Checkpoints []BackupCheckpoint `json:"checkpoints,omitempty"`
 }
 
 // VirtualMachineBackupTrackerList is a list of VirtualMachineBackupTracker resources
@@ -127,6 +175,142 @@ type VirtualMachineBackupTrackerList struct {
 	Items []VirtualMachineBackupTracker `json:"items"`
 }
 
+// VirtualMachineBackupSchedule defines a schedule to periodically back up VMs
+// +k8s:openapi-gen=true
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupScheduleSpec is the spec for a VirtualMachineBackupSchedule resource
+// +kubebuilder:validation:XValidation:rule="has(self.source) != has(self.selector)",message="exactly one of source or selector must be provided"
+// +kubebuilder:validation:XValidation:rule="!has(self.selector) || !has(self.template.pvcName)",message="pvcName is not supported with a selector"
+type VirtualMachineBackupScheduleSpec struct {
+	// Schedule is a cron expression in the standard five field format, or one
+	// of the @hourly, @daily, @weekly, @monthly and @yearly macros, evaluated in UTC
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// +optional
+	// Source specifies the VM that is backed up
+	// +kubebuilder:validation:XValidation:rule="has(self.apiGroup) && self.apiGroup == 'kubevirt.io'",message="apiGroup must be kubevirt.io"
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'VirtualMachine'",message="kind must be VirtualMachine"
+	// +kubebuilder:validation:XValidation:rule="self.name != ''",message="name is required"
+	Source *corev1.TypedLocalObjectReference `json:"source,omitempty"`
+	// +optional
+	// Selector selects the VMs that are backed up in the namespace of the schedule
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Template describes the VirtualMachineBackups created by the schedule
+	Template VirtualMachineBackupScheduleTemplate `json:"template"`
+	// +optional
+	// RetentionPolicy is set on the VirtualMachineBackupTrackers owned by the schedule
+	RetentionPolicy *BackupRetentionPolicy `json:"retentionPolicy,omitempty"`
+	// +optional
+	// Concurrency limits the number of backups running at the same time
+	Concurrency *BackupConcurrencyPolicy `json:"concurrency,omitempty"`
+	// +optional
+	// Suspend stops the schedule from creating new backups
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// VirtualMachineBackupScheduleTemplate describes the backups created by a schedule
+// +kubebuilder:validation:XValidation:rule="(has(self.mode) && self.mode != 'Push') || (has(self.pvcName) && self.pvcName != \"\")",message="pvcName must be provided when mode is unset or Push"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'Pull' || !has(self.pvcName)",message="pvcName is not supported when mode is Pull"
+// +kubebuilder:validation:XValidation:rule="!has(self.ttlDuration) || (has(self.mode) && self.mode == 'Pull')",message="ttlDuration is only supported when mode is Pull"
+type VirtualMachineBackupScheduleTemplate struct {
+	// +optional
+	// +kubebuilder:validation:Enum=Push;Pull
+	// Mode specifies the way the backup output will be recieved
+	Mode *BackupMode `json:"mode,omitempty"`
+	// +optional
+	// PvcName required in push mode. Specifies the name of the PVC
+	// where the backup output will be stored. Push mode is not supported
+	// when the schedule selects its VMs with Selector.
+	PvcName *string `json:"pvcName,omitempty"`
+	// +optional
+	// SkipQuiesce indicates whether the VM's filesystem shoule not be quiesced before the backup
+	SkipQuiesce bool `json:"skipQuiesce,omitempty"`
+	// +optional
+	// TTLDuration limits how long the export of a pull mode backup stays available
+	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+	// +optional
+	// Hooks run around the freeze of the guest filesystem, they are
+	// skipped when SkipQuiesce is set
+	Hooks *v1.FreezeHooks `json:"hooks,omitempty"`
+}
+
+// BackupConcurrencyPolicy limits the number of backups running at the same time.
+// All the running VirtualMachineBackups in the cluster are accounted for.
+type BackupConcurrencyPolicy struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// MaxPerNode is the maximum number of backups running on the same node
+	MaxPerNode *int32 `json:"maxPerNode,omitempty"`
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// MaxPerStorageClass is the maximum number of backups running on VMs
+	// with volumes of the same storage class
+	MaxPerStorageClass *int32 `json:"maxPerStorageClass,omitempty"`
+}
+
+// VirtualMachineBackupScheduleStatus is the status for a VirtualMachineBackupSchedule resource
+type VirtualMachineBackupScheduleStatus struct {
+	// +optional
+	// LastScheduleTime is the last time a backup was scheduled
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// +optional
+	// NextScheduleTime is the next time a backup will be scheduled
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// +optional
+	// +listType=atomic
+	// Sources reports the state of the schedule for each VM it backs up
+	Sources []BackupScheduleSourceStatus `json:"sources,omitempty"`
+}
+
+// BackupScheduleSourceStatus reports the state of a schedule for a single VM
+type BackupScheduleSourceStatus struct {
+	// VMName is the name of the VM
+	VMName string `json:"vmName"`
+	// +optional
+	// TrackerName is the name of the VirtualMachineBackupTracker owned by
+	// the schedule to track the checkpoints of the VM
+	TrackerName string `json:"trackerName,omitempty"`
+	// +optional
+	// LastScheduleTime is the last time a backup of the VM was scheduled
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// +optional
+	// LastBackupName is the name of the last backup created for the VM
+	LastBackupName string `json:"lastBackupName,omitempty"`
+	// +optional
+	// LastSuccessfulTime is the last time a backup of the VM completed successfully
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// +optional
+	// ConsecutiveFailures is the number of backups of the VM that failed
+	// since the last successful one
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+	// +optional
+	// LastFailure is the reason of the last failed backup of the VM
+	LastFailure string `json:"lastFailure,omitempty"`
+	// +optional
+	// Message explains why a due backup of the VM was not created yet
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineBackupScheduleList is a list of VirtualMachineBackupSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	// +listType=atomic
+	Items []VirtualMachineBackupSchedule `json:"items"`
+}
+
 // VirtualMachineBackup defines the operation of backing up a VM
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -153,6 +337,8 @@ type VirtualMachineBackupList struct {
 // VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource
 // +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable after creation"
 // +kubebuilder:validation:XValidation:rule="(has(self.mode) && self.mode != 'Push') || (has(self.pvcName) && self.pvcName != \"\")",message="pvcName must be provided when mode is unset or Push"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'Pull' || !has(self.pvcName)",message="pvcName is not supported when mode is Pull"
+// +kubebuilder:validation:XValidation:rule="!has(self.ttlDuration) || (has(self.mode) && self.mode == 'Pull')",message="ttlDuration is only supported when mode is Pull"
 type VirtualMachineBackupSpec struct {
 	// Source specifies the backup source - either a VirtualMachine or a VirtualMachineBackupTracker.
 	// When Kind is VirtualMachine: performs a backup of the specified VM.
@@ -165,7 +351,7 @@ type VirtualMachineBackupSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self.name != ''",message="name is required"
 	Source corev1.TypedLocalObjectReference `json:"source"`
 	// +optional
-	// +kubebuilder:validation:Enum=Push
+	// +kubebuilder:validation:Enum=Push;Pull
 	// Mode specifies the way the backup output will be recieved
 	Mode *BackupMode `json:"mode,omitempty"`
 	// +optional
@@ -178,6 +364,16 @@ type VirtualMachineBackupSpec struct {
 	// +optional
 	// ForceFullBackup indicates that a full backup is desired
 	ForceFullBackup bool `json:"forceFullBackup,omitempty"`
+	// +optional
+	// TTLDuration is used in pull mode only. It limits how long the backup
+	// export stays available, the export is removed once it expires or when
+	// the backup is deleted, whichever comes first.
+	// If not set the export stays available until the backup is deleted.
+	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+	// +optional
+	// Hooks run around the freeze of the guest filesystem, they are
+	// skipped when SkipQuiesce is set
+	Hooks *v1.FreezeHooks `json:"hooks,omitempty"`
 }
 
 // VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource
@@ -194,7 +390,130 @@ type VirtualMachineBackupStatus struct {
 	// +optional
 	// +listType=atomic
 	// IncludedVolumes lists the volumes that were included in the backup
-	IncludedVolumes []BackupVolumeInfo `json:"includedVolumes,omitempty"`
+	IncludedVolumes []v1.BackupVolumeInfo `json:"includedVolumes,omitempty"`
+	// +optional
+	// Export contains the information needed to read the backup
+	// output of a pull mode backup
+	Export *BackupExport `json:"export,omitempty"`
+	// +optional
+	// BaseCheckpointName is the checkpoint an incremental backup is based on
+	BaseCheckpointName *string `json:"baseCheckpointName,omitempty"`
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// VirtualMachine is the definition of the source VirtualMachine,
+	// captured when the backup started
+	VirtualMachine *runtime.RawExtension `json:"virtualMachine,omitempty"`
+	// +optional
+	// +listType=atomic
+	// FreezeHooks lists the outcome of the hooks which ran around the freeze
+	// of the guest filesystem
+	FreezeHooks []v1.FreezeHookResult `json:"freezeHooks,omitempty"`
+}
+
+// BackupExport contains the information needed to read a pull mode backup
+type BackupExport struct {
+	// Endpoint is the path of the backup export, relative to the Kubernetes
+	// API server. The extents of an exported volume are listed with a GET
+	// request to <endpoint>/map and its data is read with a GET request to
+	// <endpoint>/read. Requests are authenticated and authorized by the API
+	// server, and travel over TLS all the way to the node that runs the VM.
+	Endpoint string `json:"endpoint"`
+	// +optional
+	// ExpirationTime is the time at which the export will be removed
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+	// +optional
+	// +listType=atomic
+	// Volumes lists the exported volumes
+	Volumes []BackupExportVolume `json:"volumes,omitempty"`
+}
+
+// BackupExportVolume describes the NBD export of a single volume
+type BackupExportVolume struct {
+	// VolumeName is the volume name from VMI spec
+	VolumeName string `json:"volumeName"`
+	// ExportName is the NBD export name to read the volume data from
+	ExportName string `json:"exportName"`
+	// +optional
+	// DirtyBitmap is the name of the dirty bitmap that holds the blocks
+	// changed since the base checkpoint, only set for incremental backups
+	DirtyBitmap *string `json:"dirtyBitmap,omitempty"`
+}
+
+// VirtualMachineBackupRestore restores a VM, or a single volume of a VM,
+// from the push mode backups of a checkpoint chain
+// +k8s:openapi-gen=true
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupRestoreSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupRestoreStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupRestoreList is a list of VirtualMachineBackupRestore resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachineBackupRestore `json:"items"`
+}
+
+// VirtualMachineBackupRestoreSpec is the spec for a VirtualMachineBackupRestore resource
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable after creation"
+type VirtualMachineBackupRestoreSpec struct {
+	// Target is the VirtualMachine to restore. Unless VolumeName is set, the
+	// VirtualMachine must not exist and is recreated from the definition
+	// captured at backup time.
+	// +kubebuilder:validation:XValidation:rule="has(self.apiGroup) && self.apiGroup == 'kubevirt.io'",message="apiGroup must be kubevirt.io"
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'VirtualMachine'",message="kind must be VirtualMachine"
+	// +kubebuilder:validation:XValidation:rule="self.name != ''",message="name is required"
+	Target corev1.TypedLocalObjectReference `json:"target"`
+
+	// CheckpointName is the checkpoint to restore. The disks are rebuilt
+	// from the full backup of its chain and the incremental backups up to
+	// the checkpoint.
+	// +kubebuilder:validation:MinLength=1
+	CheckpointName string `json:"checkpointName"`
+
+	// VolumeName restores only this volume, into the existing target
+	// VirtualMachine which must be stopped
+	// +optional
+	VolumeName *string `json:"volumeName,omitempty"`
+}
+
+// VirtualMachineBackupRestoreStatus is the status for a VirtualMachineBackupRestore resource
+type VirtualMachineBackupRestoreStatus struct {
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+	// +optional
+	// +listType=atomic
+	// Backups lists the VirtualMachineBackups of the restored chain,
+	// starting with the full backup
+	Backups []string `json:"backups,omitempty"`
+	// +optional
+	// +listType=atomic
+	// Volumes lists the restored volumes
+	Volumes []BackupRestoreVolumeStatus `json:"volumes,omitempty"`
+}
+
+// BackupRestoreVolumeStatus describes the restore of a single volume
+type BackupRestoreVolumeStatus struct {
+	// VolumeName is the volume name from the VM spec
+	VolumeName string `json:"volumeName"`
+	// ClaimName is the name of the PVC the volume is restored into
+	ClaimName string `json:"claimName"`
+	// +optional
+	// Completed indicates the data of the volume was restored
+	Completed bool `json:"completed,omitempty"`
 }
 
 // ConditionType is the const type for Conditions
@@ -215,6 +534,9 @@ const (
 
 	// ConditionAborting indicates the backup is aborting
 	ConditionAborting ConditionType = "Aborting"
+
+	// ConditionFailure indicates the backup has failed
+	ConditionFailure ConditionType = "Failure"
 )
 
 // Condition defines conditions
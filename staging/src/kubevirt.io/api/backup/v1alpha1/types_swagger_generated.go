@@ -2,23 +2,23 @@
 
 package v1alpha1
 
-func (BackupVolumeInfo) SwaggerDoc() map[string]string {
+func (BackupCheckpoint) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":           "BackupVolumeInfo contains information about a volume included in a backup",
-		"volumeName": "VolumeName is the volume name from VMI spec",
-		"diskTarget": "DiskTarget is the disk target device name at backup time",
+		"backupName": "BackupName is the name of the VirtualMachineBackup that created the checkpoint\n+optional",
+		"type":       "Type is the type of the backup that created the checkpoint\n+optional",
+		"volumes":    "Volumes lists volumes and their disk targets at backup time\n+optional\n+listType=atomic",
 	}
 }
 
-func (BackupCheckpoint) SwaggerDoc() map[string]string {
+func (BackupOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"volumes": "Volumes lists volumes and their disk targets at backup time\n+optional\n+listType=atomic",
+		"": "BackupOptions are options used to configure virtual machine backup job",
 	}
 }
 
-func (BackupOptions) SwaggerDoc() map[string]string {
+func (BackupExportExtent) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "BackupOptions are options used to configure virtual machine backup job",
+		"": "BackupExportExtent describes a range of a pull mode backup export",
 	}
 }
 
@@ -31,8 +31,19 @@ func (VirtualMachineBackupTracker) SwaggerDoc() map[string]string {
 
 func (VirtualMachineBackupTrackerSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":       "VirtualMachineBackupTrackerSpec is the spec for a VirtualMachineBackupTracker resource\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"spec is immutable after creation\"",
-		"source": "Source specifies the VM that this backupTracker is associated with\n+kubebuilder:validation:XValidation:rule=\"has(self.apiGroup) && self.apiGroup == 'kubevirt.io'\",message=\"apiGroup must be kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"self.kind == 'VirtualMachine'\",message=\"kind must be VirtualMachine\"\n+kubebuilder:validation:XValidation:rule=\"self.name != ''\",message=\"name is required\"",
+		"":                "VirtualMachineBackupTrackerSpec is the spec for a VirtualMachineBackupTracker resource\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"spec is immutable after creation\"",
+		"source":          "Source specifies the VM that this backupTracker is associated with\n+kubebuilder:validation:XValidation:rule=\"has(self.apiGroup) && self.apiGroup == 'kubevirt.io'\",message=\"apiGroup must be kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"self.kind == 'VirtualMachine'\",message=\"kind must be VirtualMachine\"\n+kubebuilder:validation:XValidation:rule=\"self.name != ''\",message=\"name is required\"",
+		"retentionPolicy": "RetentionPolicy limits the checkpoint chain tracked for the VM.\nWhen a backup completes, checkpoints falling out of the policy are\ndeleted together with the VirtualMachineBackups that created them.\n+optional",
+	}
+}
+
+func (BackupRetentionPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "BackupRetentionPolicy defines how long checkpoints are kept and when a full\nbackup is forced on a VirtualMachineBackupTracker",
+		"maxChainLength":     "MaxChainLength is the maximum number of checkpoints kept in the chain.\nCheckpoints are pruned a whole chain at a time, a full checkpoint is\nonly removed with its incrementals once a newer full checkpoint exists.\n+optional\n+kubebuilder:validation:Minimum=1",
+		"maxIncrementals":    "MaxIncrementals forces a full backup once this many incremental backups\nwere taken since the last full backup\n+optional\n+kubebuilder:validation:Minimum=1",
+		"fullBackupInterval": "FullBackupInterval forces a full backup once the last full backup is\nolder than the interval\n+optional",
+		"maxAge":             "MaxAge is the maximum age of a checkpoint in the chain. Like for\nMaxChainLength, expired checkpoints are pruned a whole chain at a time.\n+optional",
 	}
 }
 
@@ -40,6 +51,7 @@ func (VirtualMachineBackupTrackerStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"latestCheckpoint":               "+optional\nLatestCheckpoint is the metadata of the checkpoint of\nthe latest performed backup",
 		"checkpointRedefinitionRequired": "+optional\nCheckpointRedefinitionRequired is set to true by virt-handler when the VM\nrestarts and has a checkpoint that needs to be redefined in libvirt.\nvirt-controller will process this flag, attempt redefinition, and clear it.",
+		"checkpoints":                    "+optional\n+listType=atomic\nCheckpoints is the history of the checkpoints kept in the chain,\nordered from the oldest to the latest",
 	}
 }
 
@@ -50,6 +62,75 @@ func (VirtualMachineBackupTrackerList) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineBackupSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackupSchedule defines a schedule to periodically back up VMs\n+k8s:openapi-gen=true\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineBackupScheduleSpec is the spec for a VirtualMachineBackupSchedule resource\n+kubebuilder:validation:XValidation:rule=\"has(self.source) != has(self.selector)\",message=\"exactly one of source or selector must be provided\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.selector) || !has(self.template.pvcName)\",message=\"pvcName is not supported with a selector\"",
+		"schedule":        "Schedule is a cron expression in the standard five field format, or one\nof the @hourly, @daily, @weekly, @monthly and @yearly macros, evaluated in UTC\n+kubebuilder:validation:MinLength=1",
+		"source":          "+optional\nSource specifies the VM that is backed up\n+kubebuilder:validation:XValidation:rule=\"has(self.apiGroup) && self.apiGroup == 'kubevirt.io'\",message=\"apiGroup must be kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"self.kind == 'VirtualMachine'\",message=\"kind must be VirtualMachine\"\n+kubebuilder:validation:XValidation:rule=\"self.name != ''\",message=\"name is required\"",
+		"selector":        "+optional\nSelector selects the VMs that are backed up in the namespace of the schedule",
+		"template":        "Template describes the VirtualMachineBackups created by the schedule",
+		"retentionPolicy": "+optional\nRetentionPolicy is set on the VirtualMachineBackupTrackers owned by the schedule",
+		"concurrency":     "+optional\nConcurrency limits the number of backups running at the same time",
+		"suspend":         "+optional\nSuspend stops the schedule from creating new backups",
+	}
+}
+
+func (VirtualMachineBackupScheduleTemplate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineBackupScheduleTemplate describes the backups created by a schedule\n+kubebuilder:validation:XValidation:rule=\"(has(self.mode) && self.mode != 'Push') || (has(self.pvcName) && self.pvcName != \\\"\\\")\",message=\"pvcName must be provided when mode is unset or Push\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.mode) || self.mode != 'Pull' || !has(self.pvcName)\",message=\"pvcName is not supported when mode is Pull\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.ttlDuration) || (has(self.mode) && self.mode == 'Pull')\",message=\"ttlDuration is only supported when mode is Pull\"",
+		"mode":        "+optional\n+kubebuilder:validation:Enum=Push;Pull\nMode specifies the way the backup output will be recieved",
+		"pvcName":     "+optional\nPvcName required in push mode. Specifies the name of the PVC\nwhere the backup output will be stored. Push mode is not supported\nwhen the schedule selects its VMs with Selector.",
+		"skipQuiesce": "+optional\nSkipQuiesce indicates whether the VM's filesystem shoule not be quiesced before the backup",
+		"ttlDuration": "+optional\nTTLDuration limits how long the export of a pull mode backup stays available",
+		"hooks":       "+optional\nHooks run around the freeze of the guest filesystem, they are\nskipped when SkipQuiesce is set",
+	}
+}
+
+func (BackupConcurrencyPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "BackupConcurrencyPolicy limits the number of backups running at the same time.\nAll the running VirtualMachineBackups in the cluster are accounted for.",
+		"maxPerNode":         "+optional\n+kubebuilder:validation:Minimum=1\nMaxPerNode is the maximum number of backups running on the same node",
+		"maxPerStorageClass": "+optional\n+kubebuilder:validation:Minimum=1\nMaxPerStorageClass is the maximum number of backups running on VMs\nwith volumes of the same storage class",
+	}
+}
+
+func (VirtualMachineBackupScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineBackupScheduleStatus is the status for a VirtualMachineBackupSchedule resource",
+		"lastScheduleTime": "+optional\nLastScheduleTime is the last time a backup was scheduled",
+		"nextScheduleTime": "+optional\nNextScheduleTime is the next time a backup will be scheduled",
+		"sources":          "+optional\n+listType=atomic\nSources reports the state of the schedule for each VM it backs up",
+	}
+}
+
+func (BackupScheduleSourceStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "BackupScheduleSourceStatus reports the state of a schedule for a single VM",
+		"vmName":              "VMName is the name of the VM",
+		"trackerName":         "+optional\nTrackerName is the name of the VirtualMachineBackupTracker owned by\nthe schedule to track the checkpoints of the VM",
+		"lastScheduleTime":    "+optional\nLastScheduleTime is the last time a backup of the VM was scheduled",
+		"lastBackupName":      "+optional\nLastBackupName is the name of the last backup created for the VM",
+		"lastSuccessfulTime":  "+optional\nLastSuccessfulTime is the last time a backup of the VM completed successfully",
+		"consecutiveFailures": "+optional\nConsecutiveFailures is the number of backups of the VM that failed\nsince the last successful one",
+		"lastFailure":         "+optional\nLastFailure is the reason of the last failed backup of the VM",
+		"message":             "+optional\nMessage explains why a due backup of the VM was not created yet",
+	}
+}
+
+func (VirtualMachineBackupScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineBackupScheduleList is a list of VirtualMachineBackupSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
 func (VirtualMachineBackup) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "VirtualMachineBackup defines the operation of backing up a VM\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -66,22 +147,87 @@ func (VirtualMachineBackupList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineBackupSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                "VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"spec is immutable after creation\"\n+kubebuilder:validation:XValidation:rule=\"(has(self.mode) && self.mode != 'Push') || (has(self.pvcName) && self.pvcName != \\\"\\\")\",message=\"pvcName must be provided when mode is unset or Push\"",
+		"":                "VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"spec is immutable after creation\"\n+kubebuilder:validation:XValidation:rule=\"(has(self.mode) && self.mode != 'Push') || (has(self.pvcName) && self.pvcName != \\\"\\\")\",message=\"pvcName must be provided when mode is unset or Push\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.mode) || self.mode != 'Pull' || !has(self.pvcName)\",message=\"pvcName is not supported when mode is Pull\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.ttlDuration) || (has(self.mode) && self.mode == 'Pull')\",message=\"ttlDuration is only supported when mode is Pull\"",
 		"source":          "Source specifies the backup source - either a VirtualMachine or a VirtualMachineBackupTracker.\nWhen Kind is VirtualMachine: performs a backup of the specified VM.\nWhen Kind is VirtualMachineBackupTracker: uses the tracker to get the source VM\nand the base checkpoint for incremental backup. The tracker will be updated\nwith the new checkpoint after backup completion.\n+kubebuilder:validation:XValidation:rule=\"has(self.apiGroup)\",message=\"apiGroup is required\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.apiGroup) || self.apiGroup == 'kubevirt.io' || self.apiGroup == 'backup.kubevirt.io'\",message=\"apiGroup must be kubevirt.io or backup.kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.apiGroup) || (self.apiGroup == 'kubevirt.io' && self.kind == 'VirtualMachine') || (self.apiGroup == 'backup.kubevirt.io' && self.kind == 'VirtualMachineBackupTracker')\",message=\"kind must be VirtualMachine for kubevirt.io or VirtualMachineBackupTracker for backup.kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"self.name != ''\",message=\"name is required\"",
-		"mode":            "+optional\n+kubebuilder:validation:Enum=Push\nMode specifies the way the backup output will be recieved",
+		"mode":            "+optional\n+kubebuilder:validation:Enum=Push;Pull\nMode specifies the way the backup output will be recieved",
 		"pvcName":         "+optional\nPvcName required in push mode. Specifies the name of the PVC\nwhere the backup output will be stored",
 		"skipQuiesce":     "+optional\nSkipQuiesce indicates whether the VM's filesystem shoule not be quiesced before the backup",
 		"forceFullBackup": "+optional\nForceFullBackup indicates that a full backup is desired",
+		"ttlDuration":     "+optional\nTTLDuration is used in pull mode only. It limits how long the backup\nexport stays available, the export is removed once it expires or when\nthe backup is deleted, whichever comes first.\nIf not set the export stays available until the backup is deleted.",
+		"hooks":           "+optional\nHooks run around the freeze of the guest filesystem, they are\nskipped when SkipQuiesce is set",
 	}
 }
 
 func (VirtualMachineBackupStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                "VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource",
-		"type":            "+optional\nType indicates if the backup was full or incremental",
-		"conditions":      "+optional\n+listType=atomic",
-		"checkpointName":  "+optional\nCheckpointName the name of the checkpoint created for the current backup",
-		"includedVolumes": "+optional\n+listType=atomic\nIncludedVolumes lists the volumes that were included in the backup",
+		"":                   "VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource",
+		"type":               "+optional\nType indicates if the backup was full or incremental",
+		"conditions":         "+optional\n+listType=atomic",
+		"checkpointName":     "+optional\nCheckpointName the name of the checkpoint created for the current backup",
+		"includedVolumes":    "+optional\n+listType=atomic\nIncludedVolumes lists the volumes that were included in the backup",
+		"export":             "+optional\nExport contains the information needed to read the backup\noutput of a pull mode backup",
+		"baseCheckpointName": "+optional\nBaseCheckpointName is the checkpoint an incremental backup is based on",
+		"virtualMachine":     "+optional\n+kubebuilder:pruning:PreserveUnknownFields\n+kubebuilder:validation:Schemaless\n+kubebuilder:validation:Type=object\nVirtualMachine is the definition of the source VirtualMachine,\ncaptured when the backup started",
+		"freezeHooks":        "+optional\n+listType=atomic\nFreezeHooks lists the outcome of the hooks which ran around the freeze\nof the guest filesystem",
+	}
+}
+
+func (BackupExport) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "BackupExport contains the information needed to read a pull mode backup",
+		"endpoint":       "Endpoint is the path of the backup export, relative to the Kubernetes\nAPI server. The extents of an exported volume are listed with a GET\nrequest to <endpoint>/map and its data is read with a GET request to\n<endpoint>/read. Requests are authenticated and authorized by the API\nserver, and travel over TLS all the way to the node that runs the VM.",
+		"expirationTime": "+optional\nExpirationTime is the time at which the export will be removed",
+		"volumes":        "+optional\n+listType=atomic\nVolumes lists the exported volumes",
+	}
+}
+
+func (BackupExportVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "BackupExportVolume describes the NBD export of a single volume",
+		"volumeName":  "VolumeName is the volume name from VMI spec",
+		"exportName":  "ExportName is the NBD export name to read the volume data from",
+		"dirtyBitmap": "+optional\nDirtyBitmap is the name of the dirty bitmap that holds the blocks\nchanged since the base checkpoint, only set for incremental backups",
+	}
+}
+
+func (VirtualMachineBackupRestore) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackupRestore restores a VM, or a single volume of a VM,\nfrom the push mode backups of a checkpoint chain\n+k8s:openapi-gen=true\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupRestoreList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineBackupRestoreList is a list of VirtualMachineBackupRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (VirtualMachineBackupRestoreSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineBackupRestoreSpec is the spec for a VirtualMachineBackupRestore resource\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"spec is immutable after creation\"",
+		"target":         "Target is the VirtualMachine to restore. Unless VolumeName is set, the\nVirtualMachine must not exist and is recreated from the definition\ncaptured at backup time.\n+kubebuilder:validation:XValidation:rule=\"has(self.apiGroup) && self.apiGroup == 'kubevirt.io'\",message=\"apiGroup must be kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"self.kind == 'VirtualMachine'\",message=\"kind must be VirtualMachine\"\n+kubebuilder:validation:XValidation:rule=\"self.name != ''\",message=\"name is required\"",
+		"checkpointName": "CheckpointName is the checkpoint to restore. The disks are rebuilt\nfrom the full backup of its chain and the incremental backups up to\nthe checkpoint.\n+kubebuilder:validation:MinLength=1",
+		"volumeName":     "VolumeName restores only this volume, into the existing target\nVirtualMachine which must be stopped\n+optional",
+	}
+}
+
+func (VirtualMachineBackupRestoreStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineBackupRestoreStatus is the status for a VirtualMachineBackupRestore resource",
+		"conditions": "+optional\n+listType=atomic",
+		"backups":    "+optional\n+listType=atomic\nBackups lists the VirtualMachineBackups of the restored chain,\nstarting with the full backup",
+		"volumes":    "+optional\n+listType=atomic\nVolumes lists the restored volumes",
+	}
+}
+
+func (BackupRestoreVolumeStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "BackupRestoreVolumeStatus describes the restore of a single volume",
+		"volumeName": "VolumeName is the volume name from the VM spec",
+		"claimName":  "ClaimName is the name of the PVC the volume is restored into",
+		"completed":  "+optional\nCompleted indicates the data of the volume was restored",
 	}
 }
 
@@ -107,6 +107,23 @@ func (in *VirtualMachineCloneList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCloneRemoteExportSource) DeepCopyInto(out *VirtualMachineCloneRemoteExportSource) {
+	*out = *in
+	in.Link.DeepCopyInto(&out.Link)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCloneRemoteExportSource.
+func (in *VirtualMachineCloneRemoteExportSource) DeepCopy() *VirtualMachineCloneRemoteExportSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCloneRemoteExportSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 	*out = *in
@@ -115,6 +132,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(v1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RemoteExport != nil {
+		in, out := &in.RemoteExport, &out.RemoteExport
+		*out = new(VirtualMachineCloneRemoteExportSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Target != nil {
 		in, out := &in.Target, &out.Target
 		*out = new(v1.TypedLocalObjectReference)
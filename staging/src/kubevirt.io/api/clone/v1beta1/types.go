@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	exportv1beta1 "kubevirt.io/api/export/v1beta1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
 )
 
@@ -55,9 +56,21 @@ type VirtualMachineCloneTemplateFilters struct {
 type VirtualMachineCloneSpec struct {
 	// Source is the object that would be cloned. Currently supported source types are:
 	// VirtualMachine of kubevirt.io API group,
-	// VirtualMachineSnapshot of snapshot.kubevirt.io API group
+	// VirtualMachineSnapshot of snapshot.kubevirt.io API group,
+	// VirtualMachineExport of export.kubevirt.io API group, which requires RemoteExport to be set
 	Source *corev1.TypedLocalObjectReference `json:"source"`
 
+	// SourceNamespace is the namespace of a VirtualMachineSnapshot source, it defaults to the
+	// namespace of the clone. Cloning a snapshot from another namespace requires a
+	// VirtualMachineSnapshotGrant in that namespace allowing the namespace of the clone.
+	// +optional
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+
+	// RemoteExport points to a VirtualMachineExport of a remote cluster to clone from.
+	// It must be set when the source is a VirtualMachineExport.
+	// +optional
+	RemoteExport *VirtualMachineCloneRemoteExportSource `json:"remoteExport,omitempty"`
+
 	// Target is the outcome of the cloning process.
 	// Currently supported source types are:
 	// - VirtualMachine of kubevirt.io API group
@@ -101,6 +114,19 @@ type VirtualMachineCloneSpec struct {
 	VolumeNamePolicy *VolumeNamePolicy `json:"volumeNamePolicy,omitempty"`
 }
 
+// VirtualMachineCloneRemoteExportSource holds the information needed to import a VirtualMachine
+// from a VirtualMachineExport of a remote cluster
+type VirtualMachineCloneRemoteExportSource struct {
+	// Link is the external link of the remote VirtualMachineExport, as found in its status.
+	// It has to provide the "all" manifest.
+	Link exportv1beta1.VirtualMachineExportLink `json:"link"`
+
+	// TokenSecretRef is the name of the secret, in the namespace of the clone,
+	// holding the token of the remote VirtualMachineExport under the "token" key.
+	// The kubevirt-controller service account has to be granted get access to the secret.
+	TokenSecretRef string `json:"tokenSecretRef"`
+}
+
 // VolumeNamePolicy defines how to handle volume naming during the clone operation
 type VolumeNamePolicy string
 
@@ -133,6 +159,7 @@ type VirtualMachineClonePhase string
 const (
 	PhaseUnset         VirtualMachineClonePhase = ""
 	SnapshotInProgress VirtualMachineClonePhase = "SnapshotInProgress"
+	ImportInProgress   VirtualMachineClonePhase = "ImportInProgress"
 	CreatingTargetVM   VirtualMachineClonePhase = "CreatingTargetVM"
 	RestoreInProgress  VirtualMachineClonePhase = "RestoreInProgress"
 	Succeeded          VirtualMachineClonePhase = "Succeeded"
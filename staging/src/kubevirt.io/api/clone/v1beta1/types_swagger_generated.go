@@ -17,7 +17,9 @@ func (VirtualMachineCloneTemplateFilters) SwaggerDoc() map[string]string {
 
 func (VirtualMachineCloneSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"source":            "Source is the object that would be cloned. Currently supported source types are:\nVirtualMachine of kubevirt.io API group,\nVirtualMachineSnapshot of snapshot.kubevirt.io API group",
+		"source":            "Source is the object that would be cloned. Currently supported source types are:\nVirtualMachine of kubevirt.io API group,\nVirtualMachineSnapshot of snapshot.kubevirt.io API group,\nVirtualMachineExport of export.kubevirt.io API group, which requires RemoteExport to be set",
+		"sourceNamespace":   "SourceNamespace is the namespace of a VirtualMachineSnapshot source, it defaults to the\nnamespace of the clone. Cloning a snapshot from another namespace requires a\nVirtualMachineSnapshotGrant in that namespace allowing the namespace of the clone.\n+optional",
+		"remoteExport":      "RemoteExport points to a VirtualMachineExport of a remote cluster to clone from.\nIt must be set when the source is a VirtualMachineExport.\n+optional",
 		"target":            "Target is the outcome of the cloning process.\nCurrently supported source types are:\n- VirtualMachine of kubevirt.io API group\n- Empty (nil).\nIf the target is not provided, the target type would default to VirtualMachine and a random\nname would be generated for the target. The target's name can be viewed by\ninspecting status \"TargetName\" field below.\n+optional",
 		"annotationFilters": "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
 		"labelFilters":      "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
@@ -29,6 +31,14 @@ func (VirtualMachineCloneSpec) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineCloneRemoteExportSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineCloneRemoteExportSource holds the information needed to import a VirtualMachine\nfrom a VirtualMachineExport of a remote cluster",
+		"link":           "Link is the external link of the remote VirtualMachineExport, as found in its status.\nIt has to provide the \"all\" manifest.",
+		"tokenSecretRef": "TokenSecretRef is the name of the secret, in the namespace of the clone,\nholding the token of the remote VirtualMachineExport under the \"token\" key.\nThe kubevirt-controller service account has to be granted get access to the secret.",
+	}
+}
+
 func (VirtualMachineCloneStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"creationTime": "+optional\n+nullable",
@@ -28,7 +28,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
-	v1alpha1 "kubevirt.io/api/backup/v1alpha1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -97,6 +96,11 @@ func (in *AddVolumeOptions) DeepCopyInto(out *AddVolumeOptions) {
 		*out = new(Disk)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Filesystem != nil {
+		in, out := &in.Filesystem, &out.Filesystem
+		*out = new(Filesystem)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VolumeSource != nil {
 		in, out := &in.VolumeSource, &out.VolumeSource
 		*out = new(HotplugVolumeSource)
@@ -214,6 +218,85 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeClaim) DeepCopyInto(out *BackupVolumeClaim) {
+	*out = *in
+	out.Capacity = in.Capacity.DeepCopy()
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeMode != nil {
+		in, out := &in.VolumeMode, &out.VolumeMode
+		*out = new(corev1.PersistentVolumeMode)
+		**out = **in
+	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeClaim.
+func (in *BackupVolumeClaim) DeepCopy() *BackupVolumeClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeInfo) DeepCopyInto(out *BackupVolumeInfo) {
+	*out = *in
+	if in.Claim != nil {
+		in, out := &in.Claim, &out.Claim
+		*out = new(BackupVolumeClaim)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeInfo.
+func (in *BackupVolumeInfo) DeepCopy() *BackupVolumeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -745,6 +828,11 @@ func (in *ContainerDiskInfo) DeepCopy() *ContainerDiskInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerDiskSource) DeepCopyInto(out *ContainerDiskSource) {
 	*out = *in
+	if in.Persistent != nil {
+		in, out := &in.Persistent, &out.Persistent
+		*out = new(PersistentContainerDisk)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -879,6 +967,22 @@ func (in *CustomizeComponentsPatch) DeepCopy() *CustomizeComponentsPatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPExtraOption) DeepCopyInto(out *DHCPExtraOption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPExtraOption.
+func (in *DHCPExtraOption) DeepCopy() *DHCPExtraOption {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPExtraOption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 	*out = *in
@@ -892,6 +996,11 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = make([]DHCPPrivateOptions, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExtraOptions != nil {
+		in, out := &in.ExtraOptions, &out.ExtraOptions
+		*out = make([]DHCPExtraOption, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1411,6 +1520,16 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AutoGrowFilesystem != nil {
+		in, out := &in.AutoGrowFilesystem, &out.AutoGrowFilesystem
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1476,6 +1595,108 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.TotalBytesSec != nil {
+		in, out := &in.TotalBytesSec, &out.TotalBytesSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadBytesSec != nil {
+		in, out := &in.ReadBytesSec, &out.ReadBytesSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteBytesSec != nil {
+		in, out := &in.WriteBytesSec, &out.WriteBytesSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TotalIOPSSec != nil {
+		in, out := &in.TotalIOPSSec, &out.TotalIOPSSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadIOPSSec != nil {
+		in, out := &in.ReadIOPSSec, &out.ReadIOPSSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPSSec != nil {
+		in, out := &in.WriteIOPSSec, &out.WriteIOPSSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(DiskIOTuneBurst)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTuneBurst) DeepCopyInto(out *DiskIOTuneBurst) {
+	*out = *in
+	if in.TotalBytesSec != nil {
+		in, out := &in.TotalBytesSec, &out.TotalBytesSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadBytesSec != nil {
+		in, out := &in.ReadBytesSec, &out.ReadBytesSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteBytesSec != nil {
+		in, out := &in.WriteBytesSec, &out.WriteBytesSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TotalIOPSSec != nil {
+		in, out := &in.TotalIOPSSec, &out.TotalIOPSSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadIOPSSec != nil {
+		in, out := &in.ReadIOPSSec, &out.ReadIOPSSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPSSec != nil {
+		in, out := &in.WriteIOPSSec, &out.WriteIOPSSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LengthSeconds != nil {
+		in, out := &in.LengthSeconds, &out.LengthSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTuneBurst.
+func (in *DiskIOTuneBurst) DeepCopy() *DiskIOTuneBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTuneBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -2014,6 +2235,48 @@ func (in *FilesystemVirtiofs) DeepCopy() *FilesystemVirtiofs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRBlocks != nil {
+		in, out := &in.CIDRBlocks, &out.CIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
+func (in *FirewallRule) DeepCopy() *FirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleStatus) DeepCopyInto(out *FirewallRuleStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleStatus.
+func (in *FirewallRuleStatus) DeepCopy() *FirewallRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Firmware) DeepCopyInto(out *Firmware) {
 	*out = *in
@@ -2082,6 +2345,88 @@ func (in *Flags) DeepCopy() *Flags {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHook) DeepCopyInto(out *FreezeHook) {
+	*out = *in
+	if in.GuestExec != nil {
+		in, out := &in.GuestExec, &out.GuestExec
+		*out = new(GuestExecHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPHook)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(FreezeHookFailurePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHook.
+func (in *FreezeHook) DeepCopy() *FreezeHook {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHookResult) DeepCopyInto(out *FreezeHookResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHookResult.
+func (in *FreezeHookResult) DeepCopy() *FreezeHookResult {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHookResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHooks) DeepCopyInto(out *FreezeHooks) {
+	*out = *in
+	if in.PreFreeze != nil {
+		in, out := &in.PreFreeze, &out.PreFreeze
+		*out = make([]FreezeHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostThaw != nil {
+		in, out := &in.PostThaw, &out.PostThaw
+		*out = make([]FreezeHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHooks.
+func (in *FreezeHooks) DeepCopy() *FreezeHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreezeUnfreezeTimeout) DeepCopyInto(out *FreezeUnfreezeTimeout) {
 	*out = *in
@@ -2177,6 +2522,48 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecHook) DeepCopyInto(out *GuestExecHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecHook.
+func (in *GuestExecHook) DeepCopy() *GuestExecHook {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecOptions) DeepCopyInto(out *GuestExecOptions) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecOptions.
+func (in *GuestExecOptions) DeepCopy() *GuestExecOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -2198,6 +2585,22 @@ func (in *HPETTimer) DeepCopy() *HPETTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHook) DeepCopyInto(out *HTTPHook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHook.
+func (in *HTTPHook) DeepCopy() *HTTPHook {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Handler) DeepCopyInto(out *Handler) {
 	*out = *in
@@ -2409,6 +2812,22 @@ func (in *I6300ESBWatchdog) DeepCopy() *I6300ESBWatchdog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6AutoConfig) DeepCopyInto(out *IPv6AutoConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6AutoConfig.
+func (in *IPv6AutoConfig) DeepCopy() *IPv6AutoConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6AutoConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitrdInfo) DeepCopyInto(out *InitrdInfo) {
 	*out = *in
@@ -2533,6 +2952,16 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(InterfaceFirewall)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2546,13 +2975,39 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
 	if in.Bridge != nil {
 		in, out := &in.Bridge, &out.Bridge
 		*out = new(InterfaceBridge)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.DeprecatedSlirp != nil {
 		in, out := &in.DeprecatedSlirp, &out.DeprecatedSlirp
@@ -2642,6 +3097,11 @@ func (in *InterfaceBindingPlugin) DeepCopy() *InterfaceBindingPlugin {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
 	*out = *in
+	if in.AutoConfig != nil {
+		in, out := &in.AutoConfig, &out.AutoConfig
+		*out = new(IPv6AutoConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -2655,6 +3115,36 @@ func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceFirewall) DeepCopyInto(out *InterfaceFirewall) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceFirewall.
+func (in *InterfaceFirewall) DeepCopy() *InterfaceFirewall {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceFirewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
 	*out = *in
@@ -3008,6 +3498,11 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(SeccompConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VMStateEncryption != nil {
+		in, out := &in.VMStateEncryption, &out.VMStateEncryption
+		*out = new(VMStateEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VirtualMachineOptions != nil {
 		in, out := &in.VirtualMachineOptions, &out.VirtualMachineOptions
 		*out = new(VirtualMachineOptions)
@@ -3528,6 +4023,22 @@ func (in *MigrateOptions) DeepCopy() *MigrateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigratedInterfaceState) DeepCopyInto(out *MigratedInterfaceState) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigratedInterfaceState.
+func (in *MigratedInterfaceState) DeepCopy() *MigratedInterfaceState {
+	if in == nil {
+		return nil
+	}
+	out := new(MigratedInterfaceState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 	*out = *in
@@ -4011,6 +4522,33 @@ func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentContainerDisk) DeepCopyInto(out *PersistentContainerDisk) {
+	*out = *in
+	out.Capacity = in.Capacity.DeepCopy()
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentContainerDisk.
+func (in *PersistentContainerDisk) DeepCopy() *PersistentContainerDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentContainerDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistentVolumeClaimInfo) DeepCopyInto(out *PersistentVolumeClaimInfo) {
 	*out = *in
@@ -4863,6 +5401,22 @@ func (in *StorageMigratedVolumeInfo) DeepCopy() *StorageMigratedVolumeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMigratedVolumeProgress) DeepCopyInto(out *StorageMigratedVolumeProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMigratedVolumeProgress.
+func (in *StorageMigratedVolumeProgress) DeepCopy() *StorageMigratedVolumeProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMigratedVolumeProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
@@ -5300,6 +5854,43 @@ func (in *VMISelector) DeepCopy() *VMISelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMStateEncryption) DeepCopyInto(out *VMStateEncryption) {
+	*out = *in
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(VMStateKMS)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStateEncryption.
+func (in *VMStateEncryption) DeepCopy() *VMStateEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(VMStateEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMStateKMS) DeepCopyInto(out *VMStateKMS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStateKMS.
+func (in *VMStateKMS) DeepCopy() *VMStateKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(VMStateKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSOCKOptions) DeepCopyInto(out *VSOCKOptions) {
 	*out = *in
@@ -5404,6 +5995,30 @@ func (in *VirtualMachineCondition) DeepCopy() *VirtualMachineCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineContainerDiskCommitRequest) DeepCopyInto(out *VirtualMachineContainerDiskCommitRequest) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineContainerDiskCommitRequest.
+func (in *VirtualMachineContainerDiskCommitRequest) DeepCopy() *VirtualMachineContainerDiskCommitRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineContainerDiskCommitRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstance) DeepCopyInto(out *VirtualMachineInstance) {
 	*out = *in
@@ -5455,8 +6070,10 @@ func (in *VirtualMachineInstanceBackupStatus) DeepCopyInto(out *VirtualMachineIn
 	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
-		*out = make([]v1alpha1.BackupVolumeInfo, len(*in))
-		copy(*out, *in)
+		*out = make([]BackupVolumeInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -5977,6 +6594,16 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		*out = new(VirtualMachineInstanceMigrationTargetState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MigratedVolumesProgress != nil {
+		in, out := &in.MigratedVolumesProgress, &out.MigratedVolumesProgress
+		*out = make([]StorageMigratedVolumeProgress, len(*in))
+		copy(*out, *in)
+	}
+	if in.MigratedInterfaces != nil {
+		in, out := &in.MigratedInterfaces, &out.MigratedInterfaces
+		*out = make([]MigratedInterfaceState, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -6102,6 +6729,11 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachi
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = make([]FirewallRuleStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -6841,6 +7473,11 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(VirtualMachineMemoryDumpRequest)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ContainerDiskCommitRequest != nil {
+		in, out := &in.ContainerDiskCommitRequest, &out.ContainerDiskCommitRequest
+		*out = new(VirtualMachineContainerDiskCommitRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VolumeUpdateState != nil {
 		in, out := &in.VolumeUpdateState, &out.VolumeUpdateState
 		*out = new(VolumeUpdateState)
@@ -6987,7 +7624,7 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 	if in.ContainerDisk != nil {
 		in, out := &in.ContainerDisk, &out.ContainerDisk
 		*out = new(ContainerDiskSource)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Ephemeral != nil {
 		in, out := &in.Ephemeral, &out.Ephemeral
@@ -7075,6 +7712,11 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(ContainerDiskInfo)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
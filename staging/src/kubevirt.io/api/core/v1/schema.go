@@ -766,6 +766,69 @@ type Disk struct {
 	// Defaults to false.
 	// +optional
 	ChangedBlockTracking *bool `json:"changedBlockTracking,omitempty"`
+	// AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent
+	// after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.
+	// Defaults to false.
+	// +optional
+	AutoGrowFilesystem *bool `json:"autoGrowFilesystem,omitempty"`
+	// IOTune throttles the I/O of the disk.
+	// Requires the DiskIOTune feature gate. Changes are applied to a running VMI
+	// when the VM uses the LiveUpdate rollout strategy.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+}
+
+// DiskIOTune represents the I/O limits of a disk.
+// A total limit can't be combined with the read or write limit of the same kind.
+type DiskIOTune struct {
+	// TotalBytesSec is the total throughput limit in bytes per second.
+	// +optional
+	TotalBytesSec *int64 `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec is the read throughput limit in bytes per second.
+	// +optional
+	ReadBytesSec *int64 `json:"readBytesSec,omitempty"`
+	// WriteBytesSec is the write throughput limit in bytes per second.
+	// +optional
+	WriteBytesSec *int64 `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec is the total I/O operations per second limit.
+	// +optional
+	TotalIOPSSec *int64 `json:"totalIOPSSec,omitempty"`
+	// ReadIOPSSec is the read I/O operations per second limit.
+	// +optional
+	ReadIOPSSec *int64 `json:"readIOPSSec,omitempty"`
+	// WriteIOPSSec is the write I/O operations per second limit.
+	// +optional
+	WriteIOPSSec *int64 `json:"writeIOPSSec,omitempty"`
+	// Burst allows the disk to exceed the limits for a short period of time.
+	// +optional
+	Burst *DiskIOTuneBurst `json:"burst,omitempty"`
+}
+
+// DiskIOTuneBurst represents the burst limits of a disk.
+// Each burst limit requires the matching base limit and must not be lower than it.
+type DiskIOTuneBurst struct {
+	// TotalBytesSec is the total burst throughput in bytes per second.
+	// +optional
+	TotalBytesSec *int64 `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec is the read burst throughput in bytes per second.
+	// +optional
+	ReadBytesSec *int64 `json:"readBytesSec,omitempty"`
+	// WriteBytesSec is the write burst throughput in bytes per second.
+	// +optional
+	WriteBytesSec *int64 `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec is the total burst I/O operations per second.
+	// +optional
+	TotalIOPSSec *int64 `json:"totalIOPSSec,omitempty"`
+	// ReadIOPSSec is the read burst I/O operations per second.
+	// +optional
+	ReadIOPSSec *int64 `json:"readIOPSSec,omitempty"`
+	// WriteIOPSSec is the write burst I/O operations per second.
+	// +optional
+	WriteIOPSSec *int64 `json:"writeIOPSSec,omitempty"`
+	// LengthSeconds is the maximum duration of a burst.
+	// Defaults to one second.
+	// +optional
+	LengthSeconds *int64 `json:"lengthSeconds,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -1003,6 +1066,11 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Format represents the format of the memory dump, defaults to Raw.
+	// A non hotpluggable volume with the SavedState format is used to
+	// start the VMI from the saved state instead of booting it.
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
 type EphemeralVolumeSource struct {
@@ -1034,6 +1102,25 @@ type ContainerDiskSource struct {
 	// More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
 	// +optional
 	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Persistent keeps the writes to the disk across restarts of the VM in a qcow2 overlay
+	// on top of the image, stored on a PVC which is created along with the VM and deleted with it.
+	// The image must not change during the lifetime of the overlay, referencing it by digest is recommended.
+	// +optional
+	Persistent *PersistentContainerDisk `json:"persistent,omitempty"`
+}
+
+// PersistentContainerDisk defines the PVC holding the overlay of a persistent containerDisk
+type PersistentContainerDisk struct {
+	// Capacity of the overlay PVC, it bounds the amount of data written to the disk
+	Capacity resource.Quantity `json:"capacity"`
+	// StorageClassName of the overlay PVC, the default storage class is used when empty
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// AccessModes of the overlay PVC, defaults to ReadWriteOnce.
+	// ReadWriteMany is required to live migrate the VM.
+	// +optional
+	// +listType=atomic
+	AccessModes []v1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
 }
 
 type UtilityVolumeType string
@@ -1448,6 +1535,42 @@ type Interface struct {
 	// Empty value functions as `up`.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// Firewall defines the rules filtering the traffic of the interface.
+	// The rules are enforced in the network namespace of the virt-launcher pod
+	// and can be updated while the VMI is running.
+	// Supported by the bridge, masquerade and passt bindings.
+	// +optional
+	Firewall *InterfaceFirewall `json:"firewall,omitempty"`
+	// Bandwidth limits the traffic of the interface.
+	// The limits are enforced with traffic control on the tap device in the network namespace
+	// of the virt-launcher pod and can be updated while the VMI is running.
+	// Requires the InterfaceBandwidth feature gate. Supported by the bridge and masquerade bindings.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+}
+
+// InterfaceBandwidth represents the traffic limits of an interface.
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *BandwidthLimit `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the guest.
+	// +optional
+	Outbound *BandwidthLimit `json:"outbound,omitempty"`
+}
+
+// BandwidthLimit represents the traffic limit of one direction of an interface.
+type BandwidthLimit struct {
+	// Average is the average rate in kibibytes per second.
+	Average int64 `json:"average"`
+	// Peak is the maximum rate in kibibytes per second at which the traffic can be sent.
+	// Must not be lower than the average.
+	// +optional
+	Peak *int64 `json:"peak,omitempty"`
+	// Burst is the amount of kibibytes that can be sent at the peak rate.
+	// Defaults to the amount sent in one second at the average rate.
+	// +optional
+	Burst *int64 `json:"burst,omitempty"`
 }
 
 type InterfaceState string
@@ -1458,6 +1581,64 @@ const (
 	InterfaceStateLinkDown InterfaceState = "down"
 )
 
+// InterfaceFirewall defines the ingress and egress rules of an interface.
+// Rules are evaluated in order and the first matching rule applies.
+// Traffic of established connections is always allowed.
+// When rules are defined for a direction, the traffic of that direction
+// which does not match any rule is denied.
+type InterfaceFirewall struct {
+	// Ingress rules filter the traffic received by the guest.
+	// +optional
+	// +listType=atomic
+	Ingress []FirewallRule `json:"ingress,omitempty"`
+	// Egress rules filter the traffic sent by the guest.
+	// +optional
+	// +listType=atomic
+	Egress []FirewallRule `json:"egress,omitempty"`
+}
+
+type FirewallAction string
+
+const (
+	FirewallActionAllow FirewallAction = "Allow"
+	FirewallActionDeny  FirewallAction = "Deny"
+)
+
+type FirewallProtocol string
+
+const (
+	FirewallProtocolTCP  FirewallProtocol = "TCP"
+	FirewallProtocolUDP  FirewallProtocol = "UDP"
+	FirewallProtocolSCTP FirewallProtocol = "SCTP"
+	FirewallProtocolICMP FirewallProtocol = "ICMP"
+)
+
+type FirewallRule struct {
+	// Name of the rule, unique per interface and direction.
+	// It identifies the rule counters reported in the VMI status.
+	Name string `json:"name"`
+	// Action applied to the matching traffic.
+	// Defaults to Allow.
+	// +optional
+	Action FirewallAction `json:"action,omitempty"`
+	// Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.
+	// ICMP matches both ICMP and ICMPv6.
+	// All the protocols are matched when empty.
+	// +optional
+	Protocol FirewallProtocol `json:"protocol,omitempty"`
+	// Ports of the matching traffic: the guest ports for ingress rules and the
+	// remote ports for egress rules. Requires the TCP, UDP or SCTP protocol.
+	// All the ports are matched when empty.
+	// +optional
+	// +listType=atomic
+	Ports []int32 `json:"ports,omitempty"`
+	// CIDRBlocks of the remote peers of the matching traffic.
+	// All the peers are matched when empty.
+	// +optional
+	// +listType=atomic
+	CIDRBlocks []string `json:"cidrBlocks,omitempty"`
+}
+
 // Extra DHCP options to use in the interface.
 type DHCPOptions struct {
 	// If specified will pass option 67 to interface's DHCP server
@@ -1472,6 +1653,12 @@ type DHCPOptions struct {
 	// If specified will pass extra DHCP options for private use, range: 224-254
 	// +optional
 	PrivateOptions []DHCPPrivateOptions `json:"privateOptions,omitempty"`
+	// ExtraOptions passes arbitrary options, identified by their numeric code,
+	// to the interface's DHCP and DHCPv6 servers.
+	// An extra option replaces the option the server provides with the same code.
+	// +optional
+	// +listType=atomic
+	ExtraOptions []DHCPExtraOption `json:"extraOptions,omitempty"`
 }
 
 func (d *DHCPOptions) UnmarshalJSON(data []byte) error {
@@ -1502,6 +1689,48 @@ type DHCPPrivateOptions struct {
 	Value string `json:"value"`
 }
 
+type DHCPOptionFamily string
+
+const (
+	DHCPOptionFamilyIPv4 DHCPOptionFamily = "IPv4"
+	DHCPOptionFamilyIPv6 DHCPOptionFamily = "IPv6"
+)
+
+type DHCPOptionType string
+
+const (
+	// DHCPOptionTypeString encodes the value as is.
+	DHCPOptionTypeString DHCPOptionType = "String"
+	// DHCPOptionTypeUint8 encodes the value as a single byte unsigned integer.
+	DHCPOptionTypeUint8 DHCPOptionType = "Uint8"
+	// DHCPOptionTypeUint16 encodes the value as a two bytes unsigned integer in network byte order.
+	DHCPOptionTypeUint16 DHCPOptionType = "Uint16"
+	// DHCPOptionTypeUint32 encodes the value as a four bytes unsigned integer in network byte order.
+	DHCPOptionTypeUint32 DHCPOptionType = "Uint32"
+	// DHCPOptionTypeIPAddresses encodes the value, a comma separated list of IP addresses
+	// of the option family, as the concatenation of the addresses.
+	DHCPOptionTypeIPAddresses DHCPOptionType = "IPAddresses"
+	// DHCPOptionTypeHex encodes the value, a hexadecimal string, as the bytes it represents.
+	DHCPOptionTypeHex DHCPOptionType = "Hex"
+)
+
+// DHCPExtraOption defines a DHCP option by its numeric code and typed value.
+type DHCPExtraOption struct {
+	// Family of the server which passes the option, IPv4 for DHCP and IPv6 for DHCPv6.
+	// Defaults to IPv4.
+	// +optional
+	Family DHCPOptionFamily `json:"family,omitempty"`
+	// Code of the option, from 1 to 254 for DHCP and from 1 to 65535 for DHCPv6.
+	// Options which are managed by the server, such as the server identifier, are not allowed.
+	Code int32 `json:"code"`
+	// Type of the value, one of String, Uint8, Uint16, Uint32, IPAddresses or Hex.
+	// Defaults to String.
+	// +optional
+	Type DHCPOptionType `json:"type,omitempty"`
+	// Value of the option, encoded according to its type.
+	Value string `json:"value"`
+}
+
 // Represents the method which will be used to connect the interface to the guest.
 // Only one of its members may be specified.
 type InterfaceBindingMethod struct {
@@ -1525,7 +1754,49 @@ type InterfaceBindingMethod struct {
 }
 
 // InterfaceBridge connects to a given network via a linux bridge.
-type InterfaceBridge struct{}
+type InterfaceBridge struct {
+	// AutoConfig enables the IPv6 auto-configuration of the guest interface.
+	// The guest is configured with the IPv6 address the network IPAM assigned to the pod interface,
+	// as reported by Multus.
+	// +optional
+	AutoConfig *IPv6AutoConfig `json:"autoConfig,omitempty"`
+	// MigrationMode defines how the guest addressing is handled when the VMI is live migrated.
+	// When set to PreserveIP on the pod network, the guest keeps the IPv4 address and gateway it received
+	// before the migration and the target virt-launcher pod translates them to its own.
+	// +optional
+	MigrationMode BridgeMigrationMode `json:"migrationMode,omitempty"`
+}
+
+type BridgeMigrationMode string
+
+const (
+	// BridgeMigrationModePreserveIP keeps the guest address across live migrations.
+	BridgeMigrationModePreserveIP BridgeMigrationMode = "PreserveIP"
+)
+
+type IPv6AutoConfigMode string
+
+const (
+	// IPv6AutoConfigModeSLAAC advertises the on-link prefix for stateless address auto-configuration.
+	// The guest derives its address from the prefix instead of using the IPAM assigned address.
+	IPv6AutoConfigModeSLAAC IPv6AutoConfigMode = "SLAAC"
+	// IPv6AutoConfigModeDHCPv6 advertises a managed configuration and serves the address over DHCPv6.
+	IPv6AutoConfigModeDHCPv6 IPv6AutoConfigMode = "DHCPv6"
+)
+
+// IPv6AutoConfig defines how the guest interface is auto-configured with IPv6.
+// Router advertisements are sent to the guest with the on-link prefix and,
+// when the pod has IPv6 nameservers, with the RDNSS option.
+type IPv6AutoConfig struct {
+	// Mode of the auto-configuration, one of SLAAC or DHCPv6.
+	// Defaults to DHCPv6.
+	// +optional
+	Mode IPv6AutoConfigMode `json:"mode,omitempty"`
+	// DelegatedPrefix is an IPv6 prefix (in CIDR notation) delegated to the guest
+	// through DHCPv6 prefix delegation. Requires the DHCPv6 mode.
+	// +optional
+	DelegatedPrefix string `json:"delegatedPrefix,omitempty"`
+}
 
 // DeprecatedInterfaceSlirp is an alias to the deprecated InterfaceSlirp
 // that connects to a given network using QEMU user networking mode.
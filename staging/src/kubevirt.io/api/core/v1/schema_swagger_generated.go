@@ -386,6 +386,34 @@ func (Disk) SwaggerDoc() map[string]string {
 		"shareable":            "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":          "If specified, it can change the default error policy (stop) for the disk\n+optional",
 		"changedBlockTracking": "ChangedBlockTracking indicates this disk should have CBT option\nDefaults to false.\n+optional",
+		"autoGrowFilesystem":   "AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent\nafter the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent.\nDefaults to false.\n+optional",
+		"ioTune":               "IOTune throttles the I/O of the disk.\nRequires the DiskIOTune feature gate. Changes are applied to a running VMI\nwhen the VM uses the LiveUpdate rollout strategy.\n+optional",
+	}
+}
+
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DiskIOTune represents the I/O limits of a disk.\nA total limit can't be combined with the read or write limit of the same kind.",
+		"totalBytesSec": "TotalBytesSec is the total throughput limit in bytes per second.\n+optional",
+		"readBytesSec":  "ReadBytesSec is the read throughput limit in bytes per second.\n+optional",
+		"writeBytesSec": "WriteBytesSec is the write throughput limit in bytes per second.\n+optional",
+		"totalIOPSSec":  "TotalIOPSSec is the total I/O operations per second limit.\n+optional",
+		"readIOPSSec":   "ReadIOPSSec is the read I/O operations per second limit.\n+optional",
+		"writeIOPSSec":  "WriteIOPSSec is the write I/O operations per second limit.\n+optional",
+		"burst":         "Burst allows the disk to exceed the limits for a short period of time.\n+optional",
+	}
+}
+
+func (DiskIOTuneBurst) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DiskIOTuneBurst represents the burst limits of a disk.\nEach burst limit requires the matching base limit and must not be lower than it.",
+		"totalBytesSec": "TotalBytesSec is the total burst throughput in bytes per second.\n+optional",
+		"readBytesSec":  "ReadBytesSec is the read burst throughput in bytes per second.\n+optional",
+		"writeBytesSec": "WriteBytesSec is the write burst throughput in bytes per second.\n+optional",
+		"totalIOPSSec":  "TotalIOPSSec is the total burst I/O operations per second.\n+optional",
+		"readIOPSSec":   "ReadIOPSSec is the read burst I/O operations per second.\n+optional",
+		"writeIOPSSec":  "WriteIOPSSec is the write burst I/O operations per second.\n+optional",
+		"lengthSeconds": "LengthSeconds is the maximum duration of a burst.\nDefaults to one second.\n+optional",
 	}
 }
 
@@ -521,7 +549,9 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"format": "Format represents the format of the memory dump, defaults to Raw.\nA non hotpluggable volume with the SavedState format is used to\nstart the VMI from the saved state instead of booting it.\n+optional",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
@@ -544,6 +574,16 @@ func (ContainerDiskSource) SwaggerDoc() map[string]string {
 		"imagePullSecret": "ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.",
 		"path":            "Path defines the path to disk file in the container",
 		"imagePullPolicy": "Image pull policy.\nOne of Always, Never, IfNotPresent.\nDefaults to Always if :latest tag is specified, or IfNotPresent otherwise.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n+optional",
+		"persistent":      "Persistent keeps the writes to the disk across restarts of the VM in a qcow2 overlay\non top of the image, stored on a PVC which is created along with the VM and deleted with it.\nThe image must not change during the lifetime of the overlay, referencing it by digest is recommended.\n+optional",
+	}
+}
+
+func (PersistentContainerDisk) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "PersistentContainerDisk defines the PVC holding the overlay of a persistent containerDisk",
+		"capacity":         "Capacity of the overlay PVC, it bounds the amount of data written to the disk",
+		"storageClassName": "StorageClassName of the overlay PVC, the default storage class is used when empty\n+optional",
+		"accessModes":      "AccessModes of the overlay PVC, defaults to ReadWriteOnce.\nReadWriteMany is required to live migrate the VM.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -745,6 +785,43 @@ func (Interface) SwaggerDoc() map[string]string {
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
 		"state":       "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+		"firewall":    "Firewall defines the rules filtering the traffic of the interface.\nThe rules are enforced in the network namespace of the virt-launcher pod\nand can be updated while the VMI is running.\nSupported by the bridge, masquerade and passt bindings.\n+optional",
+		"bandwidth":   "Bandwidth limits the traffic of the interface.\nThe limits are enforced with traffic control on the tap device in the network namespace\nof the virt-launcher pod and can be updated while the VMI is running.\nRequires the InterfaceBandwidth feature gate. Supported by the bridge and masquerade bindings.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth represents the traffic limits of an interface.",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (BandwidthLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "BandwidthLimit represents the traffic limit of one direction of an interface.",
+		"average": "Average is the average rate in kibibytes per second.",
+		"peak":    "Peak is the maximum rate in kibibytes per second at which the traffic can be sent.\nMust not be lower than the average.\n+optional",
+		"burst":   "Burst is the amount of kibibytes that can be sent at the peak rate.\nDefaults to the amount sent in one second at the average rate.\n+optional",
+	}
+}
+
+func (InterfaceFirewall) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "InterfaceFirewall defines the ingress and egress rules of an interface.\nRules are evaluated in order and the first matching rule applies.\nTraffic of established connections is always allowed.\nWhen rules are defined for a direction, the traffic of that direction\nwhich does not match any rule is denied.",
+		"ingress": "Ingress rules filter the traffic received by the guest.\n+optional\n+listType=atomic",
+		"egress":  "Egress rules filter the traffic sent by the guest.\n+optional\n+listType=atomic",
+	}
+}
+
+func (FirewallRule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"name":       "Name of the rule, unique per interface and direction.\nIt identifies the rule counters reported in the VMI status.",
+		"action":     "Action applied to the matching traffic.\nDefaults to Allow.\n+optional",
+		"protocol":   "Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP.\nICMP matches both ICMP and ICMPv6.\nAll the protocols are matched when empty.\n+optional",
+		"ports":      "Ports of the matching traffic: the guest ports for ingress rules and the\nremote ports for egress rules. Requires the TCP, UDP or SCTP protocol.\nAll the ports are matched when empty.\n+optional\n+listType=atomic",
+		"cidrBlocks": "CIDRBlocks of the remote peers of the matching traffic.\nAll the peers are matched when empty.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -755,6 +832,7 @@ func (DHCPOptions) SwaggerDoc() map[string]string {
 		"tftpServerName": "If specified will pass option 66 to interface's DHCP server\n+optional",
 		"ntpServers":     "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
 		"privateOptions": "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"extraOptions":   "ExtraOptions passes arbitrary options, identified by their numeric code,\nto the interface's DHCP and DHCPv6 servers.\nAn extra option replaces the option the server provides with the same code.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -766,6 +844,16 @@ func (DHCPPrivateOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (DHCPExtraOption) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "DHCPExtraOption defines a DHCP option by its numeric code and typed value.",
+		"family": "Family of the server which passes the option, IPv4 for DHCP and IPv6 for DHCPv6.\nDefaults to IPv4.\n+optional",
+		"code":   "Code of the option, from 1 to 254 for DHCP and from 1 to 65535 for DHCPv6.\nOptions which are managed by the server, such as the server identifier, are not allowed.",
+		"type":   "Type of the value, one of String, Uint8, Uint16, Uint32, IPAddresses or Hex.\nDefaults to String.\n+optional",
+		"value":  "Value of the option, encoded according to its type.",
+	}
+}
+
 func (InterfaceBindingMethod) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":        "Represents the method which will be used to connect the interface to the guest.\nOnly one of its members may be specified.",
@@ -777,7 +865,17 @@ func (InterfaceBindingMethod) SwaggerDoc() map[string]string {
 
 func (InterfaceBridge) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "InterfaceBridge connects to a given network via a linux bridge.",
+		"":              "InterfaceBridge connects to a given network via a linux bridge.",
+		"autoConfig":    "AutoConfig enables the IPv6 auto-configuration of the guest interface.\nThe guest is configured with the IPv6 address the network IPAM assigned to the pod interface,\nas reported by Multus.\n+optional",
+		"migrationMode": "MigrationMode defines how the guest addressing is handled when the VMI is live migrated.\nWhen set to PreserveIP on the pod network, the guest keeps the IPv4 address and gateway it received\nbefore the migration and the target virt-launcher pod translates them to its own.\n+optional",
+	}
+}
+
+func (IPv6AutoConfig) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "IPv6AutoConfig defines how the guest interface is auto-configured with IPv6.\nRouter advertisements are sent to the guest with the on-link prefix and,\nwhen the pod has IPv6 nameservers, with the RDNSS option.",
+		"mode":            "Mode of the auto-configuration, one of SLAAC or DHCPv6.\nDefaults to DHCPv6.\n+optional",
+		"delegatedPrefix": "DelegatedPrefix is an IPv6 prefix (in CIDR notation) delegated to the guest\nthrough DHCPv6 prefix delegation. Requires the DHCPv6 mode.\n+optional",
 	}
 }
 
@@ -33,7 +33,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
-	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
@@ -451,6 +450,9 @@ type VolumeStatus struct {
 	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
 	// ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk
 	ContainerDiskVolume *ContainerDiskInfo `json:"containerDiskVolume,omitempty"`
+	// IOTune shows the I/O limits currently applied to the disk of the volume
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
 }
 
 // KernelInfo show info about the kernel image
@@ -497,6 +499,9 @@ type HotplugVolumeStatus struct {
 type ContainerDiskInfo struct {
 	// Checksum is the checksum of the rootdisk or kernel artifacts inside the containerdisk
 	Checksum uint32 `json:"checksum,omitempty"`
+	// ImageID is the image reference with the digest of the containerdisk, as reported by the container runtime
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
 }
 
 // VolumePhase indicates the current phase of the hotplug process.
@@ -713,6 +718,9 @@ const (
 	// Reflects whether the QEMU guest agent updated access credentials successfully
 	VirtualMachineInstanceAccessCredentialsSynchronized VirtualMachineInstanceConditionType = "AccessCredentialsSynchronized"
 
+	// Reflects whether the QEMU guest agent grew the guest filesystems of the expanded disks
+	VirtualMachineInstanceGuestFilesystemResized VirtualMachineInstanceConditionType = "GuestFilesystemResized"
+
 	// Reflects whether the QEMU guest agent is connected through the channel
 	VirtualMachineInstanceUnsupportedAgent VirtualMachineInstanceConditionType = "AgentVersionNotSupported"
 
@@ -789,6 +797,15 @@ const (
 
 	// Indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceReasonEvictionRequested = "EvictionRequested"
+
+	// Indicates that the guest filesystems of the expanded disks are being grown
+	VirtualMachineInstanceReasonFilesystemResizeInProgress = "FilesystemResizeInProgress"
+
+	// Indicates that the guest filesystems of the expanded disks were grown
+	VirtualMachineInstanceReasonFilesystemResizeSucceeded = "FilesystemResizeSucceeded"
+
+	// Indicates that growing the guest filesystems of the expanded disks failed
+	VirtualMachineInstanceReasonFilesystemResizeFailed = "FilesystemResizeFailed"
 )
 
 const (
@@ -900,6 +917,28 @@ type VirtualMachineInstanceNetworkInterface struct {
 	QueueCount int32 `json:"queueCount,omitempty"`
 	// LinkState Reports the current operational link state`. values: up, down.
 	LinkState string `json:"linkState,omitempty"`
+	// FirewallRules reports the counters of the firewall rules enforced on the interface.
+	// +optional
+	// +listType=atomic
+	FirewallRules []FirewallRuleStatus `json:"firewallRules,omitempty"`
+}
+
+type FirewallDirection string
+
+const (
+	FirewallDirectionIngress FirewallDirection = "Ingress"
+	FirewallDirectionEgress  FirewallDirection = "Egress"
+)
+
+type FirewallRuleStatus struct {
+	// Name of the firewall rule
+	Name string `json:"name"`
+	// Direction of the traffic filtered by the rule
+	Direction FirewallDirection `json:"direction"`
+	// Packets is the number of packets matched by the rule
+	Packets int64 `json:"packets"`
+	// Bytes is the number of bytes matched by the rule
+	Bytes int64 `json:"bytes"`
 }
 
 type VirtualMachineInstanceGuestOSInfo struct {
@@ -1053,8 +1092,54 @@ type VirtualMachineInstanceMigrationState struct {
 	TargetState *VirtualMachineInstanceMigrationTargetState `json:"targetState,omitempty"`
 	// The type of migration network, either 'pod' or 'migration'
 	MigrationNetworkType MigrationNetworkType `json:"migrationNetworkType,omitempty"`
+	// MigratedVolumesProgress reports how much of every migrated volume has been copied to the target
+	// +listType=atomic
+	// +optional
+	MigratedVolumesProgress []StorageMigratedVolumeProgress `json:"migratedVolumesProgress,omitempty"`
+	// MigratedInterfaces reports the preservation of the guest address of every interface
+	// that keeps its IP across the migration
+	// +listType=atomic
+	// +optional
+	MigratedInterfaces []MigratedInterfaceState `json:"migratedInterfaces,omitempty"`
+}
+
+// StorageMigratedVolumeProgress reports the copy progress of a volume being migrated
+type StorageMigratedVolumeProgress struct {
+	// VolumeName is the name of the volume that is being migrated
+	VolumeName string `json:"volumeName"`
+	// BytesCopied is the amount of data already copied to the destination volume
+	BytesCopied int64 `json:"bytesCopied"`
+	// TotalBytes is the amount of data to copy to the destination volume
+	TotalBytes int64 `json:"totalBytes"`
+}
+
+// MigratedInterfaceState reports the preservation of the guest address of an interface being migrated
+type MigratedInterfaceState struct {
+	// Name is the name of the interface
+	Name string `json:"name"`
+	// Phase of the guest address preservation
+	Phase MigratedInterfacePhase `json:"phase,omitempty"`
+	// GuestIP is the address, in CIDR notation, the guest keeps across the migration
+	GuestIP string `json:"guestIP,omitempty"`
+	// GuestGateway is the gateway address the guest keeps across the migration
+	GuestGateway string `json:"guestGateway,omitempty"`
+	// TargetIP is the address of the target pod the guest address is translated to
+	TargetIP string `json:"targetIP,omitempty"`
+	// Message is a human readable detail of the phase
+	Message string `json:"message,omitempty"`
 }
 
+type MigratedInterfacePhase string
+
+const (
+	// MigratedInterfacePending means the source published the guest address and the target did not translate it yet
+	MigratedInterfacePending MigratedInterfacePhase = "Pending"
+	// MigratedInterfaceReady means the target pod translates the guest address to its own
+	MigratedInterfaceReady MigratedInterfacePhase = "Ready"
+	// MigratedInterfaceFailed means the guest address cannot be preserved and the guest has to renew it
+	MigratedInterfaceFailed MigratedInterfacePhase = "Failed"
+)
+
 type MigrationAbortStatus string
 
 const (
@@ -1409,6 +1494,10 @@ const (
 	// MigrationInterfaceName is an arbitrary name used in virt-handler to connect it to a dedicated migration network
 	MigrationInterfaceName string = "migration0"
 
+	// HotplugFilesystemsAnnotation marks a VirtualMachineInstance which was started with shared guest memory,
+	// so that virtiofs filesystems can be hotplugged into it
+	HotplugFilesystemsAnnotation string = "alpha.kubevirt.io/hotplug-filesystems"
+
 	// EmulatorThreadCompleteToEvenParity alpha annotation will cause Kubevirt to complete the VMI's CPU count to an even parity when IsolateEmulatorThread options are requested
 	EmulatorThreadCompleteToEvenParity string = "alpha.kubevirt.io/EmulatorThreadCompleteToEvenParity"
 
@@ -1429,6 +1518,10 @@ const (
 	// This could be useful to distinguish evictions originated from the descheduler.
 	EvictionSourceAnnotation = "kubevirt.io/eviction-source"
 
+	// StorageMigrationBandwidthAnnotation limits the bandwidth of the live migrations that move the volumes of a
+	// VirtualMachineInstance. It can only lower the bandwidth set by the cluster configuration or a MigrationPolicy.
+	StorageMigrationBandwidthAnnotation = "kubevirt.io/storage-migration-bandwidth"
+
 	// AllowAccessClusterServicesNPLabel is a pod label to be set by virt-components to indicate that they require
 	// access to cluster services otherwise blocked by the strict network policy (NP).
 	// This label will be applied to the following virt pods:
@@ -2108,6 +2201,12 @@ type VirtualMachineStatus struct {
 	// +optional
 	MemoryDumpRequest *VirtualMachineMemoryDumpRequest `json:"memoryDumpRequest,omitempty" optional:"true"`
 
+	// ContainerDiskCommitRequest tracks the request to commit a persistent containerDisk
+	// into a PVC
+	// +nullable
+	// +optional
+	ContainerDiskCommitRequest *VirtualMachineContainerDiskCommitRequest `json:"containerDiskCommitRequest,omitempty" optional:"true"`
+
 	// ObservedGeneration is the generation observed by the vmi when started.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty" optional:"true"`
@@ -2194,6 +2293,33 @@ const (
 	ChangedBlockTrackingFGDisabled ChangedBlockTrackingState = "IncrementalBackupFeatureGateDisabled"
 )
 
+// BackupVolumeInfo contains information about a volume included in a backup
+type BackupVolumeInfo struct {
+	// VolumeName is the volume name from VMI spec
+	VolumeName string `json:"volumeName"`
+	// DiskTarget is the disk target device name at backup time
+	DiskTarget string `json:"diskTarget"`
+	// Claim describes the PVC which backed the volume at backup time
+	// +optional
+	Claim *BackupVolumeClaim `json:"claim,omitempty"`
+}
+
+// BackupVolumeClaim describes the PVC of a backed up volume, it is used to
+// provision the PVC the volume is restored into
+type BackupVolumeClaim struct {
+	// ClaimName is the name of the PVC
+	ClaimName string `json:"claimName"`
+	// Capacity is the capacity of the PVC
+	Capacity resource.Quantity `json:"capacity"`
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// +optional
+	VolumeMode *k8sv1.PersistentVolumeMode `json:"volumeMode,omitempty"`
+	// +optional
+	// +listType=atomic
+	AccessModes []k8sv1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+}
+
 // VirtualMachineInstanceBackupStatus tracks the information of the executed backup
 // +k8s:openapi-gen=true
 type VirtualMachineInstanceBackupStatus struct {
@@ -2217,7 +2343,7 @@ type VirtualMachineInstanceBackupStatus struct {
 	// Volumes lists the volumes included in the backup
 	// +optional
 	// +listType=atomic
-	Volumes []backupv1.BackupVolumeInfo `json:"volumes,omitempty"`
+	Volumes []BackupVolumeInfo `json:"volumes,omitempty"`
 }
 
 // ChangedBlockTrackingStatus represents the status of ChangedBlockTracking for a VM
@@ -2916,6 +3042,111 @@ type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
 }
 
+// GuestExecOptions represent a command executed in the guest through the guest agent
+type GuestExecOptions struct {
+	// Command is the executable and its arguments
+	// +listType=atomic
+	Command []string `json:"command"`
+	// TimeoutSeconds limits how long the command may run
+	TimeoutSeconds int32 `json:"timeoutSeconds"`
+}
+
+// FreezeHooks defines hooks which run around the freeze of the guest
+// filesystem, they let applications in the guest reach a consistent state
+// before the freeze and resume after the thaw
+type FreezeHooks struct {
+	// PreFreeze hooks run in order before the guest filesystem is frozen
+	// +optional
+	// +listType=atomic
+	PreFreeze []FreezeHook `json:"preFreeze,omitempty"`
+	// PostThaw hooks run in order after the guest filesystem is thawed
+	// +optional
+	// +listType=atomic
+	PostThaw []FreezeHook `json:"postThaw,omitempty"`
+}
+
+// FreezeHook is a single action which runs before the guest filesystem is
+// frozen or after it is thawed. Exactly one of GuestExec and HTTP must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.guestExec) != has(self.http)",message="exactly one of guestExec or http must be set"
+type FreezeHook struct {
+	// Name identifies the hook in the recorded results
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +optional
+	// GuestExec runs a command in the guest through the guest agent, it is only allowed for users who may run commands in the VMI through its guestexec subresource
+	GuestExec *GuestExecHook `json:"guestExec,omitempty"`
+	// +optional
+	// HTTP sends a POST request to a pod in the namespace of the VM
+	HTTP *HTTPHook `json:"http,omitempty"`
+	// +optional
+	// Timeout limits how long the hook may run. Defaults to 30s.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// +optional
+	// +kubebuilder:validation:Enum=Fail;Continue
+	// OnFailure defines what happens when the hook fails. Fail, the default,
+	// fails the operation. Continue records the failure and goes on.
+	OnFailure *FreezeHookFailurePolicy `json:"onFailure,omitempty"`
+}
+
+// GuestExecHook runs a command in the guest through the guest agent
+type GuestExecHook struct {
+	// Command is the executable and its arguments, it is not run in a shell.
+	// The hook fails if the command exits with a non zero code.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Command []string `json:"command"`
+}
+
+// HTTPHook sends a POST request to a pod
+type HTTPHook struct {
+	// PodName is the name of the pod which receives the request, it must be
+	// in the namespace of the VM and labeled kubevirt.io/freeze-hook-target=true.
+	// Pods using the host network are not supported.
+	// +kubebuilder:validation:MinLength=1
+	PodName string `json:"podName"`
+	// Port is the port the pod listens on, it must be declared by a container
+	// of the pod
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// +optional
+	// Path is the path of the request
+	Path string `json:"path,omitempty"`
+}
+
+// FreezeHookFailurePolicy is the const type for the handling of failed hooks
+type FreezeHookFailurePolicy string
+
+const (
+	// FreezeHookFail fails the operation when the hook fails
+	FreezeHookFail FreezeHookFailurePolicy = "Fail"
+	// FreezeHookContinue records the failure of the hook and continues
+	FreezeHookContinue FreezeHookFailurePolicy = "Continue"
+)
+
+// FreezeHookStage is the const type for the point at which a hook runs
+type FreezeHookStage string
+
+const (
+	// PreFreezeStage hooks run before the guest filesystem is frozen
+	PreFreezeStage FreezeHookStage = "PreFreeze"
+	// PostThawStage hooks run after the guest filesystem is thawed
+	PostThawStage FreezeHookStage = "PostThaw"
+)
+
+// FreezeHookResult is the outcome of a hook
+type FreezeHookResult struct {
+	// Name is the name of the hook
+	Name string `json:"name"`
+	// Stage is the point at which the hook ran
+	Stage FreezeHookStage `json:"stage"`
+	// Succeeded indicates that the hook completed successfully
+	Succeeded bool `json:"succeeded"`
+	// +optional
+	// Message describes the failure of the hook
+	Message string `json:"message,omitempty"`
+}
+
 // VirtualMachineMemoryDumpRequest represent the memory dump request phase and info
 type VirtualMachineMemoryDumpRequest struct {
 	// ClaimName is the name of the pvc that will contain the memory dump
@@ -2937,8 +3168,21 @@ type VirtualMachineMemoryDumpRequest struct {
 	// Message is a detailed message about failure of the memory dump
 	// +optional
 	Message string `json:"message,omitempty"`
+	// Format represents the format of the memory dump, defaults to Raw
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
+type MemoryDumpFormat string
+
+const (
+	// The memorydump contains the raw guest memory for analysis
+	MemoryDumpFormatRaw MemoryDumpFormat = "Raw"
+	// The memorydump contains the device and RAM state of the VMI,
+	// which can be used to start the VMI from the point it was saved at
+	MemoryDumpFormatSavedState MemoryDumpFormat = "SavedState"
+)
+
 type MemoryDumpPhase string
 
 const (
@@ -2956,14 +3200,54 @@ const (
 	MemoryDumpFailed MemoryDumpPhase = "Failed"
 )
 
+// VirtualMachineContainerDiskCommitRequest represents the request to write a persistent
+// containerDisk, its base image along with the writes kept in its overlay, into a PVC
+type VirtualMachineContainerDiskCommitRequest struct {
+	// VolumeName is the name of the persistent containerDisk volume to commit
+	VolumeName string `json:"volumeName"`
+	// ClaimName is the name of the PVC receiving the disk, it can be the PVC of a blank DataVolume
+	ClaimName string `json:"claimName"`
+	// Phase represents the commit phase
+	// +optional
+	Phase ContainerDiskCommitPhase `json:"phase,omitempty"`
+	// StartTimestamp represents the time the commit started
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp represents the time the commit completed
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// Message is a detailed message about failure of the commit
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type ContainerDiskCommitPhase string
+
+const (
+	// The commit waits for its target PVC
+	ContainerDiskCommitPending ContainerDiskCommitPhase = "Pending"
+	// The commit is in progress
+	ContainerDiskCommitInProgress ContainerDiskCommitPhase = "InProgress"
+	// The commit is completed
+	ContainerDiskCommitCompleted ContainerDiskCommitPhase = "Completed"
+	// The commit failed
+	ContainerDiskCommitFailed ContainerDiskCommitPhase = "Failed"
+)
+
 // AddVolumeOptions is provided when dynamically hot plugging a volume and disk
 type AddVolumeOptions struct {
 	// Name represents the name that will be used to map the
 	// disk to the corresponding volume. This overrides any name
 	// set inside the Disk struct itself.
 	Name string `json:"name"`
-	// Disk represents the hotplug disk that will be plugged into the running VMI
-	Disk *Disk `json:"disk"`
+	// Disk represents the hotplug disk that will be plugged into the running VMI.
+	// Either Disk or Filesystem must be set.
+	// +optional
+	Disk *Disk `json:"disk,omitempty"`
+	// Filesystem represents the virtiofs filesystem that will be plugged into the running VMI
+	// instead of a disk.
+	// +optional
+	Filesystem *Filesystem `json:"filesystem,omitempty"`
 	// VolumeSource represents the source of the volume to map to the disk.
 	VolumeSource *HotplugVolumeSource `json:"volumeSource"`
 	// When present, indicates that modifications should not be
@@ -3080,7 +3364,13 @@ type KubeVirtConfiguration struct {
 	SeccompConfiguration           *SeccompConfiguration             `json:"seccompConfiguration,omitempty"`
 
 	// VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.
-	VMStateStorageClass   string                 `json:"vmStateStorageClass,omitempty"`
+	VMStateStorageClass string `json:"vmStateStorageClass,omitempty"`
+
+	// VMStateEncryption configures the encryption of the VM state, like TPM, stored in the VMStateStorageClass PVCs.
+	// When omitted, the VM state is stored unencrypted.
+	// +optional
+	VMStateEncryption *VMStateEncryption `json:"vmStateEncryption,omitempty"`
+
 	VirtualMachineOptions *VirtualMachineOptions `json:"virtualMachineOptions,omitempty"`
 
 	// KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).
@@ -3511,6 +3801,50 @@ type NodeMediatedDeviceTypesConfig struct {
 	MediatedDeviceTypes []string `json:"mediatedDeviceTypes"`
 }
 
+// VMStateKeyProvider is the source of the keys encrypting the VM state
+type VMStateKeyProvider string
+
+const (
+	// VMStateKeyProviderSecret keeps the random key of the VM state of each VM in a Secret
+	VMStateKeyProviderSecret VMStateKeyProvider = "Secret"
+	// VMStateKeyProviderKMS only keeps the random key of the VM state of each VM wrapped with a key encryption key held by a KMS
+	VMStateKeyProviderKMS VMStateKeyProvider = "KMS"
+)
+
+// VMStateEncryption configures the encryption of the VM state, the TPM state and the EFI NVRAM.
+// Each VM gets its own key, stored in a Secret owned by its backend storage PVC.
+// Only new, empty, PVCs get encrypted, and a migration target PVC follows its source.
+// The key is kept for the life of the PVC, as libvirt migrates the TPM state with the key of the source.
+// Every live migration only re-wraps it with the current key encryption key, which changes nothing
+// with the Secret key provider.
+// While the VM runs, its key is handed over to virt-launcher in clear through a Secret in the namespace of the VM,
+// so the encryption protects the VM state from those who can read the PVC or its storage,
+// not from those who can read the Secrets of the namespace.
+// +k8s:openapi-gen=true
+type VMStateEncryption struct {
+	// KeyProvider is the source of the keys, either Secret or KMS.
+	// Defaults to Secret.
+	// +optional
+	// +kubebuilder:validation:Enum=Secret;KMS
+	KeyProvider VMStateKeyProvider `json:"keyProvider,omitempty"`
+
+	// KMS configures the KMS key provider.
+	// +optional
+	KMS *VMStateKMS `json:"kms,omitempty"`
+}
+
+// VMStateKMS configures the KMS holding the key encryption key of the VM state keys.
+// The key encryption key is read from a Secret in the KubeVirt install namespace,
+// standing in for a KMS plugin.
+// +k8s:openapi-gen=true
+type VMStateKMS struct {
+	// KeyEncryptionKeySecret is the name of the Secret in the KubeVirt install namespace
+	// holding the key encryption key under the "key" entry.
+	// To rotate the key encryption key, move it to the "previous-key" entry and set the new one,
+	// the keys get re-wrapped with the new one on their next live migration.
+	KeyEncryptionKeySecret string `json:"keyEncryptionKeySecret"`
+}
+
 // KSMConfiguration holds information about KSM.
 // +k8s:openapi-gen=true
 type KSMConfiguration struct {
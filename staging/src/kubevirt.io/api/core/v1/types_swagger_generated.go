@@ -157,6 +157,7 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"size":                      "Represents the size of the volume",
 		"memoryDumpVolume":          "If the volume is memorydump volume, this will contain the memorydump info.",
 		"containerDiskVolume":       "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
+		"ioTune":                    "IOTune shows the I/O limits currently applied to the disk of the volume\n+optional",
 	}
 }
 
@@ -204,6 +205,7 @@ func (ContainerDiskInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "ContainerDiskInfo shows info about the containerdisk",
 		"checksum": "Checksum is the checksum of the rootdisk or kernel artifacts inside the containerdisk",
+		"imageID":  "ImageID is the image reference with the digest of the containerdisk, as reported by the container runtime\n+optional",
 	}
 }
 
@@ -232,6 +234,16 @@ func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
 		"queueCount":       "Specifies how many queues are allocated by MultiQueue",
 		"linkState":        "LinkState Reports the current operational link state`. values: up, down.",
+		"firewallRules":    "FirewallRules reports the counters of the firewall rules enforced on the interface.\n+optional\n+listType=atomic",
+	}
+}
+
+func (FirewallRuleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"name":      "Name of the firewall rule",
+		"direction": "Direction of the traffic filtered by the rule",
+		"packets":   "Packets is the number of packets matched by the rule",
+		"bytes":     "Bytes is the number of bytes matched by the rule",
 	}
 }
 
@@ -311,6 +323,29 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"sourceState":                    "SourceState contains migration state managed by the source virt handler",
 		"targetState":                    "TargetState contains migration state managed by the target virt handler",
 		"migrationNetworkType":           "The type of migration network, either 'pod' or 'migration'",
+		"migratedVolumesProgress":        "MigratedVolumesProgress reports how much of every migrated volume has been copied to the target\n+listType=atomic\n+optional",
+		"migratedInterfaces":             "MigratedInterfaces reports the preservation of the guest address of every interface\nthat keeps its IP across the migration\n+listType=atomic\n+optional",
+	}
+}
+
+func (StorageMigratedVolumeProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "StorageMigratedVolumeProgress reports the copy progress of a volume being migrated",
+		"volumeName":  "VolumeName is the name of the volume that is being migrated",
+		"bytesCopied": "BytesCopied is the amount of data already copied to the destination volume",
+		"totalBytes":  "TotalBytes is the amount of data to copy to the destination volume",
+	}
+}
+
+func (MigratedInterfaceState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "MigratedInterfaceState reports the preservation of the guest address of an interface being migrated",
+		"name":         "Name is the name of the interface",
+		"phase":        "Phase of the guest address preservation",
+		"guestIP":      "GuestIP is the address, in CIDR notation, the guest keeps across the migration",
+		"guestGateway": "GuestGateway is the gateway address the guest keeps across the migration",
+		"targetIP":     "TargetIP is the address of the target pod the guest address is translated to",
+		"message":      "Message is a human readable detail of the phase",
 	}
 }
 
@@ -483,25 +518,26 @@ func (VirtualMachineStartFailure) SwaggerDoc() map[string]string {
 
 func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                       "VirtualMachineStatus represents the status returned by the\ncontroller to describe how the VirtualMachine is doing",
-		"snapshotInProgress":     "SnapshotInProgress is the name of the VirtualMachineSnapshot currently executing",
-		"restoreInProgress":      "RestoreInProgress is the name of the VirtualMachineRestore currently executing",
-		"created":                "Created indicates if the virtual machine is created in the cluster",
-		"ready":                  "Ready indicates if the virtual machine is running and ready",
-		"printableStatus":        "PrintableStatus is a human readable, high-level representation of the status of the virtual machine\n+kubebuilder:default=Stopped",
-		"conditions":             "Hold the state information of the VirtualMachine and its VirtualMachineInstance",
-		"stateChangeRequests":    "StateChangeRequests indicates a list of actions that should be taken on a VMI\ne.g. stop a specific VMI then start a new one.",
-		"volumeRequests":         "VolumeRequests indicates a list of volumes add or remove from the VMI template and\nhotplug on an active running VMI.\n+listType=atomic",
-		"volumeSnapshotStatuses": "VolumeSnapshotStatuses indicates a list of statuses whether snapshotting is\nsupported by each volume.",
-		"startFailure":           "StartFailure tracks consecutive VMI startup failures for the purposes of\ncrash loop backoffs\n+nullable\n+optional",
-		"memoryDumpRequest":      "MemoryDumpRequest tracks memory dump request phase and info of getting a memory\ndump to the given pvc\n+nullable\n+optional",
-		"observedGeneration":     "ObservedGeneration is the generation observed by the vmi when started.\n+optional",
-		"desiredGeneration":      "DesiredGeneration is the generation which is desired for the VMI.\nThis will be used in comparisons with ObservedGeneration to understand when\nthe VMI is out of sync. This will be changed at the same time as\nObservedGeneration to remove errors which could occur if Generation is\nupdated through an Update() before ObservedGeneration in Status.\n+optional",
-		"runStrategy":            "RunStrategy tracks the last recorded RunStrategy used by the VM.\nThis is needed to correctly process the next strategy (for now only the RerunOnFailure)",
-		"volumeUpdateState":      "VolumeUpdateState contains the information about the volumes set\nupdates related to the volumeUpdateStrategy",
-		"changedBlockTracking":   "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
-		"instancetypeRef":        "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
-		"preferenceRef":          "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
+		"":                           "VirtualMachineStatus represents the status returned by the\ncontroller to describe how the VirtualMachine is doing",
+		"snapshotInProgress":         "SnapshotInProgress is the name of the VirtualMachineSnapshot currently executing",
+		"restoreInProgress":          "RestoreInProgress is the name of the VirtualMachineRestore currently executing",
+		"created":                    "Created indicates if the virtual machine is created in the cluster",
+		"ready":                      "Ready indicates if the virtual machine is running and ready",
+		"printableStatus":            "PrintableStatus is a human readable, high-level representation of the status of the virtual machine\n+kubebuilder:default=Stopped",
+		"conditions":                 "Hold the state information of the VirtualMachine and its VirtualMachineInstance",
+		"stateChangeRequests":        "StateChangeRequests indicates a list of actions that should be taken on a VMI\ne.g. stop a specific VMI then start a new one.",
+		"volumeRequests":             "VolumeRequests indicates a list of volumes add or remove from the VMI template and\nhotplug on an active running VMI.\n+listType=atomic",
+		"volumeSnapshotStatuses":     "VolumeSnapshotStatuses indicates a list of statuses whether snapshotting is\nsupported by each volume.",
+		"startFailure":               "StartFailure tracks consecutive VMI startup failures for the purposes of\ncrash loop backoffs\n+nullable\n+optional",
+		"memoryDumpRequest":          "MemoryDumpRequest tracks memory dump request phase and info of getting a memory\ndump to the given pvc\n+nullable\n+optional",
+		"containerDiskCommitRequest": "ContainerDiskCommitRequest tracks the request to commit a persistent containerDisk\ninto a PVC\n+nullable\n+optional",
+		"observedGeneration":         "ObservedGeneration is the generation observed by the vmi when started.\n+optional",
+		"desiredGeneration":          "DesiredGeneration is the generation which is desired for the VMI.\nThis will be used in comparisons with ObservedGeneration to understand when\nthe VMI is out of sync. This will be changed at the same time as\nObservedGeneration to remove errors which could occur if Generation is\nupdated through an Update() before ObservedGeneration in Status.\n+optional",
+		"runStrategy":                "RunStrategy tracks the last recorded RunStrategy used by the VM.\nThis is needed to correctly process the next strategy (for now only the RerunOnFailure)",
+		"volumeUpdateState":          "VolumeUpdateState contains the information about the volumes set\nupdates related to the volumeUpdateStrategy",
+		"changedBlockTracking":       "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
+		"instancetypeRef":            "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
+		"preferenceRef":              "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
 	}
 }
 
@@ -521,6 +557,26 @@ func (InstancetypeStatusRef) SwaggerDoc() map[string]string {
 	}
 }
 
+func (BackupVolumeInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "BackupVolumeInfo contains information about a volume included in a backup",
+		"volumeName": "VolumeName is the volume name from VMI spec",
+		"diskTarget": "DiskTarget is the disk target device name at backup time",
+		"claim":      "Claim describes the PVC which backed the volume at backup time\n+optional",
+	}
+}
+
+func (BackupVolumeClaim) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "BackupVolumeClaim describes the PVC of a backed up volume, it is used to\nprovision the PVC the volume is restored into",
+		"claimName":        "ClaimName is the name of the PVC",
+		"capacity":         "Capacity is the capacity of the PVC",
+		"storageClassName": "+optional",
+		"volumeMode":       "+optional",
+		"accessModes":      "+optional\n+listType=atomic",
+	}
+}
+
 func (VirtualMachineInstanceBackupStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineInstanceBackupStatus tracks the information of the executed backup\n+k8s:openapi-gen=true",
@@ -835,6 +891,59 @@ func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestExecOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "GuestExecOptions represent a command executed in the guest through the guest agent",
+		"command":        "Command is the executable and its arguments\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds limits how long the command may run",
+	}
+}
+
+func (FreezeHooks) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "FreezeHooks defines hooks which run around the freeze of the guest\nfilesystem, they let applications in the guest reach a consistent state\nbefore the freeze and resume after the thaw",
+		"preFreeze": "PreFreeze hooks run in order before the guest filesystem is frozen\n+optional\n+listType=atomic",
+		"postThaw":  "PostThaw hooks run in order after the guest filesystem is thawed\n+optional\n+listType=atomic",
+	}
+}
+
+func (FreezeHook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "FreezeHook is a single action which runs before the guest filesystem is\nfrozen or after it is thawed. Exactly one of GuestExec and HTTP must be set.\n+kubebuilder:validation:XValidation:rule=\"has(self.guestExec) != has(self.http)\",message=\"exactly one of guestExec or http must be set\"",
+		"name":      "Name identifies the hook in the recorded results\n+kubebuilder:validation:MinLength=1",
+		"guestExec": "+optional\nGuestExec runs a command in the guest through the guest agent, it is only allowed for users who may run commands in the VMI through its guestexec subresource",
+		"http":      "+optional\nHTTP sends a POST request to a pod in the namespace of the VM",
+		"timeout":   "+optional\nTimeout limits how long the hook may run. Defaults to 30s.",
+		"onFailure": "+optional\n+kubebuilder:validation:Enum=Fail;Continue\nOnFailure defines what happens when the hook fails. Fail, the default,\nfails the operation. Continue records the failure and goes on.",
+	}
+}
+
+func (GuestExecHook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "GuestExecHook runs a command in the guest through the guest agent",
+		"command": "Command is the executable and its arguments, it is not run in a shell.\nThe hook fails if the command exits with a non zero code.\n+kubebuilder:validation:MinItems=1\n+listType=atomic",
+	}
+}
+
+func (HTTPHook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "HTTPHook sends a POST request to a pod",
+		"podName": "PodName is the name of the pod which receives the request, it must be\nin the namespace of the VM and labeled kubevirt.io/freeze-hook-target=true.\nPods using the host network are not supported.\n+kubebuilder:validation:MinLength=1",
+		"port":    "Port is the port the pod listens on, it must be declared by a container\nof the pod\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=65535",
+		"path":    "+optional\nPath is the path of the request",
+	}
+}
+
+func (FreezeHookResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "FreezeHookResult is the outcome of a hook",
+		"name":      "Name is the name of the hook",
+		"stage":     "Stage is the point at which the hook ran",
+		"succeeded": "Succeeded indicates that the hook completed successfully",
+		"message":   "+optional\nMessage describes the failure of the hook",
+	}
+}
+
 func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info",
@@ -845,6 +954,19 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
 		"fileName":       "FileName represents the name of the output file\n+optional",
 		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
+		"format":         "Format represents the format of the memory dump, defaults to Raw\n+optional",
+	}
+}
+
+func (VirtualMachineContainerDiskCommitRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineContainerDiskCommitRequest represents the request to write a persistent\ncontainerDisk, its base image along with the writes kept in its overlay, into a PVC",
+		"volumeName":     "VolumeName is the name of the persistent containerDisk volume to commit",
+		"claimName":      "ClaimName is the name of the PVC receiving the disk, it can be the PVC of a blank DataVolume",
+		"phase":          "Phase represents the commit phase\n+optional",
+		"startTimestamp": "StartTimestamp represents the time the commit started\n+optional",
+		"endTimestamp":   "EndTimestamp represents the time the commit completed\n+optional",
+		"message":        "Message is a detailed message about failure of the commit\n+optional",
 	}
 }
 
@@ -852,7 +974,8 @@ func (AddVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":             "AddVolumeOptions is provided when dynamically hot plugging a volume and disk",
 		"name":         "Name represents the name that will be used to map the\ndisk to the corresponding volume. This overrides any name\nset inside the Disk struct itself.",
-		"disk":         "Disk represents the hotplug disk that will be plugged into the running VMI",
+		"disk":         "Disk represents the hotplug disk that will be plugged into the running VMI.\nEither Disk or Filesystem must be set.\n+optional",
+		"filesystem":   "Filesystem represents the virtiofs filesystem that will be plugged into the running VMI\ninstead of a disk.\n+optional",
 		"volumeSource": "VolumeSource represents the source of the volume to map to the disk.",
 		"dryRun":       "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
 	}
@@ -911,6 +1034,7 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"supportedGuestAgentVersions":        "deprecated",
 		"minCPUModel":                        "deprecated",
 		"vmStateStorageClass":                "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.",
+		"vmStateEncryption":                  "VMStateEncryption configures the encryption of the VM state, like TPM, stored in the VMStateStorageClass PVCs.\nWhen omitted, the VM state is stored unencrypted.\n+optional",
 		"ksmConfiguration":                   "KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).",
 		"autoCPULimitNamespaceLabelSelector": "When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside\nnamespaces that match the label selector.\nThe CPU limit will equal the number of requested vCPUs.\nThis setting does not apply to VMIs with dedicated CPUs.",
 		"liveUpdateConfiguration":            "LiveUpdateConfiguration holds defaults for live update features",
@@ -1122,6 +1246,21 @@ func (NodeMediatedDeviceTypesConfig) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VMStateEncryption) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VMStateEncryption configures the encryption of the VM state, the TPM state and the EFI NVRAM.\nEach VM gets its own key, stored in a Secret owned by its backend storage PVC.\nOnly new, empty, PVCs get encrypted, and a migration target PVC follows its source.\nThe key is kept for the life of the PVC, as libvirt migrates the TPM state with the key of the source.\nEvery live migration only re-wraps it with the current key encryption key, which changes nothing\nwith the Secret key provider.\nWhile the VM runs, its key is handed over to virt-launcher in clear through a Secret in the namespace of the VM,\nso the encryption protects the VM state from those who can read the PVC or its storage,\nnot from those who can read the Secrets of the namespace.\n+k8s:openapi-gen=true",
+		"keyProvider": "KeyProvider is the source of the keys, either Secret or KMS.\nDefaults to Secret.\n+optional\n+kubebuilder:validation:Enum=Secret;KMS",
+		"kms":         "KMS configures the KMS key provider.\n+optional",
+	}
+}
+
+func (VMStateKMS) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "VMStateKMS configures the KMS holding the key encryption key of the VM state keys.\nThe key encryption key is read from a Secret in the KubeVirt install namespace,\nstanding in for a KMS plugin.\n+k8s:openapi-gen=true",
+		"keyEncryptionKeySecret": "KeyEncryptionKeySecret is the name of the Secret in the KubeVirt install namespace\nholding the key encryption key under the \"key\" entry.\nTo rotate the key encryption key, move it to the \"previous-key\" entry and set the new one,\nthe keys get re-wrapped with the new one on their next live migration.",
+	}
+}
+
 func (KSMConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "KSMConfiguration holds information about KSM.\n+k8s:openapi-gen=true",
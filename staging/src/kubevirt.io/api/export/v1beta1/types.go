@@ -55,6 +55,8 @@ type VirtualMachineExportList struct {
 
 // VirtualMachineExportSpec is the spec for a VirtualMachineExport resource
 type VirtualMachineExportSpec struct {
+	// Source is the PersistentVolumeClaim, VirtualMachine, VirtualMachineSnapshot
+	// or push mode VirtualMachineBackup to export
 	Source corev1.TypedLocalObjectReference `json:"source"`
 
 	// +optional
@@ -157,6 +159,8 @@ const (
 	AllManifests ExportManifestType = "all"
 	// AuthHeader returns a CDI compatible secret containing the token as an Auth header
 	AuthHeader ExportManifestType = "auth-header-secret"
+	// OVA returns an OVA archive of the VM with its disks as qcow2 images, only available when exporting a VirtualMachine
+	OVA ExportManifestType = "ova"
 )
 
 // VirtualMachineExportVolume contains the name and available formats for the exported volume
@@ -180,6 +184,17 @@ const (
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// Qcow2 is the volume in qcow2 format, zero clusters are not included
+	Qcow2 ExportVolumeFormat = "qcow2"
+	// Qcow2Zstd is the volume in qcow2 format with zstd compressed clusters, zero clusters are not included
+	Qcow2Zstd ExportVolumeFormat = "qcow2-zstd"
+	// SHA256 is the SHA-256 digest of the volume in RAW format, in the format of sha256sum
+	SHA256 ExportVolumeFormat = "sha256"
+	// ChangedExtents is a JSON list of the extents of the volume changed since the base checkpoint
+	// of a VirtualMachineBackup, or of all its allocated extents for a full backup
+	ChangedExtents ExportVolumeFormat = "changed-extents"
+	// ChangedData is the volume data of a VirtualMachineBackup, only ranged reads of its changed extents are served
+	ChangedData ExportVolumeFormat = "changed-data"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
@@ -19,6 +19,7 @@ func (VirtualMachineExportList) SwaggerDoc() map[string]string {
 func (VirtualMachineExportSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
+		"source":         "Source is the PersistentVolumeClaim, VirtualMachine, VirtualMachineSnapshot\nor push mode VirtualMachineBackup to export",
 		"tokenSecretRef": "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
 		"ttlDuration":    "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
 	}
@@ -225,6 +225,11 @@ func (in *DevicePreferences) DeepCopyInto(out *DevicePreferences) {
 		*out = new(v1.InterfaceMasquerade)
 		**out = **in
 	}
+	if in.PreferredInterfaceBandwidth != nil {
+		in, out := &in.PreferredInterfaceBandwidth, &out.PreferredInterfaceBandwidth
+		*out = new(v1.InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PreferredPanicDeviceModel != nil {
 		in, out := &in.PreferredPanicDeviceModel, &out.PreferredPanicDeviceModel
 		*out = new(v1.PanicDeviceModel)
@@ -663,6 +668,16 @@ func (in *VirtualMachineInstancetypeSpec) DeepCopyInto(out *VirtualMachineInstan
 		*out = new(v1.DiskIOThreads)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DiskIOTune != nil {
+		in, out := &in.DiskIOTune, &out.DiskIOTune
+		*out = new(v1.DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InterfaceBandwidth != nil {
+		in, out := &in.InterfaceBandwidth, &out.InterfaceBandwidth
+		*out = new(v1.InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LaunchSecurity != nil {
 		in, out := &in.LaunchSecurity, &out.LaunchSecurity
 		*out = new(v1.LaunchSecurity)
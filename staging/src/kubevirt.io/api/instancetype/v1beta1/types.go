@@ -116,6 +116,16 @@ type VirtualMachineInstancetypeSpec struct {
 	// +optional
 	IOThreads *v1.DiskIOThreads `json:"ioThreads,omitempty"`
 
+	// Optionally defines the I/O limits applied to every disk and LUN of the VirtualMachineInstance. It conflicts with disks defining their own.
+	//
+	// +optional
+	DiskIOTune *v1.DiskIOTune `json:"diskIOTune,omitempty"`
+
+	// Optionally defines the bandwidth limits applied to every bridge and masquerade interface of the VirtualMachineInstance. It conflicts with interfaces defining their own.
+	//
+	// +optional
+	InterfaceBandwidth *v1.InterfaceBandwidth `json:"interfaceBandwidth,omitempty"`
+
 	// Optionally defines the LaunchSecurity to be used by the instancetype.
 	//
 	// +optional
@@ -529,6 +539,11 @@ type DevicePreferences struct {
 	// +optional
 	PreferredInterfaceMasquerade *v1.InterfaceMasquerade `json:"preferredInterfaceMasquerade,omitempty"`
 
+	// PreferredInterfaceBandwidth optionally defines the preferred bandwidth limits of each bridge and masquerade interface.
+	//
+	// +optional
+	PreferredInterfaceBandwidth *v1.InterfaceBandwidth `json:"preferredInterfaceBandwidth,omitempty"`
+
 	// PreferredPanicDeviceModel optionally defines the preferred panic device model to use with panic devices.
 	//
 	// +optional
@@ -30,17 +30,19 @@ func (VirtualMachineClusterInstancetypeList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstancetypeSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                "VirtualMachineInstancetypeSpec is a description of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype.\n\nCPU and Memory are required attributes with both requiring that their Guest attribute is defined, ensuring a number of vCPUs and amount of RAM is always provided by each instancetype.",
-		"nodeSelector":    "NodeSelector is a selector which must be true for the vmi to fit on a node.\nSelector which must match a node's labels for the vmi to be scheduled on that node.\nMore info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/\n\nNodeSelector is the name of the custom node selector for the instancetype.\n+optional",
-		"schedulerName":   "If specified, the VMI will be dispatched by specified scheduler.\nIf not specified, the VMI will be dispatched by default scheduler.\n\nSchedulerName is the name of the custom K8s scheduler for the instancetype.\n+optional",
-		"cpu":             "Required CPU related attributes of the instancetype.",
-		"memory":          "Required Memory related attributes of the instancetype.",
-		"gpus":            "Optionally defines any GPU devices associated with the instancetype.\n\n+optional\n+listType=atomic",
-		"hostDevices":     "Optionally defines any HostDevices associated with the instancetype.\n\n+optional\n+listType=atomic",
-		"ioThreadsPolicy": "Optionally defines the IOThreadsPolicy to be used by the instancetype.\n\n+optional",
-		"ioThreads":       "Optionally specifies the IOThreads options to be used by the instancetype.\n+optional",
-		"launchSecurity":  "Optionally defines the LaunchSecurity to be used by the instancetype.\n\n+optional",
-		"annotations":     "Optionally defines the required Annotations to be used by the instance type and applied to the VirtualMachineInstance\n\n+optional",
+		"":                   "VirtualMachineInstancetypeSpec is a description of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype.\n\nCPU and Memory are required attributes with both requiring that their Guest attribute is defined, ensuring a number of vCPUs and amount of RAM is always provided by each instancetype.",
+		"nodeSelector":       "NodeSelector is a selector which must be true for the vmi to fit on a node.\nSelector which must match a node's labels for the vmi to be scheduled on that node.\nMore info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/\n\nNodeSelector is the name of the custom node selector for the instancetype.\n+optional",
+		"schedulerName":      "If specified, the VMI will be dispatched by specified scheduler.\nIf not specified, the VMI will be dispatched by default scheduler.\n\nSchedulerName is the name of the custom K8s scheduler for the instancetype.\n+optional",
+		"cpu":                "Required CPU related attributes of the instancetype.",
+		"memory":             "Required Memory related attributes of the instancetype.",
+		"gpus":               "Optionally defines any GPU devices associated with the instancetype.\n\n+optional\n+listType=atomic",
+		"hostDevices":        "Optionally defines any HostDevices associated with the instancetype.\n\n+optional\n+listType=atomic",
+		"ioThreadsPolicy":    "Optionally defines the IOThreadsPolicy to be used by the instancetype.\n\n+optional",
+		"ioThreads":          "Optionally specifies the IOThreads options to be used by the instancetype.\n+optional",
+		"diskIOTune":         "Optionally defines the I/O limits applied to every disk and LUN of the VirtualMachineInstance. It conflicts with disks defining their own.\n\n+optional",
+		"interfaceBandwidth": "Optionally defines the bandwidth limits applied to every bridge and masquerade interface of the VirtualMachineInstance. It conflicts with interfaces defining their own.\n\n+optional",
+		"launchSecurity":     "Optionally defines the LaunchSecurity to be used by the instancetype.\n\n+optional",
+		"annotations":        "Optionally defines the required Annotations to be used by the instance type and applied to the VirtualMachineInstance\n\n+optional",
 	}
 }
 
@@ -163,6 +165,7 @@ func (DevicePreferences) SwaggerDoc() map[string]string {
 		"preferredNetworkInterfaceMultiQueue": "PreferredNetworkInterfaceMultiQueue optionally enables the vhost multiqueue feature for virtio interfaces.\n\n+optional",
 		"preferredTPM":                        "PreferredTPM optionally defines the preferred TPM device to be used.\n\n+optional",
 		"preferredInterfaceMasquerade":        "PreferredInterfaceMasquerade optionally defines the preferred masquerade configuration to use with each network interface.\n\n+optional",
+		"preferredInterfaceBandwidth":         "PreferredInterfaceBandwidth optionally defines the preferred bandwidth limits of each bridge and masquerade interface.\n\n+optional",
 		"preferredPanicDeviceModel":           "PreferredPanicDeviceModel optionally defines the preferred panic device model to use with panic devices.\n\n+optional",
 	}
 }
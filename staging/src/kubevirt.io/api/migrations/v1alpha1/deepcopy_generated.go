@@ -24,6 +24,7 @@ Copyright The KubeVirt Authors.
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -201,3 +202,175 @@ func (in *Selectors) DeepCopy() *Selectors {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMigrationVolumeStatus) DeepCopyInto(out *StorageMigrationVolumeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMigrationVolumeStatus.
+func (in *StorageMigrationVolumeStatus) DeepCopy() *StorageMigrationVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMigrationVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMigrationWindow) DeepCopyInto(out *StorageMigrationWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMigrationWindow.
+func (in *StorageMigrationWindow) DeepCopy() *StorageMigrationWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMigrationWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigrationPlan) DeepCopyInto(out *VirtualMachineStorageMigrationPlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineStorageMigrationPlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigrationPlan.
+func (in *VirtualMachineStorageMigrationPlan) DeepCopy() *VirtualMachineStorageMigrationPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigrationPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineStorageMigrationPlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigrationPlanList) DeepCopyInto(out *VirtualMachineStorageMigrationPlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineStorageMigrationPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigrationPlanList.
+func (in *VirtualMachineStorageMigrationPlanList) DeepCopy() *VirtualMachineStorageMigrationPlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigrationPlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineStorageMigrationPlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigrationPlanSpec) DeepCopyInto(out *VirtualMachineStorageMigrationPlanSpec) {
+	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxParallelMigrations != nil {
+		in, out := &in.MaxParallelMigrations, &out.MaxParallelMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BandwidthPerMigration != nil {
+		in, out := &in.BandwidthPerMigration, &out.BandwidthPerMigration
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DeleteSourcePVCs != nil {
+		in, out := &in.DeleteSourcePVCs, &out.DeleteSourcePVCs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(StorageMigrationWindow)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigrationPlanSpec.
+func (in *VirtualMachineStorageMigrationPlanSpec) DeepCopy() *VirtualMachineStorageMigrationPlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigrationPlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigrationPlanStatus) DeepCopyInto(out *VirtualMachineStorageMigrationPlanStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]StorageMigrationVolumeStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigrationPlanStatus.
+func (in *VirtualMachineStorageMigrationPlanStatus) DeepCopy() *VirtualMachineStorageMigrationPlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigrationPlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -37,6 +37,9 @@ var (
 	// GroupVersionKind
 	MigrationPolicyKind     = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationPolicy"}
 	MigrationPolicyListKind = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationPolicyList"}
+
+	VirtualMachineStorageMigrationPlanKind     = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "VirtualMachineStorageMigrationPlan"}
+	VirtualMachineStorageMigrationPlanListKind = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "VirtualMachineStorageMigrationPlanList"}
 )
 
 // Kind takes an unqualified kind and returns back a Group qualified GroupKind
@@ -60,7 +63,10 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&MigrationPolicy{},
-		&MigrationPolicyList{})
+		&MigrationPolicyList{},
+		&VirtualMachineStorageMigrationPlan{},
+		&VirtualMachineStorageMigrationPlanList{},
+	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -112,3 +112,132 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 
 	return changed, nil
 }
+
+// VirtualMachineStorageMigrationPlan moves the volumes of a set of running VirtualMachines to a new StorageClass
+// by live migrating their storage
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualMachineStorageMigrationPlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VirtualMachineStorageMigrationPlanSpec `json:"spec" valid:"required"`
+	// +optional
+	Status *VirtualMachineStorageMigrationPlanStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineStorageMigrationPlanSpec is the spec for a VirtualMachineStorageMigrationPlan resource
+type VirtualMachineStorageMigrationPlanSpec struct {
+	// VirtualMachines lists the names of the VirtualMachines, in the namespace of the plan, whose volumes are moved
+	// +optional
+	// +listType=atomic
+	VirtualMachines []string `json:"virtualMachines,omitempty"`
+
+	// Selector selects the VirtualMachines, in the namespace of the plan, whose volumes are moved
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// TargetStorageClassName is the StorageClass the volumes are moved to
+	TargetStorageClassName string `json:"targetStorageClassName"`
+
+	// MaxParallelMigrations is the maximum number of VirtualMachines whose volumes are migrated at the same time.
+	// Defaults to 1
+	// +optional
+	MaxParallelMigrations *int32 `json:"maxParallelMigrations,omitempty"`
+
+	// BandwidthPerMigration limits the bandwidth used by each storage migration
+	// +optional
+	BandwidthPerMigration *resource.Quantity `json:"bandwidthPerMigration,omitempty"`
+
+	// DeleteSourcePVCs deletes the source PersistentVolumeClaims and DataVolumes of a VirtualMachine once all of its volumes were moved
+	// +optional
+	DeleteSourcePVCs *bool `json:"deleteSourcePVCs,omitempty"`
+
+	// Window restricts the time of day at which the storage migration of a VirtualMachine may start.
+	// Storage migrations started inside the window run to completion
+	// +optional
+	Window *StorageMigrationWindow `json:"window,omitempty"`
+}
+
+// StorageMigrationWindowTimeFormat is the layout of the start and end of a StorageMigrationWindow
+const StorageMigrationWindowTimeFormat = "15:04"
+
+// StorageMigrationWindow is a daily window, in UTC, during which a VirtualMachineStorageMigrationPlan starts storage migrations
+type StorageMigrationWindow struct {
+	// Start is the time of day the window opens at, in the HH:MM format
+	Start string `json:"start"`
+
+	// End is the time of day the window closes at, in the HH:MM format. A window ending before it starts spans midnight
+	End string `json:"end"`
+}
+
+// StorageMigrationPhase is the current phase of a VirtualMachineStorageMigrationPlan or of one of its volumes
+type StorageMigrationPhase string
+
+const (
+	StorageMigrationPhaseUnset StorageMigrationPhase = ""
+	StorageMigrationPending    StorageMigrationPhase = "Pending"
+	StorageMigrationInProgress StorageMigrationPhase = "InProgress"
+	StorageMigrationSucceeded  StorageMigrationPhase = "Succeeded"
+	StorageMigrationFailed     StorageMigrationPhase = "Failed"
+)
+
+// VirtualMachineStorageMigrationPlanStatus is the status for a VirtualMachineStorageMigrationPlan resource
+type VirtualMachineStorageMigrationPlanStatus struct {
+	// +optional
+	Phase StorageMigrationPhase `json:"phase,omitempty"`
+
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Volumes reports the progress of every volume moved by the plan
+	// +optional
+	// +listType=atomic
+	Volumes []StorageMigrationVolumeStatus `json:"volumes,omitempty"`
+}
+
+// StorageMigrationVolumeStatus reports the progress of a single volume moved by a VirtualMachineStorageMigrationPlan
+type StorageMigrationVolumeStatus struct {
+	// VirtualMachine is the name of the VirtualMachine the volume belongs to
+	VirtualMachine string `json:"virtualMachine"`
+
+	// VolumeName is the name of the volume in the VirtualMachine
+	VolumeName string `json:"volumeName"`
+
+	// SourceClaimName is the name of the PersistentVolumeClaim or DataVolume the volume is moved from
+	SourceClaimName string `json:"sourceClaimName"`
+
+	// TargetClaimName is the name of the PersistentVolumeClaim the volume is moved to
+	TargetClaimName string `json:"targetClaimName"`
+
+	// +optional
+	Phase StorageMigrationPhase `json:"phase,omitempty"`
+
+	// BytesCopied is the amount of data copied so far to the target volume
+	// +optional
+	BytesCopied int64 `json:"bytesCopied,omitempty"`
+
+	// TotalBytes is the amount of data to copy to the target volume
+	// +optional
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineStorageMigrationPlanList is a list of VirtualMachineStorageMigrationPlan
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineStorageMigrationPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualMachineStorageMigrationPlan `json:"items"`
+}
@@ -36,3 +36,62 @@ func (MigrationPolicyList) SwaggerDoc() map[string]string {
 		"items": "+listType=atomic",
 	}
 }
+
+func (VirtualMachineStorageMigrationPlan) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineStorageMigrationPlan moves the volumes of a set of running VirtualMachines to a new StorageClass\nby live migrating their storage\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineStorageMigrationPlanSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "VirtualMachineStorageMigrationPlanSpec is the spec for a VirtualMachineStorageMigrationPlan resource",
+		"virtualMachines":        "VirtualMachines lists the names of the VirtualMachines, in the namespace of the plan, whose volumes are moved\n+optional\n+listType=atomic",
+		"selector":               "Selector selects the VirtualMachines, in the namespace of the plan, whose volumes are moved\n+optional",
+		"targetStorageClassName": "TargetStorageClassName is the StorageClass the volumes are moved to",
+		"maxParallelMigrations":  "MaxParallelMigrations is the maximum number of VirtualMachines whose volumes are migrated at the same time.\nDefaults to 1\n+optional",
+		"bandwidthPerMigration":  "BandwidthPerMigration limits the bandwidth used by each storage migration\n+optional",
+		"deleteSourcePVCs":       "DeleteSourcePVCs deletes the source PersistentVolumeClaims and DataVolumes of a VirtualMachine once all of its volumes were moved\n+optional",
+		"window":                 "Window restricts the time of day at which the storage migration of a VirtualMachine may start.\nStorage migrations started inside the window run to completion\n+optional",
+	}
+}
+
+func (StorageMigrationWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "StorageMigrationWindow is a daily window, in UTC, during which a VirtualMachineStorageMigrationPlan starts storage migrations",
+		"start": "Start is the time of day the window opens at, in the HH:MM format",
+		"end":   "End is the time of day the window closes at, in the HH:MM format. A window ending before it starts spans midnight",
+	}
+}
+
+func (VirtualMachineStorageMigrationPlanStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "VirtualMachineStorageMigrationPlanStatus is the status for a VirtualMachineStorageMigrationPlan resource",
+		"phase":               "+optional",
+		"startTimestamp":      "+optional\n+nullable",
+		"completionTimestamp": "+optional\n+nullable",
+		"volumes":             "Volumes reports the progress of every volume moved by the plan\n+optional\n+listType=atomic",
+	}
+}
+
+func (StorageMigrationVolumeStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "StorageMigrationVolumeStatus reports the progress of a single volume moved by a VirtualMachineStorageMigrationPlan",
+		"virtualMachine":  "VirtualMachine is the name of the VirtualMachine the volume belongs to",
+		"volumeName":      "VolumeName is the name of the volume in the VirtualMachine",
+		"sourceClaimName": "SourceClaimName is the name of the PersistentVolumeClaim or DataVolume the volume is moved from",
+		"targetClaimName": "TargetClaimName is the name of the PersistentVolumeClaim the volume is moved to",
+		"phase":           "+optional",
+		"bytesCopied":     "BytesCopied is the amount of data copied so far to the target volume\n+optional",
+		"totalBytes":      "TotalBytes is the amount of data to copy to the target volume\n+optional",
+		"message":         "+optional",
+	}
+}
+
+func (VirtualMachineStorageMigrationPlanList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineStorageMigrationPlanList is a list of VirtualMachineStorageMigrationPlan\n\n+k8s:openapi-gen=true\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
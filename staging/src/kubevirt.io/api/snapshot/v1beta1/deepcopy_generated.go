@@ -24,9 +24,10 @@ Copyright The KubeVirt Authors.
 package v1beta1
 
 import (
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -72,6 +73,43 @@ func (in *Error) DeepCopy() *Error {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryState) DeepCopyInto(out *MemoryState) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryState.
+func (in *MemoryState) DeepCopy() *MemoryState {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryStateStatus) DeepCopyInto(out *MemoryStateStatus) {
+	*out = *in
+	if in.FileName != nil {
+		in, out := &in.FileName, &out.FileName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStateStatus.
+func (in *MemoryStateStatus) DeepCopy() *MemoryStateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryStateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistentVolumeClaim) DeepCopyInto(out *PersistentVolumeClaim) {
 	*out = *in
@@ -204,6 +242,157 @@ func (in *VirtualMachineRestore) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroup) DeepCopyInto(out *VirtualMachineRestoreGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineRestoreGroupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroup.
+func (in *VirtualMachineRestoreGroup) DeepCopy() *VirtualMachineRestoreGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineRestoreGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroupList) DeepCopyInto(out *VirtualMachineRestoreGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineRestoreGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroupList.
+func (in *VirtualMachineRestoreGroupList) DeepCopy() *VirtualMachineRestoreGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineRestoreGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroupMember) DeepCopyInto(out *VirtualMachineRestoreGroupMember) {
+	*out = *in
+	if in.Complete != nil {
+		in, out := &in.Complete, &out.Complete
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroupMember.
+func (in *VirtualMachineRestoreGroupMember) DeepCopy() *VirtualMachineRestoreGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroupSpec) DeepCopyInto(out *VirtualMachineRestoreGroupSpec) {
+	*out = *in
+	if in.TargetReadinessPolicy != nil {
+		in, out := &in.TargetReadinessPolicy, &out.TargetReadinessPolicy
+		*out = new(TargetReadinessPolicy)
+		**out = **in
+	}
+	if in.VolumeRestorePolicy != nil {
+		in, out := &in.VolumeRestorePolicy, &out.VolumeRestorePolicy
+		*out = new(VolumeRestorePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroupSpec.
+func (in *VirtualMachineRestoreGroupSpec) DeepCopy() *VirtualMachineRestoreGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroupStatus) DeepCopyInto(out *VirtualMachineRestoreGroupStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]VirtualMachineRestoreGroupMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Complete != nil {
+		in, out := &in.Complete, &out.Complete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroupStatus.
+func (in *VirtualMachineRestoreGroupStatus) DeepCopy() *VirtualMachineRestoreGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineRestoreList) DeepCopyInto(out *VirtualMachineRestoreList) {
 	*out = *in
@@ -268,6 +457,11 @@ func (in *VirtualMachineRestoreSpec) DeepCopyInto(out *VirtualMachineRestoreSpec
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RestoreMemoryState != nil {
+		in, out := &in.RestoreMemoryState, &out.RestoreMemoryState
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -438,6 +632,11 @@ func (in *VirtualMachineSnapshotContentSpec) DeepCopyInto(out *VirtualMachineSna
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MemoryState != nil {
+		in, out := &in.MemoryState, &out.MemoryState
+		*out = new(MemoryState)
+		**out = **in
+	}
 	return
 }
 
@@ -475,6 +674,16 @@ func (in *VirtualMachineSnapshotContentStatus) DeepCopyInto(out *VirtualMachineS
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FreezeHooks != nil {
+		in, out := &in.FreezeHooks, &out.FreezeHooks
+		*out = make([]v1.FreezeHookResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.MemoryState != nil {
+		in, out := &in.MemoryState, &out.MemoryState
+		*out = new(MemoryStateStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -488,6 +697,285 @@ func (in *VirtualMachineSnapshotContentStatus) DeepCopy() *VirtualMachineSnapsho
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGrant) DeepCopyInto(out *VirtualMachineSnapshotGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGrant.
+func (in *VirtualMachineSnapshotGrant) DeepCopy() *VirtualMachineSnapshotGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGrantFrom) DeepCopyInto(out *VirtualMachineSnapshotGrantFrom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGrantFrom.
+func (in *VirtualMachineSnapshotGrantFrom) DeepCopy() *VirtualMachineSnapshotGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGrantList) DeepCopyInto(out *VirtualMachineSnapshotGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSnapshotGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGrantList.
+func (in *VirtualMachineSnapshotGrantList) DeepCopy() *VirtualMachineSnapshotGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGrantSpec) DeepCopyInto(out *VirtualMachineSnapshotGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]VirtualMachineSnapshotGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]VirtualMachineSnapshotGrantTo, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGrantSpec.
+func (in *VirtualMachineSnapshotGrantSpec) DeepCopy() *VirtualMachineSnapshotGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGrantTo) DeepCopyInto(out *VirtualMachineSnapshotGrantTo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGrantTo.
+func (in *VirtualMachineSnapshotGrantTo) DeepCopy() *VirtualMachineSnapshotGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroup) DeepCopyInto(out *VirtualMachineSnapshotGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineSnapshotGroupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroup.
+func (in *VirtualMachineSnapshotGroup) DeepCopy() *VirtualMachineSnapshotGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroupList) DeepCopyInto(out *VirtualMachineSnapshotGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSnapshotGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroupList.
+func (in *VirtualMachineSnapshotGroupList) DeepCopy() *VirtualMachineSnapshotGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroupMember) DeepCopyInto(out *VirtualMachineSnapshotGroupMember) {
+	*out = *in
+	if in.ReadyToUse != nil {
+		in, out := &in.ReadyToUse, &out.ReadyToUse
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroupMember.
+func (in *VirtualMachineSnapshotGroupMember) DeepCopy() *VirtualMachineSnapshotGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroupSpec) DeepCopyInto(out *VirtualMachineSnapshotGroupSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
+	if in.FailureDeadline != nil {
+		in, out := &in.FailureDeadline, &out.FailureDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroupSpec.
+func (in *VirtualMachineSnapshotGroupSpec) DeepCopy() *VirtualMachineSnapshotGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroupStatus) DeepCopyInto(out *VirtualMachineSnapshotGroupStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]VirtualMachineSnapshotGroupMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FreezeTime != nil {
+		in, out := &in.FreezeTime, &out.FreezeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ReadyToUse != nil {
+		in, out := &in.ReadyToUse, &out.ReadyToUse
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroupStatus.
+func (in *VirtualMachineSnapshotGroupStatus) DeepCopy() *VirtualMachineSnapshotGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSnapshotList) DeepCopyInto(out *VirtualMachineSnapshotList) {
 	*out = *in
@@ -532,7 +1020,17 @@ func (in *VirtualMachineSnapshotSpec) DeepCopyInto(out *VirtualMachineSnapshotSp
 	}
 	if in.FailureDeadline != nil {
 		in, out := &in.FailureDeadline, &out.FailureDeadline
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(v1.FreezeHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IncludeMemory != nil {
+		in, out := &in.IncludeMemory, &out.IncludeMemory
+		*out = new(bool)
 		**out = **in
 	}
 	return
@@ -56,6 +56,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VirtualMachineSnapshotContentList{},
 		&VirtualMachineRestore{},
 		&VirtualMachineRestoreList{},
+		&VirtualMachineSnapshotGroup{},
+		&VirtualMachineSnapshotGroupList{},
+		&VirtualMachineRestoreGroup{},
+		&VirtualMachineRestoreGroupList{},
+		&VirtualMachineSnapshotGrant{},
+		&VirtualMachineSnapshotGrantList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
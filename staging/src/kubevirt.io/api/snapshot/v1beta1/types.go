@@ -72,17 +72,32 @@ type VirtualMachineSnapshotSpec struct {
 	// Defaults to DefaultFailureDeadline - 5min
 	// +optional
 	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+
+	// Hooks run around the freeze of the guest filesystem of a running VM.
+	// They only run when the guest agent is connected and the VM is not paused.
+	// +optional
+	Hooks *v1.FreezeHooks `json:"hooks,omitempty"`
+
+	// IncludeMemory saves the device and RAM state of a running VM into a
+	// PVC that is snapshotted next to its volumes, so that a restore can
+	// resume the VM instead of booting it. The VM stays paused until its
+	// volume snapshots are taken.
+	// +optional
+	IncludeMemory *bool `json:"includeMemory,omitempty"`
 }
 
 // Indication is a way to indicate the state of the vm when taking the snapshot
 type Indication string
 
 const (
-	VMSnapshotOnlineSnapshotIndication Indication = "Online"
-	VMSnapshotNoGuestAgentIndication   Indication = "NoGuestAgent"
-	VMSnapshotGuestAgentIndication     Indication = "GuestAgent"
-	VMSnapshotQuiesceTimeoutIndication Indication = "QuiesceTimeout"
-	VMSnapshotPausedIndication         Indication = "Paused"
+	VMSnapshotOnlineSnapshotIndication   Indication = "Online"
+	VMSnapshotNoGuestAgentIndication     Indication = "NoGuestAgent"
+	VMSnapshotGuestAgentIndication       Indication = "GuestAgent"
+	VMSnapshotQuiesceTimeoutIndication   Indication = "QuiesceTimeout"
+	VMSnapshotPausedIndication           Indication = "Paused"
+	VMSnapshotFreezeHooksIndication      Indication = "FreezeHooks"
+	VMSnapshotFreezeHookFailedIndication Indication = "FreezeHookFailed"
+	VMSnapshotMemoryStateIndication      Indication = "MemoryState"
 )
 
 // SourceIndication provides an indication of the source VM with its description message
@@ -229,6 +244,15 @@ type VirtualMachineSnapshotContentSpec struct {
 	// +optional
 	// +listType=atomic
 	VolumeBackups []VolumeBackup `json:"volumeBackups,omitempty"`
+
+	// +optional
+	MemoryState *MemoryState `json:"memoryState,omitempty"`
+}
+
+// MemoryState references the volume holding the saved memory state of the VM
+type MemoryState struct {
+	// VolumeName is the name of the memory dump volume in the source VM spec
+	VolumeName string `json:"volumeName"`
 }
 
 type VirtualMachine struct {
@@ -286,6 +310,26 @@ type VirtualMachineSnapshotContentStatus struct {
 	// +optional
 	// +listType=atomic
 	VolumeSnapshotStatus []VolumeSnapshotStatus `json:"volumeSnapshotStatus,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	FreezeHooks []v1.FreezeHookResult `json:"freezeHooks,omitempty"`
+
+	// +optional
+	MemoryState *MemoryStateStatus `json:"memoryState,omitempty"`
+}
+
+// MemoryStateStatus is the status of saving the memory state of the VM
+type MemoryStateStatus struct {
+	// SourcePaused is set when the source VM was paused to save its memory
+	// state, it is unpaused once the volume snapshots are taken
+	// +optional
+	SourcePaused bool `json:"sourcePaused,omitempty"`
+
+	// FileName is the name of the saved state file, set once the memory
+	// state is saved
+	// +optional
+	FileName *string `json:"fileName,omitempty"`
 }
 
 // VirtualMachineSnapshotContentList is a list of VirtualMachineSnapshot resources
@@ -386,6 +430,13 @@ type VirtualMachineRestoreSpec struct {
 
 	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
 
+	// VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot,
+	// it defaults to the namespace of the VirtualMachineRestore. Restoring a snapshot
+	// from another namespace requires a VirtualMachineSnapshotGrant in that namespace
+	// allowing the namespace of the VirtualMachineRestore.
+	// +optional
+	VirtualMachineSnapshotNamespace string `json:"virtualMachineSnapshotNamespace,omitempty"`
+
 	// +optional
 	TargetReadinessPolicy *TargetReadinessPolicy `json:"targetReadinessPolicy,omitempty"`
 
@@ -409,6 +460,12 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// RestoreMemoryState starts the restored VM from the memory state saved
+	// in the snapshot instead of booting it. It is ignored when the snapshot
+	// does not include memory.
+	// +optional
+	RestoreMemoryState *bool `json:"restoreMemoryState,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the status for a VirtualMachineRestore resource
@@ -463,3 +520,193 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineSnapshotGrant allows VirtualMachineRestores in other namespaces
+// to restore the VirtualMachineSnapshots of its namespace
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotGrantSpec `json:"spec"`
+}
+
+// VirtualMachineSnapshotGrantSpec is the spec for a VirtualMachineSnapshotGrant resource
+type VirtualMachineSnapshotGrantSpec struct {
+	// From lists the namespaces which are allowed to restore the granted snapshots
+	// +listType=atomic
+	From []VirtualMachineSnapshotGrantFrom `json:"from"`
+
+	// To lists the VirtualMachineSnapshots that are granted, all the
+	// VirtualMachineSnapshots of the namespace are granted when it is empty
+	// +optional
+	// +listType=atomic
+	To []VirtualMachineSnapshotGrantTo `json:"to,omitempty"`
+}
+
+// VirtualMachineSnapshotGrantFrom describes a namespace which is allowed to restore the granted snapshots
+type VirtualMachineSnapshotGrantFrom struct {
+	Namespace string `json:"namespace"`
+}
+
+// VirtualMachineSnapshotGrantTo describes a granted VirtualMachineSnapshot
+type VirtualMachineSnapshotGrantTo struct {
+	Name string `json:"name"`
+}
+
+// VirtualMachineSnapshotGrantList is a list of VirtualMachineSnapshotGrant resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotGrant `json:"items"`
+}
+
+// VirtualMachineSnapshotGroup defines the operation of snapshotting a group of VMs
+// at the same point in time
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotGroupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotGroupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupSpec struct {
+	// Selector selects the VirtualMachines in the namespace of the group.
+	// The members are resolved once, when the snapshot starts.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// DeletionPolicy is passed to the VirtualMachineSnapshot of each member
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// This time represents the number of seconds we permit the group snapshot
+	// to take. In case we pass this deadline we mark the group snapshot
+	// as failed and thaw all members.
+	// Defaults to DefaultFailureDeadline - 5min
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupMember is a VM snapshotted by a VirtualMachineSnapshotGroup
+type VirtualMachineSnapshotGroupMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupStatus struct {
+	// +optional
+	// +listType=atomic
+	Members []VirtualMachineSnapshotGroupMember `json:"members,omitempty"`
+
+	// FreezeTime is the time all members were frozen, the volume
+	// snapshots of the members are only taken after it
+	// +optional
+	// +nullable
+	FreezeTime *metav1.Time `json:"freezeTime,omitempty"`
+
+	// CreationTime is the time the volume snapshots of all members
+	// were taken and the members were thawed
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotGroup `json:"items"`
+}
+
+// VirtualMachineRestoreGroup defines the operation of restoring all members
+// of a VirtualMachineSnapshotGroup
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineRestoreGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineRestoreGroupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineRestoreGroupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineRestoreGroupSpec is the spec for a VirtualMachineRestoreGroup resource
+type VirtualMachineRestoreGroupSpec struct {
+	VirtualMachineSnapshotGroupName string `json:"virtualMachineSnapshotGroupName"`
+
+	// TargetReadinessPolicy is passed to the VirtualMachineRestore of each member
+	// +optional
+	TargetReadinessPolicy *TargetReadinessPolicy `json:"targetReadinessPolicy,omitempty"`
+
+	// VolumeRestorePolicy is passed to the VirtualMachineRestore of each member
+	// +optional
+	VolumeRestorePolicy *VolumeRestorePolicy `json:"volumeRestorePolicy,omitempty"`
+}
+
+// VirtualMachineRestoreGroupMember is a VM restored by a VirtualMachineRestoreGroup
+type VirtualMachineRestoreGroupMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineRestoreName string `json:"virtualMachineRestoreName"`
+
+	// +optional
+	Complete *bool `json:"complete,omitempty"`
+}
+
+// VirtualMachineRestoreGroupStatus is the status for a VirtualMachineRestoreGroup resource
+type VirtualMachineRestoreGroupStatus struct {
+	// +optional
+	// +listType=atomic
+	Members []VirtualMachineRestoreGroupMember `json:"members,omitempty"`
+
+	// RestoreTime is the time the restores of all members completed
+	// +optional
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// +optional
+	Complete *bool `json:"complete,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineRestoreGroupList is a list of VirtualMachineRestoreGroup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineRestoreGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineRestoreGroup `json:"items"`
+}
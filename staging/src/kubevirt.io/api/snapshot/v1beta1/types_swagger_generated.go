@@ -14,6 +14,8 @@ func (VirtualMachineSnapshotSpec) SwaggerDoc() map[string]string {
 		"":                "VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource",
 		"deletionPolicy":  "+optional",
 		"failureDeadline": "This time represents the number of seconds we permit the vm snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+		"hooks":           "Hooks run around the freeze of the guest filesystem of a running VM.\nThey only run when the guest agent is connected and the VM is not paused.\n+optional",
+		"includeMemory":   "IncludeMemory saves the device and RAM state of a running VM into a\nPVC that is snapshotted next to its volumes, so that a restore can\nresume the VM instead of booting it. The VM stays paused until its\nvolume snapshots are taken.\n+optional",
 	}
 }
 
@@ -84,6 +86,14 @@ func (VirtualMachineSnapshotContentSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":              "VirtualMachineSnapshotContentSpec is the spec for a VirtualMachineSnapshotContent resource",
 		"volumeBackups": "+optional\n+listType=atomic",
+		"memoryState":   "+optional",
+	}
+}
+
+func (MemoryState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "MemoryState references the volume holding the saved memory state of the VM",
+		"volumeName": "VolumeName is the name of the memory dump volume in the source VM spec",
 	}
 }
 
@@ -121,6 +131,16 @@ func (VirtualMachineSnapshotContentStatus) SwaggerDoc() map[string]string {
 		"readyToUse":           "+optional",
 		"error":                "+optional",
 		"volumeSnapshotStatus": "+optional\n+listType=atomic",
+		"freezeHooks":          "+optional\n+listType=atomic",
+		"memoryState":          "+optional",
+	}
+}
+
+func (MemoryStateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "MemoryStateStatus is the status of saving the memory state of the VM",
+		"sourcePaused": "SourcePaused is set when the source VM was paused to save its memory\nstate, it is unpaused once the volume snapshots are taken\n+optional",
+		"fileName":     "FileName is the name of the saved state file, set once the memory\nstate is saved\n+optional",
 	}
 }
 
@@ -148,13 +168,15 @@ func (VirtualMachineRestore) SwaggerDoc() map[string]string {
 
 func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                       "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestore resource",
-		"target":                 "initially only VirtualMachine type supported",
-		"targetReadinessPolicy":  "+optional",
-		"volumeRestorePolicy":    "+optional",
-		"volumeOwnershipPolicy":  "+optional",
-		"volumeRestoreOverrides": "VolumeRestoreOverrides gives the option to change properties of each restored volume\nFor example, specifying the name of the restored volume, or adding labels/annotations to it\n+optional\n+listType=atomic",
-		"patches":                "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"":                                "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestore resource",
+		"target":                          "initially only VirtualMachine type supported",
+		"virtualMachineSnapshotNamespace": "VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot,\nit defaults to the namespace of the VirtualMachineRestore. Restoring a snapshot\nfrom another namespace requires a VirtualMachineSnapshotGrant in that namespace\nallowing the namespace of the VirtualMachineRestore.\n+optional",
+		"targetReadinessPolicy":           "+optional",
+		"volumeRestorePolicy":             "+optional",
+		"volumeOwnershipPolicy":           "+optional",
+		"volumeRestoreOverrides":          "VolumeRestoreOverrides gives the option to change properties of each restored volume\nFor example, specifying the name of the restored volume, or adding labels/annotations to it\n+optional\n+listType=atomic",
+		"patches":                         "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"restoreMemoryState":              "RestoreMemoryState starts the restored VM from the memory state saved\nin the snapshot instead of booting it. It is ignored when the snapshot\ndoes not include memory.\n+optional",
 	}
 }
 
@@ -190,3 +212,115 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotGrant) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGrant allows VirtualMachineRestores in other namespaces\nto restore the VirtualMachineSnapshots of its namespace\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotGrantSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineSnapshotGrantSpec is the spec for a VirtualMachineSnapshotGrant resource",
+		"from": "From lists the namespaces which are allowed to restore the granted snapshots\n+listType=atomic",
+		"to":   "To lists the VirtualMachineSnapshots that are granted, all the\nVirtualMachineSnapshots of the namespace are granted when it is empty\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotGrantFrom) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGrantFrom describes a namespace which is allowed to restore the granted snapshots",
+	}
+}
+
+func (VirtualMachineSnapshotGrantTo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGrantTo describes a granted VirtualMachineSnapshot",
+	}
+}
+
+func (VirtualMachineSnapshotGrantList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGrantList is a list of VirtualMachineSnapshotGrant resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotGroup defines the operation of snapshotting a group of VMs\nat the same point in time\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource",
+		"selector":        "Selector selects the VirtualMachines in the namespace of the group.\nThe members are resolved once, when the snapshot starts.",
+		"deletionPolicy":  "DeletionPolicy is passed to the VirtualMachineSnapshot of each member\n+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the group snapshot\nto take. In case we pass this deadline we mark the group snapshot\nas failed and thaw all members.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineSnapshotGroupMember is a VM snapshotted by a VirtualMachineSnapshotGroup",
+		"readyToUse": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource",
+		"members":      "+optional\n+listType=atomic",
+		"freezeTime":   "FreezeTime is the time all members were frozen, the volume\nsnapshots of the members are only taken after it\n+optional\n+nullable",
+		"creationTime": "CreationTime is the time the volume snapshots of all members\nwere taken and the members were thawed\n+optional\n+nullable",
+		"phase":        "+optional",
+		"readyToUse":   "+optional",
+		"error":        "+optional",
+		"conditions":   "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotGroupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineRestoreGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineRestoreGroup defines the operation of restoring all members\nof a VirtualMachineSnapshotGroup\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineRestoreGroupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "VirtualMachineRestoreGroupSpec is the spec for a VirtualMachineRestoreGroup resource",
+		"targetReadinessPolicy": "TargetReadinessPolicy is passed to the VirtualMachineRestore of each member\n+optional",
+		"volumeRestorePolicy":   "VolumeRestorePolicy is passed to the VirtualMachineRestore of each member\n+optional",
+	}
+}
+
+func (VirtualMachineRestoreGroupMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineRestoreGroupMember is a VM restored by a VirtualMachineRestoreGroup",
+		"complete": "+optional",
+	}
+}
+
+func (VirtualMachineRestoreGroupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineRestoreGroupStatus is the status for a VirtualMachineRestoreGroup resource",
+		"members":     "+optional\n+listType=atomic",
+		"restoreTime": "RestoreTime is the time the restores of all members completed\n+optional",
+		"complete":    "+optional",
+		"conditions":  "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineRestoreGroupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineRestoreGroupList is a list of VirtualMachineRestoreGroup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
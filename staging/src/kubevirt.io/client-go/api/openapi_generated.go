@@ -326,11 +326,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                         schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
 		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                                 schema_apimachinery_pkg_util_intstr_IntOrString(ref),
 		"kubevirt.io/api/backup/v1alpha1.BackupCheckpoint":                                                schema_kubevirtio_api_backup_v1alpha1_BackupCheckpoint(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupConcurrencyPolicy":                                         schema_kubevirtio_api_backup_v1alpha1_BackupConcurrencyPolicy(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupExport":                                                    schema_kubevirtio_api_backup_v1alpha1_BackupExport(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupExportExtent":                                              schema_kubevirtio_api_backup_v1alpha1_BackupExportExtent(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupExportVolume":                                              schema_kubevirtio_api_backup_v1alpha1_BackupExportVolume(ref),
 		"kubevirt.io/api/backup/v1alpha1.BackupOptions":                                                   schema_kubevirtio_api_backup_v1alpha1_BackupOptions(ref),
-		"kubevirt.io/api/backup/v1alpha1.BackupVolumeInfo":                                                schema_kubevirtio_api_backup_v1alpha1_BackupVolumeInfo(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupRestoreVolumeStatus":                                       schema_kubevirtio_api_backup_v1alpha1_BackupRestoreVolumeStatus(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupRetentionPolicy":                                           schema_kubevirtio_api_backup_v1alpha1_BackupRetentionPolicy(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupScheduleSourceStatus":                                      schema_kubevirtio_api_backup_v1alpha1_BackupScheduleSourceStatus(ref),
 		"kubevirt.io/api/backup/v1alpha1.Condition":                                                       schema_kubevirtio_api_backup_v1alpha1_Condition(ref),
 		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackup":                                            schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackup(ref),
 		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupList":                                        schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupList(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestore":                                     schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupRestore(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestoreList":                                 schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupRestoreList(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestoreSpec":                                 schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupRestoreSpec(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestoreStatus":                               schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupRestoreStatus(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupSchedule":                                    schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupSchedule(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleList":                                schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupScheduleList(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleSpec":                                schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupScheduleSpec(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleStatus":                              schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupScheduleStatus(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleTemplate":                            schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupScheduleTemplate(ref),
 		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupSpec":                                        schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupSpec(ref),
 		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupStatus":                                      schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupStatus(ref),
 		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupTracker":                                     schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTracker(ref),
@@ -346,6 +361,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/clone/v1beta1.Condition":                                                         schema_kubevirtio_api_clone_v1beta1_Condition(ref),
 		"kubevirt.io/api/clone/v1beta1.VirtualMachineClone":                                               schema_kubevirtio_api_clone_v1beta1_VirtualMachineClone(ref),
 		"kubevirt.io/api/clone/v1beta1.VirtualMachineCloneList":                                           schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneList(ref),
+		"kubevirt.io/api/clone/v1beta1.VirtualMachineCloneRemoteExportSource":                             schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneRemoteExportSource(ref),
 		"kubevirt.io/api/clone/v1beta1.VirtualMachineCloneSpec":                                           schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneSpec(ref),
 		"kubevirt.io/api/clone/v1beta1.VirtualMachineCloneStatus":                                         schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneStatus(ref),
 		"kubevirt.io/api/clone/v1beta1.VirtualMachineCloneTemplateFilters":                                schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneTemplateFilters(ref),
@@ -357,6 +373,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ArchSpecificConfiguration":                                               schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref),
 		"kubevirt.io/api/core/v1.AuthorizedKeysFile":                                                      schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref),
 		"kubevirt.io/api/core/v1.BIOS":                                                                    schema_kubevirtio_api_core_v1_BIOS(ref),
+		"kubevirt.io/api/core/v1.BackupVolumeClaim":                                                       schema_kubevirtio_api_core_v1_BackupVolumeClaim(ref),
+		"kubevirt.io/api/core/v1.BackupVolumeInfo":                                                        schema_kubevirtio_api_core_v1_BackupVolumeInfo(ref),
+		"kubevirt.io/api/core/v1.BandwidthLimit":                                                          schema_kubevirtio_api_core_v1_BandwidthLimit(ref),
 		"kubevirt.io/api/core/v1.BlockSize":                                                               schema_kubevirtio_api_core_v1_BlockSize(ref),
 		"kubevirt.io/api/core/v1.Bootloader":                                                              schema_kubevirtio_api_core_v1_Bootloader(ref),
 		"kubevirt.io/api/core/v1.CDRomTarget":                                                             schema_kubevirtio_api_core_v1_CDRomTarget(ref),
@@ -388,6 +407,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.CustomProfile":                                                           schema_kubevirtio_api_core_v1_CustomProfile(ref),
 		"kubevirt.io/api/core/v1.CustomizeComponents":                                                     schema_kubevirtio_api_core_v1_CustomizeComponents(ref),
 		"kubevirt.io/api/core/v1.CustomizeComponentsPatch":                                                schema_kubevirtio_api_core_v1_CustomizeComponentsPatch(ref),
+		"kubevirt.io/api/core/v1.DHCPExtraOption":                                                         schema_kubevirtio_api_core_v1_DHCPExtraOption(ref),
 		"kubevirt.io/api/core/v1.DHCPOptions":                                                             schema_kubevirtio_api_core_v1_DHCPOptions(ref),
 		"kubevirt.io/api/core/v1.DHCPPrivateOptions":                                                      schema_kubevirtio_api_core_v1_DHCPPrivateOptions(ref),
 		"kubevirt.io/api/core/v1.DataVolumeSource":                                                        schema_kubevirtio_api_core_v1_DataVolumeSource(ref),
@@ -408,6 +428,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Disk":                                                                    schema_kubevirtio_api_core_v1_Disk(ref),
 		"kubevirt.io/api/core/v1.DiskDevice":                                                              schema_kubevirtio_api_core_v1_DiskDevice(ref),
 		"kubevirt.io/api/core/v1.DiskIOThreads":                                                           schema_kubevirtio_api_core_v1_DiskIOThreads(ref),
+		"kubevirt.io/api/core/v1.DiskIOTune":                                                              schema_kubevirtio_api_core_v1_DiskIOTune(ref),
+		"kubevirt.io/api/core/v1.DiskIOTuneBurst":                                                         schema_kubevirtio_api_core_v1_DiskIOTuneBurst(ref),
 		"kubevirt.io/api/core/v1.DiskTarget":                                                              schema_kubevirtio_api_core_v1_DiskTarget(ref),
 		"kubevirt.io/api/core/v1.DiskVerification":                                                        schema_kubevirtio_api_core_v1_DiskVerification(ref),
 		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                                    schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
@@ -428,14 +450,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Features":                                                                schema_kubevirtio_api_core_v1_Features(ref),
 		"kubevirt.io/api/core/v1.Filesystem":                                                              schema_kubevirtio_api_core_v1_Filesystem(ref),
 		"kubevirt.io/api/core/v1.FilesystemVirtiofs":                                                      schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref),
+		"kubevirt.io/api/core/v1.FirewallRule":                                                            schema_kubevirtio_api_core_v1_FirewallRule(ref),
+		"kubevirt.io/api/core/v1.FirewallRuleStatus":                                                      schema_kubevirtio_api_core_v1_FirewallRuleStatus(ref),
 		"kubevirt.io/api/core/v1.Firmware":                                                                schema_kubevirtio_api_core_v1_Firmware(ref),
 		"kubevirt.io/api/core/v1.Flags":                                                                   schema_kubevirtio_api_core_v1_Flags(ref),
+		"kubevirt.io/api/core/v1.FreezeHook":                                                              schema_kubevirtio_api_core_v1_FreezeHook(ref),
+		"kubevirt.io/api/core/v1.FreezeHookResult":                                                        schema_kubevirtio_api_core_v1_FreezeHookResult(ref),
+		"kubevirt.io/api/core/v1.FreezeHooks":                                                             schema_kubevirtio_api_core_v1_FreezeHooks(ref),
 		"kubevirt.io/api/core/v1.FreezeUnfreezeTimeout":                                                   schema_kubevirtio_api_core_v1_FreezeUnfreezeTimeout(ref),
 		"kubevirt.io/api/core/v1.GPU":                                                                     schema_kubevirtio_api_core_v1_GPU(ref),
 		"kubevirt.io/api/core/v1.GenerationStatus":                                                        schema_kubevirtio_api_core_v1_GenerationStatus(ref),
 		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                                   schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
 		"kubevirt.io/api/core/v1.GuestAgentPing":                                                          schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
+		"kubevirt.io/api/core/v1.GuestExecHook":                                                           schema_kubevirtio_api_core_v1_GuestExecHook(ref),
+		"kubevirt.io/api/core/v1.GuestExecOptions":                                                        schema_kubevirtio_api_core_v1_GuestExecOptions(ref),
 		"kubevirt.io/api/core/v1.HPETTimer":                                                               schema_kubevirtio_api_core_v1_HPETTimer(ref),
+		"kubevirt.io/api/core/v1.HTTPHook":                                                                schema_kubevirtio_api_core_v1_HTTPHook(ref),
 		"kubevirt.io/api/core/v1.Handler":                                                                 schema_kubevirtio_api_core_v1_Handler(ref),
 		"kubevirt.io/api/core/v1.HostDevice":                                                              schema_kubevirtio_api_core_v1_HostDevice(ref),
 		"kubevirt.io/api/core/v1.HostDisk":                                                                schema_kubevirtio_api_core_v1_HostDisk(ref),
@@ -446,16 +476,19 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.HypervTimer":                                                             schema_kubevirtio_api_core_v1_HypervTimer(ref),
 		"kubevirt.io/api/core/v1.HypervisorConfiguration":                                                 schema_kubevirtio_api_core_v1_HypervisorConfiguration(ref),
 		"kubevirt.io/api/core/v1.I6300ESBWatchdog":                                                        schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref),
+		"kubevirt.io/api/core/v1.IPv6AutoConfig":                                                          schema_kubevirtio_api_core_v1_IPv6AutoConfig(ref),
 		"kubevirt.io/api/core/v1.InitrdInfo":                                                              schema_kubevirtio_api_core_v1_InitrdInfo(ref),
 		"kubevirt.io/api/core/v1.Input":                                                                   schema_kubevirtio_api_core_v1_Input(ref),
 		"kubevirt.io/api/core/v1.InstancetypeConfiguration":                                               schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref),
 		"kubevirt.io/api/core/v1.InstancetypeMatcher":                                                     schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref),
 		"kubevirt.io/api/core/v1.InstancetypeStatusRef":                                                   schema_kubevirtio_api_core_v1_InstancetypeStatusRef(ref),
 		"kubevirt.io/api/core/v1.Interface":                                                               schema_kubevirtio_api_core_v1_Interface(ref),
+		"kubevirt.io/api/core/v1.InterfaceBandwidth":                                                      schema_kubevirtio_api_core_v1_InterfaceBandwidth(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingMethod":                                                  schema_kubevirtio_api_core_v1_InterfaceBindingMethod(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingMigration":                                               schema_kubevirtio_api_core_v1_InterfaceBindingMigration(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingPlugin":                                                  schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref),
 		"kubevirt.io/api/core/v1.InterfaceBridge":                                                         schema_kubevirtio_api_core_v1_InterfaceBridge(ref),
+		"kubevirt.io/api/core/v1.InterfaceFirewall":                                                       schema_kubevirtio_api_core_v1_InterfaceFirewall(ref),
 		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                     schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
 		"kubevirt.io/api/core/v1.InterfacePasstBinding":                                                   schema_kubevirtio_api_core_v1_InterfacePasstBinding(ref),
 		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                          schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
@@ -485,6 +518,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                                  schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
 		"kubevirt.io/api/core/v1.MemoryStatus":                                                            schema_kubevirtio_api_core_v1_MemoryStatus(ref),
 		"kubevirt.io/api/core/v1.MigrateOptions":                                                          schema_kubevirtio_api_core_v1_MigrateOptions(ref),
+		"kubevirt.io/api/core/v1.MigratedInterfaceState":                                                  schema_kubevirtio_api_core_v1_MigratedInterfaceState(ref),
 		"kubevirt.io/api/core/v1.MigrationConfiguration":                                                  schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
 		"kubevirt.io/api/core/v1.MultusNetwork":                                                           schema_kubevirtio_api_core_v1_MultusNetwork(ref),
 		"kubevirt.io/api/core/v1.NUMA":                                                                    schema_kubevirtio_api_core_v1_NUMA(ref),
@@ -502,6 +536,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.PauseOptions":                                                            schema_kubevirtio_api_core_v1_PauseOptions(ref),
 		"kubevirt.io/api/core/v1.PciHostDevice":                                                           schema_kubevirtio_api_core_v1_PciHostDevice(ref),
 		"kubevirt.io/api/core/v1.PermittedHostDevices":                                                    schema_kubevirtio_api_core_v1_PermittedHostDevices(ref),
+		"kubevirt.io/api/core/v1.PersistentContainerDisk":                                                 schema_kubevirtio_api_core_v1_PersistentContainerDisk(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimInfo":                                               schema_kubevirtio_api_core_v1_PersistentVolumeClaimInfo(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                       schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
 		"kubevirt.io/api/core/v1.PluginBinding":                                                           schema_kubevirtio_api_core_v1_PluginBinding(ref),
@@ -542,6 +577,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.StartOptions":                                                            schema_kubevirtio_api_core_v1_StartOptions(ref),
 		"kubevirt.io/api/core/v1.StopOptions":                                                             schema_kubevirtio_api_core_v1_StopOptions(ref),
 		"kubevirt.io/api/core/v1.StorageMigratedVolumeInfo":                                               schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref),
+		"kubevirt.io/api/core/v1.StorageMigratedVolumeProgress":                                           schema_kubevirtio_api_core_v1_StorageMigratedVolumeProgress(ref),
 		"kubevirt.io/api/core/v1.SupportContainerResources":                                               schema_kubevirtio_api_core_v1_SupportContainerResources(ref),
 		"kubevirt.io/api/core/v1.SyNICTimer":                                                              schema_kubevirtio_api_core_v1_SyNICTimer(ref),
 		"kubevirt.io/api/core/v1.SysprepSource":                                                           schema_kubevirtio_api_core_v1_SysprepSource(ref),
@@ -562,11 +598,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VGPUDisplayOptions":                                                      schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref),
 		"kubevirt.io/api/core/v1.VGPUOptions":                                                             schema_kubevirtio_api_core_v1_VGPUOptions(ref),
 		"kubevirt.io/api/core/v1.VMISelector":                                                             schema_kubevirtio_api_core_v1_VMISelector(ref),
+		"kubevirt.io/api/core/v1.VMStateEncryption":                                                       schema_kubevirtio_api_core_v1_VMStateEncryption(ref),
+		"kubevirt.io/api/core/v1.VMStateKMS":                                                              schema_kubevirtio_api_core_v1_VMStateKMS(ref),
 		"kubevirt.io/api/core/v1.VSOCKOptions":                                                            schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
 		"kubevirt.io/api/core/v1.VideoDevice":                                                             schema_kubevirtio_api_core_v1_VideoDevice(ref),
 		"kubevirt.io/api/core/v1.VirtTemplateDeployment":                                                  schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref),
 		"kubevirt.io/api/core/v1.VirtualMachine":                                                          schema_kubevirtio_api_core_v1_VirtualMachine(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                                 schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineContainerDiskCommitRequest":                                schema_kubevirtio_api_core_v1_VirtualMachineContainerDiskCommitRequest(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                                  schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceBackupStatus":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCommonMigrationState":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref),
@@ -669,6 +708,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicySpec":                                         schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicyStatus":                                       schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyStatus(ref),
 		"kubevirt.io/api/migrations/v1alpha1.Selectors":                                                   schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref),
+		"kubevirt.io/api/migrations/v1alpha1.StorageMigrationVolumeStatus":                                schema_kubevirtio_api_migrations_v1alpha1_StorageMigrationVolumeStatus(ref),
+		"kubevirt.io/api/migrations/v1alpha1.StorageMigrationWindow":                                      schema_kubevirtio_api_migrations_v1alpha1_StorageMigrationWindow(ref),
+		"kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlan":                          schema_kubevirtio_api_migrations_v1alpha1_VirtualMachineStorageMigrationPlan(ref),
+		"kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlanList":                      schema_kubevirtio_api_migrations_v1alpha1_VirtualMachineStorageMigrationPlanList(ref),
+		"kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlanSpec":                      schema_kubevirtio_api_migrations_v1alpha1_VirtualMachineStorageMigrationPlanSpec(ref),
+		"kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlanStatus":                    schema_kubevirtio_api_migrations_v1alpha1_VirtualMachineStorageMigrationPlanStatus(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachineOpportunisticUpdateStrategy":                         schema_kubevirtio_api_pool_v1alpha1_VirtualMachineOpportunisticUpdateStrategy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePool":                                                schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePool(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAutohealingStrategy":                             schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolAutohealingStrategy(ref),
@@ -727,12 +772,19 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/snapshot/v1alpha1.VolumeSnapshotStatus":                                          schema_kubevirtio_api_snapshot_v1alpha1_VolumeSnapshotStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.Condition":                                                      schema_kubevirtio_api_snapshot_v1beta1_Condition(ref),
 		"kubevirt.io/api/snapshot/v1beta1.Error":                                                          schema_kubevirtio_api_snapshot_v1beta1_Error(ref),
+		"kubevirt.io/api/snapshot/v1beta1.MemoryState":                                                    schema_kubevirtio_api_snapshot_v1beta1_MemoryState(ref),
+		"kubevirt.io/api/snapshot/v1beta1.MemoryStateStatus":                                              schema_kubevirtio_api_snapshot_v1beta1_MemoryStateStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.PersistentVolumeClaim":                                          schema_kubevirtio_api_snapshot_v1beta1_PersistentVolumeClaim(ref),
 		"kubevirt.io/api/snapshot/v1beta1.SnapshotVolumesLists":                                           schema_kubevirtio_api_snapshot_v1beta1_SnapshotVolumesLists(ref),
 		"kubevirt.io/api/snapshot/v1beta1.SourceIndication":                                               schema_kubevirtio_api_snapshot_v1beta1_SourceIndication(ref),
 		"kubevirt.io/api/snapshot/v1beta1.SourceSpec":                                                     schema_kubevirtio_api_snapshot_v1beta1_SourceSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachine":                                                 schema_kubevirtio_api_snapshot_v1beta1_VirtualMachine(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestore":                                          schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestore(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroup":                                     schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroup(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupList":                                 schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupList(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupMember":                               schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupMember(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupSpec":                                 schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupSpec(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupStatus":                               schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreList":                                      schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreList(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreSpec":                                      schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreStatus":                                    schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreStatus(ref),
@@ -741,6 +793,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentList":                              schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentList(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentSpec":                              schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentStatus":                            schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentStatus(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrant":                                    schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrant(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantFrom":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrantFrom(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantList":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrantList(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantSpec":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrantSpec(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantTo":                                  schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrantTo(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroup":                                    schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroup(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupList":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupList(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupMember":                              schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupMember(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupSpec":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupSpec(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupStatus":                              schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotList":                                     schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotList(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSpec":                                     schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotStatus":                                   schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotStatus(ref),
@@ -17261,6 +17323,20 @@ func schema_kubevirtio_api_backup_v1alpha1_BackupCheckpoint(ref common.Reference
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"backupName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupName is the name of the VirtualMachineBackup that created the checkpoint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the backup that created the checkpoint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"volumes": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -17274,17 +17350,171 @@ func schema_kubevirtio_api_backup_v1alpha1_BackupCheckpoint(ref common.Reference
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/backup/v1alpha1.BackupVolumeInfo"),
+										Ref:     ref("kubevirt.io/api/core/v1.BackupVolumeInfo"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.BackupVolumeInfo"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_BackupConcurrencyPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupConcurrencyPolicy limits the number of backups running at the same time. All the running VirtualMachineBackups in the cluster are accounted for.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxPerNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPerNode is the maximum number of backups running on the same node",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxPerStorageClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPerStorageClass is the maximum number of backups running on VMs with volumes of the same storage class",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_BackupExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupExport contains the information needed to read a pull mode backup",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the path of the backup export, relative to the Kubernetes API server. The extents of an exported volume are listed with a GET request to <endpoint>/map and its data is read with a GET request to <endpoint>/read. Requests are authenticated and authorized by the API server, and travel over TLS all the way to the node that runs the VM.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTime is the time at which the export will be removed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes lists the exported volumes",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/backup/v1alpha1.BackupExportVolume"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"endpoint"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/backup/v1alpha1.BackupVolumeInfo"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/backup/v1alpha1.BackupExportVolume"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_BackupExportExtent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupExportExtent describes a range of a pull mode backup export",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"offset": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"length": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"flags": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"offset", "length", "flags"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_BackupExportVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupExportVolume describes the NBD export of a single volume",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the volume name from VMI spec",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exportName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportName is the NBD export name to read the volume data from",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dirtyBitmap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DirtyBitmap is the name of the dirty bitmap that holds the blocks changed since the base checkpoint, only set for incremental backups",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"volumeName", "exportName"},
+			},
+		},
 	}
 }
 
@@ -17344,36 +17574,155 @@ func schema_kubevirtio_api_backup_v1alpha1_BackupOptions(ref common.ReferenceCal
 	}
 }
 
-func schema_kubevirtio_api_backup_v1alpha1_BackupVolumeInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_backup_v1alpha1_BackupRestoreVolumeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BackupVolumeInfo contains information about a volume included in a backup",
+				Description: "BackupRestoreVolumeStatus describes the restore of a single volume",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"volumeName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeName is the volume name from VMI spec",
+							Description: "VolumeName is the volume name from the VM spec",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"diskTarget": {
+					"claimName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DiskTarget is the disk target device name at backup time",
+							Description: "ClaimName is the name of the PVC the volume is restored into",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"completed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Completed indicates the data of the volume was restored",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"volumeName", "diskTarget"},
+				Required: []string{"volumeName", "claimName"},
 			},
 		},
 	}
 }
 
+func schema_kubevirtio_api_backup_v1alpha1_BackupRetentionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupRetentionPolicy defines how long checkpoints are kept and when a full backup is forced on a VirtualMachineBackupTracker",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxChainLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxChainLength is the maximum number of checkpoints kept in the chain. Checkpoints are pruned a whole chain at a time, a full checkpoint is only removed with its incrementals once a newer full checkpoint exists.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxIncrementals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxIncrementals forces a full backup once this many incremental backups were taken since the last full backup",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"fullBackupInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FullBackupInterval forces a full backup once the last full backup is older than the interval",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the maximum age of a checkpoint in the chain. Like for MaxChainLength, expired checkpoints are pruned a whole chain at a time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_BackupScheduleSourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupScheduleSourceStatus reports the state of a schedule for a single VM",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vmName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMName is the name of the VM",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"trackerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrackerName is the name of the VirtualMachineBackupTracker owned by the schedule to track the checkpoints of the VM",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScheduleTime is the last time a backup of the VM was scheduled",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastBackupName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastBackupName is the name of the last backup created for the VM",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastSuccessfulTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSuccessfulTime is the last time a backup of the VM completed successfully",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"consecutiveFailures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsecutiveFailures is the number of backups of the VM that failed since the last successful one",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastFailure is the reason of the last failed backup of the VM",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains why a due backup of the VM was not created yet",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"vmName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_backup_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -17512,7 +17861,319 @@ func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupList(ref common.R
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/backup/v1alpha1.VirtualMachineBackup"),
+										Ref:     ref("kubevirt.io/api/backup/v1alpha1.VirtualMachineBackup"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/backup/v1alpha1.VirtualMachineBackup"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupRestore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupRestore restores a VM, or a single volume of a VM, from the push mode backups of a checkpoint chain",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestoreSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestoreStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestoreSpec", "kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestoreStatus"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupRestoreList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupRestoreList is a list of VirtualMachineBackupRestore resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestore"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupRestore"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupRestoreSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupRestoreSpec is the spec for a VirtualMachineBackupRestore resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the VirtualMachine to restore. Unless VolumeName is set, the VirtualMachine must not exist and is recreated from the definition captured at backup time.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+						},
+					},
+					"checkpointName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckpointName is the checkpoint to restore. The disks are rebuilt from the full backup of its chain and the incremental backups up to the checkpoint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName restores only this volume, into the existing target VirtualMachine which must be stopped",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "checkpointName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.TypedLocalObjectReference"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupRestoreStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupRestoreStatus is the status for a VirtualMachineBackupRestore resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/backup/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"backups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Backups lists the VirtualMachineBackups of the restored chain, starting with the full backup",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes lists the restored volumes",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/backup/v1alpha1.BackupRestoreVolumeStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/backup/v1alpha1.BackupRestoreVolumeStatus", "kubevirt.io/api/backup/v1alpha1.Condition"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupSchedule defines a schedule to periodically back up VMs",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleSpec", "kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleStatus"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupScheduleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupScheduleList is a list of VirtualMachineBackupSchedule resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupSchedule"),
 									},
 								},
 							},
@@ -17523,7 +18184,163 @@ func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupList(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/backup/v1alpha1.VirtualMachineBackup"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupSchedule"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupScheduleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupScheduleSpec is the spec for a VirtualMachineBackupSchedule resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression in the standard five field format, or one of the @hourly, @daily, @weekly, @monthly and @yearly macros, evaluated in UTC",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source specifies the VM that is backed up",
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the VMs that are backed up in the namespace of the schedule",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template describes the VirtualMachineBackups created by the schedule",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleTemplate"),
+						},
+					},
+					"retentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionPolicy is set on the VirtualMachineBackupTrackers owned by the schedule",
+							Ref:         ref("kubevirt.io/api/backup/v1alpha1.BackupRetentionPolicy"),
+						},
+					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Concurrency limits the number of backups running at the same time",
+							Ref:         ref("kubevirt.io/api/backup/v1alpha1.BackupConcurrencyPolicy"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend stops the schedule from creating new backups",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"schedule", "template"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/backup/v1alpha1.BackupConcurrencyPolicy", "kubevirt.io/api/backup/v1alpha1.BackupRetentionPolicy", "kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupScheduleTemplate"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupScheduleStatus is the status for a VirtualMachineBackupSchedule resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScheduleTime is the last time a backup was scheduled",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextScheduleTime is the next time a backup will be scheduled",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"sources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources reports the state of the schedule for each VM it backs up",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/backup/v1alpha1.BackupScheduleSourceStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/backup/v1alpha1.BackupScheduleSourceStatus"},
+	}
+}
+
+func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupScheduleTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupScheduleTemplate describes the backups created by a schedule",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode specifies the way the backup output will be recieved",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pvcName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PvcName required in push mode. Specifies the name of the PVC where the backup output will be stored. Push mode is not supported when the schedule selects its VMs with Selector.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"skipQuiesce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipQuiesce indicates whether the VM's filesystem shoule not be quiesced before the backup",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ttlDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLDuration limits how long the export of a pull mode backup stays available",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks run around the freeze of the guest filesystem, they are skipped when SkipQuiesce is set",
+							Ref:         ref("kubevirt.io/api/core/v1.FreezeHooks"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "kubevirt.io/api/core/v1.FreezeHooks"},
 	}
 }
 
@@ -17569,12 +18386,24 @@ func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupSpec(ref common.R
 							Format:      "",
 						},
 					},
+					"ttlDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLDuration is used in pull mode only. It limits how long the backup export stays available, the export is removed once it expires or when the backup is deleted, whichever comes first. If not set the export stays available until the backup is deleted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks run around the freeze of the guest filesystem, they are skipped when SkipQuiesce is set",
+							Ref:         ref("kubevirt.io/api/core/v1.FreezeHooks"),
+						},
+					},
 				},
 				Required: []string{"source"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference"},
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "kubevirt.io/api/core/v1.FreezeHooks"},
 	}
 }
 
@@ -17630,7 +18459,45 @@ func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupStatus(ref common
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/backup/v1alpha1.BackupVolumeInfo"),
+										Ref:     ref("kubevirt.io/api/core/v1.BackupVolumeInfo"),
+									},
+								},
+							},
+						},
+					},
+					"export": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Export contains the information needed to read the backup output of a pull mode backup",
+							Ref:         ref("kubevirt.io/api/backup/v1alpha1.BackupExport"),
+						},
+					},
+					"baseCheckpointName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BaseCheckpointName is the checkpoint an incremental backup is based on",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualMachine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachine is the definition of the source VirtualMachine, captured when the backup started",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"freezeHooks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "FreezeHooks lists the outcome of the hooks which ran around the freeze of the guest filesystem",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FreezeHookResult"),
 									},
 								},
 							},
@@ -17640,7 +18507,7 @@ func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupStatus(ref common
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/backup/v1alpha1.BackupVolumeInfo", "kubevirt.io/api/backup/v1alpha1.Condition"},
+			"k8s.io/apimachinery/pkg/runtime.RawExtension", "kubevirt.io/api/backup/v1alpha1.BackupExport", "kubevirt.io/api/backup/v1alpha1.Condition", "kubevirt.io/api/core/v1.BackupVolumeInfo", "kubevirt.io/api/core/v1.FreezeHookResult"},
 	}
 }
 
@@ -17759,12 +18626,18 @@ func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTrackerSpec(ref c
 							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
 						},
 					},
+					"retentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionPolicy limits the checkpoint chain tracked for the VM. When a backup completes, checkpoints falling out of the policy are deleted together with the VirtualMachineBackups that created them.",
+							Ref:         ref("kubevirt.io/api/backup/v1alpha1.BackupRetentionPolicy"),
+						},
+					},
 				},
 				Required: []string{"source"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference"},
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "kubevirt.io/api/backup/v1alpha1.BackupRetentionPolicy"},
 	}
 }
 
@@ -17787,6 +18660,25 @@ func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTrackerStatus(ref
 							Format:      "",
 						},
 					},
+					"checkpoints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Checkpoints is the history of the checkpoints kept in the chain, ordered from the oldest to the latest",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/backup/v1alpha1.BackupCheckpoint"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -18313,6 +19205,37 @@ func schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneList(ref common.Refe
 	}
 }
 
+func schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneRemoteExportSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineCloneRemoteExportSource holds the information needed to import a VirtualMachine from a VirtualMachineExport of a remote cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"link": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Link is the external link of the remote VirtualMachineExport, as found in its status. It has to provide the \"all\" manifest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/export/v1beta1.VirtualMachineExportLink"),
+						},
+					},
+					"tokenSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenSecretRef is the name of the secret, in the namespace of the clone, holding the token of the remote VirtualMachineExport under the \"token\" key. The kubevirt-controller service account has to be granted get access to the secret.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"link", "tokenSecretRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/export/v1beta1.VirtualMachineExportLink"},
+	}
+}
+
 func schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18321,10 +19244,23 @@ func schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneSpec(ref common.Refe
 				Properties: map[string]spec.Schema{
 					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source is the object that would be cloned. Currently supported source types are: VirtualMachine of kubevirt.io API group, VirtualMachineSnapshot of snapshot.kubevirt.io API group",
+							Description: "Source is the object that would be cloned. Currently supported source types are: VirtualMachine of kubevirt.io API group, VirtualMachineSnapshot of snapshot.kubevirt.io API group, VirtualMachineExport of export.kubevirt.io API group, which requires RemoteExport to be set",
 							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
 						},
 					},
+					"sourceNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceNamespace is the namespace of a VirtualMachineSnapshot source, it defaults to the namespace of the clone. Cloning a snapshot from another namespace requires a VirtualMachineSnapshotGrant in that namespace allowing the namespace of the clone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remoteExport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteExport points to a VirtualMachineExport of a remote cluster to clone from. It must be set when the source is a VirtualMachineExport.",
+							Ref:         ref("kubevirt.io/api/clone/v1beta1.VirtualMachineCloneRemoteExportSource"),
+						},
+					},
 					"target": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Target is the outcome of the cloning process. Currently supported source types are: - VirtualMachine of kubevirt.io API group - Empty (nil). If the target is not provided, the target type would default to VirtualMachine and a random name would be generated for the target. The target's name can be viewed by inspecting status \"TargetName\" field below.",
@@ -18433,7 +19369,7 @@ func schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneSpec(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference", "kubevirt.io/api/clone/v1beta1.VirtualMachineCloneTemplateFilters"},
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "kubevirt.io/api/clone/v1beta1.VirtualMachineCloneRemoteExportSource", "kubevirt.io/api/clone/v1beta1.VirtualMachineCloneTemplateFilters"},
 	}
 }
 
@@ -18641,10 +19577,16 @@ func schema_kubevirtio_api_core_v1_AddVolumeOptions(ref common.ReferenceCallback
 					},
 					"disk": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Disk represents the hotplug disk that will be plugged into the running VMI",
+							Description: "Disk represents the hotplug disk that will be plugged into the running VMI. Either Disk or Filesystem must be set.",
 							Ref:         ref("kubevirt.io/api/core/v1.Disk"),
 						},
 					},
+					"filesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filesystem represents the virtiofs filesystem that will be plugged into the running VMI instead of a disk.",
+							Ref:         ref("kubevirt.io/api/core/v1.Filesystem"),
+						},
+					},
 					"volumeSource": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeSource represents the source of the volume to map to the disk.",
@@ -18672,11 +19614,11 @@ func schema_kubevirtio_api_core_v1_AddVolumeOptions(ref common.ReferenceCallback
 						},
 					},
 				},
-				Required: []string{"name", "disk", "volumeSource"},
+				Required: []string{"name", "volumeSource"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.HotplugVolumeSource"},
+			"kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.HotplugVolumeSource"},
 	}
 }
 
@@ -18806,6 +19748,144 @@ func schema_kubevirtio_api_core_v1_BIOS(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_kubevirtio_api_core_v1_BackupVolumeClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupVolumeClaim describes the PVC of a backed up volume, it is used to provision the PVC the volume is restored into",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the PVC",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity is the capacity of the PVC",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"volumeMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Possible enum values:\n - `\"Block\"` means the volume will not be formatted with a filesystem and will remain a raw block device.\n - `\"Filesystem\"` means the volume will be or is formatted with a filesystem.\n - `\"FromStorageProfile\"` means the volume mode will be auto selected by CDI according to a matching StorageProfile",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Block", "Filesystem", "FromStorageProfile"},
+						},
+					},
+					"accessModes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+										Enum:    []interface{}{"ReadOnlyMany", "ReadWriteMany", "ReadWriteOnce", "ReadWriteOncePod"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"claimName", "capacity"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_BackupVolumeInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupVolumeInfo contains information about a volume included in a backup",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the volume name from VMI spec",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"diskTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiskTarget is the disk target device name at backup time",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claim describes the PVC which backed the volume at backup time",
+							Ref:         ref("kubevirt.io/api/core/v1.BackupVolumeClaim"),
+						},
+					},
+				},
+				Required: []string{"volumeName", "diskTarget"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.BackupVolumeClaim"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_BandwidthLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BandwidthLimit represents the traffic limit of one direction of an interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"average": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Average is the average rate in kibibytes per second.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"peak": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Peak is the maximum rate in kibibytes per second at which the traffic can be sent. Must not be lower than the average.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the amount of kibibytes that can be sent at the peak rate. Defaults to the amount sent in one second at the average rate.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"average"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_BlockSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19571,6 +20651,13 @@ func schema_kubevirtio_api_core_v1_ContainerDiskInfo(ref common.ReferenceCallbac
 							Format:      "int64",
 						},
 					},
+					"imageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageID is the image reference with the digest of the containerdisk, as reported by the container runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -19614,10 +20701,18 @@ func schema_kubevirtio_api_core_v1_ContainerDiskSource(ref common.ReferenceCallb
 							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
 						},
 					},
+					"persistent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Persistent keeps the writes to the disk across restarts of the VM in a qcow2 overlay on top of the image, stored on a PVC which is created along with the VM and deleted with it. The image must not change during the lifetime of the overlay, referencing it by digest is recommended.",
+							Ref:         ref("kubevirt.io/api/core/v1.PersistentContainerDisk"),
+						},
+					},
 				},
 				Required: []string{"image"},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.PersistentContainerDisk"},
 	}
 }
 
@@ -19803,6 +20898,50 @@ func schema_kubevirtio_api_core_v1_CustomizeComponentsPatch(ref common.Reference
 	}
 }
 
+func schema_kubevirtio_api_core_v1_DHCPExtraOption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DHCPExtraOption defines a DHCP option by its numeric code and typed value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"family": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Family of the server which passes the option, IPv4 for DHCP and IPv6 for DHCPv6. Defaults to IPv4.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"code": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Code of the option, from 1 to 254 for DHCP and from 1 to 65535 for DHCPv6. Options which are managed by the server, such as the server identifier, are not allowed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the value, one of String, Uint8, Uint16, Uint32, IPAddresses or Hex. Defaults to String.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value of the option, encoded according to its type.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"code", "value"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_DHCPOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19853,11 +20992,30 @@ func schema_kubevirtio_api_core_v1_DHCPOptions(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"extraOptions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ExtraOptions passes arbitrary options, identified by their numeric code, to the interface's DHCP and DHCPv6 servers. An extra option replaces the option the server provides with the same code.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.DHCPExtraOption"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPPrivateOptions"},
+			"kubevirt.io/api/core/v1.DHCPExtraOption", "kubevirt.io/api/core/v1.DHCPPrivateOptions"},
 	}
 }
 
@@ -20671,12 +21829,25 @@ func schema_kubevirtio_api_core_v1_Disk(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
+					"autoGrowFilesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoGrowFilesystem grows the guest partition and filesystem on this disk through the guest agent after the disk got expanded. Requires the ExpandDisks feature gate and a running guest agent. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ioTune": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IOTune throttles the I/O of the disk. Requires the DiskIOTune feature gate. Changes are applied to a running VMI when the VM uses the LiveUpdate rollout strategy.",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskIOTune"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
+			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskIOTune", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
 	}
 }
 
@@ -20732,6 +21903,131 @@ func schema_kubevirtio_api_core_v1_DiskIOThreads(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_DiskIOTune(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskIOTune represents the I/O limits of a disk. A total limit can't be combined with the read or write limit of the same kind.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytesSec is the total throughput limit in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadBytesSec is the read throughput limit in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBytesSec is the write throughput limit in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalIOPSSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalIOPSSec is the total I/O operations per second limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readIOPSSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadIOPSSec is the read I/O operations per second limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeIOPSSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteIOPSSec is the write I/O operations per second limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst allows the disk to exceed the limits for a short period of time.",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskIOTuneBurst"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.DiskIOTuneBurst"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DiskIOTuneBurst(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskIOTuneBurst represents the burst limits of a disk. Each burst limit requires the matching base limit and must not be lower than it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytesSec is the total burst throughput in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadBytesSec is the read burst throughput in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBytesSec is the write burst throughput in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalIOPSSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalIOPSSec is the total burst I/O operations per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readIOPSSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadIOPSSec is the read burst I/O operations per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeIOPSSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteIOPSSec is the write burst I/O operations per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lengthSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LengthSeconds is the maximum duration of a burst. Defaults to one second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_DiskTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21417,6 +22713,126 @@ func schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref common.ReferenceCallba
 	}
 }
 
+func schema_kubevirtio_api_core_v1_FirewallRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the rule, unique per interface and direction. It identifies the rule counters reported in the VMI status.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action applied to the matching traffic. Defaults to Allow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol of the matching traffic, one of TCP, UDP, SCTP or ICMP. ICMP matches both ICMP and ICMPv6. All the protocols are matched when empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports of the matching traffic: the guest ports for ingress rules and the remote ports for egress rules. Requires the TCP, UDP or SCTP protocol. All the ports are matched when empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"cidrBlocks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDRBlocks of the remote peers of the matching traffic. All the peers are matched when empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_FirewallRuleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the firewall rule",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"direction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Direction of the traffic filtered by the rule",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"packets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Packets is the number of packets matched by the rule",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"bytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bytes is the number of bytes matched by the rule",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"name", "direction", "packets", "bytes"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Firmware(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21521,6 +22937,153 @@ func schema_kubevirtio_api_core_v1_Flags(ref common.ReferenceCallback) common.Op
 	}
 }
 
+func schema_kubevirtio_api_core_v1_FreezeHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FreezeHook is a single action which runs before the guest filesystem is frozen or after it is thawed. Exactly one of GuestExec and HTTP must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the hook in the recorded results",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"guestExec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestExec runs a command in the guest through the guest agent, it is only allowed for users who may run commands in the VMI through its guestexec subresource",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestExecHook"),
+						},
+					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP sends a POST request to a pod in the namespace of the VM",
+							Ref:         ref("kubevirt.io/api/core/v1.HTTPHook"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout limits how long the hook may run. Defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"onFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFailure defines what happens when the hook fails. Fail, the default, fails the operation. Continue records the failure and goes on.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "kubevirt.io/api/core/v1.GuestExecHook", "kubevirt.io/api/core/v1.HTTPHook"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_FreezeHookResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FreezeHookResult is the outcome of a hook",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the hook",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stage is the point at which the hook ran",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"succeeded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Succeeded indicates that the hook completed successfully",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes the failure of the hook",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "stage", "succeeded"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_FreezeHooks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FreezeHooks defines hooks which run around the freeze of the guest filesystem, they let applications in the guest reach a consistent state before the freeze and resume after the thaw",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"preFreeze": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PreFreeze hooks run in order before the guest filesystem is frozen",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FreezeHook"),
+									},
+								},
+							},
+						},
+					},
+					"postThaw": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PostThaw hooks run in order after the guest filesystem is thawed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FreezeHook"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.FreezeHook"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_FreezeUnfreezeTimeout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21696,6 +23259,82 @@ func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestExecHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestExecHook runs a command in the guest through the guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the executable and its arguments, it is not run in a shell. The hook fails if the command exits with a non zero code.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"command"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestExecOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestExecOptions represent a command executed in the guest through the guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the executable and its arguments",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds limits how long the command may run",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"command", "timeoutSeconds"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21722,6 +23361,43 @@ func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_kubevirtio_api_core_v1_HTTPHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHook sends a POST request to a pod",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodName is the name of the pod which receives the request, it must be in the namespace of the VM and labeled kubevirt.io/freeze-hook-target=true. Pods using the host network are not supported.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port the pod listens on, it must be declared by a container of the pod",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the request",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"podName", "port"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22005,6 +23681,33 @@ func schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref common.ReferenceCallback
 	}
 }
 
+func schema_kubevirtio_api_core_v1_IPv6AutoConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPv6AutoConfig defines how the guest interface is auto-configured with IPv6. Router advertisements are sent to the guest with the on-link prefix and, when the pod has IPv6 nameservers, with the RDNSS option.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode of the auto-configuration, one of SLAAC or DHCPv6. Defaults to DHCPv6.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"delegatedPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DelegatedPrefix is an IPv6 prefix (in CIDR notation) delegated to the guest through DHCPv6 prefix delegation. Requires the DHCPv6 mode.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InitrdInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22303,12 +24006,51 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"firewall": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Firewall defines the rules filtering the traffic of the interface. The rules are enforced in the network namespace of the virt-launcher pod and can be updated while the VMI is running. Supported by the bridge, masquerade and passt bindings.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceFirewall"),
+						},
+					},
+					"bandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bandwidth limits the traffic of the interface. The limits are enforced with traffic control on the tap device in the network namespace of the virt-launcher pod and can be updated while the VMI is running. Requires the InterfaceBandwidth feature gate. Supported by the bridge and masquerade bindings.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBandwidth"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasstBinding", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBandwidth", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceFirewall", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasstBinding", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceBandwidth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceBandwidth represents the traffic limits of an interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"inbound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inbound limits the traffic received by the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.BandwidthLimit"),
+						},
+					},
+					"outbound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outbound limits the traffic sent by the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.BandwidthLimit"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.BandwidthLimit"},
 	}
 }
 
@@ -22444,8 +24186,78 @@ func schema_kubevirtio_api_core_v1_InterfaceBridge(ref common.ReferenceCallback)
 			SchemaProps: spec.SchemaProps{
 				Description: "InterfaceBridge connects to a given network via a linux bridge.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"autoConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoConfig enables the IPv6 auto-configuration of the guest interface. The guest is configured with the IPv6 address the network IPAM assigned to the pod interface, as reported by Multus.",
+							Ref:         ref("kubevirt.io/api/core/v1.IPv6AutoConfig"),
+						},
+					},
+					"migrationMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationMode defines how the guest addressing is handled when the VMI is live migrated. When set to PreserveIP on the pod network, the guest keeps the IPv4 address and gateway it received before the migration and the target virt-launcher pod translates them to its own.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.IPv6AutoConfig"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceFirewall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceFirewall defines the ingress and egress rules of an interface. Rules are evaluated in order and the first matching rule applies. Traffic of established connections is always allowed. When rules are defined for a direction, the traffic of that direction which does not match any rule is denied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingress": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress rules filter the traffic received by the guest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FirewallRule"),
+									},
+								},
+							},
+						},
+					},
+					"egress": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Egress rules filter the traffic sent by the guest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FirewallRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.FirewallRule"},
 	}
 }
 
@@ -22991,6 +24803,12 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"vmStateEncryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMStateEncryption configures the encryption of the VM state, like TPM, stored in the VMStateStorageClass PVCs. When omitted, the VM state is stored unencrypted.",
+							Ref:         ref("kubevirt.io/api/core/v1.VMStateEncryption"),
+						},
+					},
 					"virtualMachineOptions": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("kubevirt.io/api/core/v1.VirtualMachineOptions"),
@@ -23068,7 +24886,7 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.HypervisorConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VirtTemplateDeployment", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.HypervisorConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VMStateEncryption", "kubevirt.io/api/core/v1.VirtTemplateDeployment", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
 	}
 }
 
@@ -23863,6 +25681,13 @@ func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format represents the format of the memory dump, defaults to Raw. A non hotpluggable volume with the SavedState format is used to start the VMI from the saved state instead of booting it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"claimName"},
 			},
@@ -23965,6 +25790,63 @@ func schema_kubevirtio_api_core_v1_MigrateOptions(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MigratedInterfaceState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigratedInterfaceState reports the preservation of the guest address of an interface being migrated",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the interface",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase of the guest address preservation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"guestIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestIP is the address, in CIDR notation, the guest keeps across the migration",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"guestGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestGateway is the gateway address the guest keeps across the migration",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetIP is the address of the target pod the guest address is translated to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable detail of the phase",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24675,6 +26557,56 @@ func schema_kubevirtio_api_core_v1_PermittedHostDevices(ref common.ReferenceCall
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PersistentContainerDisk(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentContainerDisk defines the PVC holding the overlay of a persistent containerDisk",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity of the overlay PVC, it bounds the amount of data written to the disk",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName of the overlay PVC, the default storage class is used when empty",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessModes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessModes of the overlay PVC, defaults to ReadWriteOnce. ReadWriteMany is required to live migrate the VM.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+										Enum:    []interface{}{"ReadOnlyMany", "ReadWriteMany", "ReadWriteOnce", "ReadWriteOncePod"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"capacity"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PersistentVolumeClaimInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26030,6 +27962,44 @@ func schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref common.Referenc
 	}
 }
 
+func schema_kubevirtio_api_core_v1_StorageMigratedVolumeProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageMigratedVolumeProgress reports the copy progress of a volume being migrated",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the volume that is being migrated",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bytesCopied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BytesCopied is the amount of data already copied to the destination volume",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytes is the amount of data to copy to the destination volume",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"volumeName", "bytesCopied", "totalBytes"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SupportContainerResources(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26616,6 +28586,56 @@ func schema_kubevirtio_api_core_v1_VMISelector(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VMStateEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMStateEncryption configures the encryption of the VM state, the TPM state and the EFI NVRAM. Each VM gets its own key, stored in a Secret owned by its backend storage PVC. Only new, empty, PVCs get encrypted, and a migration target PVC follows its source. The key is kept for the life of the PVC, as libvirt migrates the TPM state with the key of the source. Every live migration only re-wraps it with the current key encryption key, which changes nothing with the Secret key provider. While the VM runs, its key is handed over to virt-launcher in clear through a Secret in the namespace of the VM, so the encryption protects the VM state from those who can read the PVC or its storage, not from those who can read the Secrets of the namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyProvider is the source of the keys, either Secret or KMS. Defaults to Secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kms": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KMS configures the KMS key provider.",
+							Ref:         ref("kubevirt.io/api/core/v1.VMStateKMS"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VMStateKMS"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VMStateKMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMStateKMS configures the KMS holding the key encryption key of the VM state keys. The key encryption key is read from a Secret in the KubeVirt install namespace, standing in for a KMS plugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyEncryptionKeySecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyEncryptionKeySecret is the name of the Secret in the KubeVirt install namespace holding the key encryption key under the \"key\" entry. To rotate the key encryption key, move it to the \"previous-key\" entry and set the new one, the keys get re-wrapped with the new one on their next live migration.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"keyEncryptionKeySecret"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VSOCKOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26782,6 +28802,64 @@ func schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref common.ReferenceC
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineContainerDiskCommitRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineContainerDiskCommitRequest represents the request to write a persistent containerDisk, its base image along with the writes kept in its overlay, into a PVC",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the persistent containerDisk volume to commit",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the PVC receiving the disk, it can be the PVC of a blank DataVolume",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase represents the commit phase",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTimestamp represents the time the commit started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTimestamp represents the time the commit completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a detailed message about failure of the commit",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"volumeName", "claimName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26898,7 +28976,7 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref common
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/backup/v1alpha1.BackupVolumeInfo"),
+										Ref:     ref("kubevirt.io/api/core/v1.BackupVolumeInfo"),
 									},
 								},
 							},
@@ -26908,7 +28986,7 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref common
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/backup/v1alpha1.BackupVolumeInfo"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.BackupVolumeInfo"},
 	}
 }
 
@@ -28073,11 +30151,49 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref comm
 							Format:      "",
 						},
 					},
+					"migratedVolumesProgress": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratedVolumesProgress reports how much of every migrated volume has been copied to the target",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.StorageMigratedVolumeProgress"),
+									},
+								},
+							},
+						},
+					},
+					"migratedInterfaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratedInterfaces reports the preservation of the guest address of every interface that keeps its IP across the migration",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.MigratedInterfaceState"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSourceState", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationTargetState"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MigratedInterfaceState", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.StorageMigratedVolumeProgress", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSourceState", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationTargetState"},
 	}
 }
 
@@ -28405,9 +30521,30 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceNetworkInterface(ref co
 							Format:      "",
 						},
 					},
+					"firewallRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "FirewallRules reports the counters of the firewall rules enforced on the interface.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FirewallRuleStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.FirewallRuleStatus"},
 	}
 }
 
@@ -29468,6 +31605,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineMemoryDumpRequest(ref common.Re
 							Format:      "",
 						},
 					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format represents the format of the memory dump, defaults to Raw",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"claimName", "phase"},
 			},
@@ -29764,6 +31908,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest"),
 						},
 					},
+					"containerDiskCommitRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerDiskCommitRequest tracks the request to commit a persistent containerDisk into a PVC",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineContainerDiskCommitRequest"),
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ObservedGeneration is the generation observed by the vmi when started.",
@@ -29813,7 +31963,7 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
+			"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineContainerDiskCommitRequest", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
 	}
 }
 
@@ -30218,12 +32368,18 @@ func schema_kubevirtio_api_core_v1_VolumeStatus(ref common.ReferenceCallback) co
 							Ref:         ref("kubevirt.io/api/core/v1.ContainerDiskInfo"),
 						},
 					},
+					"ioTune": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IOTune shows the I/O limits currently applied to the disk of the volume",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskIOTune"),
+						},
+					},
 				},
 				Required: []string{"name", "target"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
+			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DiskIOTune", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
 	}
 }
 
@@ -31052,8 +33208,9 @@ func schema_kubevirtio_api_export_v1beta1_VirtualMachineExportSpec(ref common.Re
 				Properties: map[string]spec.Schema{
 					"source": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+							Description: "Source is the PersistentVolumeClaim, VirtualMachine, VirtualMachineSnapshot or push mode VirtualMachineBackup to export",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
 						},
 					},
 					"tokenSecretRef": {
@@ -31547,6 +33704,12 @@ func schema_kubevirtio_api_instancetype_v1beta1_DevicePreferences(ref common.Ref
 							Ref:         ref("kubevirt.io/api/core/v1.InterfaceMasquerade"),
 						},
 					},
+					"preferredInterfaceBandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredInterfaceBandwidth optionally defines the preferred bandwidth limits of each bridge and masquerade interface.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBandwidth"),
+						},
+					},
 					"preferredPanicDeviceModel": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PreferredPanicDeviceModel optionally defines the preferred panic device model to use with panic devices.",
@@ -31558,7 +33721,7 @@ func schema_kubevirtio_api_instancetype_v1beta1_DevicePreferences(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VGPUOptions"},
+			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.InterfaceBandwidth", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VGPUOptions"},
 	}
 }
 
@@ -32179,6 +34342,18 @@ func schema_kubevirtio_api_instancetype_v1beta1_VirtualMachineInstancetypeSpec(r
 							Ref:         ref("kubevirt.io/api/core/v1.DiskIOThreads"),
 						},
 					},
+					"diskIOTune": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optionally defines the I/O limits applied to every disk and LUN of the VirtualMachineInstance. It conflicts with disks defining their own.",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskIOTune"),
+						},
+					},
+					"interfaceBandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optionally defines the bandwidth limits applied to every bridge and masquerade interface of the VirtualMachineInstance. It conflicts with interfaces defining their own.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBandwidth"),
+						},
+					},
 					"launchSecurity": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Optionally defines the LaunchSecurity to be used by the instancetype.",
@@ -32206,7 +34381,7 @@ func schema_kubevirtio_api_instancetype_v1beta1_VirtualMachineInstancetypeSpec(r
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DiskIOThreads", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.LaunchSecurity", "kubevirt.io/api/instancetype/v1beta1.CPUInstancetype", "kubevirt.io/api/instancetype/v1beta1.MemoryInstancetype"},
+			"kubevirt.io/api/core/v1.DiskIOThreads", "kubevirt.io/api/core/v1.DiskIOTune", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.InterfaceBandwidth", "kubevirt.io/api/core/v1.LaunchSecurity", "kubevirt.io/api/instancetype/v1beta1.CPUInstancetype", "kubevirt.io/api/instancetype/v1beta1.MemoryInstancetype"},
 	}
 }
 
@@ -32520,7 +34695,311 @@ func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyList(ref common.Re
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/migrations/v1alpha1.MigrationPolicy"),
+										Ref:     ref("kubevirt.io/api/migrations/v1alpha1.MigrationPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/migrations/v1alpha1.MigrationPolicy"},
+	}
+}
+
+func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selectors": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/migrations/v1alpha1.Selectors"),
+						},
+					},
+					"allowAutoConverge": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"bandwidthPerMigration": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"completionTimeoutPerGiB": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"allowPostCopy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"allowWorkloadDisruption": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"selectors"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/migrations/v1alpha1.Selectors"},
+	}
+}
+
+func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"virtualMachineInstanceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_migrations_v1alpha1_StorageMigrationVolumeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageMigrationVolumeStatus reports the progress of a single volume moved by a VirtualMachineStorageMigrationPlan",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachine is the name of the VirtualMachine the volume belongs to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the volume in the VirtualMachine",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sourceClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceClaimName is the name of the PersistentVolumeClaim or DataVolume the volume is moved from",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetClaimName is the name of the PersistentVolumeClaim the volume is moved to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"bytesCopied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BytesCopied is the amount of data copied so far to the target volume",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytes is the amount of data to copy to the target volume",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"virtualMachine", "volumeName", "sourceClaimName", "targetClaimName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_migrations_v1alpha1_StorageMigrationWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageMigrationWindow is a daily window, in UTC, during which a VirtualMachineStorageMigrationPlan starts storage migrations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the time of day the window opens at, in the HH:MM format",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the time of day the window closes at, in the HH:MM format. A window ending before it starts spans midnight",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_migrations_v1alpha1_VirtualMachineStorageMigrationPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineStorageMigrationPlan moves the volumes of a set of running VirtualMachines to a new StorageClass by live migrating their storage",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlanSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlanStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlanSpec", "kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlanStatus"},
+	}
+}
+
+func schema_kubevirtio_api_migrations_v1alpha1_VirtualMachineStorageMigrationPlanList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineStorageMigrationPlanList is a list of VirtualMachineStorageMigrationPlan",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlan"),
 									},
 								},
 							},
@@ -32531,100 +35010,123 @@ func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyList(ref common.Re
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/migrations/v1alpha1.MigrationPolicy"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/migrations/v1alpha1.VirtualMachineStorageMigrationPlan"},
 	}
 }
 
-func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_migrations_v1alpha1_VirtualMachineStorageMigrationPlanSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VirtualMachineStorageMigrationPlanSpec is the spec for a VirtualMachineStorageMigrationPlan resource",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"selectors": {
+					"virtualMachines": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevirt.io/api/migrations/v1alpha1.Selectors"),
+							Description: "VirtualMachines lists the names of the VirtualMachines, in the namespace of the plan, whose volumes are moved",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"allowAutoConverge": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Selector selects the VirtualMachines, in the namespace of the plan, whose volumes are moved",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"bandwidthPerMigration": {
+					"targetStorageClassName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "TargetStorageClassName is the StorageClass the volumes are moved to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"completionTimeoutPerGiB": {
+					"maxParallelMigrations": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "MaxParallelMigrations is the maximum number of VirtualMachines whose volumes are migrated at the same time. Defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"allowPostCopy": {
+					"bandwidthPerMigration": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "BandwidthPerMigration limits the bandwidth used by each storage migration",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"allowWorkloadDisruption": {
+					"deleteSourcePVCs": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "DeleteSourcePVCs deletes the source PersistentVolumeClaims and DataVolumes of a VirtualMachine once all of its volumes were moved",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window restricts the time of day at which the storage migration of a VirtualMachine may start. Storage migrations started inside the window run to completion",
+							Ref:         ref("kubevirt.io/api/migrations/v1alpha1.StorageMigrationWindow"),
 						},
 					},
 				},
-				Required: []string{"selectors"},
+				Required: []string{"targetStorageClassName"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/migrations/v1alpha1.Selectors"},
-	}
-}
-
-func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-			},
-		},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/migrations/v1alpha1.StorageMigrationWindow"},
 	}
 }
 
-func schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_migrations_v1alpha1_VirtualMachineStorageMigrationPlanStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VirtualMachineStorageMigrationPlanStatus is the status for a VirtualMachineStorageMigrationPlan resource",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"namespaceSelector": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"virtualMachineInstanceSelector": {
+					"startTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes reports the progress of every volume moved by the plan",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/migrations/v1alpha1.StorageMigrationVolumeStatus"),
 									},
 								},
 							},
@@ -32633,6 +35135,8 @@ func schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref common.ReferenceCal
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/migrations/v1alpha1.StorageMigrationVolumeStatus"},
 	}
 }
 
@@ -34830,6 +37334,55 @@ func schema_kubevirtio_api_snapshot_v1beta1_Error(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_snapshot_v1beta1_MemoryState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryState references the volume holding the saved memory state of the VM",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the memory dump volume in the source VM spec",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"volumeName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_MemoryStateStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryStateStatus is the status of saving the memory state of the VM",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourcePaused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourcePaused is set when the source VM was paused to save its memory state, it is unpaused once the volume snapshots are taken",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"fileName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FileName is the name of the saved state file, set once the memory state is saved",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_snapshot_v1beta1_PersistentVolumeClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -34948,95 +37501,323 @@ func schema_kubevirtio_api_snapshot_v1beta1_SourceSpec(ref common.ReferenceCallb
 				Properties: map[string]spec.Schema{
 					"virtualMachine": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachine"),
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachine"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/snapshot/v1beta1.VirtualMachine"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSpec contains the VirtualMachine specification.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status holds the current state of the controller and brief information about its associated VirtualMachineInstance",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineSpec", "kubevirt.io/api/core/v1.VirtualMachineStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestore defines the operation of restoring a VM",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreGroup defines the operation of restoring all members of a VirtualMachineSnapshotGroup",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreGroupList is a list of VirtualMachineRestoreGroup resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroup"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreGroupMember is a VM restored by a VirtualMachineRestoreGroup",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachineName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"virtualMachineRestoreName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"complete": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
+				Required: []string{"virtualMachineName", "virtualMachineRestoreName"},
 			},
 		},
-		Dependencies: []string{
-			"kubevirt.io/api/snapshot/v1beta1.VirtualMachine"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VirtualMachineRestoreGroupSpec is the spec for a VirtualMachineRestoreGroup resource",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"metadata": {
+					"virtualMachineSnapshotGroupName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"spec": {
+					"targetReadinessPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VirtualMachineSpec contains the VirtualMachine specification.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
+							Description: "TargetReadinessPolicy is passed to the VirtualMachineRestore of each member",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"volumeRestorePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status holds the current state of the controller and brief information about its associated VirtualMachineInstance",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineStatus"),
+							Description: "VolumeRestorePolicy is passed to the VirtualMachineRestore of each member",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"virtualMachineSnapshotGroupName"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineSpec", "kubevirt.io/api/core/v1.VirtualMachineStatus"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineRestore defines the operation of restoring a VM",
+				Description: "VirtualMachineRestoreGroupStatus is the status for a VirtualMachineRestoreGroup resource",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+					"members": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupMember"),
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"restoreTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "RestoreTime is the time the restores of all members completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"spec": {
+					"complete": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreSpec"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"status": {
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreStatus"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.Condition"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Condition", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupMember"},
 	}
 }
 
@@ -35110,6 +37891,13 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreSpec(ref common
 							Format:  "",
 						},
 					},
+					"virtualMachineSnapshotNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot, it defaults to the namespace of the VirtualMachineRestore. Restoring a snapshot from another namespace requires a VirtualMachineSnapshotGrant in that namespace allowing the namespace of the VirtualMachineRestore.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"targetReadinessPolicy": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -35167,6 +37955,13 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreSpec(ref common
 							},
 						},
 					},
+					"restoreMemoryState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestoreMemoryState starts the restored VM from the memory state saved in the snapshot instead of booting it. It is ignored when the snapshot does not include memory.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"target", "virtualMachineSnapshotName"},
 			},
@@ -35437,12 +38232,17 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentSpec(re
 							},
 						},
 					},
+					"memoryState": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.MemoryState"),
+						},
+					},
 				},
 				Required: []string{"source"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/snapshot/v1beta1.SourceSpec", "kubevirt.io/api/snapshot/v1beta1.VolumeBackup"},
+			"kubevirt.io/api/snapshot/v1beta1.MemoryState", "kubevirt.io/api/snapshot/v1beta1.SourceSpec", "kubevirt.io/api/snapshot/v1beta1.VolumeBackup"},
 	}
 }
 
@@ -35487,11 +38287,467 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentStatus(
 							},
 						},
 					},
+					"freezeHooks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FreezeHookResult"),
+									},
+								},
+							},
+						},
+					},
+					"memoryState": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.MemoryStateStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.FreezeHookResult", "kubevirt.io/api/snapshot/v1beta1.Error", "kubevirt.io/api/snapshot/v1beta1.MemoryStateStatus", "kubevirt.io/api/snapshot/v1beta1.VolumeSnapshotStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGrant allows VirtualMachineRestores in other namespaces to restore the VirtualMachineSnapshots of its namespace",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantSpec"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrantFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGrantFrom describes a namespace which is allowed to restore the granted snapshots",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"namespace"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGrantList is a list of VirtualMachineSnapshotGrant resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrant"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrant"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrantSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGrantSpec is the spec for a VirtualMachineSnapshotGrant resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "From lists the namespaces which are allowed to restore the granted snapshots",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantFrom"),
+									},
+								},
+							},
+						},
+					},
+					"to": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "To lists the VirtualMachineSnapshots that are granted, all the VirtualMachineSnapshots of the namespace are granted when it is empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantTo"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"from"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantFrom", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGrantTo"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGrantTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGrantTo describes a granted VirtualMachineSnapshot",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroup defines the operation of snapshotting a group of VMs at the same point in time",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroup"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupMember is a VM snapshotted by a VirtualMachineSnapshotGroup",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachineName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"virtualMachineSnapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"readyToUse": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"virtualMachineName", "virtualMachineSnapshotName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the VirtualMachines in the namespace of the group. The members are resolved once, when the snapshot starts.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy is passed to the VirtualMachineSnapshot of each member",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This time represents the number of seconds we permit the group snapshot to take. In case we pass this deadline we mark the group snapshot as failed and thaw all members. Defaults to DefaultFailureDeadline - 5min",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"selector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"members": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupMember"),
+									},
+								},
+							},
+						},
+					},
+					"freezeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreezeTime is the time all members were frozen, the volume snapshots of the members are only taken after it",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"creationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreationTime is the time the volume snapshots of all members were taken and the members were thawed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"readyToUse": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.Error"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Error", "kubevirt.io/api/snapshot/v1beta1.VolumeSnapshotStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Condition", "kubevirt.io/api/snapshot/v1beta1.Error", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupMember"},
 	}
 }
 
@@ -35569,12 +38825,25 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSpec(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks run around the freeze of the guest filesystem of a running VM. They only run when the guest agent is connected and the VM is not paused.",
+							Ref:         ref("kubevirt.io/api/core/v1.FreezeHooks"),
+						},
+					},
+					"includeMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncludeMemory saves the device and RAM state of a running VM into a PVC that is snapshotted next to its volumes, so that a restore can resume the VM instead of booting it. The VM stays paused until its volume snapshots are taken.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"source"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "kubevirt.io/api/core/v1.FreezeHooks"},
 	}
 }
 
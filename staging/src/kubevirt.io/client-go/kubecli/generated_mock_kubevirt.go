@@ -1229,6 +1229,34 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackup(namespace any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackup", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackup), namespace)
 }
 
+// VirtualMachineBackupRestore mocks base method.
+func (m *MockKubevirtClient) VirtualMachineBackupRestore(namespace string) v1alpha19.VirtualMachineBackupRestoreInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineBackupRestore", namespace)
+	ret0, _ := ret[0].(v1alpha19.VirtualMachineBackupRestoreInterface)
+	return ret0
+}
+
+// VirtualMachineBackupRestore indicates an expected call of VirtualMachineBackupRestore.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackupRestore(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackupRestore", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackupRestore), namespace)
+}
+
+// VirtualMachineBackupSchedule mocks base method.
+func (m *MockKubevirtClient) VirtualMachineBackupSchedule(namespace string) v1alpha19.VirtualMachineBackupScheduleInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineBackupSchedule", namespace)
+	ret0, _ := ret[0].(v1alpha19.VirtualMachineBackupScheduleInterface)
+	return ret0
+}
+
+// VirtualMachineBackupSchedule indicates an expected call of VirtualMachineBackupSchedule.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackupSchedule(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackupSchedule", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackupSchedule), namespace)
+}
+
 // VirtualMachineBackupTracker mocks base method.
 func (m *MockKubevirtClient) VirtualMachineBackupTracker(namespace string) v1alpha19.VirtualMachineBackupTrackerInterface {
 	m.ctrl.T.Helper()
@@ -1397,6 +1425,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineRestore(namespace any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineRestore", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineRestore), namespace)
 }
 
+// VirtualMachineRestoreGroup mocks base method.
+func (m *MockKubevirtClient) VirtualMachineRestoreGroup(namespace string) v1beta121.VirtualMachineRestoreGroupInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineRestoreGroup", namespace)
+	ret0, _ := ret[0].(v1beta121.VirtualMachineRestoreGroupInterface)
+	return ret0
+}
+
+// VirtualMachineRestoreGroup indicates an expected call of VirtualMachineRestoreGroup.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineRestoreGroup(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineRestoreGroup", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineRestoreGroup), namespace)
+}
+
 // VirtualMachineSnapshot mocks base method.
 func (m *MockKubevirtClient) VirtualMachineSnapshot(namespace string) v1beta121.VirtualMachineSnapshotInterface {
 	m.ctrl.T.Helper()
@@ -1425,6 +1467,48 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotContent(namespac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotContent", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotContent), namespace)
 }
 
+// VirtualMachineSnapshotGrant mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotGrant(namespace string) v1beta121.VirtualMachineSnapshotGrantInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotGrant", namespace)
+	ret0, _ := ret[0].(v1beta121.VirtualMachineSnapshotGrantInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotGrant indicates an expected call of VirtualMachineSnapshotGrant.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotGrant(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotGrant", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotGrant), namespace)
+}
+
+// VirtualMachineSnapshotGroup mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotGroup(namespace string) v1beta121.VirtualMachineSnapshotGroupInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotGroup", namespace)
+	ret0, _ := ret[0].(v1beta121.VirtualMachineSnapshotGroupInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotGroup indicates an expected call of VirtualMachineSnapshotGroup.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotGroup(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotGroup", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotGroup), namespace)
+}
+
+// VirtualMachineStorageMigrationPlan mocks base method.
+func (m *MockKubevirtClient) VirtualMachineStorageMigrationPlan(namespace string) v1alpha110.VirtualMachineStorageMigrationPlanInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineStorageMigrationPlan", namespace)
+	ret0, _ := ret[0].(v1alpha110.VirtualMachineStorageMigrationPlanInterface)
+	return ret0
+}
+
+// VirtualMachineStorageMigrationPlan indicates an expected call of VirtualMachineStorageMigrationPlan.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineStorageMigrationPlan(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineStorageMigrationPlan", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineStorageMigrationPlan), namespace)
+}
+
 // MockVirtualMachineInstanceInterface is a mock of VirtualMachineInstanceInterface interface.
 type MockVirtualMachineInstanceInterface struct {
 	ctrl     *gomock.Controller
@@ -1506,6 +1590,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Delete(ctx, name, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Delete), ctx, name, opts)
 }
 
+// DeleteCheckpoint mocks base method.
+func (m *MockVirtualMachineInstanceInterface) DeleteCheckpoint(ctx context.Context, name string, checkpoint *v1alpha18.BackupCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckpoint", ctx, name, checkpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckpoint indicates an expected call of DeleteCheckpoint.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) DeleteCheckpoint(ctx, name, checkpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckpoint", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).DeleteCheckpoint), ctx, name, checkpoint)
+}
+
 // DeleteCollection mocks base method.
 func (m *MockVirtualMachineInstanceInterface) DeleteCollection(ctx context.Context, opts v12.DeleteOptions, listOpts v12.ListOptions) error {
 	m.ctrl.T.Helper()
@@ -1578,6 +1676,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Get(ctx, name, opts a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Get), ctx, name, opts)
 }
 
+// GuestExec mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestExec(ctx context.Context, name string, guestExecOptions *v122.GuestExecOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExec", ctx, name, guestExecOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestExec indicates an expected call of GuestExec.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestExec(ctx, name, guestExecOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExec", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestExec), ctx, name, guestExecOptions)
+}
+
 // GuestOsInfo mocks base method.
 func (m *MockVirtualMachineInstanceInterface) GuestOsInfo(ctx context.Context, name string) (v122.VirtualMachineInstanceGuestAgentInfo, error) {
 	m.ctrl.T.Helper()
@@ -2326,6 +2438,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) AddVolume(ctx, name, addVolum
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVolume", reflect.TypeOf((*MockVirtualMachineInterface)(nil).AddVolume), ctx, name, addVolumeOptions)
 }
 
+// CommitContainerDisk mocks base method.
+func (m *MockVirtualMachineInterface) CommitContainerDisk(ctx context.Context, name string, commitRequest *v122.VirtualMachineContainerDiskCommitRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitContainerDisk", ctx, name, commitRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitContainerDisk indicates an expected call of CommitContainerDisk.
+func (mr *MockVirtualMachineInterfaceMockRecorder) CommitContainerDisk(ctx, name, commitRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitContainerDisk", reflect.TypeOf((*MockVirtualMachineInterface)(nil).CommitContainerDisk), ctx, name, commitRequest)
+}
+
 // Create mocks base method.
 func (m *MockVirtualMachineInterface) Create(ctx context.Context, virtualMachine *v122.VirtualMachine, opts v12.CreateOptions) (*v122.VirtualMachine, error) {
 	m.ctrl.T.Helper()
@@ -27,9 +27,13 @@ const (
 	pauseTemplateURI              = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
 	unpauseTemplateURI            = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
 	backupTemplateURI             = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/backup"
+	backupMapTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/backup/map"
+	backupReadTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/backup/read"
 	redefineCheckpointTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/redefine-checkpoint"
+	deleteCheckpointTemplateURI   = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/delete-checkpoint"
 	freezeTemplateURI             = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/freeze"
 	unfreezeTemplateURI           = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unfreeze"
+	guestExecTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestexec"
 	resetTemplateURI              = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/reset"
 	softRebootTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/softreboot"
 	guestInfoTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
@@ -68,6 +72,7 @@ type VirtHandlerConn interface {
 	UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnfreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	ResetURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -80,7 +85,10 @@ type VirtHandlerConn interface {
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	BackupMapURI(vmi *virtv1.VirtualMachineInstance, query string) (string, error)
+	BackupReadURI(vmi *virtv1.VirtualMachineInstance, query string) (string, error)
 	RedefineCheckpointURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	DeleteCheckpointURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -222,10 +230,30 @@ func (v *virtHandlerConn) BackupURI(vmi *virtv1.VirtualMachineInstance) (string,
 	return v.formatURI(backupTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) BackupMapURI(vmi *virtv1.VirtualMachineInstance, query string) (string, error) {
+	baseURI, err := v.formatURI(backupMapTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s?%s", baseURI, query), nil
+}
+
+func (v *virtHandlerConn) BackupReadURI(vmi *virtv1.VirtualMachineInstance, query string) (string, error) {
+	baseURI, err := v.formatURI(backupReadTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s?%s", baseURI, query), nil
+}
+
 func (v *virtHandlerConn) RedefineCheckpointURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(redefineCheckpointTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) DeleteCheckpointURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(deleteCheckpointTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(freezeTemplateURI, vmi)
 }
@@ -234,6 +262,10 @@ func (v *virtHandlerConn) UnfreezeURI(vmi *virtv1.VirtualMachineInstance) (strin
 	return v.formatURI(unfreezeTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestExecTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) ResetURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(resetTemplateURI, vmi)
 }
@@ -65,15 +65,21 @@ type KubevirtClient interface {
 	VirtualMachineInstancePreset(namespace string) VirtualMachineInstancePresetInterface
 	VirtualMachineBackup(namespace string) backupv1.VirtualMachineBackupInterface
 	VirtualMachineBackupTracker(namespace string) backupv1.VirtualMachineBackupTrackerInterface
+	VirtualMachineBackupSchedule(namespace string) backupv1.VirtualMachineBackupScheduleInterface
+	VirtualMachineBackupRestore(namespace string) backupv1.VirtualMachineBackupRestoreInterface
 	VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) snapshotv1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) snapshotv1.VirtualMachineRestoreInterface
+	VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface
+	VirtualMachineSnapshotGrant(namespace string) snapshotv1.VirtualMachineSnapshotGrantInterface
+	VirtualMachineRestoreGroup(namespace string) snapshotv1.VirtualMachineRestoreGroupInterface
 	VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface
 	VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface
 	VirtualMachineClusterInstancetype() instancetypev1beta1.VirtualMachineClusterInstancetypeInterface
 	VirtualMachinePreference(namespace string) instancetypev1beta1.VirtualMachinePreferenceInterface
 	VirtualMachineClusterPreference() instancetypev1beta1.VirtualMachineClusterPreferenceInterface
 	MigrationPolicy() migrationsv1.MigrationPolicyInterface
+	VirtualMachineStorageMigrationPlan(namespace string) migrationsv1.VirtualMachineStorageMigrationPlanInterface
 	ExpandSpec(namespace string) ExpandSpecInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clone.VirtualMachineCloneInterface
@@ -182,6 +188,14 @@ func (k kubevirtClient) VirtualMachineBackupTracker(namespace string) backupv1.V
 	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineBackupSchedule(namespace string) backupv1.VirtualMachineBackupScheduleInterface {
+	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupSchedules(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineBackupRestore(namespace string) backupv1.VirtualMachineBackupRestoreInterface {
+	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupRestores(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface {
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshots(namespace)
 }
@@ -194,6 +208,18 @@ func (k kubevirtClient) VirtualMachineRestore(namespace string) snapshotv1.Virtu
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineRestores(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineSnapshotGrant(namespace string) snapshotv1.VirtualMachineSnapshotGrantInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotGrants(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineRestoreGroup(namespace string) snapshotv1.VirtualMachineRestoreGroupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineRestoreGroups(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface {
 	return k.generatedKubeVirtClient.ExportV1beta1().VirtualMachineExports(namespace)
 }
@@ -226,6 +252,10 @@ func (k kubevirtClient) MigrationPolicy() migrationsv1.MigrationPolicyInterface
 	return k.generatedKubeVirtClient.MigrationsV1alpha1().MigrationPolicies()
 }
 
+func (k kubevirtClient) VirtualMachineStorageMigrationPlan(namespace string) migrationsv1.VirtualMachineStorageMigrationPlanInterface {
+	return k.generatedKubeVirtClient.MigrationsV1alpha1().VirtualMachineStorageMigrationPlans(namespace)
+}
+
 func (k kubevirtClient) MigrationPolicyClient() *migrationsv1.MigrationsV1alpha1Client {
 	return k.migrationsClient
 }
@@ -34,6 +34,14 @@ func (c *FakeBackupV1alpha1) VirtualMachineBackups(namespace string) v1alpha1.Vi
 	return newFakeVirtualMachineBackups(c, namespace)
 }
 
+func (c *FakeBackupV1alpha1) VirtualMachineBackupRestores(namespace string) v1alpha1.VirtualMachineBackupRestoreInterface {
+	return newFakeVirtualMachineBackupRestores(c, namespace)
+}
+
+func (c *FakeBackupV1alpha1) VirtualMachineBackupSchedules(namespace string) v1alpha1.VirtualMachineBackupScheduleInterface {
+	return newFakeVirtualMachineBackupSchedules(c, namespace)
+}
+
 func (c *FakeBackupV1alpha1) VirtualMachineBackupTrackers(namespace string) v1alpha1.VirtualMachineBackupTrackerInterface {
 	return newFakeVirtualMachineBackupTrackers(c, namespace)
 }
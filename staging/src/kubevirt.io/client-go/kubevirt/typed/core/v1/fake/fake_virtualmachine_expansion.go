@@ -87,6 +87,13 @@ func (c *fakeVirtualMachines) RemoveMemoryDump(ctx context.Context, name string)
 	return err
 }
 
+func (c *fakeVirtualMachines) CommitContainerDisk(ctx context.Context, name string, commitRequest *v1.VirtualMachineContainerDiskCommitRequest) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "commitcontainerdisk", name, commitRequest), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachines) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "addvolume", name, addVolumeOptions), nil)
@@ -79,6 +79,13 @@ func (c *fakeVirtualMachineInstances) Unfreeze(ctx context.Context, name string)
 	return err
 }
 
+func (c *fakeVirtualMachineInstances) GuestExec(ctx context.Context, name string, guestExecOptions *v1.GuestExecOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "guestexec", name, guestExecOptions), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) Reset(ctx context.Context, name string) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "reset", name, struct{}{}), nil)
@@ -188,3 +195,10 @@ func (c *fakeVirtualMachineInstances) RedefineCheckpoint(ctx context.Context, na
 
 	return err
 }
+
+func (c *fakeVirtualMachineInstances) DeleteCheckpoint(ctx context.Context, name string, checkpoint *backupv1.BackupCheckpoint) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "delete-checkpoint", name, checkpoint), nil)
+
+	return err
+}
@@ -46,6 +46,7 @@ type VirtualMachineExpansion interface {
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
+	CommitContainerDisk(ctx context.Context, name string, commitRequest *v1.VirtualMachineContainerDiskCommitRequest) error
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
 	EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v1.EvacuateCancelOptions) error
 }
@@ -200,6 +201,23 @@ func (c *virtualMachines) RemoveMemoryDump(ctx context.Context, name string) err
 		Error()
 }
 
+func (c *virtualMachines) CommitContainerDisk(ctx context.Context, name string, commitRequest *v1.VirtualMachineContainerDiskCommitRequest) error {
+	body, err := json.Marshal(commitRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("commitcontainerdisk").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachines) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error) {
 	objectGraph := v1.ObjectGraphNode{}
 
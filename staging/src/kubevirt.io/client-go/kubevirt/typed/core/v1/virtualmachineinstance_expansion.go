@@ -46,10 +46,12 @@ type VirtualMachineInstanceExpansion interface {
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	Backup(ctx context.Context, name string, backupOptions *backupv1.BackupOptions) error
 	RedefineCheckpoint(ctx context.Context, name string, checkpoint *backupv1.BackupCheckpoint) error
+	DeleteCheckpoint(ctx context.Context, name string, checkpoint *backupv1.BackupCheckpoint) error
 	Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error
 	Unpause(ctx context.Context, name string, unpauseOptions *v1.UnpauseOptions) error
 	Freeze(ctx context.Context, name string, unfreezeTimeout time.Duration) error
 	Unfreeze(ctx context.Context, name string) error
+	GuestExec(ctx context.Context, name string, guestExecOptions *v1.GuestExecOptions) error
 	Reset(ctx context.Context, name string) error
 	SoftReboot(ctx context.Context, name string) error
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
@@ -148,6 +150,24 @@ func (c *virtualMachineInstances) RedefineCheckpoint(ctx context.Context, name s
 		Error()
 }
 
+func (c *virtualMachineInstances) DeleteCheckpoint(ctx context.Context, name string, checkpoint *backupv1.BackupCheckpoint) error {
+	log.Log.Infof("DeleteCheckpoint VMI %s with checkpoint %s", name, checkpoint.Name)
+	body, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("delete-checkpoint").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error {
 	body, err := json.Marshal(pauseOptions)
 	if err != nil {
@@ -218,6 +238,23 @@ func (c *virtualMachineInstances) Unfreeze(ctx context.Context, name string) err
 		Error()
 }
 
+func (c *virtualMachineInstances) GuestExec(ctx context.Context, name string, guestExecOptions *v1.GuestExecOptions) error {
+	body, err := json.Marshal(guestExecOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestexec").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) Reset(ctx context.Context, name string) error {
 	log.Log.Infof("Reset VMI")
 	return c.GetClient().Put().
@@ -34,6 +34,10 @@ func (c *FakeMigrationsV1alpha1) MigrationPolicies() v1alpha1.MigrationPolicyInt
 	return newFakeMigrationPolicies(c)
 }
 
+func (c *FakeMigrationsV1alpha1) VirtualMachineStorageMigrationPlans(namespace string) v1alpha1.VirtualMachineStorageMigrationPlanInterface {
+	return newFakeVirtualMachineStorageMigrationPlans(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMigrationsV1alpha1) RESTClient() rest.Interface {
@@ -31,6 +31,7 @@ import (
 type MigrationsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	MigrationPoliciesGetter
+	VirtualMachineStorageMigrationPlansGetter
 }
 
 // MigrationsV1alpha1Client is used to interact with features provided by the migrations.kubevirt.io group.
@@ -42,6 +43,10 @@ func (c *MigrationsV1alpha1Client) MigrationPolicies() MigrationPolicyInterface
 	return newMigrationPolicies(c)
 }
 
+func (c *MigrationsV1alpha1Client) VirtualMachineStorageMigrationPlans(namespace string) VirtualMachineStorageMigrationPlanInterface {
+	return newVirtualMachineStorageMigrationPlans(c, namespace)
+}
+
 // NewForConfig creates a new MigrationsV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -34,6 +34,10 @@ func (c *FakeSnapshotV1beta1) VirtualMachineRestores(namespace string) v1beta1.V
 	return newFakeVirtualMachineRestores(c, namespace)
 }
 
+func (c *FakeSnapshotV1beta1) VirtualMachineRestoreGroups(namespace string) v1beta1.VirtualMachineRestoreGroupInterface {
+	return newFakeVirtualMachineRestoreGroups(c, namespace)
+}
+
 func (c *FakeSnapshotV1beta1) VirtualMachineSnapshots(namespace string) v1beta1.VirtualMachineSnapshotInterface {
 	return newFakeVirtualMachineSnapshots(c, namespace)
 }
@@ -42,6 +46,14 @@ func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotContents(namespace string) v
 	return newFakeVirtualMachineSnapshotContents(c, namespace)
 }
 
+func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotGrants(namespace string) v1beta1.VirtualMachineSnapshotGrantInterface {
+	return newFakeVirtualMachineSnapshotGrants(c, namespace)
+}
+
+func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotGroups(namespace string) v1beta1.VirtualMachineSnapshotGroupInterface {
+	return newFakeVirtualMachineSnapshotGroups(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSnapshotV1beta1) RESTClient() rest.Interface {
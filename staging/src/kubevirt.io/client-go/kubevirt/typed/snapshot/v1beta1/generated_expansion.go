@@ -22,6 +22,12 @@ package v1beta1
 
 type VirtualMachineRestoreExpansion interface{}
 
+type VirtualMachineRestoreGroupExpansion interface{}
+
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotContentExpansion interface{}
+
+type VirtualMachineSnapshotGrantExpansion interface{}
+
+type VirtualMachineSnapshotGroupExpansion interface{}
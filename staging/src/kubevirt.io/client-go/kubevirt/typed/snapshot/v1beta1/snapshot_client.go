@@ -31,8 +31,11 @@ import (
 type SnapshotV1beta1Interface interface {
 	RESTClient() rest.Interface
 	VirtualMachineRestoresGetter
+	VirtualMachineRestoreGroupsGetter
 	VirtualMachineSnapshotsGetter
 	VirtualMachineSnapshotContentsGetter
+	VirtualMachineSnapshotGrantsGetter
+	VirtualMachineSnapshotGroupsGetter
 }
 
 // SnapshotV1beta1Client is used to interact with features provided by the snapshot.kubevirt.io group.
@@ -44,6 +47,10 @@ func (c *SnapshotV1beta1Client) VirtualMachineRestores(namespace string) Virtual
 	return newVirtualMachineRestores(c, namespace)
 }
 
+func (c *SnapshotV1beta1Client) VirtualMachineRestoreGroups(namespace string) VirtualMachineRestoreGroupInterface {
+	return newVirtualMachineRestoreGroups(c, namespace)
+}
+
 func (c *SnapshotV1beta1Client) VirtualMachineSnapshots(namespace string) VirtualMachineSnapshotInterface {
 	return newVirtualMachineSnapshots(c, namespace)
 }
@@ -52,6 +59,14 @@ func (c *SnapshotV1beta1Client) VirtualMachineSnapshotContents(namespace string)
 	return newVirtualMachineSnapshotContents(c, namespace)
 }
 
+func (c *SnapshotV1beta1Client) VirtualMachineSnapshotGrants(namespace string) VirtualMachineSnapshotGrantInterface {
+	return newVirtualMachineSnapshotGrants(c, namespace)
+}
+
+func (c *SnapshotV1beta1Client) VirtualMachineSnapshotGroups(namespace string) VirtualMachineSnapshotGroupInterface {
+	return newVirtualMachineSnapshotGroups(c, namespace)
+}
+
 // NewForConfig creates a new SnapshotV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
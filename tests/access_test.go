@@ -421,6 +421,9 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachineinstances", "unfreeze",
 				allowUpdateFor("admin", "edit"),
 				denyAllFor("view", "migrate", "default")),
+			Entry("on vmi guestexec",
+				"virtualmachineinstances", "guestexec",
+				denyAllFor("admin", "edit", "view", "migrate", "default")),
 			Entry("on vmi reset",
 				"virtualmachineinstances", "reset",
 				allowUpdateFor("admin", "edit"),
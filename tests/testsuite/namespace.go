@@ -261,6 +261,14 @@ func CleanNamespaces() {
 			Expect(virtCli.VirtualMachineClone(namespace).Delete(context.Background(), clone.Name, metav1.DeleteOptions{})).To(Succeed())
 		}
 
+		// Remove storage migration plans
+		Expect(virtCli.VirtualMachineStorageMigrationPlan(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
+
+		// Remove vm snapshot groups before the vm snapshots they own
+		Expect(virtCli.VirtualMachineSnapshotGroup(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
+		Expect(virtCli.VirtualMachineRestoreGroup(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
+		Expect(virtCli.VirtualMachineSnapshotGrant(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
+
 		// Remove vm snapshots
 		Expect(virtCli.VirtualMachineSnapshot(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
 		Expect(virtCli.VirtualMachineSnapshotContent(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
@@ -270,6 +278,16 @@ func CleanNamespaces() {
 		// Remove events
 		deleteEventsFromNamespace(namespace)
 
+		// Remove vmbackuprestores
+		Expect(virtCli.VirtualMachineBackupRestore(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
+
+		// Remove vmbackupschedules
+		vmbackupscheduleList, err := virtCli.VirtualMachineBackupSchedule(namespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for _, schedule := range vmbackupscheduleList.Items {
+			Expect(virtCli.VirtualMachineBackupSchedule(namespace).Delete(context.Background(), schedule.Name, metav1.DeleteOptions{})).To(Succeed())
+		}
+
 		// Remove vmbackups
 		vmbackupList, err := virtCli.VirtualMachineBackup(namespace).List(context.Background(), metav1.ListOptions{})
 		Expect(err).ToNot(HaveOccurred())